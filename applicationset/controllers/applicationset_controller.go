@@ -196,7 +196,22 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	// Log a warning if there are unrecognized generators
 	_ = utils.CheckInvalidGenerators(&applicationSetInfo)
 	// desiredApplications is the main list of all expected Applications from all generators in this appset.
-	generatedApplications, applicationSetReason, err := template.GenerateApplications(logCtx, applicationSetInfo, r.Generators, r.Renderer, r.Client)
+	var generatedApplications []argov1alpha1.Application
+	var applicationSetReason argov1alpha1.ApplicationSetReasonType
+	if isGeneratorDiagnosticsEnabled(&applicationSetInfo) {
+		var generatorStatuses []argov1alpha1.ApplicationSetGeneratorStatus
+		generatedApplications, generatorStatuses, applicationSetReason, err = template.GenerateApplicationsWithDiagnostics(logCtx, applicationSetInfo, r.Generators, r.Renderer, r.Client)
+		if updateErr := r.updateGeneratorsStatus(ctx, logCtx, &applicationSetInfo, generatorStatuses); updateErr != nil {
+			logCtx.WithError(updateErr).Warn("failed to record generator diagnostics")
+		}
+	} else {
+		generatedApplications, applicationSetReason, err = template.GenerateApplications(logCtx, applicationSetInfo, r.Generators, r.Renderer, r.Client)
+		if len(applicationSetInfo.Status.Generators) > 0 {
+			if updateErr := r.updateGeneratorsStatus(ctx, logCtx, &applicationSetInfo, nil); updateErr != nil {
+				logCtx.WithError(updateErr).Warn("failed to clear generator diagnostics")
+			}
+		}
+	}
 	if err != nil {
 		logCtx.Errorf("unable to generate applications: %v", err)
 		_ = r.setApplicationSetStatusCondition(ctx,
@@ -1136,6 +1151,41 @@ func (r *ApplicationSetReconciler) updateResourcesStatus(ctx context.Context, lo
 	return nil
 }
 
+// isGeneratorDiagnosticsEnabled returns true if the diagnostics of the generators should be recorded in the status of the ApplicationSet
+func isGeneratorDiagnosticsEnabled(appset *argov1alpha1.ApplicationSet) bool {
+	return appset.Annotations[common.AnnotationApplicationSetGeneratorDiagnostics] == "true"
+}
+
+// updateGeneratorsStatus records the diagnostics of the generators in the status of the ApplicationSet
+func (r *ApplicationSetReconciler) updateGeneratorsStatus(ctx context.Context, logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, generatorStatuses []argov1alpha1.ApplicationSetGeneratorStatus) error {
+	appset.Status.Generators = generatorStatuses
+	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		namespacedName := types.NamespacedName{Namespace: appset.Namespace, Name: appset.Name}
+		updatedAppset := &argov1alpha1.ApplicationSet{}
+		if err := r.Get(ctx, namespacedName, updatedAppset); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return nil
+			}
+			return fmt.Errorf("error fetching updated application set: %w", err)
+		}
+
+		updatedAppset.Status.Generators = generatorStatuses
+
+		err := r.Client.Status().Update(ctx, updatedAppset)
+		if err != nil {
+			return err
+		}
+		updatedAppset.DeepCopyInto(appset)
+		return nil
+	})
+	if err != nil {
+		logCtx.Errorf("unable to set application set generators status: %v", err)
+		return fmt.Errorf("unable to set application set generators status: %w", err)
+	}
+	return nil
+}
+
 // setAppSetApplicationStatus updates the ApplicationSet's status field
 // with any new/changed Application statuses.
 func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, applicationStatuses []argov1alpha1.ApplicationSetApplicationStatus) error {
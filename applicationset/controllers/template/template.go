@@ -1,8 +1,12 @@
 package template
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	log "github.com/sirupsen/logrus"
@@ -13,14 +17,39 @@ import (
 	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
+const (
+	// maxDiagnosticsParamSets is the maximum number of parameter sets recorded in the diagnostics of a generator
+	maxDiagnosticsParamSets = 10
+	// maxDiagnosticsParamSetLength is the maximum length of a parameter set recorded in the diagnostics of a generator
+	maxDiagnosticsParamSetLength = 1024
+)
+
 func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.ApplicationSet, g map[string]generators.Generator, renderer utils.Renderer, client client.Client) ([]argov1alpha1.Application, argov1alpha1.ApplicationSetReasonType, error) {
+	res, _, applicationSetReason, err := generateApplications(logCtx, applicationSetInfo, g, renderer, client, false)
+	return res, applicationSetReason, err
+}
+
+// GenerateApplicationsWithDiagnostics generates the Applications of an ApplicationSet like GenerateApplications, and
+// also returns diagnostics about the parameters produced by each of its generators.
+func GenerateApplicationsWithDiagnostics(logCtx *log.Entry, applicationSetInfo argov1alpha1.ApplicationSet, g map[string]generators.Generator, renderer utils.Renderer, client client.Client) ([]argov1alpha1.Application, []argov1alpha1.ApplicationSetGeneratorStatus, argov1alpha1.ApplicationSetReasonType, error) {
+	return generateApplications(logCtx, applicationSetInfo, g, renderer, client, true)
+}
+
+func generateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.ApplicationSet, g map[string]generators.Generator, renderer utils.Renderer, client client.Client, withDiagnostics bool) ([]argov1alpha1.Application, []argov1alpha1.ApplicationSetGeneratorStatus, argov1alpha1.ApplicationSetReasonType, error) {
 	var res []argov1alpha1.Application
+	var generatorStatuses []argov1alpha1.ApplicationSetGeneratorStatus
 
 	var firstError error
 	var applicationSetReason argov1alpha1.ApplicationSetReasonType
 
-	for _, requestedGenerator := range applicationSetInfo.Spec.Generators {
+	for i, requestedGenerator := range applicationSetInfo.Spec.Generators {
+		startTime := time.Now()
 		t, err := generators.Transform(requestedGenerator, g, applicationSetInfo.Spec.Template, &applicationSetInfo, map[string]any{}, client)
+		var generatorStatus *argov1alpha1.ApplicationSetGeneratorStatus
+		if withDiagnostics {
+			generatorStatuses = append(generatorStatuses, newGeneratorStatus(i, &requestedGenerator, t, err, startTime))
+			generatorStatus = &generatorStatuses[len(generatorStatuses)-1]
+		}
 		if err != nil {
 			logCtx.WithError(err).WithField("generator", requestedGenerator).
 				Error("error generating application from params")
@@ -44,6 +73,7 @@ func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.App
 						firstError = err
 						applicationSetReason = argov1alpha1.ApplicationSetReasonRenderTemplateParamsError
 					}
+					setGeneratorStatusError(generatorStatus, err)
 					continue
 				}
 
@@ -57,6 +87,7 @@ func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.App
 							firstError = err
 							applicationSetReason = argov1alpha1.ApplicationSetReasonRenderTemplateParamsError
 						}
+						setGeneratorStatusError(generatorStatus, err)
 						continue
 					}
 
@@ -76,7 +107,46 @@ func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.App
 		}
 	}
 
-	return res, applicationSetReason, firstError
+	return res, generatorStatuses, applicationSetReason, firstError
+}
+
+// newGeneratorStatus returns the diagnostics of a generator, given the result of its transformation
+func newGeneratorStatus(index int, requestedGenerator *argov1alpha1.ApplicationSetGenerator, results []generators.TransformResult, err error, startTime time.Time) argov1alpha1.ApplicationSetGeneratorStatus {
+	now := metav1.Now()
+	generatorStatus := argov1alpha1.ApplicationSetGeneratorStatus{
+		Index:                int64(index),
+		Type:                 strings.Join(generators.GetRelevantGeneratorNames(requestedGenerator), ","),
+		DurationMilliseconds: now.Sub(startTime).Milliseconds(),
+		GeneratedAt:          &now,
+	}
+	setGeneratorStatusError(&generatorStatus, err)
+	for _, result := range results {
+		for _, params := range result.Params {
+			generatorStatus.ParamsCount++
+			if len(generatorStatus.Params) >= maxDiagnosticsParamSets {
+				generatorStatus.ParamsTruncated = true
+				continue
+			}
+			paramsJSON, err := json.Marshal(params)
+			if err != nil {
+				paramsJSON = fmt.Appendf(nil, "%v", params)
+			}
+			if len(paramsJSON) > maxDiagnosticsParamSetLength {
+				paramsJSON = append(paramsJSON[:maxDiagnosticsParamSetLength], "..."...)
+				generatorStatus.ParamsTruncated = true
+			}
+			generatorStatus.Params = append(generatorStatus.Params, string(paramsJSON))
+		}
+	}
+	return generatorStatus
+}
+
+// setGeneratorStatusError records the first error of a generator in its diagnostics, if they are collected
+func setGeneratorStatusError(generatorStatus *argov1alpha1.ApplicationSetGeneratorStatus, err error) {
+	if generatorStatus == nil || err == nil || generatorStatus.Error != "" {
+		return
+	}
+	generatorStatus.Error = err.Error()
 }
 
 func renderTemplatePatch(r utils.Renderer, app *argov1alpha1.Application, applicationSetInfo argov1alpha1.ApplicationSet, params map[string]any) (*argov1alpha1.Application, error) {
@@ -152,6 +152,99 @@ func TestGenerateApplications(t *testing.T) {
 	}
 }
 
+func TestGenerateApplicationsWithDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	manyParams := make([]map[string]any, 0, maxDiagnosticsParamSets+2)
+	for i := range maxDiagnosticsParamSets + 2 {
+		manyParams = append(manyParams, map[string]any{"index": i})
+	}
+
+	for _, c := range []struct {
+		name                string
+		params              []map[string]any
+		generateParamsError error
+		rendererError       error
+		expectedStatus      v1alpha1.ApplicationSetGeneratorStatus
+	}{
+		{
+			name:   "Records params",
+			params: []map[string]any{{"name": "app1"}, {"name": "app2", "values": map[string]any{"replicas": 2}}},
+			expectedStatus: v1alpha1.ApplicationSetGeneratorStatus{
+				Type:        "List",
+				ParamsCount: 2,
+				Params:      []string{`{"name":"app1"}`, `{"name":"app2","values":{"replicas":2}}`},
+			},
+		},
+		{
+			name:   "Truncates params",
+			params: manyParams,
+			expectedStatus: v1alpha1.ApplicationSetGeneratorStatus{
+				Type:            "List",
+				ParamsCount:     int64(len(manyParams)),
+				Params:          []string{`{"index":0}`, `{"index":1}`, `{"index":2}`, `{"index":3}`, `{"index":4}`, `{"index":5}`, `{"index":6}`, `{"index":7}`, `{"index":8}`, `{"index":9}`},
+				ParamsTruncated: true,
+			},
+		},
+		{
+			name:                "Records generator error",
+			generateParamsError: errors.New("generator error"),
+			expectedStatus: v1alpha1.ApplicationSetGeneratorStatus{
+				Type:  "List",
+				Error: "generator error",
+			},
+		},
+		{
+			name:          "Records render error",
+			params:        []map[string]any{{"name": "app1"}},
+			rendererError: errors.New("render error"),
+			expectedStatus: v1alpha1.ApplicationSetGeneratorStatus{
+				Type:        "List",
+				ParamsCount: 1,
+				Params:      []string{`{"name":"app1"}`},
+				Error:       "render error",
+			},
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			generatorMock := &genmock.Generator{}
+			generator := v1alpha1.ApplicationSetGenerator{
+				List: &v1alpha1.ListGenerator{},
+			}
+			generatorMock.EXPECT().GenerateParams(&generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+				Return(c.params, c.generateParamsError)
+			generatorMock.EXPECT().GetTemplate(&generator).
+				Return(&v1alpha1.ApplicationSetTemplate{})
+
+			rendererMock := &rendmock.Renderer{}
+			rendererMock.EXPECT().RenderTemplateParams(mock.Anything, mock.Anything, mock.Anything, false, []string(nil)).
+				Return(&v1alpha1.Application{}, c.rendererError).Maybe()
+
+			_, generatorStatuses, _, _ := GenerateApplicationsWithDiagnostics(log.NewEntry(log.StandardLogger()), v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "namespace",
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Generators: []v1alpha1.ApplicationSetGenerator{generator},
+				},
+			},
+				map[string]generators.Generator{"List": generatorMock},
+				rendererMock,
+				nil,
+			)
+
+			require.Len(t, generatorStatuses, 1)
+			generatorStatus := generatorStatuses[0]
+			assert.NotNil(t, generatorStatus.GeneratedAt)
+			generatorStatus.GeneratedAt = nil
+			generatorStatus.DurationMilliseconds = 0
+			assert.Equal(t, c.expectedStatus, generatorStatus)
+		})
+	}
+}
+
 func TestMergeTemplateApplications(t *testing.T) {
 	t.Parallel()
 	for _, c := range []struct {
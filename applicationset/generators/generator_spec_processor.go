@@ -104,6 +104,17 @@ func Transform(requestedGenerator argoprojiov1alpha1.ApplicationSetGenerator, al
 func GetRelevantGenerators(requestedGenerator *argoprojiov1alpha1.ApplicationSetGenerator, generators map[string]Generator) []Generator {
 	var res []Generator
 
+	for _, name := range GetRelevantGeneratorNames(requestedGenerator) {
+		res = append(res, generators[name])
+	}
+
+	return res
+}
+
+// GetRelevantGeneratorNames returns the names of the generators set in the requested generator, e.g. List or Matrix
+func GetRelevantGeneratorNames(requestedGenerator *argoprojiov1alpha1.ApplicationSetGenerator) []string {
+	var res []string
+
 	v := reflect.Indirect(reflect.ValueOf(requestedGenerator))
 	for i := 0; i < v.NumField(); i++ {
 		field := v.Field(i)
@@ -116,7 +127,7 @@ func GetRelevantGenerators(requestedGenerator *argoprojiov1alpha1.ApplicationSet
 		}
 
 		if !reflect.ValueOf(field.Interface()).IsNil() {
-			res = append(res, generators[name])
+			res = append(res, name)
 		}
 	}
 
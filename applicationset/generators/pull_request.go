@@ -225,6 +225,8 @@ func (g *PullRequestGenerator) github(ctx context.Context, cfg *argoprojiov1alph
 		}
 		httpClient = services.NewGitHubMetricsClientFrom(httpClient, metricsCtx)
 	}
+	// the cache wraps the metrics transport, so that only requests actually sent to GitHub are measured
+	httpClient = services.NewSharedHTTPCacheClientFrom(httpClient)
 
 	// use an app if it was configured
 	if cfg.AppSecretName != "" {
@@ -303,6 +303,8 @@ func (g *SCMProviderGenerator) githubProvider(ctx context.Context, github *argop
 		}
 		httpClient = services.NewGitHubMetricsClientFrom(httpClient, metricsCtx)
 	}
+	// the cache wraps the metrics transport, so that only requests actually sent to GitHub are measured
+	httpClient = services.NewSharedHTTPCacheClientFrom(httpClient)
 
 	if github.AppSecretName != "" {
 		auth, err := g.GitHubApps.GetAuthSecret(ctx, github.AppSecretName)
@@ -0,0 +1,267 @@
+package services
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+)
+
+// The HTTP cache stores the responses of GET requests sent to SCM provider APIs. Fresh responses (younger than the
+// TTL) are returned without contacting the API at all. Stale responses carrying an ETag or Last-Modified header are
+// revalidated with a conditional request, so that unchanged resources are answered with a 304 Not Modified, which
+// does not count against the rate limit of most providers.
+
+const (
+	scmHTTPCacheRequestsTotalMetricName = "argocd_appset_scm_http_cache_requests_total"
+
+	httpCacheKeyPrefix = "scm-http"
+
+	// HTTPCacheResultHit is reported when a fresh response is returned without contacting the API
+	HTTPCacheResultHit = "hit"
+	// HTTPCacheResultRevalidated is reported when the API confirmed with a 304 that a cached response is unchanged
+	HTTPCacheResultRevalidated = "revalidated"
+	// HTTPCacheResultMiss is reported when the response had to be fetched from the API
+	HTTPCacheResultMiss = "miss"
+)
+
+// httpCacheKeyHeaders are the request headers which are part of the cache key. Most of them carry credentials, so
+// that responses are never shared between different credentials.
+var httpCacheKeyHeaders = []string{"Authorization", "Private-Token", "Job-Token", "Accept"}
+
+// HTTPCacheMetrics groups the metric vectors of the HTTP cache for easier injection and registration
+type HTTPCacheMetrics struct {
+	RequestTotal *prometheus.CounterVec
+}
+
+// NewHTTPCacheMetrics creates a new set of HTTP cache metrics (for tests or custom registries)
+func NewHTTPCacheMetrics() *HTTPCacheMetrics {
+	return &HTTPCacheMetrics{
+		RequestTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: scmHTTPCacheRequestsTotalMetricName,
+				Help: "Total number of cacheable SCM provider API requests, by cache result (hit, revalidated or miss)",
+			},
+			[]string{"host", "result"},
+		),
+	}
+}
+
+var globalHTTPCacheMetrics = NewHTTPCacheMetrics()
+
+func init() {
+	log.Debug("Registering SCM HTTP cache AppSet metrics")
+	metrics.Registry.MustRegister(globalHTTPCacheMetrics.RequestTotal)
+}
+
+// CachedHTTPResponse is a response stored in the HTTP cache
+type CachedHTTPResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	StoredAt   time.Time
+}
+
+// HTTPCache is a TTL- and ETag-aware cache of SCM provider API responses
+type HTTPCache struct {
+	cache *cacheutil.Cache
+	// ttl is the duration during which a stored response is returned without contacting the API
+	ttl time.Duration
+	// retention is the duration during which a stored response is kept for revalidation
+	retention time.Duration
+	metrics   *HTTPCacheMetrics
+}
+
+// NewHTTPCache creates an HTTP cache storing responses in the given cache, which may be backed by Redis
+func NewHTTPCache(cache *cacheutil.Cache, ttl time.Duration, retention time.Duration) *HTTPCache {
+	return &HTTPCache{
+		cache:     cache,
+		ttl:       ttl,
+		retention: max(ttl, retention),
+		metrics:   globalHTTPCacheMetrics,
+	}
+}
+
+// NewInMemoryHTTPCache creates an HTTP cache storing responses in the memory of the current process
+func NewInMemoryHTTPCache(ttl time.Duration, retention time.Duration) *HTTPCache {
+	return NewHTTPCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(max(ttl, retention))), ttl, retention)
+}
+
+func (c *HTTPCache) get(key string) (*CachedHTTPResponse, bool) {
+	var entry CachedHTTPResponse
+	err := c.cache.GetItem(key, &entry)
+	if err != nil {
+		if !errors.Is(err, cacheutil.ErrCacheMiss) {
+			log.Warnf("Failed to read SCM HTTP cache: %v", err)
+		}
+		return nil, false
+	}
+	return &entry, true
+}
+
+func (c *HTTPCache) set(key string, entry *CachedHTTPResponse) {
+	err := c.cache.SetItem(key, entry, &cacheutil.CacheActionOpts{Expiration: c.retention})
+	if err != nil {
+		log.Warnf("Failed to write SCM HTTP cache: %v", err)
+	}
+}
+
+// sharedHTTPCache is the HTTP cache used by all SCM and pull request providers. Caching is disabled while it is nil.
+var sharedHTTPCache atomic.Pointer[HTTPCache]
+
+// SetSharedHTTPCache configures the HTTP cache used by all SCM and pull request providers. A nil cache disables caching.
+func SetSharedHTTPCache(cache *HTTPCache) {
+	sharedHTTPCache.Store(cache)
+}
+
+// HTTPCacheTransport is a custom http.RoundTripper that serves GET requests from an HTTP cache
+type HTTPCacheTransport struct {
+	transport http.RoundTripper
+	cache     *HTTPCache
+}
+
+// NewHTTPCacheTransport wraps the given transport with the given HTTP cache
+func NewHTTPCacheTransport(transport http.RoundTripper, cache *HTTPCache) *HTTPCacheTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &HTTPCacheTransport{
+		transport: transport,
+		cache:     cache,
+	}
+}
+
+// NewSharedHTTPCacheTransport wraps the given transport with the shared HTTP cache, if one is configured
+func NewSharedHTTPCacheTransport(transport http.RoundTripper) http.RoundTripper {
+	cache := sharedHTTPCache.Load()
+	if cache == nil {
+		return transport
+	}
+	return NewHTTPCacheTransport(transport, cache)
+}
+
+// NewSharedHTTPCacheClientFrom returns a new http.Client wrapping the provided one with the shared HTTP cache, if one
+// is configured
+func NewSharedHTTPCacheClientFrom(httpClient *http.Client) *http.Client {
+	if sharedHTTPCache.Load() == nil {
+		return httpClient
+	}
+	httpClientCopy := *httpClient
+	httpClientCopy.Transport = NewSharedHTTPCacheTransport(httpClient.Transport)
+	return &httpClientCopy
+}
+
+// RoundTrip implements http.RoundTripper interface
+func (t *HTTPCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get("Range") != "" {
+		return t.transport.RoundTrip(req)
+	}
+
+	key := httpCacheKey(req)
+	host := req.URL.Hostname()
+	entry, found := t.cache.get(key)
+	if found && time.Since(entry.StoredAt) < t.cache.ttl {
+		t.cache.metrics.RequestTotal.WithLabelValues(host, HTTPCacheResultHit).Inc()
+		return entry.toResponse(req), nil
+	}
+
+	if found {
+		etag := entry.Header.Get("ETag")
+		lastModified := entry.Header.Get("Last-Modified")
+		if etag != "" || lastModified != "" {
+			req = req.Clone(req.Context())
+			if etag != "" {
+				req.Header.Set("If-None-Match", etag)
+			}
+			if lastModified != "" {
+				req.Header.Set("If-Modified-Since", lastModified)
+			}
+		}
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if found && resp.StatusCode == http.StatusNotModified {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		// the 304 carries up-to-date headers, e.g. the current rate limit
+		for name, values := range resp.Header {
+			entry.Header[name] = values
+		}
+		entry.StoredAt = time.Now()
+		t.cache.set(key, entry)
+		t.cache.metrics.RequestTotal.WithLabelValues(host, HTTPCacheResultRevalidated).Inc()
+		return entry.toResponse(req), nil
+	}
+
+	t.cache.metrics.RequestTotal.WithLabelValues(host, HTTPCacheResultMiss).Inc()
+	if !t.isCacheable(resp) {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	t.cache.set(key, &CachedHTTPResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+		StoredAt:   time.Now(),
+	})
+	return resp, nil
+}
+
+// isCacheable returns true if the response can be returned as-is until the TTL expires or can later be revalidated
+func (t *HTTPCacheTransport) isCacheable(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusOK {
+		return false
+	}
+	if strings.Contains(resp.Header.Get("Cache-Control"), "no-store") {
+		return false
+	}
+	return t.cache.ttl > 0 || resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != ""
+}
+
+func (e *CachedHTTPResponse) toResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// httpCacheKey returns the cache key of the given request. The credentials are hashed together with the URL, so that
+// responses are only shared between requests using the same credentials.
+func httpCacheKey(req *http.Request) string {
+	h := sha256.New()
+	_, _ = h.Write([]byte(req.URL.String()))
+	for _, name := range httpCacheKeyHeaders {
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(req.Header.Get(name)))
+	}
+	return httpCacheKeyPrefix + "|" + hex.EncodeToString(h.Sum(nil))
+}
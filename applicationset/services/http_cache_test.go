@@ -0,0 +1,138 @@
+package services
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type httpCacheTestServer struct {
+	*httptest.Server
+	requests        atomic.Int32
+	notModified     atomic.Int32
+	etag            atomic.Value
+	lastAuthHeaders []string
+}
+
+func newHTTPCacheTestServer(t *testing.T) *httpCacheTestServer {
+	t.Helper()
+	s := &httpCacheTestServer{}
+	s.etag.Store(`"v1"`)
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests.Add(1)
+		s.lastAuthHeaders = append(s.lastAuthHeaders, r.Header.Get("Authorization"))
+		etag := s.etag.Load().(string)
+		if r.Header.Get("If-None-Match") == etag {
+			s.notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte("body " + etag + " " + r.Header.Get("Authorization")))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newTestHTTPCacheClient(ttl time.Duration) (*http.Client, *HTTPCache) {
+	cache := NewInMemoryHTTPCache(ttl, time.Hour)
+	cache.metrics = NewHTTPCacheMetrics()
+	return &http.Client{Transport: NewHTTPCacheTransport(http.DefaultTransport, cache)}, cache
+}
+
+func doGet(t *testing.T, client *http.Client, rawURL string, authorization string) string {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, rawURL, http.NoBody)
+	require.NoError(t, err)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func cacheResultCount(t *testing.T, cache *HTTPCache, rawURL string, result string) float64 {
+	t.Helper()
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	return testutil.ToFloat64(cache.metrics.RequestTotal.WithLabelValues(u.Hostname(), result))
+}
+
+func TestHTTPCacheTransport_Revalidation(t *testing.T) {
+	server := newHTTPCacheTestServer(t)
+	client, cache := newTestHTTPCacheClient(0)
+
+	assert.Equal(t, `body "v1" token`, doGet(t, client, server.URL, "token"))
+	assert.Equal(t, `body "v1" token`, doGet(t, client, server.URL, "token"))
+	assert.Equal(t, int32(2), server.requests.Load())
+	assert.Equal(t, int32(1), server.notModified.Load())
+	assert.InDelta(t, 1, cacheResultCount(t, cache, server.URL, HTTPCacheResultMiss), 0)
+	assert.InDelta(t, 1, cacheResultCount(t, cache, server.URL, HTTPCacheResultRevalidated), 0)
+
+	// the resource changed, so the full response is returned and cached again
+	server.etag.Store(`"v2"`)
+	assert.Equal(t, `body "v2" token`, doGet(t, client, server.URL, "token"))
+	assert.Equal(t, `body "v2" token`, doGet(t, client, server.URL, "token"))
+	assert.Equal(t, int32(4), server.requests.Load())
+	assert.Equal(t, int32(2), server.notModified.Load())
+}
+
+func TestHTTPCacheTransport_TTL(t *testing.T) {
+	server := newHTTPCacheTestServer(t)
+	client, cache := newTestHTTPCacheClient(time.Hour)
+
+	assert.Equal(t, `body "v1" token`, doGet(t, client, server.URL, "token"))
+	server.etag.Store(`"v2"`)
+	assert.Equal(t, `body "v1" token`, doGet(t, client, server.URL, "token"))
+	assert.Equal(t, int32(1), server.requests.Load())
+	assert.InDelta(t, 1, cacheResultCount(t, cache, server.URL, HTTPCacheResultHit), 0)
+}
+
+func TestHTTPCacheTransport_CredentialsAreNotShared(t *testing.T) {
+	server := newHTTPCacheTestServer(t)
+	client, _ := newTestHTTPCacheClient(time.Hour)
+
+	assert.Equal(t, `body "v1" token-a`, doGet(t, client, server.URL, "token-a"))
+	assert.Equal(t, `body "v1" token-b`, doGet(t, client, server.URL, "token-b"))
+	assert.Equal(t, `body "v1" token-a`, doGet(t, client, server.URL, "token-a"))
+	assert.Equal(t, int32(2), server.requests.Load())
+	assert.Equal(t, []string{"token-a", "token-b"}, server.lastAuthHeaders)
+}
+
+func TestHTTPCacheTransport_NonGetRequestsAreNotCached(t *testing.T) {
+	server := newHTTPCacheTestServer(t)
+	client, cache := newTestHTTPCacheClient(time.Hour)
+
+	for range 2 {
+		resp, err := client.Post(server.URL, "application/json", http.NoBody)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+	assert.Equal(t, int32(2), server.requests.Load())
+	assert.InDelta(t, 0, cacheResultCount(t, cache, server.URL, HTTPCacheResultMiss), 0)
+}
+
+func TestNewSharedHTTPCacheClientFrom(t *testing.T) {
+	client := &http.Client{}
+	assert.Same(t, client, NewSharedHTTPCacheClientFrom(client))
+
+	SetSharedHTTPCache(NewInMemoryHTTPCache(0, time.Hour))
+	defer SetSharedHTTPCache(nil)
+	cachedClient := NewSharedHTTPCacheClientFrom(client)
+	assert.NotSame(t, client, cachedClient)
+	assert.IsType(t, &HTTPCacheTransport{}, cachedClient.Transport)
+	assert.Nil(t, client.Transport)
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 )
 
 const (
@@ -26,7 +28,7 @@ type devopsFactoryImpl struct {
 }
 
 func (factory *devopsFactoryImpl) GetClient(ctx context.Context) (git.Client, error) {
-	gitClient, err := git.NewClient(ctx, factory.connection)
+	gitClient, err := services.NewAzureDevOpsGitClient(ctx, factory.connection)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get new Azure DevOps git client for pull request generator: %w", err)
 	}
@@ -9,6 +9,8 @@ import (
 	"strings"
 
 	"github.com/ktrysmt/go-bitbucket"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 )
 
 type BitbucketCloudService struct {
@@ -86,6 +88,7 @@ func NewBitbucketCloudServiceBasicAuth(baseURL, username, password, owner, repos
 		return nil, fmt.Errorf("error creating BitBucket Cloud client with basic auth: %w", err)
 	}
 	bitbucketClient.SetApiBaseURL(*url)
+	bitbucketClient.HttpClient = services.NewSharedHTTPCacheClientFrom(bitbucketClient.HttpClient)
 
 	return &BitbucketCloudService{
 		client:         bitbucketClient,
@@ -105,6 +108,7 @@ func NewBitbucketCloudServiceBearerToken(baseURL, bearerToken, owner, repository
 		return nil, fmt.Errorf("error creating BitBucket Cloud client with oauth bearer token: %w", err)
 	}
 	bitbucketClient.SetApiBaseURL(*url)
+	bitbucketClient.HttpClient = services.NewSharedHTTPCacheClientFrom(bitbucketClient.HttpClient)
 
 	return &BitbucketCloudService{client: bitbucketClient, owner: owner, repositorySlug: repositorySlug}, nil
 }
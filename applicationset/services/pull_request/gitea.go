@@ -9,6 +9,7 @@ import (
 
 	"code.gitea.io/sdk/gitea"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	"github.com/argoproj/argo-cd/v3/util/proxy"
 )
 
@@ -35,7 +36,7 @@ func NewGiteaService(token, url, owner, repo string, labels []string, insecure b
 
 	httpClient := &http.Client{
 		Jar:       cookieJar,
-		Transport: tr,
+		Transport: services.NewSharedHTTPCacheTransport(tr),
 	}
 	client, err := gitea.NewClient(url, gitea.SetToken(token), gitea.SetHTTPClient(httpClient))
 	if err != nil {
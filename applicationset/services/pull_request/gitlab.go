@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/go-retryablehttp"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	"github.com/argoproj/argo-cd/v3/util/proxy"
 )
@@ -39,7 +40,7 @@ func NewGitLabService(token, url, project string, labels []string, pullRequestSt
 	tr.Proxy = proxy.GetCallback(proxyURL, noProxy)
 
 	retryClient := retryablehttp.NewClient()
-	retryClient.HTTPClient.Transport = tr
+	retryClient.HTTPClient.Transport = services.NewSharedHTTPCacheTransport(tr)
 
 	clientOptionFns = append(clientOptionFns, gitlab.WithHTTPClient(retryClient.HTTPClient))
 
@@ -10,6 +10,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	azureGit "github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 )
 
 const AZURE_DEVOPS_DEFAULT_URL = "https://dev.azure.com"
@@ -34,7 +36,7 @@ type devopsFactoryImpl struct {
 }
 
 func (factory *devopsFactoryImpl) GetClient(ctx context.Context) (azureGit.Client, error) {
-	gitClient, err := azureGit.NewClient(ctx, factory.connection)
+	gitClient, err := services.NewAzureDevOpsGitClient(ctx, factory.connection)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get new Azure DevOps git client for SCM generator: %w", err)
 	}
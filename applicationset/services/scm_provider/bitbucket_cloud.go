@@ -8,6 +8,8 @@ import (
 	"strings"
 
 	bitbucket "github.com/ktrysmt/go-bitbucket"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 )
 
 type BitBucketCloudProvider struct {
@@ -57,6 +59,7 @@ func NewBitBucketCloudProvider(owner string, user string, password string, allBr
 	if err != nil {
 		return nil, fmt.Errorf("error creating BitBucket Cloud client with basic auth: %w", err)
 	}
+	bitbucketClient.HttpClient = services.NewSharedHTTPCacheClientFrom(bitbucketClient.HttpClient)
 	client := &ExtendedClient{
 		bitbucketClient,
 		user,
@@ -10,6 +10,7 @@ import (
 
 	"code.gitea.io/sdk/gitea"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	"github.com/argoproj/argo-cd/v3/util/proxy"
 )
 
@@ -34,7 +35,7 @@ func NewGiteaProvider(owner, token, url string, allBranches, insecure, excludeAr
 	transport.Proxy = proxy.GetCallback(proxyURL, noProxy)
 
 	cookieJar, _ := cookiejar.New(nil)
-	httpClient := &http.Client{Jar: cookieJar, Transport: transport}
+	httpClient := &http.Client{Jar: cookieJar, Transport: services.NewSharedHTTPCacheTransport(transport)}
 	client, err := gitea.NewClient(url, gitea.SetToken(token), gitea.SetHTTPClient(httpClient))
 	if err != nil {
 		return nil, fmt.Errorf("error creating a new gitea client: %w", err)
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-retryablehttp"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	"github.com/argoproj/argo-cd/v3/util/proxy"
 )
@@ -38,7 +39,7 @@ func NewGitlabProvider(organization string, token string, url string, allBranche
 	tr.Proxy = proxy.GetCallback(proxyURL, noProxy)
 
 	retryClient := retryablehttp.NewClient()
-	retryClient.HTTPClient.Transport = tr
+	retryClient.HTTPClient.Transport = services.NewSharedHTTPCacheTransport(tr)
 
 	if url == "" {
 		var err error
@@ -2,9 +2,13 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	bitbucketv1 "github.com/gfleury/go-bitbucket-v1"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	azureGit "github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	"github.com/argoproj/argo-cd/v3/util/proxy"
@@ -18,7 +22,39 @@ func SetupBitbucketClient(ctx context.Context, config *bitbucketv1.Configuration
 	transport := http.DefaultTransport.(*http.Transport).Clone()
 	transport.TLSClientConfig = tlsConfig
 	transport.Proxy = proxy.GetCallback(proxyURL, noProxy)
-	config.HTTPClient = &http.Client{Transport: transport}
+	config.HTTPClient = &http.Client{Transport: NewSharedHTTPCacheTransport(transport)}
 
 	return bitbucketv1.NewAPIClient(ctx, config)
 }
+
+// NewAzureDevOpsGitClient creates an Azure DevOps git client for the given connection, which sends its requests through
+// the shared HTTP cache if one is configured. Since the connections of the Azure DevOps SDK always create their own HTTP
+// clients, the client of the git resource area is then resolved here rather than by the connection.
+func NewAzureDevOpsGitClient(ctx context.Context, connection *azuredevops.Connection) (azureGit.Client, error) {
+	if sharedHTTPCache.Load() == nil {
+		return azureGit.NewClient(ctx, connection)
+	}
+	httpClient := &http.Client{}
+	if connection.TlsConfig != nil {
+		httpClient.Transport = &http.Transport{TLSClientConfig: connection.TlsConfig}
+	}
+	if connection.Timeout != nil {
+		httpClient.Timeout = *connection.Timeout
+	}
+	httpClient = NewSharedHTTPCacheClientFrom(httpClient)
+
+	resourceAreas, err := azuredevops.NewClientWithOptions(connection, connection.BaseUrl, azuredevops.WithHTTPClient(httpClient)).GetResourceAreas(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting Azure DevOps resource areas: %w", err)
+	}
+	// on-premises servers have no resource areas and serve the git API from their base URL
+	baseURL := connection.BaseUrl
+	for _, resourceArea := range *resourceAreas {
+		if resourceArea.Id != nil && *resourceArea.Id == azureGit.ResourceAreaId && resourceArea.LocationUrl != nil {
+			baseURL = *resourceArea.LocationUrl
+			break
+		}
+	}
+	client := azuredevops.NewClientWithOptions(connection, strings.ToLower(strings.TrimRight(baseURL, "/")), azuredevops.WithHTTPClient(httpClient))
+	return &azureGit.ClientImpl{Client: *client}, nil
+}
@@ -2,11 +2,17 @@ package services
 
 import (
 	"crypto/tls"
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	bitbucketv1 "github.com/gfleury/go-bitbucket-v1"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	azureGit "github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,3 +41,56 @@ func TestSetupBitbucketClient(t *testing.T) {
 	require.Positive(t, tr.MaxIdleConns, "MaxIdleConns should be non-zero")
 	require.Greater(t, tr.TLSHandshakeTimeout, time.Duration(0), "TLSHandshakeTimeout should be non-zero")
 }
+
+func TestNewAzureDevOpsGitClient_SharedHTTPCache(t *testing.T) {
+	var gitRequests, notModified atomic.Int32
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		location := func(id, area, resource, routeTemplate string) map[string]any {
+			return map[string]any{
+				"id": id, "area": area, "resourceName": resource, "routeTemplate": routeTemplate,
+				"minVersion": "1.0", "maxVersion": "7.1", "releasedVersion": "7.0", "resourceVersion": 1,
+			}
+		}
+		var value any
+		switch {
+		case r.Method == http.MethodOptions && r.URL.Path == "/_apis":
+			value = []any{location("e81700f7-3be2-46de-8624-2eb35882fcaa", "Location", "ResourceAreas", "_apis/{resource}")}
+		case r.Method == http.MethodOptions && r.URL.Path == "/git/_apis":
+			value = []any{location("225f7195-f9c7-4d14-ab28-a83f7ff77e1f", "git", "repositories", "{project}/_apis/{area}/{resource}")}
+		case r.URL.Path == "/_apis/ResourceAreas":
+			value = []any{map[string]any{"id": "4e080c62-fa21-4fbc-8fef-2a10a2b38049", "name": "git", "locationUrl": server.URL + "/Git/"}}
+		case r.URL.Path == "/git/project/_apis/git/repositories":
+			gitRequests.Add(1)
+			value = []any{map[string]any{"name": "repo"}}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"count": 1, "value": value})
+	}))
+	defer server.Close()
+
+	SetSharedHTTPCache(NewInMemoryHTTPCache(0, time.Hour))
+	defer SetSharedHTTPCache(nil)
+	connection := azuredevops.NewPatConnection(server.URL, "token")
+	project := "project"
+	for range 2 {
+		gitClient, err := NewAzureDevOpsGitClient(t.Context(), connection)
+		require.NoError(t, err)
+		repos, err := gitClient.GetRepositories(t.Context(), azureGit.GetRepositoriesArgs{Project: &project})
+		require.NoError(t, err)
+		require.Len(t, *repos, 1)
+		assert.Equal(t, "repo", *(*repos)[0].Name)
+	}
+	// the git API is served from the location of its resource area, and both requests are revalidated the second time
+	assert.Equal(t, int32(2), gitRequests.Load())
+	assert.Equal(t, int32(2), notModified.Load())
+}
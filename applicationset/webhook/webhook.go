@@ -23,6 +23,9 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/webhook"
 
 	"github.com/go-playground/webhooks/v6/azuredevops"
+	"github.com/go-playground/webhooks/v6/bitbucket"
+	bitbucketserver "github.com/go-playground/webhooks/v6/bitbucket-server"
+	"github.com/go-playground/webhooks/v6/gitea"
 	"github.com/go-playground/webhooks/v6/github"
 	"github.com/go-playground/webhooks/v6/gitlab"
 	log "github.com/sirupsen/logrus"
@@ -35,13 +38,16 @@ const payloadQueueSize = 50000
 const panicMsgAppSet = "panic while processing applicationset-controller webhook event"
 
 type WebhookHandler struct {
-	sync.WaitGroup // for testing
-	github         *github.Webhook
-	gitlab         *gitlab.Webhook
-	azuredevops    *azuredevops.Webhook
-	client         client.Client
-	generators     map[string]generators.Generator
-	queue          chan any
+	sync.WaitGroup  // for testing
+	github          *github.Webhook
+	gitlab          *gitlab.Webhook
+	gitea           *gitea.Webhook
+	bitbucket       *bitbucket.Webhook
+	bitbucketServer *bitbucketserver.Webhook
+	azuredevops     *azuredevops.Webhook
+	client          client.Client
+	generators      map[string]generators.Generator
+	queue           chan any
 }
 
 type gitGeneratorInfo struct {
@@ -72,6 +78,44 @@ type prGeneratorGitlabInfo struct {
 	APIHostname string
 }
 
+// scmProviderGeneratorInfo describes an organisation/group level event (repository created, deleted,
+// archived or renamed, branch created or deleted) which may change the output of SCM provider generators.
+type scmProviderGeneratorInfo struct {
+	// BranchOnly is true when only the branches of an existing repository changed. Such events are only
+	// relevant for generators scanning all branches.
+	BranchOnly      bool
+	Github          *scmProviderGeneratorGithubInfo
+	Gitlab          *scmProviderGeneratorGitlabInfo
+	Gitea           *scmProviderGeneratorGiteaInfo
+	Bitbucket       *scmProviderGeneratorBitbucketInfo
+	BitbucketServer *scmProviderGeneratorBitbucketServerInfo
+}
+
+type scmProviderGeneratorGithubInfo struct {
+	Organization string
+	APIHostname  string
+}
+
+type scmProviderGeneratorGitlabInfo struct {
+	// Namespaces contains the full paths of the groups owning the project, before and after the event.
+	Namespaces []string
+	// APIHostname is empty for system hook events, which don't carry the URL of the GitLab instance.
+	APIHostname string
+}
+
+type scmProviderGeneratorGiteaInfo struct {
+	Owner       string
+	APIHostname string
+}
+
+type scmProviderGeneratorBitbucketInfo struct {
+	Owner string
+}
+
+type scmProviderGeneratorBitbucketServerInfo struct {
+	Project string
+}
+
 func NewWebhookHandler(webhookParallelism int, argocdSettingsMgr *argosettings.SettingsManager, client client.Client, generators map[string]generators.Generator) (*WebhookHandler, error) {
 	// register the webhook secrets stored under "argocd-secret" for verifying incoming payloads
 	argocdSettings, err := argocdSettingsMgr.GetSettings()
@@ -86,18 +130,34 @@ func NewWebhookHandler(webhookParallelism int, argocdSettingsMgr *argosettings.S
 	if err != nil {
 		return nil, fmt.Errorf("unable to init GitLab webhook: %w", err)
 	}
+	// Gitea is a fork of Gogs and shares its webhook secret
+	giteaHandler, err := gitea.New(gitea.Options.Secret(argocdSettings.GetWebhookGogsSecret()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to init Gitea webhook: %w", err)
+	}
+	bitbucketHandler, err := bitbucket.New(bitbucket.Options.UUID(argocdSettings.GetWebhookBitbucketUUID()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to init Bitbucket webhook: %w", err)
+	}
+	bitbucketServerHandler, err := bitbucketserver.New(bitbucketserver.Options.Secret(argocdSettings.GetWebhookBitbucketServerSecret()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to init Bitbucket Server webhook: %w", err)
+	}
 	azuredevopsHandler, err := azuredevops.New(azuredevops.Options.BasicAuth(argocdSettings.GetWebhookAzureDevOpsUsername(), argocdSettings.GetWebhookAzureDevOpsPassword()))
 	if err != nil {
 		return nil, fmt.Errorf("unable to init Azure DevOps webhook: %w", err)
 	}
 
 	webhookHandler := &WebhookHandler{
-		github:      githubHandler,
-		gitlab:      gitlabHandler,
-		azuredevops: azuredevopsHandler,
-		client:      client,
-		generators:  generators,
-		queue:       make(chan any, payloadQueueSize),
+		github:          githubHandler,
+		gitlab:          gitlabHandler,
+		gitea:           giteaHandler,
+		bitbucket:       bitbucketHandler,
+		bitbucketServer: bitbucketServerHandler,
+		azuredevops:     azuredevopsHandler,
+		client:          client,
+		generators:      generators,
+		queue:           make(chan any, payloadQueueSize),
 	}
 
 	webhookHandler.startWorkerPool(webhookParallelism)
@@ -123,7 +183,8 @@ func (h *WebhookHandler) startWorkerPool(webhookParallelism int) {
 func (h *WebhookHandler) HandleEvent(payload any) {
 	gitGenInfo := getGitGeneratorInfo(payload)
 	prGenInfo := getPRGeneratorInfo(payload)
-	if gitGenInfo == nil && prGenInfo == nil {
+	scmGenInfo := getSCMProviderGeneratorInfo(payload)
+	if gitGenInfo == nil && prGenInfo == nil && scmGenInfo == nil {
 		return
 	}
 
@@ -140,9 +201,10 @@ func (h *WebhookHandler) HandleEvent(payload any) {
 			// check if the ApplicationSet uses any generator that is relevant to the payload
 			shouldRefresh = shouldRefreshGitGenerator(gen.Git, gitGenInfo) ||
 				shouldRefreshPRGenerator(gen.PullRequest, prGenInfo) ||
+				shouldRefreshSCMProviderGenerator(gen.SCMProvider, scmGenInfo) ||
 				shouldRefreshPluginGenerator(gen.Plugin) ||
-				h.shouldRefreshMatrixGenerator(gen.Matrix, &appSet, gitGenInfo, prGenInfo) ||
-				h.shouldRefreshMergeGenerator(gen.Merge, &appSet, gitGenInfo, prGenInfo)
+				h.shouldRefreshMatrixGenerator(gen.Matrix, &appSet, gitGenInfo, prGenInfo, scmGenInfo) ||
+				h.shouldRefreshMergeGenerator(gen.Merge, &appSet, gitGenInfo, prGenInfo, scmGenInfo)
 			if shouldRefresh {
 				break
 			}
@@ -163,10 +225,18 @@ func (h *WebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 	var err error
 
 	switch {
+	// Gitea also sends GitHub headers, so its organisation events need to be checked before GitHub
+	case slices.Contains(giteaSCMProviderEvents, gitea.Event(r.Header.Get("X-Gitea-Event"))):
+		payload, err = h.gitea.Parse(r, giteaSCMProviderEvents...)
 	case r.Header.Get("X-GitHub-Event") != "":
-		payload, err = h.github.Parse(r, github.PushEvent, github.PullRequestEvent, github.PingEvent)
+		payload, err = h.github.Parse(r, github.PushEvent, github.PullRequestEvent, github.PingEvent, github.RepositoryEvent, github.CreateEvent, github.DeleteEvent)
 	case r.Header.Get("X-Gitlab-Event") != "":
 		payload, err = h.gitlab.Parse(r, gitlab.PushEvents, gitlab.TagEvents, gitlab.MergeRequestEvents, gitlab.SystemHookEvents)
+	// Bitbucket Cloud also sends the X-Event-Key header, so it needs to be checked before Bitbucket Server
+	case r.Header.Get("X-Hook-UUID") != "":
+		payload, err = h.bitbucket.Parse(r, bitbucket.RepoPushEvent, bitbucket.RepoUpdatedEvent)
+	case r.Header.Get("X-Event-Key") != "":
+		payload, err = h.bitbucketServer.Parse(r, bitbucketserver.RepositoryReferenceChangedEvent, bitbucketserver.RepositoryModifiedEvent, bitbucketserver.DiagnosticsPingEvent)
 	case r.Header.Get("X-Vss-Activityid") != "":
 		payload, err = h.azuredevops.Parse(r, azuredevops.GitPushEventType, azuredevops.GitPullRequestCreatedEventType, azuredevops.GitPullRequestUpdatedEventType, azuredevops.GitPullRequestMergedEventType)
 	default:
@@ -286,6 +356,176 @@ func getPRGeneratorInfo(payload any) *prGeneratorInfo {
 	return &info
 }
 
+// gitRefTypeBranch is the ref type used by GitHub and Gitea create/delete events for branches
+const gitRefTypeBranch = "branch"
+
+// gitNullCommit is the commit SHA GitLab uses as the before/after value of pushes creating/deleting a branch
+const gitNullCommit = "0000000000000000000000000000000000000000"
+
+func getSCMProviderGeneratorInfo(payload any) *scmProviderGeneratorInfo {
+	var info scmProviderGeneratorInfo
+	switch payload := payload.(type) {
+	case github.RepositoryPayload:
+		if !slices.Contains(githubAllowedRepositoryActions, payload.Action) {
+			return nil
+		}
+		info.Github = &scmProviderGeneratorGithubInfo{
+			Organization: payload.Repository.Owner.Login,
+			APIHostname:  urlHostname(payload.Repository.URL),
+		}
+	case github.CreatePayload:
+		if payload.RefType != gitRefTypeBranch {
+			return nil
+		}
+		info.BranchOnly = true
+		info.Github = &scmProviderGeneratorGithubInfo{
+			Organization: payload.Repository.Owner.Login,
+			APIHostname:  urlHostname(payload.Repository.URL),
+		}
+	case github.DeletePayload:
+		if payload.RefType != gitRefTypeBranch {
+			return nil
+		}
+		info.BranchOnly = true
+		info.Github = &scmProviderGeneratorGithubInfo{
+			Organization: payload.Repository.Owner.Login,
+			APIHostname:  urlHostname(payload.Repository.URL),
+		}
+	case gitlab.PushEventPayload:
+		// GitLab has no dedicated branch events, pushes from or to the null commit create or delete a branch
+		if payload.Before != gitNullCommit && payload.After != gitNullCommit {
+			return nil
+		}
+		info.BranchOnly = true
+		info.Gitlab = &scmProviderGeneratorGitlabInfo{
+			Namespaces:  []string{gitlabProjectNamespace(payload.Project.PathWithNamespace)},
+			APIHostname: urlHostname(payload.Project.WebURL),
+		}
+	case gitlab.ProjectCreatedEventPayload:
+		info.Gitlab = &scmProviderGeneratorGitlabInfo{
+			Namespaces: []string{gitlabProjectNamespace(payload.PathWithNamespace)},
+		}
+	case gitlab.ProjectDestroyedEventPayload:
+		info.Gitlab = &scmProviderGeneratorGitlabInfo{
+			Namespaces: []string{gitlabProjectNamespace(payload.PathWithNamespace)},
+		}
+	case gitlab.ProjectRenamedEventPayload:
+		info.Gitlab = &scmProviderGeneratorGitlabInfo{
+			Namespaces: []string{gitlabProjectNamespace(payload.PathWithNamespace), gitlabProjectNamespace(payload.OldPathWithNamespace)},
+		}
+	case gitlab.ProjectTransferredEventPayload:
+		info.Gitlab = &scmProviderGeneratorGitlabInfo{
+			Namespaces: []string{gitlabProjectNamespace(payload.PathWithNamespace), gitlabProjectNamespace(payload.OldPathWithNamespace)},
+		}
+	case gitea.RepositoryPayload:
+		if payload.Repository == nil || payload.Repository.Owner == nil {
+			return nil
+		}
+		info.Gitea = &scmProviderGeneratorGiteaInfo{
+			Owner:       payload.Repository.Owner.UserName,
+			APIHostname: urlHostname(payload.Repository.HTMLURL),
+		}
+	case gitea.CreatePayload:
+		if payload.RefType != gitRefTypeBranch || payload.Repo == nil || payload.Repo.Owner == nil {
+			return nil
+		}
+		info.BranchOnly = true
+		info.Gitea = &scmProviderGeneratorGiteaInfo{
+			Owner:       payload.Repo.Owner.UserName,
+			APIHostname: urlHostname(payload.Repo.HTMLURL),
+		}
+	case gitea.DeletePayload:
+		if payload.RefType != gitRefTypeBranch || payload.Repo == nil || payload.Repo.Owner == nil {
+			return nil
+		}
+		info.BranchOnly = true
+		info.Gitea = &scmProviderGeneratorGiteaInfo{
+			Owner:       payload.Repo.Owner.UserName,
+			APIHostname: urlHostname(payload.Repo.HTMLURL),
+		}
+	case bitbucket.RepoPushPayload:
+		// See: https://support.atlassian.com/bitbucket-cloud/docs/event-payloads/#Push
+		branchChanged := false
+		for _, change := range payload.Push.Changes {
+			branchChanged = branchChanged || change.Created || change.Closed
+		}
+		if !branchChanged {
+			return nil
+		}
+		info.BranchOnly = true
+		info.Bitbucket = &scmProviderGeneratorBitbucketInfo{
+			Owner: bitbucketWorkspace(payload.Repository.FullName),
+		}
+	case bitbucket.RepoUpdatedPayload:
+		info.Bitbucket = &scmProviderGeneratorBitbucketInfo{
+			Owner: bitbucketWorkspace(payload.Repository.FullName),
+		}
+	case bitbucketserver.RepositoryReferenceChangedPayload:
+		branchChanged := slices.ContainsFunc(payload.Changes, func(change bitbucketserver.RepositoryChange) bool {
+			return change.Reference.Type == "BRANCH" && (change.Type == "ADD" || change.Type == "DELETE")
+		})
+		if !branchChanged {
+			return nil
+		}
+		info.BranchOnly = true
+		info.BitbucketServer = &scmProviderGeneratorBitbucketServerInfo{
+			Project: payload.Repository.Project.Key,
+		}
+	case bitbucketserver.RepositoryModifiedPayload:
+		info.BitbucketServer = &scmProviderGeneratorBitbucketServerInfo{
+			Project: payload.New.Project.Key,
+		}
+	default:
+		return nil
+	}
+
+	log.Infof("Received repository event affecting SCM providers, branchOnly: %v", info.BranchOnly)
+	return &info
+}
+
+// urlHostname returns the hostname of the given URL, or an empty string if it can't be parsed
+func urlHostname(rawURL string) string {
+	urlObj, err := url.Parse(rawURL)
+	if err != nil {
+		log.Errorf("Failed to parse URL '%s'", rawURL)
+		return ""
+	}
+	return urlObj.Hostname()
+}
+
+// gitlabProjectNamespace returns the full path of the group owning the project with the given path
+func gitlabProjectNamespace(pathWithNamespace string) string {
+	i := strings.LastIndex(pathWithNamespace, "/")
+	if i < 0 {
+		return ""
+	}
+	return pathWithNamespace[:i]
+}
+
+// bitbucketWorkspace returns the workspace of the repository with the given full name
+func bitbucketWorkspace(fullName string) string {
+	workspace, _, _ := strings.Cut(fullName, "/")
+	return workspace
+}
+
+// githubAllowedRepositoryActions is a list of github repository actions that allow refresh
+// https://docs.github.com/en/webhooks/webhook-events-and-payloads#repository
+var githubAllowedRepositoryActions = []string{
+	"created",
+	"deleted",
+	"archived",
+	"unarchived",
+	"renamed",
+	"transferred",
+}
+
+// giteaSCMProviderEvents is a list of gitea events that are relevant to SCM provider generators
+var giteaSCMProviderEvents = []gitea.Event{
+	gitea.RepositoryEvent,
+	gitea.CreateEvent,
+	gitea.DeleteEvent,
+}
+
 // githubAllowedPullRequestActions is a list of github actions that allow refresh
 var githubAllowedPullRequestActions = []string{
 	"opened",
@@ -413,7 +653,90 @@ func shouldRefreshPRGenerator(gen *v1alpha1.PullRequestGenerator, info *prGenera
 	return false
 }
 
-func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenerator, appSet *v1alpha1.ApplicationSet, gitGenInfo *gitGeneratorInfo, prGenInfo *prGeneratorInfo) bool {
+func shouldRefreshSCMProviderGenerator(gen *v1alpha1.SCMProviderGenerator, info *scmProviderGeneratorInfo) bool {
+	if gen == nil || info == nil {
+		return false
+	}
+
+	if gen.Github != nil && info.Github != nil {
+		if info.BranchOnly && !gen.Github.AllBranches {
+			return false
+		}
+		// organization names are case-insensitive
+		if !strings.EqualFold(gen.Github.Organization, info.Github.Organization) {
+			return false
+		}
+		api := gen.Github.API
+		if api == "" {
+			api = "https://api.github.com/"
+		}
+		if !strings.EqualFold(urlHostname(api), info.Github.APIHostname) {
+			log.Debugf("%s does not match %s", api, info.Github.APIHostname)
+			return false
+		}
+		return true
+	}
+
+	if gen.Gitlab != nil && info.Gitlab != nil {
+		if info.BranchOnly && !gen.Gitlab.AllBranches {
+			return false
+		}
+		if info.Gitlab.APIHostname != "" {
+			api := gen.Gitlab.API
+			if api == "" {
+				api = "https://gitlab.com/"
+			}
+			if !strings.EqualFold(urlHostname(api), info.Gitlab.APIHostname) {
+				log.Debugf("%s does not match %s", api, info.Gitlab.APIHostname)
+				return false
+			}
+		}
+		// the payload doesn't contain the group ID, so a generator referencing its group by ID always needs a refresh
+		if _, err := strconv.ParseInt(gen.Gitlab.Group, 10, 64); err == nil {
+			return true
+		}
+		group := strings.ToLower(strings.Trim(gen.Gitlab.Group, "/"))
+		for _, namespace := range info.Gitlab.Namespaces {
+			namespace = strings.ToLower(namespace)
+			if namespace == group || (gen.Gitlab.IncludeSubgroups && strings.HasPrefix(namespace, group+"/")) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if gen.Gitea != nil && info.Gitea != nil {
+		if info.BranchOnly && !gen.Gitea.AllBranches {
+			return false
+		}
+		if !strings.EqualFold(gen.Gitea.Owner, info.Gitea.Owner) {
+			return false
+		}
+		if !strings.EqualFold(urlHostname(gen.Gitea.API), info.Gitea.APIHostname) {
+			log.Debugf("%s does not match %s", gen.Gitea.API, info.Gitea.APIHostname)
+			return false
+		}
+		return true
+	}
+
+	if gen.Bitbucket != nil && info.Bitbucket != nil {
+		if info.BranchOnly && !gen.Bitbucket.AllBranches {
+			return false
+		}
+		return strings.EqualFold(gen.Bitbucket.Owner, info.Bitbucket.Owner)
+	}
+
+	if gen.BitbucketServer != nil && info.BitbucketServer != nil {
+		if info.BranchOnly && !gen.BitbucketServer.AllBranches {
+			return false
+		}
+		return strings.EqualFold(gen.BitbucketServer.Project, info.BitbucketServer.Project)
+	}
+
+	return false
+}
+
+func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenerator, appSet *v1alpha1.ApplicationSet, gitGenInfo *gitGeneratorInfo, prGenInfo *prGeneratorInfo, scmGenInfo *scmProviderGeneratorInfo) bool {
 	if gen == nil {
 		return false
 	}
@@ -425,9 +748,10 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 
 	g0 := gen.Generators[0]
 
-	// Check first child generator for Git or Pull Request Generator
+	// Check first child generator for Git, Pull Request or SCM Provider Generator
 	if shouldRefreshGitGenerator(g0.Git, gitGenInfo) ||
-		shouldRefreshPRGenerator(g0.PullRequest, prGenInfo) {
+		shouldRefreshPRGenerator(g0.PullRequest, prGenInfo) ||
+		shouldRefreshSCMProviderGenerator(g0.SCMProvider, scmGenInfo) {
 		return true
 	}
 
@@ -442,7 +766,7 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		}
 		if nestedMatrix != nil {
 			matrixGenerator0 = nestedMatrix.ToMatrixGenerator()
-			if h.shouldRefreshMatrixGenerator(matrixGenerator0, appSet, gitGenInfo, prGenInfo) {
+			if h.shouldRefreshMatrixGenerator(matrixGenerator0, appSet, gitGenInfo, prGenInfo, scmGenInfo) {
 				return true
 			}
 		}
@@ -459,7 +783,7 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		}
 		if nestedMerge != nil {
 			mergeGenerator0 = nestedMerge.ToMergeGenerator()
-			if h.shouldRefreshMergeGenerator(mergeGenerator0, appSet, gitGenInfo, prGenInfo) {
+			if h.shouldRefreshMergeGenerator(mergeGenerator0, appSet, gitGenInfo, prGenInfo, scmGenInfo) {
 				return true
 			}
 		}
@@ -546,9 +870,10 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 			// Check all interpolated child generators
 			if shouldRefreshGitGenerator(interpolatedGenerator.Git, gitGenInfo) ||
 				shouldRefreshPRGenerator(interpolatedGenerator.PullRequest, prGenInfo) ||
+				shouldRefreshSCMProviderGenerator(interpolatedGenerator.SCMProvider, scmGenInfo) ||
 				shouldRefreshPluginGenerator(interpolatedGenerator.Plugin) ||
-				h.shouldRefreshMatrixGenerator(interpolatedGenerator.Matrix, appSet, gitGenInfo, prGenInfo) ||
-				h.shouldRefreshMergeGenerator(requestedGenerator1.Merge, appSet, gitGenInfo, prGenInfo) {
+				h.shouldRefreshMatrixGenerator(interpolatedGenerator.Matrix, appSet, gitGenInfo, prGenInfo, scmGenInfo) ||
+				h.shouldRefreshMergeGenerator(requestedGenerator1.Merge, appSet, gitGenInfo, prGenInfo, scmGenInfo) {
 				return true
 			}
 		}
@@ -557,20 +882,22 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 	// First child generator didn't return any params, just check the second child generator
 	return shouldRefreshGitGenerator(requestedGenerator1.Git, gitGenInfo) ||
 		shouldRefreshPRGenerator(requestedGenerator1.PullRequest, prGenInfo) ||
+		shouldRefreshSCMProviderGenerator(requestedGenerator1.SCMProvider, scmGenInfo) ||
 		shouldRefreshPluginGenerator(requestedGenerator1.Plugin) ||
-		h.shouldRefreshMatrixGenerator(requestedGenerator1.Matrix, appSet, gitGenInfo, prGenInfo) ||
-		h.shouldRefreshMergeGenerator(requestedGenerator1.Merge, appSet, gitGenInfo, prGenInfo)
+		h.shouldRefreshMatrixGenerator(requestedGenerator1.Matrix, appSet, gitGenInfo, prGenInfo, scmGenInfo) ||
+		h.shouldRefreshMergeGenerator(requestedGenerator1.Merge, appSet, gitGenInfo, prGenInfo, scmGenInfo)
 }
 
-func (h *WebhookHandler) shouldRefreshMergeGenerator(gen *v1alpha1.MergeGenerator, appSet *v1alpha1.ApplicationSet, gitGenInfo *gitGeneratorInfo, prGenInfo *prGeneratorInfo) bool {
+func (h *WebhookHandler) shouldRefreshMergeGenerator(gen *v1alpha1.MergeGenerator, appSet *v1alpha1.ApplicationSet, gitGenInfo *gitGeneratorInfo, prGenInfo *prGeneratorInfo, scmGenInfo *scmProviderGeneratorInfo) bool {
 	if gen == nil {
 		return false
 	}
 
 	for _, g := range gen.Generators {
-		// Check Git or Pull Request generator
+		// Check Git, Pull Request or SCM Provider generator
 		if shouldRefreshGitGenerator(g.Git, gitGenInfo) ||
-			shouldRefreshPRGenerator(g.PullRequest, prGenInfo) {
+			shouldRefreshPRGenerator(g.PullRequest, prGenInfo) ||
+			shouldRefreshSCMProviderGenerator(g.SCMProvider, scmGenInfo) {
 			return true
 		}
 
@@ -583,7 +910,7 @@ func (h *WebhookHandler) shouldRefreshMergeGenerator(gen *v1alpha1.MergeGenerato
 				return false
 			}
 			if nestedMatrix != nil {
-				if h.shouldRefreshMatrixGenerator(nestedMatrix.ToMatrixGenerator(), appSet, gitGenInfo, prGenInfo) {
+				if h.shouldRefreshMatrixGenerator(nestedMatrix.ToMatrixGenerator(), appSet, gitGenInfo, prGenInfo, scmGenInfo) {
 					return true
 				}
 			}
@@ -598,7 +925,7 @@ func (h *WebhookHandler) shouldRefreshMergeGenerator(gen *v1alpha1.MergeGenerato
 				return false
 			}
 			if nestedMerge != nil {
-				if h.shouldRefreshMergeGenerator(nestedMerge.ToMergeGenerator(), appSet, gitGenInfo, prGenInfo) {
+				if h.shouldRefreshMergeGenerator(nestedMerge.ToMergeGenerator(), appSet, gitGenInfo, prGenInfo, scmGenInfo) {
 					return true
 				}
 			}
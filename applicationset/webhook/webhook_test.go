@@ -193,6 +193,51 @@ func TestWebhookHandler(t *testing.T) {
 			expectedStatusCode: http.StatusOK,
 			expectedRefresh:    true,
 		},
+		{
+			desc:               "WebHook from a GitHub organization via repository created event",
+			headerKey:          "X-GitHub-Event",
+			headerValue:        "repository",
+			payloadFile:        "github-repository-created-event.json",
+			effectedAppSets:    []string{"scm-github", "scm-github-all-branches", "matrix-scm-git-github", "plugin", "matrix-pull-request-github-plugin"},
+			expectedStatusCode: http.StatusOK,
+			expectedRefresh:    true,
+		},
+		{
+			desc:               "WebHook from a GitHub organization via branch created event",
+			headerKey:          "X-GitHub-Event",
+			headerValue:        "create",
+			payloadFile:        "github-branch-created-event.json",
+			effectedAppSets:    []string{"scm-github-all-branches", "plugin", "matrix-pull-request-github-plugin"},
+			expectedStatusCode: http.StatusOK,
+			expectedRefresh:    true,
+		},
+		{
+			desc:               "WebHook from a GitLab System Hook via project created event",
+			headerKey:          "X-Gitlab-Event",
+			headerValue:        "System Hook",
+			payloadFile:        "gitlab-project-create-system-hook.json",
+			effectedAppSets:    []string{"scm-gitlab-subgroups", "scm-gitlab-group-id", "plugin", "matrix-pull-request-github-plugin"},
+			expectedStatusCode: http.StatusOK,
+			expectedRefresh:    true,
+		},
+		{
+			desc:               "WebHook from a Gitea organization via repository created event",
+			headerKey:          "X-Gitea-Event",
+			headerValue:        "repository",
+			payloadFile:        "gitea-repository-created-event.json",
+			effectedAppSets:    []string{"scm-gitea", "plugin", "matrix-pull-request-github-plugin"},
+			expectedStatusCode: http.StatusOK,
+			expectedRefresh:    true,
+		},
+		{
+			desc:               "WebHook from a Bitbucket Server project via branch created event",
+			headerKey:          "X-Event-Key",
+			headerValue:        "repo:refs_changed",
+			payloadFile:        "bitbucket-server-branch-created-event.json",
+			effectedAppSets:    []string{"scm-bitbucket-server", "plugin", "matrix-pull-request-github-plugin"},
+			expectedStatusCode: http.StatusOK,
+			expectedRefresh:    true,
+		},
 	}
 
 	namespace := "test"
@@ -230,6 +275,15 @@ func TestWebhookHandler(t *testing.T) {
 				fakeAppWithMergeAndGitGenerator("merge-git-github", namespace, "https://github.com/org/repo"),
 				fakeAppWithMergeAndPullRequestGenerator("merge-pull-request-github", namespace, "Codertocat", "Hello-World"),
 				fakeAppWithMergeAndNestedGitGenerator("merge-nested-git-github", namespace, "https://github.com/org/repo"),
+				fakeAppWithSCMProviderGenerator("scm-github", namespace, v1alpha1.SCMProviderGenerator{Github: &v1alpha1.SCMProviderGeneratorGithub{Organization: "Org"}}),
+				fakeAppWithSCMProviderGenerator("scm-github-all-branches", namespace, v1alpha1.SCMProviderGenerator{Github: &v1alpha1.SCMProviderGeneratorGithub{Organization: "org", AllBranches: true}}),
+				fakeAppWithSCMProviderGenerator("scm-github-other-org", namespace, v1alpha1.SCMProviderGenerator{Github: &v1alpha1.SCMProviderGeneratorGithub{Organization: "other-org", AllBranches: true}}),
+				fakeAppWithSCMProviderGenerator("scm-github-enterprise", namespace, v1alpha1.SCMProviderGenerator{Github: &v1alpha1.SCMProviderGeneratorGithub{Organization: "org", API: "https://github.example.com/api/v3"}}),
+				fakeAppWithSCMProviderGenerator("scm-gitlab", namespace, v1alpha1.SCMProviderGenerator{Gitlab: &v1alpha1.SCMProviderGeneratorGitlab{Group: "group"}}),
+				fakeAppWithSCMProviderGenerator("scm-gitlab-subgroups", namespace, v1alpha1.SCMProviderGenerator{Gitlab: &v1alpha1.SCMProviderGeneratorGitlab{Group: "group", IncludeSubgroups: true}}),
+				fakeAppWithSCMProviderGenerator("scm-gitlab-group-id", namespace, v1alpha1.SCMProviderGenerator{Gitlab: &v1alpha1.SCMProviderGeneratorGitlab{Group: "1234"}}),
+				fakeAppWithSCMProviderGenerator("scm-gitea", namespace, v1alpha1.SCMProviderGenerator{Gitea: &v1alpha1.SCMProviderGeneratorGitea{Owner: "org", API: "https://gitea.example.com/"}}),
+				fakeAppWithSCMProviderGenerator("scm-bitbucket-server", namespace, v1alpha1.SCMProviderGenerator{BitbucketServer: &v1alpha1.SCMProviderGeneratorBitbucketServer{Project: "PROJ", API: "https://bitbucket.example.com", AllBranches: true}}),
 			).Build()
 			set := argosettings.NewSettingsManager(t.Context(), fakeClient, namespace)
 			h, err := NewWebhookHandler(webhookParallelism, set, fc, mockGenerators())
@@ -405,6 +459,22 @@ func fakeAppWithGitGenerator(name, namespace, repo string) *v1alpha1.Application
 	}
 }
 
+func fakeAppWithSCMProviderGenerator(name, namespace string, scmProvider v1alpha1.SCMProviderGenerator) *v1alpha1.ApplicationSet {
+	return &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Generators: []v1alpha1.ApplicationSetGenerator{
+				{
+					SCMProvider: &scmProvider,
+				},
+			},
+		},
+	}
+}
+
 func fakeAppWithGitGeneratorWithRevision(name, namespace, repo, revision string) *v1alpha1.ApplicationSet {
 	appSet := fakeAppWithGitGenerator(name, namespace, repo)
 	appSet.Spec.Generators[0].Git.Revision = revision
@@ -78,6 +78,8 @@ func NewCommand() *cobra.Command {
 		metricsApplicationConditions     []string
 		metricsClusterLabels             []string
 		kubectlParallelismLimit          int64
+		clusterSyncConcurrencyLimit      int
+		projectSyncConcurrencyLimit      int
 		cacheSource                      func() (*appstatecache.Cache, error)
 		redisClient                      *redis.Client
 		repoServerPlaintext              bool
@@ -212,6 +214,8 @@ func NewCommand() *cobra.Command {
 				metricsApplicationConditions,
 				metricsClusterLabels,
 				kubectlParallelismLimit,
+				clusterSyncConcurrencyLimit,
+				projectSyncConcurrencyLimit,
 				persistResourceHealth,
 				clusterSharding,
 				applicationNamespaces,
@@ -280,6 +284,8 @@ func NewCommand() *cobra.Command {
 	errors.CheckError(command.Flags().MarkDeprecated("self-heal-backoff-cooldown-seconds", "This flag is deprecated and has no effect."))
 	command.Flags().IntVar(&syncTimeout, "sync-timeout", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_SYNC_TIMEOUT", 0, 0, math.MaxInt32), "Specifies the timeout after which a sync would be terminated. 0 means no timeout (default 0).")
 	command.Flags().Int64Var(&kubectlParallelismLimit, "kubectl-parallelism-limit", env.ParseInt64FromEnv("ARGOCD_APPLICATION_CONTROLLER_KUBECTL_PARALLELISM_LIMIT", 20, 0, math.MaxInt64), "Maximum number of concurrent cluster operations during sync. Any value less than 1 means no limit.")
+	command.Flags().IntVar(&clusterSyncConcurrencyLimit, "cluster-sync-concurrency-limit", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_CLUSTER_SYNC_CONCURRENCY_LIMIT", 0, 0, math.MaxInt32), "Maximum number of concurrent sync operations per destination cluster, unless overridden by the cluster. 0 means no limit.")
+	command.Flags().IntVar(&projectSyncConcurrencyLimit, "project-sync-concurrency-limit", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_PROJECT_SYNC_CONCURRENCY_LIMIT", 0, 0, math.MaxInt32), "Maximum number of concurrent sync operations per project and controller shard. 0 means no limit.")
 	command.Flags().BoolVar(&repoServerPlaintext, "repo-server-plaintext", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER_PLAINTEXT", false), "Disable TLS on connections to repo server")
 	command.Flags().BoolVar(&repoServerStrictTLS, "repo-server-strict-tls", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER_STRICT_TLS", false), "Whether to use strict validation of the TLS cert presented by the repo server")
 	errors.CheckError(command.Flags().MarkDeprecated("repo-server-strict-tls", "use --repo-server-ca-cert-path instead"))
@@ -293,7 +299,7 @@ func NewCommand() *cobra.Command {
 	cli.BoundedFloat64Var(command.Flags(), &otlpSampleRatio, "otlp-sample-ratio", env.ParseFloat64FromEnv("ARGOCD_APPLICATION_CONTROLLER_OTLP_SAMPLE_RATIO", 1.0, 0.0, 1.0), 0.0, 1.0, "Fraction of traces to sample, from 0.0 (none) to 1.0 (all). Parent-based, so downstream services honor the upstream sampling decision")
 	command.Flags().StringSliceVar(&applicationNamespaces, "application-namespaces", env.StringsFromEnv("ARGOCD_APPLICATION_NAMESPACES", []string{}, ","), "List of additional namespaces that applications are allowed to be reconciled from")
 	command.Flags().BoolVar(&persistResourceHealth, "persist-resource-health", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_PERSIST_RESOURCE_HEALTH", false), "Enables storing the managed resources health in the Application CRD")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", env.StringFromEnv(common.EnvControllerShardingAlgorithm, common.DefaultShardingAlgorithm), "Enables choice of sharding method. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", env.StringFromEnv(common.EnvControllerShardingAlgorithm, common.DefaultShardingAlgorithm), "Enables choice of sharding method. Supported sharding methods are : [legacy, round-robin, consistent-hashing, resource-weighted] ")
 	// global queue rate limit config
 	command.Flags().Int64Var(&workqueueRateLimit.BucketSize, "wq-bucket-size", env.ParseInt64FromEnv("WORKQUEUE_BUCKET_SIZE", 500, 1, math.MaxInt64), "Set Workqueue Rate Limiter Bucket Size, default 500")
 	command.Flags().Float64Var(&workqueueRateLimit.BucketQPS, "wq-bucket-qps", env.ParseFloat64FromEnv("WORKQUEUE_BUCKET_QPS", math.MaxFloat64, 1, math.MaxFloat64), "Set Workqueue Rate Limiter Bucket QPS, default set to MaxFloat64 which disables the bucket limiter")
@@ -43,6 +43,7 @@ import (
 	appsetmetrics "github.com/argoproj/argo-cd/v3/applicationset/metrics"
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	appv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/errors"
@@ -85,6 +86,11 @@ func NewCommand() *cobra.Command {
 		repoServerClientTLSConfigSrc func() (tls.Configuration, error)
 		scmProxyURL                  string
 		scmNoProxy                   string
+		enableSCMHTTPCache           bool
+		scmHTTPCacheTTL              time.Duration
+		scmHTTPCacheRetention        time.Duration
+		scmHTTPCacheUseRedis         bool
+		scmHTTPCacheRedisSrc         func() (*cacheutil.Cache, error)
 	)
 	scheme := runtime.NewScheme()
 	_ = clientgoscheme.AddToScheme(scheme)
@@ -207,6 +213,17 @@ func NewCommand() *cobra.Command {
 				os.Exit(1)
 			}
 
+			if enableSCMHTTPCache {
+				if scmHTTPCacheUseRedis {
+					redisCache, err := scmHTTPCacheRedisSrc()
+					errors.CheckError(err)
+					services.SetSharedHTTPCache(services.NewHTTPCache(redisCache, scmHTTPCacheTTL, scmHTTPCacheRetention))
+				} else {
+					services.SetSharedHTTPCache(services.NewInMemoryHTTPCache(scmHTTPCacheTTL, scmHTTPCacheRetention))
+				}
+				log.Infof("SCM provider HTTP cache enabled (ttl: %s, retention: %s, redis: %t)", scmHTTPCacheTTL, scmHTTPCacheRetention, scmHTTPCacheUseRedis)
+			}
+
 			scmConfig := generators.NewSCMConfig(
 				scmRootCAPath,
 				allowedScmProviders,
@@ -326,6 +343,11 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&maxResourcesStatusCount, "max-resources-status-count", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_MAX_RESOURCES_STATUS_COUNT", 5000, 0, math.MaxInt), "Max number of resources stored in appset status.")
 	command.Flags().DurationVar(&cacheSyncPeriod, "cache-sync-period", env.ParseDurationFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_CACHE_SYNC_PERIOD", time.Hour*10, 0, time.Hour*24), "Period at which the manager client cache is forcefully resynced with the Kubernetes API server. 0 disables periodic resync.")
 	command.Flags().IntVar(&concurrentApplicationUpdates, "concurrent-application-updates", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_CONCURRENT_APPLICATION_UPDATES", 1, 1, 200), "Number of concurrent Application create/update/delete operations per ApplicationSet reconcile.")
+	command.Flags().BoolVar(&enableSCMHTTPCache, "enable-scm-http-cache", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_SCM_HTTP_CACHE", false), "Cache the responses of SCM provider APIs used by the SCM and PR generators, and revalidate them with conditional requests (ETag/Last-Modified)")
+	command.Flags().DurationVar(&scmHTTPCacheTTL, "scm-http-cache-ttl", env.ParseDurationFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_HTTP_CACHE_TTL", 0, 0, math.MaxInt64), "Duration during which cached SCM provider API responses are used without contacting the API. 0 always revalidates cached responses with a conditional request.")
+	command.Flags().DurationVar(&scmHTTPCacheRetention, "scm-http-cache-retention", env.ParseDurationFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_HTTP_CACHE_RETENTION", 24*time.Hour, 0, math.MaxInt64), "Duration during which cached SCM provider API responses are kept for revalidation")
+	command.Flags().BoolVar(&scmHTTPCacheUseRedis, "scm-http-cache-use-redis", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_HTTP_CACHE_USE_REDIS", false), "Store the SCM provider HTTP cache in Redis instead of memory, so that it is shared between controller replicas and survives restarts")
+	scmHTTPCacheRedisSrc = cacheutil.AddCacheFlagsToCmd(&command)
 	repoServerClientTLSConfigSrc = tls.AddClientTLSFlagsToCmdWithPrefix(&command, "APPLICATIONSET_CONTROLLER")
 	return &command
 }
@@ -62,6 +62,9 @@ func NewAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewAccountGenerateTokenCommand(clientOpts))
 	command.AddCommand(NewAccountGetCommand(clientOpts))
 	command.AddCommand(NewAccountDeleteTokenCommand(clientOpts))
+	command.AddCommand(NewAccountGeneratePersonalTokenCommand(clientOpts))
+	command.AddCommand(NewAccountListPersonalTokensCommand(clientOpts))
+	command.AddCommand(NewAccountDeletePersonalTokenCommand(clientOpts))
 	command.AddCommand(NewAccountSessionTokenCommand(clientOpts))
 	command.AddCommand(NewBcryptCmd())
 	return command
@@ -452,6 +455,150 @@ argocd account delete-token --account <account-name> ID`,
 	return cmd
 }
 
+// parsePersonalTokenScope parses a personal token scope given as RESOURCE,ACTION,OBJECT
+func parsePersonalTokenScope(scope string) (*accountpkg.PersonalTokenScope, error) {
+	parts := strings.Split(scope, ",")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid scope '%s', expected RESOURCE,ACTION,OBJECT", scope)
+	}
+	return &accountpkg.PersonalTokenScope{
+		Resource: strings.TrimSpace(parts[0]),
+		Action:   strings.TrimSpace(parts[1]),
+		Object:   strings.TrimSpace(parts[2]),
+	}, nil
+}
+
+func NewAccountGeneratePersonalTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		expiresIn   string
+		description string
+		scopes      []string
+	)
+	cmd := &cobra.Command{
+		Use:   "generate-personal-token",
+		Short: "Generate a personal token for the currently logged in SSO user",
+		Example: `# Generate a personal token which expires in 30 days
+argocd account generate-personal-token --expires-in 30d --description "CI pipeline"
+
+# Generate a personal token which can only get and sync the applications of the 'default' project
+argocd account generate-personal-token --expires-in 7d --scope applications,get,default/* --scope applications,sync,default/*`,
+		Run: cli.WithSignalContext(func(c *cobra.Command, _ []string, _ context.CancelFunc) {
+			ctx := c.Context()
+
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDieWithContext(ctx)
+			defer utilio.Close(conn)
+			expiresIn, err := timeutil.ParseDuration(expiresIn)
+			errors.CheckError(err)
+			request := &accountpkg.CreatePersonalTokenRequest{
+				ExpiresIn:   int64(expiresIn.Seconds()),
+				Description: description,
+			}
+			for _, s := range scopes {
+				scope, err := parsePersonalTokenScope(s)
+				errors.CheckError(err)
+				request.Scopes = append(request.Scopes, scope)
+			}
+			response, err := client.CreatePersonalToken(ctx, request)
+			errors.CheckError(err)
+			fmt.Println(response.Token)
+		}),
+	}
+	cmd.Flags().StringVarP(&expiresIn, "expires-in", "e", "30d", "Duration before the token will expire")
+	cmd.Flags().StringVar(&description, "description", "", "Description of the token")
+	cmd.Flags().StringArrayVar(&scopes, "scope", []string{}, "Narrow the permissions of the token to the requests matching RESOURCE,ACTION,OBJECT, where each part is a glob pattern. This flag can be repeated.")
+	return cmd
+}
+
+func printPersonalTokensTable(items []*accountpkg.PersonalToken) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, "ID\tSUBJECT\tDESCRIPTION\tISSUED AT\tEXPIRING AT\tSCOPES\n")
+	for _, t := range items {
+		expiresAt := time.Unix(t.ExpiresAt, 0)
+		expiresAtFormatted := expiresAt.Format(time.RFC3339)
+		if expiresAt.Before(time.Now()) {
+			expiresAtFormatted = expiresAtFormatted + " (expired)"
+		}
+		scopes := "*"
+		if len(t.Scopes) > 0 {
+			var scopeItems []string
+			for _, s := range t.Scopes {
+				scopeItems = append(scopeItems, fmt.Sprintf("%s,%s,%s", s.Resource, s.Action, s.Object))
+			}
+			scopes = strings.Join(scopeItems, " ")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", t.Id, t.Subject, t.Description, time.Unix(t.IssuedAt, 0).Format(time.RFC3339), expiresAtFormatted, scopes)
+	}
+	_ = w.Flush()
+}
+
+func NewAccountListPersonalTokensCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output  string
+		subject string
+	)
+	cmd := &cobra.Command{
+		Use:   "list-personal-tokens",
+		Short: "List personal tokens of SSO users",
+		Example: `# List the personal tokens of the currently logged in SSO user, or all the ones the user is allowed to see
+argocd account list-personal-tokens
+
+# List the personal tokens of the SSO user with the specified subject
+argocd account list-personal-tokens --subject <subject>`,
+		Run: cli.WithSignalContext(func(c *cobra.Command, _ []string, _ context.CancelFunc) {
+			ctx := c.Context()
+
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDieWithContext(ctx)
+			defer utilio.Close(conn)
+
+			response, err := client.ListPersonalTokens(ctx, &accountpkg.ListPersonalTokensRequest{Subject: subject})
+
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(response.Items, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printPersonalTokensTable(response.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		}),
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	cmd.Flags().StringVar(&subject, "subject", "", "List only the tokens of the SSO user with the given subject")
+	return cmd
+}
+
+func NewAccountDeletePersonalTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-personal-token",
+		Short: "Deletes and revokes a personal token",
+		Example: `# Delete a personal token
+argocd account delete-personal-token ID`,
+		Run: cli.WithSignalContext(func(c *cobra.Command, args []string, _ context.CancelFunc) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			id := args[0]
+
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDieWithContext(ctx)
+			defer utilio.Close(conn)
+			promptUtil := utils.NewPrompt(clientOpts.PromptsEnabled)
+			canDelete := promptUtil.Confirm(fmt.Sprintf("Are you sure you want to delete '%s' personal token? [y/n]", id))
+			if canDelete {
+				_, err := client.DeletePersonalToken(ctx, &accountpkg.DeletePersonalTokenRequest{Id: id})
+				errors.CheckError(err)
+			} else {
+				fmt.Printf("The command to delete '%s' was cancelled.\n", id)
+			}
+		}),
+	}
+	return cmd
+}
+
 func NewAccountSessionTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var output string
 	cmd := &cobra.Command{
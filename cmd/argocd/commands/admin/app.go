@@ -409,6 +409,8 @@ func reconcileApplications(
 	appStateManager := controller.NewAppStateManager(
 		argoDB,
 		appClientset,
+		appLister,
+		nil,
 		repoServerClient,
 		namespace,
 		kubeutil.NewKubectl(),
@@ -425,6 +427,7 @@ func reconcileApplications(
 		0,
 		serverSideDiff,
 		ignoreNormalizerOpts,
+		nil,
 	)
 
 	appsList, err := appClientset.ArgoprojV1alpha1().Applications(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
@@ -2,7 +2,9 @@ package admin
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"sort"
@@ -74,6 +76,8 @@ type ClusterWithInfo struct {
 	v1alpha1.Cluster
 	// Shard holds controller shard number that handles the cluster
 	Shard int
+	// PredictedShard holds controller shard number that handles the cluster once the clusters are balanced by their load
+	PredictedShard int
 	// Namespaces holds list of namespaces managed by Argo CD in the cluster
 	Namespaces []string
 }
@@ -104,10 +108,6 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 			shardingAlgorithm = common.DefaultShardingAlgorithm
 		}
 	}
-	clusterShardingCache := sharding.NewClusterSharding(argoDB, shard, replicas, shardingAlgorithm)
-	clusterShardingCache.Init(clustersList, appItems)
-	clusterShards := clusterShardingCache.GetDistribution()
-
 	var cache *appstatecache.Cache
 	if portForwardRedis {
 		overrides := clientcmd.ConfigOverrides{}
@@ -136,6 +136,41 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 		}
 	}
 
+	infos := make([]v1alpha1.ClusterInfo, len(clustersList.Items))
+	batchSize := 10
+	batchesCount := int(math.Ceil(float64(len(clustersList.Items)) / float64(batchSize)))
+	for batchNum := range batchesCount {
+		batchStart := batchSize * batchNum
+		batchEnd := min((batchSize * (batchNum + 1)), len(clustersList.Items))
+		_ = kube.RunAllAsync(batchEnd-batchStart, func(i int) error {
+			infos[batchStart+i] = clustersList.Items[batchStart+i].Info
+			_ = cache.GetClusterInfo(clustersList.Items[batchStart+i].Server, &infos[batchStart+i])
+			return nil
+		})
+	}
+	loads := make(map[string]sharding.ClusterLoad, len(clustersList.Items))
+	clusterList := make([]*v1alpha1.Cluster, len(clustersList.Items))
+	for i := range clustersList.Items {
+		clusterList[i] = &clustersList.Items[i]
+		if load, ok := sharding.ClusterLoadFromInfo(&infos[i]); ok {
+			loads[clustersList.Items[i].Server] = load
+		}
+	}
+
+	clusterShardingCache := sharding.NewClusterSharding(argoDB, shard, replicas, shardingAlgorithm)
+	clusterShardingCache.Init(clustersList, appItems)
+	var assignment map[string]int
+	if shardingAlgorithm == common.ResourceWeightedShardingAlgorithm {
+		assignment, err = cache.GetClusterShardAssignment()
+		if err != nil && !stderrors.Is(err, appstatecache.ErrCacheMiss) {
+			return nil, err
+		}
+	}
+	clusterShardingCache.UpdateClusterLoads(loads, assignment)
+	clusterShards := clusterShardingCache.GetDistribution()
+	// the shards the clusters would be moved to once they are balanced by their load
+	predictedShards := sharding.BalanceClusters(clusterList, loads, clusterShards, replicas)
+
 	namespacesByServer := map[string]map[string]bool{}
 	for _, app := range appItems.Items {
 		destCluster, resolveErr := argo.GetDestinationCluster(ctx, app.Spec.Destination, argoDB)
@@ -149,32 +184,25 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 		namespacesByServer[destCluster.Server][app.Spec.Destination.Namespace] = true
 	}
 	clusters := make([]ClusterWithInfo, len(clustersList.Items))
-
-	batchSize := 10
-	batchesCount := int(math.Ceil(float64(len(clusters)) / float64(batchSize)))
-	for batchNum := range batchesCount {
-		batchStart := batchSize * batchNum
-		batchEnd := min((batchSize * (batchNum + 1)), len(clustersList.Items))
-		batch := clustersList.Items[batchStart:batchEnd]
-		_ = kube.RunAllAsync(len(batch), func(i int) error {
-			clusterShard := 0
-			cluster := batch[i]
-			if replicas > 0 {
-				clusterShard = clusterShards[cluster.Server]
-				cluster.Shard = new(int64(clusterShard))
-				log.Infof("Cluster with uid: %s will be processed by shard %d", cluster.ID, clusterShard)
-			}
-			if shard != -1 && clusterShard != shard {
-				return nil
-			}
-			var namespaces []string
-			for ns := range namespacesByServer[cluster.Server] {
-				namespaces = append(namespaces, ns)
-			}
-			_ = cache.GetClusterInfo(cluster.Server, &cluster.Info)
-			clusters[batchStart+i] = ClusterWithInfo{cluster, clusterShard, namespaces}
-			return nil
-		})
+	for i := range clustersList.Items {
+		clusterShard := 0
+		predictedShard := 0
+		cluster := clustersList.Items[i]
+		if replicas > 0 {
+			clusterShard = clusterShards[cluster.Server]
+			predictedShard = predictedShards[cluster.Server]
+			cluster.Shard = new(int64(clusterShard))
+			log.Infof("Cluster with uid: %s will be processed by shard %d", cluster.ID, clusterShard)
+		}
+		if shard != -1 && clusterShard != shard {
+			continue
+		}
+		var namespaces []string
+		for ns := range namespacesByServer[cluster.Server] {
+			namespaces = append(namespaces, ns)
+		}
+		cluster.Info = infos[i]
+		clusters[i] = ClusterWithInfo{cluster, clusterShard, predictedShard, namespaces}
 	}
 	return clusters, nil
 }
@@ -201,7 +229,7 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	)
 	command := cobra.Command{
 		Use:   "shards",
-		Short: "Print information about each controller shard and the estimated portion of Kubernetes resources it is responsible for.",
+		Short: "Print information about each controller shard, the estimated portion of Kubernetes resources it is responsible for and its actual and predicted load.",
 		Run: func(cmd *cobra.Command, _ []string) {
 			ctx := cmd.Context()
 
@@ -233,7 +261,7 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", "", "Sharding method. Defaults to what is set for sharding algorithm in argocd-cmd-params (legacy if not provided). Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", "", "Sharding method. Defaults to what is set for sharding algorithm in argocd-cmd-params (legacy if not provided). Supported sharding methods are : [legacy, round-robin, consistent-hashing, resource-weighted] ")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
 
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
@@ -246,11 +274,22 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 }
 
 func printStatsSummary(clusters []ClusterWithInfo, replicas int) {
+	printStatsSummaryTo(os.Stdout, clusters, replicas)
+}
+
+func printStatsSummaryTo(out io.Writer, clusters []ClusterWithInfo, replicas int) {
 	totalResourcesCount := int64(0)
 	resourcesCountByShard := map[int]int64{}
+	eventsPerMinuteByShard := map[int]int64{}
+	loadByShard := map[int]int64{}
+	predictedLoadByShard := map[int]int64{}
+	weights := getClusterWeights(clusters)
 	for _, c := range clusters {
 		totalResourcesCount += c.Info.CacheInfo.ResourcesCount
 		resourcesCountByShard[c.Shard] += c.Info.CacheInfo.ResourcesCount
+		eventsPerMinuteByShard[c.Shard] += c.Info.CacheInfo.EventsPerMinute
+		loadByShard[c.Shard] += weights[c.Server]
+		predictedLoadByShard[c.PredictedShard] += weights[c.Server]
 	}
 
 	for replica := range replicas {
@@ -261,17 +300,35 @@ func printStatsSummary(clusters []ClusterWithInfo, replicas int) {
 	}
 
 	avgResourcesByShard := totalResourcesCount / int64(replicas)
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintf(w, "SHARD\tRESOURCES COUNT\t%% OF TOTAL\t%% OF AVG PER SHARD (%d)\n", avgResourcesByShard)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "SHARD\tRESOURCES COUNT\t%% OF TOTAL\t%% OF AVG PER SHARD (%d)\tEVENTS PER MINUTE\tLOAD\tPREDICTED LOAD\n", avgResourcesByShard)
 	for shard := 0; shard < len(resourcesCountByShard); shard++ {
 		cnt := resourcesCountByShard[shard]
 		totalPercent := (float64(cnt) / float64(totalResourcesCount)) * 100.0
 		avgPercent := (float64(cnt) / float64(avgResourcesByShard)) * 100.0
-		_, _ = fmt.Fprintf(w, "%d\t%d\t%.0f%%\t%.0f%%\n", shard, cnt, totalPercent, avgPercent)
+		_, _ = fmt.Fprintf(w, "%d\t%d\t%.0f%%\t%.0f%%\t%d\t%d\t%d\n", shard, cnt, totalPercent, avgPercent, eventsPerMinuteByShard[shard], loadByShard[shard], predictedLoadByShard[shard])
 	}
 	_ = w.Flush()
 }
 
+// getClusterWeights returns the weight of the clusters by server which is balanced across the shards by the resource
+// weighted sharding algorithm
+func getClusterWeights(clusters []ClusterWithInfo) map[string]int64 {
+	clusterList := make([]*v1alpha1.Cluster, 0, len(clusters))
+	loads := make(map[string]sharding.ClusterLoad, len(clusters))
+	for i := range clusters {
+		// clusters of other shards are left empty when filtering by shard
+		if clusters[i].Server == "" {
+			continue
+		}
+		clusterList = append(clusterList, &clusters[i].Cluster)
+		if load, ok := sharding.ClusterLoadFromInfo(&clusters[i].Info); ok {
+			loads[clusters[i].Server] = load
+		}
+	}
+	return sharding.GetClusterWeights(clusterList, loads)
+}
+
 func runClusterNamespacesCommand(ctx context.Context, clientConfig clientcmd.ClientConfig, action func(appClient *versioned.Clientset, argoDB db.ArgoDB, clusters map[string][]string) error) error {
 	clientCfg, err := clientConfig.ClientConfig()
 	if err != nil {
@@ -528,7 +585,7 @@ argocd admin cluster stats target-cluster`,
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", "", "Sharding method. Defaults to what is set for sharding algorithm in argocd-cmd-params (legacy if not provided). Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", "", "Sharding method. Defaults to what is set for sharding algorithm in argocd-cmd-params (legacy if not provided). Supported sharding methods are : [legacy, round-robin, consistent-hashing, resource-weighted] ")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
 
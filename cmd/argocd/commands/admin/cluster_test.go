@@ -201,3 +201,33 @@ func Test_loadClusters_ShardingAlgorithm(t *testing.T) {
 		require.Contains(t, logOutput.String(), "Using filter function:  legacy")
 	})
 }
+
+func Test_printStatsSummary(t *testing.T) {
+	syncTime := metav1.Now()
+	newCluster := func(server string, resources, eventsPerMinute int64, shard, predictedShard int) ClusterWithInfo {
+		return ClusterWithInfo{
+			Cluster: v1alpha1.Cluster{
+				Server: server,
+				Info: v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{
+					ResourcesCount:    resources,
+					EventsPerMinute:   eventsPerMinute,
+					LastCacheSyncTime: &syncTime,
+				}},
+			},
+			Shard:          shard,
+			PredictedShard: predictedShard,
+		}
+	}
+	clusters := []ClusterWithInfo{
+		newCluster("https://cluster-a", 100, 2, 0, 0),
+		newCluster("https://cluster-b", 60, 0, 0, 1),
+		newCluster("https://cluster-c", 40, 0, 1, 1),
+	}
+
+	var out bytes.Buffer
+	printStatsSummaryTo(&out, clusters, 2)
+	assert.Equal(t, `SHARD  RESOURCES COUNT  % OF TOTAL  % OF AVG PER SHARD (100)  EVENTS PER MINUTE  LOAD  PREDICTED LOAD
+0      160              80%         160%                      2                  180   120
+1      40               20%         40%                       0                  40    100
+`, out.String())
+}
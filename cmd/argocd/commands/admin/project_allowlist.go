@@ -118,7 +118,7 @@ func generateProjectAllowList(serverResources []*metav1.APIResourceList, cluster
 		return nil, fmt.Errorf("error converting cluster role yaml into ClusterRole struct: %w", err)
 	}
 
-	resourceList := make([]metav1.GroupKind, 0)
+	resourceList := make([]v1alpha1.NamespaceResourceRestrictionItem, 0)
 	for _, rule := range clusterRole.Rules {
 		if len(rule.APIGroups) == 0 {
 			continue
@@ -146,7 +146,7 @@ func generateProjectAllowList(serverResources []*metav1.APIResourceList, cluster
 				if ruleAPIGroup == gv.Group {
 					for _, apiResource := range apiResourcesList.APIResources {
 						if apiResource.Name == ruleResource {
-							resourceList = append(resourceList, metav1.GroupKind{Group: ruleAPIGroup, Kind: apiResource.Kind})
+							resourceList = append(resourceList, v1alpha1.NamespaceResourceRestrictionItem{Group: ruleAPIGroup, Kind: apiResource.Kind})
 						}
 					}
 				}
@@ -3,7 +3,10 @@ package admin
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -19,6 +22,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/assets"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/text/label"
 )
 
 type actionTraitMap map[string]rbacTrait
@@ -131,10 +135,13 @@ func NewRBACCanCommand() *cobra.Command {
 		useBuiltin   bool
 		strict       bool
 		quiet        bool
+		explain      bool
 		subject      string
 		action       string
 		resource     string
 		subResource  string
+		labels       []string
+		attrs        rbac.Attributes
 		clientConfig clientcmd.ClientConfig
 	)
 	command := &cobra.Command{
@@ -161,6 +168,15 @@ argocd admin settings rbac can some:role create application 'default/app' --name
 # You can override a possibly configured default role
 argocd admin settings rbac can someuser create application 'default/app' --default-role role:readonly
 
+# Policies with conditions are evaluated against the attributes of the application
+# given as flags, e.g. its labels and destination
+argocd admin settings rbac can role:developer sync application 'default/app' --policy-file policy.csv \
+  --label env=prod --dest-namespace guestbook
+
+# Explain the result: the default role, the roles of the subject and the
+# matching policies, along with the ConfigMap key or file they come from
+argocd admin settings rbac can someuser sync application 'default/app' --namespace argocd --explain
+
 `,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -216,7 +232,27 @@ argocd admin settings rbac can someuser create application 'default/app' --defau
 				log.SetLevel(log.ErrorLevel)
 			}
 
-			res := checkPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole, matchMode, strict)
+			// The conditions of the policies are evaluated against the
+			// attributes only if at least one of them was given.
+			var requestAttrs *rbac.Attributes
+			if len(labels) > 0 || attrs.Server != "" || attrs.Cluster != "" || attrs.Namespace != "" || attrs.Group != "" || attrs.Kind != "" {
+				attrs.Labels, err = label.Parse(labels)
+				if err != nil {
+					log.Fatalf("invalid label: %v", err)
+				}
+				requestAttrs = &attrs
+			}
+
+			var res bool
+			if explain {
+				explanation := explainPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole, matchMode, strict, requestAttrs)
+				if !quiet {
+					printExplanation(os.Stdout, explanation)
+				}
+				res = explanation.Allowed
+			} else {
+				res = checkPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole, matchMode, strict, requestAttrs)
+			}
 			if res {
 				if !quiet {
 					fmt.Println("Yes")
@@ -235,6 +271,13 @@ argocd admin settings rbac can someuser create application 'default/app' --defau
 	command.Flags().BoolVar(&useBuiltin, "use-builtin-policy", true, "whether to also use builtin-policy")
 	command.Flags().BoolVar(&strict, "strict", true, "whether to perform strict check on action and resource names")
 	command.Flags().BoolVarP(&quiet, "quiet", "q", false, "quiet mode - do not print results to stdout")
+	command.Flags().BoolVar(&explain, "explain", false, "explain the result: print the default role, the roles of the subject and the matching policies")
+	command.Flags().StringArrayVar(&labels, "label", nil, "label of the application to evaluate policy conditions against (e.g. --label key=value)")
+	command.Flags().StringVar(&attrs.Server, "dest-server", "", "destination server of the application to evaluate policy conditions against")
+	command.Flags().StringVar(&attrs.Cluster, "dest-name", "", "destination cluster name of the application to evaluate policy conditions against")
+	command.Flags().StringVar(&attrs.Namespace, "dest-namespace", "", "destination namespace of the application to evaluate policy conditions against")
+	command.Flags().StringVar(&attrs.Group, "resource-group", "", "API group of the resource to evaluate policy conditions against")
+	command.Flags().StringVar(&attrs.Kind, "resource-kind", "", "kind of the resource to evaluate policy conditions against")
 	return command
 }
 
@@ -250,8 +293,9 @@ func NewRBACValidateCommand() *cobra.Command {
 		Use:   "validate [--policy-file POLICYFILE] [--namespace NAMESPACE]",
 		Short: "Validate RBAC policy",
 		Long: `
-Validates an RBAC policy for being syntactically correct. The policy must be
-a local file or a K8s ConfigMap in the provided namespace, and in either CSV or K8s ConfigMap format.
+Validates an RBAC policy for being syntactically correct, including the
+conditions of its policies. The policy must be a local file or a K8s ConfigMap
+in the provided namespace, and in either CSV or K8s ConfigMap format.
 `,
 		Example: `
 # Check whether a given policy file is valid using a local policy.csv file.
@@ -290,8 +334,9 @@ argocd admin settings rbac validate --namespace argocd
 			}
 
 			userPolicy, _, _ := getPolicy(ctx, policyFile, realClientset, namespace)
-			if userPolicy != "" {
-				if err := rbac.ValidatePolicy(userPolicy); err == nil {
+			if policy := rbac.JoinPolicySources(userPolicy); policy != "" {
+				err := rbac.ValidatePolicy(policy)
+				if err == nil {
 					fmt.Print("Policy is valid.\n")
 					os.Exit(0)
 				}
@@ -310,7 +355,7 @@ argocd admin settings rbac validate --namespace argocd
 
 // Load user policy file if requested or use Kubernetes client to get the
 // appropriate ConfigMap from the current context
-func getPolicy(ctx context.Context, policyFile string, kubeClient kubernetes.Interface, namespace string) (userPolicy string, defaultRole string, matchMode string) {
+func getPolicy(ctx context.Context, policyFile string, kubeClient kubernetes.Interface, namespace string) (userPolicy []rbac.PolicySource, defaultRole string, matchMode string) {
 	if policyFile != "" {
 		// load from file
 		userPolicy, defaultRole, matchMode = getPolicyFromFile(policyFile)
@@ -328,9 +373,9 @@ func getPolicy(ctx context.Context, policyFile string, kubeClient kubernetes.Int
 // getPolicyFromFile loads an RBAC policy from the given path. The file may be
 // raw policy text or a serialized ConfigMap. If [os.ReadFile] fails, it calls
 // log.Fatalf (which exits the process) and does not return to the caller.
-func getPolicyFromFile(policyFile string) (string, string, string) {
+func getPolicyFromFile(policyFile string) ([]rbac.PolicySource, string, string) {
 	var (
-		userPolicy  string
+		userPolicy  []rbac.PolicySource
 		defaultRole string
 		matchMode   string
 	)
@@ -345,7 +390,7 @@ func getPolicyFromFile(policyFile string) (string, string, string) {
 	var upolCM *corev1.ConfigMap
 	err = yaml.Unmarshal(upol, &upolCM)
 	if err != nil {
-		userPolicy = string(upol)
+		userPolicy = []rbac.PolicySource{{Name: filepath.Base(policyFile), Policy: string(upol)}}
 	} else {
 		userPolicy, defaultRole, matchMode = getPolicyFromConfigMap(upolCM)
 	}
@@ -354,7 +399,7 @@ func getPolicyFromFile(policyFile string) (string, string, string) {
 }
 
 // Retrieve policy information from a ConfigMap
-func getPolicyFromConfigMap(cm *corev1.ConfigMap) (string, string, string) {
+func getPolicyFromConfigMap(cm *corev1.ConfigMap) ([]rbac.PolicySource, string, string) {
 	var (
 		defaultRole string
 		ok          bool
@@ -365,7 +410,7 @@ func getPolicyFromConfigMap(cm *corev1.ConfigMap) (string, string, string) {
 		defaultRole = ""
 	}
 
-	return rbac.PolicyCSV(cm.Data), defaultRole, cm.Data[rbac.ConfigMapMatchModeKey]
+	return rbac.PolicyCSVSources(cm.Data), defaultRole, cm.Data[rbac.ConfigMapMatchModeKey]
 }
 
 // getPolicyConfigMap fetches the RBAC config map from K8s cluster
@@ -378,28 +423,53 @@ func getPolicyConfigMap(ctx context.Context, client kubernetes.Interface, namesp
 }
 
 // checkPolicy checks whether given subject is allowed to execute specified
-// action against specified resource
-func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole, matchMode string, strict bool) bool {
+// action against specified resource, with the given optional attributes
+func checkPolicy(subject, action, resource, subResource, builtinPolicy string, userPolicy []rbac.PolicySource, defaultRole, matchMode string, strict bool, attrs *rbac.Attributes) bool {
+	enf := newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode)
+	realResource, subResource := resolveRBACRequest(resource, action, subResource, strict)
+	result := enf.Enforce(subject, realResource, action, subResource, attrs)
+	if result {
+		warnIfUnenforcedGroupGrant(enf, subject, realResource, action, subResource, attrs)
+	}
+	return result
+}
+
+// explainPolicy is checkPolicy, but returns the trace of the evaluation of the
+// request along with its result
+func explainPolicy(subject, action, resource, subResource, builtinPolicy string, userPolicy []rbac.PolicySource, defaultRole, matchMode string, strict bool, attrs *rbac.Attributes) *rbac.Explanation {
+	enf := newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode)
+	realResource, subResource := resolveRBACRequest(resource, action, subResource, strict)
+	explanation := enf.Explain(subject, realResource, action, subResource, attrs)
+	if explanation.Allowed {
+		warnIfUnenforcedGroupGrant(enf, subject, realResource, action, subResource, attrs)
+	}
+	return explanation
+}
+
+// newPolicyEnforcer returns an enforcer for the given built-in and user policy
+func newPolicyEnforcer(builtinPolicy string, userPolicy []rbac.PolicySource, defaultRole, matchMode string) *rbac.Enforcer {
 	enf := rbac.NewEnforcer(nil, "argocd", "argocd-rbac-cm", nil)
 	enf.SetDefaultRole(defaultRole)
 	enf.SetMatchMode(matchMode)
 	if builtinPolicy != "" {
 		if err := enf.SetBuiltinPolicy(builtinPolicy); err != nil {
 			log.Fatalf("could not set built-in policy: %v", err)
-			return false
 		}
 	}
-	if userPolicy != "" {
-		if err := rbac.ValidatePolicy(userPolicy); err != nil {
+	if policy := rbac.JoinPolicySources(userPolicy); policy != "" {
+		if err := rbac.ValidatePolicy(policy); err != nil {
 			log.Fatalf("invalid user policy: %v", err)
-			return false
 		}
-		if err := enf.SetUserPolicy(userPolicy); err != nil {
+		if err := enf.SetUserPolicySources(userPolicy); err != nil {
 			log.Fatalf("could not set user policy: %v", err)
-			return false
 		}
 	}
+	return enf
+}
 
+// resolveRBACRequest returns the RBAC resource and sub-resource to enforce
+// for the given user supplied resource and sub-resource
+func resolveRBACRequest(resource, action, subResource string, strict bool) (string, string) {
 	// User could have used a mutation of the resource name (i.e. 'cert' for
 	// 'certificate') - let's resolve it to the valid resource.
 	realResource := resolveRBACResourceName(resource)
@@ -409,7 +479,6 @@ func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPoli
 	if strict {
 		if err := validateRBACResourceAction(realResource, action); err != nil {
 			log.Fatalf("error in RBAC request: %v", err)
-			return false
 		}
 	}
 
@@ -421,22 +490,62 @@ func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPoli
 			subResource = "*/*"
 		}
 	}
-	result := enf.Enforce(subject, realResource, action, subResource)
-	if result {
-		warnIfUnenforcedGroupGrant(enf, subject, realResource, action, subResource)
+	return realResource, subResource
+}
+
+// printExplanation prints the trace of the evaluation of an RBAC request
+func printExplanation(w io.Writer, explanation *rbac.Explanation) {
+	if explanation.DefaultRole != nil {
+		printSubjectExplanation(w, "Default role", *explanation.DefaultRole)
+	} else {
+		fmt.Fprintln(w, "Default role: none")
+	}
+	for _, subject := range explanation.Subjects {
+		printSubjectExplanation(w, "Subject", subject)
+	}
+}
+
+// printSubjectExplanation prints the trace of the evaluation of an RBAC
+// request for a single subject
+func printSubjectExplanation(w io.Writer, kind string, explanation rbac.SubjectExplanation) {
+	result := "not allowed"
+	switch {
+	case explanation.Skipped != "":
+		result = "skipped, " + explanation.Skipped
+	case explanation.Allowed:
+		result = "allowed"
+	}
+	fmt.Fprintf(w, "%s '%s': %s\n", kind, explanation.Subject, result)
+	if len(explanation.Roles) > 0 {
+		fmt.Fprintf(w, "  roles: %s\n", strings.Join(explanation.Roles, ", "))
+	}
+	if explanation.Skipped != "" {
+		return
+	}
+	if len(explanation.Policies) == 0 {
+		fmt.Fprintln(w, "  no matching policy")
+	}
+	denied := slices.ContainsFunc(explanation.Policies, func(p rbac.MatchedPolicy) bool {
+		return p.Effect == "deny"
+	})
+	for _, p := range explanation.Policies {
+		precedence := ""
+		if denied && p.Effect == "deny" {
+			precedence = " (deny takes precedence)"
+		}
+		fmt.Fprintf(w, "  %s: %s%s\n", p.Source, p.Policy, precedence)
 	}
-	return result
 }
 
 // warnIfUnenforcedGroupGrant warns when a group subject has a grant of its own
 // but no `g,` binding. The API server only evaluates a group that appears in a
 // grouping policy (see server/rbacpolicy.EnforceClaims), so such a grant is
 // silently ignored at runtime even though this command reports it as allowed.
-func warnIfUnenforcedGroupGrant(enf *rbac.Enforcer, subject, resource, action, subResource string) {
+func warnIfUnenforcedGroupGrant(enf *rbac.Enforcer, subject, resource, action, subResource string, attrs *rbac.Attributes) {
 	if !isGroupSubject(subject) {
 		return
 	}
-	if !hasDirectGrant(enf, subject, resource, action, subResource) {
+	if !hasDirectGrant(enf, subject, resource, action, subResource, attrs) {
 		return
 	}
 	if hasGroupBinding(enf, subject) {
@@ -458,9 +567,9 @@ func isGroupSubject(subject string) bool {
 
 // hasDirectGrant reports whether the subject is granted the request by its own
 // policy, ignoring the default role (which is enforced independently of groups).
-func hasDirectGrant(enf *rbac.Enforcer, subject, resource, action, subResource string) bool {
+func hasDirectGrant(enf *rbac.Enforcer, subject, resource, action, subResource string, attrs *rbac.Attributes) bool {
 	casbinEnf := enf.CreateEnforcerWithRuntimePolicy("", "")
-	ok, err := casbinEnf.Enforce(subject, resource, action, subResource)
+	ok, err := casbinEnf.Enforce(subject, resource, action, subResource, attrs)
 	return err == nil && ok
 }
 
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"bytes"
 	"os"
 	"slices"
 	"testing"
@@ -134,7 +135,7 @@ func Test_PolicyFromYAML(t *testing.T) {
 	require.Equal(t, "role:unknown", dRole)
 	require.Empty(t, matchMode)
 	require.True(t, checkPolicy("my-org:team-qa", "update", "project", "foo",
-		"", uPol, dRole, matchMode, true))
+		"", uPol, dRole, matchMode, true, nil))
 }
 
 func Test_PolicyFromK8s(t *testing.T) {
@@ -158,63 +159,63 @@ func Test_PolicyFromK8s(t *testing.T) {
 	require.Empty(t, matchMode)
 
 	t.Run("get applications", func(t *testing.T) {
-		ok := checkPolicy("role:user", "get", "applications", "*/*", assets.BuiltinPolicyCSV, uPol, dRole, "", true)
+		ok := checkPolicy("role:user", "get", "applications", "*/*", assets.BuiltinPolicyCSV, uPol, dRole, "", true, nil)
 		require.True(t, ok)
 	})
 	t.Run("get clusters", func(t *testing.T) {
-		ok := checkPolicy("role:user", "get", "clusters", "*", assets.BuiltinPolicyCSV, uPol, dRole, "", true)
+		ok := checkPolicy("role:user", "get", "clusters", "*", assets.BuiltinPolicyCSV, uPol, dRole, "", true, nil)
 		require.True(t, ok)
 	})
 	t.Run("get certificates", func(t *testing.T) {
-		ok := checkPolicy("role:user", "get", "certificates", "*", assets.BuiltinPolicyCSV, uPol, dRole, "", true)
+		ok := checkPolicy("role:user", "get", "certificates", "*", assets.BuiltinPolicyCSV, uPol, dRole, "", true, nil)
 		require.False(t, ok)
 	})
 	t.Run("get certificates by default role", func(t *testing.T) {
-		ok := checkPolicy("role:user", "get", "certificates", "*", assets.BuiltinPolicyCSV, uPol, "role:readonly", "glob", true)
+		ok := checkPolicy("role:user", "get", "certificates", "*", assets.BuiltinPolicyCSV, uPol, "role:readonly", "glob", true, nil)
 		require.True(t, ok)
 	})
 	t.Run("get certificates by default role without builtin policy", func(t *testing.T) {
-		ok := checkPolicy("role:user", "get", "certificates", "*", "", uPol, "role:readonly", "glob", true)
+		ok := checkPolicy("role:user", "get", "certificates", "*", "", uPol, "role:readonly", "glob", true, nil)
 		require.False(t, ok)
 	})
 	t.Run("use regex match mode instead of glob", func(t *testing.T) {
-		ok := checkPolicy("role:user", "get", "certificates", ".*", assets.BuiltinPolicyCSV, uPol, "role:readonly", "regex", true)
+		ok := checkPolicy("role:user", "get", "certificates", ".*", assets.BuiltinPolicyCSV, uPol, "role:readonly", "regex", true, nil)
 		require.False(t, ok)
 	})
 	t.Run("get logs", func(t *testing.T) {
-		ok := checkPolicy("role:test", "get", "logs", "*/*", assets.BuiltinPolicyCSV, uPol, dRole, "", true)
+		ok := checkPolicy("role:test", "get", "logs", "*/*", assets.BuiltinPolicyCSV, uPol, dRole, "", true, nil)
 		require.True(t, ok)
 	})
 	t.Run("no-such-user get logs", func(t *testing.T) {
-		ok := checkPolicy("no-such-user", "get", "logs", "*/*", assets.BuiltinPolicyCSV, uPol, dRole, "", true)
+		ok := checkPolicy("no-such-user", "get", "logs", "*/*", assets.BuiltinPolicyCSV, uPol, dRole, "", true, nil)
 		require.False(t, ok)
 	})
 	t.Run("log-deny-user get logs", func(t *testing.T) {
-		ok := checkPolicy("log-deny-user", "get", "logs", "*/*", assets.BuiltinPolicyCSV, uPol, dRole, "", true)
+		ok := checkPolicy("log-deny-user", "get", "logs", "*/*", assets.BuiltinPolicyCSV, uPol, dRole, "", true, nil)
 		require.False(t, ok)
 	})
 	t.Run("log-allow-user get logs", func(t *testing.T) {
-		ok := checkPolicy("log-allow-user", "get", "logs", "*/*", assets.BuiltinPolicyCSV, uPol, dRole, "", true)
+		ok := checkPolicy("log-allow-user", "get", "logs", "*/*", assets.BuiltinPolicyCSV, uPol, dRole, "", true, nil)
 		require.True(t, ok)
 	})
 	t.Run("get logs", func(t *testing.T) {
-		ok := checkPolicy("role:test", "get", "logs", "*", assets.BuiltinPolicyCSV, uPol, dRole, "", true)
+		ok := checkPolicy("role:test", "get", "logs", "*", assets.BuiltinPolicyCSV, uPol, dRole, "", true, nil)
 		require.True(t, ok)
 	})
 	t.Run("get logs", func(t *testing.T) {
-		ok := checkPolicy("role:test", "get", "logs", "", assets.BuiltinPolicyCSV, uPol, dRole, "", true)
+		ok := checkPolicy("role:test", "get", "logs", "", assets.BuiltinPolicyCSV, uPol, dRole, "", true, nil)
 		require.True(t, ok)
 	})
 	t.Run("create exec", func(t *testing.T) {
-		ok := checkPolicy("role:test", "create", "exec", "*/*", assets.BuiltinPolicyCSV, uPol, dRole, "", true)
+		ok := checkPolicy("role:test", "create", "exec", "*/*", assets.BuiltinPolicyCSV, uPol, dRole, "", true, nil)
 		require.True(t, ok)
 	})
 	t.Run("create applicationsets", func(t *testing.T) {
-		ok := checkPolicy("role:user", "create", "applicationsets", "*/*", assets.BuiltinPolicyCSV, uPol, dRole, "", true)
+		ok := checkPolicy("role:user", "create", "applicationsets", "*/*", assets.BuiltinPolicyCSV, uPol, dRole, "", true, nil)
 		require.True(t, ok)
 	})
 	t.Run("delete applicationsets", func(t *testing.T) {
-		ok := checkPolicy("role:user", "delete", "applicationsets", "*/*", assets.BuiltinPolicyCSV, uPol, dRole, "", true)
+		ok := checkPolicy("role:user", "delete", "applicationsets", "*/*", assets.BuiltinPolicyCSV, uPol, dRole, "", true, nil)
 		require.True(t, ok)
 	})
 }
@@ -254,43 +255,43 @@ p, role:readonly, certificates, get, .*, allow
 p, role:, certificates, get, .*, allow`
 
 	t.Run("get applications", func(t *testing.T) {
-		ok := checkPolicy("role:user", "get", "applications", ".*/.*", builtInPolicy, uPol, dRole, "regex", true)
+		ok := checkPolicy("role:user", "get", "applications", ".*/.*", builtInPolicy, uPol, dRole, "regex", true, nil)
 		require.True(t, ok)
 	})
 	t.Run("get clusters", func(t *testing.T) {
-		ok := checkPolicy("role:user", "get", "clusters", ".*", builtInPolicy, uPol, dRole, "regex", true)
+		ok := checkPolicy("role:user", "get", "clusters", ".*", builtInPolicy, uPol, dRole, "regex", true, nil)
 		require.True(t, ok)
 	})
 	t.Run("get certificates", func(t *testing.T) {
-		ok := checkPolicy("role:user", "get", "certificates", ".*", builtInPolicy, uPol, dRole, "regex", true)
+		ok := checkPolicy("role:user", "get", "certificates", ".*", builtInPolicy, uPol, dRole, "regex", true, nil)
 		require.False(t, ok)
 	})
 	t.Run("get certificates by default role", func(t *testing.T) {
-		ok := checkPolicy("role:user", "get", "certificates", ".*", builtInPolicy, uPol, "role:readonly", "regex", true)
+		ok := checkPolicy("role:user", "get", "certificates", ".*", builtInPolicy, uPol, "role:readonly", "regex", true, nil)
 		require.True(t, ok)
 	})
 	t.Run("get certificates by default role without builtin policy", func(t *testing.T) {
-		ok := checkPolicy("role:user", "get", "certificates", ".*", "", uPol, "role:readonly", "regex", true)
+		ok := checkPolicy("role:user", "get", "certificates", ".*", "", uPol, "role:readonly", "regex", true, nil)
 		require.False(t, ok)
 	})
 	t.Run("use glob match mode instead of regex", func(t *testing.T) {
-		ok := checkPolicy("role:user", "get", "certificates", ".+", builtInPolicy, uPol, dRole, "glob", true)
+		ok := checkPolicy("role:user", "get", "certificates", ".+", builtInPolicy, uPol, dRole, "glob", true, nil)
 		require.False(t, ok)
 	})
 	t.Run("get logs via glob match mode", func(t *testing.T) {
-		ok := checkPolicy("role:user", "get", "logs", ".*/.*", builtInPolicy, uPol, dRole, "glob", true)
+		ok := checkPolicy("role:user", "get", "logs", ".*/.*", builtInPolicy, uPol, dRole, "glob", true, nil)
 		require.True(t, ok)
 	})
 	t.Run("create exec", func(t *testing.T) {
-		ok := checkPolicy("role:user", "create", "exec", ".*/.*", builtInPolicy, uPol, dRole, "regex", true)
+		ok := checkPolicy("role:user", "create", "exec", ".*/.*", builtInPolicy, uPol, dRole, "regex", true, nil)
 		require.True(t, ok)
 	})
 	t.Run("create applicationsets", func(t *testing.T) {
-		ok := checkPolicy("role:user", "create", "applicationsets", ".*/.*", builtInPolicy, uPol, dRole, "regex", true)
+		ok := checkPolicy("role:user", "create", "applicationsets", ".*/.*", builtInPolicy, uPol, dRole, "regex", true, nil)
 		require.True(t, ok)
 	})
 	t.Run("delete applicationsets", func(t *testing.T) {
-		ok := checkPolicy("role:user", "delete", "applicationsets", ".*/.*", builtInPolicy, uPol, dRole, "regex", true)
+		ok := checkPolicy("role:user", "delete", "applicationsets", ".*/.*", builtInPolicy, uPol, dRole, "regex", true, nil)
 		require.True(t, ok)
 	})
 }
@@ -311,6 +312,46 @@ func TestNewRBACValidateCommand(t *testing.T) {
 	assert.Equal(t, "Validate RBAC policy", command.Short)
 }
 
+func Test_checkPolicyWithConditions(t *testing.T) {
+	policy := []rbac.PolicySource{{Name: rbac.ConfigMapPolicyCSVKey, Policy: `
+p, role:dev, applications, sync, */*, allow
+p, role:dev, applications, sync, */*, deny, label('env') == 'prod'
+p, role:dev, applications, delete/*, */*, allow, "kind in ('Pod', 'ReplicaSet')"
+`}}
+	prod := &rbac.Attributes{Labels: map[string]string{"env": "prod"}}
+	dev := &rbac.Attributes{Labels: map[string]string{"env": "dev"}}
+	assert.True(t, checkPolicy("role:dev", "sync", "applications", "default/app", "", policy, "", "", true, dev))
+	assert.False(t, checkPolicy("role:dev", "sync", "applications", "default/app", "", policy, "", "", true, prod))
+	assert.False(t, checkPolicy("role:dev", "sync", "applications", "default/app", "", policy, "", "", true, nil))
+	assert.True(t, checkPolicy("role:dev", "delete/*/Pod/default/my-pod", "applications", "default/app", "", policy, "", "", true, &rbac.Attributes{Kind: "Pod"}))
+	assert.False(t, checkPolicy("role:dev", "delete/apps/Deployment/default/my-app", "applications", "default/app", "", policy, "", "", true, &rbac.Attributes{Group: "apps", Kind: "Deployment"}))
+}
+
+func Test_explainPolicy(t *testing.T) {
+	policy := []rbac.PolicySource{
+		{Name: rbac.ConfigMapPolicyCSVKey, Policy: "p, role:dev, applications, sync, */*, allow\ng, alice, role:dev"},
+		{Name: "policy.overlay.csv", Policy: "p, alice, applications, sync, prod/*, deny"},
+	}
+	explanation := explainPolicy("alice", "sync", "applications", "prod/app", "", policy, "role:readonly", "", true, nil)
+	assert.False(t, explanation.Allowed)
+
+	var out bytes.Buffer
+	printExplanation(&out, explanation)
+	assert.Equal(t, `Default role 'role:readonly': not allowed
+  no matching policy
+Subject 'alice': not allowed
+  roles: role:dev
+  policy.csv: p, role:dev, applications, sync, */*, allow
+  policy.overlay.csv: p, alice, applications, sync, prod/*, deny (deny takes precedence)
+`, out.String())
+
+	explanation = explainPolicy("alice", "sync", "applications", "dev/app", "", policy, "", "", true, nil)
+	assert.True(t, explanation.Allowed)
+	assert.Nil(t, explanation.DefaultRole)
+	require.Len(t, explanation.Subjects, 1)
+	assert.Equal(t, []rbac.MatchedPolicy{{Source: rbac.ConfigMapPolicyCSVKey, Policy: "p, role:dev, applications, sync, */*, allow", Effect: "allow"}}, explanation.Subjects[0].Policies)
+}
+
 func Test_isGroupSubject(t *testing.T) {
 	assert.True(t, isGroupSubject("my-org:team"))
 	assert.True(t, isGroupSubject("my-org:my:team"))
@@ -336,7 +377,7 @@ func Test_warnIfUnenforcedGroupGrant(t *testing.T) {
 	// checkPolicy calls warnIfUnenforcedGroupGrant when it returns Yes.
 	check := func(subject, defaultRole, userPolicy string) {
 		hook.Reset()
-		require.True(t, checkPolicy(subject, "get", "logs", "some-proj/some-app", "", userPolicy, defaultRole, "", true))
+		require.True(t, checkPolicy(subject, "get", "logs", "some-proj/some-app", "", []rbac.PolicySource{{Name: rbac.ConfigMapPolicyCSVKey, Policy: userPolicy}}, defaultRole, "", true, nil))
 	}
 
 	t.Run("warns for group with direct p, and no g, binding", func(t *testing.T) {
@@ -371,7 +412,7 @@ func Test_warnIfUnenforcedGroupGrant(t *testing.T) {
 		hook.Reset()
 		require.True(t, checkPolicy("my-org:team", "get", "logs", "some-proj/some-app",
 			"p, role:foo, logs, get, some-proj/some-app, allow\ng, my-org:team, role:foo",
-			"p, my-org:team, logs, get, some-proj/some-app, allow", "", "", true))
+			[]rbac.PolicySource{{Name: rbac.ConfigMapPolicyCSVKey, Policy: "p, my-org:team, logs, get, some-proj/some-app, allow"}}, "", "", true, nil))
 		assert.Empty(t, lastWarning())
 	})
 }
@@ -347,6 +347,23 @@ func TestResourceOverrideHealth(t *testing.T) {
 		require.NoError(t, err)
 		assert.Contains(t, out, "Progressing")
 	})
+
+	t.Run("HealthAssessmentConfiguredCEL", func(t *testing.T) {
+		cmd := NewResourceOverridesCommand(newCmdContext(t.Context(), map[string]string{
+			"resource.customizations": `example.com/ExampleResource:
+  healthCEL: |
+    {"status": "Degraded", "message": "replicas: " + string(spec.replicas)}
+`,
+		}))
+		out, err := captureStdout(func() {
+			cmd.SetArgs([]string{"health", f})
+			err := cmd.Execute()
+			require.NoError(t, err)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, out, "STATUS: Degraded")
+		assert.Contains(t, out, "MESSAGE: replicas: 0")
+	})
 }
 
 func TestResourceOverrideAction(t *testing.T) {
@@ -475,6 +475,12 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 				errors.CheckError(err)
 			case "wide", "":
 				printHeader(ctx, acdClient, app, windows, showOperation, showParams, sourcePosition)
+				if len(app.Status.Fleet) > 0 {
+					fmt.Println()
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					printAppFleet(w, app)
+					_ = w.Flush()
+				}
 				if len(app.Status.Resources) > 0 {
 					fmt.Println()
 					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
@@ -828,6 +834,9 @@ func printHelmParams(helm *argoappv1.ApplicationSourceHelm) {
 }
 
 func getServer(app *argoappv1.Application) string {
+	if app.Spec.IsFleet() {
+		return "[" + metav1.FormatLabelSelector(app.Spec.Destination.ClusterSelector) + "]"
+	}
 	if app.Spec.Destination.Server == "" {
 		return app.Spec.Destination.Name
 	}
@@ -1678,6 +1687,14 @@ func printAppResources(w io.Writer, app *argoappv1.Application) {
 	}
 }
 
+// printAppFleet prints the applications of a fleet application in a tabwriter table
+func printAppFleet(w io.Writer, app *argoappv1.Application) {
+	_, _ = fmt.Fprint(w, "CLUSTER\tNAME\tAPPLICATION\tSTEP\tSTATUS\tHEALTH\tREVISION\n")
+	for _, cluster := range app.Status.Fleet {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t%s\n", cluster.Server, cluster.Name, cluster.Application, cluster.Step, cluster.Sync, cluster.Health, cluster.Revision)
+	}
+}
+
 func printTreeView(nodeMapping map[string]argoappv1.ResourceNode, parentChildMapping map[string][]string, parentNodes map[string]struct{}, mapNodeNameToResourceState map[string]*resourceState) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	_, _ = fmt.Fprint(w, "KIND/NAME\tSTATUS\tHEALTH\tMESSAGE\n")
@@ -1714,6 +1731,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		replace                   bool
 		serverSideApply           bool
 		applyOutOfSyncOnly        bool
+		queueUntilWindow          bool
 		async                     bool
 		retryLimit                int64
 		retryRefresh              bool
@@ -1965,6 +1983,9 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					if applyOutOfSyncOnly {
 						items = append(items, common.SyncOptionApplyOutOfSyncOnly)
 					}
+					if queueUntilWindow {
+						items = append(items, argoappv1.SyncOptionQueueUntilSyncWindow)
+					}
 
 					if len(items) == 0 {
 						// for prevent send even empty array if not need
@@ -2074,6 +2095,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().BoolVar(&replace, "replace", false, "Use a kubectl create/replace instead apply")
 	command.Flags().BoolVar(&serverSideApply, "server-side", false, "Use server-side apply while syncing the application")
 	command.Flags().BoolVar(&applyOutOfSyncOnly, "apply-out-of-sync-only", false, "Sync only out-of-sync resources")
+	command.Flags().BoolVar(&queueUntilWindow, "queue-until-window", false, "Queue the sync until a sync window allows it instead of failing if it is blocked by a sync window")
 	command.Flags().BoolVar(&async, "async", false, "Do not wait for application to sync before continuing")
 	command.Flags().StringVar(&local, "local", "", "Path to a local directory. When this flag is present no git queries will be made")
 	command.Flags().StringVar(&localRepoRoot, "local-repo-root", "/", "Path to the repository root. Used together with --local allows setting the repository root")
@@ -2781,6 +2803,9 @@ func printOperationResult(opState *argoappv1.OperationState) {
 		}
 	}
 	fmt.Printf(printOpFmtStr, "Phase:", opState.Phase)
+	if opState.ScheduledAt != nil {
+		fmt.Printf(printOpFmtStr, "Scheduled:", opState.ScheduledAt)
+	}
 	fmt.Printf(printOpFmtStr, "Start:", opState.StartedAt)
 	fmt.Printf(printOpFmtStr, "Finished:", opState.FinishedAt)
 	var duration time.Duration
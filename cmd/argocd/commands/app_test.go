@@ -949,6 +949,31 @@ func TestGetService(t *testing.T) {
 		expectation := "test-name"
 		require.Equal(t, result, expectation, "Incorrect server name %q, should be %q", result, expectation)
 	})
+	t.Run("ClusterSelector", func(t *testing.T) {
+		app := &v1alpha1.Application{
+			Spec: v1alpha1.ApplicationSpec{
+				Destination: v1alpha1.ApplicationDestination{
+					ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+				},
+			},
+		}
+		assert.Equal(t, "[env=prod]", getServer(app))
+	})
+}
+
+func TestPrintAppFleet(t *testing.T) {
+	app := &v1alpha1.Application{
+		Status: v1alpha1.ApplicationStatus{Fleet: []v1alpha1.ApplicationFleetClusterStatus{
+			{Server: "https://dev-1", Name: "dev-1", Application: "guestbook-dev-1", Step: 0, Sync: v1alpha1.SyncStatusCodeSynced, Health: health.HealthStatusHealthy, Revision: "abc"},
+			{Server: "https://prod-1", Name: "prod-1", Application: "guestbook-prod-1", Step: 1},
+		}},
+	}
+	var buf bytes.Buffer
+	printAppFleet(&buf, app)
+	expectation := "CLUSTER\tNAME\tAPPLICATION\tSTEP\tSTATUS\tHEALTH\tREVISION\n" +
+		"https://dev-1\tdev-1\tguestbook-dev-1\t0\tSynced\tHealthy\tabc\n" +
+		"https://prod-1\tprod-1\tguestbook-prod-1\t1\t\t\t\n"
+	assert.Equal(t, expectation, buf.String())
 }
 
 func TestTargetObjects(t *testing.T) {
@@ -7,6 +7,7 @@ import (
 	"os"
 	"reflect"
 	"text/tabwriter"
+	"time"
 
 	k8swatch "k8s.io/apimachinery/pkg/watch"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
+	"github.com/argoproj/argo-cd/v3/common"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	arogappsetv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -71,6 +73,7 @@ func NewApplicationSetGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 	var (
 		output          string
 		showParams      bool
+		showGenerators  bool
 		appSetNamespace string
 	)
 	command := &cobra.Command{
@@ -86,6 +89,9 @@ func NewApplicationSetGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 	# Get ApplicationSet in a specific namespace using --appset-namespace flag
 	argocd appset get --appset-namespace=APPSET_NAMESPACE APPSETNAME
 
+	# Get ApplicationSet with the diagnostics of its generators
+	argocd appset get APPSETNAME --show-generators
+
 		`),
 		Run: cli.WithSignalContext(func(c *cobra.Command, args []string, _ context.CancelFunc) {
 			ctx := c.Context()
@@ -119,6 +125,9 @@ func NewApplicationSetGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 				if showParams {
 					printHelmParams(appSet.Spec.Template.Spec.GetSource().Helm)
 				}
+				if showGenerators {
+					printAppSetGenerators(appSet)
+				}
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 			}
@@ -126,6 +135,7 @@ func NewApplicationSetGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
 	command.Flags().BoolVar(&showParams, "show-params", false, "Show ApplicationSet parameters and overrides")
+	command.Flags().BoolVar(&showGenerators, "show-generators", false, "Show the diagnostics of the ApplicationSet generators recorded by the controller")
 	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Only get ApplicationSet from a namespace (ignored when qualified name is provided)")
 	return command
 }
@@ -551,6 +561,36 @@ func printAppSetConditions(w io.Writer, appSet *arogappsetv1.ApplicationSet) {
 	}
 }
 
+func printAppSetGenerators(appSet *arogappsetv1.ApplicationSet) {
+	if len(appSet.Status.Generators) == 0 {
+		fmt.Printf("No generator diagnostics recorded. Set the %s annotation to \"true\" to record them.\n", common.AnnotationApplicationSetGeneratorDiagnostics)
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprint(w, "GENERATOR\tTYPE\tPARAMS\tDURATION\tGENERATED AT\tERROR\n")
+	for _, generator := range appSet.Status.Generators {
+		generatedAt := ""
+		if generator.GeneratedAt != nil {
+			generatedAt = generator.GeneratedAt.Format(time.RFC3339)
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%d\t%s\t%s\t%s\n", generator.Index, generator.Type, generator.ParamsCount, time.Duration(generator.DurationMilliseconds)*time.Millisecond, generatedAt, generator.Error)
+	}
+	_ = w.Flush()
+	for _, generator := range appSet.Status.Generators {
+		if len(generator.Params) == 0 {
+			continue
+		}
+		fmt.Println()
+		fmt.Printf("Generator %d (%s) parameters:\n", generator.Index, generator.Type)
+		for _, params := range generator.Params {
+			fmt.Printf("  %s\n", params)
+		}
+		if generator.ParamsTruncated {
+			fmt.Printf("  (truncated, %d parameter sets in total)\n", generator.ParamsCount)
+		}
+	}
+}
+
 func isApplicationSetResourcesUpToDate(appSet *arogappsetv1.ApplicationSet) bool {
 	for _, c := range appSet.Status.Conditions {
 		if c.Type == arogappsetv1.ApplicationSetConditionResourcesUpToDate {
@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -372,3 +373,52 @@ SyncPolicy:         <none>
 		})
 	}
 }
+
+func TestPrintAppSetGenerators(t *testing.T) {
+	t.Run("no diagnostics", func(t *testing.T) {
+		output, err := captureOutput(func() error {
+			printAppSetGenerators(&v1alpha1.ApplicationSet{})
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "No generator diagnostics recorded. Set the argocd.argoproj.io/generator-diagnostics annotation to \"true\" to record them.\n", output)
+	})
+
+	t.Run("diagnostics", func(t *testing.T) {
+		generatedAt := metav1.NewTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+		output, err := captureOutput(func() error {
+			printAppSetGenerators(&v1alpha1.ApplicationSet{
+				Status: v1alpha1.ApplicationSetStatus{
+					Generators: []v1alpha1.ApplicationSetGeneratorStatus{
+						{
+							Index:                0,
+							Type:                 "List",
+							ParamsCount:          3,
+							Params:               []string{`{"cluster":"a"}`, `{"cluster":"b"}`},
+							ParamsTruncated:      true,
+							DurationMilliseconds: 12,
+							GeneratedAt:          &generatedAt,
+						},
+						{
+							Index: 1,
+							Type:  "Git",
+							Error: "repository not found",
+						},
+					},
+				},
+			})
+			return nil
+		})
+		require.NoError(t, err)
+		expectation := `GENERATOR  TYPE  PARAMS  DURATION  GENERATED AT          ERROR
+0          List  3       12ms      2024-01-02T03:04:05Z  
+1          Git   0       0s                              repository not found
+
+Generator 0 (List) parameters:
+  {"cluster":"a"}
+  {"cluster":"b"}
+  (truncated, 3 parameter sets in total)
+`
+		assert.Equal(t, expectation, output)
+	})
+}
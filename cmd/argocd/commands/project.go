@@ -275,7 +275,10 @@ func NewProjectRemoveSignatureKeyCommand(clientOpts *argocdclient.ClientOptions)
 
 // NewProjectAddDestinationCommand returns a new instance of an `argocd proj add-destination` command
 func NewProjectAddDestinationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var nameInsteadServer bool
+	var (
+		nameInsteadServer bool
+		clusterSelector   string
+	)
 
 	buildApplicationDestination := func(destination string, namespace string, nameInsteadServer bool) v1alpha1.ApplicationDestination {
 		if nameInsteadServer {
@@ -293,6 +296,9 @@ func NewProjectAddDestinationCommand(clientOpts *argocdclient.ClientOptions) *co
 
 			# Add project destination using a server name (NAME) in the specified namespace (NAMESPACE) on the project with name PROJECT
 			argocd proj add-destination PROJECT NAME NAMESPACE --name
+
+			# Add project destination in the specified namespace (NAMESPACE) on any cluster with the label tier=shared
+			argocd proj add-destination PROJECT '*' NAMESPACE --cluster-selector tier=shared
 		`),
 		Run: cli.WithSignalContext(func(c *cobra.Command, args []string, _ context.CancelFunc) {
 			ctx := c.Context()
@@ -304,6 +310,11 @@ func NewProjectAddDestinationCommand(clientOpts *argocdclient.ClientOptions) *co
 			projName := args[0]
 			namespace := args[2]
 			destination := buildApplicationDestination(args[1], namespace, nameInsteadServer)
+			if clusterSelector != "" {
+				selector, err := metav1.ParseToLabelSelector(clusterSelector)
+				errors.CheckError(err)
+				destination.ClusterSelector = selector
+			}
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDieWithContext(ctx)
 			defer utilio.Close(conn)
 
@@ -313,7 +324,7 @@ func NewProjectAddDestinationCommand(clientOpts *argocdclient.ClientOptions) *co
 			for _, dest := range proj.Spec.Destinations {
 				dstServerExist := destination.Server != "" && dest.Server == destination.Server
 				dstNameExist := destination.Name != "" && dest.Name == destination.Name
-				if dest.Namespace == namespace && (dstServerExist || dstNameExist) {
+				if dest.Namespace == namespace && (dstServerExist || dstNameExist) && formatClusterSelector(dest) == formatClusterSelector(destination) {
 					log.Fatal("Specified destination is already defined in project")
 				}
 			}
@@ -323,17 +334,22 @@ func NewProjectAddDestinationCommand(clientOpts *argocdclient.ClientOptions) *co
 		}),
 	}
 	command.Flags().BoolVar(&nameInsteadServer, "name", false, "Use name as destination instead server")
+	command.Flags().StringVar(&clusterSelector, "cluster-selector", "", "Only allow the clusters whose labels match the label selector (e.g. tier=shared)")
 	return command
 }
 
 // NewProjectRemoveDestinationCommand returns a new instance of an `argocd proj remove-destination` command
 func NewProjectRemoveDestinationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var clusterSelector string
 	command := &cobra.Command{
 		Use:   "remove-destination PROJECT SERVER NAMESPACE",
 		Short: "Remove project destination",
 		Example: templates.Examples(`
 			# Remove the destination (SERVER) from the specified namespace (NAMESPACE) on the project with name PROJECT
 			argocd proj remove-destination PROJECT SERVER NAMESPACE
+
+			# Remove the destination of the clusters with the label tier=shared from the specified namespace (NAMESPACE)
+			argocd proj remove-destination PROJECT '*' NAMESPACE --cluster-selector tier=shared
 		`),
 		Run: cli.WithSignalContext(func(c *cobra.Command, args []string, _ context.CancelFunc) {
 			ctx := c.Context()
@@ -345,6 +361,12 @@ func NewProjectRemoveDestinationCommand(clientOpts *argocdclient.ClientOptions)
 			projName := args[0]
 			server := args[1]
 			namespace := args[2]
+			destination := v1alpha1.ApplicationDestination{Server: server, Namespace: namespace}
+			if clusterSelector != "" {
+				selector, err := metav1.ParseToLabelSelector(clusterSelector)
+				errors.CheckError(err)
+				destination.ClusterSelector = selector
+			}
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDieWithContext(ctx)
 			defer utilio.Close(conn)
 
@@ -353,7 +375,7 @@ func NewProjectRemoveDestinationCommand(clientOpts *argocdclient.ClientOptions)
 
 			index := -1
 			for i, dest := range proj.Spec.Destinations {
-				if dest.Namespace == namespace && dest.Server == server {
+				if dest.Namespace == namespace && dest.Server == server && formatClusterSelector(dest) == formatClusterSelector(destination) {
 					index = i
 					break
 				}
@@ -366,7 +388,7 @@ func NewProjectRemoveDestinationCommand(clientOpts *argocdclient.ClientOptions)
 			errors.CheckError(err)
 		}),
 	}
-
+	command.Flags().StringVar(&clusterSelector, "cluster-selector", "", "Label selector of the clusters of the destination (e.g. tier=shared)")
 	return command
 }
 
@@ -597,28 +619,32 @@ func NewProjectRemoveSourceNamespace(clientOpts *argocdclient.ClientOptions) *co
 	return command
 }
 
-func modifyNamespacedResourcesList(list *[]metav1.GroupKind, add bool, listAction string, group string, kind string) (bool, string) {
+func modifyNamespacedResourcesList(list *[]v1alpha1.NamespaceResourceRestrictionItem, add bool, listAction string, group string, kind string, name string) (bool, string) {
+	// items with a label selector are only managed declaratively, so that they are never matched by the commands
+	matches := func(item v1alpha1.NamespaceResourceRestrictionItem) bool {
+		return item.Group == group && item.Kind == kind && item.Name == name && item.LabelSelector == nil
+	}
 	if add {
 		for _, item := range *list {
-			if item.Group == group && item.Kind == kind {
-				return false, fmt.Sprintf("Group '%s' and kind '%s' already present in %s namespaced resources", group, kind, listAction)
+			if matches(item) {
+				return false, fmt.Sprintf("Group '%s', kind '%s', and name '%s' is already present in %s namespaced resources", group, kind, name, listAction)
 			}
 		}
-		*list = append(*list, metav1.GroupKind{Group: group, Kind: kind})
-		return true, fmt.Sprintf("Group '%s' and kind '%s' is added to %s namespaced resources", group, kind, listAction)
+		*list = append(*list, v1alpha1.NamespaceResourceRestrictionItem{Group: group, Kind: kind, Name: name})
+		return true, fmt.Sprintf("Group '%s', kind '%s', and name '%s' is added to %s namespaced resources", group, kind, name, listAction)
 	}
 	index := -1
 	for i, item := range *list {
-		if item.Group == group && item.Kind == kind {
+		if matches(item) {
 			index = i
 			break
 		}
 	}
 	if index == -1 {
-		return false, fmt.Sprintf("Group '%s' and kind '%s' not in %s namespaced resources", group, kind, listAction)
+		return false, fmt.Sprintf("Group '%s', kind '%s', and name '%s' not in %s namespaced resources", group, kind, name, listAction)
 	}
 	*list = append((*list)[:index], (*list)[index+1:]...)
-	return true, fmt.Sprintf("Group '%s' and kind '%s' is removed from %s namespaced resources", group, kind, listAction)
+	return true, fmt.Sprintf("Group '%s', kind '%s', and name '%s' is removed from %s namespaced resources", group, kind, name, listAction)
 }
 
 func modifyClusterResourcesList(list *[]v1alpha1.ClusterResourceRestrictionItem, add bool, listAction string, group string, kind string, name string) (bool, string) {
@@ -662,20 +688,15 @@ func modifyResourceListCmd(getProjIf func(*cobra.Command) (io.Closer, projectpkg
 		Run: cli.WithSignalContext(func(c *cobra.Command, args []string, _ context.CancelFunc) {
 			ctx := c.Context()
 
-			if namespacedList && len(args) != 3 {
-				c.HelpFunc()(c, args)
-				os.Exit(1)
-			}
-
-			if !namespacedList && (len(args) < 3 || len(args) > 4) {
-				// Cluster-scoped resource command can have an optional NAME argument.
+			if len(args) < 3 || len(args) > 4 {
+				// Resource commands can have an optional NAME argument.
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
 
 			projName, group, kind := args[0], args[1], args[2]
 			var name string
-			if !namespacedList && len(args) > 3 {
+			if len(args) > 3 {
 				name = args[3]
 			}
 			conn, projIf := getProjIf(c)
@@ -683,7 +704,7 @@ func modifyResourceListCmd(getProjIf func(*cobra.Command) (io.Closer, projectpkg
 
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
-			var list, allowList, denyList *[]metav1.GroupKind
+			var list, allowList, denyList *[]v1alpha1.NamespaceResourceRestrictionItem
 			var clusterList *[]v1alpha1.ClusterResourceRestrictionItem
 			var clusterAllowList, clusterDenyList *[]v1alpha1.ClusterResourceRestrictionItem
 			var listAction string
@@ -715,7 +736,7 @@ func modifyResourceListCmd(getProjIf func(*cobra.Command) (io.Closer, projectpkg
 				return
 			}
 
-			if ok, msg := modifyNamespacedResourcesList(list, add, listAction, group, kind); ok {
+			if ok, msg := modifyNamespacedResourcesList(list, add, listAction, group, kind, name); ok {
 				c.Println(msg)
 				_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
 				errors.CheckError(err)
@@ -728,11 +749,14 @@ func modifyResourceListCmd(getProjIf func(*cobra.Command) (io.Closer, projectpkg
 
 // NewProjectAllowNamespaceResourceCommand returns a new instance of an `allow-namespace-resource` command
 func NewProjectAllowNamespaceResourceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	use := "allow-namespace-resource PROJECT GROUP KIND"
+	use := "allow-namespace-resource PROJECT GROUP KIND [NAME]"
 	desc := "Removes a namespaced API resource from the deny list or add a namespaced API resource to the allow list"
 	examples := `
 	# Removes a namespaced API resource with specified GROUP and KIND from the deny list or add a namespaced API resource to the allow list for project PROJECT
 	argocd proj allow-namespace-resource PROJECT GROUP KIND
+
+	# Removes a namespaced API resource with specified GROUP, KIND and NAME pattern from the deny list or add it to the allow list for project PROJECT
+	argocd proj allow-namespace-resource PROJECT GROUP KIND NAME
 	`
 	getProjIf := func(cmd *cobra.Command) (io.Closer, projectpkg.ProjectServiceClient) {
 		return headless.NewClientOrDie(clientOpts, cmd).NewProjectClientOrDieWithContext(cmd.Context())
@@ -742,11 +766,14 @@ func NewProjectAllowNamespaceResourceCommand(clientOpts *argocdclient.ClientOpti
 
 // NewProjectDenyNamespaceResourceCommand returns a new instance of an `argocd proj deny-namespace-resource` command
 func NewProjectDenyNamespaceResourceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	use := "deny-namespace-resource PROJECT GROUP KIND"
+	use := "deny-namespace-resource PROJECT GROUP KIND [NAME]"
 	desc := "Adds a namespaced API resource to the deny list or removes a namespaced API resource from the allow list"
 	examples := `
 	# Adds a namespaced API resource with specified GROUP and KIND from the deny list or removes a namespaced API resource from the allow list for project PROJECT
 	argocd proj deny-namespace-resource PROJECT GROUP KIND
+
+	# Adds a namespaced API resource with specified GROUP, KIND and NAME pattern to the deny list or removes it from the allow list for project PROJECT
+	argocd proj deny-namespace-resource PROJECT GROUP KIND NAME
 	`
 	getProjIf := func(cmd *cobra.Command) (io.Closer, projectpkg.ProjectServiceClient) {
 		return headless.NewClientOrDie(clientOpts, cmd).NewProjectClientOrDieWithContext(cmd.Context())
@@ -929,13 +956,27 @@ func formatOrphanedResources(p *v1alpha1.AppProject) string {
 	return fmt.Sprintf("enabled (%s)", details)
 }
 
+func formatClusterSelector(dest v1alpha1.ApplicationDestination) string {
+	if dest.ClusterSelector == nil {
+		return ""
+	}
+	return metav1.FormatLabelSelector(dest.ClusterSelector)
+}
+
+func formatProjectDestination(dest v1alpha1.ApplicationDestination) string {
+	if dest.ClusterSelector != nil {
+		return fmt.Sprintf("%s[%s],%s", dest.Server, formatClusterSelector(dest), dest.Namespace)
+	}
+	return fmt.Sprintf("%s,%s", dest.Server, dest.Namespace)
+}
+
 func printProjectLine(w io.Writer, p *v1alpha1.AppProject) {
 	var destinations, destinationServiceAccounts, sourceRepos, clusterWhitelist, namespaceBlacklist, signatureKeys string
 	switch len(p.Spec.Destinations) {
 	case 0:
 		destinations = "<none>"
 	case 1:
-		destinations = fmt.Sprintf("%s,%s", p.Spec.Destinations[0].Server, p.Spec.Destinations[0].Namespace)
+		destinations = formatProjectDestination(p.Spec.Destinations[0])
 	default:
 		destinations = fmt.Sprintf("%d destinations", len(p.Spec.Destinations))
 	}
@@ -983,15 +1024,18 @@ func printProject(p *v1alpha1.AppProject, scopedRepositories []*v1alpha1.Reposit
 
 	fmt.Printf(printProjFmtStr, "Name:", p.Name)
 	fmt.Printf(printProjFmtStr, "Description:", p.Spec.Description)
+	if p.Spec.Parent != "" {
+		fmt.Printf(printProjFmtStr, "Parent:", p.Spec.Parent)
+	}
 
 	// Print destinations
 	dest0 := "<none>"
 	if len(p.Spec.Destinations) > 0 {
-		dest0 = fmt.Sprintf("%s,%s", p.Spec.Destinations[0].Server, p.Spec.Destinations[0].Namespace)
+		dest0 = formatProjectDestination(p.Spec.Destinations[0])
 	}
 	fmt.Printf(printProjFmtStr, "Destinations:", dest0)
 	for i := 1; i < len(p.Spec.Destinations); i++ {
-		fmt.Printf(printProjFmtStr, "", fmt.Sprintf("%s,%s", p.Spec.Destinations[i].Server, p.Spec.Destinations[i].Namespace))
+		fmt.Printf(printProjFmtStr, "", formatProjectDestination(p.Spec.Destinations[i]))
 	}
 
 	// Print sources
@@ -1070,7 +1114,10 @@ func printProject(p *v1alpha1.AppProject, scopedRepositories []*v1alpha1.Reposit
 
 // NewProjectGetCommand returns a new instance of an `argocd proj get` command
 func NewProjectGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var output string
+	var (
+		output    string
+		effective bool
+	)
 	command := &cobra.Command{
 		Use:   "get PROJECT",
 		Short: "Get project details",
@@ -1081,6 +1128,9 @@ func NewProjectGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 			# Get details from project PROJECT in yaml format
 			argocd proj get PROJECT -o yaml
 
+			# Get the policies of project PROJECT merged with the ones inherited from its parent projects
+			argocd proj get PROJECT --effective
+
 		`),
 		Run: cli.WithSignalContext(func(c *cobra.Command, args []string, _ context.CancelFunc) {
 			ctx := c.Context()
@@ -1091,6 +1141,21 @@ func NewProjectGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 			}
 			projName := args[0]
 			detailedProject := getProject(ctx, c, clientOpts, projName)
+			if effective {
+				effectiveProject := getEffectiveProject(ctx, c, clientOpts, projName)
+				switch output {
+				case "yaml", "json":
+					err := PrintResource(effectiveProject, output)
+					errors.CheckError(err)
+				case "wide", "":
+					printProject(effectiveProject.Project, detailedProject.Repositories, detailedProject.Clusters)
+					fmt.Println()
+					printProjectRuleOrigins(effectiveProject.Origins)
+				default:
+					errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+				}
+				return
+			}
 
 			switch output {
 			case "yaml", "json":
@@ -1104,9 +1169,28 @@ func NewProjectGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 		}),
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().BoolVar(&effective, "effective", false, "Show the project merged with the policies inherited from its parent projects, and the project which defines each rule")
 	return command
 }
 
+func getEffectiveProject(ctx context.Context, c *cobra.Command, clientOpts *argocdclient.ClientOptions, projName string) *projectpkg.EffectiveProjectResponse {
+	conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDieWithContext(ctx)
+	defer utilio.Close(conn)
+	effectiveProject, err := projIf.GetEffectiveProject(ctx, &projectpkg.ProjectQuery{Name: projName})
+	errors.CheckError(err)
+	return effectiveProject
+}
+
+// printProjectRuleOrigins prints the project which defines each rule of an effective project
+func printProjectRuleOrigins(origins []*v1alpha1.ProjectRuleOrigin) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "FIELD\tRULE\tPROJECT\n")
+	for _, origin := range origins {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", origin.Field, origin.Rule, origin.Project)
+	}
+	_ = w.Flush()
+}
+
 func getProject(ctx context.Context, c *cobra.Command, clientOpts *argocdclient.ClientOptions, projName string) *projectpkg.DetailedProjectsResponse {
 	conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDieWithContext(ctx)
 	defer utilio.Close(conn)
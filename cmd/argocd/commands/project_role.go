@@ -50,6 +50,9 @@ func NewProjectRoleCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	roleCommand.AddCommand(NewProjectRoleRemovePolicyCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleAddGroupCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleRemoveGroupCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectRoleGrantCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectRoleApproveGrantCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectRoleRevokeGrantCommand(clientOpts))
 	return roleCommand
 }
 
@@ -221,13 +224,19 @@ ID          ISSUED-AT                                EXPIRES-AT
 
 // NewProjectRoleCreateCommand returns a new instance of an `argocd proj role create` command
 func NewProjectRoleCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var description string
+	var (
+		description          string
+		requireGrantApproval bool
+	)
 	command := &cobra.Command{
 		Use:   "create PROJECT ROLE-NAME",
 		Short: "Create a project role",
 		Example: templates.Examples(`
   # Create a project role in the "my-project" project with the name "my-role".
   argocd proj role create my-project my-role --description "My project role description"
+
+  # Create a project role whose just-in-time grants must be approved by a second user.
+  argocd proj role create my-project on-call --require-grant-approval
   		`),
 
 		Run: cli.WithSignalContext(func(c *cobra.Command, args []string, _ context.CancelFunc) {
@@ -250,7 +259,7 @@ func NewProjectRoleCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 				fmt.Printf("Role '%s' already exists\n", roleName)
 				return
 			}
-			proj.Spec.Roles = append(proj.Spec.Roles, v1alpha1.ProjectRole{Name: roleName, Description: description})
+			proj.Spec.Roles = append(proj.Spec.Roles, v1alpha1.ProjectRole{Name: roleName, Description: description, RequireGrantApproval: requireGrantApproval})
 
 			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
 			errors.CheckError(err)
@@ -258,6 +267,7 @@ func NewProjectRoleCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 		}),
 	}
 	command.Flags().StringVarP(&description, "description", "", "", "Project description")
+	command.Flags().BoolVar(&requireGrantApproval, "require-grant-approval", false, "Require the just-in-time grants of the role to be approved by a second user")
 	return command
 }
 
@@ -583,6 +593,9 @@ JWT Tokens:
 ID          ISSUED-AT                                  EXPIRES-AT
 1696774900  2023-10-08T15:21:40+01:00 (4 minutes ago)  <none>
 1696759698  2023-10-08T11:08:18+01:00 (4 hours ago)    <none>
+Grants:
+ID                                    STATUS  REQUESTED-BY  APPROVED-BY  EXPIRES-AT                                      REASON
+4b4ae2b6-66e4-4fd4-9f0e-1d4c4bc9b5e2  Active  alice         bob          2023-10-08T17:21:40+01:00 (1 hour from now)  INC-1234
 `,
 		Run: cli.WithSignalContext(func(c *cobra.Command, args []string, _ context.CancelFunc) {
 			ctx := c.Context()
@@ -628,6 +641,162 @@ ID          ISSUED-AT                                  EXPIRES-AT
 				fmt.Fprintf(w, "%d\t%s\t%s\n", token.IssuedAt, humanizeTimestamp(token.IssuedAt), expiresAt)
 			}
 			_ = w.Flush()
+			fmt.Print("Grants:\n")
+			printProjectRoleGrants(proj, roleName, time.Now())
+		}),
+	}
+	return command
+}
+
+// grantStatus returns a human readable status of a project role grant
+func grantStatus(grant v1alpha1.ProjectRoleGrant, now time.Time) string {
+	switch {
+	case grant.IsPending():
+		return "Pending"
+	case grant.IsActive(now):
+		return "Active"
+	default:
+		return "Expired"
+	}
+}
+
+// printProjectRoleGrants prints the grants of a project role in a table
+func printProjectRoleGrants(proj *v1alpha1.AppProject, roleName string, now time.Time) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, "ID\tSTATUS\tREQUESTED-BY\tAPPROVED-BY\tEXPIRES-AT\tREASON\n")
+	for _, grant := range proj.Status.Grants {
+		if grant.Role != roleName {
+			continue
+		}
+		expiresAt := "<none>"
+		if grant.ExpiresAt > 0 {
+			expiresAt = humanizeTimestamp(grant.ExpiresAt)
+		}
+		approvedBy := grant.ApprovedBy
+		if approvedBy == "" {
+			approvedBy = "<none>"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", grant.ID, grantStatus(grant, now), grant.RequestedBy, approvedBy, expiresAt, grant.Reason)
+	}
+	_ = w.Flush()
+}
+
+// NewProjectRoleGrantCommand returns a new instance of an `argocd proj role grant` command
+func NewProjectRoleGrantCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		duration string
+		reason   string
+		actions  []string
+		object   string
+		resource string
+		groups   []string
+	)
+	command := &cobra.Command{
+		Use:   "grant PROJECT ROLE-NAME",
+		Short: "Grant time-bound access to a project role",
+		Example: templates.Examples(`
+  # Allow the on-call role to sync and run actions on the applications of the project for 2 hours
+  argocd proj role grant my-project on-call --duration 2h --reason "INC-1234" --action sync --action "action/*" --object "*"
+
+  # Bind a group to the on-call role for 2 hours
+  argocd proj role grant my-project on-call --duration 2h --reason "INC-1234" --group my-org:sre
+  		`),
+		Run: cli.WithSignalContext(func(c *cobra.Command, args []string, _ context.CancelFunc) {
+			ctx := c.Context()
+
+			if len(args) != 2 || !rbac.ProjectScoped[resource] {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			roleName := args[1]
+			if len(actions) > 0 && object == "" {
+				errors.Fatal(errors.ErrorGeneric, "--object is required to grant actions")
+			}
+			var policies []string
+			for _, action := range actions {
+				policies = append(policies, fmt.Sprintf(policyTemplate, projName, roleName, resource, action, projName, object, "allow"))
+			}
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDieWithContext(ctx)
+			defer utilio.Close(conn)
+
+			grant, err := projIf.CreateRoleGrant(ctx, &projectpkg.ProjectRoleGrantCreateRequest{
+				Project:  projName,
+				Role:     roleName,
+				Duration: duration,
+				Reason:   reason,
+				Policies: policies,
+				Groups:   groups,
+			})
+			errors.CheckError(err)
+			if grant.IsPending() {
+				fmt.Printf("Grant '%s' of role '%s' requested, it must be approved by another user\n", grant.ID, roleName)
+			} else {
+				fmt.Printf("Grant '%s' of role '%s' active until %s\n", grant.ID, roleName, time.Unix(grant.ExpiresAt, 0).Format(time.RFC3339))
+			}
+		}),
+	}
+	command.Flags().StringVar(&duration, "duration", "", "Duration of the grant once it takes effect, e.g. \"2h\"")
+	command.Flags().StringVar(&reason, "reason", "", "Reason for the grant, e.g. an incident reference")
+	command.Flags().StringArrayVarP(&actions, "action", "a", nil, "Action to grant on the object, e.g. sync. Can be repeated.")
+	command.Flags().StringVarP(&object, "object", "o", "", "Object within the project to grant access to. Use '*' for a wildcard. Will grant access to '<project>/<object>'")
+	command.Flags().StringVarP(&resource, "resource", "r", "applications", "Resource e.g. 'applications', 'applicationsets', 'logs', 'exec', etc.")
+	command.Flags().StringArrayVarP(&groups, "group", "g", nil, "OIDC group claim or user name to bind to the role. Can be repeated.")
+	errors.CheckError(command.MarkFlagRequired("duration"))
+	errors.CheckError(command.MarkFlagRequired("reason"))
+	return command
+}
+
+// NewProjectRoleApproveGrantCommand returns a new instance of an `argocd proj role approve-grant` command
+func NewProjectRoleApproveGrantCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "approve-grant PROJECT ROLE-NAME GRANT-ID",
+		Short: "Approve a pending grant of a project role",
+		Example: templates.Examples(`
+  # Approve a grant requested by another user, the grant takes effect immediately
+  argocd proj role approve-grant my-project on-call 4b4ae2b6-66e4-4fd4-9f0e-1d4c4bc9b5e2
+  		`),
+		Run: cli.WithSignalContext(func(c *cobra.Command, args []string, _ context.CancelFunc) {
+			ctx := c.Context()
+
+			if len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDieWithContext(ctx)
+			defer utilio.Close(conn)
+
+			grant, err := projIf.ApproveRoleGrant(ctx, &projectpkg.ProjectRoleGrantRequest{Project: args[0], Role: args[1], Id: args[2]})
+			errors.CheckError(err)
+			fmt.Printf("Grant '%s' of role '%s' active until %s\n", grant.ID, grant.Role, time.Unix(grant.ExpiresAt, 0).Format(time.RFC3339))
+		}),
+	}
+	return command
+}
+
+// NewProjectRoleRevokeGrantCommand returns a new instance of an `argocd proj role revoke-grant` command
+func NewProjectRoleRevokeGrantCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "revoke-grant PROJECT ROLE-NAME GRANT-ID",
+		Short: "Revoke a grant of a project role",
+		Example: templates.Examples(`
+  # Revoke a grant before it expires
+  argocd proj role revoke-grant my-project on-call 4b4ae2b6-66e4-4fd4-9f0e-1d4c4bc9b5e2
+  		`),
+		Aliases: []string{"delete-grant"},
+		Run: cli.WithSignalContext(func(c *cobra.Command, args []string, _ context.CancelFunc) {
+			ctx := c.Context()
+
+			if len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDieWithContext(ctx)
+			defer utilio.Close(conn)
+
+			_, err := projIf.DeleteRoleGrant(ctx, &projectpkg.ProjectRoleGrantRequest{Project: args[0], Role: args[1], Id: args[2]})
+			errors.CheckError(err)
+			fmt.Printf("Grant '%s' of role '%s' revoked\n", args[2], args[1])
 		}),
 	}
 	return command
@@ -74,67 +74,94 @@ func TestModifyResourceListCmd_AddClusterAllowItemWithName(t *testing.T) {
 func Test_modifyNamespacedResourceList(t *testing.T) {
 	tests := []struct {
 		name           string
-		initialList    []metav1.GroupKind
+		initialList    []v1alpha1.NamespaceResourceRestrictionItem
 		add            bool
 		group          string
 		kind           string
-		expectedList   []metav1.GroupKind
+		resourceName   string
+		expectedList   []v1alpha1.NamespaceResourceRestrictionItem
 		expectedResult bool
 	}{
 		{
 			name:        "Add new item to empty list",
-			initialList: []metav1.GroupKind{},
+			initialList: []v1alpha1.NamespaceResourceRestrictionItem{},
 			add:         true,
 			group:       "apps",
 			kind:        "Deployment",
-			expectedList: []metav1.GroupKind{
+			expectedList: []v1alpha1.NamespaceResourceRestrictionItem{
 				{Group: "apps", Kind: "Deployment"},
 			},
 			expectedResult: true,
 		},
 		{
 			name: "Add duplicate item",
-			initialList: []metav1.GroupKind{
+			initialList: []v1alpha1.NamespaceResourceRestrictionItem{
 				{Group: "apps", Kind: "Deployment"},
 			},
 			add:   true,
 			group: "apps",
 			kind:  "Deployment",
-			expectedList: []metav1.GroupKind{
+			expectedList: []v1alpha1.NamespaceResourceRestrictionItem{
 				{Group: "apps", Kind: "Deployment"},
 			},
 			expectedResult: false,
 		},
 		{
 			name: "Remove existing item",
-			initialList: []metav1.GroupKind{
+			initialList: []v1alpha1.NamespaceResourceRestrictionItem{
 				{Group: "apps", Kind: "Deployment"},
 			},
 			add:            false,
 			group:          "apps",
 			kind:           "Deployment",
-			expectedList:   []metav1.GroupKind{},
+			expectedList:   []v1alpha1.NamespaceResourceRestrictionItem{},
 			expectedResult: true,
 		},
 		{
 			name: "Remove non-existent item",
-			initialList: []metav1.GroupKind{
+			initialList: []v1alpha1.NamespaceResourceRestrictionItem{
 				{Group: "apps", Kind: "Deployment"},
 			},
 			add:   false,
 			group: "apps",
 			kind:  "StatefulSet",
-			expectedList: []metav1.GroupKind{
+			expectedList: []v1alpha1.NamespaceResourceRestrictionItem{
 				{Group: "apps", Kind: "Deployment"},
 			},
 			expectedResult: false,
 		},
+		{
+			name: "Add item with name",
+			initialList: []v1alpha1.NamespaceResourceRestrictionItem{
+				{Kind: "ConfigMap"},
+			},
+			add:          true,
+			kind:         "ConfigMap",
+			resourceName: "aws-auth",
+			expectedList: []v1alpha1.NamespaceResourceRestrictionItem{
+				{Kind: "ConfigMap"},
+				{Kind: "ConfigMap", Name: "aws-auth"},
+			},
+			expectedResult: true,
+		},
+		{
+			name: "Items with label selector are not removed",
+			initialList: []v1alpha1.NamespaceResourceRestrictionItem{
+				{Kind: "Secret", LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "foo"}}},
+			},
+			add:  false,
+			kind: "Secret",
+			expectedList: []v1alpha1.NamespaceResourceRestrictionItem{
+				{Kind: "Secret", LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "foo"}}},
+			},
+			expectedResult: false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			list := tt.initialList
-			result, _ := modifyNamespacedResourcesList(&list, tt.add, "", tt.group, tt.kind)
+			result, _ := modifyNamespacedResourcesList(&list, tt.add, "", tt.group, tt.kind, tt.resourceName)
 			assert.Equal(t, tt.expectedResult, result)
 			assert.Equal(t, tt.expectedList, list)
 		})
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -387,7 +388,9 @@ argocd proj windows list test-project`,
 				err := PrintResourceList(proj.Spec.SyncWindows, output, false)
 				errors.CheckError(err)
 			case "wide", "":
-				printSyncWindows(proj)
+				state, err := projIf.GetSyncWindowsState(ctx, &projectpkg.SyncWindowsQuery{Name: projName})
+				errors.CheckError(err)
+				printSyncWindows(proj, state.Schedules)
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 			}
@@ -397,22 +400,40 @@ argocd proj windows list test-project`,
 	return command
 }
 
-// Print table of sync window data
-func printSyncWindows(proj *v1alpha1.AppProject) {
+// Print table of sync window data. The upcoming occurrences of the windows are taken from the given schedules, and
+// computed locally for windows without a schedule.
+func printSyncWindows(proj *v1alpha1.AppProject, schedules []*projectpkg.SyncWindowSchedule) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	var fmtStr string
-	headers := []any{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "TIMEZONE", "USEANDOPERATOR"}
+	headers := []any{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "TIMEZONE", "USEANDOPERATOR", "NEXT"}
 	fmtStr = strings.Repeat("%s\t", len(headers)) + "\n"
 	fmt.Fprintf(w, fmtStr, headers...)
+	schedulesByIndex := map[int]*projectpkg.SyncWindowSchedule{}
+	for _, schedule := range schedules {
+		schedulesByIndex[int(schedule.Index)] = schedule
+	}
+	now := time.Now()
 	if proj.Spec.SyncWindows.HasWindows() {
 		for i, window := range proj.Spec.SyncWindows {
-			isActive, _ := window.Active()
+			schedule, ok := schedulesByIndex[i]
+			if !ok {
+				schedule = &projectpkg.SyncWindowSchedule{Index: int32(i), Window: window}
+				occurrences, err := window.UpcomingOccurrences(now, 2)
+				if err != nil {
+					schedule.Error = err.Error()
+				}
+				schedule.Occurrences = occurrences
+			}
+			scheduleOutput := window.Schedule
+			if window.Calendar != nil {
+				scheduleOutput = window.Calendar.Source()
+			}
 			vals := []any{
 				strconv.Itoa(i),
-				formatBoolOutput(isActive),
+				formatSyncWindowStatus(schedule, now),
 				window.Kind,
-				window.Schedule,
-				window.Duration,
+				scheduleOutput,
+				formatStringOutput(window.Duration),
 				formatListOutput(window.Applications),
 				formatListOutput(window.Namespaces),
 				formatListOutput(window.Clusters),
@@ -420,6 +441,7 @@ func printSyncWindows(proj *v1alpha1.AppProject) {
 				formatBoolEnabledOutput(window.SyncOverrun),
 				window.TimeZone,
 				formatBoolEnabledOutput(window.UseAndOperator),
+				formatNextSyncWindowOccurrence(schedule, now),
 			}
 			fmt.Fprintf(w, fmtStr, vals...)
 		}
@@ -427,6 +449,32 @@ func printSyncWindows(proj *v1alpha1.AppProject) {
 	_ = w.Flush()
 }
 
+func formatSyncWindowStatus(schedule *projectpkg.SyncWindowSchedule, now time.Time) string {
+	if schedule.Error != "" {
+		return "Unknown"
+	}
+	return formatBoolOutput(len(schedule.Occurrences) > 0 && !schedule.Occurrences[0].Start.After(now))
+}
+
+func formatNextSyncWindowOccurrence(schedule *projectpkg.SyncWindowSchedule, now time.Time) string {
+	if schedule.Error != "" {
+		return "Error: " + schedule.Error
+	}
+	for _, occurrence := range schedule.Occurrences {
+		if occurrence.Start.After(now) {
+			return occurrence.Start.UTC().Format(time.RFC3339)
+		}
+	}
+	return "-"
+}
+
+func formatStringOutput(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
 func formatListOutput(list []string) string {
 	var o string
 	if len(list) == 0 {
@@ -59,10 +59,10 @@ func TestPrintSyncWindows(t *testing.T) {
 					},
 				},
 			},
-			expectedHeader: []string{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "TIMEZONE", "USEANDOPERATOR"},
+			expectedHeader: []string{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "TIMEZONE", "USEANDOPERATOR", "NEXT"},
 			expectedRows: [][]string{
-				{"0", "Active", "allow", "0 0 * * *", "1h", "app1,app2", "default", "cluster1", "Disabled", "Disabled", "UTC", "Disabled"},
-				{"1", "Inactive", "deny", "0 12 * * *", "2h", "*", "production", "*", "Enabled", "Enabled", "America/New_York", "Enabled"},
+				{"0", "Active", "allow", "0 0 * * *", "1h", "app1,app2", "default", "cluster1", "Disabled", "Disabled", "UTC", "Disabled", "2000-01-02T00:00:00Z"},
+				{"1", "Inactive", "deny", "0 12 * * *", "2h", "*", "production", "*", "Enabled", "Enabled", "America/New_York", "Enabled", "2000-01-01T17:00:00Z"},
 			},
 		},
 		{
@@ -88,9 +88,42 @@ func TestPrintSyncWindows(t *testing.T) {
 					},
 				},
 			},
-			expectedHeader: []string{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "TIMEZONE", "USEANDOPERATOR"},
+			expectedHeader: []string{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "TIMEZONE", "USEANDOPERATOR", "NEXT"},
 			expectedRows: [][]string{
-				{"0", "Inactive", "allow", "0 1 * * *", "30m", "-", "-", "-", "Disabled", "Disabled", "UTC", "Disabled"},
+				{"0", "Inactive", "allow", "0 1 * * *", "30m", "-", "-", "-", "Disabled", "Disabled", "UTC", "Disabled", "2000-01-01T01:00:00Z"},
+			},
+		},
+		{
+			name: "Project with calendar sync windows",
+			project: &v1alpha1.AppProject{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-project",
+				},
+				Spec: v1alpha1.AppProjectSpec{
+					SyncWindows: v1alpha1.SyncWindows{
+						{
+							Kind:         "deny",
+							Applications: []string{"*"},
+							Calendar: &v1alpha1.SyncWindowCalendar{
+								ICS: "BEGIN:VEVENT\nDTSTART;VALUE=DATE:19991231\nDTEND;VALUE=DATE:20000103\nRRULE:FREQ=YEARLY\nEND:VEVENT",
+							},
+							TimeZone: "UTC",
+						},
+						{
+							Kind:         "deny",
+							Applications: []string{"*"},
+							Calendar: &v1alpha1.SyncWindowCalendar{
+								URL: "https://calendar.example.com/freeze.ics",
+							},
+							TimeZone: "UTC",
+						},
+					},
+				},
+			},
+			expectedHeader: []string{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "TIMEZONE", "USEANDOPERATOR", "NEXT"},
+			expectedRows: [][]string{
+				{"0", "Active", "deny", "inline", "-", "*", "-", "-", "Disabled", "Disabled", "UTC", "Disabled", "2000-12-31T00:00:00Z"},
+				{"1", "Unknown", "deny", "https://calendar.example.com/freeze.ics", "-", "*", "-", "-", "Disabled", "Disabled", "UTC", "Disabled", "Error: cannot load calendar https://calendar.example.com/freeze.ics: calendars referencing a ConfigMap or a URL can only be evaluated by Argo CD"},
 			},
 		},
 		{
@@ -103,7 +136,7 @@ func TestPrintSyncWindows(t *testing.T) {
 					SyncWindows: v1alpha1.SyncWindows{},
 				},
 			},
-			expectedHeader: []string{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "TIMEZONE", "USEANDOPERATOR"},
+			expectedHeader: []string{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "TIMEZONE", "USEANDOPERATOR", "NEXT"},
 			expectedRows:   [][]string{},
 		},
 	}
@@ -123,7 +156,7 @@ func TestPrintSyncWindows(t *testing.T) {
 				os.Stdout = w
 
 				// Call the function
-				printSyncWindows(tt.project)
+				printSyncWindows(tt.project, nil)
 
 				// Restore stdout
 				w.Close()
@@ -25,6 +25,7 @@ type ProjectOpts struct {
 	Sources                    []string
 	SignatureKeys              []string
 	SourceNamespaces           []string
+	Parent                     string
 
 	orphanedResourcesEnabled   bool
 	orphanedResourcesWarn      bool
@@ -36,6 +37,7 @@ type ProjectOpts struct {
 
 func AddProjFlags(command *cobra.Command, opts *ProjectOpts) {
 	command.Flags().StringVarP(&opts.Description, "description", "", "", "Project description")
+	command.Flags().StringVar(&opts.Parent, "parent", "", "Parent project from which the project inherits its policies")
 	command.Flags().StringArrayVarP(&opts.destinations, "dest", "d", []string{},
 		"Permitted destination server and namespace (e.g. https://192.168.99.100:8443,default)")
 	command.Flags().StringArrayVarP(&opts.Sources, "src", "s", []string{}, "Permitted source repository URL")
@@ -44,20 +46,22 @@ func AddProjFlags(command *cobra.Command, opts *ProjectOpts) {
 	command.Flags().BoolVar(&opts.orphanedResourcesWarn, "orphaned-resources-warn", false, "Specifies if applications should have a warning condition when orphaned resources detected")
 	command.Flags().StringArrayVar(&opts.allowedClusterResources, "allow-cluster-resource", []string{}, "List of allowed cluster level resources, optionally with group and name (e.g. ClusterRole, apiextensions.k8s.io/CustomResourceDefinition, /Namespace/team1-*)")
 	command.Flags().StringArrayVar(&opts.deniedClusterResources, "deny-cluster-resource", []string{}, "List of denied cluster level resources, optionally with group and name (e.g. ClusterRole, apiextensions.k8s.io/CustomResourceDefinition, /Namespace/kube-*)")
-	command.Flags().StringArrayVar(&opts.allowedNamespacedResources, "allow-namespaced-resource", []string{}, "List of allowed namespaced resources")
-	command.Flags().StringArrayVar(&opts.deniedNamespacedResources, "deny-namespaced-resource", []string{}, "List of denied namespaced resources")
+	command.Flags().StringArrayVar(&opts.allowedNamespacedResources, "allow-namespaced-resource", []string{}, "List of allowed namespaced resources, optionally with group and name (e.g. ConfigMap, apps/Deployment, /Secret/tenant-*)")
+	command.Flags().StringArrayVar(&opts.deniedNamespacedResources, "deny-namespaced-resource", []string{}, "List of denied namespaced resources, optionally with group and name (e.g. ConfigMap, apps/Deployment, /ConfigMap/aws-auth)")
 	command.Flags().StringSliceVar(&opts.SourceNamespaces, "source-namespaces", []string{}, "List of source namespaces for applications")
 	command.Flags().StringArrayVar(&opts.destinationServiceAccounts, "dest-service-accounts", []string{},
 		"Destination server, namespace and target service account (e.g. https://192.168.99.100:8443,default,default-sa)")
 }
 
-func getGroupKindList(values []string) []metav1.GroupKind {
-	var res []metav1.GroupKind
+func getNamespaceResourceRestrictionItemList(values []string) []v1alpha1.NamespaceResourceRestrictionItem {
+	var res []v1alpha1.NamespaceResourceRestrictionItem
 	for _, val := range values {
-		if parts := strings.Split(val, "/"); len(parts) == 2 {
-			res = append(res, metav1.GroupKind{Group: parts[0], Kind: parts[1]})
+		if parts := strings.Split(val, "/"); len(parts) == 3 {
+			res = append(res, v1alpha1.NamespaceResourceRestrictionItem{Group: parts[0], Kind: parts[1], Name: parts[2]})
+		} else if len(parts) == 2 {
+			res = append(res, v1alpha1.NamespaceResourceRestrictionItem{Group: parts[0], Kind: parts[1]})
 		} else if len(parts) == 1 {
-			res = append(res, metav1.GroupKind{Kind: parts[0]})
+			res = append(res, v1alpha1.NamespaceResourceRestrictionItem{Kind: parts[0]})
 		}
 	}
 	return res
@@ -85,12 +89,12 @@ func (opts *ProjectOpts) GetDeniedClusterResources() []v1alpha1.ClusterResourceR
 	return getClusterResourceRestrictionItemList(opts.deniedClusterResources)
 }
 
-func (opts *ProjectOpts) GetAllowedNamespacedResources() []metav1.GroupKind {
-	return getGroupKindList(opts.allowedNamespacedResources)
+func (opts *ProjectOpts) GetAllowedNamespacedResources() []v1alpha1.NamespaceResourceRestrictionItem {
+	return getNamespaceResourceRestrictionItemList(opts.allowedNamespacedResources)
 }
 
-func (opts *ProjectOpts) GetDeniedNamespacedResources() []metav1.GroupKind {
-	return getGroupKindList(opts.deniedNamespacedResources)
+func (opts *ProjectOpts) GetDeniedNamespacedResources() []v1alpha1.NamespaceResourceRestrictionItem {
+	return getNamespaceResourceRestrictionItemList(opts.deniedNamespacedResources)
 }
 
 func (opts *ProjectOpts) GetDestinations() []v1alpha1.ApplicationDestination {
@@ -182,6 +186,8 @@ func SetProjSpecOptions(flags *pflag.FlagSet, spec *v1alpha1.AppProjectSpec, pro
 		switch f.Name {
 		case "description":
 			spec.Description = projOpts.Description
+		case "parent":
+			spec.Parent = projOpts.Parent
 		case "dest":
 			spec.Destinations = projOpts.GetDestinations()
 		case "src":
@@ -3,8 +3,9 @@ package util
 import (
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"github.com/stretchr/testify/require"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
@@ -13,13 +14,13 @@ func TestProjectOpts_ResourceLists(t *testing.T) {
 	t.Parallel()
 	opts := ProjectOpts{
 		allowedNamespacedResources: []string{"ConfigMap"},
-		deniedNamespacedResources:  []string{"apps/DaemonSet"},
+		deniedNamespacedResources:  []string{"apps/DaemonSet", "/ConfigMap/aws-auth"},
 		allowedClusterResources:    []string{"apiextensions.k8s.io/CustomResourceDefinition"},
 		deniedClusterResources:     []string{"rbac.authorization.k8s.io/ClusterRole"},
 	}
 
-	assert.ElementsMatch(t, []metav1.GroupKind{{Kind: "ConfigMap"}}, opts.GetAllowedNamespacedResources())
-	assert.ElementsMatch(t, []metav1.GroupKind{{Group: "apps", Kind: "DaemonSet"}}, opts.GetDeniedNamespacedResources())
+	assert.ElementsMatch(t, []v1alpha1.NamespaceResourceRestrictionItem{{Kind: "ConfigMap"}}, opts.GetAllowedNamespacedResources())
+	assert.ElementsMatch(t, []v1alpha1.NamespaceResourceRestrictionItem{{Group: "apps", Kind: "DaemonSet"}, {Kind: "ConfigMap", Name: "aws-auth"}}, opts.GetDeniedNamespacedResources())
 	assert.ElementsMatch(t, []v1alpha1.ClusterResourceRestrictionItem{{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}}, opts.GetAllowedClusterResources())
 	assert.ElementsMatch(t, []v1alpha1.ClusterResourceRestrictionItem{{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}}, opts.GetDeniedClusterResources())
 }
@@ -48,3 +49,17 @@ func TestProjectOpts_GetDestinationServiceAccounts(t *testing.T) {
 		}, opts.GetDestinationServiceAccounts(),
 	)
 }
+
+func TestSetProjSpecOptions_Parent(t *testing.T) {
+	t.Parallel()
+	command := &cobra.Command{}
+	opts := ProjectOpts{}
+	AddProjFlags(command, &opts)
+	require.NoError(t, command.Flags().Parse([]string{"--parent", "platform"}))
+
+	spec := v1alpha1.AppProjectSpec{Description: "team project"}
+	visited := SetProjSpecOptions(command.Flags(), &spec, &opts)
+	assert.Equal(t, 1, visited)
+	assert.Equal(t, "platform", spec.Parent)
+	assert.Equal(t, "team project", spec.Description)
+}
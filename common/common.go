@@ -156,6 +156,11 @@ const (
 	// cluster changes, this algorithm minimises the changes between shard and clusters assignments.
 	ConsistentHashingWithBoundedLoadsAlgorithm = "consistent-hashing"
 
+	// ResourceWeightedShardingAlgorithm uses an algorithm that distributes the clusters by their load, i.e. the number
+	// of cached resources and the rate of watch events, across all shards. Clusters are only moved to other shards
+	// once the load of a shard exceeds the average load noticeably.
+	ResourceWeightedShardingAlgorithm = "resource-weighted"
+
 	DefaultShardingAlgorithm = LegacyShardingAlgorithm
 )
 
@@ -198,6 +203,10 @@ const (
 	LabelKeySecretType = "argocd.argoproj.io/secret-type"
 	// LabelKeyClusterKubernetesVersion contains the kubernetes version of the cluster secret if it has been enabled
 	LabelKeyClusterKubernetesVersion = "argocd.argoproj.io/kubernetes-version"
+	// LabelKeyFleetApplication contains the name of the fleet application an application was created for
+	LabelKeyFleetApplication = "argocd.argoproj.io/fleet-application"
+	// LabelKeyFleetRolloutStep contains the index of the rollout step of an application created for a fleet application
+	LabelKeyFleetRolloutStep = "argocd.argoproj.io/fleet-rollout-step"
 	// LabelValueSecretTypeCluster indicates a secret type of cluster
 	LabelValueSecretTypeCluster = "cluster"
 	// LabelValueSecretTypeRepository indicates a secret type of repository
@@ -292,7 +301,7 @@ const (
 	EnvControllerHeartbeatTime = "ARGOCD_CONTROLLER_HEARTBEAT_TIME"
 	// EnvControllerShard is the shard number that should be handled by controller
 	EnvControllerShard = "ARGOCD_CONTROLLER_SHARD"
-	// EnvControllerShardingAlgorithm is the distribution sharding algorithm to be used: legacy or round-robin
+	// EnvControllerShardingAlgorithm is the distribution sharding algorithm to be used: legacy, round-robin, consistent-hashing or resource-weighted
 	EnvControllerShardingAlgorithm = "ARGOCD_CONTROLLER_SHARDING_ALGORITHM"
 	// EnvEnableDynamicClusterDistribution enables dynamic sharding (ALPHA)
 	EnvEnableDynamicClusterDistribution = "ARGOCD_ENABLE_DYNAMIC_CLUSTER_DISTRIBUTION"
@@ -443,6 +452,8 @@ func GetCMPWorkDir() string {
 const (
 	// AnnotationApplicationSetRefresh is an annotation that is added when an ApplicationSet is requested to be refreshed by a webhook. The ApplicationSet controller will remove this annotation at the end of reconciliation.
 	AnnotationApplicationSetRefresh = "argocd.argoproj.io/application-set-refresh"
+	// AnnotationApplicationSetGeneratorDiagnostics is an annotation that enables the recording of generator diagnostics in the status of an ApplicationSet, when set to "true"
+	AnnotationApplicationSetGeneratorDiagnostics = "argocd.argoproj.io/generator-diagnostics"
 )
 
 // gRPC settings
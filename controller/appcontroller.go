@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"reflect"
 	"runtime/debug"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -72,6 +73,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/helm"
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
 	settings_util "github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/argoproj/argo-cd/v3/util/syncwindow"
 	traceutil "github.com/argoproj/argo-cd/v3/util/trace"
 )
 
@@ -80,6 +82,8 @@ const (
 	defaultDeploymentInformerResyncDuration = 10 * time.Second
 	// orphanedIndex contains application which monitor orphaned resources by namespace
 	orphanedIndex = "orphaned"
+	// projectIndex contains applications by project
+	projectIndex = "project"
 	// appOperationRequeueDelay is the batching window used when a managed resource changes.
 	// The burst of resource change events during a sync is batched by the delaying queue into a
 	// single operation processing per window, batching status and operationState writes.
@@ -127,37 +131,46 @@ type ApplicationController struct {
 	// queue contains app namespace/name/comparisonType and used to request app refresh with the predefined comparison type
 	appComparisonTypeRefreshQueue workqueue.TypedRateLimitingInterface[string]
 	appOperationQueue             workqueue.TypedRateLimitingInterface[string]
-	projectRefreshQueue           workqueue.TypedRateLimitingInterface[string]
-	appHydrateQueue               workqueue.TypedRateLimitingInterface[string]
-	hydrationQueue                workqueue.TypedRateLimitingInterface[hydratortypes.HydrationQueueKey]
-	appInformer                   cache.SharedIndexInformer
-	appLister                     applisters.ApplicationLister
-	projInformer                  cache.SharedIndexInformer
-	appStateManager               AppStateManager
-	stateCache                    statecache.LiveStateCache
-	statusRefreshTimeout          time.Duration
-	statusHardRefreshTimeout      time.Duration
-	statusRefreshJitter           time.Duration
-	selfHealTimeout               time.Duration
-	selfHealBackoff               *wait.Backoff
-	syncTimeout                   time.Duration
-	db                            db.ArgoDB
-	settingsMgr                   *settings_util.SettingsManager
-	refreshRequestedApps          map[string]CompareWith
-	refreshRequestedAppsMutex     *sync.Mutex
-	metricsServer                 *metrics.MetricsServer
-	metricsClusterLabels          []string
-	kubectlSemaphore              *semaphore.Weighted
-	clusterSharding               sharding.ClusterShardingCache
-	projByNameCache               sync.Map
-	applicationNamespaces         []string
-	ignoreNormalizerOpts          normalizers.IgnoreNormalizerOpts
+	// appRefreshPriorities and appOperationPriorities order the items of appRefreshQueue and appOperationQueue
+	appRefreshPriorities        *ratelimiter.PriorityQueue[string]
+	appOperationPriorities      *ratelimiter.PriorityQueue[string]
+	projectRefreshQueue         workqueue.TypedRateLimitingInterface[string]
+	appHydrateQueue             workqueue.TypedRateLimitingInterface[string]
+	hydrationQueue              workqueue.TypedRateLimitingInterface[hydratortypes.HydrationQueueKey]
+	orphanedResourcesQueue      workqueue.TypedRateLimitingInterface[string]
+	appInformer                 cache.SharedIndexInformer
+	appLister                   applisters.ApplicationLister
+	projInformer                cache.SharedIndexInformer
+	appStateManager             AppStateManager
+	stateCache                  statecache.LiveStateCache
+	statusRefreshTimeout        time.Duration
+	statusHardRefreshTimeout    time.Duration
+	statusRefreshJitter         time.Duration
+	selfHealTimeout             time.Duration
+	selfHealBackoff             *wait.Backoff
+	syncTimeout                 time.Duration
+	db                          db.ArgoDB
+	settingsMgr                 *settings_util.SettingsManager
+	refreshRequestedApps        map[string]CompareWith
+	refreshRequestedAppsMutex   *sync.Mutex
+	metricsServer               *metrics.MetricsServer
+	metricsClusterLabels        []string
+	kubectlSemaphore            *semaphore.Weighted
+	clusterSyncConcurrencyLimit int
+	syncLimiter                 *syncConcurrencyLimiter
+	orphanedResources           *orphanedResourcesTracker
+	clusterSharding             sharding.ClusterShardingCache
+	projByNameCache             sync.Map
+	dependencyCycles            sync.Map
+	applicationNamespaces       []string
+	ignoreNormalizerOpts        normalizers.IgnoreNormalizerOpts
 
 	// dynamicClusterDistributionEnabled if disabled deploymentInformer is never initialized
 	dynamicClusterDistributionEnabled bool
 	deploymentInformer                informerv1.DeploymentInformer
 
-	hydrator *hydrator.Hydrator
+	hydrator       *hydrator.Hydrator
+	calendarLoader *syncwindow.CalendarLoader
 }
 
 // NewApplicationController creates new instance of ApplicationController.
@@ -183,6 +196,8 @@ func NewApplicationController(
 	metricsApplicationConditions []string,
 	metricsClusterLabels []string,
 	kubectlParallelismLimit int64,
+	clusterSyncConcurrencyLimit int,
+	projectSyncConcurrencyLimit int,
 	persistResourceHealth bool,
 	clusterSharding sharding.ClusterShardingCache,
 	applicationNamespaces []string,
@@ -205,12 +220,11 @@ func NewApplicationController(
 		kubeClientset:                     kubeClientset,
 		kubectl:                           kubectl,
 		applicationClientset:              applicationClientset,
-		appRefreshQueue:                   workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_reconciliation_queue"}),
-		appOperationQueue:                 workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_operation_processing_queue"}),
 		projectRefreshQueue:               workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "project_reconciliation_queue"}),
 		appComparisonTypeRefreshQueue:     workqueue.NewTypedRateLimitingQueue(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig)),
 		appHydrateQueue:                   workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_hydration_queue"}),
 		hydrationQueue:                    workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[hydratortypes.HydrationQueueKey](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[hydratortypes.HydrationQueueKey]{Name: "manifest_hydration_queue"}),
+		orphanedResourcesQueue:            workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "orphaned_resources_queue"}),
 		db:                                db,
 		statusRefreshTimeout:              appResyncPeriod,
 		statusHardRefreshTimeout:          appHardResyncPeriod,
@@ -228,6 +242,10 @@ func NewApplicationController(
 		dynamicClusterDistributionEnabled: dynamicClusterDistributionEnabled,
 		ignoreNormalizerOpts:              ignoreNormalizerOpts,
 		metricsClusterLabels:              metricsClusterLabels,
+		clusterSyncConcurrencyLimit:       clusterSyncConcurrencyLimit,
+		syncLimiter:                       newSyncConcurrencyLimiter(projectSyncConcurrencyLimit),
+		orphanedResources:                 newOrphanedResourcesTracker(),
+		calendarLoader:                    syncwindow.NewCalendarLoader(kubeClientset, settingsMgr, namespace),
 	}
 	if hydratorEnabled {
 		ctrl.hydrator = hydrator.NewHydrator(&ctrl, appResyncPeriod, commitClientset, repoClientset, db)
@@ -312,8 +330,13 @@ func NewApplicationController(
 			return nil, err
 		}
 	}
+	// user requested refreshes and operations are processed ahead of periodic reconciliations
+	ctrl.appRefreshPriorities = ratelimiter.NewPriorityQueue[string]("app_reconciliation_queue", ctrl.metricsServer)
+	ctrl.appRefreshQueue = ratelimiter.NewPriorityRateLimitingQueue(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), "app_reconciliation_queue", ctrl.appRefreshPriorities)
+	ctrl.appOperationPriorities = ratelimiter.NewPriorityQueue[string]("app_operation_processing_queue", ctrl.metricsServer)
+	ctrl.appOperationQueue = ratelimiter.NewPriorityRateLimitingQueue(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), "app_operation_processing_queue", ctrl.appOperationPriorities)
 	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking())
-	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.onKubectlRun, ctrl.settingsMgr, stateCache, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts)
+	appStateManager := NewAppStateManager(db, applicationClientset, appLister, appInformer.GetIndexer(), repoClientset, namespace, kubectl, ctrl.onKubectlRun, ctrl.settingsMgr, stateCache, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts, ctrl.calendarLoader)
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
 	ctrl.projInformer = projInformer
@@ -337,6 +360,16 @@ func (ctrl *ApplicationController) InvalidateProjectsCache(names ...string) {
 	}
 }
 
+// getProjectAndDescendants returns the name of the project and of the projects which inherit its policies
+func (ctrl *ApplicationController) getProjectAndDescendants(name string) []string {
+	projs, err := applisters.NewAppProjectLister(ctrl.projInformer.GetIndexer()).AppProjects(ctrl.namespace).List(labels.Everything())
+	if err != nil {
+		log.Warnf("Failed to list projects: %v", err)
+		return []string{name}
+	}
+	return append([]string{name}, argo.GetProjectDescendants(name, projs)...)
+}
+
 func (ctrl *ApplicationController) GetMetricsServer() *metrics.MetricsServer {
 	return ctrl.metricsServer
 }
@@ -538,14 +571,9 @@ func isKnownOrphanedResourceExclusion(key kube.ResourceKey, proj *appv1.AppProje
 	if key.Group == "" && key.Kind == "ConfigMap" && key.Name == "kube-root-ca.crt" {
 		return true
 	}
-	list := proj.Spec.OrphanedResources.Ignore
-	for _, item := range list {
-		if item.Kind == "" || glob.Match(item.Kind, key.Kind) {
-			if glob.Match(item.Group, key.Group) {
-				if item.Name == "" || glob.Match(item.Name, key.Name) {
-					return true
-				}
-			}
+	for _, item := range proj.Spec.OrphanedResources.Ignore {
+		if item.Matches(key.Group, key.Kind, key.Name) {
+			return true
 		}
 	}
 	return false
@@ -637,6 +665,8 @@ func (ctrl *ApplicationController) getResourceTree(destCluster *appv1.Cluster, a
 			orphanedNodesKeys = append(orphanedNodesKeys, k)
 		}
 	}
+	// top-level orphaned resources which are pruned or adopted, depending on the orphaned resources mode
+	var topLevelOrphans []appv1.ResourceNode
 	// Process orphaned resources
 	err = ctrl.stateCache.IterateHierarchyV2(destCluster, orphanedNodesKeys, func(child appv1.ResourceNode, appName string) bool {
 		belongToAnotherApp := false
@@ -659,11 +689,15 @@ func (ctrl *ApplicationController) getResourceTree(destCluster *appv1.Cluster, a
 			return false
 		}
 		orphanedNodes = append(orphanedNodes, child)
+		if node, ok := orphanedNodesMap[kube.NewResourceKey(child.Group, child.Kind, child.Namespace, child.Name)]; ok {
+			topLevelOrphans = append(topLevelOrphans, node)
+		}
 		return true
 	})
 	if err != nil {
 		return nil, err
 	}
+	ctrl.processOrphanedResources(context.TODO(), a, proj, topLevelOrphans)
 
 	var conditions []appv1.ApplicationCondition
 	if len(orphanedNodes) > 0 && warnOrphaned {
@@ -947,6 +981,7 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	defer ctrl.projectRefreshQueue.ShutDown()
 	defer ctrl.appHydrateQueue.ShutDown()
 	defer ctrl.hydrationQueue.ShutDown()
+	defer ctrl.orphanedResourcesQueue.ShutDown()
 
 	ctrl.RegisterClusterSecretUpdater(ctx)
 	ctrl.metricsServer.RegisterClustersInfoSource(ctx, ctrl.stateCache, ctrl.db, ctrl.metricsClusterLabels)
@@ -979,8 +1014,19 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 		return
 	}
 
+	// start loading the calendars of the sync windows before the applications are processed
+	for _, obj := range ctrl.projInformer.GetStore().List() {
+		if proj, ok := obj.(*appv1.AppProject); ok {
+			_, _ = ctrl.calendarLoader.Resolve(&proj.Spec.SyncWindows)
+		}
+	}
+
 	go func() { errors.CheckError(ctrl.stateCache.Run(ctx)) }()
 	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
+	go ctrl.calendarLoader.Run(ctx)
+	if ctrl.clusterSharding.IsResourceWeighted() {
+		go wait.UntilWithContext(ctx, ctrl.rebalanceClusterShards, clusterShardRebalanceInterval)
+	}
 
 	for range statusProcessors {
 		go wait.Until(func() {
@@ -1006,6 +1052,11 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 		}
 	}, time.Second, ctx.Done())
 
+	go wait.Until(func() {
+		for ctrl.processOrphanedResourcesQueueItem(ctx) {
+		}
+	}, time.Second, ctx.Done())
+
 	if ctrl.hydrator != nil {
 		// The app hydrate queue is keyed per application. Its only job is to decide whether the
 		// app needs hydration and, if so, enqueue the (deduped) hydration key. The Hydrating
@@ -1300,6 +1351,15 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(ctx context.Conte
 		return err
 	}
 
+	if app.Spec.IsFleet() {
+		// the applications of the fleet, which delete the resources, are deleted by the garbage collector since they
+		// are owned by the fleet application
+		app.UnSetCascadedDeletion()
+		app.UnSetPostDeleteFinalizerAll()
+		app.UnSetPreDeleteFinalizerAll()
+		return ctrl.updateFinalizers(app)
+	}
+
 	// Get destination cluster
 	destCluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, ctrl.db)
 	if err != nil {
@@ -1585,13 +1645,19 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	defer func() {
 		// Re-enqueue the app onto the operation queue to keep polling the in-progress sync.
 		// Cap the delay by the remaining sync timeout so a timeout is enforced promptly.
-		if ctrl.syncTimeout > 0 && state != nil && state.Phase != synccommon.OperationTerminating {
+		if ctrl.syncTimeout > 0 && state != nil && state.Phase != synccommon.OperationTerminating && state.Phase != appv1.OperationPendingWindow {
 			if remaining := time.Until(state.StartedAt.Add(ctrl.syncTimeout)); remaining < requeueAfter {
 				requeueAfter = remaining
 			}
 		}
 		ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.QualifiedName()), requeueAfter)
 	}()
+	defer func() {
+		// Free the sync slot of the completed operation for the next waiting one
+		if state != nil && state.Phase.Completed() {
+			ctrl.releaseSyncSlot(app)
+		}
+	}()
 
 	terminatingCause := ""
 	if isOperationInProgress(app) {
@@ -1599,7 +1665,7 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		switch {
 		case state.Phase == synccommon.OperationTerminating:
 			logCtx.Infof("Resuming in-progress operation. phase: %s, message: %s", state.Phase, state.Message)
-		case ctrl.syncTimeout != time.Duration(0) && time.Now().After(state.StartedAt.Add(ctrl.syncTimeout)):
+		case ctrl.syncTimeout != time.Duration(0) && state.Phase != appv1.OperationPendingWindow && time.Now().After(state.StartedAt.Add(ctrl.syncTimeout)):
 			state.Phase = synccommon.OperationTerminating
 			state.Message = "operation is terminating due to timeout"
 			terminatingCause = "controller sync timeout"
@@ -1650,7 +1716,59 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 
 	terminating := state.Phase == synccommon.OperationTerminating
 
+	// The sync of a fleet application is delegated to the applications of the fleet
+	if app.Spec.IsFleet() {
+		ctrl.syncFleet(ctx, app, state, terminating)
+		ctrl.setOperationState(ctx, app, state)
+		return
+	}
+
+	// Hold the sync until the application dependencies are Synced and Healthy. Once the sync has started it is not
+	// interrupted anymore.
+	if !terminating && state.SyncResult == nil && state.Operation.Sync != nil && !state.Operation.Sync.DryRun {
+		if cond := ctrl.dependenciesCondition(app); cond != nil {
+			logCtx.Info(cond.Message)
+			if state.Message != cond.Message {
+				state.Message = cond.Message
+				ctrl.setOperationState(ctx, app, state)
+			}
+			ctrl.setAppCondition(app, *cond)
+			return
+		}
+	}
+
 	project, err := ctrl.getAppProj(app)
+
+	// Queue the sync until a sync window allows it if requested. Once the sync has started it is not queued anymore.
+	if err == nil && state.SyncResult == nil && state.Operation.Sync != nil && state.Operation.Sync.SyncOptions.HasOption(appv1.SyncOptionQueueUntilSyncWindow) {
+		if terminating {
+			state.Phase = synccommon.OperationFailed
+			state.Message = "Operation terminated"
+			ctrl.setOperationState(ctx, app, state)
+			return
+		}
+		if holdUntilSyncWindow(ctrl.calendarLoader, app, project, state, time.Now()) {
+			logCtx.Info(state.Message)
+			if state.ScheduledAt != nil {
+				requeueAfter = min(requeueAfter, time.Until(state.ScheduledAt.Time)+time.Second)
+			}
+			ctrl.setOperationState(ctx, app, state)
+			return
+		}
+	}
+
+	// Wait for a free sync slot of the destination cluster and the project if their concurrent syncs are limited
+	if err == nil && !terminating && state.Operation.Sync != nil && !state.Operation.Sync.DryRun {
+		if message := ctrl.waitForSyncSlot(ctx, app, state); message != "" {
+			logCtx.Info(message)
+			if state.Message != message {
+				state.Message = message
+				ctrl.setOperationState(ctx, app, state)
+			}
+			return
+		}
+	}
+
 	if err == nil {
 		// Start or resume the sync
 		ctrl.appStateManager.SyncAppState(ctx, app, project, state)
@@ -1675,7 +1793,8 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 			}
 		}
 	case synccommon.OperationFailed, synccommon.OperationError:
-		if !terminating && (state.RetryCount < state.Operation.Retry.Limit || state.Operation.Retry.Limit < 0) {
+		// operations which exceeded their timeout are not retried, since the retry would time out right away
+		if !terminating && !operationTimedOut(app, state) && (state.RetryCount < state.Operation.Retry.Limit || state.Operation.Retry.Limit < 0) {
 			now := metav1.Now()
 			if retryAt, err := state.Operation.Retry.NextRetryAt(now.Time, state.RetryCount); err != nil {
 				state.Phase = synccommon.OperationError
@@ -1936,6 +2055,15 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		return processNext
 	}
 
+	if app.Spec.IsFleet() {
+		ctrl.reconcileFleet(ctx, app, project)
+		app.Status.ReconciledAt = &now
+		app.Status.ControllerNamespace = ctrl.namespace
+		ts.AddCheckpoint("reconcile_fleet_ms")
+		patchDuration = ctrl.persistReconciliationStatus(ctx, origApp, &app.Status)
+		return processNext
+	}
+
 	destCluster, err = argo.GetDestinationCluster(ctx, app.Spec.Destination, ctrl.db)
 	if err != nil {
 		logCtx.WithError(err).Error("Failed to get destination cluster")
@@ -1999,24 +2127,23 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
-	canSync, _ := project.Spec.SyncWindows.Matches(app).CanSync(false, nil)
-	if canSync {
-		syncErrCond, opDuration := ctrl.autoSync(ctx, app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges)
-		setOpDuration = opDuration
-		if syncErrCond != nil {
-			app.Status.SetConditions(
-				[]appv1.ApplicationCondition{*syncErrCond},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
-			)
-		} else {
-			app.Status.SetConditions(
-				[]appv1.ApplicationCondition{},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
-			)
-		}
+	var dependenciesCond *appv1.ApplicationCondition
+	if compareResult.syncStatus.Status != appv1.SyncStatusCodeSynced || app.Operation != nil {
+		dependenciesCond = ctrl.dependenciesCondition(app)
+	}
+	if dependenciesCond != nil {
+		app.Status.SetConditions(
+			[]appv1.ApplicationCondition{*dependenciesCond},
+			map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionDependencyWarning: true, appv1.ApplicationConditionDependencyError: true},
+		)
 	} else {
-		logCtx.Info("Sync prevented by sync window")
+		app.Status.SetConditions(
+			[]appv1.ApplicationCondition{},
+			map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionDependencyWarning: true, appv1.ApplicationConditionDependencyError: true},
+		)
 	}
+
+	setOpDuration = ctrl.processAutomatedSync(ctx, app, project, compareResult, dependenciesCond)
 	ts.AddCheckpoint("auto_sync_ms")
 
 	if app.Status.ReconciledAt == nil || comparisonLevel >= CompareWithLatest {
@@ -2515,6 +2642,44 @@ func (ctrl *ApplicationController) persistAppStatus(ctx context.Context, orig *a
 	return patchDuration
 }
 
+// processAutomatedSync rolls the application back or syncs it automatically, unless its dependencies or the sync windows
+// of the project prevent it. It returns the time spent patching the operation of the application.
+func (ctrl *ApplicationController) processAutomatedSync(ctx context.Context, app *appv1.Application, project *appv1.AppProject, compareResult *comparisonResult, dependenciesCond *appv1.ApplicationCondition) time.Duration {
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	canSync := false
+	if windows, err := ctrl.calendarLoader.Resolve(project.Spec.SyncWindows.Matches(app)); err != nil {
+		logCtx.WithError(err).Warn("Cannot evaluate sync windows")
+	} else {
+		canSync, _ = windows.CanSync(false, nil)
+	}
+	// automated syncs blocked by a sync window are queued by the operation processing if requested
+	queueUntilSyncWindow := app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.SyncOptions.HasOption(appv1.SyncOptionQueueUntilSyncWindow)
+	switch {
+	case dependenciesCond != nil:
+		logCtx.Info("Sync held until application dependencies are Synced and Healthy")
+	case !canSync && !queueUntilSyncWindow:
+		logCtx.Info("Sync prevented by sync window")
+	// rollbacks are not queued until a sync window allows them, since the health may have changed by then
+	case canSync && ctrl.processAutomatedRollback(ctx, app, compareResult.healthStatus):
+		logCtx.Info("Skipping auto-sync: automated rollback initiated")
+	default:
+		syncErrCond, opDuration := ctrl.autoSync(ctx, app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges)
+		if syncErrCond != nil {
+			app.Status.SetConditions(
+				[]appv1.ApplicationCondition{*syncErrCond},
+				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
+			)
+		} else {
+			app.Status.SetConditions(
+				[]appv1.ApplicationCondition{},
+				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
+			)
+		}
+		return opDuration
+	}
+	return 0
+}
+
 // autoSync will initiate a sync operation for an application configured with automated sync
 func (ctrl *ApplicationController) autoSync(ctx context.Context, app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus, shouldCompareRevisions bool) (*appv1.ApplicationCondition, time.Duration) {
 	_, span := tracer.Start(ctx, "controller.autoSync")
@@ -2570,6 +2735,13 @@ func (ctrl *ApplicationController) autoSync(ctx context.Context, app *appv1.Appl
 		desiredRevisions = syncStatus.Revisions
 	}
 
+	// Do not sync again to revisions which were automatically rolled back until new revisions arrive
+	if rollback := lastAutomatedRollback(app); rollback != nil && slices.Equal(rollback.Revisions, desiredRevisions) {
+		message := fmt.Sprintf("Auto-sync paused: %s was automatically rolled back and will not be synced again until a new revision is available", strings.Join(desiredRevisions, ", "))
+		logCtx.Info(message)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}, 0
+	}
+
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
 			Source:      source,
@@ -2841,6 +3013,13 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 				return nil, nil
 			},
+			projectIndex: func(obj any) ([]string, error) {
+				app, ok := obj.(*appv1.Application)
+				if !ok || !ctrl.isAppNamespaceAllowed(app) {
+					return nil, nil
+				}
+				return []string{app.Spec.GetProject()}, nil
+			},
 		},
 	)
 	lister := applisters.NewApplicationLister(informer.GetIndexer())
@@ -2862,16 +3041,18 @@ func (ctrl *ApplicationController) appProjectEventHandlerFuncs() cache.ResourceE
 			if key, err := cache.MetaNamespaceKeyFunc(obj); err == nil {
 				ctrl.projectRefreshQueue.AddRateLimited(key)
 				if projMeta, ok := obj.(metav1.Object); ok {
-					ctrl.InvalidateProjectsCache(projMeta.GetName())
+					ctrl.InvalidateProjectsCache(ctrl.getProjectAndDescendants(projMeta.GetName())...)
 				}
+				ctrl.invalidateDependencyCycles()
 			}
 		},
 		UpdateFunc: func(_, new any) {
 			if key, err := cache.MetaNamespaceKeyFunc(new); err == nil {
 				ctrl.projectRefreshQueue.AddRateLimited(key)
 				if projMeta, ok := new.(metav1.Object); ok {
-					ctrl.InvalidateProjectsCache(projMeta.GetName())
+					ctrl.InvalidateProjectsCache(ctrl.getProjectAndDescendants(projMeta.GetName())...)
 				}
+				ctrl.invalidateDependencyCycles()
 			}
 		},
 		DeleteFunc: func(obj any) {
@@ -2883,8 +3064,9 @@ func (ctrl *ApplicationController) appProjectEventHandlerFuncs() cache.ResourceE
 				// immediately push to queue for deletes
 				ctrl.projectRefreshQueue.Add(key)
 				if projMeta, ok := obj.(metav1.Object); ok {
-					ctrl.InvalidateProjectsCache(projMeta.GetName())
+					ctrl.InvalidateProjectsCache(ctrl.getProjectAndDescendants(projMeta.GetName())...)
 				}
+				ctrl.invalidateDependencyCycles()
 			}
 		},
 	}
@@ -2908,6 +3090,7 @@ func (ctrl *ApplicationController) applicationEventHandlerFuncs() cache.Resource
 			newApp, newOK := obj.(*appv1.Application)
 			if err == nil && newOK {
 				ctrl.clusterSharding.AddApp(newApp)
+				ctrl.invalidateDependencyCycles()
 			}
 		},
 		UpdateFunc: func(old, new any) {
@@ -2926,6 +3109,7 @@ func (ctrl *ApplicationController) applicationEventHandlerFuncs() cache.Resource
 			oldApp, oldOK := old.(*appv1.Application)
 			newApp, newOK := new.(*appv1.Application)
 			if oldOK && newOK {
+				ctrl.prioritizeUserRequests(key, oldApp, newApp)
 				if automatedSyncEnabled(oldApp, newApp) {
 					log.WithFields(applog.GetAppLogFields(newApp)).Info("Enabled automated sync")
 					compareWith = CompareWithLatest.Pointer()
@@ -2936,7 +3120,16 @@ func (ctrl *ApplicationController) applicationEventHandlerFuncs() cache.Resource
 					delay = &jitter
 				}
 			}
+			if oldOK && newOK && dependencySpecChanged(oldApp, newApp) {
+				ctrl.invalidateDependencyCycles()
+			}
 			ctrl.requestAppRefresh(newApp.QualifiedName(), compareWith, delay)
+			if oldOK && newOK && dependencyStatusChanged(oldApp, newApp) {
+				ctrl.requestDependentAppsRefresh(newApp)
+			}
+			if oldOK && newOK && fleetAppStatusChanged(oldApp, newApp) {
+				ctrl.requestFleetAppRefresh(newApp)
+			}
 			if ctrl.hydrator != nil && newOK {
 				ctrl.appHydrateQueue.AddRateLimited(newApp.QualifiedName())
 			}
@@ -2963,11 +3156,27 @@ func (ctrl *ApplicationController) applicationEventHandlerFuncs() cache.Resource
 			delApp, delOK := obj.(*appv1.Application)
 			if err == nil && delOK {
 				ctrl.clusterSharding.DeleteApp(delApp)
+				ctrl.invalidateDependencyCycles()
+				// the application of a fleet is recreated if it has been deleted while its cluster is still selected
+				ctrl.requestFleetAppRefresh(delApp)
 			}
 		},
 	}
 }
 
+// prioritizeUserRequests raises the priority of the refresh or the operation of the given application if it was
+// requested by a user, e.g. using the API or a webhook, so that it is processed ahead of periodic reconciliations
+func (ctrl *ApplicationController) prioritizeUserRequests(key string, oldApp, newApp *appv1.Application) {
+	_, refreshRequested := newApp.IsRefreshRequested()
+	_, wasRefreshRequested := oldApp.IsRefreshRequested()
+	if refreshRequested && !wasRefreshRequested {
+		ctrl.appRefreshPriorities.Prioritize(key, ratelimiter.PriorityHigh)
+	}
+	if newApp.Operation != nil && oldApp.Operation == nil && !newApp.Operation.InitiatedBy.Automated {
+		ctrl.appOperationPriorities.Prioritize(key, ratelimiter.PriorityHigh)
+	}
+}
+
 func (ctrl *ApplicationController) projectErrorToCondition(err error, app *appv1.Application) appv1.ApplicationCondition {
 	var condition appv1.ApplicationCondition
 	if apierrors.IsNotFound(err) {
@@ -19,6 +19,7 @@ import (
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
 
@@ -88,6 +89,10 @@ type fakeData struct {
 	// persistResourceHealth controls whether managed resource health is stored
 	// inline on the Application. When nil it defaults to true.
 	persistResourceHealth *bool
+	// clusterSyncConcurrencyLimit and projectSyncConcurrencyLimit limit the
+	// number of concurrent sync operations. 0 means no limit.
+	clusterSyncConcurrencyLimit int
+	projectSyncConcurrencyLimit int
 }
 
 type MockKubectl struct {
@@ -95,6 +100,19 @@ type MockKubectl struct {
 
 	DeletedResources []kube.ResourceKey
 	CreatedResources []*unstructured.Unstructured
+	PatchedResources map[kube.ResourceKey]string
+	// LiveResources are returned by GetResource, if set
+	LiveResources map[kube.ResourceKey]*unstructured.Unstructured
+}
+
+func (m *MockKubectl) GetResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
+	if m.LiveResources != nil {
+		if live, ok := m.LiveResources[kube.NewResourceKey(gvk.Group, gvk.Kind, namespace, name)]; ok {
+			return live.DeepCopy(), nil
+		}
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, name)
+	}
+	return m.Kubectl.GetResource(ctx, config, gvk, name, namespace)
 }
 
 func (m *MockKubectl) CreateResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, obj *unstructured.Unstructured, createOptions metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
@@ -102,6 +120,14 @@ func (m *MockKubectl) CreateResource(ctx context.Context, config *rest.Config, g
 	return m.Kubectl.CreateResource(ctx, config, gvk, name, namespace, obj, createOptions, subresources...)
 }
 
+func (m *MockKubectl) PatchResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, patchType types.PatchType, patchBytes []byte, subresources ...string) (*unstructured.Unstructured, error) {
+	if m.PatchedResources == nil {
+		m.PatchedResources = map[kube.ResourceKey]string{}
+	}
+	m.PatchedResources[kube.NewResourceKey(gvk.Group, gvk.Kind, namespace, name)] = string(patchBytes)
+	return m.Kubectl.PatchResource(ctx, config, gvk, name, namespace, patchType, patchBytes, subresources...)
+}
+
 func (m *MockKubectl) DeleteResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, deleteOptions metav1.DeleteOptions) error {
 	m.DeletedResources = append(m.DeletedResources, kube.NewResourceKey(gvk.Group, gvk.Kind, namespace, name))
 	return m.Kubectl.DeleteResource(ctx, config, gvk, name, namespace, deleteOptions)
@@ -249,6 +275,8 @@ func newFakeControllerWithResync(ctx context.Context, data *fakeData, appResyncP
 		[]string{},
 		[]string{},
 		0,
+		data.clusterSyncConcurrencyLimit,
+		data.projectSyncConcurrencyLimit,
 		persistResourceHealth,
 		nil,
 		data.applicationNamespaces,
@@ -2688,7 +2716,7 @@ func TestOrphanedIndexDoesNotQueryProjectDuringStartupRace(t *testing.T) {
 		time.Minute, time.Hour, time.Second, time.Minute, nil, 0, 10*time.Second,
 		common.DefaultPortArgoCDMetrics, 0,
 		[]string{}, []string{}, []string{},
-		0, true, nil, nil, nil, false, false,
+		0, 0, 0, true, nil, nil, nil, false, false,
 		normalizers.IgnoreNormalizerOpts{}, testEnableEventList, false,
 	)
 	require.NoError(t, err)
@@ -2753,7 +2781,7 @@ func TestOrphanedIndexReturnsNamespaceWhenProjectHasOrphanedResources(t *testing
 		time.Minute, time.Hour, time.Second, time.Minute, nil, 0, 10*time.Second,
 		common.DefaultPortArgoCDMetrics, 0,
 		[]string{}, []string{}, []string{},
-		0, true, nil, nil, nil, false, false,
+		0, 0, 0, true, nil, nil, nil, false, false,
 		normalizers.IgnoreNormalizerOpts{}, testEnableEventList, false,
 	)
 	require.NoError(t, err)
@@ -3072,6 +3100,153 @@ func TestProcessRequestedAppOperation_HasRetriesTerminated(t *testing.T) {
 	assert.Equal(t, "Operation terminated", patchedApp.Status.OperationState.Message)
 }
 
+func TestProcessRequestedAppOperation_QueuedUntilSyncWindow(t *testing.T) {
+	app := newFakeApp()
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{SyncOptions: v1alpha1.SyncOptions{v1alpha1.SyncOptionQueueUntilSyncWindow}},
+	}
+	app.Status.OperationState = nil
+	proj := defaultProj
+	proj.Spec.SyncWindows = v1alpha1.SyncWindows{{Kind: "allow", Schedule: "0 0 1 1 *", Duration: "1h", Applications: []string{"*"}}}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &proj}}, nil)
+
+	ctrl.processRequestedAppOperation(app)
+
+	patchedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, patchedApp.Operation)
+	require.NotNil(t, patchedApp.Status.OperationState)
+	assert.Equal(t, v1alpha1.OperationPendingWindow, patchedApp.Status.OperationState.Phase)
+	require.NotNil(t, patchedApp.Status.OperationState.ScheduledAt)
+	scheduledAt := patchedApp.Status.OperationState.ScheduledAt.UTC()
+	assert.Equal(t, time.January, scheduledAt.Month())
+	assert.Equal(t, 1, scheduledAt.Day())
+	assert.Contains(t, patchedApp.Status.OperationState.Message, "Sync operation queued until the next sync window at")
+	assert.Nil(t, patchedApp.Status.OperationState.SyncResult)
+}
+
+func TestProcessRequestedAppOperation_QueuedUntilSyncWindowStarts(t *testing.T) {
+	app := newFakeApp()
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{SyncOptions: v1alpha1.SyncOptions{v1alpha1.SyncOptionQueueUntilSyncWindow}},
+	}
+	app.Status.OperationState.Operation = *app.Operation
+	app.Status.OperationState.Phase = v1alpha1.OperationPendingWindow
+	app.Status.OperationState.SyncResult = nil
+	app.Status.OperationState.ScheduledAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	data := &fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+	}
+	ctrl := newFakeController(t.Context(), data, nil)
+
+	ctrl.processRequestedAppOperation(app)
+
+	patchedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, patchedApp.Status.OperationState)
+	assert.Equal(t, synccommon.OperationSucceeded, patchedApp.Status.OperationState.Phase)
+	assert.NotNil(t, patchedApp.Status.OperationState.ScheduledAt)
+}
+
+func TestProcessRequestedAppOperation_QueuedUntilSyncWindowTerminated(t *testing.T) {
+	app := newFakeApp()
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{SyncOptions: v1alpha1.SyncOptions{v1alpha1.SyncOptionQueueUntilSyncWindow}},
+	}
+	app.Status.OperationState.Operation = *app.Operation
+	app.Status.OperationState.Phase = synccommon.OperationTerminating
+	app.Status.OperationState.SyncResult = nil
+	proj := defaultProj
+	proj.Spec.SyncWindows = v1alpha1.SyncWindows{{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"*"}}}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &proj}}, nil)
+
+	ctrl.processRequestedAppOperation(app)
+
+	patchedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, patchedApp.Operation)
+	require.NotNil(t, patchedApp.Status.OperationState)
+	assert.Equal(t, synccommon.OperationFailed, patchedApp.Status.OperationState.Phase)
+	assert.Equal(t, "Operation terminated", patchedApp.Status.OperationState.Message)
+}
+
+func TestApplicationEventHandler_PrioritizesUserRequests(t *testing.T) {
+	app := newFakeApp()
+	otherApp := newFakeApp()
+	otherApp.Name = "other-app"
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, otherApp, &defaultProj}}, nil)
+	handler := ctrl.applicationEventHandlerFuncs()
+	appKey := ctrl.toAppKey(app.QualifiedName())
+	otherKey := ctrl.toAppKey(otherApp.QualifiedName())
+
+	ctrl.appRefreshQueue.Add(otherKey)
+	ctrl.appOperationQueue.Add(otherKey)
+	refreshedApp := app.DeepCopy()
+	refreshedApp.ResourceVersion = "2"
+	refreshedApp.Annotations = map[string]string{v1alpha1.AnnotationKeyRefresh: string(v1alpha1.RefreshTypeNormal)}
+	refreshedApp.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}, InitiatedBy: v1alpha1.OperationInitiator{Username: "admin"}}
+	handler.UpdateFunc(app, refreshedApp)
+
+	assert.Eventually(t, func() bool { return ctrl.appRefreshQueue.Len() == 2 && ctrl.appOperationQueue.Len() == 2 }, 5*time.Second, 10*time.Millisecond)
+	key, _ := ctrl.appRefreshQueue.Get()
+	assert.Equal(t, appKey, key)
+	key, _ = ctrl.appOperationQueue.Get()
+	assert.Equal(t, appKey, key)
+}
+
+func TestProcessRequestedAppOperation_WaitsForSyncSlot(t *testing.T) {
+	app := newFakeApp()
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	app.Status.OperationState = nil
+	otherApp := newFakeApp()
+	otherApp.Name = "other-app"
+	otherApp.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, otherApp, &defaultProj}, clusterSyncConcurrencyLimit: 1}, nil)
+	require.NoError(t, ctrl.appInformer.GetIndexer().Add(otherApp))
+	ctrl.syncLimiter.track(ctrl.toAppKey(otherApp.QualifiedName()), syncSlot{cluster: app.Spec.Destination.Server, project: "default"})
+
+	ctrl.processRequestedAppOperation(app)
+
+	patchedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, patchedApp.Status.OperationState)
+	assert.Equal(t, synccommon.OperationRunning, patchedApp.Status.OperationState.Phase)
+	assert.Equal(t, "Waiting for a free sync slot of cluster "+app.Spec.Destination.Server+": 1 operations running or queued ahead, limit is 1", patchedApp.Status.OperationState.Message)
+	assert.Nil(t, patchedApp.Status.OperationState.SyncResult)
+	assert.Equal(t, 1, ctrl.syncLimiter.queueDepth(app.Spec.Destination.Server))
+}
+
+func TestProcessRequestedAppOperation_ReleasesSyncSlot(t *testing.T) {
+	app := newFakeApp()
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	app.Status.OperationState = nil
+	data := &fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		clusterSyncConcurrencyLimit: 1,
+	}
+	ctrl := newFakeController(t.Context(), data, nil)
+
+	ctrl.processRequestedAppOperation(app)
+
+	patchedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, patchedApp.Status.OperationState)
+	assert.Equal(t, synccommon.OperationSucceeded, patchedApp.Status.OperationState.Phase)
+	assert.Empty(t, ctrl.syncLimiter.running)
+}
+
 func TestProcessRequestedAppOperation_Successful(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.Project = "default"
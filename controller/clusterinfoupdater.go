@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/argoproj/argo-cd/v3/common"
@@ -40,6 +41,16 @@ type clusterInfoUpdater struct {
 	projGetter    func(app *appv1.Application) (*appv1.AppProject, error)
 	namespace     string
 	lastUpdated   time.Time
+
+	// eventSamples holds the number of watch events of each cluster seen by the previous update, which is used to
+	// compute the events rate
+	eventSamples     map[string]eventsSample
+	eventSamplesLock sync.Mutex
+}
+
+type eventsSample struct {
+	count int64
+	time  time.Time
 }
 
 func NewClusterInfoUpdater(
@@ -51,7 +62,15 @@ func NewClusterInfoUpdater(
 	projGetter func(app *appv1.Application) (*appv1.AppProject, error),
 	namespace string,
 ) *clusterInfoUpdater {
-	return &clusterInfoUpdater{infoSource, db, appLister, cache, clusterFilter, projGetter, namespace, time.Time{}}
+	return &clusterInfoUpdater{
+		infoSource:    infoSource,
+		db:            db,
+		appLister:     appLister,
+		cache:         cache,
+		clusterFilter: clusterFilter,
+		projGetter:    projGetter,
+		namespace:     namespace,
+	}
 }
 
 func (c *clusterInfoUpdater) Run(ctx context.Context) {
@@ -156,6 +175,7 @@ func (c *clusterInfoUpdater) getUpdatedClusterInfo(ctx context.Context, apps []*
 			clusterInfo.CacheInfo.LastCacheSyncTime = &syncTime
 			clusterInfo.CacheInfo.APIsCount = int64(info.APIsCount)
 			clusterInfo.CacheInfo.ResourcesCount = int64(info.ResourcesCount)
+			clusterInfo.CacheInfo.EventsPerMinute = c.eventsPerMinute(cluster.Server, info.EventsCount, now.Time)
 		default:
 			clusterInfo.ConnectionState.Status = appv1.ConnectionStatusFailed
 			clusterInfo.ConnectionState.Message = info.SyncError.Error()
@@ -170,6 +190,23 @@ func (c *clusterInfoUpdater) getUpdatedClusterInfo(ctx context.Context, apps []*
 	return clusterInfo
 }
 
+// eventsPerMinute returns the rate of watch events received from the cluster since the previous update. The rate is
+// unknown and zero on the first update, and after the cluster cache was recreated.
+func (c *clusterInfoUpdater) eventsPerMinute(server string, count int64, now time.Time) int64 {
+	c.eventSamplesLock.Lock()
+	defer c.eventSamplesLock.Unlock()
+	if c.eventSamples == nil {
+		c.eventSamples = map[string]eventsSample{}
+	}
+	prev, ok := c.eventSamples[server]
+	c.eventSamples[server] = eventsSample{count: count, time: now}
+	elapsed := now.Sub(prev.time)
+	if !ok || count < prev.count || elapsed <= 0 {
+		return 0
+	}
+	return int64(float64(count-prev.count) * float64(time.Minute) / float64(elapsed))
+}
+
 func updateClusterLabels(ctx context.Context, clusterInfo *cache.ClusterInfo, cluster appv1.Cluster, updateCluster func(context.Context, *appv1.Cluster) (*appv1.Cluster, error)) error {
 	if clusterInfo != nil && cluster.Labels[common.LabelKeyAutoLabelClusterInfo] == "true" && cluster.Labels[common.LabelKeyClusterKubernetesVersion] != clusterInfo.K8SVersion {
 		cluster.Labels[common.LabelKeyClusterKubernetesVersion] = clusterInfo.K8SVersion
@@ -216,6 +216,18 @@ func TestGetUpdatedClusterInfo_AmbiguousName(t *testing.T) {
 	assert.Equal(t, int64(0), info.ApplicationsCount, "ambiguous name should not count app")
 }
 
+func TestClusterInfoUpdater_EventsPerMinute(t *testing.T) {
+	updater := &clusterInfoUpdater{}
+	now := time.Now()
+
+	assert.Equal(t, int64(0), updater.eventsPerMinute("https://cluster-1", 100, now), "rate is unknown on the first update")
+	assert.Equal(t, int64(60), updater.eventsPerMinute("https://cluster-1", 110, now.Add(10*time.Second)))
+	assert.Equal(t, int64(0), updater.eventsPerMinute("https://cluster-2", 500, now.Add(10*time.Second)))
+	// the cluster cache was recreated
+	assert.Equal(t, int64(0), updater.eventsPerMinute("https://cluster-1", 5, now.Add(20*time.Second)))
+	assert.Equal(t, int64(120), updater.eventsPerMinute("https://cluster-1", 25, now.Add(30*time.Second)))
+}
+
 func TestUpdateClusterLabels(t *testing.T) {
 	shouldNotBeInvoked := func(_ context.Context, _ *v1alpha1.Cluster) (*v1alpha1.Cluster, error) {
 		shouldNotHappen := errors.New("if an error happens here, something's wrong")
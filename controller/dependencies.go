@@ -0,0 +1,237 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+)
+
+// dependenciesCondition returns a condition explaining why the sync of the given application is held back by its
+// dependencies, or nil if the application has no dependencies or all of them are Synced and Healthy.
+func (ctrl *ApplicationController) dependenciesCondition(app *appv1.Application) *appv1.ApplicationCondition {
+	if len(app.Spec.DependsOn) == 0 {
+		return nil
+	}
+	if cycle := ctrl.findDependencyCycle(app); len(cycle) > 0 {
+		return &appv1.ApplicationCondition{
+			Type:    appv1.ApplicationConditionDependencyError,
+			Message: "Application dependencies form a cycle: " + strings.Join(cycle, " -> "),
+		}
+	}
+	dependencies, missing, err := ctrl.getAppDependencies(app)
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionDependencyError, Message: err.Error()}
+	}
+	waiting := make([]string, 0, len(missing))
+	for _, name := range missing {
+		waiting = append(waiting, name+" (not found)")
+	}
+	for _, dependency := range dependencies {
+		if reason := dependencyNotReadyReason(dependency); reason != "" {
+			waiting = append(waiting, fmt.Sprintf("%s (%s)", dependency.QualifiedName(), reason))
+		}
+	}
+	if len(waiting) == 0 {
+		return nil
+	}
+	return &appv1.ApplicationCondition{
+		Type:    appv1.ApplicationConditionDependencyWarning,
+		Message: "Waiting for dependencies to be Synced and Healthy: " + strings.Join(waiting, ", "),
+	}
+}
+
+// dependencyNotReadyReason returns why the given dependency is not yet Synced and Healthy, or an empty string if it is.
+func dependencyNotReadyReason(dependency *appv1.Application) string {
+	switch {
+	case dependency.Operation != nil || isOperationInProgress(dependency):
+		return "operation in progress"
+	case dependency.Status.Sync.Status != appv1.SyncStatusCodeSynced:
+		if dependency.Status.Sync.Status == "" {
+			return string(appv1.SyncStatusCodeUnknown)
+		}
+		return string(dependency.Status.Sync.Status)
+	case dependency.Status.Health.Status != health.HealthStatusHealthy:
+		if dependency.Status.Health.Status == "" {
+			return string(health.HealthStatusUnknown)
+		}
+		return string(dependency.Status.Health.Status)
+	}
+	return ""
+}
+
+// getAppDependencies resolves the dependencies of the given application. Only applications of the same project, or in
+// the namespaces the project of the application permits, can be dependencies. Dependencies referenced by name which do
+// not exist or are not permitted are returned as missing, while selectors which do not match any permitted application
+// are ignored.
+func (ctrl *ApplicationController) getAppDependencies(app *appv1.Application) ([]*appv1.Application, []string, error) {
+	proj, err := ctrl.getAppProj(app)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting the project of the application: %w", err)
+	}
+	permitted := func(dependency *appv1.Application) bool {
+		return dependency.Spec.GetProject() == app.Spec.GetProject() || proj.IsAppNamespacePermitted(dependency, ctrl.namespace)
+	}
+	var dependencies []*appv1.Application
+	var missing []string
+	seen := map[string]bool{}
+	add := func(dependency *appv1.Application) {
+		key := dependency.Namespace + "/" + dependency.Name
+		if !seen[key] {
+			seen[key] = true
+			dependencies = append(dependencies, dependency)
+		}
+	}
+	for _, ref := range app.Spec.DependsOn {
+		namespace := dependencyNamespace(app, ref)
+		if ref.Name != "" {
+			dependency, err := ctrl.appLister.Applications(namespace).Get(ref.Name)
+			// dependencies which are not permitted are reported as missing to not disclose their existence
+			if err != nil || !permitted(dependency) {
+				missing = append(missing, (&appv1.Application{ObjectMeta: metav1.ObjectMeta{Name: ref.Name, Namespace: namespace}}).QualifiedName())
+				continue
+			}
+			add(dependency)
+			continue
+		}
+		if ref.Selector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(ref.Selector)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid dependency selector: %w", err)
+		}
+		candidates, err := ctrl.appLister.Applications(namespace).List(selector)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error listing application dependencies: %w", err)
+		}
+		for _, candidate := range candidates {
+			if (candidate.Namespace != app.Namespace || candidate.Name != app.Name) && permitted(candidate) {
+				add(candidate)
+			}
+		}
+	}
+	return dependencies, missing, nil
+}
+
+// findDependencyCycle returns the names of the applications forming a dependency cycle reachable from the given
+// application, or nil if there is none. The dependency graph is only walked again once the dependencies of an
+// application or a project changed, see invalidateDependencyCycles.
+func (ctrl *ApplicationController) findDependencyCycle(app *appv1.Application) []string {
+	key := app.Namespace + "/" + app.Name
+	if cached, ok := ctrl.dependencyCycles.Load(key); ok {
+		return cached.([]string)
+	}
+	cycle := ctrl.walkDependencyCycle(app)
+	ctrl.dependencyCycles.Store(key, cycle)
+	return cycle
+}
+
+// invalidateDependencyCycles drops the dependency cycles found so far, so that the dependency graph is walked again
+func (ctrl *ApplicationController) invalidateDependencyCycles() {
+	ctrl.dependencyCycles.Clear()
+}
+
+func (ctrl *ApplicationController) walkDependencyCycle(app *appv1.Application) []string {
+	var path []*appv1.Application
+	visited := map[string]bool{}
+	var visit func(current *appv1.Application) []string
+	visit = func(current *appv1.Application) []string {
+		key := current.Namespace + "/" + current.Name
+		if i := slices.IndexFunc(path, func(a *appv1.Application) bool { return a.Namespace+"/"+a.Name == key }); i >= 0 {
+			cycle := make([]string, 0, len(path)-i+1)
+			for _, a := range path[i:] {
+				cycle = append(cycle, a.QualifiedName())
+			}
+			return append(cycle, current.QualifiedName())
+		}
+		if visited[key] {
+			return nil
+		}
+		visited[key] = true
+		path = append(path, current)
+		dependencies, _, err := ctrl.getAppDependencies(current)
+		if err == nil {
+			for _, dependency := range dependencies {
+				if cycle := visit(dependency); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		return nil
+	}
+	return visit(app)
+}
+
+// requestDependentAppsRefresh requests a refresh of the applications which depend on the given application, so that
+// their held syncs are resumed as soon as it becomes Synced and Healthy.
+func (ctrl *ApplicationController) requestDependentAppsRefresh(app *appv1.Application) {
+	apps, err := ctrl.appLister.List(labels.Everything())
+	if err != nil {
+		log.WithFields(applog.GetAppLogFields(app)).WithError(err).Warn("Failed to list dependent applications")
+		return
+	}
+	for _, candidate := range apps {
+		if !dependsOn(candidate, app) || !ctrl.canProcessApp(candidate) {
+			continue
+		}
+		ctrl.requestAppRefresh(candidate.QualifiedName(), CompareWithRecent.Pointer(), nil)
+		if ctrl.shouldProcessOperation(candidate) {
+			ctrl.appOperationQueue.AddRateLimited(ctrl.toAppKey(candidate.QualifiedName()))
+		}
+	}
+}
+
+// dependsOn returns whether the application references the given dependency in its spec.dependsOn field
+func dependsOn(app *appv1.Application, dependency *appv1.Application) bool {
+	if app.Namespace == dependency.Namespace && app.Name == dependency.Name {
+		return false
+	}
+	for _, ref := range app.Spec.DependsOn {
+		if dependencyNamespace(app, ref) != dependency.Namespace {
+			continue
+		}
+		if ref.Name != "" {
+			if ref.Name == dependency.Name {
+				return true
+			}
+			continue
+		}
+		if ref.Selector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(ref.Selector)
+		if err == nil && selector.Matches(labels.Set(dependency.Labels)) {
+			return true
+		}
+	}
+	return false
+}
+
+// dependencyStatusChanged returns whether the change of the application may affect the applications depending on it
+func dependencyStatusChanged(oldApp *appv1.Application, newApp *appv1.Application) bool {
+	return dependencyNotReadyReason(oldApp) != dependencyNotReadyReason(newApp)
+}
+
+// dependencySpecChanged returns whether the change of the application may change the dependency graph
+func dependencySpecChanged(oldApp *appv1.Application, newApp *appv1.Application) bool {
+	return !reflect.DeepEqual(oldApp.Spec.DependsOn, newApp.Spec.DependsOn) ||
+		!reflect.DeepEqual(oldApp.Labels, newApp.Labels) ||
+		oldApp.Spec.GetProject() != newApp.Spec.GetProject()
+}
+
+func dependencyNamespace(app *appv1.Application, ref appv1.ApplicationDependency) string {
+	if ref.Namespace != "" {
+		return ref.Namespace
+	}
+	return app.Namespace
+}
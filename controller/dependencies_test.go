@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newFakeDependencyApp(name string, syncStatus v1alpha1.SyncStatusCode, healthStatus health.HealthStatusCode, dependsOn ...v1alpha1.ApplicationDependency) *v1alpha1.Application {
+	app := newFakeApp()
+	app.Name = name
+	app.Labels = map[string]string{"tier": "infra"}
+	app.Spec.Project = "default"
+	app.Spec.DependsOn = dependsOn
+	app.Status.Sync.Status = syncStatus
+	app.Status.Health.Status = healthStatus
+	app.Status.OperationState = nil
+	return app
+}
+
+func TestDependenciesCondition(t *testing.T) {
+	t.Run("NoDependencies", func(t *testing.T) {
+		app := newFakeDependencyApp("app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+		assert.Nil(t, ctrl.dependenciesCondition(app))
+	})
+	t.Run("DependenciesReady", func(t *testing.T) {
+		crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		app := newFakeDependencyApp("app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, v1alpha1.ApplicationDependency{Name: "crds"})
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, crds, &defaultProj}}, nil)
+		assert.Nil(t, ctrl.dependenciesCondition(app))
+	})
+	t.Run("DependenciesNotReady", func(t *testing.T) {
+		crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+		operator := newFakeDependencyApp("operator", v1alpha1.SyncStatusCodeSynced, health.HealthStatusProgressing)
+		app := newFakeDependencyApp("app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy,
+			v1alpha1.ApplicationDependency{Name: "crds"},
+			v1alpha1.ApplicationDependency{Name: "missing"},
+			v1alpha1.ApplicationDependency{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "infra"}}},
+		)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, crds, operator, &defaultProj}}, nil)
+		cond := ctrl.dependenciesCondition(app)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionDependencyWarning, cond.Type)
+		assert.Equal(t, "Waiting for dependencies to be Synced and Healthy: fake-argocd-ns/missing (not found), fake-argocd-ns/crds (OutOfSync), fake-argocd-ns/operator (Progressing)", cond.Message)
+	})
+	t.Run("Cycle", func(t *testing.T) {
+		crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, v1alpha1.ApplicationDependency{Name: "operator"})
+		operator := newFakeDependencyApp("operator", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, v1alpha1.ApplicationDependency{Name: "app"})
+		app := newFakeDependencyApp("app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, v1alpha1.ApplicationDependency{Name: "crds"})
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, crds, operator, &defaultProj}}, nil)
+		cond := ctrl.dependenciesCondition(app)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionDependencyError, cond.Type)
+		assert.Equal(t, "Application dependencies form a cycle: fake-argocd-ns/app -> fake-argocd-ns/crds -> fake-argocd-ns/operator -> fake-argocd-ns/app", cond.Message)
+	})
+	t.Run("CycleIsCachedUntilDependenciesChange", func(t *testing.T) {
+		crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, v1alpha1.ApplicationDependency{Name: "app"})
+		app := newFakeDependencyApp("app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, v1alpha1.ApplicationDependency{Name: "crds"})
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, crds, &defaultProj}}, nil)
+		require.NotEmpty(t, ctrl.findDependencyCycle(app))
+
+		updated := crds.DeepCopy()
+		updated.Spec.DependsOn = nil
+		require.NoError(t, ctrl.appInformer.GetStore().Update(updated))
+		assert.NotEmpty(t, ctrl.findDependencyCycle(app), "the cycle is cached")
+
+		ctrl.applicationEventHandlerFuncs().UpdateFunc(crds, updated)
+		assert.Empty(t, ctrl.findDependencyCycle(app))
+	})
+	t.Run("DependencyNotPermitted", func(t *testing.T) {
+		otherProj := defaultProj.DeepCopy()
+		otherProj.Name = "other"
+		crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		crds.Namespace = "other-ns"
+		crds.Spec.Project = "other"
+		operator := newFakeDependencyApp("operator", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+		operator.Namespace = "other-ns"
+		operator.Spec.Project = "other"
+		app := newFakeDependencyApp("app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy,
+			v1alpha1.ApplicationDependency{Name: "crds", Namespace: "other-ns"},
+			v1alpha1.ApplicationDependency{Namespace: "other-ns", Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "infra"}}},
+		)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, crds, operator, &defaultProj, otherProj}, applicationNamespaces: []string{"other-ns"}}, nil)
+		cond := ctrl.dependenciesCondition(app)
+		require.NotNil(t, cond)
+		assert.Equal(t, "Waiting for dependencies to be Synced and Healthy: other-ns/crds (not found)", cond.Message)
+
+		proj := defaultProj.DeepCopy()
+		proj.Spec.SourceNamespaces = []string{"other-ns"}
+		ctrl = newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, crds, operator, proj, otherProj}, applicationNamespaces: []string{"other-ns"}}, nil)
+		cond = ctrl.dependenciesCondition(app)
+		require.NotNil(t, cond)
+		assert.Equal(t, "Waiting for dependencies to be Synced and Healthy: other-ns/operator (OutOfSync)", cond.Message)
+	})
+}
+
+func TestDependsOn(t *testing.T) {
+	crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+	assert.True(t, dependsOn(newFakeDependencyApp("app", "", "", v1alpha1.ApplicationDependency{Name: "crds"}), crds))
+	assert.True(t, dependsOn(newFakeDependencyApp("app", "", "", v1alpha1.ApplicationDependency{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "infra"}}}), crds))
+	assert.False(t, dependsOn(newFakeDependencyApp("app", "", "", v1alpha1.ApplicationDependency{Name: "crds", Namespace: "other"}), crds))
+	assert.False(t, dependsOn(newFakeDependencyApp("app", "", "", v1alpha1.ApplicationDependency{Name: "operator"}), crds))
+	assert.False(t, dependsOn(crds, crds))
+}
+
+func TestProcessRequestedAppOperation_HeldByDependencies(t *testing.T) {
+	crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+	app := newFakeDependencyApp("app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, v1alpha1.ApplicationDependency{Name: "crds"})
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, crds, &defaultProj}}, nil)
+
+	ctrl.processRequestedAppOperation(app)
+
+	patchedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, patchedApp.Status.OperationState)
+	assert.Equal(t, synccommon.OperationRunning, patchedApp.Status.OperationState.Phase)
+	assert.Equal(t, "Waiting for dependencies to be Synced and Healthy: fake-argocd-ns/crds (OutOfSync)", patchedApp.Status.OperationState.Message)
+	assert.Nil(t, patchedApp.Status.OperationState.SyncResult)
+	require.Len(t, patchedApp.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionDependencyWarning, patchedApp.Status.Conditions[0].Type)
+}
@@ -0,0 +1,401 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+)
+
+var invalidFleetAppNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// fleetCluster is a cluster selected by the destination of a fleet application
+type fleetCluster struct {
+	cluster *appv1.Cluster
+	step    int64
+}
+
+// fleetAppName returns the name of the application which deploys the fleet application to the given cluster. The name
+// ends with a short hash of the name of the fleet application and the server of the cluster, so that the names of the
+// applications of different clusters, or of different fleet applications, don't collide once sanitized.
+func fleetAppName(app *appv1.Application, cluster *appv1.Cluster) string {
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(app.Name+"/"+cluster.Server)))[:8]
+	name := strings.Trim(invalidFleetAppNameChars.ReplaceAllString(strings.ToLower(cluster.Name), "-"), "-.")
+	if name == "" {
+		return app.Name + "-" + hash
+	}
+	return app.Name + "-" + name + "-" + hash
+}
+
+// fleetLabelValue returns the value of the label which marks the applications of the fleet application with the given
+// name. Label values are limited to 63 characters, so longer names are truncated and end with a short hash of the full
+// name, so that the applications of fleet applications sharing a long prefix don't collide.
+func fleetLabelValue(name string) string {
+	if len(name) <= validation.LabelValueMaxLength {
+		return name
+	}
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(name)))[:8]
+	prefix := strings.TrimRight(name[:validation.LabelValueMaxLength-len(hash)-1], "-.")
+	return prefix + "-" + hash
+}
+
+// fleetRolloutStep returns the index of the first rollout step selecting the cluster, or the number of rollout steps if
+// none of them selects it
+func fleetRolloutStep(app *appv1.Application, cluster *appv1.Cluster) int64 {
+	if app.Spec.Fleet == nil {
+		return 0
+	}
+	for i, step := range app.Spec.Fleet.RolloutSteps {
+		selector, err := metav1.LabelSelectorAsSelector(&step.ClusterSelector)
+		if err == nil && selector.Matches(labels.Set(cluster.Labels)) {
+			return int64(i)
+		}
+	}
+	return int64(len(app.Spec.Fleet.RolloutSteps))
+}
+
+// getFleetClusters returns the clusters selected by the destination of the fleet application which are permitted by its
+// project, sorted by rollout step and name
+func (ctrl *ApplicationController) getFleetClusters(ctx context.Context, app *appv1.Application, proj *appv1.AppProject) ([]fleetCluster, error) {
+	selector, err := metav1.LabelSelectorAsSelector(app.Spec.Destination.ClusterSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cluster selector: %w", err)
+	}
+	clusters, err := ctrl.db.ListClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing clusters: %w", err)
+	}
+	projectClusters := func(project string) ([]*appv1.Cluster, error) {
+		return ctrl.db.GetProjectClusters(ctx, project)
+	}
+	var res []fleetCluster
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if !selector.Matches(labels.Set(cluster.Labels)) {
+			continue
+		}
+		permitted, err := proj.IsDestinationPermitted(cluster, app.Spec.Destination.Namespace, projectClusters)
+		if err != nil {
+			return nil, fmt.Errorf("error checking the destination of cluster %q: %w", cluster.Server, err)
+		}
+		if permitted {
+			res = append(res, fleetCluster{cluster: cluster, step: fleetRolloutStep(app, cluster)})
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].step != res[j].step {
+			return res[i].step < res[j].step
+		}
+		return res[i].cluster.Name < res[j].cluster.Name
+	})
+	return res, nil
+}
+
+// getFleetApps returns the applications of the fleet application, by name
+func (ctrl *ApplicationController) getFleetApps(app *appv1.Application) (map[string]*appv1.Application, error) {
+	selector := labels.SelectorFromSet(labels.Set{common.LabelKeyFleetApplication: fleetLabelValue(app.Name)})
+	candidates, err := ctrl.appLister.Applications(app.Namespace).List(selector)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the applications of the fleet: %w", err)
+	}
+	res := make(map[string]*appv1.Application, len(candidates))
+	for _, candidate := range candidates {
+		if metav1.IsControlledBy(candidate, app) {
+			res[candidate.Name] = candidate
+		}
+	}
+	return res, nil
+}
+
+// newFleetApp returns the application which deploys the fleet application to the given cluster. If previousStep is set,
+// the application depends on the applications of the previous rollout step, so that it is only synced once they are
+// Synced and Healthy.
+func newFleetApp(app *appv1.Application, cluster fleetCluster, previousStep *int64) *appv1.Application {
+	spec := app.Spec.DeepCopy()
+	spec.Destination = appv1.ApplicationDestination{Server: cluster.cluster.Server, Namespace: app.Spec.Destination.Namespace}
+	spec.Fleet = nil
+	if previousStep != nil {
+		spec.DependsOn = append(spec.DependsOn, appv1.ApplicationDependency{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{
+			common.LabelKeyFleetApplication: fleetLabelValue(app.Name),
+			common.LabelKeyFleetRolloutStep: strconv.FormatInt(*previousStep, 10),
+		}}})
+	}
+	// the resources of the fleet are deleted along with the fleet application if it has a resources finalizer
+	var finalizers []string
+	for _, finalizer := range app.Finalizers {
+		if strings.HasPrefix(finalizer, appv1.ResourcesFinalizerName) {
+			finalizers = append(finalizers, finalizer)
+		}
+	}
+	return &appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fleetAppName(app, cluster.cluster),
+			Namespace: app.Namespace,
+			Labels: map[string]string{
+				common.LabelKeyFleetApplication: fleetLabelValue(app.Name),
+				common.LabelKeyFleetRolloutStep: strconv.FormatInt(cluster.step, 10),
+			},
+			Finalizers:      finalizers,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(app, appv1.ApplicationSchemaGroupVersionKind)},
+		},
+		Spec: *spec,
+	}
+}
+
+// reconcileFleet creates, updates and deletes the applications of the fleet application, one per selected cluster, and
+// sets the status of the fleet application from their status
+func (ctrl *ApplicationController) reconcileFleet(ctx context.Context, app *appv1.Application, proj *appv1.AppProject) {
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	var errs []string
+	defer func() {
+		conditions := make([]appv1.ApplicationCondition, 0)
+		if len(errs) > 0 {
+			conditions = append(conditions, appv1.ApplicationCondition{
+				Type:    appv1.ApplicationConditionFleetError,
+				Message: "Failed to reconcile the applications of the fleet: " + strings.Join(errs, "; "),
+			})
+		}
+		app.Status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionFleetError: true})
+	}()
+
+	clusters, err := ctrl.getFleetClusters(ctx, app, proj)
+	if err != nil {
+		errs = append(errs, err.Error())
+		return
+	}
+	existing, err := ctrl.getFleetApps(app)
+	if err != nil {
+		errs = append(errs, err.Error())
+		return
+	}
+
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	fleetStatus := make([]appv1.ApplicationFleetClusterStatus, 0, len(clusters))
+	desiredNames := make(map[string]bool, len(clusters))
+	var previousStep, currentStep *int64
+	for _, cluster := range clusters {
+		if currentStep == nil || *currentStep != cluster.step {
+			previousStep = currentStep
+			currentStep = &cluster.step
+		}
+		desired := newFleetApp(app, cluster, previousStep)
+		desiredNames[desired.Name] = true
+		fleetApp, ok := existing[desired.Name]
+		switch {
+		case !ok:
+			logCtx.Infof("Creating application %s of the fleet for cluster %s", desired.Name, cluster.cluster.Server)
+			created, err := appIf.Create(ctx, desired, metav1.CreateOptions{})
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("error creating application %s: %v", desired.Name, err))
+				fleetApp = desired
+			} else {
+				fleetApp = created
+			}
+		case !equality.Semantic.DeepEqual(fleetApp.Spec, desired.Spec) || fleetApp.Labels[common.LabelKeyFleetRolloutStep] != desired.Labels[common.LabelKeyFleetRolloutStep]:
+			updated := fleetApp.DeepCopy()
+			updated.Spec = desired.Spec
+			updated.Labels[common.LabelKeyFleetRolloutStep] = desired.Labels[common.LabelKeyFleetRolloutStep]
+			logCtx.Infof("Updating application %s of the fleet", desired.Name)
+			if fleetApp, err = appIf.Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+				errs = append(errs, fmt.Sprintf("error updating application %s: %v", desired.Name, err))
+				fleetApp = updated
+			}
+		}
+		fleetStatus = append(fleetStatus, appv1.ApplicationFleetClusterStatus{
+			Server:      cluster.cluster.Server,
+			Name:        cluster.cluster.Name,
+			Application: fleetApp.Name,
+			Step:        cluster.step,
+			Sync:        fleetApp.Status.Sync.Status,
+			Health:      fleetApp.Status.Health.Status,
+			Revision:    fleetApp.Status.Sync.Revision,
+		})
+	}
+	for name, fleetApp := range existing {
+		if desiredNames[name] || fleetApp.DeletionTimestamp != nil {
+			continue
+		}
+		logCtx.Infof("Deleting application %s of the fleet for cluster %s", name, fleetApp.Spec.Destination.Server)
+		if err := appIf.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Sprintf("error deleting application %s: %v", name, err))
+		}
+	}
+
+	app.Status.Fleet = fleetStatus
+	app.Status.Resources = nil
+	app.Status.Sync.Status, app.Status.Health.Status, app.Status.Health.Message = getFleetStatus(fleetStatus)
+}
+
+// getFleetStatus returns the sync and health status of a fleet application from the status of its applications
+func getFleetStatus(fleetStatus []appv1.ApplicationFleetClusterStatus) (appv1.SyncStatusCode, health.HealthStatusCode, string) {
+	if len(fleetStatus) == 0 {
+		return appv1.SyncStatusCodeSynced, health.HealthStatusMissing, "No cluster is selected by the destination"
+	}
+	syncStatus := appv1.SyncStatusCodeSynced
+	healthStatus := health.HealthStatusHealthy
+	for _, status := range fleetStatus {
+		switch status.Sync {
+		case appv1.SyncStatusCodeSynced:
+		case appv1.SyncStatusCodeOutOfSync:
+			syncStatus = appv1.SyncStatusCodeOutOfSync
+		default:
+			if syncStatus != appv1.SyncStatusCodeOutOfSync {
+				syncStatus = appv1.SyncStatusCodeUnknown
+			}
+		}
+		appHealth := status.Health
+		if appHealth == "" {
+			appHealth = health.HealthStatusUnknown
+		}
+		if health.IsWorse(healthStatus, appHealth) {
+			healthStatus = appHealth
+		}
+	}
+	return syncStatus, healthStatus, ""
+}
+
+// fleetOperationInfoName is the name of the info item which marks the operations requested by the sync of a fleet
+// application
+const fleetOperationInfoName = "Fleet operation"
+
+// fleetOperationID returns the value which identifies the operations requested by the given attempt of the operation
+// of the fleet application
+func fleetOperationID(app *appv1.Application, state *appv1.OperationState) string {
+	return fmt.Sprintf("%s/%s/%d", app.UID, state.StartedAt.UTC().Format(time.RFC3339), state.RetryCount)
+}
+
+// isFleetOperation returns whether the operation was requested by the operation of a fleet application with the given
+// ID
+func isFleetOperation(operation *appv1.Operation, id string) bool {
+	if operation == nil {
+		return false
+	}
+	for _, info := range operation.Info {
+		if info != nil && info.Name == fleetOperationInfoName && info.Value == id {
+			return true
+		}
+	}
+	return false
+}
+
+// syncFleet requests the sync of the applications of the fleet application and reports their result. They are synced
+// in the order of the rollout steps, since the applications of a step depend on the ones of the previous step. The sync
+// of an application which has another operation in progress is requested once that operation completes. The operation
+// of the fleet application keeps running until the syncs of all its applications completed, and fails if any of them
+// failed.
+func (ctrl *ApplicationController) syncFleet(ctx context.Context, app *appv1.Application, state *appv1.OperationState, terminating bool) {
+	switch {
+	case terminating:
+		state.Phase = synccommon.OperationFailed
+		state.Message = "Operation terminated"
+		return
+	case state.Operation.Sync == nil:
+		state.Phase = synccommon.OperationError
+		state.Message = "Only sync operations are supported by fleet applications"
+		return
+	}
+	fleetApps, err := ctrl.getFleetApps(app)
+	if err != nil {
+		state.Phase = synccommon.OperationError
+		state.Message = err.Error()
+		return
+	}
+	id := fleetOperationID(app, state)
+	operation := state.Operation.DeepCopy()
+	operation.Retry = appv1.RetryStrategy{}
+	operation.Info = append(operation.Info, &appv1.Info{Name: fleetOperationInfoName, Value: id})
+	patch, err := json.Marshal(map[string]any{"operation": operation})
+	if err != nil {
+		state.Phase = synccommon.OperationError
+		state.Message = fmt.Sprintf("error marshaling the operation: %v", err)
+		return
+	}
+	names := slices.Sorted(maps.Keys(fleetApps))
+	inProgress, waiting := 0, 0
+	var failed, errs []string
+	for _, name := range names {
+		fleetApp := fleetApps[name]
+		switch {
+		case isFleetOperation(fleetApp.Operation, id):
+			inProgress++
+		case fleetApp.Status.OperationState != nil && isFleetOperation(&fleetApp.Status.OperationState.Operation, id):
+			switch opState := fleetApp.Status.OperationState; {
+			case !opState.Phase.Completed():
+				inProgress++
+			case !opState.Phase.Successful():
+				failed = append(failed, fmt.Sprintf("%s (%s: %s)", fleetApp.Name, opState.Phase, opState.Message))
+			}
+		case fleetApp.Operation != nil || isOperationInProgress(fleetApp):
+			waiting++
+		default:
+			if _, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(fleetApp.Namespace).Patch(ctx, fleetApp.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+				errs = append(errs, fmt.Sprintf("error requesting the sync of application %s: %v", fleetApp.Name, err))
+				continue
+			}
+			inProgress++
+		}
+	}
+	switch {
+	case len(errs) > 0:
+		state.Phase = synccommon.OperationError
+		state.Message = strings.Join(errs, "; ")
+	case inProgress > 0 || waiting > 0:
+		state.Phase = synccommon.OperationRunning
+		state.Message = fmt.Sprintf("Syncing the applications of the fleet: %d of %d completed, %d in progress, %d waiting for another operation to complete",
+			len(fleetApps)-inProgress-waiting, len(fleetApps), inProgress, waiting)
+	case len(failed) > 0:
+		state.Phase = synccommon.OperationFailed
+		state.Message = fmt.Sprintf("Failed to sync %d of the %d applications of the fleet: %s", len(failed), len(fleetApps), strings.Join(failed, ", "))
+	default:
+		state.Phase = synccommon.OperationSucceeded
+		state.Message = fmt.Sprintf("Synced the %d applications of the fleet", len(fleetApps))
+	}
+}
+
+// requestFleetAppRefresh requests a refresh of the fleet application the given application was created for, if any
+func (ctrl *ApplicationController) requestFleetAppRefresh(app *appv1.Application) {
+	owner := metav1.GetControllerOf(app)
+	if owner == nil || owner.Kind != application.ApplicationKind || app.Labels[common.LabelKeyFleetApplication] != fleetLabelValue(owner.Name) {
+		return
+	}
+	ctrl.requestAppRefresh(ctrl.toAppQualifiedName(owner.Name, app.Namespace), CompareWithRecent.Pointer(), nil)
+	// the operation of the fleet application reports the result of the operations of its applications
+	ctrl.appOperationQueue.AddRateLimited(ctrl.toAppKey(ctrl.toAppQualifiedName(owner.Name, app.Namespace)))
+}
+
+// fleetAppStatusChanged returns whether the change of the application may affect the status of its fleet application
+func fleetAppStatusChanged(oldApp *appv1.Application, newApp *appv1.Application) bool {
+	return oldApp.Status.Sync.Status != newApp.Status.Sync.Status ||
+		oldApp.Status.Sync.Revision != newApp.Status.Sync.Revision ||
+		oldApp.Status.Health.Status != newApp.Status.Health.Status ||
+		operationPhase(oldApp) != operationPhase(newApp)
+}
+
+func operationPhase(app *appv1.Application) synccommon.OperationPhase {
+	if app.Status.OperationState == nil {
+		return ""
+	}
+	return app.Status.OperationState.Phase
+}
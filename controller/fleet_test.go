@@ -0,0 +1,230 @@
+package controller
+
+import (
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newFakeFleetClusterSecret(name string, server string, clusterLabels map[string]string) *corev1.Secret {
+	secretLabels := map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeCluster}
+	maps.Copy(secretLabels, clusterLabels)
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: test.FakeArgoCDNamespace, Labels: secretLabels},
+		Data: map[string][]byte{
+			"name":   []byte(name),
+			"server": []byte(server),
+			"config": []byte("{}"),
+		},
+	}
+}
+
+func newFakeFleetApp() *v1alpha1.Application {
+	app := newFakeApp()
+	app.Name = "guestbook"
+	app.Finalizers = []string{v1alpha1.ResourcesFinalizerName}
+	app.Spec.Destination = v1alpha1.ApplicationDestination{
+		Namespace:       "guestbook",
+		ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "shared"}},
+	}
+	app.Spec.Fleet = &v1alpha1.ApplicationFleet{RolloutSteps: []v1alpha1.ApplicationFleetRolloutStep{
+		{ClusterSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}}},
+	}}
+	app.Status = v1alpha1.ApplicationStatus{}
+	return app
+}
+
+func TestReconcileFleet(t *testing.T) {
+	app := newFakeFleetApp()
+	stale := newFakeApp()
+	stale.Name = "guestbook-old"
+	stale.Labels = map[string]string{common.LabelKeyFleetApplication: app.Name, common.LabelKeyFleetRolloutStep: "0"}
+	stale.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(app, v1alpha1.ApplicationSchemaGroupVersionKind)}
+	prod := newFakeApp()
+	prod.Name = fleetAppName(app, &v1alpha1.Cluster{Name: "prod-1", Server: "https://prod-1"})
+	prod.Labels = map[string]string{common.LabelKeyFleetApplication: app.Name, common.LabelKeyFleetRolloutStep: "0"}
+	prod.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(app, v1alpha1.ApplicationSchemaGroupVersionKind)}
+	prod.Status.Sync = v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revision: "abc"}
+	prod.Status.Health.Status = health.HealthStatusDegraded
+
+	ctrl := newFakeController(t.Context(), &fakeData{
+		apps: []runtime.Object{app, stale, prod, &defaultProj},
+		additionalObjs: []runtime.Object{
+			newFakeFleetClusterSecret("dev-1", "https://dev-1", map[string]string{"tier": "shared", "env": "dev"}),
+			newFakeFleetClusterSecret("prod-1", "https://prod-1", map[string]string{"tier": "shared", "env": "prod"}),
+			newFakeFleetClusterSecret("dedicated-1", "https://dedicated-1", map[string]string{"tier": "dedicated"}),
+		},
+	}, nil)
+
+	ctrl.reconcileFleet(t.Context(), app, &defaultProj)
+
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace)
+	devName := fleetAppName(app, &v1alpha1.Cluster{Name: "dev-1", Server: "https://dev-1"})
+	dev, err := appIf.Get(t.Context(), devName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.ApplicationDestination{Server: "https://dev-1", Namespace: "guestbook"}, dev.Spec.Destination)
+	assert.Nil(t, dev.Spec.Fleet)
+	assert.Empty(t, dev.Spec.DependsOn)
+	assert.Equal(t, "0", dev.Labels[common.LabelKeyFleetRolloutStep])
+	assert.Equal(t, []string{v1alpha1.ResourcesFinalizerName}, dev.Finalizers)
+	assert.True(t, metav1.IsControlledBy(dev, app))
+
+	// the existing application is updated to depend on the previous rollout step
+	prodUpdated, err := appIf.Get(t.Context(), prod.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "https://prod-1", prodUpdated.Spec.Destination.Server)
+	assert.Equal(t, "1", prodUpdated.Labels[common.LabelKeyFleetRolloutStep])
+	assert.Equal(t, []v1alpha1.ApplicationDependency{{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{
+		common.LabelKeyFleetApplication: app.Name,
+		common.LabelKeyFleetRolloutStep: "0",
+	}}}}, prodUpdated.Spec.DependsOn)
+
+	// the application of a cluster which is not selected anymore is deleted
+	_, err = appIf.Get(t.Context(), "guestbook-old", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	assert.Equal(t, []v1alpha1.ApplicationFleetClusterStatus{
+		{Server: "https://dev-1", Name: "dev-1", Application: devName, Step: 0},
+		{Server: "https://prod-1", Name: "prod-1", Application: prod.Name, Step: 1, Sync: v1alpha1.SyncStatusCodeSynced, Health: health.HealthStatusDegraded, Revision: "abc"},
+	}, app.Status.Fleet)
+	assert.Equal(t, v1alpha1.SyncStatusCodeUnknown, app.Status.Sync.Status)
+	assert.Equal(t, health.HealthStatusUnknown, app.Status.Health.Status)
+	assert.Empty(t, app.Status.Conditions)
+}
+
+func TestGetFleetStatus(t *testing.T) {
+	syncStatus, healthStatus, message := getFleetStatus(nil)
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, syncStatus)
+	assert.Equal(t, health.HealthStatusMissing, healthStatus)
+	assert.Equal(t, "No cluster is selected by the destination", message)
+
+	syncStatus, healthStatus, _ = getFleetStatus([]v1alpha1.ApplicationFleetClusterStatus{
+		{Sync: v1alpha1.SyncStatusCodeSynced, Health: health.HealthStatusHealthy},
+		{Sync: v1alpha1.SyncStatusCodeSynced, Health: health.HealthStatusHealthy},
+	})
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, syncStatus)
+	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
+
+	syncStatus, healthStatus, _ = getFleetStatus([]v1alpha1.ApplicationFleetClusterStatus{
+		{Sync: v1alpha1.SyncStatusCodeOutOfSync, Health: health.HealthStatusProgressing},
+		{Sync: v1alpha1.SyncStatusCodeSynced, Health: health.HealthStatusHealthy},
+	})
+	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, syncStatus)
+	assert.Equal(t, health.HealthStatusProgressing, healthStatus)
+
+	// the applications which have not been reconciled yet have an unknown status
+	syncStatus, healthStatus, _ = getFleetStatus([]v1alpha1.ApplicationFleetClusterStatus{
+		{Sync: v1alpha1.SyncStatusCodeOutOfSync, Health: health.HealthStatusProgressing},
+		{},
+	})
+	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, syncStatus)
+	assert.Equal(t, health.HealthStatusUnknown, healthStatus)
+}
+
+func TestFleetAppName(t *testing.T) {
+	app := newFakeFleetApp()
+	assert.Regexp(t, `^guestbook-in-cluster-[0-9a-f]{8}$`, fleetAppName(app, &v1alpha1.Cluster{Name: "in-cluster", Server: "https://kubernetes.default.svc"}))
+	assert.Regexp(t, `^guestbook-eu-west-1.prod-[0-9a-f]{8}$`, fleetAppName(app, &v1alpha1.Cluster{Name: "EU_West_1.prod", Server: "https://1.2.3.4"}))
+	assert.Regexp(t, `^guestbook-[0-9a-f]{8}$`, fleetAppName(app, &v1alpha1.Cluster{Server: "https://1.2.3.4"}))
+
+	// the names of clusters which only differ by the sanitized characters don't collide
+	assert.NotEqual(t,
+		fleetAppName(app, &v1alpha1.Cluster{Name: "eu_west", Server: "https://1.2.3.4"}),
+		fleetAppName(app, &v1alpha1.Cluster{Name: "eu.west", Server: "https://5.6.7.8"}))
+	// nor do the names of the applications of fleet applications whose names are prefixes of each other
+	other := newFakeFleetApp()
+	other.Name = "guestbook-eu"
+	assert.NotEqual(t,
+		fleetAppName(app, &v1alpha1.Cluster{Name: "eu-west", Server: "https://1.2.3.4"}),
+		fleetAppName(other, &v1alpha1.Cluster{Name: "west", Server: "https://1.2.3.4"}))
+}
+
+func TestFleetLabelValue(t *testing.T) {
+	assert.Equal(t, "guestbook", fleetLabelValue("guestbook"))
+	name := strings.Repeat("a", 63)
+	assert.Equal(t, name, fleetLabelValue(name))
+
+	// long names are truncated, and the names sharing a long prefix don't collide
+	long := strings.Repeat("a", 53) + ".guestbook-1"
+	value := fleetLabelValue(long)
+	assert.LessOrEqual(t, len(value), 63)
+	assert.Empty(t, validation.IsValidLabelValue(value))
+	assert.NotEqual(t, value, fleetLabelValue(strings.Repeat("a", 53)+".guestbook-2"))
+	// the truncated name does not end with a separator
+	assert.Regexp(t, `^a{53}-[0-9a-f]{8}$`, value)
+}
+
+func TestSyncFleet(t *testing.T) {
+	app := newFakeFleetApp()
+	app.UID = "fleet-uid"
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Prune: true}}
+	dev := newFakeApp()
+	dev.Name = "guestbook-dev-1"
+	dev.Labels = map[string]string{common.LabelKeyFleetApplication: app.Name, common.LabelKeyFleetRolloutStep: "0"}
+	dev.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(app, v1alpha1.ApplicationSchemaGroupVersionKind)}
+	dev.Status.OperationState = nil
+	busy := dev.DeepCopy()
+	busy.Name = "guestbook-prod-1"
+	busy.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, dev, busy, &defaultProj}}, nil)
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace)
+
+	state := NewOperationState(*app.Operation)
+	id := fleetOperationID(app, state)
+	ctrl.syncFleet(t.Context(), app, state, false)
+	assert.Equal(t, synccommon.OperationRunning, state.Phase)
+	assert.Equal(t, "Syncing the applications of the fleet: 0 of 2 completed, 1 in progress, 1 waiting for another operation to complete", state.Message)
+
+	updated, err := appIf.Get(t.Context(), dev.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, updated.Operation)
+	assert.True(t, updated.Operation.Sync.Prune)
+	assert.True(t, isFleetOperation(updated.Operation, id))
+
+	// the sync of the application is requested once its other operation completed
+	setFleetAppOperationState := func(fleetApp *v1alpha1.Application, operation v1alpha1.Operation, phase synccommon.OperationPhase, message string) {
+		t.Helper()
+		fleetApp = fleetApp.DeepCopy()
+		fleetApp.Operation = nil
+		fleetApp.Status.OperationState = &v1alpha1.OperationState{Operation: operation, Phase: phase, Message: message}
+		require.NoError(t, ctrl.appInformer.GetStore().Update(fleetApp))
+	}
+	setFleetAppOperationState(updated, *updated.Operation, synccommon.OperationRunning, "")
+	setFleetAppOperationState(busy, *busy.Operation, synccommon.OperationSucceeded, "")
+	ctrl.syncFleet(t.Context(), app, state, false)
+	assert.Equal(t, synccommon.OperationRunning, state.Phase)
+	assert.Equal(t, "Syncing the applications of the fleet: 0 of 2 completed, 2 in progress, 0 waiting for another operation to complete", state.Message)
+	busyUpdated, err := appIf.Get(t.Context(), busy.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, isFleetOperation(busyUpdated.Operation, id))
+
+	// the operation completes once the syncs of all the applications completed, and fails if any of them failed
+	setFleetAppOperationState(updated, *updated.Operation, synccommon.OperationSucceeded, "successfully synced")
+	setFleetAppOperationState(busyUpdated, *busyUpdated.Operation, synccommon.OperationFailed, "one or more objects failed to apply")
+	ctrl.syncFleet(t.Context(), app, state, false)
+	assert.Equal(t, synccommon.OperationFailed, state.Phase)
+	assert.Equal(t, "Failed to sync 1 of the 2 applications of the fleet: guestbook-prod-1 (Failed: one or more objects failed to apply)", state.Message)
+
+	setFleetAppOperationState(busyUpdated, *busyUpdated.Operation, synccommon.OperationSucceeded, "successfully synced")
+	ctrl.syncFleet(t.Context(), app, state, false)
+	assert.Equal(t, synccommon.OperationSucceeded, state.Phase)
+	assert.Equal(t, "Synced the 2 applications of the fleet", state.Message)
+
+	state = NewOperationState(*app.Operation)
+	ctrl.syncFleet(t.Context(), app, state, true)
+	assert.Equal(t, synccommon.OperationFailed, state.Phase)
+}
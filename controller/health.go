@@ -3,6 +3,8 @@ package controller
 import (
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
 	hookutil "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/hook"
@@ -20,13 +22,80 @@ import (
 // maxHealthCausesShown bounds how many causes are rendered in events/logs to keep them readable.
 const maxHealthCausesShown = 3
 
+// getProgressDeadline returns the progress deadline of the application, which overrides the progress deadline of its
+// project. It returns zero if no progress deadline is configured.
+func getProgressDeadline(app *appv1.Application, proj *appv1.AppProject) time.Duration {
+	seconds := app.Spec.ProgressDeadlineSeconds
+	if seconds == nil && proj != nil {
+		seconds = proj.Spec.ProgressDeadlineSeconds
+	}
+	if seconds == nil || *seconds <= 0 {
+		return 0
+	}
+	return time.Duration(*seconds) * time.Second
+}
+
+// progressingResourcesTracker tracks since when the resources of the applications are Progressing, so that the
+// progress deadline is measured from the time each resource started progressing. The state is kept in memory, so the
+// deadline starts over when the controller restarts.
+type progressingResourcesTracker struct {
+	lock  sync.Mutex
+	since map[string]map[kubeutil.ResourceKey]time.Time
+}
+
+func newProgressingResourcesTracker() *progressingResourcesTracker {
+	return &progressingResourcesTracker{since: map[string]map[kubeutil.ResourceKey]time.Time{}}
+}
+
+// progressingSince records the given resource of the application as Progressing and returns since when it is
+func (t *progressingResourcesTracker) progressingSince(appKey string, key kubeutil.ResourceKey, now time.Time) time.Time {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	resources, ok := t.since[appKey]
+	if !ok {
+		resources = map[kubeutil.ResourceKey]time.Time{}
+		t.since[appKey] = resources
+	}
+	since, ok := resources[key]
+	if !ok {
+		since = now
+		resources[key] = since
+	}
+	return since
+}
+
+// retain forgets the resources of the application which are not Progressing anymore
+func (t *progressingResourcesTracker) retain(appKey string, progressing map[kubeutil.ResourceKey]bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if len(progressing) == 0 {
+		delete(t.since, appKey)
+		return
+	}
+	for key := range t.since[appKey] {
+		if !progressing[key] {
+			delete(t.since[appKey], key)
+		}
+	}
+}
+
 // setApplicationHealth updates the health statuses of all resources performed in the comparison.
 // It returns the aggregated application health status along with the resources that caused that status.
-func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application, persistResourceHealth bool) (health.HealthStatusCode, string, error) {
+// Resources which are still Progressing once the progress deadline has passed since they started progressing, as tracked
+// by the given tracker, are reported as Degraded. The deadline never passes while the application is being synced.
+func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application, persistResourceHealth bool, progressDeadline time.Duration, progressingResources *progressingResourcesTracker) (health.HealthStatusCode, string, error) {
 	var savedErr error
 	var errCount uint
 	var containsResources, containsLiveResources bool
 	var causes []managedResource
+	var deadlineExceededCount int
+
+	now := time.Now()
+	trackProgress := progressDeadline > 0 && progressingResources != nil
+	progressing := map[kubeutil.ResourceKey]bool{}
+	if progressingResources != nil {
+		defer progressingResources.retain(app.QualifiedName(), progressing)
+	}
 
 	appHealthStatus := health.HealthStatusHealthy
 	for i, res := range resources {
@@ -71,6 +140,20 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 			continue
 		}
 
+		if trackProgress && res.Live != nil && healthStatus.Status == health.HealthStatusProgressing {
+			key := kubeutil.GetResourceKey(res.Live)
+			progressing[key] = true
+			since := progressingResources.progressingSince(app.QualifiedName(), key, now)
+			if app.Operation == nil && now.Sub(since) > progressDeadline {
+				message := fmt.Sprintf("Resource has been Progressing for more than %s", progressDeadline)
+				if healthStatus.Message != "" {
+					message = fmt.Sprintf("%s: %s", message, healthStatus.Message)
+				}
+				healthStatus = &health.HealthStatus{Status: health.HealthStatusDegraded, Message: message}
+				deadlineExceededCount++
+			}
+		}
+
 		if persistResourceHealth {
 			resHealth := appv1.HealthStatus{Status: healthStatus.Status, Message: healthStatus.Message}
 			statuses[i].Health = &resHealth
@@ -110,7 +193,12 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 	if savedErr != nil && errCount > 1 {
 		savedErr = fmt.Errorf("see application-controller logs for %d other errors; most recent error was: %w", errCount-1, savedErr)
 	}
-	return appHealthStatus, formatHealthCauses(causes), savedErr
+	message := formatHealthCauses(causes)
+	// resources exceeding the progress deadline are Degraded, hence among the causes if the application is Degraded
+	if appHealthStatus == health.HealthStatusDegraded && deadlineExceededCount > 0 {
+		message = fmt.Sprintf("Progress deadline of %s exceeded by %d resource(s). %s", progressDeadline, deadlineExceededCount, message)
+	}
+	return appHealthStatus, message, savedErr
 }
 
 // formatHealthCauses renders a human-readable, truncated summary of the resources that caused
@@ -66,7 +66,7 @@ func TestSetApplicationHealth(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, 0, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 	assert.Equal(t, health.HealthStatusHealthy, resourceStatuses[0].Health.Status)
@@ -77,7 +77,7 @@ func TestSetApplicationHealth(t *testing.T) {
 
 	// now mark the job as a hook and retry. it should ignore the hook and consider the app healthy
 	failedJob.SetAnnotations(map[string]string{synccommon.AnnotationKeyHook: "PreSync"})
-	healthStatus, healthCauses, err = setApplicationHealth(resources, resourceStatuses, nil, app, true)
+	healthStatus, healthCauses, err = setApplicationHealth(resources, resourceStatuses, nil, app, true, 0, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	// A Healthy app has no contributing causes.
@@ -89,7 +89,7 @@ func TestSetApplicationHealth(t *testing.T) {
 	failedJob.SetAnnotations(nil)
 	failedJobIgnoreHealthcheck := resourceFromFile("./testdata/job-failed-ignore-healthcheck.yaml")
 	resources[1].Live = &failedJobIgnoreHealthcheck
-	healthStatus, healthCauses, err = setApplicationHealth(resources, resourceStatuses, nil, app, true)
+	healthStatus, healthCauses, err = setApplicationHealth(resources, resourceStatuses, nil, app, true, 0, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	assert.Empty(t, healthCauses)
@@ -103,7 +103,7 @@ func TestSetApplicationHealth_ResourceHealthNotPersisted(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, false)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, false, 0, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 
@@ -112,11 +112,78 @@ func TestSetApplicationHealth_ResourceHealthNotPersisted(t *testing.T) {
 	assert.Equal(t, "Caused by batch/Job:default/failed-job", healthCauses)
 }
 
+func TestSetApplicationHealth_ProgressDeadline(t *testing.T) {
+	runningPod := resourceFromFile("./testdata/pod-running-restart-always.yaml")
+	resources := []managedResource{{
+		Group: "", Version: "v1", Kind: "Pod", Namespace: "default", Name: "running-pod", Live: &runningPod,
+	}}
+	overrides := lua.ResourceHealthOverrides{
+		"Pod": appv1.ResourceOverride{HealthCEL: `{"status": "Progressing", "message": "waiting"}`},
+	}
+	progressingApp := &appv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"}}
+	tracker := newProgressingResourcesTracker()
+
+	// the deadline has not passed yet
+	resourceStatuses := initStatuses(resources)
+	healthStatus, _, err := setApplicationHealth(resources, resourceStatuses, overrides, progressingApp, true, 10*time.Minute, tracker)
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatusProgressing, healthStatus)
+
+	// the deadline is measured from the time the resource started progressing
+	key := kube.GetResourceKey(&runningPod)
+	tracker.since[progressingApp.QualifiedName()][key] = time.Now().Add(-time.Hour)
+	resourceStatuses = initStatuses(resources)
+	healthStatus, _, err = setApplicationHealth(resources, resourceStatuses, overrides, progressingApp, true, 2*time.Hour, tracker)
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatusProgressing, healthStatus)
+
+	resourceStatuses = initStatuses(resources)
+	healthStatus, healthMessage, err := setApplicationHealth(resources, resourceStatuses, overrides, progressingApp, true, 10*time.Minute, tracker)
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatusDegraded, healthStatus)
+	assert.Equal(t, "Progress deadline of 10m0s exceeded by 1 resource(s). Caused by Pod:default/running-pod", healthMessage)
+	assert.Equal(t, &appv1.HealthStatus{Status: health.HealthStatusDegraded, Message: "Resource has been Progressing for more than 10m0s: waiting"}, resourceStatuses[0].Health)
+
+	// the deadline never passes while the application is being synced
+	progressingApp.Operation = &appv1.Operation{Sync: &appv1.SyncOperation{}}
+	healthStatus, _, err = setApplicationHealth(resources, initStatuses(resources), overrides, progressingApp, true, 10*time.Minute, tracker)
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatusProgressing, healthStatus)
+	progressingApp.Operation = nil
+
+	// the resource is forgotten once it is not Progressing anymore, so the deadline starts over when it progresses again
+	healthyOverrides := lua.ResourceHealthOverrides{
+		"Pod": appv1.ResourceOverride{HealthCEL: `{"status": "Healthy"}`},
+	}
+	healthStatus, _, err = setApplicationHealth(resources, initStatuses(resources), healthyOverrides, progressingApp, true, 10*time.Minute, tracker)
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
+	assert.NotContains(t, tracker.since, progressingApp.QualifiedName())
+	healthStatus, _, err = setApplicationHealth(resources, initStatuses(resources), overrides, progressingApp, true, 10*time.Minute, tracker)
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatusProgressing, healthStatus)
+}
+
+func TestGetProgressDeadline(t *testing.T) {
+	app := &appv1.Application{}
+	proj := &appv1.AppProject{}
+	assert.Zero(t, getProgressDeadline(app, proj))
+
+	proj.Spec.ProgressDeadlineSeconds = new(int64(600))
+	assert.Equal(t, 10*time.Minute, getProgressDeadline(app, proj))
+
+	// the progress deadline of the application overrides the one of the project
+	app.Spec.ProgressDeadlineSeconds = new(int64(60))
+	assert.Equal(t, time.Minute, getProgressDeadline(app, proj))
+	app.Spec.ProgressDeadlineSeconds = new(int64(0))
+	assert.Zero(t, getProgressDeadline(app, proj))
+}
+
 func TestSetApplicationHealth_NoResource(t *testing.T) {
 	resources := []managedResource{}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, 0, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	assert.Empty(t, healthCauses)
@@ -131,7 +198,7 @@ func TestSetApplicationHealth_OnlyHooks(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, 0, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	// Hooks are skipped, so the Healthy app has no causes.
@@ -149,7 +216,7 @@ func TestSetApplicationHealth_MissingResource(t *testing.T) {
 	}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, 0, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	// The missing target-only resource does not degrade the app, so there are no causes.
@@ -168,7 +235,7 @@ func TestSetApplicationHealth_MissingResource_WithIgnoreHealthcheck(t *testing.T
 	}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, 0, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	// The ignored resource is not aggregated, so the Healthy app has no causes.
@@ -184,7 +251,7 @@ func TestSetApplicationHealth_MissingResource_WithChildApp(t *testing.T) {
 	}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, 0, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	// An Unknown child app does not affect the parent, so the Healthy app has no causes.
@@ -202,7 +269,7 @@ func TestSetApplicationHealth_AllMissingResources(t *testing.T) {
 	}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, 0, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusMissing, healthStatus)
 	// The Missing app health from the all-missing fallback does not attribute individual causes.
@@ -222,7 +289,7 @@ func TestSetApplicationHealth_AllMissingResources_WithHooks(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, 0, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusMissing, healthStatus)
 	// The all-missing fallback does not attribute individual causes.
@@ -242,7 +309,7 @@ func TestSetApplicationHealth_MultipleCauses(t *testing.T) {
 	}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, 0, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 	// Both failed Jobs are causes; the healthy Pod is not.
@@ -305,7 +372,7 @@ func TestSetApplicationHealth_HealthImproves(t *testing.T) {
 		resourceStatuses := initStatuses(resources)
 
 		t.Run(string(fmt.Sprintf("%s to %s", tc.oldStatus, tc.newStatus)), func(t *testing.T) {
-			healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+			healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true, 0, nil)
 			require.NoError(t, err)
 			assert.Equal(t, tc.newStatus, healthStatus)
 			// A non-Healthy app attributes the offending Pod as its cause; a Healthy app has none.
@@ -363,7 +430,7 @@ return hs`,
 		}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true, 0, nil)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 		// The Degraded child app is the cause of the parent's Degraded health.
@@ -377,7 +444,7 @@ return hs`,
 		}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true, 0, nil)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 		// A Missing child app does not affect the parent, so there are no causes.
@@ -391,7 +458,7 @@ return hs`,
 		}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true, 0, nil)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 		// An Unknown child app does not affect the parent, so there are no causes.
@@ -44,6 +44,10 @@ type MetricsServer struct {
 	redisRequestHistogram             *prometheus.HistogramVec
 	resourceEventsProcessingHistogram *prometheus.HistogramVec
 	resourceEventsNumberGauge         *prometheus.GaugeVec
+	syncQueueDepthGauge               *prometheus.GaugeVec
+	syncQueueWaitHistogram            *prometheus.HistogramVec
+	appQueueDepthGauge                *prometheus.GaugeVec
+	appQueueWaitHistogram             *prometheus.HistogramVec
 	registry                          *prometheus.Registry
 	hostname                          string
 	cron                              *cron.Cron
@@ -156,6 +160,34 @@ var (
 		Name: "argocd_resource_events_processed_in_batch",
 		Help: "Number of resource events processed in batch",
 	}, []string{"server"})
+
+	syncQueueDepthGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_app_sync_queue_depth",
+		Help: "Number of sync operations waiting for a free sync slot of the destination cluster or project",
+	}, []string{"dest_server"})
+
+	syncQueueWaitHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_app_sync_queue_wait_seconds",
+			Help:    "Time sync operations waited for a free sync slot of the destination cluster or project in seconds.",
+			Buckets: []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800},
+		},
+		[]string{"dest_server"},
+	)
+
+	appQueueDepthGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_app_queue_depth",
+		Help: "Number of applications waiting in the application controller work queues per priority",
+	}, []string{"queue", "priority"})
+
+	appQueueWaitHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_app_queue_wait_seconds",
+			Help:    "Time applications waited in the application controller work queues per priority in seconds.",
+			Buckets: []float64{0.01, 0.1, 0.5, 1, 5, 15, 30, 60, 300},
+		},
+		[]string{"queue", "priority"},
+	)
 )
 
 // NewMetricsServer returns a new prometheus server which collects application metrics
@@ -208,6 +240,10 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 	registry.MustRegister(redisRequestHistogram)
 	registry.MustRegister(resourceEventsProcessingHistogram)
 	registry.MustRegister(resourceEventsNumberGauge)
+	registry.MustRegister(syncQueueDepthGauge)
+	registry.MustRegister(syncQueueWaitHistogram)
+	registry.MustRegister(appQueueDepthGauge)
+	registry.MustRegister(appQueueWaitHistogram)
 
 	kubectl.RegisterWithClientGo()
 	kubectl.RegisterWithPrometheus(registry)
@@ -230,6 +266,10 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 		redisRequestHistogram:             redisRequestHistogram,
 		resourceEventsProcessingHistogram: resourceEventsProcessingHistogram,
 		resourceEventsNumberGauge:         resourceEventsNumberGauge,
+		syncQueueDepthGauge:               syncQueueDepthGauge,
+		syncQueueWaitHistogram:            syncQueueWaitHistogram,
+		appQueueDepthGauge:                appQueueDepthGauge,
+		appQueueWaitHistogram:             appQueueWaitHistogram,
 		hostname:                          hostname,
 		// This cron is used to expire the metrics cache.
 		// Currently clearing the metrics cache is logging and deleting from the map
@@ -314,6 +354,26 @@ func (m *MetricsServer) ObserveResourceEventsProcessingDuration(server string, d
 	m.resourceEventsNumberGauge.WithLabelValues(server).Set(float64(processedEventsNumber))
 }
 
+// SetSyncQueueDepth sets the number of sync operations waiting for a free sync slot of the destination cluster
+func (m *MetricsServer) SetSyncQueueDepth(destServer string, depth int) {
+	m.syncQueueDepthGauge.WithLabelValues(destServer).Set(float64(depth))
+}
+
+// ObserveSyncQueueWait observes the time a sync operation waited for a free sync slot of the destination cluster
+func (m *MetricsServer) ObserveSyncQueueWait(destServer string, duration time.Duration) {
+	m.syncQueueWaitHistogram.WithLabelValues(destServer).Observe(duration.Seconds())
+}
+
+// SetQueueDepth sets the number of applications waiting with the given priority in the given work queue
+func (m *MetricsServer) SetQueueDepth(queue string, priority string, depth int) {
+	m.appQueueDepthGauge.WithLabelValues(queue, priority).Set(float64(depth))
+}
+
+// ObserveQueueWait observes the time an application waited with the given priority in the given work queue
+func (m *MetricsServer) ObserveQueueWait(queue string, priority string, wait time.Duration) {
+	m.appQueueWaitHistogram.WithLabelValues(queue, priority).Observe(wait.Seconds())
+}
+
 // IncReconcile increments the reconcile counter for an application
 func (m *MetricsServer) IncReconcile(app *argoappv1.Application, destServer string, duration time.Duration) {
 	m.reconcileHistogram.WithLabelValues(app.Namespace, destServer).Observe(duration.Seconds())
@@ -344,6 +404,8 @@ func (m *MetricsServer) SetExpiration(cacheExpiration time.Duration) error {
 		m.redisRequestHistogram.Reset()
 		m.resourceEventsProcessingHistogram.Reset()
 		m.resourceEventsNumberGauge.Reset()
+		m.syncQueueDepthGauge.Reset()
+		m.syncQueueWaitHistogram.Reset()
 		kubectl.ResetAll()
 	})
 	if err != nil {
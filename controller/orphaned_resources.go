@@ -0,0 +1,355 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v3/controller/metrics"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// orphanedResource holds the state of a resource which has been observed as orphaned
+type orphanedResource struct {
+	// since is the time the resource has first been observed as orphaned
+	since time.Time
+	// node is the resource as last observed in the cluster cache
+	node appv1.ResourceNode
+	// pending indicates that the resource is queued to be pruned or adopted
+	pending bool
+	// handled indicates that the resource has been pruned or adopted, or that a dry-run prune or a failure has been
+	// reported, so that neither the action nor the event is repeated while the resource is still observed as orphaned
+	handled bool
+}
+
+// orphanedResourcesTracker tracks the orphaned resources of the applications, so that they are only pruned once they
+// have been orphaned for the grace period. The state is kept in memory, so the grace period starts over when the
+// controller restarts.
+type orphanedResourcesTracker struct {
+	lock      sync.Mutex
+	resources map[string]map[kube.ResourceKey]*orphanedResource
+}
+
+func newOrphanedResourcesTracker() *orphanedResourcesTracker {
+	return &orphanedResourcesTracker{resources: map[string]map[kube.ResourceKey]*orphanedResource{}}
+}
+
+// observe records the given resources as the orphaned resources of the application, forgets the resources which are
+// not orphaned anymore, and returns a copy of the state of each given resource.
+func (t *orphanedResourcesTracker) observe(appKey string, keys []kube.ResourceKey, now time.Time) map[kube.ResourceKey]orphanedResource {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	previous := t.resources[appKey]
+	observed := make(map[kube.ResourceKey]*orphanedResource, len(keys))
+	res := make(map[kube.ResourceKey]orphanedResource, len(keys))
+	for _, key := range keys {
+		state, ok := previous[key]
+		if !ok {
+			state = &orphanedResource{since: now}
+		}
+		observed[key] = state
+		res[key] = *state
+	}
+	if len(observed) == 0 {
+		delete(t.resources, appKey)
+	} else {
+		t.resources[appKey] = observed
+	}
+	return res
+}
+
+// setHandled marks the given resource of the application as handled
+func (t *orphanedResourcesTracker) setHandled(appKey string, key kube.ResourceKey) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if state, ok := t.resources[appKey][key]; ok {
+		state.handled = true
+	}
+}
+
+// setPending queues the given resource of the application to be pruned or adopted, and returns whether it has not
+// been queued yet
+func (t *orphanedResourcesTracker) setPending(appKey string, node appv1.ResourceNode) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	state, ok := t.resources[appKey][kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)]
+	if !ok || state.handled || state.pending {
+		return false
+	}
+	state.node = node
+	state.pending = true
+	return true
+}
+
+// takePending returns the resources of the application which are queued to be pruned or adopted, and marks them as
+// handled. The resources which are not orphaned anymore are not returned.
+func (t *orphanedResourcesTracker) takePending(appKey string) []appv1.ResourceNode {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	var nodes []appv1.ResourceNode
+	for _, state := range t.resources[appKey] {
+		if state.pending {
+			state.pending = false
+			state.handled = true
+			nodes = append(nodes, state.node)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ResourceRef.String() < nodes[j].ResourceRef.String()
+	})
+	return nodes
+}
+
+// forget removes the orphaned resources of the application
+func (t *orphanedResourcesTracker) forget(appKey string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.resources, appKey)
+}
+
+// processOrphanedResources observes the given top-level orphaned resources of the application, and queues the ones
+// which are to be pruned or adopted, depending on the orphaned resources mode of the project. Since it is called while
+// refreshing the resource tree, the resources are pruned or adopted by the orphaned resources worker.
+func (ctrl *ApplicationController) processOrphanedResources(ctx context.Context, a *appv1.Application, proj *appv1.AppProject, orphans []appv1.ResourceNode) {
+	appKey := a.QualifiedName()
+	settings := proj.Spec.OrphanedResources
+	if settings == nil || settings.Mode == "" || a.DeletionTimestamp != nil {
+		ctrl.orphanedResources.forget(appKey)
+		return
+	}
+
+	keys := make([]kube.ResourceKey, 0, len(orphans))
+	for _, orphan := range orphans {
+		keys = append(keys, kube.NewResourceKey(orphan.Group, orphan.Kind, orphan.Namespace, orphan.Name))
+	}
+	observed := ctrl.orphanedResources.observe(appKey, keys, time.Now())
+
+	queued := false
+	for i, orphan := range orphans {
+		state := observed[keys[i]]
+		if state.handled {
+			continue
+		}
+		switch settings.Mode {
+		case appv1.OrphanedResourcesModePrune:
+			if !settings.IsPruneAllowed(orphan.Group, orphan.Kind, orphan.Name) || time.Since(state.since) < settings.GetPruneGracePeriod() {
+				continue
+			}
+			if settings.IsPruneDryRun() {
+				ctrl.orphanedResources.setHandled(appKey, keys[i])
+				ctrl.logAppEvent(ctx, a, argo.EventInfo{Reason: argo.EventReasonOrphanedResourcePruneDryRun, Type: corev1.EventTypeNormal}, fmt.Sprintf("orphaned resource %s would be pruned (dry run)", orphan.ResourceRef.String()))
+				continue
+			}
+		case appv1.OrphanedResourcesModeAdopt:
+		default:
+			log.WithFields(applog.GetAppLogFields(a)).Warnf("Unknown orphaned resources mode %q", settings.Mode)
+			return
+		}
+		if ctrl.orphanedResources.setPending(appKey, orphan) {
+			queued = true
+		}
+	}
+	if queued {
+		ctrl.orphanedResourcesQueue.Add(ctrl.toAppKey(appKey))
+	}
+}
+
+// processOrphanedResourcesQueueItem prunes or adopts the queued orphaned resources of an application. Every action is
+// recorded as an event of the application. A single worker processes the queue, so that the applications which share
+// a namespace don't act on the same orphaned resource concurrently.
+func (ctrl *ApplicationController) processOrphanedResourcesQueueItem(ctx context.Context) (processNext bool) {
+	appKey, shutdown := ctrl.orphanedResourcesQueue.Get()
+	if shutdown {
+		processNext = false
+		return processNext
+	}
+	processNext = true
+	defer func() {
+		if r := recover(); r != nil {
+			log.WithField("appkey", appKey).Errorf("Recovered from panic: %+v\n%s", r, debug.Stack())
+		}
+		ctrl.orphanedResourcesQueue.Done(appKey)
+	}()
+	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appKey)
+	if err != nil {
+		log.WithField("appkey", appKey).WithError(err).Error("Failed to get application from informer index")
+		return processNext
+	}
+	if !exists {
+		return processNext
+	}
+	a, ok := obj.(*appv1.Application)
+	if !ok {
+		log.WithField("appkey", appKey).Warn("Key in index is not an application")
+		return processNext
+	}
+	pending := ctrl.orphanedResources.takePending(a.QualifiedName())
+	if len(pending) == 0 {
+		return processNext
+	}
+	logCtx := log.WithFields(applog.GetAppLogFields(a))
+
+	// the project may have changed since the resources have been queued
+	proj, err := ctrl.getAppProj(a)
+	if err != nil {
+		logCtx.WithError(err).Warn("Failed to process orphaned resources")
+		return processNext
+	}
+	settings := proj.Spec.OrphanedResources
+	if settings == nil || (settings.Mode != appv1.OrphanedResourcesModePrune && settings.Mode != appv1.OrphanedResourcesModeAdopt) || a.DeletionTimestamp != nil {
+		return processNext
+	}
+	destCluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, ctrl.db)
+	if err != nil {
+		logCtx.WithError(err).Warn("Failed to process orphaned resources")
+		return processNext
+	}
+	clusterRESTConfig, err := destCluster.RESTConfig()
+	if err != nil {
+		logCtx.WithError(err).Warn("Failed to process orphaned resources")
+		return processNext
+	}
+	config := metrics.AddMetricsTransportWrapper(ctrl.metricsServer, a, clusterRESTConfig)
+	if err := ctrl.applyImpersonationConfig(config, proj, a, destCluster); err != nil {
+		logCtx.WithError(err).Warn("Failed to process orphaned resources")
+		return processNext
+	}
+
+	for _, orphan := range pending {
+		if settings.Mode == appv1.OrphanedResourcesModePrune && !settings.IsPruneAllowed(orphan.Group, orphan.Kind, orphan.Name) {
+			continue
+		}
+		gvk := schema.GroupVersionKind{Group: orphan.Group, Version: orphan.Version, Kind: orphan.Kind}
+		live, err := ctrl.getOrphanedResource(ctx, config, gvk, orphan)
+		if err == nil && live == nil {
+			// the resource has been deleted, recreated or adopted by another application since it has been queued
+			continue
+		}
+		if err == nil {
+			if settings.Mode == appv1.OrphanedResourcesModePrune {
+				err = ctrl.pruneOrphanedResource(ctx, config, gvk, live)
+				if err == nil {
+					logCtx.Infof("Pruned orphaned resource %s", orphan.ResourceRef.String())
+					ctrl.logAppEvent(ctx, a, argo.EventInfo{Reason: argo.EventReasonOrphanedResourcePruned, Type: corev1.EventTypeNormal}, "pruned orphaned resource "+orphan.ResourceRef.String())
+					continue
+				}
+			} else {
+				err = ctrl.adoptOrphanedResource(ctx, config, a, gvk, live)
+				if err == nil {
+					logCtx.Infof("Adopted orphaned resource %s", orphan.ResourceRef.String())
+					ctrl.logAppEvent(ctx, a, argo.EventInfo{Reason: argo.EventReasonOrphanedResourceAdopted, Type: corev1.EventTypeNormal}, "adopted orphaned resource "+orphan.ResourceRef.String())
+					continue
+				}
+			}
+		}
+		if apierrors.IsConflict(err) || apierrors.IsNotFound(err) {
+			// the resource has been modified or deleted concurrently, e.g. adopted by another application
+			logCtx.WithError(err).Infof("Skipped orphaned resource %s which has been modified", orphan.ResourceRef.String())
+			continue
+		}
+		// failures are reported once, the action is retried once the controller restarts or the resource is orphaned again
+		logCtx.WithError(err).Warnf("Failed to %s orphaned resource %s", settings.Mode, orphan.ResourceRef.String())
+		ctrl.logAppEvent(ctx, a, argo.EventInfo{Reason: argo.EventReasonOrphanedResourceFailed, Type: corev1.EventTypeWarning}, fmt.Sprintf("failed to %s orphaned resource %s: %v", settings.Mode, orphan.ResourceRef.String(), err))
+	}
+	return processNext
+}
+
+// getOrphanedResource returns the live state of the orphaned resource, or nil if it has been deleted, recreated or
+// is tracked by an existing application
+func (ctrl *ApplicationController) getOrphanedResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, orphan appv1.ResourceNode) (*unstructured.Unstructured, error) {
+	live, err := ctrl.kubectl.GetResource(ctx, config, gvk, orphan.Name, orphan.Namespace)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil || live == nil {
+		return nil, err
+	}
+	if orphan.UID != "" && string(live.GetUID()) != orphan.UID {
+		return nil, nil
+	}
+	appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, err
+	}
+	trackingMethod, err := ctrl.settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return nil, err
+	}
+	installationID, err := ctrl.settingsMgr.GetInstallationID()
+	if err != nil {
+		return nil, err
+	}
+	if appName := argo.NewResourceTracking().GetAppName(live, appLabelKey, appv1.TrackingMethod(trackingMethod), installationID); appName != "" {
+		if _, exists, err := ctrl.appInformer.GetIndexer().GetByKey(ctrl.toAppKey(appName)); exists && err == nil {
+			return nil, nil
+		}
+	}
+	return live, nil
+}
+
+// pruneOrphanedResource deletes the orphaned resource, unless it has been modified since its live state has been read
+func (ctrl *ApplicationController) pruneOrphanedResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, live *unstructured.Unstructured) error {
+	propagationPolicy := metav1.DeletePropagationBackground
+	uid := live.GetUID()
+	resourceVersion := live.GetResourceVersion()
+	return ctrl.kubectl.DeleteResource(ctx, config, gvk, live.GetName(), live.GetNamespace(), metav1.DeleteOptions{
+		PropagationPolicy: &propagationPolicy,
+		Preconditions:     &metav1.Preconditions{UID: &uid, ResourceVersion: &resourceVersion},
+	})
+}
+
+// adoptOrphanedResource adds the tracking metadata of the application to the orphaned resource, using the configured
+// tracking method. The patch fails with a conflict if the resource has been modified since its live state has been
+// read, e.g. if it has been adopted by another application.
+func (ctrl *ApplicationController) adoptOrphanedResource(ctx context.Context, config *rest.Config, a *appv1.Application, gvk schema.GroupVersionKind, live *unstructured.Unstructured) error {
+	appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return err
+	}
+	trackingMethod, err := ctrl.settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return err
+	}
+	installationID, err := ctrl.settingsMgr.GetInstallationID()
+	if err != nil {
+		return err
+	}
+	// set the tracking metadata on an empty resource, so that the patch only contains the tracking metadata
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(live.GetName())
+	obj.SetNamespace(live.GetNamespace())
+	err = argo.NewResourceTracking().SetAppInstance(obj, appLabelKey, a.InstanceName(ctrl.namespace), a.Spec.Destination.Namespace, appv1.TrackingMethod(trackingMethod), installationID)
+	if err != nil {
+		return fmt.Errorf("failed to set app instance tracking: %w", err)
+	}
+	metadata := map[string]any{"resourceVersion": live.GetResourceVersion()}
+	if labels := obj.GetLabels(); len(labels) > 0 {
+		metadata["labels"] = labels
+	}
+	if annotations := obj.GetAnnotations(); len(annotations) > 0 {
+		metadata["annotations"] = annotations
+	}
+	patch, err := json.Marshal(map[string]any{"metadata": metadata})
+	if err != nil {
+		return err
+	}
+	_, err = ctrl.kubectl.PatchResource(ctx, config, gvk, live.GetName(), live.GetNamespace(), types.MergePatchType, patch)
+	return err
+}
@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestOrphanedResourcesTracker(t *testing.T) {
+	tracker := newOrphanedResourcesTracker()
+	deploy1 := kube.NewResourceKey("apps", "Deployment", "default", "deploy1")
+	deploy2 := kube.NewResourceKey("apps", "Deployment", "default", "deploy2")
+	start := time.Now()
+
+	observed := tracker.observe("argocd/app", []kube.ResourceKey{deploy1}, start)
+	assert.Equal(t, start, observed[deploy1].since)
+
+	// resources keep the time they have first been observed as orphaned
+	observed = tracker.observe("argocd/app", []kube.ResourceKey{deploy1, deploy2}, start.Add(time.Minute))
+	assert.Equal(t, start, observed[deploy1].since)
+	assert.Equal(t, start.Add(time.Minute), observed[deploy2].since)
+
+	// resources which are not orphaned anymore are forgotten
+	tracker.observe("argocd/app", []kube.ResourceKey{deploy2}, start.Add(2*time.Minute))
+	observed = tracker.observe("argocd/app", []kube.ResourceKey{deploy1, deploy2}, start.Add(3*time.Minute))
+	assert.Equal(t, start.Add(3*time.Minute), observed[deploy1].since)
+
+	tracker.forget("argocd/app")
+	assert.Empty(t, tracker.resources)
+}
+
+func newOrphanedResourcesTestController(t *testing.T, settings *v1alpha1.OrphanedResourcesMonitorSettings, objs ...runtime.Object) (*ApplicationController, *v1alpha1.Application) {
+	t.Helper()
+	app := newFakeApp()
+	proj := defaultProj.DeepCopy()
+	proj.Spec.OrphanedResources = settings
+	ctrl := newFakeController(t.Context(), &fakeData{
+		apps: append([]runtime.Object{app, proj}, objs...),
+		namespacedResources: map[kube.ResourceKey]namespacedResource{
+			kube.NewResourceKey("apps", "Deployment", "default", "deploy1"): {ResourceNode: v1alpha1.ResourceNode{
+				ResourceRef: v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "default", Name: "deploy1"},
+			}},
+			kube.NewResourceKey("", "ConfigMap", "default", "config1"): {ResourceNode: v1alpha1.ResourceNode{
+				ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "ConfigMap", Namespace: "default", Name: "config1"},
+			}},
+			kube.NewResourceKey("", "ConfigMap", "default", "ignored"): {ResourceNode: v1alpha1.ResourceNode{
+				ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "ConfigMap", Namespace: "default", Name: "ignored"},
+			}},
+		},
+	}, nil)
+	kubectl := ctrl.kubectl.(*MockKubectl)
+	kubectl.LiveResources = map[kube.ResourceKey]*unstructured.Unstructured{}
+	for _, key := range []kube.ResourceKey{
+		kube.NewResourceKey("apps", "Deployment", "default", "deploy1"),
+		kube.NewResourceKey("", "ConfigMap", "default", "config1"),
+		kube.NewResourceKey("", "ConfigMap", "default", "ignored"),
+	} {
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(schema.GroupVersionKind{Group: key.Group, Version: "v1", Kind: key.Kind})
+		live.SetNamespace(key.Namespace)
+		live.SetName(key.Name)
+		live.SetResourceVersion("1")
+		kubectl.LiveResources[key] = live
+	}
+	return ctrl, app
+}
+
+// refreshOrphanedResources refreshes the resource tree of the application as if the orphaned resources had been
+// orphaned for the given duration, and processes the queued orphaned resources
+func refreshOrphanedResources(t *testing.T, ctrl *ApplicationController, app *v1alpha1.Application, orphanedFor time.Duration) {
+	t.Helper()
+	_, err := ctrl.getResourceTree(&v1alpha1.Cluster{Server: "https://localhost:6443", Name: "fake-cluster"}, app, nil)
+	require.NoError(t, err)
+	for _, state := range ctrl.orphanedResources.resources[app.QualifiedName()] {
+		state.since = time.Now().Add(-orphanedFor)
+	}
+	_, err = ctrl.getResourceTree(&v1alpha1.Cluster{Server: "https://localhost:6443", Name: "fake-cluster"}, app, nil)
+	require.NoError(t, err)
+	for ctrl.orphanedResourcesQueue.Len() > 0 {
+		ctrl.processOrphanedResourcesQueueItem(t.Context())
+	}
+}
+
+func TestGetResourceTree_PruneOrphanedResources(t *testing.T) {
+	ctrl, app := newOrphanedResourcesTestController(t, &v1alpha1.OrphanedResourcesMonitorSettings{
+		Mode:   v1alpha1.OrphanedResourcesModePrune,
+		Ignore: []v1alpha1.OrphanedResourceKey{{Kind: "ConfigMap", Name: "ignored"}},
+		Prune: &v1alpha1.OrphanedResourcesPruneSettings{
+			Allow: []v1alpha1.OrphanedResourceKey{{Group: "apps", Kind: "*"}},
+		},
+	})
+
+	tree, err := ctrl.getResourceTree(&v1alpha1.Cluster{Server: "https://localhost:6443", Name: "fake-cluster"}, app, nil)
+	require.NoError(t, err)
+	assert.Len(t, tree.OrphanedNodes, 2)
+	// the resources are not pruned while refreshing the resource tree
+	assert.Zero(t, ctrl.orphanedResourcesQueue.Len())
+
+	refreshOrphanedResources(t, ctrl, app, time.Hour)
+	kubectl := ctrl.kubectl.(*MockKubectl)
+	assert.Equal(t, []kube.ResourceKey{kube.NewResourceKey("apps", "Deployment", "default", "deploy1")}, kubectl.DeletedResources)
+
+	// resources are only pruned once
+	refreshOrphanedResources(t, ctrl, app, time.Hour)
+	assert.Len(t, kubectl.DeletedResources, 1)
+}
+
+func TestGetResourceTree_PruneOrphanedResourcesGracePeriodAndDryRun(t *testing.T) {
+	allow := []v1alpha1.OrphanedResourceKey{{Group: "apps", Kind: "*"}}
+	// the default grace period applies if it is not set
+	ctrl, app := newOrphanedResourcesTestController(t, &v1alpha1.OrphanedResourcesMonitorSettings{
+		Mode:  v1alpha1.OrphanedResourcesModePrune,
+		Prune: &v1alpha1.OrphanedResourcesPruneSettings{Allow: allow},
+	})
+	refreshOrphanedResources(t, ctrl, app, time.Minute)
+	assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+
+	ctrl, app = newOrphanedResourcesTestController(t, &v1alpha1.OrphanedResourcesMonitorSettings{
+		Mode:  v1alpha1.OrphanedResourcesModePrune,
+		Prune: &v1alpha1.OrphanedResourcesPruneSettings{Allow: allow, GracePeriodSeconds: 3600},
+	})
+	refreshOrphanedResources(t, ctrl, app, 10*time.Minute)
+	assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+
+	ctrl, app = newOrphanedResourcesTestController(t, &v1alpha1.OrphanedResourcesMonitorSettings{
+		Mode:  v1alpha1.OrphanedResourcesModePrune,
+		Prune: &v1alpha1.OrphanedResourcesPruneSettings{Allow: allow, DryRun: true},
+	})
+	refreshOrphanedResources(t, ctrl, app, time.Hour)
+	assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	observed := ctrl.orphanedResources.resources[app.QualifiedName()]
+	assert.True(t, observed[kube.NewResourceKey("apps", "Deployment", "default", "deploy1")].handled, "dry-run prunes are reported once")
+}
+
+func TestGetResourceTree_PruneOrphanedResourcesWithoutAllowList(t *testing.T) {
+	ctrl, app := newOrphanedResourcesTestController(t, &v1alpha1.OrphanedResourcesMonitorSettings{
+		Mode: v1alpha1.OrphanedResourcesModePrune,
+	})
+	refreshOrphanedResources(t, ctrl, app, time.Hour)
+	assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	assert.False(t, ctrl.orphanedResources.resources[app.QualifiedName()][kube.NewResourceKey("apps", "Deployment", "default", "deploy1")].handled)
+}
+
+func TestGetResourceTree_AdoptOrphanedResources(t *testing.T) {
+	ctrl, app := newOrphanedResourcesTestController(t, &v1alpha1.OrphanedResourcesMonitorSettings{
+		Mode:   v1alpha1.OrphanedResourcesModeAdopt,
+		Ignore: []v1alpha1.OrphanedResourceKey{{Kind: "ConfigMap"}},
+	})
+	refreshOrphanedResources(t, ctrl, app, 0)
+	kubectl := ctrl.kubectl.(*MockKubectl)
+	assert.Empty(t, kubectl.DeletedResources)
+	assert.Equal(t, map[kube.ResourceKey]string{
+		kube.NewResourceKey("apps", "Deployment", "default", "deploy1"): `{"metadata":{"annotations":{"argocd.argoproj.io/tracking-id":"my-app:apps/Deployment:default/deploy1"},"resourceVersion":"1"}}`,
+	}, kubectl.PatchedResources)
+}
+
+func TestGetResourceTree_AdoptOrphanedResourcesAdoptedByAnotherApp(t *testing.T) {
+	otherApp := newFakeApp()
+	otherApp.Name = "other-app"
+	ctrl, app := newOrphanedResourcesTestController(t, &v1alpha1.OrphanedResourcesMonitorSettings{
+		Mode:   v1alpha1.OrphanedResourcesModeAdopt,
+		Ignore: []v1alpha1.OrphanedResourceKey{{Kind: "ConfigMap"}},
+	}, otherApp)
+	_, err := ctrl.getResourceTree(&v1alpha1.Cluster{Server: "https://localhost:6443", Name: "fake-cluster"}, app, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, ctrl.orphanedResourcesQueue.Len())
+
+	// the other application of the namespace adopts the resource before it is processed
+	kubectl := ctrl.kubectl.(*MockKubectl)
+	kubectl.LiveResources[kube.NewResourceKey("apps", "Deployment", "default", "deploy1")].SetAnnotations(map[string]string{
+		"argocd.argoproj.io/tracking-id": "other-app:apps/Deployment:default/deploy1",
+	})
+	ctrl.processOrphanedResourcesQueueItem(t.Context())
+	assert.Empty(t, kubectl.PatchedResources)
+}
@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// getManifestsSize returns the total size, in bytes, of the rendered or local manifests of an application
+func getManifestsSize(manifestInfos []*apiclient.ManifestResponse, localManifests []string) int64 {
+	var size int64
+	for _, manifestInfo := range manifestInfos {
+		if manifestInfo == nil {
+			continue
+		}
+		for _, manifest := range manifestInfo.Manifests {
+			size += int64(len(manifest))
+		}
+	}
+	for _, manifest := range localManifests {
+		size += int64(len(manifest))
+	}
+	return size
+}
+
+// getProjectQuotaViolations returns a message for each quota of the project which is exceeded by the application, given
+// the resources and the size of the manifests it renders
+func (m *appStateManager) getProjectQuotaViolations(app *v1alpha1.Application, project *v1alpha1.AppProject, targetObjs []*unstructured.Unstructured, manifestsSize int64) ([]string, error) {
+	quotas := project.Spec.Quotas
+	if quotas == nil {
+		return nil, nil
+	}
+	var violations []string
+	if quotas.MaxResourcesPerApplication > 0 && int64(len(targetObjs)) > quotas.MaxResourcesPerApplication {
+		violations = append(violations, fmt.Sprintf("application renders %d resources, which exceeds the maximum of %d resources per application", len(targetObjs), quotas.MaxResourcesPerApplication))
+	}
+	if quotas.MaxManifestSizeBytes > 0 && manifestsSize > quotas.MaxManifestSizeBytes {
+		violations = append(violations, fmt.Sprintf("application manifests are %d bytes, which exceeds the maximum of %d bytes per application", manifestsSize, quotas.MaxManifestSizeBytes))
+	}
+	if (quotas.MaxApplications <= 0 && quotas.MaxManagedResources <= 0) || m.appLister == nil {
+		return violations, nil
+	}
+
+	apps, err := m.getProjectApps(project.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+	if quotas.MaxApplications > 0 && int64(len(apps)) > quotas.MaxApplications {
+		// the oldest applications are within the quota, so that creating an application does not affect existing ones
+		sort.Slice(apps, func(i, j int) bool {
+			if !apps[i].CreationTimestamp.Equal(&apps[j].CreationTimestamp) {
+				return apps[i].CreationTimestamp.Before(&apps[j].CreationTimestamp)
+			}
+			return apps[i].QualifiedName() < apps[j].QualifiedName()
+		})
+		for i, a := range apps {
+			if a.QualifiedName() == app.QualifiedName() && int64(i) >= quotas.MaxApplications {
+				violations = append(violations, fmt.Sprintf("project has %d applications, which exceeds the maximum of %d applications", len(apps), quotas.MaxApplications))
+				break
+			}
+		}
+	}
+	if quotas.MaxManagedResources > 0 {
+		managedResources := int64(len(targetObjs))
+		for _, a := range apps {
+			if a.QualifiedName() != app.QualifiedName() {
+				managedResources += int64(len(a.Status.Resources))
+			}
+		}
+		if managedResources > quotas.MaxManagedResources {
+			violations = append(violations, fmt.Sprintf("applications of the project manage %d resources, which exceeds the maximum of %d managed resources", managedResources, quotas.MaxManagedResources))
+		}
+	}
+	return violations, nil
+}
+
+// getProjectApps returns the applications of the given project. The applications are looked up in the project index of
+// the application informer if available, so that the applications of other projects are not listed.
+func (m *appStateManager) getProjectApps(projectName string) ([]*v1alpha1.Application, error) {
+	if m.appIndexer == nil {
+		allApps, err := m.appLister.List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		return argo.FilterByProjectsP(allApps, []string{projectName}), nil
+	}
+	objs, err := m.appIndexer.ByIndex(projectIndex, projectName)
+	if err != nil {
+		return nil, err
+	}
+	apps := make([]*v1alpha1.Application, 0, len(objs))
+	for _, obj := range objs {
+		if app, ok := obj.(*v1alpha1.Application); ok {
+			apps = append(apps, app)
+		}
+	}
+	return apps, nil
+}
@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	. "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func TestGetManifestsSize(t *testing.T) {
+	assert.Equal(t, int64(5), getManifestsSize([]*apiclient.ManifestResponse{{Manifests: []string{"ab", "c"}}, nil}, []string{"de"}))
+	assert.Equal(t, int64(0), getManifestsSize(nil, nil))
+}
+
+func compareAppStateWithQuotas(t *testing.T, app *v1alpha1.Application, apps []runtime.Object, quotas *v1alpha1.ProjectQuotas) *comparisonResult {
+	t.Helper()
+	pod := NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	svc := NewService()
+	svc.SetNamespace(test.FakeDestNamespace)
+	data := fakeData{
+		apps: apps,
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, pod), toJSON(t, svc)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+	proj := defaultProj.DeepCopy()
+	proj.Spec.Quotas = quotas
+	compRes, err := ctrl.appStateManager.CompareAppState(t.Context(), app, proj, []string{""}, []v1alpha1.ApplicationSource{app.Spec.GetSource()}, false, false, nil, false)
+	require.NoError(t, err)
+	require.NotNil(t, compRes)
+	return compRes
+}
+
+func TestCompareAppState_ProjectQuotas(t *testing.T) {
+	t.Run("Within quotas", func(t *testing.T) {
+		app := newFakeApp()
+		compRes := compareAppStateWithQuotas(t, app, []runtime.Object{app}, &v1alpha1.ProjectQuotas{
+			MaxApplications:            1,
+			MaxResourcesPerApplication: 2,
+			MaxManagedResources:        2,
+			MaxManifestSizeBytes:       1024 * 1024,
+		})
+		assert.Empty(t, app.Status.Conditions)
+		assert.Len(t, compRes.resources, 2)
+	})
+
+	t.Run("Resources per application exceeded", func(t *testing.T) {
+		app := newFakeApp()
+		compRes := compareAppStateWithQuotas(t, app, []runtime.Object{app}, &v1alpha1.ProjectQuotas{MaxResourcesPerApplication: 1})
+		conditions := app.Status.Conditions
+		require.Len(t, conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionComparisonError, conditions[0].Type)
+		assert.Equal(t, "Project quota exceeded: application renders 2 resources, which exceeds the maximum of 1 resources per application", conditions[0].Message)
+		assert.Equal(t, v1alpha1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+		assert.Empty(t, compRes.resources)
+	})
+
+	t.Run("Manifest size exceeded", func(t *testing.T) {
+		app := newFakeApp()
+		compareAppStateWithQuotas(t, app, []runtime.Object{app}, &v1alpha1.ProjectQuotas{MaxManifestSizeBytes: 10})
+		conditions := app.Status.Conditions
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "which exceeds the maximum of 10 bytes per application")
+	})
+
+	t.Run("Managed resources exceeded", func(t *testing.T) {
+		app := newFakeApp()
+		other := newFakeApp()
+		other.Name = "other-app"
+		other.Status.Resources = []v1alpha1.ResourceStatus{{Kind: "ConfigMap", Name: "config"}}
+		compareAppStateWithQuotas(t, app, []runtime.Object{app, other}, &v1alpha1.ProjectQuotas{MaxManagedResources: 2})
+		conditions := app.Status.Conditions
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "Project quota exceeded: applications of the project manage 3 resources, which exceeds the maximum of 2 managed resources", conditions[0].Message)
+	})
+
+	t.Run("Applications exceeded", func(t *testing.T) {
+		oldest := newFakeApp()
+		oldest.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+		newest := newFakeApp()
+		newest.Name = "newest-app"
+		newest.CreationTimestamp = metav1.NewTime(time.Now())
+
+		// only the applications created after the quota has been reached are affected
+		compareAppStateWithQuotas(t, oldest, []runtime.Object{oldest, newest}, &v1alpha1.ProjectQuotas{MaxApplications: 1})
+		assert.Empty(t, oldest.Status.Conditions)
+		compareAppStateWithQuotas(t, newest, []runtime.Object{oldest, newest}, &v1alpha1.ProjectQuotas{MaxApplications: 1})
+		conditions := newest.Status.Conditions
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "Project quota exceeded: project has 2 applications, which exceeds the maximum of 1 applications", conditions[0].Message)
+	})
+
+	t.Run("Applications of other projects are not counted", func(t *testing.T) {
+		app := newFakeApp()
+		app.CreationTimestamp = metav1.NewTime(time.Now())
+		other := newFakeApp()
+		other.Name = "other-app"
+		other.Spec.Project = "other"
+		other.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+		other.Status.Resources = []v1alpha1.ResourceStatus{{Kind: "ConfigMap", Name: "config"}}
+		compareAppStateWithQuotas(t, app, []runtime.Object{app, other}, &v1alpha1.ProjectQuotas{MaxApplications: 1, MaxManagedResources: 2})
+		assert.Empty(t, app.Status.Conditions)
+	})
+}
@@ -0,0 +1,154 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// processAutomatedRollback evaluates the health of the application after its most recent sync when automated rollback
+// is enabled. If the application became Degraded within the rollback window, a sync back to the last healthy revision
+// is initiated. The health observed at the end of the window is recorded in the revision history of the given
+// application, which has to be persisted by the caller. It returns whether a rollback was initiated.
+func (ctrl *ApplicationController) processAutomatedRollback(ctx context.Context, app *appv1.Application, healthStatus health.HealthStatusCode) bool {
+	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.AutomatedRollback == nil || !app.Spec.SyncPolicy.IsAutomatedSyncEnabled() || len(app.Status.History) == 0 {
+		return false
+	}
+	if app.Operation != nil || isOperationInProgress(app) || app.DeletionTimestamp != nil {
+		return false
+	}
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	latest := &app.Status.History[len(app.Status.History)-1]
+	if latest.HealthStatus != "" {
+		// the health after the most recent sync has already been evaluated
+		return false
+	}
+	window, err := app.Spec.SyncPolicy.AutomatedRollback.WindowDuration()
+	if err != nil {
+		logCtx.WithError(err).Warn("Skipping automated rollback: invalid window")
+		return false
+	}
+
+	elapsed := time.Since(latest.DeployedAt.Time)
+	if healthStatus != health.HealthStatusDegraded || elapsed > window {
+		if elapsed > window {
+			latest.HealthStatus = healthStatus
+		} else {
+			// evaluate the health once more at the end of the window
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithRecent.Pointer(), new(window-elapsed))
+		}
+		return false
+	}
+	latest.HealthStatus = health.HealthStatusDegraded
+
+	rolledBackRevisions := historyRevisions(*latest)
+	attempt := int64(1)
+	olderThanID := latest.ID
+	if previous := lastAutomatedRollback(app); previous != nil {
+		// the revision the application was rolled back to became Degraded as well
+		rolledBackRevisions = previous.Revisions
+		attempt = previous.Attempt + 1
+		olderThanID = previous.HistoryID
+	}
+	if maxAttempts := app.Spec.SyncPolicy.AutomatedRollback.GetMaxAttempts(); attempt > maxAttempts {
+		message := fmt.Sprintf("Application became Degraded after the sync to %s, but automated rollback already reached the maximum number of attempts (%d)", strings.Join(historyRevisions(*latest), ", "), maxAttempts)
+		logCtx.Warn(message)
+		ctrl.logAppEvent(ctx, app, argo.EventInfo{Reason: argo.EventReasonAutomatedRollback, Type: corev1.EventTypeWarning}, message)
+		return false
+	}
+
+	target := findRollbackTarget(app.Status.History, olderThanID, historyRevisions(*latest))
+	if target == nil {
+		message := fmt.Sprintf("Application became Degraded after the sync to %s, but there is no healthy revision to roll back to", strings.Join(historyRevisions(*latest), ", "))
+		logCtx.Warn(message)
+		ctrl.logAppEvent(ctx, app, argo.EventInfo{Reason: argo.EventReasonAutomatedRollback, Type: corev1.EventTypeWarning}, message)
+		return false
+	}
+
+	message := fmt.Sprintf("Application became Degraded %s after the sync to %s, rolling back to %s (history ID %d)", elapsed.Round(time.Second), strings.Join(historyRevisions(*latest), ", "), strings.Join(historyRevisions(*target), ", "), target.ID)
+	if attempt > 1 {
+		message = fmt.Sprintf("%s. Attempt #%d", message, attempt)
+	}
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Revision:    target.Revision,
+			Revisions:   target.Revisions,
+			Prune:       app.Spec.SyncPolicy.Automated.GetPrune(),
+			SyncOptions: app.Spec.SyncPolicy.SyncOptions,
+			Sources:     target.Sources,
+		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
+		Retry:       appv1.RetryStrategy{Limit: 5},
+		AutomatedRollback: &appv1.OperationAutomatedRollback{
+			Revisions: rolledBackRevisions,
+			HistoryID: target.ID,
+			Attempt:   attempt,
+			Message:   message,
+		},
+	}
+	if len(target.Sources) == 0 {
+		op.Sync.Source = new(target.Source)
+	}
+	if app.Spec.SyncPolicy.Retry != nil {
+		op.Retry = *app.Spec.SyncPolicy.Retry
+	}
+
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	updatedApp, err := argo.SetAppOperation(appIf, app.Name, &op)
+	if err != nil {
+		// evaluate the health again on the next reconciliation
+		latest.HealthStatus = ""
+		logCtx.WithError(err).Error("Failed to initiate automated rollback")
+		return false
+	}
+	ctrl.writeBackToInformer(updatedApp)
+
+	logCtx.Warn(message)
+	ctrl.logAppEvent(ctx, app, argo.EventInfo{Reason: argo.EventReasonAutomatedRollback, Type: corev1.EventTypeWarning}, message)
+	return true
+}
+
+// lastAutomatedRollback returns the automated rollback performed by the most recent operation, if any
+func lastAutomatedRollback(app *appv1.Application) *appv1.OperationAutomatedRollback {
+	if app.Status.OperationState == nil {
+		return nil
+	}
+	return app.Status.OperationState.Operation.AutomatedRollback
+}
+
+// findRollbackTarget returns the most recent healthy revision history entry older than the given ID whose revisions
+// differ from the given ones, or nil if there is none.
+func findRollbackTarget(history appv1.RevisionHistories, olderThanID int64, revisions []string) *appv1.RevisionHistory {
+	for i := len(history) - 1; i >= 0; i-- {
+		h := history[i]
+		if h.ID >= olderThanID || h.HealthStatus != health.HealthStatusHealthy {
+			continue
+		}
+		if h.Source.IsZero() && h.Sources.IsZero() {
+			// revisions deployed with Argo CD v0.11 or lower cannot be rolled back to
+			continue
+		}
+		if slices.Equal(historyRevisions(h), revisions) {
+			continue
+		}
+		return &h
+	}
+	return nil
+}
+
+func historyRevisions(h appv1.RevisionHistory) []string {
+	if len(h.Sources) > 0 {
+		return h.Revisions
+	}
+	return []string{h.Revision}
+}
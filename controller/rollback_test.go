@@ -0,0 +1,195 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newFakeAppWithRollbackHistory(deployedAt time.Time) *v1alpha1.Application {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.AutomatedRollback = &v1alpha1.AutomatedRollback{Window: "10m"}
+	source := app.Spec.GetSource()
+	app.Status.History = v1alpha1.RevisionHistories{
+		{ID: 0, Revision: "aaa", Source: source, DeployedAt: metav1.NewTime(deployedAt.Add(-2 * time.Hour)), HealthStatus: health.HealthStatusHealthy},
+		{ID: 1, Revision: "bbb", Source: source, DeployedAt: metav1.NewTime(deployedAt.Add(-time.Hour)), HealthStatus: health.HealthStatusHealthy},
+		{ID: 2, Revision: "ccc", Source: source, DeployedAt: metav1.NewTime(deployedAt)},
+	}
+	return app
+}
+
+func TestProcessAutomatedRollback(t *testing.T) {
+	t.Run("RollbackToLastHealthyRevision", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory(time.Now().Add(-time.Minute))
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		assert.True(t, ctrl.processAutomatedRollback(t.Context(), app, health.HealthStatusDegraded))
+		assert.Equal(t, health.HealthStatusDegraded, app.Status.History[2].HealthStatus)
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, updatedApp.Operation)
+		assert.Equal(t, "bbb", updatedApp.Operation.Sync.Revision)
+		assert.True(t, updatedApp.Operation.InitiatedBy.Automated)
+		require.NotNil(t, updatedApp.Operation.AutomatedRollback)
+		assert.Equal(t, []string{"ccc"}, updatedApp.Operation.AutomatedRollback.Revisions)
+		assert.Equal(t, int64(1), updatedApp.Operation.AutomatedRollback.HistoryID)
+		assert.Equal(t, int64(1), updatedApp.Operation.AutomatedRollback.Attempt)
+		assert.Contains(t, updatedApp.Operation.AutomatedRollback.Message, "rolling back to bbb (history ID 1)")
+	})
+	t.Run("MultiSourceRollback", func(t *testing.T) {
+		app := newFakeMultiSourceApp()
+		app.Spec.SyncPolicy.AutomatedRollback = &v1alpha1.AutomatedRollback{}
+		app.Status.History = v1alpha1.RevisionHistories{
+			{ID: 0, Revisions: []string{"a1", "a2"}, Sources: app.Spec.Sources, DeployedAt: metav1.NewTime(time.Now().Add(-time.Hour)), HealthStatus: health.HealthStatusHealthy},
+			{ID: 1, Revisions: []string{"b1", "b2"}, Sources: app.Spec.Sources, DeployedAt: metav1.NewTime(time.Now())},
+		}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		assert.True(t, ctrl.processAutomatedRollback(t.Context(), app, health.HealthStatusDegraded))
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, updatedApp.Operation)
+		assert.Equal(t, []string{"a1", "a2"}, updatedApp.Operation.Sync.Revisions)
+		assert.Equal(t, app.Spec.Sources, updatedApp.Operation.Sync.Sources)
+		assert.Nil(t, updatedApp.Operation.Sync.Source)
+		assert.Equal(t, []string{"b1", "b2"}, updatedApp.Operation.AutomatedRollback.Revisions)
+	})
+	t.Run("SecondAttemptRollsBackFurther", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory(time.Now().Add(-time.Minute))
+		app.Spec.SyncPolicy.AutomatedRollback.MaxAttempts = 2
+		app.Status.History[2].Revision = "bbb"
+		app.Status.OperationState.Operation.AutomatedRollback = &v1alpha1.OperationAutomatedRollback{Revisions: []string{"ccc"}, HistoryID: 1, Attempt: 1}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		assert.True(t, ctrl.processAutomatedRollback(t.Context(), app, health.HealthStatusDegraded))
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, updatedApp.Operation)
+		assert.Equal(t, "aaa", updatedApp.Operation.Sync.Revision)
+		assert.Equal(t, []string{"ccc"}, updatedApp.Operation.AutomatedRollback.Revisions)
+		assert.Equal(t, int64(2), updatedApp.Operation.AutomatedRollback.Attempt)
+	})
+	t.Run("MaxAttemptsReached", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory(time.Now().Add(-time.Minute))
+		app.Status.OperationState.Operation.AutomatedRollback = &v1alpha1.OperationAutomatedRollback{Revisions: []string{"ddd"}, HistoryID: 2, Attempt: 1}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		assert.False(t, ctrl.processAutomatedRollback(t.Context(), app, health.HealthStatusDegraded))
+		assert.Equal(t, health.HealthStatusDegraded, app.Status.History[2].HealthStatus)
+	})
+	t.Run("DegradedAfterWindow", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory(time.Now().Add(-time.Hour))
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		assert.False(t, ctrl.processAutomatedRollback(t.Context(), app, health.HealthStatusDegraded))
+		assert.Equal(t, health.HealthStatusDegraded, app.Status.History[2].HealthStatus)
+	})
+	t.Run("HealthyAfterWindow", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory(time.Now().Add(-time.Hour))
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		assert.False(t, ctrl.processAutomatedRollback(t.Context(), app, health.HealthStatusHealthy))
+		assert.Equal(t, health.HealthStatusHealthy, app.Status.History[2].HealthStatus)
+	})
+	t.Run("HealthyWithinWindow", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory(time.Now().Add(-time.Minute))
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		assert.False(t, ctrl.processAutomatedRollback(t.Context(), app, health.HealthStatusHealthy))
+		assert.Empty(t, app.Status.History[2].HealthStatus)
+	})
+	t.Run("NoHealthyRevision", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory(time.Now().Add(-time.Minute))
+		app.Status.History[0].HealthStatus = health.HealthStatusDegraded
+		app.Status.History[1].HealthStatus = health.HealthStatusProgressing
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		assert.False(t, ctrl.processAutomatedRollback(t.Context(), app, health.HealthStatusDegraded))
+	})
+	t.Run("AutomatedSyncDisabled", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory(time.Now().Add(-time.Minute))
+		app.Spec.SyncPolicy.Automated = nil
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		assert.False(t, ctrl.processAutomatedRollback(t.Context(), app, health.HealthStatusDegraded))
+		assert.Empty(t, app.Status.History[2].HealthStatus)
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory(time.Now().Add(-time.Minute))
+		app.Spec.SyncPolicy.AutomatedRollback = nil
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		assert.False(t, ctrl.processAutomatedRollback(t.Context(), app, health.HealthStatusDegraded))
+		assert.Empty(t, app.Status.History[2].HealthStatus)
+	})
+}
+
+func TestProcessAutomatedSync_RollbackPreventedBySyncWindow(t *testing.T) {
+	compareResult := &comparisonResult{
+		healthStatus: health.HealthStatusDegraded,
+		syncStatus:   &v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revision: "ccc"},
+	}
+
+	t.Run("DenyWindow", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory(time.Now().Add(-time.Minute))
+		proj := defaultProj.DeepCopy()
+		proj.Spec.SyncWindows = v1alpha1.SyncWindows{{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"*"}}}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, proj}}, nil)
+
+		ctrl.processAutomatedSync(t.Context(), app, proj, compareResult, nil)
+		assert.Empty(t, app.Status.History[2].HealthStatus)
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, updatedApp.Operation)
+	})
+	t.Run("NoWindow", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory(time.Now().Add(-time.Minute))
+		proj := defaultProj.DeepCopy()
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, proj}}, nil)
+
+		ctrl.processAutomatedSync(t.Context(), app, proj, compareResult, nil)
+		assert.Equal(t, health.HealthStatusDegraded, app.Status.History[2].HealthStatus)
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, updatedApp.Operation)
+		assert.NotNil(t, updatedApp.Operation.AutomatedRollback)
+	})
+}
+
+func TestAutoSyncPausedAfterAutomatedRollback(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState.Operation.AutomatedRollback = &v1alpha1.OperationAutomatedRollback{Revisions: []string{"bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	resources := []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}
+
+	cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, resources, true)
+	require.NotNil(t, cond)
+	assert.Contains(t, cond.Message, "Auto-sync paused")
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, updatedApp.Operation)
+
+	// a new revision resumes auto-sync
+	syncStatus.Revision = "cccccccccccccccccccccccccccccccccccccccc"
+	cond, _ = ctrl.autoSync(t.Context(), app, &syncStatus, resources, true)
+	assert.Nil(t, cond)
+	updatedApp, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotNil(t, updatedApp.Operation)
+}
@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+	"maps"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v3/controller/sharding"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+)
+
+// clusterShardRebalanceInterval is the interval in which the clusters are rebalanced across the shards by their load
+// if the resource weighted sharding algorithm is used
+const clusterShardRebalanceInterval = time.Minute
+
+// rebalanceClusterShards updates the load of the clusters from the cluster info stored by all shards, and the shard
+// assignment published to all shards. The first shard then rebalances the clusters across the shards and publishes the
+// new assignment, which all shards, including the first one, apply once they read it on their next update.
+func (ctrl *ApplicationController) rebalanceClusterShards(ctx context.Context) {
+	clusters, err := ctrl.db.ListClusters(ctx)
+	if err != nil {
+		log.WithError(err).Warn("Failed to rebalance cluster shards: error while querying clusters list from database")
+		return
+	}
+	loads := make(map[string]sharding.ClusterLoad, len(clusters.Items))
+	for _, c := range clusters.Items {
+		var info appv1.ClusterInfo
+		if err := ctrl.cache.GetClusterInfo(c.Server, &info); err != nil {
+			continue
+		}
+		if load, ok := sharding.ClusterLoadFromInfo(&info); ok {
+			loads[c.Server] = load
+		}
+	}
+	assignment, err := ctrl.cache.GetClusterShardAssignment()
+	if err != nil && !stderrors.Is(err, appstatecache.ErrCacheMiss) {
+		log.WithError(err).Warn("Failed to rebalance cluster shards: error while getting the shard assignment")
+		return
+	}
+
+	distribution := ctrl.clusterSharding.GetDistribution()
+	ctrl.clusterSharding.UpdateClusterLoads(loads, assignment)
+	if rebalanced := ctrl.clusterSharding.Rebalance(); rebalanced != nil && !maps.Equal(rebalanced, assignment) {
+		if err := ctrl.cache.SetClusterShardAssignment(rebalanced); err != nil {
+			log.WithError(err).Warn("Failed to publish the cluster shard assignment")
+		}
+	}
+	if maps.Equal(distribution, ctrl.clusterSharding.GetDistribution()) {
+		return
+	}
+
+	// refresh the applications of the clusters which have been moved to this shard
+	apps, err := ctrl.appLister.List(labels.Everything())
+	if err != nil {
+		log.WithError(err).Warn("Failed to refresh applications after rebalancing cluster shards")
+		return
+	}
+	for _, app := range apps {
+		if !ctrl.canProcessApp(app) {
+			continue
+		}
+		key, err := cache.MetaNamespaceKeyFunc(app)
+		if err == nil {
+			ctrl.appRefreshQueue.AddRateLimited(key)
+		}
+	}
+}
@@ -24,6 +24,9 @@ type ClusterShardingCache interface {
 	GetDistribution() map[string]int
 	GetAppDistribution() map[string]int
 	UpdateShard(shard int) bool
+	IsResourceWeighted() bool
+	UpdateClusterLoads(loads map[string]ClusterLoad, assignment map[string]int)
+	Rebalance() map[string]int
 }
 
 type ClusterSharding struct {
@@ -34,6 +37,13 @@ type ClusterSharding struct {
 	Apps            map[string]*v1alpha1.Application
 	lock            sync.RWMutex
 	getClusterShard DistributionFunction
+
+	shardingAlgorithm string
+	// loads holds the load of the clusters by server, used by the resource weighted sharding algorithm
+	loads map[string]ClusterLoad
+	// assignment holds the shard of the clusters by server agreed on by all shards, used by the resource weighted
+	// sharding algorithm
+	assignment map[string]int
 }
 
 func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm string) ClusterShardingCache {
@@ -44,12 +54,20 @@ func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm stri
 		Shards:   make(map[string]int),
 		Clusters: make(map[string]*v1alpha1.Cluster),
 		Apps:     make(map[string]*v1alpha1.Application),
+
+		shardingAlgorithm: shardingAlgorithm,
+		loads:             make(map[string]ClusterLoad),
+		assignment:        make(map[string]int),
 	}
 	distributionFunction := NoShardingDistributionFunction()
-	if replicas > 1 {
+	switch {
+	case replicas > 1 && shardingAlgorithm == common.ResourceWeightedShardingAlgorithm:
+		log.Debugf("Processing clusters from shard %d: Using filter function:  %s", shard, shardingAlgorithm)
+		distributionFunction = ResourceWeightedDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getLoadAccessor(), clusterSharding.getAssignmentAccessor(), replicas)
+	case replicas > 1:
 		log.Debugf("Processing clusters from shard %d: Using filter function:  %s", shard, shardingAlgorithm)
 		distributionFunction = GetDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), shardingAlgorithm, replicas)
-	} else {
+	default:
 		log.Info("Processing all cluster shards")
 	}
 	clusterSharding.getClusterShard = distributionFunction
@@ -206,6 +224,20 @@ func (sharding *ClusterSharding) getClusterAccessor() clusterAccessor {
 	}
 }
 
+// A read lock should be acquired before calling getLoadAccessor.
+func (sharding *ClusterSharding) getLoadAccessor() loadAccessor {
+	return func() map[string]ClusterLoad {
+		return sharding.loads
+	}
+}
+
+// A read lock should be acquired before calling getAssignmentAccessor.
+func (sharding *ClusterSharding) getAssignmentAccessor() assignmentAccessor {
+	return func() map[string]int {
+		return sharding.assignment
+	}
+}
+
 // A read lock should be acquired before calling getAppAccessor.
 func (sharding *ClusterSharding) getAppAccessor() appAccessor {
 	return func() []*v1alpha1.Application {
@@ -281,3 +313,47 @@ func (sharding *ClusterSharding) UpdateShard(shard int) bool {
 	}
 	return false
 }
+
+// UpdateClusterLoads updates the load of the clusters and the shard assignment agreed on by all shards, which are
+// used by the resource weighted sharding algorithm. Clusters missing from the given loads keep their last known load.
+func (sharding *ClusterSharding) UpdateClusterLoads(loads map[string]ClusterLoad, assignment map[string]int) {
+	sharding.lock.Lock()
+	defer sharding.lock.Unlock()
+	updated := make(map[string]ClusterLoad, len(sharding.Clusters))
+	for server := range sharding.Clusters {
+		if load, ok := loads[server]; ok {
+			updated[server] = load
+		} else if load, ok := sharding.loads[server]; ok {
+			updated[server] = load
+		}
+	}
+	sharding.loads = updated
+	sharding.assignment = maps.Clone(assignment)
+	if sharding.assignment == nil {
+		sharding.assignment = make(map[string]int)
+	}
+	if sharding.shardingAlgorithm == common.ResourceWeightedShardingAlgorithm {
+		sharding.updateDistribution()
+	}
+}
+
+// IsResourceWeighted returns whether the clusters are distributed by their load using the resource weighted sharding
+// algorithm, in which case the shard assignment has to be rebalanced periodically.
+func (sharding *ClusterSharding) IsResourceWeighted() bool {
+	sharding.lock.RLock()
+	defer sharding.lock.RUnlock()
+	return sharding.shardingAlgorithm == common.ResourceWeightedShardingAlgorithm && sharding.Replicas > 1
+}
+
+// Rebalance balances the clusters across the shards by their load and returns the new shard assignment, which has to
+// be published to all shards. The assignment is only computed by the first shard, so that it is published once, and it
+// is not applied until it is read back with UpdateClusterLoads, like on all other shards. It returns nil on the other
+// shards, and unless the resource weighted sharding algorithm is used.
+func (sharding *ClusterSharding) Rebalance() map[string]int {
+	sharding.lock.RLock()
+	defer sharding.lock.RUnlock()
+	if sharding.shardingAlgorithm != common.ResourceWeightedShardingAlgorithm || sharding.Replicas <= 1 || sharding.Shard != 0 {
+		return nil
+	}
+	return BalanceClusters(sharding.getClusterAccessor()(), sharding.loads, sharding.assignment, sharding.Replicas)
+}
@@ -0,0 +1,211 @@
+package sharding
+
+import (
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// eventsPerMinuteWeight is the weight of one watch event per minute relative to the weight of one cached resource
+	eventsPerMinuteWeight = 10
+	// rebalanceThreshold is the portion by which the weight of the busiest shard has to exceed the average weight per
+	// shard before clusters are moved to other shards. It prevents clusters from moving back and forth between shards
+	// when their load changes slightly.
+	rebalanceThreshold = 0.2
+)
+
+type (
+	loadAccessor       func() map[string]ClusterLoad
+	assignmentAccessor func() map[string]int
+)
+
+// ClusterLoad is the load a cluster puts on the application controller shard which manages it
+type ClusterLoad struct {
+	// ResourcesCount is the number of resources of the cluster held in the cluster cache
+	ResourcesCount int64
+	// EventsPerMinute is the rate of watch events received from the cluster
+	EventsPerMinute int64
+}
+
+// Weight returns the weight of the cluster which is balanced across the shards
+func (l ClusterLoad) Weight() int64 {
+	return l.ResourcesCount + eventsPerMinuteWeight*l.EventsPerMinute
+}
+
+// ClusterLoadFromInfo returns the load of a cluster from its cluster info. The load is only known once the cluster
+// cache has been synced.
+func ClusterLoadFromInfo(info *v1alpha1.ClusterInfo) (ClusterLoad, bool) {
+	if info == nil || info.CacheInfo.LastCacheSyncTime == nil {
+		return ClusterLoad{}, false
+	}
+	return ClusterLoad{
+		ResourcesCount:  info.CacheInfo.ResourcesCount,
+		EventsPerMinute: info.CacheInfo.EventsPerMinute,
+	}, true
+}
+
+// GetClusterWeights returns the weight of each of the given clusters by server. Clusters with an unknown load get the
+// average weight of the clusters with a known load, so that clusters which are not cached yet are spread across the
+// shards as well.
+func GetClusterWeights(clusters []*v1alpha1.Cluster, loads map[string]ClusterLoad) map[string]int64 {
+	weights := make(map[string]int64, len(clusters))
+	var known, total int64
+	for _, c := range clusters {
+		if load, ok := loads[c.Server]; ok {
+			weights[c.Server] = load.Weight()
+			total += load.Weight()
+			known++
+		}
+	}
+	defaultWeight := int64(1)
+	if known > 0 && total/known > 0 {
+		defaultWeight = total / known
+	}
+	for _, c := range clusters {
+		if _, ok := weights[c.Server]; !ok {
+			weights[c.Server] = defaultWeight
+		}
+	}
+	return weights
+}
+
+// ResourceWeightedDistributionFunction returns a DistributionFunction which balances the clusters across the shards by
+// their load, i.e. the number of cached resources and the rate of watch events. Clusters keep the shard of the given
+// assignment, which is rebalanced periodically using BalanceClusters. Clusters which are not assigned yet are placed on
+// the shard with the lowest load.
+func ResourceWeightedDistributionFunction(clusters clusterAccessor, loads loadAccessor, assignment assignmentAccessor, replicas int) DistributionFunction {
+	return func(c *v1alpha1.Cluster) int {
+		if replicas > 0 {
+			if c == nil { // in-cluster does not necessarily have a secret assigned. So we are receiving a nil cluster here.
+				return 0
+			}
+			// if Shard is manually set and the assigned value is lower than the number of replicas,
+			// then its value is returned otherwise it is the default calculated value
+			if c.Shard != nil && int(*c.Shard) < replicas {
+				return int(*c.Shard)
+			}
+			current := assignment()
+			if shard, ok := current[c.Server]; ok && shard >= 0 && shard < replicas {
+				return shard
+			}
+			clusterList := clusters()
+			shard, ok := placeClusters(clusterList, GetClusterWeights(clusterList, loads()), current, replicas)[c.Server]
+			if !ok {
+				log.Warnf("Cluster with server=%s not found in cluster map.", c.Server)
+				return -1
+			}
+			log.Debugf("Cluster with server=%s will be processed by shard %d", c.Server, shard)
+			return shard
+		}
+		log.Warnf("The number of replicas (%d) is lower than 1", replicas)
+		return -1
+	}
+}
+
+// BalanceClusters assigns the clusters to the shards so that their weight is distributed evenly, and returns the
+// shard of each cluster by server. Clusters keep their shard of the given assignment, and are only moved once the
+// weight of the busiest shard exceeds the average weight per shard by more than the rebalance threshold. Clusters
+// with a manually set shard are never moved. The result only depends on the arguments, so that all shards compute
+// the same assignment.
+func BalanceClusters(clusters []*v1alpha1.Cluster, loads map[string]ClusterLoad, assignment map[string]int, replicas int) map[string]int {
+	if replicas <= 0 {
+		return map[string]int{}
+	}
+	weights := GetClusterWeights(clusters, loads)
+	shards := placeClusters(clusters, weights, assignment, replicas)
+
+	sorted := sortedByServer(clusters)
+	shardWeights := make([]int64, replicas)
+	var total int64
+	for _, c := range sorted {
+		shardWeights[shards[c.Server]] += weights[c.Server]
+		total += weights[c.Server]
+	}
+	limit := float64(total) / float64(replicas) * (1 + rebalanceThreshold)
+
+	for range sorted {
+		busiest, idlest := 0, 0
+		for shard, weight := range shardWeights {
+			if weight > shardWeights[busiest] {
+				busiest = shard
+			}
+			if weight < shardWeights[idlest] {
+				idlest = shard
+			}
+		}
+		if float64(shardWeights[busiest]) <= limit {
+			break
+		}
+		// move the cluster which lowers the weight of the busier of both shards the most
+		var moved *v1alpha1.Cluster
+		best := shardWeights[busiest]
+		for _, c := range sorted {
+			if shards[c.Server] != busiest || isPinned(c, replicas) {
+				continue
+			}
+			weight := weights[c.Server]
+			if peak := max(shardWeights[busiest]-weight, shardWeights[idlest]+weight); peak < best {
+				best = peak
+				moved = c
+			}
+		}
+		if moved == nil {
+			break
+		}
+		log.Infof("Moving cluster %s from shard %d to shard %d to balance the shards load", moved.Server, busiest, idlest)
+		shards[moved.Server] = idlest
+		shardWeights[busiest] -= weights[moved.Server]
+		shardWeights[idlest] += weights[moved.Server]
+	}
+	return shards
+}
+
+// placeClusters returns the shard of each cluster by server. Clusters keep their shard of the given assignment, all
+// other clusters are placed on the shard with the lowest weight, starting with the heaviest cluster.
+func placeClusters(clusters []*v1alpha1.Cluster, weights map[string]int64, assignment map[string]int, replicas int) map[string]int {
+	shards := make(map[string]int, len(clusters))
+	shardWeights := make([]int64, replicas)
+	var unassigned []*v1alpha1.Cluster
+	for _, c := range sortedByServer(clusters) {
+		shard, ok := assignment[c.Server]
+		if isPinned(c, replicas) {
+			shard, ok = int(*c.Shard), true
+		}
+		if !ok || shard < 0 || shard >= replicas {
+			unassigned = append(unassigned, c)
+			continue
+		}
+		shards[c.Server] = shard
+		shardWeights[shard] += weights[c.Server]
+	}
+	sort.SliceStable(unassigned, func(i, j int) bool {
+		return weights[unassigned[i].Server] > weights[unassigned[j].Server]
+	})
+	for _, c := range unassigned {
+		idlest := 0
+		for shard, weight := range shardWeights {
+			if weight < shardWeights[idlest] {
+				idlest = shard
+			}
+		}
+		shards[c.Server] = idlest
+		shardWeights[idlest] += weights[c.Server]
+	}
+	return shards
+}
+
+func isPinned(c *v1alpha1.Cluster, replicas int) bool {
+	return c.Shard != nil && *c.Shard >= 0 && int(*c.Shard) < replicas
+}
+
+func sortedByServer(clusters []*v1alpha1.Cluster) []*v1alpha1.Cluster {
+	sorted := make([]*v1alpha1.Cluster, len(clusters))
+	copy(sorted, clusters)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Server < sorted[j].Server
+	})
+	return sorted
+}
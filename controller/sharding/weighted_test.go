@@ -0,0 +1,176 @@
+package sharding
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
+)
+
+func createWeightedTestClusters() []*v1alpha1.Cluster {
+	return []*v1alpha1.Cluster{
+		{Server: "https://cluster-d"},
+		{Server: "https://cluster-c"},
+		{Server: "https://cluster-b"},
+		{Server: "https://cluster-a"},
+	}
+}
+
+func createWeightedTestLoads() map[string]ClusterLoad {
+	return map[string]ClusterLoad{
+		"https://cluster-a": {ResourcesCount: 100},
+		"https://cluster-b": {ResourcesCount: 60},
+		"https://cluster-c": {ResourcesCount: 50},
+		"https://cluster-d": {ResourcesCount: 10},
+	}
+}
+
+func TestClusterLoadFromInfo(t *testing.T) {
+	t.Parallel()
+	_, ok := ClusterLoadFromInfo(&v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 10}})
+	assert.False(t, ok, "the load is unknown until the cluster cache has been synced")
+
+	syncTime := metav1.NewTime(time.Now())
+	load, ok := ClusterLoadFromInfo(&v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 10, EventsPerMinute: 3, LastCacheSyncTime: &syncTime}})
+	assert.True(t, ok)
+	assert.Equal(t, ClusterLoad{ResourcesCount: 10, EventsPerMinute: 3}, load)
+	assert.Equal(t, int64(40), load.Weight())
+}
+
+func TestGetClusterWeights_UnknownLoad(t *testing.T) {
+	t.Parallel()
+	clusters := createWeightedTestClusters()
+	weights := GetClusterWeights(clusters, map[string]ClusterLoad{
+		"https://cluster-a": {ResourcesCount: 100},
+		"https://cluster-b": {ResourcesCount: 20, EventsPerMinute: 2},
+	})
+	assert.Equal(t, map[string]int64{
+		"https://cluster-a": 100,
+		"https://cluster-b": 40,
+		"https://cluster-c": 70,
+		"https://cluster-d": 70,
+	}, weights)
+
+	weights = GetClusterWeights(clusters, nil)
+	assert.Equal(t, int64(1), weights["https://cluster-a"])
+}
+
+func TestBalanceClusters(t *testing.T) {
+	t.Parallel()
+	shards := BalanceClusters(createWeightedTestClusters(), createWeightedTestLoads(), nil, 2)
+	assert.Equal(t, map[string]int{
+		"https://cluster-a": 0,
+		"https://cluster-b": 1,
+		"https://cluster-c": 1,
+		"https://cluster-d": 0,
+	}, shards)
+
+	assert.Empty(t, BalanceClusters(createWeightedTestClusters(), createWeightedTestLoads(), nil, 0))
+}
+
+func TestBalanceClusters_Hysteresis(t *testing.T) {
+	t.Parallel()
+	clusters := createWeightedTestClusters()
+	assignment := map[string]int{
+		"https://cluster-a": 0,
+		"https://cluster-b": 1,
+		"https://cluster-c": 1,
+		"https://cluster-d": 0,
+	}
+
+	// shard 0 is 8% above the average load, the clusters are not moved
+	loads := createWeightedTestLoads()
+	loads["https://cluster-a"] = ClusterLoad{ResourcesCount: 120}
+	assert.Equal(t, assignment, BalanceClusters(clusters, loads, assignment, 2))
+
+	// shard 0 is 31% above the average load, the cluster lowering the load the most is moved
+	loads["https://cluster-a"] = ClusterLoad{ResourcesCount: 100, EventsPerMinute: 10}
+	assert.Equal(t, map[string]int{
+		"https://cluster-a": 0,
+		"https://cluster-b": 1,
+		"https://cluster-c": 1,
+		"https://cluster-d": 1,
+	}, BalanceClusters(clusters, loads, assignment, 2))
+}
+
+func TestBalanceClusters_PinnedShard(t *testing.T) {
+	t.Parallel()
+	clusters := createWeightedTestClusters()
+	for _, c := range clusters {
+		c.Shard = new(int64(0))
+	}
+	clusters[3].Shard = nil
+
+	shards := BalanceClusters(clusters, createWeightedTestLoads(), nil, 2)
+	assert.Equal(t, map[string]int{
+		"https://cluster-a": 1,
+		"https://cluster-b": 0,
+		"https://cluster-c": 0,
+		"https://cluster-d": 0,
+	}, shards)
+}
+
+func TestClusterSharding_Rebalance(t *testing.T) {
+	t.Parallel()
+	clusters := createWeightedTestClusters()
+	clusterList := &v1alpha1.ClusterList{}
+	for _, c := range clusters {
+		clusterList.Items = append(clusterList.Items, *c)
+	}
+	first := NewClusterSharding(&dbmocks.ArgoDB{}, 0, 2, common.ResourceWeightedShardingAlgorithm).(*ClusterSharding)
+	first.Init(clusterList, &v1alpha1.ApplicationList{})
+	second := NewClusterSharding(&dbmocks.ArgoDB{}, 1, 2, common.ResourceWeightedShardingAlgorithm).(*ClusterSharding)
+	second.Init(clusterList, &v1alpha1.ApplicationList{})
+	assert.True(t, first.IsResourceWeighted())
+
+	// all shards start from the published assignment
+	published := map[string]int{
+		"https://cluster-a": 0,
+		"https://cluster-b": 0,
+		"https://cluster-c": 1,
+		"https://cluster-d": 1,
+	}
+	first.UpdateClusterLoads(createWeightedTestLoads(), published)
+	second.UpdateClusterLoads(createWeightedTestLoads(), published)
+	assert.False(t, second.IsManagedCluster(clusters[2]))
+
+	// only the first shard rebalances the clusters
+	assert.Nil(t, second.Rebalance())
+	assignment := first.Rebalance()
+	assert.Equal(t, map[string]int{
+		"https://cluster-a": 0,
+		"https://cluster-b": 1,
+		"https://cluster-c": 1,
+		"https://cluster-d": 1,
+	}, assignment)
+
+	// the new assignment is applied by all shards once it is read back
+	assert.Equal(t, published, first.GetDistribution())
+	first.UpdateClusterLoads(createWeightedTestLoads(), assignment)
+	second.UpdateClusterLoads(createWeightedTestLoads(), assignment)
+	assert.Equal(t, assignment, first.GetDistribution())
+	assert.Equal(t, assignment, second.GetDistribution())
+	assert.True(t, second.IsManagedCluster(clusters[2]))
+
+	// clusters without a known load keep their last known load
+	second.UpdateClusterLoads(nil, assignment)
+	assert.Equal(t, createWeightedTestLoads(), second.loads)
+
+	// new clusters are placed on the shard with the lowest load
+	newCluster := &v1alpha1.Cluster{Server: "https://cluster-e"}
+	second.Add(newCluster)
+	assert.Equal(t, 0, second.GetDistribution()[newCluster.Server])
+}
+
+func TestClusterSharding_RebalanceOtherAlgorithm(t *testing.T) {
+	t.Parallel()
+	sharding := NewClusterSharding(&dbmocks.ArgoDB{}, 0, 2, common.RoundRobinShardingAlgorithm)
+	assert.False(t, sharding.IsResourceWeighted())
+	sharding.UpdateClusterLoads(createWeightedTestLoads(), nil)
+	assert.Nil(t, sharding.Rebalance())
+}
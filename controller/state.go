@@ -32,6 +32,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/argoproj/argo-cd/v3/common"
@@ -39,6 +40,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/controller/metrics"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
 	"github.com/argoproj/argo-cd/v3/util/app/path"
@@ -51,6 +53,7 @@ import (
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 	"github.com/argoproj/argo-cd/v3/util/stats"
+	"github.com/argoproj/argo-cd/v3/util/syncwindow"
 	traceutil "github.com/argoproj/argo-cd/v3/util/trace"
 )
 
@@ -134,6 +137,8 @@ type appStateManager struct {
 	db                    db.ArgoDB
 	settingsMgr           *settings.SettingsManager
 	appclientset          appclientset.Interface
+	appLister             applisters.ApplicationLister
+	appIndexer            cache.Indexer
 	kubectl               kubeutil.Kubectl
 	onKubectlRun          kubeutil.OnKubectlRunFunc
 	repoClientset         apiclient.Clientset
@@ -147,6 +152,8 @@ type appStateManager struct {
 	repoErrorGracePeriod  time.Duration
 	serverSideDiff        bool
 	ignoreNormalizerOpts  normalizers.IgnoreNormalizerOpts
+	progressingResources  *progressingResourcesTracker
+	calendarLoader        *syncwindow.CalendarLoader
 }
 
 // EvaluateAppRevisionsChanges checks if any source revisions have changes without generating manifests.
@@ -649,8 +656,12 @@ func (m *appStateManager) getComparisonSettings() (string, map[string]v1alpha1.R
 	return appLabelKey, resourceOverrides, resFilter, installationID, trackingMethod, nil
 }
 
-func isManagedNamespace(ns *unstructured.Unstructured, app *v1alpha1.Application) bool {
-	return ns != nil && ns.GetKind() == kubeutil.NamespaceKind && ns.GetName() == app.Spec.Destination.Namespace && app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.ManagedNamespaceMetadata != nil
+func isManagedNamespace(ns *unstructured.Unstructured, app *v1alpha1.Application, project *v1alpha1.AppProject) bool {
+	if ns == nil || ns.GetKind() != kubeutil.NamespaceKind || ns.GetName() != app.Spec.Destination.Namespace {
+		return false
+	}
+	return (app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.ManagedNamespaceMetadata != nil) ||
+		(createsNamespace(app) && project.Spec.NamespaceTemplate.HasMetadata())
 }
 
 // partitionTargetObjsForSync returns the manifest subset passed to gitops-engine sync, and whether
@@ -792,6 +803,19 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 		infoProvider = &resourceInfoProviderStub{}
 	}
 
+	if !failedToLoadObjs && createsNamespace(app) {
+		// the resources of the namespace template of the project are applied along with the namespace, so the ones of
+		// the application with the same name are not managed by the application
+		templateObjs, err := getNamespaceTemplateObjs(app.Spec.Destination.Namespace, project.Spec.NamespaceTemplate)
+		if err != nil {
+			msg := "Failed to load namespace template of the project: " + err.Error()
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+			failedToLoadObjs = true
+		} else {
+			targetObjs = removeNamespaceTemplateObjs(targetObjs, templateObjs, app.Spec.Destination.Namespace)
+		}
+	}
+
 	targetObjs, dedupConditions, err := NormalizeTargetObjects(app.Spec.Destination.Namespace, targetObjs, infoProvider, func(u *unstructured.Unstructured) error {
 		return m.resourceTracking.SetAppInstance(u, appLabelKey, app.InstanceName(m.namespace), app.Spec.Destination.Namespace, v1alpha1.TrackingMethod(trackingMethod), installationID)
 	})
@@ -816,12 +840,31 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 		// If we reach this path, this means that a namespace has been both defined in Git, as well in the
 		// application's managedNamespaceMetadata. We want to ensure that this manifest is the one being used instead
 		// of what is present in managedNamespaceMetadata.
-		if isManagedNamespace(targetObj, app) {
+		if isManagedNamespace(targetObj, app, project) {
 			targetNsExists = true
 		}
 	}
 	ts.AddCheckpoint("dedup_ms")
 
+	if !failedToLoadObjs {
+		// the target state is discarded when a quota is exceeded, so that the application is neither diffed nor synced
+		var msgs []string
+		violations, err := m.getProjectQuotaViolations(app, project, targetObjs, getManifestsSize(manifestInfos, localManifests))
+		if err != nil {
+			msgs = append(msgs, "Failed to check project quotas: "+err.Error())
+		}
+		for _, violation := range violations {
+			msgs = append(msgs, "Project quota exceeded: "+violation)
+		}
+		if len(msgs) > 0 {
+			targetObjs = make([]*unstructured.Unstructured, 0)
+			for _, msg := range msgs {
+				conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+			}
+			failedToLoadObjs = true
+		}
+	}
+
 	liveObjByKey, err := m.liveStateCache.GetManagedLiveObjs(destCluster, app, targetObjs)
 	if err != nil {
 		liveObjByKey = make(map[kubeutil.ResourceKey]*unstructured.Unstructured)
@@ -876,7 +919,7 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 			//
 			// targetNsExists == true implies that it already exists as a target, so no need to add the namespace to the
 			// targetObjs array.
-			if isManagedNamespace(liveObj, app) && !targetNsExists {
+			if isManagedNamespace(liveObj, app, project) && !targetNsExists {
 				nsSpec := &corev1.Namespace{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: kubeutil.NamespaceKind}, ObjectMeta: metav1.ObjectMeta{Name: liveObj.GetName()}}
 				managedNs, err := kubeutil.ToUnstructured(nsSpec)
 				if err != nil {
@@ -886,7 +929,7 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 				}
 
 				// No need to care about the return value here, we just want the modified managedNs
-				_, err = syncNamespace(app.Spec.SyncPolicy)(managedNs, liveObj)
+				_, err = syncNamespace(app.Spec.SyncPolicy, project.Spec.NamespaceTemplate)(managedNs, liveObj)
 				if err != nil {
 					conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
 					failedToLoadObjs = true
@@ -1034,7 +1077,7 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 		//
 		// This is in addition to the bookkeeping we do (see `isManagedNamespace` and its references) to prevent said
 		// namespace from being pruned.
-		isManagedNs := isManagedNamespace(targetObj, app) && liveObj == nil
+		isManagedNs := isManagedNamespace(targetObj, app, project) && liveObj == nil
 
 		switch {
 		case resState.Hook || ignore.Ignore(obj) || (targetObj != nil && hookutil.Skip(targetObj)) || !isSelfReferencedObj:
@@ -1058,7 +1101,7 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 		}
 		// set unknown status to all resource that are not permitted in the app project
 		isNamespaced, err := m.liveStateCache.IsNamespaced(destCluster, gvk.GroupKind())
-		if !project.IsGroupKindNamePermitted(gvk.GroupKind(), obj.GetName(), isNamespaced && err == nil) {
+		if !project.IsGroupKindNameLabelsPermitted(gvk.GroupKind(), obj.GetName(), obj.GetLabels(), isNamespaced && err == nil) {
 			resState.Status = v1alpha1.SyncStatusCodeUnknown
 		}
 
@@ -1107,7 +1150,7 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 
 	ts.AddCheckpoint("sync_ms")
 
-	healthStatus, healthMessage, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, app, m.persistResourceHealth)
+	healthStatus, healthMessage, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, app, m.persistResourceHealth, getProgressDeadline(app, project), m.progressingResources)
 	if err != nil {
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: "error setting app health: " + err.Error(), LastTransitionTime: &now})
 	}
@@ -1304,6 +1347,8 @@ func (m *appStateManager) persistRevisionHistory(
 func NewAppStateManager(
 	db db.ArgoDB,
 	appclientset appclientset.Interface,
+	appLister applisters.ApplicationLister,
+	appIndexer cache.Indexer,
 	repoClientset apiclient.Clientset,
 	namespace string,
 	kubectl kubeutil.Kubectl,
@@ -1318,12 +1363,15 @@ func NewAppStateManager(
 	repoErrorGracePeriod time.Duration,
 	serverSideDiff bool,
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts,
+	calendarLoader *syncwindow.CalendarLoader,
 ) AppStateManager {
 	return &appStateManager{
 		liveStateCache:        liveStateCache,
 		cache:                 cache,
 		db:                    db,
 		appclientset:          appclientset,
+		appLister:             appLister,
+		appIndexer:            appIndexer,
 		kubectl:               kubectl,
 		onKubectlRun:          onKubectlRun,
 		repoClientset:         repoClientset,
@@ -1336,6 +1384,8 @@ func NewAppStateManager(
 		repoErrorGracePeriod:  repoErrorGracePeriod,
 		serverSideDiff:        serverSideDiff,
 		ignoreNormalizerOpts:  ignoreNormalizerOpts,
+		progressingResources:  newProgressingResourcesTracker(),
+		calendarLoader:        calendarLoader,
 	}
 }
 
@@ -974,6 +974,49 @@ func TestCompareAppStateManagedNamespaceMetadataWithLiveNsDoesNotGetPruned(t *te
 	assert.Len(t, compRes.managedResources, 1)
 }
 
+func TestCompareAppStateProjectNamespaceTemplate(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"CreateNamespace=true"}}
+	proj := defaultProj.DeepCopy()
+	proj.Spec.NamespaceTemplate = &v1alpha1.ProjectNamespaceTemplate{
+		Labels:                   map[string]string{"team": "project-team"},
+		DefaultDenyNetworkPolicy: true,
+	}
+
+	ns := NewNamespace()
+	ns.SetName(test.FakeDestNamespace)
+	ns.SetNamespace(test.FakeDestNamespace)
+
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(ns): ns,
+		},
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+	compRes, err := ctrl.appStateManager.CompareAppState(t.Context(), app, proj, []string{}, app.Spec.Sources, false, false, nil, false)
+	require.NoError(t, err)
+	require.NotNil(t, compRes)
+	assert.Empty(t, app.Status.Conditions)
+
+	targets := map[string]*unstructured.Unstructured{}
+	for _, target := range compRes.reconciliationResult.Target {
+		if target != nil {
+			targets[target.GetKind()] = target
+		}
+	}
+	require.Len(t, targets, 1)
+	// the live namespace is managed with the labels of the project
+	assert.Equal(t, map[string]string{"team": "project-team"}, targets[kube.NamespaceKind].GetLabels())
+	// the network policy of the project is applied along with the namespace, not as a resource of the application
+	assert.NotContains(t, targets, "NetworkPolicy")
+}
+
 var defaultProj = v1alpha1.AppProject{
 	ObjectMeta: metav1.ObjectMeta{
 		Name:      "default",
@@ -39,6 +39,7 @@ import (
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
 	"github.com/argoproj/argo-cd/v3/util/lua"
 	"github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/argoproj/argo-cd/v3/util/syncwindow"
 )
 
 const (
@@ -188,7 +189,7 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		state.SyncResult = newSyncOperationResult(app, syncOp)
 	}
 
-	if isBlocked, err := syncWindowPreventsSync(app, project); isBlocked {
+	if isBlocked, err := syncWindowPreventsSync(m.calendarLoader, app, project); isBlocked {
 		// If the operation is currently running, simply let the user know the sync is blocked by a current sync window
 		if state.Phase == common.OperationRunning {
 			state.Message = "Sync operation blocked by sync window"
@@ -281,6 +282,23 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 			Images:      res.Images,
 			Order:       i + 1,
 		}
+		if res.StartedAt != nil {
+			initialResourcesRes[i].StartedAt = res.StartedAt.Time
+		}
+	}
+
+	timeouts := getSyncTimeouts(app, &syncOp)
+	operationTimeout, err := timeouts.OperationDuration()
+	if err != nil {
+		state.Phase = common.OperationError
+		state.Message = fmt.Sprintf("Invalid operation timeout: %v", err)
+		return
+	}
+	healthTimeout, err := timeouts.HealthDuration()
+	if err != nil {
+		state.Phase = common.OperationError
+		state.Message = fmt.Sprintf("Invalid health timeout: %v", err)
+		return
 	}
 
 	prunePropagationPolicy := metav1.DeletePropagationForeground
@@ -375,18 +393,43 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		}
 	}
 
+	// the resources of the namespace template of the project are applied along with the namespace it creates, outside of
+	// the resources of the application
+	var namespaceTemplateObjs []*unstructured.Unstructured
+	if syncOp.SyncOptions.HasOption("CreateNamespace=true") {
+		namespaceTemplateObjs, err = getNamespaceTemplateObjs(app.Spec.Destination.Namespace, project.Spec.NamespaceTemplate)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("failed to load the namespace template of the project: %v", err)
+			return
+		}
+	}
+	namespaceTemplateKeys := getNamespaceTemplateKeys(namespaceTemplateObjs)
+
+	// the live objects are validated as well, since a target object without the denied labels would modify them
+	liveObjs := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	for _, live := range reconciliationResult.Live {
+		if live != nil {
+			liveObjs[kube.GetResourceKey(live)] = live
+		}
+	}
+
 	opts := []sync.SyncOpt{
 		sync.WithLogr(logutils.NewLogrusLogger(logEntry)),
 		sync.WithHealthOverride(lua.ResourceHealthOverrides(resourceOverrides)),
 		sync.WithPermissionValidator(func(un *unstructured.Unstructured, res *metav1.APIResource) error {
+			// the resources of the namespace template are defined by the project itself
+			if namespaceTemplateKeys[kube.GetResourceKey(un)] {
+				return nil
+			}
 			return validateSyncPermissions(project, destCluster, func(proj string) ([]*v1alpha1.Cluster, error) {
 				return m.db.GetProjectClusters(ctx, proj)
-			}, un, res)
+			}, un, liveObjs[kube.GetResourceKey(un)], res)
 		}),
 		sync.WithOperationSettings(syncOp.DryRun, syncOp.Prune, syncOp.SyncStrategy.Force(), syncOp.IsApplyStrategy() || len(syncOp.Resources) > 0),
 		sync.WithInitialState(state.Phase, state.Message, initialResourcesRes, state.StartedAt),
 		sync.WithResourcesFilter(func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool {
-			return (len(syncOp.Resources) == 0 ||
+			return !namespaceTemplateKeys[key] && (len(syncOp.Resources) == 0 ||
 				isPostDeleteHook(target) ||
 				isPreDeleteHook(target) ||
 				argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)) &&
@@ -407,10 +450,15 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		sync.WithPruneConfirmed(app.IsDeletionConfirmed(state.StartedAt.Time)),
 		sync.WithDefaultPruneOption(syncOp.SyncOptions.GetOptionValue(common.SyncOptionPrune)),
 		sync.WithSkipDryRunOnMissingResource(syncOp.SyncOptions.HasOption(common.SyncOptionSkipDryRunOnMissingResource)),
+		sync.WithOperationTimeout(operationTimeout),
+		sync.WithHealthTimeout(healthTimeout),
 	}
 
 	if syncOp.SyncOptions.HasOption("CreateNamespace=true") {
-		opts = append(opts, sync.WithNamespaceModifier(syncNamespace(app.Spec.SyncPolicy)))
+		opts = append(opts,
+			sync.WithNamespaceModifier(syncNamespace(app.Spec.SyncPolicy, project.Spec.NamespaceTemplate)),
+			sync.WithNamespaceResources(namespaceTemplateObjs),
+		)
 	}
 
 	syncCtx, cleanup, err := sync.NewSyncContext(
@@ -463,7 +511,7 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 			res.Message = augmentedMsg
 		}
 
-		state.SyncResult.Resources = append(state.SyncResult.Resources, &v1alpha1.ResourceResult{
+		resourceResult := &v1alpha1.ResourceResult{
 			HookType:  res.HookType,
 			Group:     res.ResourceKey.Group,
 			Kind:      res.ResourceKey.Kind,
@@ -475,7 +523,11 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 			Status:    res.Status,
 			Message:   res.Message,
 			Images:    res.Images,
-		})
+		}
+		if !res.StartedAt.IsZero() {
+			resourceResult.StartedAt = &metav1.Time{Time: res.StartedAt}
+		}
+		state.SyncResult.Resources = append(state.SyncResult.Resources, resourceResult)
 	}
 
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
@@ -489,6 +541,24 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 	}
 }
 
+// getSyncTimeouts returns the timeouts of the given sync operation. The timeouts of the operation take precedence
+// over the ones of the application sync policy.
+func getSyncTimeouts(app *v1alpha1.Application, syncOp *v1alpha1.SyncOperation) *v1alpha1.SyncTimeouts {
+	if syncOp != nil && syncOp.Timeouts != nil {
+		return syncOp.Timeouts
+	}
+	if app.Spec.SyncPolicy != nil {
+		return app.Spec.SyncPolicy.Timeouts
+	}
+	return nil
+}
+
+// operationTimedOut returns whether the given operation exceeded the operation timeout
+func operationTimedOut(app *v1alpha1.Application, state *v1alpha1.OperationState) bool {
+	timeout, err := getSyncTimeouts(app, state.Operation.Sync).OperationDuration()
+	return err == nil && timeout > 0 && time.Since(state.StartedAt.Time) > timeout
+}
+
 // normalizeTargetResources modifies target resources to ensure ignored fields are not touched during synchronization:
 //   - applies normalization to the target resources based on the live resources
 //   - copies ignored fields from the matching live resources: apply normalizer to the live resource,
@@ -724,8 +794,12 @@ func delayBetweenSyncWaves(_ common.SyncPhase, _ int, finalWave bool) error {
 	return nil
 }
 
-func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject) (bool, error) {
-	window := proj.Spec.SyncWindows.Matches(app)
+func syncWindowPreventsSync(calendarLoader *syncwindow.CalendarLoader, app *v1alpha1.Application, proj *v1alpha1.AppProject) (bool, error) {
+	window, err := calendarLoader.Resolve(proj.Spec.SyncWindows.Matches(app))
+	if err != nil {
+		// prevents sync because the calendar of a sync window cannot be loaded
+		return true, err
+	}
 	isManual := false
 	var operationStartTime *time.Time
 	if app.Status.OperationState != nil {
@@ -743,23 +817,65 @@ func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject
 	return !canSync, nil
 }
 
+// holdUntilSyncWindow moves a sync operation which is blocked by the sync windows of the project to the PendingWindow
+// phase and schedules it for the next time a sync window allows it. An operation in the PendingWindow phase is moved
+// back to the Running phase as soon as a sync window allows it. Returns true if the operation has to wait.
+func holdUntilSyncWindow(calendarLoader *syncwindow.CalendarLoader, app *v1alpha1.Application, proj *v1alpha1.AppProject, state *v1alpha1.OperationState, now time.Time) bool {
+	windows, err := calendarLoader.Resolve(proj.Spec.SyncWindows.Matches(app))
+	if err != nil {
+		// sync windows which cannot be loaded block the sync, which is reported by SyncAppState
+		return false
+	}
+	isManual := !state.Operation.InitiatedBy.Automated
+	canSync, err := windows.CanSync(isManual, nil)
+	if err != nil {
+		// invalid sync windows block the sync, which is reported by SyncAppState
+		return false
+	}
+	if canSync {
+		if state.Phase == v1alpha1.OperationPendingWindow {
+			state.Phase = common.OperationRunning
+			state.Message = "Sync window opened, starting queued sync operation"
+			state.StartedAt = metav1.NewTime(now)
+		}
+		return false
+	}
+
+	state.Phase = v1alpha1.OperationPendingWindow
+	state.Message = "Sync operation queued until a sync window allows it"
+	state.ScheduledAt = nil
+	next, err := windows.NextSyncTime(isManual, now)
+	if err != nil {
+		state.Message = fmt.Sprintf("%s: %v", state.Message, err)
+	} else if next != nil {
+		state.ScheduledAt = &metav1.Time{Time: *next}
+		state.Message = fmt.Sprintf("Sync operation queued until the next sync window at %s", next.UTC().Format(time.RFC3339))
+	}
+	return true
+}
+
 // validateSyncPermissions checks whether the given resource is permitted by the project's
 // allow/deny lists and destination rules. It returns an error if the API resource info is nil
 // (preventing a nil-pointer panic), if the resource's group/kind is not permitted, or if
-// the resource's namespace is not an allowed destination.
+// the resource's namespace is not an allowed destination. The labels of both the resource
+// and its live object, if any, must be permitted.
 func validateSyncPermissions(
 	project *v1alpha1.AppProject,
 	destCluster *v1alpha1.Cluster,
 	getProjectClusters func(string) ([]*v1alpha1.Cluster, error),
 	un *unstructured.Unstructured,
+	live *unstructured.Unstructured,
 	res *metav1.APIResource,
 ) error {
 	if res == nil {
 		return fmt.Errorf("failed to get API resource info for %s/%s: unable to verify permissions", un.GroupVersionKind().Group, un.GroupVersionKind().Kind)
 	}
-	if !project.IsGroupKindNamePermitted(un.GroupVersionKind().GroupKind(), un.GetName(), res.Namespaced) {
+	if !project.IsGroupKindNameLabelsPermitted(un.GroupVersionKind().GroupKind(), un.GetName(), un.GetLabels(), res.Namespaced) {
 		return fmt.Errorf("resource %s:%s is not permitted in project %s", un.GroupVersionKind().Group, un.GroupVersionKind().Kind, project.Name)
 	}
+	if live != nil && !project.IsGroupKindNameLabelsPermitted(live.GroupVersionKind().GroupKind(), live.GetName(), live.GetLabels(), res.Namespaced) {
+		return fmt.Errorf("live resource %s:%s is not permitted in project %s", live.GroupVersionKind().Group, live.GroupVersionKind().Kind, project.Name)
+	}
 	if res.Namespaced {
 		permitted, err := project.IsDestinationPermitted(destCluster, un.GetNamespace(), getProjectClusters)
 		if err != nil {
@@ -0,0 +1,221 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// syncSlot identifies the destination cluster and project a sync operation counts against
+type syncSlot struct {
+	cluster string
+	project string
+}
+
+type waitingSyncOperation struct {
+	key   string
+	slot  syncSlot
+	since time.Time
+}
+
+// syncConcurrencyLimiter limits the number of sync operations running concurrently per destination cluster and per
+// project. Operations exceeding a limit wait in the order in which they asked for a slot. Operations hold their slot
+// from the moment they are admitted until they are released, so that an operation spanning several reconciliations
+// is counted for its whole duration.
+type syncConcurrencyLimiter struct {
+	projectLimit int
+
+	lock    sync.Mutex
+	running map[string]syncSlot
+	waiting []*waitingSyncOperation
+}
+
+func newSyncConcurrencyLimiter(projectLimit int) *syncConcurrencyLimiter {
+	return &syncConcurrencyLimiter{
+		projectLimit: projectLimit,
+		running:      map[string]syncSlot{},
+	}
+}
+
+// syncSlotResult is the outcome of asking for a sync slot
+type syncSlotResult struct {
+	// admitted is true if the operation may run
+	admitted bool
+	// waited is the time the operation waited for the slot, set once it is admitted after waiting
+	waited time.Duration
+	// message explains why the operation waits
+	message string
+}
+
+// track records an operation which already runs, e.g. because it was started before the controller restarted
+func (l *syncConcurrencyLimiter) track(key string, slot syncSlot) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.removeWaiting(key)
+	l.running[key] = slot
+}
+
+// acquire admits the operation of the given application if the cluster and the project of the slot have capacity left,
+// and otherwise queues it. Operations for which active returns false are dropped before capacity is computed, so that
+// slots of deleted applications or terminated operations are freed.
+func (l *syncConcurrencyLimiter) acquire(key string, slot syncSlot, clusterLimit int, active func(key string) bool, now time.Time) syncSlotResult {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if _, ok := l.running[key]; ok {
+		return syncSlotResult{admitted: true}
+	}
+	for runningKey := range l.running {
+		if runningKey != key && !active(runningKey) {
+			delete(l.running, runningKey)
+		}
+	}
+	waiting := l.waiting[:0]
+	var current *waitingSyncOperation
+	for _, op := range l.waiting {
+		switch {
+		case op.key == key:
+			op.slot = slot
+			current = op
+		case !active(op.key):
+			continue
+		}
+		waiting = append(waiting, op)
+	}
+	l.waiting = waiting
+	if current == nil {
+		current = &waitingSyncOperation{key: key, slot: slot, since: now}
+		l.waiting = append(l.waiting, current)
+	}
+
+	// operations which asked for a slot earlier are served first
+	clusterUsed, projectUsed := 0, 0
+	for _, running := range l.running {
+		if running.cluster == slot.cluster {
+			clusterUsed++
+		}
+		if running.project == slot.project {
+			projectUsed++
+		}
+	}
+	for _, op := range l.waiting {
+		if op == current {
+			break
+		}
+		if op.slot.cluster == slot.cluster {
+			clusterUsed++
+		}
+		if op.slot.project == slot.project {
+			projectUsed++
+		}
+	}
+
+	switch {
+	case clusterLimit > 0 && clusterUsed >= clusterLimit:
+		return syncSlotResult{message: fmt.Sprintf("Waiting for a free sync slot of cluster %s: %d operations running or queued ahead, limit is %d", slot.cluster, clusterUsed, clusterLimit)}
+	case l.projectLimit > 0 && projectUsed >= l.projectLimit:
+		return syncSlotResult{message: fmt.Sprintf("Waiting for a free sync slot of project %s: %d operations running or queued ahead, limit is %d", slot.project, projectUsed, l.projectLimit)}
+	}
+	l.removeWaiting(key)
+	l.running[key] = slot
+	return syncSlotResult{admitted: true, waited: now.Sub(current.since)}
+}
+
+// release frees the slot of the given application and returns the applications waiting for a slot of the same
+// cluster or project, which might be admitted now
+func (l *syncConcurrencyLimiter) release(key string) []string {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	slot, ok := l.running[key]
+	if !ok {
+		l.removeWaiting(key)
+		return nil
+	}
+	delete(l.running, key)
+	var next []string
+	for _, op := range l.waiting {
+		if op.slot.cluster == slot.cluster || op.slot.project == slot.project {
+			next = append(next, op.key)
+		}
+	}
+	return next
+}
+
+// queueDepth returns the number of operations waiting for a slot of the given cluster
+func (l *syncConcurrencyLimiter) queueDepth(cluster string) int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	depth := 0
+	for _, op := range l.waiting {
+		if op.slot.cluster == cluster {
+			depth++
+		}
+	}
+	return depth
+}
+
+func (l *syncConcurrencyLimiter) removeWaiting(key string) {
+	for i, op := range l.waiting {
+		if op.key == key {
+			l.waiting = append(l.waiting[:i], l.waiting[i+1:]...)
+			return
+		}
+	}
+}
+
+// waitForSyncSlot returns a message explaining why the sync operation of the given application waits for a free sync
+// slot of its destination cluster or project, or an empty string if the operation may run
+func (ctrl *ApplicationController) waitForSyncSlot(ctx context.Context, app *appv1.Application, state *appv1.OperationState) string {
+	destCluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, ctrl.db)
+	if err != nil {
+		// the invalid destination is reported by the sync
+		return ""
+	}
+	clusterLimit := ctrl.clusterSyncConcurrencyLimit
+	if destCluster.MaxConcurrentSyncs > 0 {
+		clusterLimit = int(destCluster.MaxConcurrentSyncs)
+	}
+	if clusterLimit <= 0 && ctrl.syncLimiter.projectLimit <= 0 {
+		return ""
+	}
+
+	key := ctrl.toAppKey(app.QualifiedName())
+	slot := syncSlot{cluster: destCluster.Server, project: app.Spec.GetProject()}
+	if state.SyncResult != nil {
+		// the operation was started before, e.g. before the controller restarted
+		ctrl.syncLimiter.track(key, slot)
+		return ""
+	}
+	result := ctrl.syncLimiter.acquire(key, slot, clusterLimit, ctrl.isOperationActive, time.Now())
+	ctrl.metricsServer.SetSyncQueueDepth(slot.cluster, ctrl.syncLimiter.queueDepth(slot.cluster))
+	if !result.admitted {
+		return result.message
+	}
+	if result.waited > 0 {
+		ctrl.metricsServer.ObserveSyncQueueWait(slot.cluster, result.waited)
+	}
+	return ""
+}
+
+// releaseSyncSlot frees the sync slot of the given application and requeues the operations waiting for it
+func (ctrl *ApplicationController) releaseSyncSlot(app *appv1.Application) {
+	for _, key := range ctrl.syncLimiter.release(ctrl.toAppKey(app.QualifiedName())) {
+		ctrl.appOperationQueue.Add(key)
+	}
+}
+
+// isOperationActive returns true if the application with the given key has a requested or in-progress operation
+func (ctrl *ApplicationController) isOperationActive(key string) bool {
+	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(key)
+	if err != nil || !exists {
+		return false
+	}
+	app, ok := obj.(*appv1.Application)
+	return ok && (app.Operation != nil || isOperationInProgress(app))
+}
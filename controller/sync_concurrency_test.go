@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncConcurrencyLimiter_ClusterLimit(t *testing.T) {
+	limiter := newSyncConcurrencyLimiter(0)
+	active := func(string) bool { return true }
+	now := time.Now()
+	slot := syncSlot{cluster: "https://cluster-1", project: "default"}
+
+	assert.True(t, limiter.acquire("argocd/app-1", slot, 2, active, now).admitted)
+	assert.True(t, limiter.acquire("argocd/app-2", slot, 2, active, now).admitted)
+	result := limiter.acquire("argocd/app-3", slot, 2, active, now)
+	assert.False(t, result.admitted)
+	assert.Equal(t, "Waiting for a free sync slot of cluster https://cluster-1: 2 operations running or queued ahead, limit is 2", result.message)
+	assert.Equal(t, 1, limiter.queueDepth("https://cluster-1"))
+
+	// other clusters are not affected
+	assert.True(t, limiter.acquire("argocd/app-4", syncSlot{cluster: "https://cluster-2", project: "default"}, 2, active, now).admitted)
+
+	// admitted operations keep their slot
+	assert.True(t, limiter.acquire("argocd/app-1", slot, 2, active, now).admitted)
+
+	assert.Equal(t, []string{"argocd/app-3"}, limiter.release("argocd/app-1"))
+	result = limiter.acquire("argocd/app-3", slot, 2, active, now.Add(time.Minute))
+	assert.True(t, result.admitted)
+	assert.Equal(t, time.Minute, result.waited)
+	assert.Equal(t, 0, limiter.queueDepth("https://cluster-1"))
+}
+
+func TestSyncConcurrencyLimiter_ProjectLimit(t *testing.T) {
+	limiter := newSyncConcurrencyLimiter(1)
+	active := func(string) bool { return true }
+	now := time.Now()
+
+	assert.True(t, limiter.acquire("argocd/app-1", syncSlot{cluster: "https://cluster-1", project: "team-a"}, 0, active, now).admitted)
+	result := limiter.acquire("argocd/app-2", syncSlot{cluster: "https://cluster-2", project: "team-a"}, 0, active, now)
+	assert.False(t, result.admitted)
+	assert.Equal(t, "Waiting for a free sync slot of project team-a: 1 operations running or queued ahead, limit is 1", result.message)
+	assert.True(t, limiter.acquire("argocd/app-3", syncSlot{cluster: "https://cluster-1", project: "team-b"}, 0, active, now).admitted)
+}
+
+func TestSyncConcurrencyLimiter_InOrder(t *testing.T) {
+	limiter := newSyncConcurrencyLimiter(0)
+	active := func(string) bool { return true }
+	now := time.Now()
+	slot := syncSlot{cluster: "https://cluster-1", project: "default"}
+
+	assert.True(t, limiter.acquire("argocd/app-1", slot, 1, active, now).admitted)
+	assert.False(t, limiter.acquire("argocd/app-2", slot, 1, active, now).admitted)
+	assert.False(t, limiter.acquire("argocd/app-3", slot, 1, active, now).admitted)
+
+	assert.Equal(t, []string{"argocd/app-2", "argocd/app-3"}, limiter.release("argocd/app-1"))
+	// app-3 asked later than app-2 and has to wait for it
+	result := limiter.acquire("argocd/app-3", slot, 1, active, now)
+	assert.False(t, result.admitted)
+	assert.Equal(t, "Waiting for a free sync slot of cluster https://cluster-1: 1 operations running or queued ahead, limit is 1", result.message)
+	assert.True(t, limiter.acquire("argocd/app-2", slot, 1, active, now).admitted)
+}
+
+func TestSyncConcurrencyLimiter_DropsInactiveOperations(t *testing.T) {
+	limiter := newSyncConcurrencyLimiter(0)
+	now := time.Now()
+	slot := syncSlot{cluster: "https://cluster-1", project: "default"}
+
+	limiter.track("argocd/deleted", slot)
+	assert.False(t, limiter.acquire("argocd/terminated", slot, 1, func(string) bool { return true }, now).admitted)
+
+	active := func(key string) bool { return key == "argocd/app" }
+	assert.True(t, limiter.acquire("argocd/app", slot, 1, active, now).admitted)
+	assert.Equal(t, 0, limiter.queueDepth("https://cluster-1"))
+	assert.Empty(t, limiter.release("argocd/app"))
+}
@@ -1,17 +1,28 @@
 package controller
 
 import (
+	"fmt"
 	"maps"
 
 	gitopscommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
+// namespaceTemplateResourceName is the name of the resources created from the namespace template of a project
+const namespaceTemplateResourceName = "argocd-project-defaults"
+
 // syncNamespace determine if Argo CD should create and/or manage the namespace
 // where the application will be deployed.
-func syncNamespace(syncPolicy *v1alpha1.SyncPolicy) func(m *unstructured.Unstructured, l *unstructured.Unstructured) (bool, error) {
+// The labels and annotations of the namespace template of the project take precedence over the ones of the
+// application's managedNamespaceMetadata.
+func syncNamespace(syncPolicy *v1alpha1.SyncPolicy, nsTemplate *v1alpha1.ProjectNamespaceTemplate) func(m *unstructured.Unstructured, l *unstructured.Unstructured) (bool, error) {
 	// This function must return true for the managed namespace to be synced.
 	return func(managedNs, liveNs *unstructured.Unstructured) (bool, error) {
 		if managedNs == nil {
@@ -19,7 +30,8 @@ func syncNamespace(syncPolicy *v1alpha1.SyncPolicy) func(m *unstructured.Unstruc
 		}
 
 		isNewNamespace := liveNs == nil
-		isManagedNamespace := syncPolicy != nil && syncPolicy.ManagedNamespaceMetadata != nil
+		hasManagedNamespaceMetadata := syncPolicy != nil && syncPolicy.ManagedNamespaceMetadata != nil
+		isManagedNamespace := hasManagedNamespaceMetadata || nsTemplate.HasMetadata()
 
 		// should only sync the namespace if it doesn't exist in k8s or if
 		// syncPolicy is defined to manage the metadata
@@ -28,7 +40,14 @@ func syncNamespace(syncPolicy *v1alpha1.SyncPolicy) func(m *unstructured.Unstruc
 		}
 
 		if isManagedNamespace {
-			managedNamespaceMetadata := syncPolicy.ManagedNamespaceMetadata
+			managedNamespaceMetadata := v1alpha1.ManagedNamespaceMetadata{}
+			if hasManagedNamespaceMetadata {
+				managedNamespaceMetadata = *syncPolicy.ManagedNamespaceMetadata
+			}
+			if nsTemplate != nil {
+				managedNamespaceMetadata.Labels = mergeNamespaceMetadata(managedNamespaceMetadata.Labels, nsTemplate.Labels)
+				managedNamespaceMetadata.Annotations = mergeNamespaceMetadata(managedNamespaceMetadata.Annotations, nsTemplate.Annotations)
+			}
 			managedNs.SetLabels(managedNamespaceMetadata.Labels)
 			// managedNamespaceMetadata relies on SSA in order to avoid overriding
 			// existing labels and annotations in namespaces
@@ -53,3 +72,95 @@ func appendSSAAnnotation(in map[string]string) map[string]string {
 	r[gitopscommon.AnnotationSyncOptions] = gitopscommon.SyncOptionServerSideApply
 	return r
 }
+
+// mergeNamespaceMetadata returns the labels or annotations of the application, overridden by the mandatory ones of
+// the project
+func mergeNamespaceMetadata(appMetadata map[string]string, projectMetadata map[string]string) map[string]string {
+	if len(projectMetadata) == 0 {
+		return appMetadata
+	}
+	r := map[string]string{}
+	maps.Copy(r, appMetadata)
+	maps.Copy(r, projectMetadata)
+	return r
+}
+
+// createsNamespace returns true if the application creates its destination namespace with the CreateNamespace=true
+// sync option
+func createsNamespace(app *v1alpha1.Application) bool {
+	return app.Spec.Destination.Namespace != "" && app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.SyncOptions.HasOption("CreateNamespace=true")
+}
+
+// getNamespaceTemplateObjs returns the ResourceQuota, LimitRange and NetworkPolicy defined by the namespace template of
+// the project, which are applied in the given namespace along with it when it is created by an application. They are
+// not part of the resources of the application.
+func getNamespaceTemplateObjs(namespace string, nsTemplate *v1alpha1.ProjectNamespaceTemplate) ([]*unstructured.Unstructured, error) {
+	if nsTemplate == nil || namespace == "" {
+		return nil, nil
+	}
+	objectMeta := metav1.ObjectMeta{Name: namespaceTemplateResourceName, Namespace: namespace}
+	var objs []runtime.Object
+	if nsTemplate.ResourceQuota != nil {
+		objs = append(objs, &corev1.ResourceQuota{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ResourceQuota"},
+			ObjectMeta: objectMeta,
+			Spec:       *nsTemplate.ResourceQuota,
+		})
+	}
+	if nsTemplate.LimitRange != nil {
+		objs = append(objs, &corev1.LimitRange{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "LimitRange"},
+			ObjectMeta: objectMeta,
+			Spec:       *nsTemplate.LimitRange,
+		})
+	}
+	if nsTemplate.DefaultDenyNetworkPolicy {
+		objs = append(objs, &networkingv1.NetworkPolicy{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "networking.k8s.io/v1", Kind: "NetworkPolicy"},
+			ObjectMeta: objectMeta,
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{},
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			},
+		})
+	}
+	var res []*unstructured.Unstructured
+	for _, obj := range objs {
+		un, err := kube.ToUnstructured(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert the namespace template resource to unstructured: %w", err)
+		}
+		res = append(res, un)
+	}
+	return res, nil
+}
+
+// getNamespaceTemplateKeys returns the keys of the given resources of the namespace template of the project
+func getNamespaceTemplateKeys(templateObjs []*unstructured.Unstructured) map[kube.ResourceKey]bool {
+	keys := make(map[kube.ResourceKey]bool, len(templateObjs))
+	for _, obj := range templateObjs {
+		keys[kube.GetResourceKey(obj)] = true
+	}
+	return keys
+}
+
+// removeNamespaceTemplateObjs removes the target objects of the application which have the same group, kind and name
+// in the application namespace as a resource of the namespace template of the project. The resources of the namespace
+// template are mandatory and applied along with the namespace, so they can't be managed by the application.
+func removeNamespaceTemplateObjs(targetObjs []*unstructured.Unstructured, templateObjs []*unstructured.Unstructured, namespace string) []*unstructured.Unstructured {
+	if len(templateObjs) == 0 {
+		return targetObjs
+	}
+	templateKeys := getNamespaceTemplateKeys(templateObjs)
+	res := make([]*unstructured.Unstructured, 0, len(targetObjs))
+	for _, obj := range targetObjs {
+		key := kube.GetResourceKey(obj)
+		if key.Namespace == "" {
+			key.Namespace = namespace
+		}
+		if !templateKeys[key] {
+			res = append(res, obj)
+		}
+	}
+	return res
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -248,7 +250,7 @@ func Test_shouldNamespaceSync(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			actual, err := syncNamespace(tt.syncPolicy)(tt.managedNs, tt.liveNs)
+			actual, err := syncNamespace(tt.syncPolicy, nil)(tt.managedNs, tt.liveNs)
 			require.NoError(t, err)
 
 			if tt.managedNs != nil {
@@ -260,3 +262,104 @@ func Test_shouldNamespaceSync(t *testing.T) {
 		})
 	}
 }
+
+func Test_syncNamespace_ProjectNamespaceTemplate(t *testing.T) {
+	nsTemplate := &v1alpha1.ProjectNamespaceTemplate{
+		Labels:      map[string]string{"team": "project-team"},
+		Annotations: map[string]string{"owner": "project-owner"},
+	}
+
+	t.Run("project metadata overrides the managed namespace metadata", func(t *testing.T) {
+		managedNs := createFakeNamespace("", "", nil, nil)
+		syncPolicy := &v1alpha1.SyncPolicy{
+			ManagedNamespaceMetadata: &v1alpha1.ManagedNamespaceMetadata{
+				Labels:      map[string]string{"team": "app-team", "app": "guestbook"},
+				Annotations: map[string]string{"owner": "app-owner"},
+			},
+		}
+		actual, err := syncNamespace(syncPolicy, nsTemplate)(managedNs, createFakeNamespace("something", "1", nil, nil))
+		require.NoError(t, err)
+		assert.True(t, actual)
+		assert.Equal(t, map[string]string{"team": "project-team", "app": "guestbook"}, managedNs.GetLabels())
+		assert.Equal(t, map[string]string{"owner": "project-owner", "argocd.argoproj.io/sync-options": "ServerSideApply=true"}, managedNs.GetAnnotations())
+		// the sync policy of the application is left untouched
+		assert.Equal(t, "app-team", syncPolicy.ManagedNamespaceMetadata.Labels["team"])
+	})
+
+	t.Run("project metadata is synced without managed namespace metadata", func(t *testing.T) {
+		managedNs := createFakeNamespace("", "", nil, nil)
+		actual, err := syncNamespace(nil, nsTemplate)(managedNs, createFakeNamespace("something", "1", nil, nil))
+		require.NoError(t, err)
+		assert.True(t, actual)
+		assert.Equal(t, map[string]string{"team": "project-team"}, managedNs.GetLabels())
+	})
+
+	t.Run("existing namespace is not synced without metadata", func(t *testing.T) {
+		managedNs := createFakeNamespace("", "", nil, nil)
+		actual, err := syncNamespace(nil, &v1alpha1.ProjectNamespaceTemplate{DefaultDenyNetworkPolicy: true})(managedNs, createFakeNamespace("something", "1", nil, nil))
+		require.NoError(t, err)
+		assert.False(t, actual)
+	})
+}
+
+func Test_getNamespaceTemplateObjs(t *testing.T) {
+	nsTemplate := &v1alpha1.ProjectNamespaceTemplate{
+		ResourceQuota: &corev1.ResourceQuotaSpec{
+			Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+		},
+		LimitRange: &corev1.LimitRangeSpec{
+			Limits: []corev1.LimitRangeItem{{Type: corev1.LimitTypeContainer, Default: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")}}},
+		},
+		DefaultDenyNetworkPolicy: true,
+	}
+
+	t.Run("namespace template", func(t *testing.T) {
+		objs, err := getNamespaceTemplateObjs("guestbook", nsTemplate)
+		require.NoError(t, err)
+		require.Len(t, objs, 3)
+		for i, kind := range []string{"ResourceQuota", "LimitRange", "NetworkPolicy"} {
+			assert.Equal(t, kind, objs[i].GetKind())
+			assert.Equal(t, namespaceTemplateResourceName, objs[i].GetName())
+			assert.Equal(t, "guestbook", objs[i].GetNamespace())
+		}
+		pods, _, err := unstructured.NestedString(objs[0].Object, "spec", "hard", "pods")
+		require.NoError(t, err)
+		assert.Equal(t, "10", pods)
+		policyTypes, _, err := unstructured.NestedStringSlice(objs[2].Object, "spec", "policyTypes")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Ingress", "Egress"}, policyTypes)
+	})
+
+	t.Run("no namespace", func(t *testing.T) {
+		objs, err := getNamespaceTemplateObjs("", nsTemplate)
+		require.NoError(t, err)
+		assert.Empty(t, objs)
+	})
+
+	t.Run("project has no namespace template", func(t *testing.T) {
+		objs, err := getNamespaceTemplateObjs("guestbook", nil)
+		require.NoError(t, err)
+		assert.Empty(t, objs)
+	})
+}
+
+func Test_removeNamespaceTemplateObjs(t *testing.T) {
+	newObj := func(kind string, name string, namespace string) *unstructured.Unstructured {
+		un := &unstructured.Unstructured{}
+		un.SetAPIVersion("v1")
+		un.SetKind(kind)
+		un.SetName(name)
+		un.SetNamespace(namespace)
+		return un
+	}
+	templateQuota := newObj("ResourceQuota", namespaceTemplateResourceName, "guestbook")
+	appQuota := newObj("ResourceQuota", namespaceTemplateResourceName, "")
+	otherQuota := newObj("ResourceQuota", "other", "guestbook")
+	pod := newObj("Pod", "pod", "guestbook")
+
+	res := removeNamespaceTemplateObjs([]*unstructured.Unstructured{pod, appQuota, otherQuota}, []*unstructured.Unstructured{templateQuota}, "guestbook")
+	assert.Equal(t, []*unstructured.Unstructured{pod, otherQuota}, res)
+
+	targetObjs := []*unstructured.Unstructured{pod}
+	assert.Equal(t, targetObjs, removeNamespaceTemplateObjs(targetObjs, nil, "guestbook"))
+}
@@ -4,6 +4,7 @@ import (
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	openapi_v2 "github.com/google/gnostic-models/openapiv2"
 	"k8s.io/kubectl/pkg/util/openapi"
@@ -16,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -133,6 +135,44 @@ func TestPersistManagedNamespaceMetadataState(t *testing.T) {
 	assert.Equal(t, app.Spec.SyncPolicy.ManagedNamespaceMetadata, opState.SyncResult.ManagedNamespaceMetadata)
 }
 
+func TestSyncAppStateNamespaceTemplate(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"CreateNamespace=true"}}
+	proj := defaultProj.DeepCopy()
+	proj.Spec.NamespaceTemplate = &v1alpha1.ProjectNamespaceTemplate{
+		ResourceQuota: &corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")}},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, proj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{SyncOptions: v1alpha1.SyncOptions{"CreateNamespace=true"}},
+	}}
+	ctrl.appStateManager.SyncAppState(t.Context(), app, proj, opState)
+	require.NotNil(t, opState.SyncResult, opState.Message)
+	var quota *v1alpha1.ResourceResult
+	for _, res := range opState.SyncResult.Resources {
+		if res.Kind == "ResourceQuota" {
+			quota = res
+		}
+	}
+	require.NotNil(t, quota, "the ResourceQuota of the namespace template is applied")
+	assert.Equal(t, namespaceTemplateResourceName, quota.Name)
+	assert.Equal(t, test.FakeDestNamespace, quota.Namespace)
+	assert.Equal(t, synccommon.SyncPhasePreSync, string(quota.SyncPhase))
+}
+
 func TestPersistRevisionHistoryRollback(t *testing.T) {
 	app := newFakeApp()
 	app.Status.OperationState = nil
@@ -2365,7 +2405,7 @@ func TestValidateSyncPermissions(t *testing.T) {
 		t.Parallel()
 		un := newResource("apps", "Deployment", "my-deploy", "default")
 
-		err := validateSyncPermissions(project, destCluster, noopGetClusters, un, nil)
+		err := validateSyncPermissions(project, destCluster, noopGetClusters, un, nil, nil)
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to get API resource info for apps/Deployment")
@@ -2377,7 +2417,7 @@ func TestValidateSyncPermissions(t *testing.T) {
 		un := newResource("", "ConfigMap", "my-cm", "default")
 		res := &metav1.APIResource{Name: "configmaps", Namespaced: true}
 
-		err := validateSyncPermissions(project, destCluster, noopGetClusters, un, res)
+		err := validateSyncPermissions(project, destCluster, noopGetClusters, un, nil, res)
 
 		assert.NoError(t, err)
 	})
@@ -2401,18 +2441,52 @@ func TestValidateSyncPermissions(t *testing.T) {
 		un := newResource("rbac.authorization.k8s.io", "ClusterRole", "my-role", "")
 		res := &metav1.APIResource{Name: "clusterroles", Namespaced: false}
 
-		err := validateSyncPermissions(projectWithDenyList, destCluster, noopGetClusters, un, res)
+		err := validateSyncPermissions(projectWithDenyList, destCluster, noopGetClusters, un, nil, res)
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "is not permitted in project")
 	})
 
+	t.Run("namespaced resource denied by name or labels returns error", func(t *testing.T) {
+		t.Parallel()
+		projectWithNamespacedDenyList := project.DeepCopy()
+		projectWithNamespacedDenyList.Spec.NamespaceResourceBlacklist = []v1alpha1.NamespaceResourceRestrictionItem{
+			{Kind: "ConfigMap", Name: "aws-auth"},
+			{Kind: "Secret", LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"protected": "true"}}},
+		}
+		res := &metav1.APIResource{Name: "configmaps", Namespaced: true}
+
+		err := validateSyncPermissions(projectWithNamespacedDenyList, destCluster, noopGetClusters, newResource("", "ConfigMap", "aws-auth", "default"), nil, res)
+		require.ErrorContains(t, err, "is not permitted in project")
+
+		secret := newResource("", "Secret", "my-secret", "default")
+		require.NoError(t, validateSyncPermissions(projectWithNamespacedDenyList, destCluster, noopGetClusters, secret, nil, res))
+		secret.SetLabels(map[string]string{"protected": "true"})
+		require.ErrorContains(t, validateSyncPermissions(projectWithNamespacedDenyList, destCluster, noopGetClusters, secret, nil, res), "is not permitted in project")
+	})
+
+	t.Run("live resource denied by labels returns error", func(t *testing.T) {
+		t.Parallel()
+		projectWithNamespacedDenyList := project.DeepCopy()
+		projectWithNamespacedDenyList.Spec.NamespaceResourceBlacklist = []v1alpha1.NamespaceResourceRestrictionItem{
+			{Kind: "Secret", LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"protected": "true"}}},
+		}
+		res := &metav1.APIResource{Name: "secrets", Namespaced: true}
+
+		// the target object drops the label which protects the live object
+		target := newResource("", "Secret", "my-secret", "default")
+		live := newResource("", "Secret", "my-secret", "default")
+		live.SetLabels(map[string]string{"protected": "true"})
+		require.NoError(t, validateSyncPermissions(projectWithNamespacedDenyList, destCluster, noopGetClusters, target, nil, res))
+		require.ErrorContains(t, validateSyncPermissions(projectWithNamespacedDenyList, destCluster, noopGetClusters, target, live, res), "is not permitted in project")
+	})
+
 	t.Run("namespace not permitted returns error", func(t *testing.T) {
 		t.Parallel()
 		un := newResource("", "ConfigMap", "my-cm", "kube-system")
 		res := &metav1.APIResource{Name: "configmaps", Namespaced: true}
 
-		err := validateSyncPermissions(project, destCluster, noopGetClusters, un, res)
+		err := validateSyncPermissions(project, destCluster, noopGetClusters, un, nil, res)
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "namespace kube-system is not permitted in project")
@@ -2437,8 +2511,26 @@ func TestValidateSyncPermissions(t *testing.T) {
 		un := newResource("", "Namespace", "my-ns", "")
 		res := &metav1.APIResource{Name: "namespaces", Namespaced: false}
 
-		err := validateSyncPermissions(projectWithClusterResources, destCluster, noopGetClusters, un, res)
+		err := validateSyncPermissions(projectWithClusterResources, destCluster, noopGetClusters, un, nil, res)
 
 		assert.NoError(t, err)
 	})
 }
+
+func TestOperationTimedOut(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Timeouts = &v1alpha1.SyncTimeouts{Operation: "30m"}
+	state := &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}},
+		StartedAt: metav1.NewTime(time.Now().Add(-time.Hour)),
+	}
+	assert.True(t, operationTimedOut(app, state))
+
+	// the timeouts of the operation take precedence over the ones of the sync policy
+	state.Operation.Sync.Timeouts = &v1alpha1.SyncTimeouts{Operation: "2h"}
+	assert.False(t, operationTimedOut(app, state))
+
+	state.Operation.Sync.Timeouts = nil
+	app.Spec.SyncPolicy.Timeouts = nil
+	assert.False(t, operationTimedOut(app, state))
+}
@@ -30,6 +30,7 @@ import (
 	"runtime/debug"
 	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -129,6 +130,8 @@ type ClusterInfo struct {
 	SyncError error
 	// APIResources holds list of API resources supported by the cluster
 	APIResources []kube.APIResourceInfo
+	// EventsCount holds number of watch events received since the cache was created
+	EventsCount int64
 }
 
 // OnEventHandler is a function that handles Kubernetes event
@@ -280,6 +283,9 @@ type clusterCache struct {
 
 	respectRBAC int
 
+	// eventsCount is the number of watch events received since the cache was created
+	eventsCount atomic.Int64
+
 	// Parent-to-children index for O(1) child lookup during hierarchy traversal
 	// Maps any resource's UID to a set of its direct children's ResourceKeys
 	// Using a set eliminates O(k) duplicate checking on insertions
@@ -1636,6 +1642,7 @@ func (c *clusterCache) GetManagedLiveObjs(targetObjs []*unstructured.Unstructure
 }
 
 func (c *clusterCache) recordEvent(event watch.EventType, un *unstructured.Unstructured) {
+	c.eventsCount.Add(1)
 	for _, h := range c.getEventHandlers() {
 		h(event, un)
 	}
@@ -1778,6 +1785,7 @@ func (c *clusterCache) GetClusterInfo() ClusterInfo {
 		LastCacheSyncTime: c.syncStatus.syncTime,
 		SyncError:         c.syncStatus.syncError,
 		APIResources:      c.apiResources,
+		EventsCount:       c.eventsCount.Load(),
 	}
 }
 
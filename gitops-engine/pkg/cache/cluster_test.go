@@ -1093,6 +1093,10 @@ func TestGetClusterInfo(t *testing.T) {
 		APIResources: cluster.apiResources,
 		K8SVersion:   cluster.serverVersion,
 	}, info)
+
+	cluster.recordEvent(watch.Added, mustToUnstructured(testPod1()))
+	cluster.recordEvent(watch.Modified, mustToUnstructured(testPod1()))
+	assert.Equal(t, int64(2), cluster.GetClusterInfo().EventsCount)
 }
 
 func TestDeleteAPIResource(t *testing.T) {
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -17,6 +19,8 @@ const (
 	// AnnotationKeyHookDeletePolicy is the policy of deleting a hook
 	AnnotationKeyHookDeletePolicy = "argocd.argoproj.io/hook-delete-policy"
 	AnnotationDeletionApproved    = "argocd.argoproj.io/deletion-approved"
+	// AnnotationKeyHookTimeout is the maximum duration a hook is allowed to run before it is considered failed
+	AnnotationKeyHookTimeout = "argocd.argoproj.io/hook-timeout"
 	// AnnotationIgnoreRestartPolicy makes health assessment of Pods with restartPolicy
 	// Never/OnFailure treat them as long-running pods (ignored for hook Pods)
 	AnnotationIgnoreRestartPolicy = "argocd.argoproj.io/ignore-restart-policy"
@@ -169,4 +173,6 @@ type ResourceSyncResult struct {
 	HookPhase OperationPhase
 	// indicates the particular phase of the sync that this is for
 	SyncPhase SyncPhase
+	// the time the resource was first applied or the hook was created during the operation
+	StartedAt time.Time
 }
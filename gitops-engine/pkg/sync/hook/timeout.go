@@ -0,0 +1,32 @@
+package hook
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+)
+
+// Timeout returns the maximum duration the hook is allowed to run, as configured by the hook-timeout annotation.
+// The value is either a duration string (e.g. "5m") or a number of seconds. Zero is returned if the annotation is not
+// set or invalid.
+func Timeout(obj *unstructured.Unstructured) time.Duration {
+	text := strings.TrimSpace(obj.GetAnnotations()[common.AnnotationKeyHookTimeout])
+	if text == "" {
+		return 0
+	}
+	if seconds, err := strconv.ParseInt(text, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	timeout, err := time.ParseDuration(text)
+	if err != nil || timeout < 0 {
+		return 0
+	}
+	return timeout
+}
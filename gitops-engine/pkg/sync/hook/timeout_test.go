@@ -0,0 +1,19 @@
+package hook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	testingutils "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/testing"
+)
+
+func TestTimeout(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, time.Duration(0), Timeout(testingutils.NewPod()))
+	assert.Equal(t, time.Duration(0), Timeout(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/hook-timeout", "garbage")))
+	assert.Equal(t, time.Duration(0), Timeout(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/hook-timeout", "-5m")))
+	assert.Equal(t, 90*time.Second, Timeout(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/hook-timeout", "90")))
+	assert.Equal(t, 5*time.Minute, Timeout(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/hook-timeout", "5m")))
+}
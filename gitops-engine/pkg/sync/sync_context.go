@@ -192,6 +192,14 @@ func WithNamespaceModifier(namespaceModifier func(*unstructured.Unstructured, *u
 	}
 }
 
+// WithNamespaceResources sets resources which are applied in the namespace created or managed by the namespace
+// modifier, along with the namespace. They are not part of the resources being synced, so they are never pruned.
+func WithNamespaceResources(resources []*unstructured.Unstructured) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.namespaceResources = resources
+	}
+}
+
 // WithLogr sets the logger to use.
 func WithLogr(log logr.Logger) SyncOpt {
 	return func(ctx *syncContext) {
@@ -242,6 +250,22 @@ func WithClientSideApplyMigration(enabled bool, manager string) SyncOpt {
 	}
 }
 
+// WithOperationTimeout sets the maximum duration of the sync operation. Once it is exceeded, running hooks are
+// terminated, the operation fails and the SyncFail hooks are executed.
+func WithOperationTimeout(timeout time.Duration) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.operationTimeout = timeout
+	}
+}
+
+// WithHealthTimeout sets the maximum duration the resources of a wave are allowed to take to become healthy after the
+// wave was applied. Once it is exceeded, the operation fails and the SyncFail hooks are executed.
+func WithHealthTimeout(timeout time.Duration) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.healthTimeout = timeout
+	}
+}
+
 // NewSyncContext creates new instance of a SyncContext
 func NewSyncContext(
 	revision string,
@@ -415,6 +439,8 @@ type syncContext struct {
 	defaultPruneOption              *string
 	clientSideApplyMigrationManager string
 	enableClientSideApplyMigration  bool
+	operationTimeout                time.Duration
+	healthTimeout                   time.Duration
 
 	syncRes   map[string]common.ResourceSyncResult
 	startedAt time.Time
@@ -429,6 +455,8 @@ type syncContext struct {
 	// syncNamespace is a function that will determine if the managed
 	// namespace should be synced
 	syncNamespace func(*unstructured.Unstructured, *unstructured.Unstructured) (bool, error)
+	// namespaceResources are the resources applied in the namespace managed by syncNamespace
+	namespaceResources []*unstructured.Unstructured
 
 	syncWaveHook common.SyncWaveHook
 
@@ -588,6 +616,42 @@ func (sc *syncContext) Sync(ctx context.Context) {
 		}
 	}
 
+	// fail hooks which have been running for longer than their timeout
+	for _, task := range tasks.Filter(func(t *syncTask) bool { return t.isHook() && t.running() }) {
+		if timeout := task.hookTimeout(); timeout > 0 && sc.runningFor(task) > timeout {
+			sc.terminateTimedOutTask(ctx, task, fmt.Sprintf("hook timed out after %s", timeout))
+		}
+	}
+
+	// fail the resources of the running wave if it did not become healthy within the health timeout
+	if waveTasks := sc.runningWaveTasks(tasks); sc.healthTimeout > 0 && waveTasks.Len() > 0 {
+		var waveStartedAt time.Time
+		for _, task := range waveTasks {
+			if startedAt := sc.syncRes[task.resultKey()].StartedAt; !startedAt.IsZero() && (waveStartedAt.IsZero() || startedAt.Before(waveStartedAt)) {
+				waveStartedAt = startedAt
+			}
+		}
+		if !waveStartedAt.IsZero() && time.Since(waveStartedAt) > sc.healthTimeout {
+			message := fmt.Sprintf("timed out waiting for healthy state of wave %d after %s", waveTasks.wave(), sc.healthTimeout)
+			for _, task := range waveTasks.Filter(func(t *syncTask) bool { return t.running() }) {
+				sc.terminateTimedOutTask(ctx, task, message)
+			}
+		}
+	}
+
+	if sc.operationTimeout > 0 && time.Since(sc.startedAt) > sc.operationTimeout {
+		message := fmt.Sprintf("operation timed out after %s", sc.operationTimeout)
+		syncFailTasks, tasks := tasks.Split(func(t *syncTask) bool { return t.phase == common.SyncPhaseSyncFail })
+		for _, task := range tasks.Filter(func(t *syncTask) bool { return t.running() && !t.isPrune() }) {
+			sc.terminateTimedOutTask(ctx, task, message)
+		}
+		syncFailedTasks := tasks.Filter(func(t *syncTask) bool {
+			return t.syncStatus == common.ResultCodeSyncFailed && t.message != message
+		})
+		sc.executeSyncFailPhase(ctx, syncFailTasks, syncFailedTasks, message)
+		return
+	}
+
 	// if (a) we are multi-step and we have any running tasks,
 	// or (b) there are any running hooks,
 	// then wait...
@@ -866,6 +930,44 @@ func (sc *syncContext) terminateHooksPreemptively(ctx context.Context, tasks syn
 	return terminateSuccessful
 }
 
+// terminateTimedOutTask fails a task which has been running for longer than its timeout. Running hooks are terminated
+// preemptively, unless they completed in the meantime.
+func (sc *syncContext) terminateTimedOutTask(ctx context.Context, task *syncTask, message string) {
+	sc.log.WithValues("task", task).Info(message)
+	if task.isHook() {
+		sc.terminateHooksPreemptively(ctx, syncTasks{task})
+		if task.completed() && task.message != "Terminated" {
+			return
+		}
+	}
+	sc.setResourceResult(task, common.ResultCodeSyncFailed, common.OperationFailed, message)
+}
+
+// runningFor returns for how long the task has been running, measured from the time it was first applied during the
+// operation or, for hooks, from the time the hook was created
+func (sc *syncContext) runningFor(task *syncTask) time.Duration {
+	startedAt := sc.syncRes[task.resultKey()].StartedAt
+	if startedAt.IsZero() && task.isHook() && task.liveObj != nil {
+		startedAt = task.liveObj.GetCreationTimestamp().Time
+	}
+	if startedAt.IsZero() {
+		return 0
+	}
+	return time.Since(startedAt)
+}
+
+// runningWaveTasks returns the resources, excluding hooks and prunes, of the phase and wave of the first running
+// resource. Waves are applied one after the other, so these are the resources of the wave which is being waited for.
+func (sc *syncContext) runningWaveTasks(tasks syncTasks) syncTasks {
+	running := tasks.Find(func(t *syncTask) bool { return !t.isHook() && !t.isPrune() && t.running() })
+	if running == nil {
+		return nil
+	}
+	return tasks.Filter(func(t *syncTask) bool {
+		return !t.isHook() && !t.isPrune() && t.phase == running.phase && t.wave() == running.wave()
+	})
+}
+
 func (sc *syncContext) removeHookFinalizer(ctx context.Context, task *syncTask) error {
 	if task.liveObj == nil {
 		return nil
@@ -1224,6 +1326,34 @@ func (sc *syncContext) autoCreateNamespace(ctx context.Context, tasks syncTasks)
 			sc.setOperationPhase(common.OperationFailed, fmt.Sprintf("namespace auto creation failed: %s", err))
 		}
 	}
+	return sc.appendNamespaceResourceTasks(ctx, tasks)
+}
+
+// appendNamespaceResourceTasks appends the tasks applying the resources of the managed namespace. They are applied in
+// the phase and wave of the namespace, after it since namespaces are applied first, or in the PreSync phase if the
+// namespace is not applied.
+func (sc *syncContext) appendNamespaceResourceTasks(ctx context.Context, tasks syncTasks) syncTasks {
+	phase, wave := common.SyncPhase(common.SyncPhasePreSync), 0
+	for _, task := range tasks {
+		if isNamespaceWithName(task.targetObj, sc.namespace) {
+			phase, wave = task.phase, task.wave()
+			break
+		}
+	}
+	for _, res := range sc.namespaceResources {
+		targetObj := res.DeepCopy()
+		targetObj.SetNamespace(sc.namespace)
+		liveObj, err := sc.kubectl.GetResource(ctx, sc.config, targetObj.GroupVersionKind(), targetObj.GetName(), sc.namespace)
+		switch {
+		case err == nil:
+		case apierrors.IsNotFound(err):
+			liveObj = nil
+		default:
+			tasks = sc.appendFailedNsTask(tasks, targetObj, fmt.Errorf("failed to get the resource of the namespace: %w", err))
+			continue
+		}
+		tasks = append(tasks, &syncTask{phase: phase, targetObj: targetObj, liveObj: liveObj, waveOverride: &wave})
+	}
 	return tasks
 }
 
@@ -1823,6 +1953,7 @@ func (sc *syncContext) setResourceResult(task *syncTask, syncStatus common.Resul
 	} else {
 		logCtx.Info(fmt.Sprintf("Adding resource result, status: '%s', phase: '%s', message: '%s'", res.Status, res.HookPhase, res.Message))
 		res.Order = len(sc.syncRes) + 1
+		res.StartedAt = time.Now()
 		sc.syncRes[task.resultKey()] = res
 	}
 }
@@ -1515,6 +1515,51 @@ func TestNamespaceAutoCreation(t *testing.T) {
 	})
 }
 
+func TestNamespaceAutoCreationWithNamespaceResources(t *testing.T) {
+	defaults := testingutils.Unstructured(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "defaults"}}`)
+	getResourceFunc := func(_ context.Context, _ *rest.Config, gvk schema.GroupVersionKind, name string, _ string) (*unstructured.Unstructured, error) {
+		if gvk.Kind == "ConfigMap" {
+			return defaults, nil
+		}
+		return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+	}
+	pod := testingutils.NewPod()
+	syncCtx := newTestSyncCtx(&getResourceFunc, WithNamespaceResources([]*unstructured.Unstructured{defaults}))
+	syncCtx.namespace = testingutils.FakeArgoCDNamespace
+	syncCtx.syncNamespace = func(_, _ *unstructured.Unstructured) (bool, error) {
+		return true, nil
+	}
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil},
+		Target: []*unstructured.Unstructured{pod},
+	})
+
+	tasks, successful := syncCtx.getSyncTasks(context.Background())
+	assert.True(t, successful)
+	require.Len(t, tasks, 3)
+	defaultsTasks := tasks.Filter(func(t *syncTask) bool { return t.kind() == "ConfigMap" })
+	require.Len(t, defaultsTasks, 1)
+	assert.Equal(t, synccommon.SyncPhase(synccommon.SyncPhasePreSync), defaultsTasks[0].phase)
+	assert.Equal(t, testingutils.FakeArgoCDNamespace, defaultsTasks[0].targetObj.GetNamespace())
+	assert.Equal(t, defaults, defaultsTasks[0].liveObj)
+
+	// the resources of the namespace are applied in the phase and wave of the namespace if it is one of the resources
+	namespace := testingutils.NewNamespace()
+	namespace.SetName(testingutils.FakeArgoCDNamespace)
+	namespace.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: "-1"})
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil},
+		Target: []*unstructured.Unstructured{namespace},
+	})
+	tasks, successful = syncCtx.getSyncTasks(context.Background())
+	assert.True(t, successful)
+	require.Len(t, tasks, 2)
+	defaultsTasks = tasks.Filter(func(t *syncTask) bool { return t.kind() == "ConfigMap" })
+	require.Len(t, defaultsTasks, 1)
+	assert.Equal(t, synccommon.SyncPhase(synccommon.SyncPhaseSync), defaultsTasks[0].phase)
+	assert.Equal(t, -1, defaultsTasks[0].wave())
+}
+
 func TestNamespaceAutoCreationForNonExistingNs(t *testing.T) {
 	getResourceFunc := func(_ context.Context, _ *rest.Config, _ schema.GroupVersionKind, _ string, _ string) (*unstructured.Unstructured, error) {
 		return nil, apierrors.NewNotFound(schema.GroupResource{}, testingutils.FakeArgoCDNamespace)
@@ -3329,3 +3374,162 @@ func TestTerminate_Hooks_Error(t *testing.T) {
 	assert.Equal(t, synccommon.OperationError, results[0].HookPhase)
 	assert.Contains(t, results[0].Message, "update failed")
 }
+
+func TestSync_HookTimeout(t *testing.T) {
+	hook1 := testingutils.Annotate(newHook("hook-1", synccommon.HookTypePreSync, synccommon.HookDeletePolicyBeforeHookCreation), synccommon.AnnotationKeyHookTimeout, "1m")
+	hook2 := newHook("hook-2", synccommon.HookTypePreSync, synccommon.HookDeletePolicyBeforeHookCreation)
+
+	syncCtx := newTestSyncCtx(nil,
+		WithHealthOverride(resourceNameHealthOverride(map[string]health.HealthStatusCode{
+			hook1.GetName(): health.HealthStatusProgressing,
+			hook2.GetName(): health.HealthStatusHealthy,
+		})),
+		WithInitialState(synccommon.OperationRunning, "", []synccommon.ResourceSyncResult{{
+			ResourceKey: kube.GetResourceKey(hook1),
+			HookPhase:   synccommon.OperationRunning,
+			Status:      synccommon.ResultCodeSynced,
+			SyncPhase:   synccommon.SyncPhasePreSync,
+			Order:       0,
+			StartedAt:   time.Now().Add(-2 * time.Minute),
+		}, {
+			ResourceKey: kube.GetResourceKey(hook2),
+			HookPhase:   synccommon.OperationRunning,
+			Status:      synccommon.ResultCodeSynced,
+			SyncPhase:   synccommon.SyncPhasePreSync,
+			Order:       1,
+			StartedAt:   time.Now().Add(-2 * time.Minute),
+		}},
+			metav1.Now(),
+		))
+	fakeDynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), hook1, hook2)
+	syncCtx.dynamicIf = fakeDynamicClient
+	deletedCount := 0
+	fakeDynamicClient.PrependReactor("delete", "*", func(_ testcore.Action) (handled bool, ret runtime.Object, err error) {
+		deletedCount++
+		return false, nil, nil
+	})
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{hook1, hook2},
+		Target: []*unstructured.Unstructured{nil, nil},
+	})
+	syncCtx.hooks = []*unstructured.Unstructured{hook1, hook2}
+
+	syncCtx.Sync(context.Background())
+	phase, message, results := syncCtx.GetState()
+
+	assert.Equal(t, synccommon.OperationFailed, phase)
+	assert.Equal(t, "one or more synchronization tasks completed unsuccessfully, reason: hook timed out after 1m0s", message)
+	require.Len(t, results, 2)
+	assert.Equal(t, synccommon.OperationFailed, results[0].HookPhase)
+	assert.Equal(t, synccommon.ResultCodeSyncFailed, results[0].Status)
+	assert.Equal(t, "hook timed out after 1m0s", results[0].Message)
+	assert.Equal(t, synccommon.OperationSucceeded, results[1].HookPhase)
+	assert.Equal(t, 1, deletedCount)
+}
+
+func TestSync_HealthTimeout(t *testing.T) {
+	pod1 := testingutils.NewPod()
+	pod1.SetName("pod-1")
+	pod1.SetNamespace(testingutils.FakeArgoCDNamespace)
+	pod2 := testingutils.NewPod()
+	pod2.SetName("pod-2")
+	pod2.SetNamespace(testingutils.FakeArgoCDNamespace)
+	pod3 := testingutils.NewPod()
+	pod3.SetName("pod-3")
+	pod3.SetNamespace(testingutils.FakeArgoCDNamespace)
+	testingutils.Annotate(pod3, synccommon.AnnotationSyncWave, "1")
+
+	syncCtx := newTestSyncCtx(nil,
+		WithHealthTimeout(time.Minute),
+		WithHealthOverride(resourceNameHealthOverride(map[string]health.HealthStatusCode{
+			pod1.GetName(): health.HealthStatusHealthy,
+			pod2.GetName(): health.HealthStatusProgressing,
+		})),
+		WithInitialState(synccommon.OperationRunning, "", []synccommon.ResourceSyncResult{{
+			ResourceKey: kube.GetResourceKey(pod1),
+			HookPhase:   synccommon.OperationRunning,
+			Status:      synccommon.ResultCodeSynced,
+			SyncPhase:   synccommon.SyncPhaseSync,
+			Order:       0,
+			StartedAt:   time.Now().Add(-30 * time.Second),
+		}, {
+			ResourceKey: kube.GetResourceKey(pod2),
+			HookPhase:   synccommon.OperationRunning,
+			Status:      synccommon.ResultCodeSynced,
+			SyncPhase:   synccommon.SyncPhaseSync,
+			Order:       1,
+			StartedAt:   time.Now().Add(-30 * time.Second),
+		}},
+			metav1.Now(),
+		))
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{pod1, pod2, nil},
+		Target: []*unstructured.Unstructured{pod1, pod2, pod3},
+	})
+
+	// the wave is still within the health timeout
+	syncCtx.Sync(context.Background())
+	phase, message, _ := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationRunning, phase)
+	assert.Equal(t, "waiting for healthy state of /Pod/pod-2", message)
+
+	// the timeout is measured from the time the wave was applied, not from the time each resource was applied
+	pod1Res := syncCtx.syncRes[resourceResultKey(kube.GetResourceKey(pod1), synccommon.SyncPhaseSync)]
+	pod1Res.StartedAt = time.Now().Add(-2 * time.Minute)
+	syncCtx.syncRes[resourceResultKey(kube.GetResourceKey(pod1), synccommon.SyncPhaseSync)] = pod1Res
+	syncCtx.Sync(context.Background())
+	phase, message, results := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationFailed, phase)
+	assert.Equal(t, "one or more synchronization tasks completed unsuccessfully, reason: timed out waiting for healthy state of wave 0 after 1m0s", message)
+	require.Len(t, results, 2)
+	assert.Equal(t, synccommon.OperationSucceeded, results[0].HookPhase)
+	assert.Equal(t, synccommon.ResultCodeSyncFailed, results[1].Status)
+	assert.Equal(t, synccommon.OperationFailed, results[1].HookPhase)
+}
+
+func TestSync_OperationTimeout(t *testing.T) {
+	hook := newHook("hook-1", synccommon.HookTypePreSync, synccommon.HookDeletePolicyBeforeHookCreation)
+	syncFailHook := newHook("sync-fail-hook", synccommon.HookTypeSyncFail, synccommon.HookDeletePolicyHookSucceeded)
+
+	syncCtx := newTestSyncCtx(nil,
+		WithOperationTimeout(5*time.Minute),
+		WithHealthOverride(resourceNameHealthOverride(map[string]health.HealthStatusCode{
+			hook.GetName():         health.HealthStatusProgressing,
+			syncFailHook.GetName(): health.HealthStatusHealthy,
+		})),
+		WithInitialState(synccommon.OperationRunning, "", []synccommon.ResourceSyncResult{{
+			ResourceKey: kube.GetResourceKey(hook),
+			HookPhase:   synccommon.OperationRunning,
+			Status:      synccommon.ResultCodeSynced,
+			SyncPhase:   synccommon.SyncPhasePreSync,
+			Order:       0,
+		}},
+			metav1.NewTime(time.Now().Add(-10*time.Minute)),
+		))
+	syncCtx.dynamicIf = fake.NewSimpleDynamicClient(runtime.NewScheme(), hook)
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{hook},
+		Target: []*unstructured.Unstructured{nil},
+	})
+	syncCtx.hooks = []*unstructured.Unstructured{hook, syncFailHook}
+
+	// the running hook is terminated and the SyncFail hooks are started
+	syncCtx.Sync(context.Background())
+	phase, message, results := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationRunning, phase)
+	assert.Equal(t, "waiting for completion of hook /Pod/sync-fail-hook", message)
+	require.Len(t, results, 2)
+	assert.Equal(t, synccommon.OperationFailed, results[0].HookPhase)
+	assert.Equal(t, "operation timed out after 5m0s", results[0].Message)
+
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{syncFailHook},
+		Target: []*unstructured.Unstructured{nil},
+	})
+
+	// the operation fails once the SyncFail hooks completed
+	syncCtx.Sync(context.Background())
+	phase, message, _ = syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationFailed, phase)
+	assert.Equal(t, "operation timed out after 5m0s", message)
+}
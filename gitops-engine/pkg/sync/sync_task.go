@@ -3,6 +3,7 @@ package sync
 import (
 	"fmt"
 	"slices"
+	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -119,6 +120,14 @@ func (t *syncTask) hookType() common.HookType {
 	return ""
 }
 
+// hookTimeout returns the maximum duration the hook is allowed to run, zero if it is not a hook or has no timeout
+func (t *syncTask) hookTimeout() time.Duration {
+	if !t.isHook() || t.targetObj == nil {
+		return 0
+	}
+	return hook.Timeout(t.targetObj)
+}
+
 func (t *syncTask) hasHookDeletePolicy(policy common.HookDeletePolicy) bool {
 	// cannot have a policy if it is not a hook, it is meaningless
 	if !t.isHook() {
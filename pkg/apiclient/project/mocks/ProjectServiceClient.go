@@ -42,6 +42,93 @@ func (_m *ProjectServiceClient) EXPECT() *ProjectServiceClient_Expecter {
 	return &ProjectServiceClient_Expecter{mock: &_m.Mock}
 }
 
+// ApproveRoleGrant provides a mock function for the type ProjectServiceClient
+func (_mock *ProjectServiceClient) ApproveRoleGrant(ctx context.Context, in *project.ProjectRoleGrantRequest, opts ...grpc.CallOption) (*v1alpha1.ProjectRoleGrant, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveRoleGrant")
+	}
+
+	var r0 *v1alpha1.ProjectRoleGrant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *project.ProjectRoleGrantRequest, ...grpc.CallOption) (*v1alpha1.ProjectRoleGrant, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *project.ProjectRoleGrantRequest, ...grpc.CallOption) *v1alpha1.ProjectRoleGrant); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ProjectRoleGrant)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *project.ProjectRoleGrantRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectServiceClient_ApproveRoleGrant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveRoleGrant'
+type ProjectServiceClient_ApproveRoleGrant_Call struct {
+	*mock.Call
+}
+
+// ApproveRoleGrant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *project.ProjectRoleGrantRequest
+//   - opts ...grpc.CallOption
+func (_e *ProjectServiceClient_Expecter) ApproveRoleGrant(ctx any, in any, opts ...any) *ProjectServiceClient_ApproveRoleGrant_Call {
+	return &ProjectServiceClient_ApproveRoleGrant_Call{Call: _e.mock.On("ApproveRoleGrant",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ProjectServiceClient_ApproveRoleGrant_Call) Run(run func(ctx context.Context, in *project.ProjectRoleGrantRequest, opts ...grpc.CallOption)) *ProjectServiceClient_ApproveRoleGrant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *project.ProjectRoleGrantRequest
+		if args[1] != nil {
+			arg1 = args[1].(*project.ProjectRoleGrantRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ProjectServiceClient_ApproveRoleGrant_Call) Return(projectRoleGrant *v1alpha1.ProjectRoleGrant, err error) *ProjectServiceClient_ApproveRoleGrant_Call {
+	_c.Call.Return(projectRoleGrant, err)
+	return _c
+}
+
+func (_c *ProjectServiceClient_ApproveRoleGrant_Call) RunAndReturn(run func(ctx context.Context, in *project.ProjectRoleGrantRequest, opts ...grpc.CallOption) (*v1alpha1.ProjectRoleGrant, error)) *ProjectServiceClient_ApproveRoleGrant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type ProjectServiceClient
 func (_mock *ProjectServiceClient) Create(ctx context.Context, in *project.ProjectCreateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error) {
 	// grpc.CallOption
@@ -129,6 +216,93 @@ func (_c *ProjectServiceClient_Create_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
+// CreateRoleGrant provides a mock function for the type ProjectServiceClient
+func (_mock *ProjectServiceClient) CreateRoleGrant(ctx context.Context, in *project.ProjectRoleGrantCreateRequest, opts ...grpc.CallOption) (*v1alpha1.ProjectRoleGrant, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRoleGrant")
+	}
+
+	var r0 *v1alpha1.ProjectRoleGrant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *project.ProjectRoleGrantCreateRequest, ...grpc.CallOption) (*v1alpha1.ProjectRoleGrant, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *project.ProjectRoleGrantCreateRequest, ...grpc.CallOption) *v1alpha1.ProjectRoleGrant); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ProjectRoleGrant)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *project.ProjectRoleGrantCreateRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectServiceClient_CreateRoleGrant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRoleGrant'
+type ProjectServiceClient_CreateRoleGrant_Call struct {
+	*mock.Call
+}
+
+// CreateRoleGrant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *project.ProjectRoleGrantCreateRequest
+//   - opts ...grpc.CallOption
+func (_e *ProjectServiceClient_Expecter) CreateRoleGrant(ctx any, in any, opts ...any) *ProjectServiceClient_CreateRoleGrant_Call {
+	return &ProjectServiceClient_CreateRoleGrant_Call{Call: _e.mock.On("CreateRoleGrant",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ProjectServiceClient_CreateRoleGrant_Call) Run(run func(ctx context.Context, in *project.ProjectRoleGrantCreateRequest, opts ...grpc.CallOption)) *ProjectServiceClient_CreateRoleGrant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *project.ProjectRoleGrantCreateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*project.ProjectRoleGrantCreateRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ProjectServiceClient_CreateRoleGrant_Call) Return(projectRoleGrant *v1alpha1.ProjectRoleGrant, err error) *ProjectServiceClient_CreateRoleGrant_Call {
+	_c.Call.Return(projectRoleGrant, err)
+	return _c
+}
+
+func (_c *ProjectServiceClient_CreateRoleGrant_Call) RunAndReturn(run func(ctx context.Context, in *project.ProjectRoleGrantCreateRequest, opts ...grpc.CallOption) (*v1alpha1.ProjectRoleGrant, error)) *ProjectServiceClient_CreateRoleGrant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateToken provides a mock function for the type ProjectServiceClient
 func (_mock *ProjectServiceClient) CreateToken(ctx context.Context, in *project.ProjectTokenCreateRequest, opts ...grpc.CallOption) (*project.ProjectTokenResponse, error) {
 	// grpc.CallOption
@@ -303,6 +477,93 @@ func (_c *ProjectServiceClient_Delete_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
+// DeleteRoleGrant provides a mock function for the type ProjectServiceClient
+func (_mock *ProjectServiceClient) DeleteRoleGrant(ctx context.Context, in *project.ProjectRoleGrantRequest, opts ...grpc.CallOption) (*project.EmptyResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRoleGrant")
+	}
+
+	var r0 *project.EmptyResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *project.ProjectRoleGrantRequest, ...grpc.CallOption) (*project.EmptyResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *project.ProjectRoleGrantRequest, ...grpc.CallOption) *project.EmptyResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*project.EmptyResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *project.ProjectRoleGrantRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectServiceClient_DeleteRoleGrant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoleGrant'
+type ProjectServiceClient_DeleteRoleGrant_Call struct {
+	*mock.Call
+}
+
+// DeleteRoleGrant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *project.ProjectRoleGrantRequest
+//   - opts ...grpc.CallOption
+func (_e *ProjectServiceClient_Expecter) DeleteRoleGrant(ctx any, in any, opts ...any) *ProjectServiceClient_DeleteRoleGrant_Call {
+	return &ProjectServiceClient_DeleteRoleGrant_Call{Call: _e.mock.On("DeleteRoleGrant",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ProjectServiceClient_DeleteRoleGrant_Call) Run(run func(ctx context.Context, in *project.ProjectRoleGrantRequest, opts ...grpc.CallOption)) *ProjectServiceClient_DeleteRoleGrant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *project.ProjectRoleGrantRequest
+		if args[1] != nil {
+			arg1 = args[1].(*project.ProjectRoleGrantRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ProjectServiceClient_DeleteRoleGrant_Call) Return(emptyResponse *project.EmptyResponse, err error) *ProjectServiceClient_DeleteRoleGrant_Call {
+	_c.Call.Return(emptyResponse, err)
+	return _c
+}
+
+func (_c *ProjectServiceClient_DeleteRoleGrant_Call) RunAndReturn(run func(ctx context.Context, in *project.ProjectRoleGrantRequest, opts ...grpc.CallOption) (*project.EmptyResponse, error)) *ProjectServiceClient_DeleteRoleGrant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteToken provides a mock function for the type ProjectServiceClient
 func (_mock *ProjectServiceClient) DeleteToken(ctx context.Context, in *project.ProjectTokenDeleteRequest, opts ...grpc.CallOption) (*project.EmptyResponse, error) {
 	// grpc.CallOption
@@ -564,6 +825,93 @@ func (_c *ProjectServiceClient_GetDetailedProject_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// GetEffectiveProject provides a mock function for the type ProjectServiceClient
+func (_mock *ProjectServiceClient) GetEffectiveProject(ctx context.Context, in *project.ProjectQuery, opts ...grpc.CallOption) (*project.EffectiveProjectResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEffectiveProject")
+	}
+
+	var r0 *project.EffectiveProjectResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *project.ProjectQuery, ...grpc.CallOption) (*project.EffectiveProjectResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *project.ProjectQuery, ...grpc.CallOption) *project.EffectiveProjectResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*project.EffectiveProjectResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *project.ProjectQuery, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectServiceClient_GetEffectiveProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEffectiveProject'
+type ProjectServiceClient_GetEffectiveProject_Call struct {
+	*mock.Call
+}
+
+// GetEffectiveProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *project.ProjectQuery
+//   - opts ...grpc.CallOption
+func (_e *ProjectServiceClient_Expecter) GetEffectiveProject(ctx any, in any, opts ...any) *ProjectServiceClient_GetEffectiveProject_Call {
+	return &ProjectServiceClient_GetEffectiveProject_Call{Call: _e.mock.On("GetEffectiveProject",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ProjectServiceClient_GetEffectiveProject_Call) Run(run func(ctx context.Context, in *project.ProjectQuery, opts ...grpc.CallOption)) *ProjectServiceClient_GetEffectiveProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *project.ProjectQuery
+		if args[1] != nil {
+			arg1 = args[1].(*project.ProjectQuery)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ProjectServiceClient_GetEffectiveProject_Call) Return(effectiveProjectResponse *project.EffectiveProjectResponse, err error) *ProjectServiceClient_GetEffectiveProject_Call {
+	_c.Call.Return(effectiveProjectResponse, err)
+	return _c
+}
+
+func (_c *ProjectServiceClient_GetEffectiveProject_Call) RunAndReturn(run func(ctx context.Context, in *project.ProjectQuery, opts ...grpc.CallOption) (*project.EffectiveProjectResponse, error)) *ProjectServiceClient_GetEffectiveProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetGlobalProjects provides a mock function for the type ProjectServiceClient
 func (_mock *ProjectServiceClient) GetGlobalProjects(ctx context.Context, in *project.ProjectQuery, opts ...grpc.CallOption) (*project.GlobalProjectsResponse, error) {
 	// grpc.CallOption
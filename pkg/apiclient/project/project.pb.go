@@ -291,6 +291,159 @@ func (m *ProjectTokenResponse) GetToken() string {
 	return ""
 }
 
+// ProjectRoleGrantCreateRequest defines project role grant creation parameters.
+type ProjectRoleGrantCreateRequest struct {
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Role    string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// duration is how long the grant is active once it takes effect, e.g. 2h
+	Duration             string   `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Policies             []string `protobuf:"bytes,5,rep,name=policies,proto3" json:"policies,omitempty"`
+	Groups               []string `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectRoleGrantCreateRequest) Reset()         { *m = ProjectRoleGrantCreateRequest{} }
+func (m *ProjectRoleGrantCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*ProjectRoleGrantCreateRequest) ProtoMessage()    {}
+func (*ProjectRoleGrantCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{4}
+}
+func (m *ProjectRoleGrantCreateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectRoleGrantCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectRoleGrantCreateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectRoleGrantCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectRoleGrantCreateRequest.Merge(m, src)
+}
+func (m *ProjectRoleGrantCreateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectRoleGrantCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectRoleGrantCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectRoleGrantCreateRequest proto.InternalMessageInfo
+
+func (m *ProjectRoleGrantCreateRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *ProjectRoleGrantCreateRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ProjectRoleGrantCreateRequest) GetDuration() string {
+	if m != nil {
+		return m.Duration
+	}
+	return ""
+}
+
+func (m *ProjectRoleGrantCreateRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ProjectRoleGrantCreateRequest) GetPolicies() []string {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *ProjectRoleGrantCreateRequest) GetGroups() []string {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+// ProjectRoleGrantRequest identifies a project role grant.
+type ProjectRoleGrantRequest struct {
+	Project              string   `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectRoleGrantRequest) Reset()         { *m = ProjectRoleGrantRequest{} }
+func (m *ProjectRoleGrantRequest) String() string { return proto.CompactTextString(m) }
+func (*ProjectRoleGrantRequest) ProtoMessage()    {}
+func (*ProjectRoleGrantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{5}
+}
+func (m *ProjectRoleGrantRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectRoleGrantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectRoleGrantRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectRoleGrantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectRoleGrantRequest.Merge(m, src)
+}
+func (m *ProjectRoleGrantRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectRoleGrantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectRoleGrantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectRoleGrantRequest proto.InternalMessageInfo
+
+func (m *ProjectRoleGrantRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *ProjectRoleGrantRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ProjectRoleGrantRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 // ProjectQuery is a query for Project resources
 type ProjectQuery struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -303,7 +456,7 @@ func (m *ProjectQuery) Reset()         { *m = ProjectQuery{} }
 func (m *ProjectQuery) String() string { return proto.CompactTextString(m) }
 func (*ProjectQuery) ProtoMessage()    {}
 func (*ProjectQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{4}
+	return fileDescriptor_5f0a51496972c9e2, []int{6}
 }
 func (m *ProjectQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +503,7 @@ func (m *ProjectUpdateRequest) Reset()         { *m = ProjectUpdateRequest{} }
 func (m *ProjectUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*ProjectUpdateRequest) ProtoMessage()    {}
 func (*ProjectUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{5}
+	return fileDescriptor_5f0a51496972c9e2, []int{7}
 }
 func (m *ProjectUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,7 +549,7 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{6}
+	return fileDescriptor_5f0a51496972c9e2, []int{8}
 }
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,7 +589,7 @@ func (m *SyncWindowsQuery) Reset()         { *m = SyncWindowsQuery{} }
 func (m *SyncWindowsQuery) String() string { return proto.CompactTextString(m) }
 func (*SyncWindowsQuery) ProtoMessage()    {}
 func (*SyncWindowsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{7}
+	return fileDescriptor_5f0a51496972c9e2, []int{9}
 }
 func (m *SyncWindowsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,17 +626,19 @@ func (m *SyncWindowsQuery) GetName() string {
 }
 
 type SyncWindowsResponse struct {
-	Windows              []*v1alpha1.SyncWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	Windows []*v1alpha1.SyncWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	// schedules holds the upcoming occurrences of every sync window of the project
+	Schedules            []*SyncWindowSchedule `protobuf:"bytes,2,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *SyncWindowsResponse) Reset()         { *m = SyncWindowsResponse{} }
 func (m *SyncWindowsResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncWindowsResponse) ProtoMessage()    {}
 func (*SyncWindowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{8}
+	return fileDescriptor_5f0a51496972c9e2, []int{10}
 }
 func (m *SyncWindowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,6 +674,87 @@ func (m *SyncWindowsResponse) GetWindows() []*v1alpha1.SyncWindow {
 	return nil
 }
 
+func (m *SyncWindowsResponse) GetSchedules() []*SyncWindowSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+// SyncWindowSchedule holds the upcoming occurrences of a sync window
+type SyncWindowSchedule struct {
+	// index is the position of the window in the project's sync windows
+	Index       int32                            `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Window      *v1alpha1.SyncWindow             `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	Occurrences []*v1alpha1.SyncWindowOccurrence `protobuf:"bytes,3,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	// error is set if the occurrences of the window cannot be computed, e.g. because its calendar cannot be loaded
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncWindowSchedule) Reset()         { *m = SyncWindowSchedule{} }
+func (m *SyncWindowSchedule) String() string { return proto.CompactTextString(m) }
+func (*SyncWindowSchedule) ProtoMessage()    {}
+func (*SyncWindowSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{11}
+}
+func (m *SyncWindowSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncWindowSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncWindowSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowSchedule.Merge(m, src)
+}
+func (m *SyncWindowSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowSchedule proto.InternalMessageInfo
+
+func (m *SyncWindowSchedule) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *SyncWindowSchedule) GetWindow() *v1alpha1.SyncWindow {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (m *SyncWindowSchedule) GetOccurrences() []*v1alpha1.SyncWindowOccurrence {
+	if m != nil {
+		return m.Occurrences
+	}
+	return nil
+}
+
+func (m *SyncWindowSchedule) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type GlobalProjectsResponse struct {
 	Items                []*v1alpha1.AppProject `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -530,7 +766,7 @@ func (m *GlobalProjectsResponse) Reset()         { *m = GlobalProjectsResponse{}
 func (m *GlobalProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*GlobalProjectsResponse) ProtoMessage()    {}
 func (*GlobalProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{9}
+	return fileDescriptor_5f0a51496972c9e2, []int{12}
 }
 func (m *GlobalProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -567,20 +803,21 @@ func (m *GlobalProjectsResponse) GetItems() []*v1alpha1.AppProject {
 }
 
 type DetailedProjectsResponse struct {
-	GlobalProjects       []*v1alpha1.AppProject `protobuf:"bytes,1,rep,name=globalProjects,proto3" json:"globalProjects,omitempty"`
-	Project              *v1alpha1.AppProject   `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
-	Repositories         []*v1alpha1.Repository `protobuf:"bytes,3,rep,name=repositories,proto3" json:"repositories,omitempty"`
-	Clusters             []*v1alpha1.Cluster    `protobuf:"bytes,4,rep,name=clusters,proto3" json:"clusters,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	GlobalProjects       []*v1alpha1.AppProject      `protobuf:"bytes,1,rep,name=globalProjects,proto3" json:"globalProjects,omitempty"`
+	Project              *v1alpha1.AppProject        `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Repositories         []*v1alpha1.Repository      `protobuf:"bytes,3,rep,name=repositories,proto3" json:"repositories,omitempty"`
+	Clusters             []*v1alpha1.Cluster         `protobuf:"bytes,4,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	QuotaUsage           *v1alpha1.ProjectQuotaUsage `protobuf:"bytes,5,opt,name=quotaUsage,proto3" json:"quotaUsage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
 func (m *DetailedProjectsResponse) Reset()         { *m = DetailedProjectsResponse{} }
 func (m *DetailedProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*DetailedProjectsResponse) ProtoMessage()    {}
 func (*DetailedProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{10}
+	return fileDescriptor_5f0a51496972c9e2, []int{13}
 }
 func (m *DetailedProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -637,6 +874,68 @@ func (m *DetailedProjectsResponse) GetClusters() []*v1alpha1.Cluster {
 	return nil
 }
 
+func (m *DetailedProjectsResponse) GetQuotaUsage() *v1alpha1.ProjectQuotaUsage {
+	if m != nil {
+		return m.QuotaUsage
+	}
+	return nil
+}
+
+type EffectiveProjectResponse struct {
+	Project              *v1alpha1.AppProject          `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Origins              []*v1alpha1.ProjectRuleOrigin `protobuf:"bytes,2,rep,name=origins,proto3" json:"origins,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *EffectiveProjectResponse) Reset()         { *m = EffectiveProjectResponse{} }
+func (m *EffectiveProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*EffectiveProjectResponse) ProtoMessage()    {}
+func (*EffectiveProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{14}
+}
+func (m *EffectiveProjectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EffectiveProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveProjectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EffectiveProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveProjectResponse.Merge(m, src)
+}
+func (m *EffectiveProjectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EffectiveProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveProjectResponse proto.InternalMessageInfo
+
+func (m *EffectiveProjectResponse) GetProject() *v1alpha1.AppProject {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+func (m *EffectiveProjectResponse) GetOrigins() []*v1alpha1.ProjectRuleOrigin {
+	if m != nil {
+		return m.Origins
+	}
+	return nil
+}
+
 type ListProjectLinksRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -648,7 +947,7 @@ func (m *ListProjectLinksRequest) Reset()         { *m = ListProjectLinksRequest
 func (m *ListProjectLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListProjectLinksRequest) ProtoMessage()    {}
 func (*ListProjectLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{11}
+	return fileDescriptor_5f0a51496972c9e2, []int{15}
 }
 func (m *ListProjectLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,83 +988,109 @@ func init() {
 	proto.RegisterType((*ProjectTokenDeleteRequest)(nil), "project.ProjectTokenDeleteRequest")
 	proto.RegisterType((*ProjectTokenCreateRequest)(nil), "project.ProjectTokenCreateRequest")
 	proto.RegisterType((*ProjectTokenResponse)(nil), "project.ProjectTokenResponse")
+	proto.RegisterType((*ProjectRoleGrantCreateRequest)(nil), "project.ProjectRoleGrantCreateRequest")
+	proto.RegisterType((*ProjectRoleGrantRequest)(nil), "project.ProjectRoleGrantRequest")
 	proto.RegisterType((*ProjectQuery)(nil), "project.ProjectQuery")
 	proto.RegisterType((*ProjectUpdateRequest)(nil), "project.ProjectUpdateRequest")
 	proto.RegisterType((*EmptyResponse)(nil), "project.EmptyResponse")
 	proto.RegisterType((*SyncWindowsQuery)(nil), "project.SyncWindowsQuery")
 	proto.RegisterType((*SyncWindowsResponse)(nil), "project.SyncWindowsResponse")
+	proto.RegisterType((*SyncWindowSchedule)(nil), "project.SyncWindowSchedule")
 	proto.RegisterType((*GlobalProjectsResponse)(nil), "project.GlobalProjectsResponse")
 	proto.RegisterType((*DetailedProjectsResponse)(nil), "project.DetailedProjectsResponse")
+	proto.RegisterType((*EffectiveProjectResponse)(nil), "project.EffectiveProjectResponse")
 	proto.RegisterType((*ListProjectLinksRequest)(nil), "project.ListProjectLinksRequest")
 }
 
 func init() { proto.RegisterFile("server/project/project.proto", fileDescriptor_5f0a51496972c9e2) }
 
 var fileDescriptor_5f0a51496972c9e2 = []byte{
-	// 997 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x97, 0x93, 0x36, 0xbb, 0x7d, 0x2d, 0xa5, 0x3b, 0xbb, 0xdb, 0x75, 0x43, 0xb7, 0x0d, 0x83,
-	0xb6, 0x8a, 0x0a, 0xb5, 0xd5, 0x06, 0x24, 0xfe, 0x9c, 0xd8, 0x6e, 0x15, 0x90, 0x7a, 0x00, 0x17,
-	0x04, 0xe2, 0xb0, 0xc8, 0xb1, 0x9f, 0xb2, 0x43, 0x1c, 0x7b, 0xf0, 0x4c, 0xb2, 0x0d, 0x51, 0x2f,
-	0x48, 0x80, 0xc4, 0x81, 0xc3, 0x72, 0xe2, 0x0b, 0xf0, 0x3d, 0xb8, 0x71, 0x44, 0xe2, 0x0b, 0xa0,
-	0x8a, 0x0f, 0x82, 0x3c, 0x1e, 0x3b, 0x71, 0x52, 0xc3, 0xa2, 0x06, 0x4e, 0x1e, 0x8f, 0xdf, 0xfc,
-	0x7e, 0xbf, 0xf7, 0x66, 0xe6, 0x37, 0x63, 0xd8, 0x16, 0x18, 0x0f, 0x31, 0xb6, 0x79, 0x1c, 0x7d,
-	0x81, 0x9e, 0xcc, 0x9e, 0x16, 0x8f, 0x23, 0x19, 0x91, 0x1b, 0xfa, 0xb5, 0xbe, 0xdd, 0x8d, 0xa2,
-	0x6e, 0x80, 0xb6, 0xcb, 0x99, 0xed, 0x86, 0x61, 0x24, 0x5d, 0xc9, 0xa2, 0x50, 0xa4, 0x61, 0xf5,
-	0xd3, 0x2e, 0x93, 0x4f, 0x06, 0x1d, 0xcb, 0x8b, 0xfa, 0xb6, 0x1b, 0x77, 0xa3, 0x64, 0x94, 0x6a,
-	0x1c, 0x78, 0xbe, 0x3d, 0x6c, 0xd9, 0xbc, 0xd7, 0x4d, 0x46, 0x0a, 0xdb, 0xe5, 0x3c, 0x60, 0x9e,
-	0x1a, 0x6b, 0x0f, 0x0f, 0xdd, 0x80, 0x3f, 0x71, 0x0f, 0xed, 0x2e, 0x86, 0x18, 0xbb, 0x12, 0x7d,
-	0x8d, 0x76, 0xfc, 0x0f, 0x68, 0x5a, 0xf1, 0x34, 0xd6, 0x54, 0x5b, 0x83, 0xbc, 0xf5, 0x7c, 0x20,
-	0x38, 0xc4, 0x50, 0x0a, 0xfd, 0x48, 0x87, 0xd2, 0x67, 0x06, 0xdc, 0xf9, 0x20, 0xcd, 0xfb, 0x38,
-	0x46, 0x57, 0xa2, 0x83, 0x5f, 0x0e, 0x50, 0x48, 0xd2, 0x81, 0xac, 0x1e, 0xa6, 0xd1, 0x30, 0x9a,
-	0xab, 0x47, 0xef, 0x59, 0x13, 0x16, 0x2b, 0x63, 0x51, 0x8d, 0xcf, 0x3d, 0xdf, 0x1a, 0xb6, 0x2c,
-	0xde, 0xeb, 0x5a, 0x49, 0xe2, 0xd6, 0xb4, 0xc0, 0x2c, 0x71, 0xeb, 0x5d, 0xce, 0x35, 0x8f, 0x93,
-	0x01, 0x93, 0x4d, 0xa8, 0x0d, 0xb8, 0xc0, 0x58, 0x9a, 0x95, 0x86, 0xd1, 0xbc, 0xe9, 0xe8, 0x37,
-	0xda, 0x83, 0x2d, 0x1d, 0xfb, 0x51, 0xd4, 0xc3, 0xf0, 0x11, 0x06, 0x38, 0x11, 0x66, 0x16, 0x85,
-	0xad, 0x4c, 0xe0, 0x08, 0x2c, 0xc5, 0x51, 0x80, 0x0a, 0x6c, 0xc5, 0x51, 0x6d, 0xb2, 0x01, 0x55,
-	0xe6, 0x4a, 0xb3, 0xda, 0x30, 0x9a, 0x55, 0x27, 0x69, 0x92, 0x75, 0xa8, 0x30, 0xdf, 0x5c, 0x52,
-	0x31, 0x15, 0xe6, 0xd3, 0x9f, 0x8c, 0x22, 0x5b, 0xb1, 0x0c, 0xe5, 0x6c, 0x0d, 0x58, 0xf5, 0x51,
-	0x78, 0x31, 0xe3, 0x49, 0xa2, 0x9a, 0x74, 0xba, 0x2b, 0xd7, 0x53, 0x9d, 0xd2, 0xb3, 0x0d, 0x2b,
-	0x78, 0xce, 0x59, 0x8c, 0xe2, 0xfd, 0x50, 0x89, 0xa8, 0x3a, 0x93, 0x0e, 0xad, 0x6d, 0x39, 0xd7,
-	0xf6, 0x5a, 0x3e, 0x39, 0x4a, 0x9a, 0x83, 0x82, 0x47, 0xa1, 0x40, 0x72, 0x07, 0x96, 0x65, 0xd2,
-	0xa1, 0x35, 0xa5, 0x2f, 0x94, 0xc2, 0x9a, 0x8e, 0xfe, 0x70, 0x80, 0xf1, 0x28, 0xe1, 0x0f, 0xdd,
-	0x3e, 0xea, 0x20, 0xd5, 0xa6, 0x5f, 0xe5, 0x88, 0x1f, 0x73, 0xff, 0xff, 0x9d, 0x6e, 0xfa, 0x22,
-	0xbc, 0x70, 0xd2, 0xe7, 0x72, 0x94, 0xa5, 0x41, 0xf7, 0x60, 0xe3, 0x6c, 0x14, 0x7a, 0x9f, 0xb0,
-	0xd0, 0x8f, 0x9e, 0x8a, 0x72, 0xd1, 0x23, 0xb8, 0x3d, 0x15, 0x97, 0x57, 0xa1, 0x03, 0x37, 0x9e,
-	0xa6, 0x5d, 0xa6, 0xd1, 0xa8, 0x5e, 0x5f, 0xf3, 0x84, 0xc3, 0xc9, 0x80, 0xe9, 0x39, 0x6c, 0xb6,
-	0x83, 0xa8, 0xe3, 0x06, 0x3a, 0x9b, 0x09, 0xfb, 0x63, 0x58, 0x66, 0x12, 0xfb, 0x0b, 0xe2, 0x9e,
-	0xaa, 0x57, 0x0a, 0x4b, 0x7f, 0xa9, 0x82, 0xf9, 0x08, 0xa5, 0xcb, 0x02, 0xf4, 0xe7, 0xc8, 0x39,
-	0xac, 0x77, 0x0b, 0xb2, 0x16, 0xae, 0x62, 0x06, 0x7f, 0x7a, 0x81, 0x54, 0xfe, 0x2b, 0x3f, 0x08,
-	0x60, 0x2d, 0x46, 0x1e, 0x09, 0x26, 0xa3, 0x98, 0xa1, 0x30, 0xab, 0x8b, 0xc8, 0xc9, 0xc9, 0x10,
-	0x47, 0x4e, 0x01, 0x9d, 0xb8, 0x70, 0xd3, 0x0b, 0x06, 0x42, 0x62, 0x2c, 0xcc, 0x25, 0xc5, 0x74,
-	0x72, 0x3d, 0xa6, 0xe3, 0x14, 0xcd, 0xc9, 0x61, 0xe9, 0x01, 0xdc, 0x3b, 0x65, 0x42, 0xea, 0x44,
-	0x4f, 0x59, 0xd8, 0x13, 0xd9, 0x86, 0xbb, 0x62, 0x9d, 0x1f, 0x3d, 0x5b, 0x83, 0x75, 0x1d, 0x7b,
-	0x86, 0xf1, 0x90, 0x79, 0x48, 0xbe, 0x37, 0x60, 0x35, 0x75, 0x24, 0xe5, 0x00, 0x84, 0x5a, 0xd9,
-	0xa1, 0x55, 0xea, 0x59, 0xf5, 0xfb, 0x57, 0xc6, 0xe4, 0xbb, 0xee, 0xcd, 0xaf, 0x7f, 0xff, 0xf3,
-	0xc7, 0xca, 0x11, 0x3d, 0x50, 0x07, 0xdc, 0xf0, 0x30, 0x3b, 0x06, 0x85, 0x3d, 0xd6, 0xad, 0x0b,
-	0x3b, 0xf1, 0x2a, 0x61, 0x8f, 0x93, 0xc7, 0x85, 0xad, 0xdc, 0xe5, 0x6d, 0x63, 0x9f, 0x7c, 0x6b,
-	0xc0, 0x6a, 0x6a, 0xc6, 0x7f, 0x27, 0xa6, 0x60, 0xd7, 0xf5, 0xcd, 0x3c, 0xa6, 0xb8, 0xf7, 0xdf,
-	0x51, 0x2a, 0xde, 0xd8, 0x6f, 0xfd, 0x2b, 0x15, 0xf6, 0x98, 0xb9, 0xf2, 0x82, 0xfc, 0x60, 0x40,
-	0x2d, 0xcd, 0x99, 0xcc, 0x25, 0x5b, 0xac, 0xc5, 0xc2, 0x56, 0x29, 0x7d, 0x49, 0x09, 0xbe, 0x4b,
-	0x37, 0x66, 0x05, 0x27, 0x95, 0xf9, 0xc6, 0x80, 0xa5, 0x64, 0xa6, 0xc9, 0xdd, 0x59, 0x39, 0xca,
-	0xd5, 0xea, 0xa7, 0x8b, 0x92, 0x91, 0x90, 0x50, 0x53, 0x49, 0x21, 0x64, 0x4e, 0x0a, 0x39, 0x07,
-	0xd2, 0x46, 0x39, 0x63, 0x1b, 0x65, 0xa2, 0x5e, 0xce, 0xbb, 0xcb, 0x7c, 0x86, 0x36, 0x15, 0x13,
-	0x25, 0x8d, 0xf9, 0x59, 0x4a, 0x56, 0xec, 0x85, 0xed, 0xeb, 0x91, 0xe4, 0x3b, 0x03, 0xaa, 0x6d,
-	0x2c, 0xe5, 0x5a, 0xdc, 0x3c, 0xec, 0x2a, 0x49, 0x5b, 0xe4, 0x5e, 0x89, 0x24, 0x32, 0x86, 0x5b,
-	0x6d, 0x94, 0x45, 0xd7, 0x2e, 0x93, 0xb5, 0x9b, 0x77, 0x5f, 0xed, 0xf2, 0xd4, 0x52, 0x6c, 0x4d,
-	0xb2, 0x57, 0x56, 0x80, 0xd4, 0x26, 0xf3, 0x09, 0xf8, 0xd9, 0x80, 0x5a, 0x7a, 0xb2, 0xce, 0xaf,
-	0xcc, 0xc2, 0x89, 0xbb, 0xc0, 0x8a, 0xb4, 0x94, 0xc6, 0x83, 0x7a, 0xb3, 0x74, 0x2b, 0x59, 0x7d,
-	0x94, 0xae, 0xef, 0x4a, 0xd7, 0x52, 0xa2, 0x93, 0x15, 0xfb, 0x29, 0xd4, 0xd2, 0x8d, 0x5a, 0x56,
-	0x9a, 0xb2, 0x8d, 0xab, 0xeb, 0xbf, 0x5f, 0x5a, 0xff, 0xc7, 0x00, 0xc9, 0x2a, 0x3d, 0x51, 0xd7,
-	0xcc, 0x32, 0xf4, 0x5b, 0x96, 0xbe, 0x86, 0xaa, 0x30, 0xb5, 0xaa, 0xf7, 0x14, 0x70, 0x83, 0xec,
-	0x94, 0x95, 0x3a, 0x1d, 0x41, 0xc6, 0x70, 0xbb, 0x8d, 0x72, 0xea, 0x42, 0x70, 0x26, 0x93, 0x72,
-	0x6f, 0xe5, 0x44, 0xb3, 0x77, 0x8a, 0xfa, 0xf6, 0x55, 0x9f, 0xf2, 0x84, 0x5e, 0x55, 0xbc, 0x0f,
-	0xc8, 0x2b, 0x65, 0xbc, 0x62, 0x14, 0x7a, 0xfa, 0x3e, 0x40, 0x38, 0xac, 0x24, 0x62, 0x95, 0x95,
-	0x93, 0x46, 0x8e, 0x5b, 0xe2, 0xf2, 0xf5, 0x7a, 0x61, 0xf2, 0xf4, 0x27, 0xcd, 0xfb, 0x40, 0xf1,
-	0xee, 0x92, 0xfb, 0x65, 0xbc, 0x41, 0x12, 0xfe, 0xf0, 0xe1, 0xaf, 0x97, 0x3b, 0xc6, 0x6f, 0x97,
-	0x3b, 0xc6, 0x1f, 0x97, 0x3b, 0xc6, 0x67, 0xaf, 0x3f, 0xdf, 0xdf, 0x87, 0x17, 0x30, 0x0c, 0xf3,
-	0x1f, 0x9c, 0x4e, 0x4d, 0x5d, 0xf6, 0x5b, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x27, 0x88,
-	0x4d, 0x01, 0x0d, 0x00, 0x00,
+	// 1345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0x97, 0x77, 0x93, 0x6d, 0xf3, 0xd2, 0x6f, 0x9b, 0x4e, 0x7f, 0xb9, 0xdb, 0x34, 0xdd, 0xba,
+	0x6a, 0x94, 0x6f, 0x21, 0xb6, 0x9a, 0x80, 0x44, 0xe9, 0x85, 0xfe, 0x08, 0x01, 0x29, 0xa2, 0xe0,
+	0x50, 0x15, 0x71, 0x28, 0x38, 0xf6, 0xeb, 0x76, 0x88, 0xe3, 0x71, 0x67, 0x66, 0xb7, 0x49, 0xa3,
+	0x5c, 0x90, 0xf8, 0x21, 0x0e, 0x1c, 0xe0, 0x02, 0xff, 0x00, 0x57, 0x24, 0x04, 0xff, 0x01, 0x07,
+	0x0e, 0x1c, 0x90, 0xf8, 0x07, 0x50, 0xc5, 0x85, 0xff, 0x02, 0xcd, 0x78, 0xec, 0xf5, 0x6e, 0xd6,
+	0x34, 0x25, 0x1b, 0x4e, 0x3b, 0xe3, 0x7d, 0xf3, 0xf9, 0x7c, 0xde, 0x7b, 0x33, 0xf3, 0x9e, 0x0d,
+	0xd3, 0x02, 0x79, 0x17, 0xb9, 0x97, 0x72, 0xf6, 0x11, 0x86, 0x32, 0xff, 0x75, 0x53, 0xce, 0x24,
+	0x23, 0x87, 0xcc, 0xb4, 0x39, 0xdd, 0x66, 0xac, 0x1d, 0xa3, 0x17, 0xa4, 0xd4, 0x0b, 0x92, 0x84,
+	0xc9, 0x40, 0x52, 0x96, 0x88, 0xcc, 0xac, 0xb9, 0xd2, 0xa6, 0xf2, 0x61, 0x67, 0xcd, 0x0d, 0xd9,
+	0x86, 0x17, 0xf0, 0x36, 0x53, 0xab, 0xf4, 0x60, 0x3e, 0x8c, 0xbc, 0xee, 0xa2, 0x97, 0xae, 0xb7,
+	0xd5, 0x4a, 0xe1, 0x05, 0x69, 0x1a, 0xd3, 0x50, 0xaf, 0xf5, 0xba, 0x57, 0x83, 0x38, 0x7d, 0x18,
+	0x5c, 0xf5, 0xda, 0x98, 0x20, 0x0f, 0x24, 0x46, 0x06, 0xed, 0xd6, 0x33, 0xd0, 0x8c, 0xe2, 0x32,
+	0x56, 0x69, 0x6c, 0x40, 0xae, 0xed, 0x0d, 0x04, 0xbb, 0x98, 0x48, 0x61, 0x7e, 0xb2, 0xa5, 0xce,
+	0x57, 0x16, 0x9c, 0x7c, 0x3b, 0xf3, 0xfb, 0x16, 0xc7, 0x40, 0xa2, 0x8f, 0x8f, 0x3a, 0x28, 0x24,
+	0x59, 0x83, 0x3c, 0x1e, 0xb6, 0xd5, 0xb2, 0xe6, 0x26, 0x17, 0xde, 0x70, 0x7b, 0x2c, 0x6e, 0xce,
+	0xa2, 0x07, 0x1f, 0x84, 0x91, 0xdb, 0x5d, 0x74, 0xd3, 0xf5, 0xb6, 0xab, 0x1c, 0x77, 0xcb, 0x02,
+	0x73, 0xc7, 0xdd, 0x1b, 0x69, 0x6a, 0x78, 0xfc, 0x1c, 0x98, 0x9c, 0x86, 0x46, 0x27, 0x15, 0xc8,
+	0xa5, 0x5d, 0x6b, 0x59, 0x73, 0x87, 0x7d, 0x33, 0x73, 0xd6, 0xe1, 0xac, 0xb1, 0x7d, 0x97, 0xad,
+	0x63, 0x72, 0x1b, 0x63, 0xec, 0x09, 0xb3, 0xfb, 0x85, 0x4d, 0xf4, 0xe0, 0x08, 0x8c, 0x71, 0x16,
+	0xa3, 0x06, 0x9b, 0xf0, 0xf5, 0x98, 0x4c, 0x41, 0x9d, 0x06, 0xd2, 0xae, 0xb7, 0xac, 0xb9, 0xba,
+	0xaf, 0x86, 0xe4, 0x28, 0xd4, 0x68, 0x64, 0x8f, 0x69, 0x9b, 0x1a, 0x8d, 0x9c, 0x6f, 0xad, 0x7e,
+	0xb6, 0xfe, 0x30, 0x54, 0xb3, 0xb5, 0x60, 0x32, 0x42, 0x11, 0x72, 0x9a, 0x2a, 0x47, 0x0d, 0x69,
+	0xf9, 0x51, 0xa1, 0xa7, 0x5e, 0xd2, 0x33, 0x0d, 0x13, 0xb8, 0x99, 0x52, 0x8e, 0xe2, 0xcd, 0x44,
+	0x8b, 0xa8, 0xfb, 0xbd, 0x07, 0x46, 0xdb, 0x78, 0xa1, 0xed, 0x45, 0x38, 0x59, 0x96, 0xe6, 0xa3,
+	0x48, 0x59, 0x22, 0x90, 0x9c, 0x84, 0x71, 0xa9, 0x1e, 0x18, 0x4d, 0xd9, 0xc4, 0xf9, 0xc9, 0x82,
+	0xf3, 0x79, 0x8c, 0x59, 0x8c, 0xcb, 0x3c, 0x48, 0xe4, 0x5e, 0xbd, 0x19, 0x16, 0xbb, 0x26, 0x1c,
+	0x8e, 0x3a, 0x5c, 0xe7, 0xd1, 0xf8, 0x50, 0xcc, 0x55, 0xea, 0x38, 0x06, 0x82, 0x25, 0x26, 0x92,
+	0x66, 0xa6, 0xd6, 0xa4, 0x2c, 0xa6, 0x21, 0x45, 0x61, 0x8f, 0xb7, 0xea, 0x6a, 0x4d, 0x3e, 0x57,
+	0x6b, 0xda, 0x9c, 0x75, 0x52, 0x61, 0x37, 0xf4, 0x3f, 0x66, 0xe6, 0xdc, 0x83, 0x33, 0x83, 0xb2,
+	0xff, 0x9d, 0xe0, 0x2c, 0x7c, 0xf5, 0x22, 0x7c, 0x0e, 0x1c, 0x31, 0xc0, 0xef, 0x74, 0x90, 0x6f,
+	0xa9, 0x35, 0x49, 0xb0, 0x81, 0x06, 0x4a, 0x8f, 0x9d, 0x27, 0x45, 0x88, 0xef, 0xa6, 0xd1, 0x7f,
+	0xbb, 0xff, 0x9d, 0x63, 0xf0, 0xbf, 0xa5, 0x8d, 0x54, 0x6e, 0xe5, 0x79, 0x75, 0x66, 0x61, 0x6a,
+	0x75, 0x2b, 0x09, 0xef, 0xd1, 0x24, 0x62, 0x8f, 0x45, 0xb5, 0xe8, 0x1f, 0x2d, 0x38, 0x51, 0x32,
+	0x2c, 0xf6, 0xc5, 0x1a, 0x1c, 0x7a, 0x9c, 0x3d, 0xb2, 0xad, 0x56, 0x7d, 0xff, 0xa2, 0x7b, 0x1c,
+	0x7e, 0x0e, 0x4c, 0xae, 0xc1, 0x84, 0x08, 0x1f, 0x62, 0xd4, 0x89, 0x51, 0xd8, 0x35, 0xcd, 0x72,
+	0xce, 0xcd, 0x6f, 0xd2, 0xde, 0x82, 0x55, 0x63, 0xe3, 0xf7, 0xac, 0x9d, 0x6f, 0x6a, 0x40, 0x76,
+	0x5b, 0xa8, 0xdd, 0x4c, 0x93, 0x08, 0x37, 0xb5, 0x8b, 0xe3, 0x7e, 0x36, 0x21, 0x1f, 0x42, 0x23,
+	0xa3, 0xd4, 0x29, 0x1e, 0xa5, 0x2b, 0x06, 0x97, 0x48, 0x98, 0x64, 0x61, 0xd8, 0xe1, 0x1c, 0x93,
+	0x10, 0x85, 0x5d, 0xd7, 0xbe, 0xf8, 0xa3, 0xa2, 0xb9, 0x53, 0x40, 0xfb, 0x65, 0x1a, 0xe5, 0x2d,
+	0x72, 0xce, 0xb8, 0x39, 0x38, 0xd9, 0xc4, 0xd9, 0x84, 0xd3, 0xcb, 0x31, 0x5b, 0x0b, 0x62, 0xb3,
+	0x49, 0x7a, 0x39, 0xbd, 0x0f, 0xe3, 0x54, 0xe2, 0xc6, 0x88, 0x32, 0x5a, 0xda, 0x86, 0x19, 0xac,
+	0xf3, 0xc3, 0x18, 0xd8, 0xb7, 0x51, 0x06, 0x34, 0xc6, 0x68, 0x17, 0x79, 0x0a, 0x47, 0xdb, 0x7d,
+	0xb2, 0x46, 0xae, 0x62, 0x00, 0xbf, 0x7c, 0xee, 0x6a, 0x07, 0x55, 0x77, 0x62, 0x38, 0xc2, 0x31,
+	0x65, 0x82, 0x4a, 0xc6, 0x69, 0x91, 0xf9, 0x7d, 0x12, 0xf9, 0x39, 0xe2, 0x96, 0xdf, 0x87, 0x4e,
+	0x02, 0x38, 0x1c, 0xc6, 0x1d, 0x21, 0x91, 0x0b, 0x7b, 0x4c, 0x33, 0x2d, 0xed, 0x8f, 0xe9, 0x56,
+	0x86, 0xe6, 0x17, 0xb0, 0x84, 0x01, 0x3c, 0xea, 0x30, 0x19, 0xdc, 0x15, 0x41, 0x1b, 0x75, 0xfd,
+	0x98, 0x5c, 0xb8, 0xb3, 0x3f, 0x92, 0xe2, 0xe2, 0xcc, 0x61, 0xfd, 0x12, 0x85, 0xf3, 0x97, 0x05,
+	0xf6, 0xd2, 0x83, 0x07, 0x18, 0x4a, 0xda, 0xc5, 0x3c, 0xbe, 0xa5, 0x5b, 0xe8, 0xc0, 0x5b, 0x07,
+	0x0a, 0x87, 0x18, 0xa7, 0x6d, 0x9a, 0xe4, 0x77, 0xd0, 0x68, 0xdc, 0xf5, 0x3b, 0x31, 0xde, 0xd1,
+	0xb8, 0x7e, 0x8e, 0xef, 0xcc, 0xc3, 0x99, 0x15, 0x2a, 0xa4, 0xb1, 0x58, 0xa1, 0xc9, 0xba, 0xc8,
+	0x8b, 0xc4, 0x90, 0xbb, 0x79, 0xe1, 0xfb, 0xe3, 0x70, 0xd4, 0xd8, 0xae, 0x22, 0xef, 0xd2, 0x10,
+	0xc9, 0x17, 0x16, 0x4c, 0x66, 0x85, 0x58, 0x97, 0x71, 0xe2, 0x14, 0xf7, 0x65, 0x65, 0xe3, 0xd1,
+	0x3c, 0x3f, 0xd4, 0xa6, 0xa8, 0x14, 0xaf, 0x7c, 0xfc, 0xfb, 0x9f, 0x5f, 0xd7, 0x16, 0x9c, 0x79,
+	0xdd, 0xa5, 0x76, 0xaf, 0xe6, 0xbd, 0xac, 0xf0, 0xb6, 0xcd, 0x68, 0xc7, 0x53, 0x35, 0x51, 0x78,
+	0xdb, 0xea, 0x67, 0xc7, 0xd3, 0x2d, 0xc2, 0xab, 0xd6, 0x15, 0xf2, 0xa9, 0x05, 0x93, 0x59, 0x47,
+	0xf5, 0x4f, 0x62, 0xfa, 0x7a, 0xae, 0xe6, 0xe9, 0xc2, 0xa6, 0xbf, 0x5e, 0x5d, 0xd7, 0x2a, 0x5e,
+	0xbe, 0xb2, 0xf8, 0x5c, 0x2a, 0xbc, 0x6d, 0x1a, 0xc8, 0x1d, 0xf2, 0xb3, 0x05, 0xc7, 0x8c, 0xcf,
+	0x79, 0xd9, 0x27, 0xb3, 0x83, 0x62, 0x86, 0x37, 0x32, 0xcd, 0xb7, 0x46, 0x93, 0xed, 0x1c, 0xdc,
+	0xb9, 0xa6, 0x1d, 0x59, 0x74, 0xdc, 0xbd, 0x3a, 0xd2, 0x56, 0xcb, 0x84, 0x8a, 0xe7, 0xaf, 0x16,
+	0x4c, 0xdd, 0x48, 0x53, 0xce, 0xba, 0x25, 0x3f, 0x5a, 0x95, 0x7e, 0x1c, 0x94, 0x07, 0xaf, 0x6b,
+	0x0f, 0x5e, 0x73, 0xae, 0x3f, 0x9f, 0x07, 0xde, 0x36, 0x8d, 0x76, 0xbc, 0x20, 0xd3, 0xaf, 0xdc,
+	0xf9, 0xdc, 0x82, 0x63, 0x26, 0xf9, 0xcf, 0xe1, 0xcd, 0xc8, 0x36, 0x48, 0x49, 0x15, 0xf9, 0xd2,
+	0x82, 0x46, 0x96, 0x76, 0xb2, 0xeb, 0x34, 0xf4, 0x6f, 0x87, 0x91, 0x5d, 0x30, 0xce, 0x39, 0x2d,
+	0xf8, 0x94, 0x33, 0x35, 0x28, 0x58, 0xc5, 0xe6, 0x13, 0x0b, 0xc6, 0xd4, 0x55, 0x40, 0x4e, 0x0d,
+	0xca, 0xd1, 0xad, 0x5a, 0x73, 0x65, 0x54, 0x32, 0x14, 0x89, 0x63, 0x6b, 0x29, 0x84, 0xec, 0x92,
+	0x42, 0x36, 0x81, 0x2c, 0xa3, 0x1c, 0x28, 0xda, 0x55, 0xa2, 0x2e, 0x16, 0x8f, 0xab, 0xaa, 0xbc,
+	0x33, 0xa7, 0x99, 0x1c, 0xd2, 0xda, 0x9d, 0x25, 0x75, 0xa5, 0xed, 0x78, 0x91, 0x59, 0x49, 0x3e,
+	0xb3, 0xa0, 0xbe, 0x8c, 0x95, 0x5c, 0xa3, 0xcb, 0xc3, 0x05, 0x2d, 0xe9, 0x2c, 0x39, 0x53, 0x21,
+	0x89, 0x6c, 0xc3, 0xf1, 0x65, 0x94, 0xfd, 0x3d, 0x53, 0x95, 0xac, 0x0b, 0xc5, 0xe3, 0xe1, 0x3d,
+	0x96, 0xe3, 0x6a, 0xb6, 0x39, 0x32, 0x5b, 0x15, 0x80, 0xac, 0x49, 0x29, 0x12, 0xf0, 0x04, 0x4e,
+	0x2c, 0xa3, 0x1c, 0x2c, 0x80, 0xcf, 0xce, 0x40, 0x55, 0xc9, 0x74, 0xfe, 0xaf, 0x05, 0x5c, 0x22,
+	0x17, 0xab, 0x04, 0x60, 0xbe, 0x92, 0x7c, 0x67, 0x41, 0x23, 0x7b, 0x55, 0xd9, 0x7d, 0x2a, 0xfa,
+	0x5e, 0x61, 0x46, 0x98, 0x8d, 0x45, 0x2d, 0x6f, 0xbe, 0x39, 0x57, 0x79, 0x8c, 0xdd, 0x0d, 0x94,
+	0x41, 0x14, 0xc8, 0xc0, 0xd5, 0x7a, 0xd5, 0x69, 0x79, 0x0f, 0x1a, 0xd9, 0x45, 0x52, 0x15, 0x97,
+	0xaa, 0x4b, 0xc3, 0xe4, 0xfe, 0x4a, 0x65, 0xee, 0xef, 0x03, 0xa8, 0x13, 0xb2, 0xa4, 0x3f, 0x64,
+	0x54, 0xa1, 0x1f, 0x77, 0xcd, 0x87, 0x0e, 0x6d, 0xa6, 0x4f, 0xd4, 0xac, 0x06, 0x6e, 0x91, 0x99,
+	0xca, 0x28, 0x67, 0x88, 0xdb, 0x3a, 0xbd, 0xa5, 0x17, 0xac, 0x55, 0xa9, 0xc2, 0x7d, 0x76, 0xc8,
+	0x6b, 0x4e, 0xf6, 0x92, 0xd6, 0x9c, 0x1e, 0xf6, 0x57, 0xe1, 0xd0, 0x0b, 0x9a, 0xf7, 0x32, 0xb9,
+	0x54, 0xc5, 0x2b, 0xb6, 0x92, 0x30, 0x7f, 0xbf, 0x4a, 0x61, 0x42, 0x89, 0xd5, 0x7d, 0x46, 0xe9,
+	0xe6, 0xad, 0x68, 0x41, 0x9a, 0xcd, 0xbe, 0xe4, 0x99, 0xbf, 0x0c, 0xef, 0x65, 0xcd, 0x7b, 0x81,
+	0x9c, 0xaf, 0xe2, 0x8d, 0x95, 0xf9, 0xcd, 0x9b, 0xbf, 0x3c, 0x9d, 0xb1, 0x7e, 0x7b, 0x3a, 0x63,
+	0xfd, 0xf1, 0x74, 0xc6, 0x7a, 0xff, 0xa5, 0xbd, 0x7d, 0xdf, 0x0a, 0x63, 0x8a, 0x49, 0xf1, 0x09,
+	0x6d, 0xad, 0xa1, 0x3f, 0x27, 0x2d, 0xfe, 0x3d, 0x00, 0x99, 0xff, 0x6a, 0x82, 0x63, 0x13, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -784,6 +1109,12 @@ type ProjectServiceClient interface {
 	CreateToken(ctx context.Context, in *ProjectTokenCreateRequest, opts ...grpc.CallOption) (*ProjectTokenResponse, error)
 	// Delete a new project token
 	DeleteToken(ctx context.Context, in *ProjectTokenDeleteRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// Create a new just-in-time grant for a project role
+	CreateRoleGrant(ctx context.Context, in *ProjectRoleGrantCreateRequest, opts ...grpc.CallOption) (*v1alpha1.ProjectRoleGrant, error)
+	// Approve a just-in-time grant of a project role
+	ApproveRoleGrant(ctx context.Context, in *ProjectRoleGrantRequest, opts ...grpc.CallOption) (*v1alpha1.ProjectRoleGrant, error)
+	// Revoke a just-in-time grant of a project role
+	DeleteRoleGrant(ctx context.Context, in *ProjectRoleGrantRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	// Create a new project
 	Create(ctx context.Context, in *ProjectCreateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error)
 	// List returns list of projects
@@ -794,6 +1125,8 @@ type ProjectServiceClient interface {
 	Get(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*v1alpha1.AppProject, error)
 	// Get returns a virtual project by name
 	GetGlobalProjects(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*GlobalProjectsResponse, error)
+	// GetEffectiveProject returns a project merged with the policies inherited from its parent projects
+	GetEffectiveProject(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*EffectiveProjectResponse, error)
 	// Update updates a project
 	Update(ctx context.Context, in *ProjectUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error)
 	// Delete deletes a project
@@ -832,6 +1165,33 @@ func (c *projectServiceClient) DeleteToken(ctx context.Context, in *ProjectToken
 	return out, nil
 }
 
+func (c *projectServiceClient) CreateRoleGrant(ctx context.Context, in *ProjectRoleGrantCreateRequest, opts ...grpc.CallOption) (*v1alpha1.ProjectRoleGrant, error) {
+	out := new(v1alpha1.ProjectRoleGrant)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/CreateRoleGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ApproveRoleGrant(ctx context.Context, in *ProjectRoleGrantRequest, opts ...grpc.CallOption) (*v1alpha1.ProjectRoleGrant, error) {
+	out := new(v1alpha1.ProjectRoleGrant)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/ApproveRoleGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) DeleteRoleGrant(ctx context.Context, in *ProjectRoleGrantRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/DeleteRoleGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) Create(ctx context.Context, in *ProjectCreateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error) {
 	out := new(v1alpha1.AppProject)
 	err := c.cc.Invoke(ctx, "/project.ProjectService/Create", in, out, opts...)
@@ -877,6 +1237,15 @@ func (c *projectServiceClient) GetGlobalProjects(ctx context.Context, in *Projec
 	return out, nil
 }
 
+func (c *projectServiceClient) GetEffectiveProject(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*EffectiveProjectResponse, error) {
+	out := new(EffectiveProjectResponse)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/GetEffectiveProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) Update(ctx context.Context, in *ProjectUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error) {
 	out := new(v1alpha1.AppProject)
 	err := c.cc.Invoke(ctx, "/project.ProjectService/Update", in, out, opts...)
@@ -928,6 +1297,12 @@ type ProjectServiceServer interface {
 	CreateToken(context.Context, *ProjectTokenCreateRequest) (*ProjectTokenResponse, error)
 	// Delete a new project token
 	DeleteToken(context.Context, *ProjectTokenDeleteRequest) (*EmptyResponse, error)
+	// Create a new just-in-time grant for a project role
+	CreateRoleGrant(context.Context, *ProjectRoleGrantCreateRequest) (*v1alpha1.ProjectRoleGrant, error)
+	// Approve a just-in-time grant of a project role
+	ApproveRoleGrant(context.Context, *ProjectRoleGrantRequest) (*v1alpha1.ProjectRoleGrant, error)
+	// Revoke a just-in-time grant of a project role
+	DeleteRoleGrant(context.Context, *ProjectRoleGrantRequest) (*EmptyResponse, error)
 	// Create a new project
 	Create(context.Context, *ProjectCreateRequest) (*v1alpha1.AppProject, error)
 	// List returns list of projects
@@ -938,6 +1313,8 @@ type ProjectServiceServer interface {
 	Get(context.Context, *ProjectQuery) (*v1alpha1.AppProject, error)
 	// Get returns a virtual project by name
 	GetGlobalProjects(context.Context, *ProjectQuery) (*GlobalProjectsResponse, error)
+	// GetEffectiveProject returns a project merged with the policies inherited from its parent projects
+	GetEffectiveProject(context.Context, *ProjectQuery) (*EffectiveProjectResponse, error)
 	// Update updates a project
 	Update(context.Context, *ProjectUpdateRequest) (*v1alpha1.AppProject, error)
 	// Delete deletes a project
@@ -960,6 +1337,15 @@ func (*UnimplementedProjectServiceServer) CreateToken(ctx context.Context, req *
 func (*UnimplementedProjectServiceServer) DeleteToken(ctx context.Context, req *ProjectTokenDeleteRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteToken not implemented")
 }
+func (*UnimplementedProjectServiceServer) CreateRoleGrant(ctx context.Context, req *ProjectRoleGrantCreateRequest) (*v1alpha1.ProjectRoleGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoleGrant not implemented")
+}
+func (*UnimplementedProjectServiceServer) ApproveRoleGrant(ctx context.Context, req *ProjectRoleGrantRequest) (*v1alpha1.ProjectRoleGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRoleGrant not implemented")
+}
+func (*UnimplementedProjectServiceServer) DeleteRoleGrant(ctx context.Context, req *ProjectRoleGrantRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleGrant not implemented")
+}
 func (*UnimplementedProjectServiceServer) Create(ctx context.Context, req *ProjectCreateRequest) (*v1alpha1.AppProject, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
@@ -975,6 +1361,9 @@ func (*UnimplementedProjectServiceServer) Get(ctx context.Context, req *ProjectQ
 func (*UnimplementedProjectServiceServer) GetGlobalProjects(ctx context.Context, req *ProjectQuery) (*GlobalProjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGlobalProjects not implemented")
 }
+func (*UnimplementedProjectServiceServer) GetEffectiveProject(ctx context.Context, req *ProjectQuery) (*EffectiveProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectiveProject not implemented")
+}
 func (*UnimplementedProjectServiceServer) Update(ctx context.Context, req *ProjectUpdateRequest) (*v1alpha1.AppProject, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
@@ -1031,62 +1420,116 @@ func _ProjectService_DeleteToken_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProjectCreateRequest)
+func _ProjectService_CreateRoleGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectRoleGrantCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectServiceServer).Create(ctx, in)
+		return srv.(ProjectServiceServer).CreateRoleGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/project.ProjectService/Create",
+		FullMethod: "/project.ProjectService/CreateRoleGrant",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectServiceServer).Create(ctx, req.(*ProjectCreateRequest))
+		return srv.(ProjectServiceServer).CreateRoleGrant(ctx, req.(*ProjectRoleGrantCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProjectQuery)
+func _ProjectService_ApproveRoleGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectRoleGrantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectServiceServer).List(ctx, in)
+		return srv.(ProjectServiceServer).ApproveRoleGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/project.ProjectService/List",
+		FullMethod: "/project.ProjectService/ApproveRoleGrant",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectServiceServer).List(ctx, req.(*ProjectQuery))
+		return srv.(ProjectServiceServer).ApproveRoleGrant(ctx, req.(*ProjectRoleGrantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectService_GetDetailedProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProjectQuery)
+func _ProjectService_DeleteRoleGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectRoleGrantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectServiceServer).GetDetailedProject(ctx, in)
+		return srv.(ProjectServiceServer).DeleteRoleGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/project.ProjectService/GetDetailedProject",
+		FullMethod: "/project.ProjectService/DeleteRoleGrant",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectServiceServer).GetDetailedProject(ctx, req.(*ProjectQuery))
+		return srv.(ProjectServiceServer).DeleteRoleGrant(ctx, req.(*ProjectRoleGrantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProjectQuery)
+func _ProjectService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Create(ctx, req.(*ProjectCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).List(ctx, req.(*ProjectQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetDetailedProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetDetailedProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/GetDetailedProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetDetailedProject(ctx, req.(*ProjectQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1121,6 +1564,24 @@ func _ProjectService_GetGlobalProjects_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_GetEffectiveProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetEffectiveProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/GetEffectiveProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetEffectiveProject(ctx, req.(*ProjectQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProjectUpdateRequest)
 	if err := dec(in); err != nil {
@@ -1223,6 +1684,18 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteToken",
 			Handler:    _ProjectService_DeleteToken_Handler,
 		},
+		{
+			MethodName: "CreateRoleGrant",
+			Handler:    _ProjectService_CreateRoleGrant_Handler,
+		},
+		{
+			MethodName: "ApproveRoleGrant",
+			Handler:    _ProjectService_ApproveRoleGrant_Handler,
+		},
+		{
+			MethodName: "DeleteRoleGrant",
+			Handler:    _ProjectService_DeleteRoleGrant_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _ProjectService_Create_Handler,
@@ -1243,6 +1716,10 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetGlobalProjects",
 			Handler:    _ProjectService_GetGlobalProjects_Handler,
 		},
+		{
+			MethodName: "GetEffectiveProject",
+			Handler:    _ProjectService_GetEffectiveProject_Handler,
+		},
 		{
 			MethodName: "Update",
 			Handler:    _ProjectService_Update_Handler,
@@ -1464,6 +1941,127 @@ func (m *ProjectTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectRoleGrantCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectRoleGrantCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectRoleGrantCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Groups[iNdEx])
+			copy(dAtA[i:], m.Groups[iNdEx])
+			i = encodeVarintProject(dAtA, i, uint64(len(m.Groups[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Policies[iNdEx])
+			copy(dAtA[i:], m.Policies[iNdEx])
+			i = encodeVarintProject(dAtA, i, uint64(len(m.Policies[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Duration) > 0 {
+		i -= len(m.Duration)
+		copy(dAtA[i:], m.Duration)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Duration)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectRoleGrantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectRoleGrantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectRoleGrantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1622,6 +2220,20 @@ func (m *SyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProject(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Windows) > 0 {
 		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1639,6 +2251,71 @@ func (m *SyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncWindowSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Occurrences) > 0 {
+		for iNdEx := len(m.Occurrences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Occurrences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProject(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Window != nil {
+		{
+			size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProject(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GlobalProjectsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1704,6 +2381,18 @@ func (m *DetailedProjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.QuotaUsage != nil {
+		{
+			size, err := m.QuotaUsage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProject(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Clusters) > 0 {
 		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1761,7 +2450,7 @@ func (m *DetailedProjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *ListProjectLinksRequest) Marshal() (dAtA []byte, err error) {
+func (m *EffectiveProjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1771,12 +2460,12 @@ func (m *ListProjectLinksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListProjectLinksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EffectiveProjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListProjectLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EffectiveProjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1785,18 +2474,71 @@ func (m *ListProjectLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintProject(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintProject(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProject(v)
+	if len(m.Origins) > 0 {
+		for iNdEx := len(m.Origins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Origins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProject(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Project != nil {
+		{
+			size, err := m.Project.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProject(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListProjectLinksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListProjectLinksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListProjectLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProject(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProject(v)
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -1899,6 +2641,70 @@ func (m *ProjectTokenResponse) Size() (n int) {
 	return n
 }
 
+func (m *ProjectRoleGrantCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Duration)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if len(m.Policies) > 0 {
+		for _, s := range m.Policies {
+			l = len(s)
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProjectRoleGrantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ProjectQuery) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1971,6 +2777,41 @@ func (m *SyncWindowsResponse) Size() (n int) {
 			n += 1 + l + sovProject(uint64(l))
 		}
 	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncWindowSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovProject(uint64(m.Index))
+	}
+	if m.Window != nil {
+		l = m.Window.Size()
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if len(m.Occurrences) > 0 {
+		for _, e := range m.Occurrences {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2023,6 +2864,32 @@ func (m *DetailedProjectsResponse) Size() (n int) {
 			n += 1 + l + sovProject(uint64(l))
 		}
 	}
+	if m.QuotaUsage != nil {
+		l = m.QuotaUsage.Size()
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EffectiveProjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Project != nil {
+		l = m.Project.Size()
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if len(m.Origins) > 0 {
+		for _, e := range m.Origins {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2605,7 +3472,7 @@ func (m *ProjectTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectQuery) Unmarshal(dAtA []byte) error {
+func (m *ProjectRoleGrantCreateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2628,15 +3495,15 @@ func (m *ProjectQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectRoleGrantCreateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectRoleGrantCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2664,64 +3531,45 @@ func (m *ProjectQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProject(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProject
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProjectUpdateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProject
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectUpdateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProject
@@ -2731,23 +3579,432 @@ func (m *ProjectUpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProject
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProject
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Project == nil {
-				m.Project = &v1alpha1.AppProject{}
+			m.Duration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectRoleGrantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectRoleGrantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectRoleGrantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Project == nil {
+				m.Project = &v1alpha1.AppProject{}
 			}
 			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2857,9 +4114,126 @@ func (m *SyncWindowsQuery) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWindowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, &v1alpha1.SyncWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProject
@@ -2869,23 +4243,25 @@ func (m *SyncWindowsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProject
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProject
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Schedules = append(m.Schedules, &SyncWindowSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2909,7 +4285,7 @@ func (m *SyncWindowsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncWindowsResponse) Unmarshal(dAtA []byte) error {
+func (m *SyncWindowSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2932,15 +4308,34 @@ func (m *SyncWindowsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncWindowsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncWindowSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncWindowSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2967,11 +4362,79 @@ func (m *SyncWindowsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Windows = append(m.Windows, &v1alpha1.SyncWindow{})
-			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Window == nil {
+				m.Window = &v1alpha1.SyncWindow{}
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Occurrences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Occurrences = append(m.Occurrences, &v1alpha1.SyncWindowOccurrence{})
+			if err := m.Occurrences[len(m.Occurrences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
@@ -3246,6 +4709,163 @@ func (m *DetailedProjectsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuotaUsage == nil {
+				m.QuotaUsage = &v1alpha1.ProjectQuotaUsage{}
+			}
+			if err := m.QuotaUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EffectiveProjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EffectiveProjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EffectiveProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Project == nil {
+				m.Project = &v1alpha1.AppProject{}
+			}
+			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origins = append(m.Origins, &v1alpha1.ProjectRuleOrigin{})
+			if err := m.Origins[len(m.Origins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
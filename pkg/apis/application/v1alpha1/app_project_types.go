@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	globutil "github.com/gobwas/glob"
 	"github.com/google/go-cmp/cmp"
@@ -13,6 +14,7 @@ import (
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/argoproj/argo-cd/v3/util/git"
@@ -54,6 +56,8 @@ type AppProject struct {
 type AppProjectStatus struct {
 	// JWTTokensByRole contains a list of JWT tokens issued for a given role
 	JWTTokensByRole map[string]JWTTokens `json:"jwtTokensByRole,omitempty" protobuf:"bytes,1,opt,name=jwtTokensByRole"`
+	// Grants contains the just-in-time grants of the project roles
+	Grants []ProjectRoleGrant `json:"grants,omitempty" protobuf:"bytes,2,rep,name=grants"`
 }
 
 // GetRoleByName returns the role in a project by the name with its index
@@ -168,6 +172,7 @@ func (proj *AppProject) ValidateJWTTokenID(roleName string, id string) error {
 //   - Destinations:
 //   - Rejects invalid wildcard formats like "!*"
 //   - Ensures uniqueness of (server/namespace) or (name/namespace) combinations
+//   - Cluster selectors must be valid label selectors
 //   - SourceNamespaces:
 //   - Must be unique
 //   - SourceRepos:
@@ -186,6 +191,8 @@ func (proj *AppProject) ValidateJWTTokenID(roleName string, id string) error {
 //   - Default service account must not be empty or contain disallowed characters
 //   - Server/namespace values must compile as valid glob patterns
 //   - Each (server/namespace) combination must be unique
+//   - Parent:
+//   - Must not be the project itself
 func (proj *AppProject) ValidateProject() error {
 	destKeys := make(map[string]bool)
 	for _, dest := range proj.Spec.Destinations {
@@ -206,6 +213,12 @@ func (proj *AppProject) ValidateProject() error {
 			// destination cluster set using name instead of server endpoint
 			key = fmt.Sprintf("%s/%s", dest.Name, dest.Namespace)
 		}
+		if dest.ClusterSelector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(dest.ClusterSelector); err != nil {
+				return status.Errorf(codes.InvalidArgument, "cluster selector of destination '%s' has an invalid format: %v", key, err)
+			}
+			key = fmt.Sprintf("%s[%s]", key, metav1.FormatLabelSelector(dest.ClusterSelector))
+		}
 		if _, ok := destKeys[key]; ok {
 			return status.Errorf(codes.InvalidArgument, "destination '%s' already added", key)
 		}
@@ -287,6 +300,15 @@ func (proj *AppProject) ValidateProject() error {
 		}
 	}
 
+	for _, item := range append(slices.Clone(proj.Spec.NamespaceResourceWhitelist), proj.Spec.NamespaceResourceBlacklist...) {
+		if item.LabelSelector == nil {
+			continue
+		}
+		if _, err := metav1.LabelSelectorAsSelector(item.LabelSelector); err != nil {
+			return status.Errorf(codes.InvalidArgument, "label selector of namespaced resource '%s/%s' has an invalid format: %v", item.Group, item.Kind, err)
+		}
+	}
+
 	destServiceAccts := make(map[string]bool)
 	for _, destServiceAcct := range proj.Spec.DestinationServiceAccounts {
 		if strings.Contains(destServiceAcct.Server, "!") {
@@ -319,6 +341,37 @@ func (proj *AppProject) ValidateProject() error {
 		destServiceAccts[key] = true
 	}
 
+	grantIDs := make(map[string]bool)
+	for _, grant := range proj.Status.Grants {
+		if grant.ID == "" {
+			return status.Errorf(codes.InvalidArgument, "grant of role '%s' has no id", grant.Role)
+		}
+		if _, ok := grantIDs[grant.ID]; ok {
+			return status.Errorf(codes.AlreadyExists, "grant '%s' already exists", grant.ID)
+		}
+		if duration, err := time.ParseDuration(grant.Duration); err != nil || duration <= 0 {
+			return status.Errorf(codes.InvalidArgument, "grant '%s' has an invalid duration '%s'", grant.ID, grant.Duration)
+		}
+		if len(grant.Policies) == 0 && len(grant.Groups) == 0 {
+			return status.Errorf(codes.InvalidArgument, "grant '%s' must have at least one policy or group", grant.ID)
+		}
+		for _, policy := range grant.Policies {
+			if err := validatePolicy(proj.Name, grant.Role, policy); err != nil {
+				return err
+			}
+		}
+		for _, group := range grant.Groups {
+			if err := validateGroupName(group); err != nil {
+				return err
+			}
+		}
+		grantIDs[grant.ID] = true
+	}
+
+	if proj.Spec.Parent != "" && proj.Spec.Parent == proj.Name {
+		return status.Errorf(codes.InvalidArgument, "project '%s' cannot be its own parent", proj.Name)
+	}
+
 	return nil
 }
 
@@ -366,6 +419,13 @@ func (proj *AppProject) NormalizePolicies() {
 		}
 		proj.Spec.Roles[i].Policies = normalizedPolicies
 	}
+	for i, grant := range proj.Status.Grants {
+		var normalizedPolicies []string
+		for _, policy := range grant.Policies {
+			normalizedPolicies = append(normalizedPolicies, proj.normalizePolicy(policy))
+		}
+		proj.Status.Grants[i].Policies = normalizedPolicies
+	}
 }
 
 func (proj *AppProject) normalizePolicy(policy string) string {
@@ -381,8 +441,15 @@ func (proj *AppProject) normalizePolicy(policy string) string {
 	return normalizedPolicy
 }
 
-// ProjectPoliciesString returns a Casbin formatted string of a project's policies for each role
+// ProjectPoliciesString returns a Casbin formatted string of a project's policies for each role, including the
+// policies and groups of the role grants which are active
 func (proj *AppProject) ProjectPoliciesString() string {
+	return proj.ProjectPoliciesStringWithGrants(proj.ActiveRoleGrants(time.Now()))
+}
+
+// ProjectPoliciesStringWithGrants returns a Casbin formatted string of a project's policies for each role, including the
+// policies and groups of the given role grants
+func (proj *AppProject) ProjectPoliciesStringWithGrants(grants []ProjectRoleGrant) string {
 	var policies []string
 	for _, role := range proj.Spec.Roles {
 		projectPolicy := fmt.Sprintf("p, proj:%s:%s, projects, get, %s, allow", proj.Name, role.Name, proj.Name)
@@ -391,12 +458,83 @@ func (proj *AppProject) ProjectPoliciesString() string {
 		for _, groupName := range role.Groups {
 			policies = append(policies, fmt.Sprintf("g, %s, proj:%s:%s", groupName, proj.Name, role.Name))
 		}
+		for _, grant := range grants {
+			if grant.Role != role.Name {
+				continue
+			}
+			policies = append(policies, grant.Policies...)
+			for _, groupName := range grant.Groups {
+				policies = append(policies, fmt.Sprintf("g, %s, proj:%s:%s", groupName, proj.Name, role.Name))
+			}
+		}
 	}
 	return strings.Join(policies, "\n")
 }
 
-// IsGroupKindNamePermitted validates if the given resource group/kind is permitted to be deployed in the project
+// IsPending returns whether the grant is waiting for approval
+func (g *ProjectRoleGrant) IsPending() bool {
+	return g.ExpiresAt == 0
+}
+
+// IsActive returns whether the grant is in effect at the given time
+func (g *ProjectRoleGrant) IsActive(now time.Time) bool {
+	return g.ExpiresAt > now.Unix()
+}
+
+// Activate puts the grant into effect from the given time for its duration
+func (g *ProjectRoleGrant) Activate(now time.Time) error {
+	duration, err := time.ParseDuration(g.Duration)
+	if err != nil {
+		return fmt.Errorf("invalid duration '%s': %w", g.Duration, err)
+	}
+	g.ExpiresAt = now.Add(duration).Unix()
+	return nil
+}
+
+// ActiveRoleGrants returns the role grants of the project which are in effect at the given time
+func (proj *AppProject) ActiveRoleGrants(now time.Time) []ProjectRoleGrant {
+	var grants []ProjectRoleGrant
+	for _, grant := range proj.Status.Grants {
+		if grant.IsActive(now) {
+			grants = append(grants, grant)
+		}
+	}
+	return grants
+}
+
+// GetRoleGrant returns the grant of a project role by its ID with its index
+func (proj *AppProject) GetRoleGrant(roleName string, id string) (*ProjectRoleGrant, int, error) {
+	for i, grant := range proj.Status.Grants {
+		if grant.Role == roleName && grant.ID == id {
+			return &grant, i, nil
+		}
+	}
+	return nil, -1, fmt.Errorf("grant '%s' does not exist for role '%s' in project '%s'", id, roleName, proj.Name)
+}
+
+// RemoveExpiredRoleGrants removes the role grants which have expired at the given time and returns whether any grant
+// was removed. Grants which are waiting for approval are kept.
+func (proj *AppProject) RemoveExpiredRoleGrants(now time.Time) bool {
+	grants := slices.DeleteFunc(slices.Clone(proj.Status.Grants), func(grant ProjectRoleGrant) bool {
+		return !grant.IsPending() && !grant.IsActive(now)
+	})
+	if len(grants) == len(proj.Status.Grants) {
+		return false
+	}
+	proj.Status.Grants = grants
+	return true
+}
+
+// IsGroupKindNamePermitted validates if the given resource group/kind is permitted to be deployed in the project.
+// The label selectors of the namespaced resource lists are evaluated against a resource without labels, use
+// IsGroupKindNameLabelsPermitted when the labels of the resource are known.
 func (proj AppProject) IsGroupKindNamePermitted(gk schema.GroupKind, name string, namespaced bool) bool {
+	return proj.IsGroupKindNameLabelsPermitted(gk, name, nil, namespaced)
+}
+
+// IsGroupKindNameLabelsPermitted validates if the given resource group/kind with the given name and labels is permitted
+// to be deployed in the project
+func (proj AppProject) IsGroupKindNameLabelsPermitted(gk schema.GroupKind, name string, resourceLabels map[string]string, namespaced bool) bool {
 	var isWhiteListed, isBlackListed bool
 	res := metav1.GroupKind{Group: gk.Group, Kind: gk.Kind}
 
@@ -404,8 +542,8 @@ func (proj AppProject) IsGroupKindNamePermitted(gk schema.GroupKind, name string
 		namespaceWhitelist := proj.Spec.NamespaceResourceWhitelist
 		namespaceBlacklist := proj.Spec.NamespaceResourceBlacklist
 
-		isWhiteListed = namespaceWhitelist == nil || len(namespaceWhitelist) != 0 && isResourceInList(res, namespaceWhitelist)
-		isBlackListed = len(namespaceBlacklist) != 0 && isResourceInList(res, namespaceBlacklist)
+		isWhiteListed = namespaceWhitelist == nil || len(namespaceWhitelist) != 0 && isNamespacedResourceInList(res, name, resourceLabels, namespaceWhitelist)
+		isBlackListed = len(namespaceBlacklist) != 0 && isNamespacedResourceInList(res, name, resourceLabels, namespaceBlacklist)
 		return isWhiteListed && !isBlackListed
 	}
 
@@ -419,11 +557,17 @@ func (proj AppProject) IsGroupKindNamePermitted(gk schema.GroupKind, name string
 
 // IsLiveResourcePermitted returns whether a live resource found in the cluster is permitted by an AppProject
 func (proj AppProject) IsLiveResourcePermitted(un *unstructured.Unstructured, destCluster *Cluster, projectClusters func(project string) ([]*Cluster, error)) (bool, error) {
-	return proj.IsResourcePermitted(un.GroupVersionKind().GroupKind(), un.GetName(), un.GetNamespace(), destCluster, projectClusters)
+	return proj.isResourcePermitted(un.GroupVersionKind().GroupKind(), un.GetName(), un.GetNamespace(), un.GetLabels(), destCluster, projectClusters)
 }
 
+// IsResourcePermitted returns whether a resource is permitted by an AppProject. The label selectors of the namespaced
+// resource lists are evaluated against a resource without labels, use IsLiveResourcePermitted when the resource is known.
 func (proj AppProject) IsResourcePermitted(groupKind schema.GroupKind, name string, namespace string, destCluster *Cluster, projectClusters func(project string) ([]*Cluster, error)) (bool, error) {
-	if !proj.IsGroupKindNamePermitted(groupKind, name, namespace != "") {
+	return proj.isResourcePermitted(groupKind, name, namespace, nil, destCluster, projectClusters)
+}
+
+func (proj AppProject) isResourcePermitted(groupKind schema.GroupKind, name string, namespace string, resourceLabels map[string]string, destCluster *Cluster, projectClusters func(project string) ([]*Cluster, error)) (bool, error) {
+	if !proj.IsGroupKindNameLabelsPermitted(groupKind, name, resourceLabels, namespace != "") {
 		return false, nil
 	}
 	if namespace != "" {
@@ -485,7 +629,7 @@ func (proj AppProject) IsDestinationPermitted(destCluster *Cluster, destNamespac
 		return false, nil
 	}
 	dst := ApplicationDestination{Server: destCluster.Server, Name: destCluster.Name, Namespace: destNamespace}
-	destinationMatched := proj.isDestinationMatched(dst)
+	destinationMatched := proj.isDestinationMatched(dst, destCluster.Labels)
 	if destinationMatched && proj.Spec.PermitOnlyProjectScopedClusters {
 		clusters, err := projectClusters(proj.Name)
 		if err != nil {
@@ -504,10 +648,23 @@ func (proj AppProject) IsDestinationPermitted(destCluster *Cluster, destNamespac
 	return destinationMatched, nil
 }
 
-func (proj AppProject) isDestinationMatched(dst ApplicationDestination) bool {
+func (proj AppProject) isDestinationMatched(dst ApplicationDestination, clusterLabels map[string]string) bool {
 	anyDestinationMatched := false
 
 	for _, item := range proj.Spec.Destinations {
+		if item.ClusterSelector != nil {
+			if isClusterSelectorMatched(item, dst, clusterLabels) {
+				dstNamespaceMatched := globMatch(item.Namespace, dst.Namespace, true)
+				switch {
+				case dstNamespaceMatched:
+					anyDestinationMatched = true
+				case isDenyPattern(item.Namespace):
+					return false
+				}
+			}
+			continue
+		}
+
 		dstNameMatched := dst.Name != "" && globMatch(item.Name, dst.Name, true)
 		dstServerMatched := dst.Server != "" && globMatch(item.Server, dst.Server, true)
 		dstNamespaceMatched := globMatch(item.Namespace, dst.Namespace, true)
@@ -526,6 +683,22 @@ func (proj AppProject) isDestinationMatched(dst ApplicationDestination) bool {
 	return anyDestinationMatched
 }
 
+// isClusterSelectorMatched checks if the cluster of the destination is selected by the cluster selector of a project
+// destination. The server and name of the project destination are optional and further restrict the selected clusters.
+func isClusterSelectorMatched(item ApplicationDestination, dst ApplicationDestination, clusterLabels map[string]string) bool {
+	selector, err := metav1.LabelSelectorAsSelector(item.ClusterSelector)
+	if err != nil || !selector.Matches(labels.Set(clusterLabels)) {
+		return false
+	}
+	if item.Server != "" && !globMatch(item.Server, dst.Server, true) {
+		return false
+	}
+	if item.Name != "" && !globMatch(item.Name, dst.Name, true) {
+		return false
+	}
+	return true
+}
+
 // isDenyPattern checks if a pattern contains negation
 func isDenyPattern(pattern string) bool {
 	return strings.HasPrefix(pattern, "!")
@@ -822,6 +822,30 @@ type ApplicationSetStatus struct {
 	ResourcesCount int64 `json:"resourcesCount,omitempty" protobuf:"varint,4,opt,name=resourcesCount"`
 	// Health contains information about the applicationset's current health status based on the applicationset conditions
 	Health HealthStatus `json:"health,omitempty" protobuf:"bytes,5,opt,name=health"`
+	// Generators contains diagnostics about the parameters produced by each generator during the last reconciliation.
+	// It is only populated when the argocd.argoproj.io/generator-diagnostics annotation of the ApplicationSet is "true".
+	Generators []ApplicationSetGeneratorStatus `json:"generators,omitempty" protobuf:"bytes,6,rep,name=generators"`
+}
+
+// ApplicationSetGeneratorStatus contains diagnostics about the parameters produced by a generator of an ApplicationSet
+type ApplicationSetGeneratorStatus struct {
+	// Index is the position of the generator in spec.generators
+	Index int64 `json:"index" protobuf:"varint,1,opt,name=index"`
+	// Type is the type of the generator, e.g. List, Git or Matrix
+	Type string `json:"type" protobuf:"bytes,2,opt,name=type"`
+	// ParamsCount is the number of parameter sets produced by the generator, after applying its selector
+	ParamsCount int64 `json:"paramsCount" protobuf:"varint,3,opt,name=paramsCount"`
+	// Params is a snapshot of the parameter sets produced by the generator, each one encoded as JSON. It contains a
+	// limited number of parameter sets, and each one is truncated to a maximum length.
+	Params []string `json:"params,omitempty" protobuf:"bytes,4,rep,name=params"`
+	// ParamsTruncated is true when Params does not contain all parameter sets, or when some of them were truncated
+	ParamsTruncated bool `json:"paramsTruncated,omitempty" protobuf:"varint,5,opt,name=paramsTruncated"`
+	// Error is the error returned while generating the parameters or rendering the template with them, if any
+	Error string `json:"error,omitempty" protobuf:"bytes,6,opt,name=error"`
+	// DurationMilliseconds is the time spent generating the parameters, in milliseconds
+	DurationMilliseconds int64 `json:"durationMilliseconds" protobuf:"varint,7,opt,name=durationMilliseconds"`
+	// GeneratedAt is the time at which the parameters were generated
+	GeneratedAt *metav1.Time `json:"generatedAt,omitempty" protobuf:"bytes,8,opt,name=generatedAt"`
 }
 
 // ApplicationSetCondition contains details about an applicationset condition, which is usually an error or warning
@@ -263,10 +263,38 @@ func (m *ApplicationCondition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationCondition proto.InternalMessageInfo
 
+func (m *ApplicationDependency) Reset()      { *m = ApplicationDependency{} }
+func (*ApplicationDependency) ProtoMessage() {}
+func (*ApplicationDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{8}
+}
+func (m *ApplicationDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDependency.Merge(m, src)
+}
+func (m *ApplicationDependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDependency proto.InternalMessageInfo
+
 func (m *ApplicationDestination) Reset()      { *m = ApplicationDestination{} }
 func (*ApplicationDestination) ProtoMessage() {}
 func (*ApplicationDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{8}
+	return fileDescriptor_c078c3c476799f44, []int{9}
 }
 func (m *ApplicationDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,7 +322,7 @@ var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 func (m *ApplicationDestinationServiceAccount) Reset()      { *m = ApplicationDestinationServiceAccount{} }
 func (*ApplicationDestinationServiceAccount) ProtoMessage() {}
 func (*ApplicationDestinationServiceAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{9}
+	return fileDescriptor_c078c3c476799f44, []int{10}
 }
 func (m *ApplicationDestinationServiceAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,10 +347,94 @@ func (m *ApplicationDestinationServiceAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationDestinationServiceAccount proto.InternalMessageInfo
 
+func (m *ApplicationFleet) Reset()      { *m = ApplicationFleet{} }
+func (*ApplicationFleet) ProtoMessage() {}
+func (*ApplicationFleet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{11}
+}
+func (m *ApplicationFleet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationFleet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationFleet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationFleet.Merge(m, src)
+}
+func (m *ApplicationFleet) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationFleet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationFleet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationFleet proto.InternalMessageInfo
+
+func (m *ApplicationFleetClusterStatus) Reset()      { *m = ApplicationFleetClusterStatus{} }
+func (*ApplicationFleetClusterStatus) ProtoMessage() {}
+func (*ApplicationFleetClusterStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{12}
+}
+func (m *ApplicationFleetClusterStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationFleetClusterStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationFleetClusterStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationFleetClusterStatus.Merge(m, src)
+}
+func (m *ApplicationFleetClusterStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationFleetClusterStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationFleetClusterStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationFleetClusterStatus proto.InternalMessageInfo
+
+func (m *ApplicationFleetRolloutStep) Reset()      { *m = ApplicationFleetRolloutStep{} }
+func (*ApplicationFleetRolloutStep) ProtoMessage() {}
+func (*ApplicationFleetRolloutStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{13}
+}
+func (m *ApplicationFleetRolloutStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationFleetRolloutStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationFleetRolloutStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationFleetRolloutStep.Merge(m, src)
+}
+func (m *ApplicationFleetRolloutStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationFleetRolloutStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationFleetRolloutStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationFleetRolloutStep proto.InternalMessageInfo
+
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{10}
+	return fileDescriptor_c078c3c476799f44, []int{14}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +462,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationMatchExpression) Reset()      { *m = ApplicationMatchExpression{} }
 func (*ApplicationMatchExpression) ProtoMessage() {}
 func (*ApplicationMatchExpression) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{11}
+	return fileDescriptor_c078c3c476799f44, []int{15}
 }
 func (m *ApplicationMatchExpression) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +490,7 @@ var xxx_messageInfo_ApplicationMatchExpression proto.InternalMessageInfo
 func (m *ApplicationPreservedFields) Reset()      { *m = ApplicationPreservedFields{} }
 func (*ApplicationPreservedFields) ProtoMessage() {}
 func (*ApplicationPreservedFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{12}
+	return fileDescriptor_c078c3c476799f44, []int{16}
 }
 func (m *ApplicationPreservedFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +518,7 @@ var xxx_messageInfo_ApplicationPreservedFields proto.InternalMessageInfo
 func (m *ApplicationSet) Reset()      { *m = ApplicationSet{} }
 func (*ApplicationSet) ProtoMessage() {}
 func (*ApplicationSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{13}
+	return fileDescriptor_c078c3c476799f44, []int{17}
 }
 func (m *ApplicationSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +546,7 @@ var xxx_messageInfo_ApplicationSet proto.InternalMessageInfo
 func (m *ApplicationSetApplicationStatus) Reset()      { *m = ApplicationSetApplicationStatus{} }
 func (*ApplicationSetApplicationStatus) ProtoMessage() {}
 func (*ApplicationSetApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{14}
+	return fileDescriptor_c078c3c476799f44, []int{18}
 }
 func (m *ApplicationSetApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +574,7 @@ var xxx_messageInfo_ApplicationSetApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSetCondition) Reset()      { *m = ApplicationSetCondition{} }
 func (*ApplicationSetCondition) ProtoMessage() {}
 func (*ApplicationSetCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{15}
+	return fileDescriptor_c078c3c476799f44, []int{19}
 }
 func (m *ApplicationSetCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +602,7 @@ var xxx_messageInfo_ApplicationSetCondition proto.InternalMessageInfo
 func (m *ApplicationSetGenerator) Reset()      { *m = ApplicationSetGenerator{} }
 func (*ApplicationSetGenerator) ProtoMessage() {}
 func (*ApplicationSetGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{16}
+	return fileDescriptor_c078c3c476799f44, []int{20}
 }
 func (m *ApplicationSetGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,10 +627,38 @@ func (m *ApplicationSetGenerator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSetGenerator proto.InternalMessageInfo
 
+func (m *ApplicationSetGeneratorStatus) Reset()      { *m = ApplicationSetGeneratorStatus{} }
+func (*ApplicationSetGeneratorStatus) ProtoMessage() {}
+func (*ApplicationSetGeneratorStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{21}
+}
+func (m *ApplicationSetGeneratorStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetGeneratorStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetGeneratorStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetGeneratorStatus.Merge(m, src)
+}
+func (m *ApplicationSetGeneratorStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetGeneratorStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetGeneratorStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetGeneratorStatus proto.InternalMessageInfo
+
 func (m *ApplicationSetList) Reset()      { *m = ApplicationSetList{} }
 func (*ApplicationSetList) ProtoMessage() {}
 func (*ApplicationSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{17}
+	return fileDescriptor_c078c3c476799f44, []int{22}
 }
 func (m *ApplicationSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +686,7 @@ var xxx_messageInfo_ApplicationSetList proto.InternalMessageInfo
 func (m *ApplicationSetNestedGenerator) Reset()      { *m = ApplicationSetNestedGenerator{} }
 func (*ApplicationSetNestedGenerator) ProtoMessage() {}
 func (*ApplicationSetNestedGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{18}
+	return fileDescriptor_c078c3c476799f44, []int{23}
 }
 func (m *ApplicationSetNestedGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,7 +716,7 @@ func (m *ApplicationSetResourceIgnoreDifferences) Reset() {
 }
 func (*ApplicationSetResourceIgnoreDifferences) ProtoMessage() {}
 func (*ApplicationSetResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{19}
+	return fileDescriptor_c078c3c476799f44, []int{24}
 }
 func (m *ApplicationSetResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,7 +744,7 @@ var xxx_messageInfo_ApplicationSetResourceIgnoreDifferences proto.InternalMessag
 func (m *ApplicationSetRolloutStep) Reset()      { *m = ApplicationSetRolloutStep{} }
 func (*ApplicationSetRolloutStep) ProtoMessage() {}
 func (*ApplicationSetRolloutStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{20}
+	return fileDescriptor_c078c3c476799f44, []int{25}
 }
 func (m *ApplicationSetRolloutStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +772,7 @@ var xxx_messageInfo_ApplicationSetRolloutStep proto.InternalMessageInfo
 func (m *ApplicationSetRolloutStrategy) Reset()      { *m = ApplicationSetRolloutStrategy{} }
 func (*ApplicationSetRolloutStrategy) ProtoMessage() {}
 func (*ApplicationSetRolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{21}
+	return fileDescriptor_c078c3c476799f44, []int{26}
 }
 func (m *ApplicationSetRolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +800,7 @@ var xxx_messageInfo_ApplicationSetRolloutStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSpec) Reset()      { *m = ApplicationSetSpec{} }
 func (*ApplicationSetSpec) ProtoMessage() {}
 func (*ApplicationSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{22}
+	return fileDescriptor_c078c3c476799f44, []int{27}
 }
 func (m *ApplicationSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +828,7 @@ var xxx_messageInfo_ApplicationSetSpec proto.InternalMessageInfo
 func (m *ApplicationSetStatus) Reset()      { *m = ApplicationSetStatus{} }
 func (*ApplicationSetStatus) ProtoMessage() {}
 func (*ApplicationSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{23}
+	return fileDescriptor_c078c3c476799f44, []int{28}
 }
 func (m *ApplicationSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +856,7 @@ var xxx_messageInfo_ApplicationSetStatus proto.InternalMessageInfo
 func (m *ApplicationSetStrategy) Reset()      { *m = ApplicationSetStrategy{} }
 func (*ApplicationSetStrategy) ProtoMessage() {}
 func (*ApplicationSetStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{24}
+	return fileDescriptor_c078c3c476799f44, []int{29}
 }
 func (m *ApplicationSetStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +884,7 @@ var xxx_messageInfo_ApplicationSetStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSyncPolicy) Reset()      { *m = ApplicationSetSyncPolicy{} }
 func (*ApplicationSetSyncPolicy) ProtoMessage() {}
 func (*ApplicationSetSyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{25}
+	return fileDescriptor_c078c3c476799f44, []int{30}
 }
 func (m *ApplicationSetSyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +912,7 @@ var xxx_messageInfo_ApplicationSetSyncPolicy proto.InternalMessageInfo
 func (m *ApplicationSetTemplate) Reset()      { *m = ApplicationSetTemplate{} }
 func (*ApplicationSetTemplate) ProtoMessage() {}
 func (*ApplicationSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{26}
+	return fileDescriptor_c078c3c476799f44, []int{31}
 }
 func (m *ApplicationSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,7 +940,7 @@ var xxx_messageInfo_ApplicationSetTemplate proto.InternalMessageInfo
 func (m *ApplicationSetTemplateMeta) Reset()      { *m = ApplicationSetTemplateMeta{} }
 func (*ApplicationSetTemplateMeta) ProtoMessage() {}
 func (*ApplicationSetTemplateMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{27}
+	return fileDescriptor_c078c3c476799f44, []int{32}
 }
 func (m *ApplicationSetTemplateMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +968,7 @@ var xxx_messageInfo_ApplicationSetTemplateMeta proto.InternalMessageInfo
 func (m *ApplicationSetTerminalGenerator) Reset()      { *m = ApplicationSetTerminalGenerator{} }
 func (*ApplicationSetTerminalGenerator) ProtoMessage() {}
 func (*ApplicationSetTerminalGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{28}
+	return fileDescriptor_c078c3c476799f44, []int{33}
 }
 func (m *ApplicationSetTerminalGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +996,7 @@ var xxx_messageInfo_ApplicationSetTerminalGenerator proto.InternalMessageInfo
 func (m *ApplicationSetTree) Reset()      { *m = ApplicationSetTree{} }
 func (*ApplicationSetTree) ProtoMessage() {}
 func (*ApplicationSetTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{29}
+	return fileDescriptor_c078c3c476799f44, []int{34}
 }
 func (m *ApplicationSetTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +1024,7 @@ var xxx_messageInfo_ApplicationSetTree proto.InternalMessageInfo
 func (m *ApplicationSetWatchEvent) Reset()      { *m = ApplicationSetWatchEvent{} }
 func (*ApplicationSetWatchEvent) ProtoMessage() {}
 func (*ApplicationSetWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{30}
+	return fileDescriptor_c078c3c476799f44, []int{35}
 }
 func (m *ApplicationSetWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +1052,7 @@ var xxx_messageInfo_ApplicationSetWatchEvent proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{31}
+	return fileDescriptor_c078c3c476799f44, []int{36}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +1080,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{32}
+	return fileDescriptor_c078c3c476799f44, []int{37}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +1108,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{33}
+	return fileDescriptor_c078c3c476799f44, []int{38}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -996,7 +1136,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{34}
+	return fileDescriptor_c078c3c476799f44, []int{39}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1164,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{35}
+	return fileDescriptor_c078c3c476799f44, []int{40}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1192,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{36}
+	return fileDescriptor_c078c3c476799f44, []int{41}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1220,7 @@ var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 func (m *ApplicationSourcePluginParameter) Reset()      { *m = ApplicationSourcePluginParameter{} }
 func (*ApplicationSourcePluginParameter) ProtoMessage() {}
 func (*ApplicationSourcePluginParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{37}
+	return fileDescriptor_c078c3c476799f44, []int{42}
 }
 func (m *ApplicationSourcePluginParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1248,7 @@ var xxx_messageInfo_ApplicationSourcePluginParameter proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{38}
+	return fileDescriptor_c078c3c476799f44, []int{43}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1276,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{39}
+	return fileDescriptor_c078c3c476799f44, []int{44}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1304,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{40}
+	return fileDescriptor_c078c3c476799f44, []int{45}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1332,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{41}
+	return fileDescriptor_c078c3c476799f44, []int{46}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1220,7 +1360,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{42}
+	return fileDescriptor_c078c3c476799f44, []int{47}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1245,10 +1385,38 @@ func (m *ApplicationWatchEvent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 
+func (m *AutomatedRollback) Reset()      { *m = AutomatedRollback{} }
+func (*AutomatedRollback) ProtoMessage() {}
+func (*AutomatedRollback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{48}
+}
+func (m *AutomatedRollback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutomatedRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AutomatedRollback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutomatedRollback.Merge(m, src)
+}
+func (m *AutomatedRollback) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutomatedRollback) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutomatedRollback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutomatedRollback proto.InternalMessageInfo
+
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{43}
+	return fileDescriptor_c078c3c476799f44, []int{49}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1444,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuthBitbucketServer) Reset()      { *m = BasicAuthBitbucketServer{} }
 func (*BasicAuthBitbucketServer) ProtoMessage() {}
 func (*BasicAuthBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{44}
+	return fileDescriptor_c078c3c476799f44, []int{50}
 }
 func (m *BasicAuthBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1304,7 +1472,7 @@ var xxx_messageInfo_BasicAuthBitbucketServer proto.InternalMessageInfo
 func (m *BearerTokenBitbucket) Reset()      { *m = BearerTokenBitbucket{} }
 func (*BearerTokenBitbucket) ProtoMessage() {}
 func (*BearerTokenBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{45}
+	return fileDescriptor_c078c3c476799f44, []int{51}
 }
 func (m *BearerTokenBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1500,7 @@ var xxx_messageInfo_BearerTokenBitbucket proto.InternalMessageInfo
 func (m *BearerTokenBitbucketCloud) Reset()      { *m = BearerTokenBitbucketCloud{} }
 func (*BearerTokenBitbucketCloud) ProtoMessage() {}
 func (*BearerTokenBitbucketCloud) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{46}
+	return fileDescriptor_c078c3c476799f44, []int{52}
 }
 func (m *BearerTokenBitbucketCloud) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1528,7 @@ var xxx_messageInfo_BearerTokenBitbucketCloud proto.InternalMessageInfo
 func (m *ChartDetails) Reset()      { *m = ChartDetails{} }
 func (*ChartDetails) ProtoMessage() {}
 func (*ChartDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{47}
+	return fileDescriptor_c078c3c476799f44, []int{53}
 }
 func (m *ChartDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1556,7 @@ var xxx_messageInfo_ChartDetails proto.InternalMessageInfo
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{48}
+	return fileDescriptor_c078c3c476799f44, []int{54}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1416,7 +1584,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
 func (*ClusterCacheInfo) ProtoMessage() {}
 func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{49}
+	return fileDescriptor_c078c3c476799f44, []int{55}
 }
 func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,7 +1612,7 @@ var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{50}
+	return fileDescriptor_c078c3c476799f44, []int{56}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1472,7 +1640,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterGenerator) Reset()      { *m = ClusterGenerator{} }
 func (*ClusterGenerator) ProtoMessage() {}
 func (*ClusterGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{51}
+	return fileDescriptor_c078c3c476799f44, []int{57}
 }
 func (m *ClusterGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1668,7 @@ var xxx_messageInfo_ClusterGenerator proto.InternalMessageInfo
 func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
 func (*ClusterInfo) ProtoMessage() {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{52}
+	return fileDescriptor_c078c3c476799f44, []int{58}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1528,7 +1696,7 @@ var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{53}
+	return fileDescriptor_c078c3c476799f44, []int{59}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1556,7 +1724,7 @@ var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 func (m *ClusterResourceRestrictionItem) Reset()      { *m = ClusterResourceRestrictionItem{} }
 func (*ClusterResourceRestrictionItem) ProtoMessage() {}
 func (*ClusterResourceRestrictionItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{54}
+	return fileDescriptor_c078c3c476799f44, []int{60}
 }
 func (m *ClusterResourceRestrictionItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1752,7 @@ var xxx_messageInfo_ClusterResourceRestrictionItem proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{55}
+	return fileDescriptor_c078c3c476799f44, []int{61}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +1780,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *CommitMetadata) Reset()      { *m = CommitMetadata{} }
 func (*CommitMetadata) ProtoMessage() {}
 func (*CommitMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{56}
+	return fileDescriptor_c078c3c476799f44, []int{62}
 }
 func (m *CommitMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1640,7 +1808,7 @@ var xxx_messageInfo_CommitMetadata proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{57}
+	return fileDescriptor_c078c3c476799f44, []int{63}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1668,7 +1836,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{58}
+	return fileDescriptor_c078c3c476799f44, []int{64}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1696,7 +1864,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{59}
+	return fileDescriptor_c078c3c476799f44, []int{65}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1724,7 +1892,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConfigMapKeyRef) Reset()      { *m = ConfigMapKeyRef{} }
 func (*ConfigMapKeyRef) ProtoMessage() {}
 func (*ConfigMapKeyRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{60}
+	return fileDescriptor_c078c3c476799f44, []int{66}
 }
 func (m *ConfigMapKeyRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +1920,7 @@ var xxx_messageInfo_ConfigMapKeyRef proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{61}
+	return fileDescriptor_c078c3c476799f44, []int{67}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +1948,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *DrySource) Reset()      { *m = DrySource{} }
 func (*DrySource) ProtoMessage() {}
 func (*DrySource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{62}
+	return fileDescriptor_c078c3c476799f44, []int{68}
 }
 func (m *DrySource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1808,7 +1976,7 @@ var xxx_messageInfo_DrySource proto.InternalMessageInfo
 func (m *DuckTypeGenerator) Reset()      { *m = DuckTypeGenerator{} }
 func (*DuckTypeGenerator) ProtoMessage() {}
 func (*DuckTypeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{63}
+	return fileDescriptor_c078c3c476799f44, []int{69}
 }
 func (m *DuckTypeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1836,7 +2004,7 @@ var xxx_messageInfo_DuckTypeGenerator proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{64}
+	return fileDescriptor_c078c3c476799f44, []int{70}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +2032,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{65}
+	return fileDescriptor_c078c3c476799f44, []int{71}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1892,7 +2060,7 @@ var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 func (m *GitDirectoryGeneratorItem) Reset()      { *m = GitDirectoryGeneratorItem{} }
 func (*GitDirectoryGeneratorItem) ProtoMessage() {}
 func (*GitDirectoryGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{66}
+	return fileDescriptor_c078c3c476799f44, []int{72}
 }
 func (m *GitDirectoryGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1920,7 +2088,7 @@ var xxx_messageInfo_GitDirectoryGeneratorItem proto.InternalMessageInfo
 func (m *GitFileGeneratorItem) Reset()      { *m = GitFileGeneratorItem{} }
 func (*GitFileGeneratorItem) ProtoMessage() {}
 func (*GitFileGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{67}
+	return fileDescriptor_c078c3c476799f44, []int{73}
 }
 func (m *GitFileGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1948,7 +2116,7 @@ var xxx_messageInfo_GitFileGeneratorItem proto.InternalMessageInfo
 func (m *GitGenerator) Reset()      { *m = GitGenerator{} }
 func (*GitGenerator) ProtoMessage() {}
 func (*GitGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{68}
+	return fileDescriptor_c078c3c476799f44, []int{74}
 }
 func (m *GitGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2144,7 @@ var xxx_messageInfo_GitGenerator proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{69}
+	return fileDescriptor_c078c3c476799f44, []int{75}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2004,7 +2172,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{70}
+	return fileDescriptor_c078c3c476799f44, []int{76}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2032,7 +2200,7 @@ var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{71}
+	return fileDescriptor_c078c3c476799f44, []int{77}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2228,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{72}
+	return fileDescriptor_c078c3c476799f44, []int{78}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2088,7 +2256,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
 func (*HelmOptions) ProtoMessage() {}
 func (*HelmOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{73}
+	return fileDescriptor_c078c3c476799f44, []int{79}
 }
 func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2116,7 +2284,7 @@ var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{74}
+	return fileDescriptor_c078c3c476799f44, []int{80}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +2312,7 @@ var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{75}
+	return fileDescriptor_c078c3c476799f44, []int{81}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2172,7 +2340,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{76}
+	return fileDescriptor_c078c3c476799f44, []int{82}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2200,7 +2368,7 @@ var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 func (m *HydrateOperation) Reset()      { *m = HydrateOperation{} }
 func (*HydrateOperation) ProtoMessage() {}
 func (*HydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{77}
+	return fileDescriptor_c078c3c476799f44, []int{83}
 }
 func (m *HydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2228,7 +2396,7 @@ var xxx_messageInfo_HydrateOperation proto.InternalMessageInfo
 func (m *HydrateTo) Reset()      { *m = HydrateTo{} }
 func (*HydrateTo) ProtoMessage() {}
 func (*HydrateTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{78}
+	return fileDescriptor_c078c3c476799f44, []int{84}
 }
 func (m *HydrateTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2256,7 +2424,7 @@ var xxx_messageInfo_HydrateTo proto.InternalMessageInfo
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{79}
+	return fileDescriptor_c078c3c476799f44, []int{85}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2284,7 +2452,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{80}
+	return fileDescriptor_c078c3c476799f44, []int{86}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,7 +2480,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{81}
+	return fileDescriptor_c078c3c476799f44, []int{87}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2508,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{82}
+	return fileDescriptor_c078c3c476799f44, []int{88}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2368,7 +2536,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{83}
+	return fileDescriptor_c078c3c476799f44, []int{89}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2396,7 +2564,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{84}
+	return fileDescriptor_c078c3c476799f44, []int{90}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2424,7 +2592,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KustomizeGvk) Reset()      { *m = KustomizeGvk{} }
 func (*KustomizeGvk) ProtoMessage() {}
 func (*KustomizeGvk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{85}
+	return fileDescriptor_c078c3c476799f44, []int{91}
 }
 func (m *KustomizeGvk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2452,7 +2620,7 @@ var xxx_messageInfo_KustomizeGvk proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{86}
+	return fileDescriptor_c078c3c476799f44, []int{92}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2480,7 +2648,7 @@ var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 func (m *KustomizePatch) Reset()      { *m = KustomizePatch{} }
 func (*KustomizePatch) ProtoMessage() {}
 func (*KustomizePatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{87}
+	return fileDescriptor_c078c3c476799f44, []int{93}
 }
 func (m *KustomizePatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2676,7 @@ var xxx_messageInfo_KustomizePatch proto.InternalMessageInfo
 func (m *KustomizeReplica) Reset()      { *m = KustomizeReplica{} }
 func (*KustomizeReplica) ProtoMessage() {}
 func (*KustomizeReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{88}
+	return fileDescriptor_c078c3c476799f44, []int{94}
 }
 func (m *KustomizeReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2536,7 +2704,7 @@ var xxx_messageInfo_KustomizeReplica proto.InternalMessageInfo
 func (m *KustomizeResId) Reset()      { *m = KustomizeResId{} }
 func (*KustomizeResId) ProtoMessage() {}
 func (*KustomizeResId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{89}
+	return fileDescriptor_c078c3c476799f44, []int{95}
 }
 func (m *KustomizeResId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2564,7 +2732,7 @@ var xxx_messageInfo_KustomizeResId proto.InternalMessageInfo
 func (m *KustomizeSelector) Reset()      { *m = KustomizeSelector{} }
 func (*KustomizeSelector) ProtoMessage() {}
 func (*KustomizeSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{90}
+	return fileDescriptor_c078c3c476799f44, []int{96}
 }
 func (m *KustomizeSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2592,7 +2760,7 @@ var xxx_messageInfo_KustomizeSelector proto.InternalMessageInfo
 func (m *KustomizeVersion) Reset()      { *m = KustomizeVersion{} }
 func (*KustomizeVersion) ProtoMessage() {}
 func (*KustomizeVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{91}
+	return fileDescriptor_c078c3c476799f44, []int{97}
 }
 func (m *KustomizeVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2620,7 +2788,7 @@ var xxx_messageInfo_KustomizeVersion proto.InternalMessageInfo
 func (m *ListGenerator) Reset()      { *m = ListGenerator{} }
 func (*ListGenerator) ProtoMessage() {}
 func (*ListGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{92}
+	return fileDescriptor_c078c3c476799f44, []int{98}
 }
 func (m *ListGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2648,7 +2816,7 @@ var xxx_messageInfo_ListGenerator proto.InternalMessageInfo
 func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
 func (*ManagedNamespaceMetadata) ProtoMessage() {}
 func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{93}
+	return fileDescriptor_c078c3c476799f44, []int{99}
 }
 func (m *ManagedNamespaceMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2676,7 +2844,7 @@ var xxx_messageInfo_ManagedNamespaceMetadata proto.InternalMessageInfo
 func (m *MatrixGenerator) Reset()      { *m = MatrixGenerator{} }
 func (*MatrixGenerator) ProtoMessage() {}
 func (*MatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{94}
+	return fileDescriptor_c078c3c476799f44, []int{100}
 }
 func (m *MatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2704,7 +2872,7 @@ var xxx_messageInfo_MatrixGenerator proto.InternalMessageInfo
 func (m *MergeGenerator) Reset()      { *m = MergeGenerator{} }
 func (*MergeGenerator) ProtoMessage() {}
 func (*MergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{95}
+	return fileDescriptor_c078c3c476799f44, []int{101}
 }
 func (m *MergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2729,10 +2897,38 @@ func (m *MergeGenerator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MergeGenerator proto.InternalMessageInfo
 
+func (m *NamespaceResourceRestrictionItem) Reset()      { *m = NamespaceResourceRestrictionItem{} }
+func (*NamespaceResourceRestrictionItem) ProtoMessage() {}
+func (*NamespaceResourceRestrictionItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{102}
+}
+func (m *NamespaceResourceRestrictionItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceResourceRestrictionItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NamespaceResourceRestrictionItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceResourceRestrictionItem.Merge(m, src)
+}
+func (m *NamespaceResourceRestrictionItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceResourceRestrictionItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceResourceRestrictionItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceResourceRestrictionItem proto.InternalMessageInfo
+
 func (m *NestedMatrixGenerator) Reset()      { *m = NestedMatrixGenerator{} }
 func (*NestedMatrixGenerator) ProtoMessage() {}
 func (*NestedMatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{96}
+	return fileDescriptor_c078c3c476799f44, []int{103}
 }
 func (m *NestedMatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +2956,7 @@ var xxx_messageInfo_NestedMatrixGenerator proto.InternalMessageInfo
 func (m *NestedMergeGenerator) Reset()      { *m = NestedMergeGenerator{} }
 func (*NestedMergeGenerator) ProtoMessage() {}
 func (*NestedMergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{97}
+	return fileDescriptor_c078c3c476799f44, []int{104}
 }
 func (m *NestedMergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2788,7 +2984,7 @@ var xxx_messageInfo_NestedMergeGenerator proto.InternalMessageInfo
 func (m *OCIMetadata) Reset()      { *m = OCIMetadata{} }
 func (*OCIMetadata) ProtoMessage() {}
 func (*OCIMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{98}
+	return fileDescriptor_c078c3c476799f44, []int{105}
 }
 func (m *OCIMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,7 +3012,7 @@ var xxx_messageInfo_OCIMetadata proto.InternalMessageInfo
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{99}
+	return fileDescriptor_c078c3c476799f44, []int{106}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2841,10 +3037,38 @@ func (m *Operation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Operation proto.InternalMessageInfo
 
+func (m *OperationAutomatedRollback) Reset()      { *m = OperationAutomatedRollback{} }
+func (*OperationAutomatedRollback) ProtoMessage() {}
+func (*OperationAutomatedRollback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{107}
+}
+func (m *OperationAutomatedRollback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationAutomatedRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OperationAutomatedRollback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationAutomatedRollback.Merge(m, src)
+}
+func (m *OperationAutomatedRollback) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationAutomatedRollback) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationAutomatedRollback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationAutomatedRollback proto.InternalMessageInfo
+
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{100}
+	return fileDescriptor_c078c3c476799f44, []int{108}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +3096,7 @@ var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{101}
+	return fileDescriptor_c078c3c476799f44, []int{109}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2900,7 +3124,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OptionalArray) Reset()      { *m = OptionalArray{} }
 func (*OptionalArray) ProtoMessage() {}
 func (*OptionalArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{102}
+	return fileDescriptor_c078c3c476799f44, []int{110}
 }
 func (m *OptionalArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2928,7 +3152,7 @@ var xxx_messageInfo_OptionalArray proto.InternalMessageInfo
 func (m *OptionalMap) Reset()      { *m = OptionalMap{} }
 func (*OptionalMap) ProtoMessage() {}
 func (*OptionalMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{103}
+	return fileDescriptor_c078c3c476799f44, []int{111}
 }
 func (m *OptionalMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2956,7 +3180,7 @@ var xxx_messageInfo_OptionalMap proto.InternalMessageInfo
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{104}
+	return fileDescriptor_c078c3c476799f44, []int{112}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2984,7 +3208,7 @@ var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{105}
+	return fileDescriptor_c078c3c476799f44, []int{113}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3009,10 +3233,38 @@ func (m *OrphanedResourcesMonitorSettings) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 
+func (m *OrphanedResourcesPruneSettings) Reset()      { *m = OrphanedResourcesPruneSettings{} }
+func (*OrphanedResourcesPruneSettings) ProtoMessage() {}
+func (*OrphanedResourcesPruneSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{114}
+}
+func (m *OrphanedResourcesPruneSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrphanedResourcesPruneSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OrphanedResourcesPruneSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrphanedResourcesPruneSettings.Merge(m, src)
+}
+func (m *OrphanedResourcesPruneSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrphanedResourcesPruneSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrphanedResourcesPruneSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrphanedResourcesPruneSettings proto.InternalMessageInfo
+
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{106}
+	return fileDescriptor_c078c3c476799f44, []int{115}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3040,7 +3292,7 @@ var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 func (m *PluginConfigMapRef) Reset()      { *m = PluginConfigMapRef{} }
 func (*PluginConfigMapRef) ProtoMessage() {}
 func (*PluginConfigMapRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{107}
+	return fileDescriptor_c078c3c476799f44, []int{116}
 }
 func (m *PluginConfigMapRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3068,7 +3320,7 @@ var xxx_messageInfo_PluginConfigMapRef proto.InternalMessageInfo
 func (m *PluginGenerator) Reset()      { *m = PluginGenerator{} }
 func (*PluginGenerator) ProtoMessage() {}
 func (*PluginGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{108}
+	return fileDescriptor_c078c3c476799f44, []int{117}
 }
 func (m *PluginGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3348,7 @@ var xxx_messageInfo_PluginGenerator proto.InternalMessageInfo
 func (m *PluginInput) Reset()      { *m = PluginInput{} }
 func (*PluginInput) ProtoMessage() {}
 func (*PluginInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{109}
+	return fileDescriptor_c078c3c476799f44, []int{118}
 }
 func (m *PluginInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3121,10 +3373,94 @@ func (m *PluginInput) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PluginInput proto.InternalMessageInfo
 
+func (m *ProjectNamespaceTemplate) Reset()      { *m = ProjectNamespaceTemplate{} }
+func (*ProjectNamespaceTemplate) ProtoMessage() {}
+func (*ProjectNamespaceTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{119}
+}
+func (m *ProjectNamespaceTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectNamespaceTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectNamespaceTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectNamespaceTemplate.Merge(m, src)
+}
+func (m *ProjectNamespaceTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectNamespaceTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectNamespaceTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectNamespaceTemplate proto.InternalMessageInfo
+
+func (m *ProjectQuotaUsage) Reset()      { *m = ProjectQuotaUsage{} }
+func (*ProjectQuotaUsage) ProtoMessage() {}
+func (*ProjectQuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{120}
+}
+func (m *ProjectQuotaUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectQuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectQuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectQuotaUsage.Merge(m, src)
+}
+func (m *ProjectQuotaUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectQuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectQuotaUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectQuotaUsage proto.InternalMessageInfo
+
+func (m *ProjectQuotas) Reset()      { *m = ProjectQuotas{} }
+func (*ProjectQuotas) ProtoMessage() {}
+func (*ProjectQuotas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{121}
+}
+func (m *ProjectQuotas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectQuotas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectQuotas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectQuotas.Merge(m, src)
+}
+func (m *ProjectQuotas) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectQuotas) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectQuotas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectQuotas proto.InternalMessageInfo
+
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{110}
+	return fileDescriptor_c078c3c476799f44, []int{122}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3149,10 +3485,66 @@ func (m *ProjectRole) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 
+func (m *ProjectRoleGrant) Reset()      { *m = ProjectRoleGrant{} }
+func (*ProjectRoleGrant) ProtoMessage() {}
+func (*ProjectRoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{123}
+}
+func (m *ProjectRoleGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectRoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectRoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectRoleGrant.Merge(m, src)
+}
+func (m *ProjectRoleGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectRoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectRoleGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectRoleGrant proto.InternalMessageInfo
+
+func (m *ProjectRuleOrigin) Reset()      { *m = ProjectRuleOrigin{} }
+func (*ProjectRuleOrigin) ProtoMessage() {}
+func (*ProjectRuleOrigin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{124}
+}
+func (m *ProjectRuleOrigin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectRuleOrigin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectRuleOrigin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectRuleOrigin.Merge(m, src)
+}
+func (m *ProjectRuleOrigin) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectRuleOrigin) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectRuleOrigin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectRuleOrigin proto.InternalMessageInfo
+
 func (m *PullRequestGenerator) Reset()      { *m = PullRequestGenerator{} }
 func (*PullRequestGenerator) ProtoMessage() {}
 func (*PullRequestGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{111}
+	return fileDescriptor_c078c3c476799f44, []int{125}
 }
 func (m *PullRequestGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3180,7 +3572,7 @@ var xxx_messageInfo_PullRequestGenerator proto.InternalMessageInfo
 func (m *PullRequestGeneratorAzureDevOps) Reset()      { *m = PullRequestGeneratorAzureDevOps{} }
 func (*PullRequestGeneratorAzureDevOps) ProtoMessage() {}
 func (*PullRequestGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{112}
+	return fileDescriptor_c078c3c476799f44, []int{126}
 }
 func (m *PullRequestGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3208,7 +3600,7 @@ var xxx_messageInfo_PullRequestGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucket) Reset()      { *m = PullRequestGeneratorBitbucket{} }
 func (*PullRequestGeneratorBitbucket) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{113}
+	return fileDescriptor_c078c3c476799f44, []int{127}
 }
 func (m *PullRequestGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,7 +3628,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucket proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucketServer) Reset()      { *m = PullRequestGeneratorBitbucketServer{} }
 func (*PullRequestGeneratorBitbucketServer) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{114}
+	return fileDescriptor_c078c3c476799f44, []int{128}
 }
 func (m *PullRequestGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3264,7 +3656,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucketServer proto.InternalMessageInf
 func (m *PullRequestGeneratorFilter) Reset()      { *m = PullRequestGeneratorFilter{} }
 func (*PullRequestGeneratorFilter) ProtoMessage() {}
 func (*PullRequestGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{115}
+	return fileDescriptor_c078c3c476799f44, []int{129}
 }
 func (m *PullRequestGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3292,7 +3684,7 @@ var xxx_messageInfo_PullRequestGeneratorFilter proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitLab) Reset()      { *m = PullRequestGeneratorGitLab{} }
 func (*PullRequestGeneratorGitLab) ProtoMessage() {}
 func (*PullRequestGeneratorGitLab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{116}
+	return fileDescriptor_c078c3c476799f44, []int{130}
 }
 func (m *PullRequestGeneratorGitLab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3320,7 +3712,7 @@ var xxx_messageInfo_PullRequestGeneratorGitLab proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitea) Reset()      { *m = PullRequestGeneratorGitea{} }
 func (*PullRequestGeneratorGitea) ProtoMessage() {}
 func (*PullRequestGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{117}
+	return fileDescriptor_c078c3c476799f44, []int{131}
 }
 func (m *PullRequestGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3348,7 +3740,7 @@ var xxx_messageInfo_PullRequestGeneratorGitea proto.InternalMessageInfo
 func (m *PullRequestGeneratorGithub) Reset()      { *m = PullRequestGeneratorGithub{} }
 func (*PullRequestGeneratorGithub) ProtoMessage() {}
 func (*PullRequestGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{118}
+	return fileDescriptor_c078c3c476799f44, []int{132}
 }
 func (m *PullRequestGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3376,7 +3768,7 @@ var xxx_messageInfo_PullRequestGeneratorGithub proto.InternalMessageInfo
 func (m *RefTarget) Reset()      { *m = RefTarget{} }
 func (*RefTarget) ProtoMessage() {}
 func (*RefTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{119}
+	return fileDescriptor_c078c3c476799f44, []int{133}
 }
 func (m *RefTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3796,7 @@ var xxx_messageInfo_RefTarget proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{120}
+	return fileDescriptor_c078c3c476799f44, []int{134}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3432,7 +3824,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{121}
+	return fileDescriptor_c078c3c476799f44, []int{135}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3460,7 +3852,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{122}
+	return fileDescriptor_c078c3c476799f44, []int{136}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3488,7 +3880,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{123}
+	return fileDescriptor_c078c3c476799f44, []int{137}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3908,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{124}
+	return fileDescriptor_c078c3c476799f44, []int{138}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +3936,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{125}
+	return fileDescriptor_c078c3c476799f44, []int{139}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3572,7 +3964,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{126}
+	return fileDescriptor_c078c3c476799f44, []int{140}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3600,7 +3992,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{127}
+	return fileDescriptor_c078c3c476799f44, []int{141}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +4020,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{128}
+	return fileDescriptor_c078c3c476799f44, []int{142}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3656,7 +4048,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{129}
+	return fileDescriptor_c078c3c476799f44, []int{143}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3684,7 +4076,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{130}
+	return fileDescriptor_c078c3c476799f44, []int{144}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3712,7 +4104,7 @@ var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{131}
+	return fileDescriptor_c078c3c476799f44, []int{145}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3740,7 +4132,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{132}
+	return fileDescriptor_c078c3c476799f44, []int{146}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3768,7 +4160,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{133}
+	return fileDescriptor_c078c3c476799f44, []int{147}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,7 +4188,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{134}
+	return fileDescriptor_c078c3c476799f44, []int{148}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3824,7 +4216,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{135}
+	return fileDescriptor_c078c3c476799f44, []int{149}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3852,7 +4244,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{136}
+	return fileDescriptor_c078c3c476799f44, []int{150}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3880,7 +4272,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{137}
+	return fileDescriptor_c078c3c476799f44, []int{151}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3908,7 +4300,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{138}
+	return fileDescriptor_c078c3c476799f44, []int{152}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3936,7 +4328,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{139}
+	return fileDescriptor_c078c3c476799f44, []int{153}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3964,7 +4356,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{140}
+	return fileDescriptor_c078c3c476799f44, []int{154}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3992,7 +4384,7 @@ var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 func (m *RevisionReference) Reset()      { *m = RevisionReference{} }
 func (*RevisionReference) ProtoMessage() {}
 func (*RevisionReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{141}
+	return fileDescriptor_c078c3c476799f44, []int{155}
 }
 func (m *RevisionReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4020,7 +4412,7 @@ var xxx_messageInfo_RevisionReference proto.InternalMessageInfo
 func (m *SCMProviderGenerator) Reset()      { *m = SCMProviderGenerator{} }
 func (*SCMProviderGenerator) ProtoMessage() {}
 func (*SCMProviderGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{142}
+	return fileDescriptor_c078c3c476799f44, []int{156}
 }
 func (m *SCMProviderGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4048,7 +4440,7 @@ var xxx_messageInfo_SCMProviderGenerator proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAWSCodeCommit) Reset()      { *m = SCMProviderGeneratorAWSCodeCommit{} }
 func (*SCMProviderGeneratorAWSCodeCommit) ProtoMessage() {}
 func (*SCMProviderGeneratorAWSCodeCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{143}
+	return fileDescriptor_c078c3c476799f44, []int{157}
 }
 func (m *SCMProviderGeneratorAWSCodeCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4076,7 +4468,7 @@ var xxx_messageInfo_SCMProviderGeneratorAWSCodeCommit proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAzureDevOps) Reset()      { *m = SCMProviderGeneratorAzureDevOps{} }
 func (*SCMProviderGeneratorAzureDevOps) ProtoMessage() {}
 func (*SCMProviderGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{144}
+	return fileDescriptor_c078c3c476799f44, []int{158}
 }
 func (m *SCMProviderGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4496,7 @@ var xxx_messageInfo_SCMProviderGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucket) Reset()      { *m = SCMProviderGeneratorBitbucket{} }
 func (*SCMProviderGeneratorBitbucket) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{145}
+	return fileDescriptor_c078c3c476799f44, []int{159}
 }
 func (m *SCMProviderGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4132,7 +4524,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucket proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucketServer) Reset()      { *m = SCMProviderGeneratorBitbucketServer{} }
 func (*SCMProviderGeneratorBitbucketServer) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{146}
+	return fileDescriptor_c078c3c476799f44, []int{160}
 }
 func (m *SCMProviderGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4160,7 +4552,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucketServer proto.InternalMessageInf
 func (m *SCMProviderGeneratorFilter) Reset()      { *m = SCMProviderGeneratorFilter{} }
 func (*SCMProviderGeneratorFilter) ProtoMessage() {}
 func (*SCMProviderGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{147}
+	return fileDescriptor_c078c3c476799f44, []int{161}
 }
 func (m *SCMProviderGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4188,7 +4580,7 @@ var xxx_messageInfo_SCMProviderGeneratorFilter proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitea) Reset()      { *m = SCMProviderGeneratorGitea{} }
 func (*SCMProviderGeneratorGitea) ProtoMessage() {}
 func (*SCMProviderGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{148}
+	return fileDescriptor_c078c3c476799f44, []int{162}
 }
 func (m *SCMProviderGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4216,7 +4608,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitea proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGithub) Reset()      { *m = SCMProviderGeneratorGithub{} }
 func (*SCMProviderGeneratorGithub) ProtoMessage() {}
 func (*SCMProviderGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{149}
+	return fileDescriptor_c078c3c476799f44, []int{163}
 }
 func (m *SCMProviderGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4244,7 +4636,7 @@ var xxx_messageInfo_SCMProviderGeneratorGithub proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitlab) Reset()      { *m = SCMProviderGeneratorGitlab{} }
 func (*SCMProviderGeneratorGitlab) ProtoMessage() {}
 func (*SCMProviderGeneratorGitlab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{150}
+	return fileDescriptor_c078c3c476799f44, []int{164}
 }
 func (m *SCMProviderGeneratorGitlab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4272,7 +4664,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitlab proto.InternalMessageInfo
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{151}
+	return fileDescriptor_c078c3c476799f44, []int{165}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +4692,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{152}
+	return fileDescriptor_c078c3c476799f44, []int{166}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4328,7 +4720,7 @@ var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 func (m *SourceHydrator) Reset()      { *m = SourceHydrator{} }
 func (*SourceHydrator) ProtoMessage() {}
 func (*SourceHydrator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{153}
+	return fileDescriptor_c078c3c476799f44, []int{167}
 }
 func (m *SourceHydrator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4356,7 +4748,7 @@ var xxx_messageInfo_SourceHydrator proto.InternalMessageInfo
 func (m *SourceHydratorStatus) Reset()      { *m = SourceHydratorStatus{} }
 func (*SourceHydratorStatus) ProtoMessage() {}
 func (*SourceHydratorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{154}
+	return fileDescriptor_c078c3c476799f44, []int{168}
 }
 func (m *SourceHydratorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4384,7 +4776,7 @@ var xxx_messageInfo_SourceHydratorStatus proto.InternalMessageInfo
 func (m *SourceIntegrity) Reset()      { *m = SourceIntegrity{} }
 func (*SourceIntegrity) ProtoMessage() {}
 func (*SourceIntegrity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{155}
+	return fileDescriptor_c078c3c476799f44, []int{169}
 }
 func (m *SourceIntegrity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4412,7 +4804,7 @@ var xxx_messageInfo_SourceIntegrity proto.InternalMessageInfo
 func (m *SourceIntegrityCheckResult) Reset()      { *m = SourceIntegrityCheckResult{} }
 func (*SourceIntegrityCheckResult) ProtoMessage() {}
 func (*SourceIntegrityCheckResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{156}
+	return fileDescriptor_c078c3c476799f44, []int{170}
 }
 func (m *SourceIntegrityCheckResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4440,7 +4832,7 @@ var xxx_messageInfo_SourceIntegrityCheckResult proto.InternalMessageInfo
 func (m *SourceIntegrityCheckResultItem) Reset()      { *m = SourceIntegrityCheckResultItem{} }
 func (*SourceIntegrityCheckResultItem) ProtoMessage() {}
 func (*SourceIntegrityCheckResultItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{157}
+	return fileDescriptor_c078c3c476799f44, []int{171}
 }
 func (m *SourceIntegrityCheckResultItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4468,7 +4860,7 @@ var xxx_messageInfo_SourceIntegrityCheckResultItem proto.InternalMessageInfo
 func (m *SourceIntegrityGit) Reset()      { *m = SourceIntegrityGit{} }
 func (*SourceIntegrityGit) ProtoMessage() {}
 func (*SourceIntegrityGit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{158}
+	return fileDescriptor_c078c3c476799f44, []int{172}
 }
 func (m *SourceIntegrityGit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4496,7 +4888,7 @@ var xxx_messageInfo_SourceIntegrityGit proto.InternalMessageInfo
 func (m *SourceIntegrityGitPolicy) Reset()      { *m = SourceIntegrityGitPolicy{} }
 func (*SourceIntegrityGitPolicy) ProtoMessage() {}
 func (*SourceIntegrityGitPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{159}
+	return fileDescriptor_c078c3c476799f44, []int{173}
 }
 func (m *SourceIntegrityGitPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4524,7 +4916,7 @@ var xxx_messageInfo_SourceIntegrityGitPolicy proto.InternalMessageInfo
 func (m *SourceIntegrityGitPolicyGPG) Reset()      { *m = SourceIntegrityGitPolicyGPG{} }
 func (*SourceIntegrityGitPolicyGPG) ProtoMessage() {}
 func (*SourceIntegrityGitPolicyGPG) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{160}
+	return fileDescriptor_c078c3c476799f44, []int{174}
 }
 func (m *SourceIntegrityGitPolicyGPG) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4552,7 +4944,7 @@ var xxx_messageInfo_SourceIntegrityGitPolicyGPG proto.InternalMessageInfo
 func (m *SourceIntegrityGitPolicyRepo) Reset()      { *m = SourceIntegrityGitPolicyRepo{} }
 func (*SourceIntegrityGitPolicyRepo) ProtoMessage() {}
 func (*SourceIntegrityGitPolicyRepo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{161}
+	return fileDescriptor_c078c3c476799f44, []int{175}
 }
 func (m *SourceIntegrityGitPolicyRepo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4580,7 +4972,7 @@ var xxx_messageInfo_SourceIntegrityGitPolicyRepo proto.InternalMessageInfo
 func (m *SuccessfulHydrateOperation) Reset()      { *m = SuccessfulHydrateOperation{} }
 func (*SuccessfulHydrateOperation) ProtoMessage() {}
 func (*SuccessfulHydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{162}
+	return fileDescriptor_c078c3c476799f44, []int{176}
 }
 func (m *SuccessfulHydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4608,7 +5000,7 @@ var xxx_messageInfo_SuccessfulHydrateOperation proto.InternalMessageInfo
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{163}
+	return fileDescriptor_c078c3c476799f44, []int{177}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4636,7 +5028,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{164}
+	return fileDescriptor_c078c3c476799f44, []int{178}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4664,7 +5056,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{165}
+	return fileDescriptor_c078c3c476799f44, []int{179}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4692,7 +5084,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{166}
+	return fileDescriptor_c078c3c476799f44, []int{180}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4720,7 +5112,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{167}
+	return fileDescriptor_c078c3c476799f44, []int{181}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4748,7 +5140,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncSource) Reset()      { *m = SyncSource{} }
 func (*SyncSource) ProtoMessage() {}
 func (*SyncSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{168}
+	return fileDescriptor_c078c3c476799f44, []int{182}
 }
 func (m *SyncSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4776,7 +5168,7 @@ var xxx_messageInfo_SyncSource proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{169}
+	return fileDescriptor_c078c3c476799f44, []int{183}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4804,7 +5196,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{170}
+	return fileDescriptor_c078c3c476799f44, []int{184}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4832,7 +5224,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{171}
+	return fileDescriptor_c078c3c476799f44, []int{185}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4860,7 +5252,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{172}
+	return fileDescriptor_c078c3c476799f44, []int{186}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4885,10 +5277,38 @@ func (m *SyncStrategyHook) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 
+func (m *SyncTimeouts) Reset()      { *m = SyncTimeouts{} }
+func (*SyncTimeouts) ProtoMessage() {}
+func (*SyncTimeouts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{187}
+}
+func (m *SyncTimeouts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncTimeouts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncTimeouts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncTimeouts.Merge(m, src)
+}
+func (m *SyncTimeouts) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncTimeouts) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncTimeouts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncTimeouts proto.InternalMessageInfo
+
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{173}
+	return fileDescriptor_c078c3c476799f44, []int{188}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4913,10 +5333,94 @@ func (m *SyncWindow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 
+func (m *SyncWindowCalendar) Reset()      { *m = SyncWindowCalendar{} }
+func (*SyncWindowCalendar) ProtoMessage() {}
+func (*SyncWindowCalendar) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{189}
+}
+func (m *SyncWindowCalendar) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowCalendar) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncWindowCalendar) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowCalendar.Merge(m, src)
+}
+func (m *SyncWindowCalendar) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowCalendar) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowCalendar.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowCalendar proto.InternalMessageInfo
+
+func (m *SyncWindowCalendarConfigMapRef) Reset()      { *m = SyncWindowCalendarConfigMapRef{} }
+func (*SyncWindowCalendarConfigMapRef) ProtoMessage() {}
+func (*SyncWindowCalendarConfigMapRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{190}
+}
+func (m *SyncWindowCalendarConfigMapRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowCalendarConfigMapRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncWindowCalendarConfigMapRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowCalendarConfigMapRef.Merge(m, src)
+}
+func (m *SyncWindowCalendarConfigMapRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowCalendarConfigMapRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowCalendarConfigMapRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowCalendarConfigMapRef proto.InternalMessageInfo
+
+func (m *SyncWindowOccurrence) Reset()      { *m = SyncWindowOccurrence{} }
+func (*SyncWindowOccurrence) ProtoMessage() {}
+func (*SyncWindowOccurrence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{191}
+}
+func (m *SyncWindowOccurrence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowOccurrence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncWindowOccurrence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowOccurrence.Merge(m, src)
+}
+func (m *SyncWindowOccurrence) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowOccurrence) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowOccurrence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowOccurrence proto.InternalMessageInfo
+
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{174}
+	return fileDescriptor_c078c3c476799f44, []int{192}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4944,7 +5448,7 @@ var xxx_messageInfo_TLSClientConfig proto.InternalMessageInfo
 func (m *TagFilter) Reset()      { *m = TagFilter{} }
 func (*TagFilter) ProtoMessage() {}
 func (*TagFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{175}
+	return fileDescriptor_c078c3c476799f44, []int{193}
 }
 func (m *TagFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4979,8 +5483,12 @@ func init() {
 	proto.RegisterMapType((map[string]JWTTokens)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProjectStatus.JwtTokensByRoleEntry")
 	proto.RegisterType((*Application)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Application")
 	proto.RegisterType((*ApplicationCondition)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationCondition")
+	proto.RegisterType((*ApplicationDependency)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDependency")
 	proto.RegisterType((*ApplicationDestination)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDestination")
 	proto.RegisterType((*ApplicationDestinationServiceAccount)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDestinationServiceAccount")
+	proto.RegisterType((*ApplicationFleet)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationFleet")
+	proto.RegisterType((*ApplicationFleetClusterStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationFleetClusterStatus")
+	proto.RegisterType((*ApplicationFleetRolloutStep)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationFleetRolloutStep")
 	proto.RegisterType((*ApplicationList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationList")
 	proto.RegisterType((*ApplicationMatchExpression)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationMatchExpression")
 	proto.RegisterType((*ApplicationPreservedFields)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationPreservedFields")
@@ -4988,6 +5496,7 @@ func init() {
 	proto.RegisterType((*ApplicationSetApplicationStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetApplicationStatus")
 	proto.RegisterType((*ApplicationSetCondition)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetCondition")
 	proto.RegisterType((*ApplicationSetGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetGenerator")
+	proto.RegisterType((*ApplicationSetGeneratorStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetGeneratorStatus")
 	proto.RegisterType((*ApplicationSetList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetList")
 	proto.RegisterType((*ApplicationSetNestedGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetNestedGenerator")
 	proto.RegisterType((*ApplicationSetResourceIgnoreDifferences)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetResourceIgnoreDifferences")
@@ -5018,6 +5527,7 @@ func init() {
 	proto.RegisterType((*ApplicationSummary)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSummary")
 	proto.RegisterType((*ApplicationTree)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationTree")
 	proto.RegisterType((*ApplicationWatchEvent)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationWatchEvent")
+	proto.RegisterType((*AutomatedRollback)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AutomatedRollback")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Backoff")
 	proto.RegisterType((*BasicAuthBitbucketServer)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.BasicAuthBitbucketServer")
 	proto.RegisterType((*BearerTokenBitbucket)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.BearerTokenBitbucket")
@@ -5081,10 +5591,12 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata.LabelsEntry")
 	proto.RegisterType((*MatrixGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.MatrixGenerator")
 	proto.RegisterType((*MergeGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.MergeGenerator")
+	proto.RegisterType((*NamespaceResourceRestrictionItem)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NamespaceResourceRestrictionItem")
 	proto.RegisterType((*NestedMatrixGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NestedMatrixGenerator")
 	proto.RegisterType((*NestedMergeGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NestedMergeGenerator")
 	proto.RegisterType((*OCIMetadata)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OCIMetadata")
 	proto.RegisterType((*Operation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Operation")
+	proto.RegisterType((*OperationAutomatedRollback)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OperationAutomatedRollback")
 	proto.RegisterType((*OperationInitiator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OperationInitiator")
 	proto.RegisterType((*OperationState)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OperationState")
 	proto.RegisterType((*OptionalArray)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OptionalArray")
@@ -5092,13 +5604,21 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OptionalMap.MapEntry")
 	proto.RegisterType((*OrphanedResourceKey)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OrphanedResourceKey")
 	proto.RegisterType((*OrphanedResourcesMonitorSettings)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OrphanedResourcesMonitorSettings")
+	proto.RegisterType((*OrphanedResourcesPruneSettings)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OrphanedResourcesPruneSettings")
 	proto.RegisterType((*OverrideIgnoreDiff)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OverrideIgnoreDiff")
 	proto.RegisterType((*PluginConfigMapRef)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.PluginConfigMapRef")
 	proto.RegisterType((*PluginGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.PluginGenerator")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.PluginGenerator.ValuesEntry")
 	proto.RegisterType((*PluginInput)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.PluginInput")
 	proto.RegisterMapType((PluginParameters)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.PluginInput.ParametersEntry")
+	proto.RegisterType((*ProjectNamespaceTemplate)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ProjectNamespaceTemplate")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ProjectNamespaceTemplate.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ProjectNamespaceTemplate.LabelsEntry")
+	proto.RegisterType((*ProjectQuotaUsage)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ProjectQuotaUsage")
+	proto.RegisterType((*ProjectQuotas)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ProjectQuotas")
 	proto.RegisterType((*ProjectRole)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ProjectRole")
+	proto.RegisterType((*ProjectRoleGrant)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ProjectRoleGrant")
+	proto.RegisterType((*ProjectRuleOrigin)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ProjectRuleOrigin")
 	proto.RegisterType((*PullRequestGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.PullRequestGenerator")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.PullRequestGenerator.ValuesEntry")
 	proto.RegisterType((*PullRequestGeneratorAzureDevOps)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.PullRequestGeneratorAzureDevOps")
@@ -5165,7 +5685,11 @@ func init() {
 	proto.RegisterType((*SyncStrategy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategy")
 	proto.RegisterType((*SyncStrategyApply)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategyApply")
 	proto.RegisterType((*SyncStrategyHook)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategyHook")
+	proto.RegisterType((*SyncTimeouts)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncTimeouts")
 	proto.RegisterType((*SyncWindow)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindow")
+	proto.RegisterType((*SyncWindowCalendar)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindowCalendar")
+	proto.RegisterType((*SyncWindowCalendarConfigMapRef)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindowCalendarConfigMapRef")
+	proto.RegisterType((*SyncWindowOccurrence)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindowOccurrence")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TLSClientConfig")
 	proto.RegisterType((*TagFilter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TagFilter")
 }
@@ -5175,833 +5699,930 @@ func init() {
 }
 
 var fileDescriptor_c078c3c476799f44 = []byte{
-	// 13202 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x90, 0x24, 0xc9,
-	0x55, 0x18, 0x7e, 0xd5, 0x3d, 0x3d, 0xd3, 0xfd, 0xe6, 0x6b, 0x27, 0x6f, 0xf7, 0x6e, 0x76, 0x6f,
-	0x6f, 0x67, 0x55, 0x87, 0x4e, 0xc7, 0x4f, 0xa7, 0x19, 0x6e, 0xa5, 0x13, 0xf7, 0xe3, 0x40, 0x78,
-	0x3e, 0x76, 0x67, 0x67, 0x77, 0x66, 0x67, 0x94, 0x3d, 0xbb, 0xcb, 0x9d, 0x74, 0x92, 0x6a, 0xba,
-	0x73, 0x7a, 0x6a, 0xa7, 0xba, 0xaa, 0xaf, 0xaa, 0x7a, 0x76, 0xfb, 0x10, 0x42, 0x42, 0x08, 0x24,
-	0x24, 0x24, 0x81, 0x1c, 0xe6, 0xc0, 0x16, 0x16, 0x46, 0xf8, 0x23, 0x1c, 0x18, 0x6c, 0x87, 0x31,
-	0x61, 0x20, 0x08, 0x03, 0x41, 0x40, 0xf8, 0x03, 0x4c, 0x60, 0xc0, 0x06, 0xc6, 0xd2, 0x3a, 0x1c,
-	0x10, 0xfe, 0x03, 0x07, 0xb6, 0xc3, 0x61, 0x9f, 0x09, 0xc2, 0x91, 0xdf, 0x59, 0xd5, 0xd5, 0x33,
-	0x3d, 0xdb, 0x35, 0xbb, 0x2b, 0x71, 0xff, 0x75, 0xe7, 0x7b, 0xf9, 0x5e, 0x56, 0x56, 0xd6, 0x7b,
-	0x2f, 0x5f, 0xbe, 0xf7, 0x12, 0x56, 0x1b, 0x6e, 0xbc, 0xd3, 0xde, 0x9a, 0xad, 0x05, 0xcd, 0x39,
-	0x27, 0x6c, 0x04, 0xad, 0x30, 0xb8, 0xc5, 0x7e, 0xbc, 0xa3, 0x56, 0x9f, 0xdb, 0x7b, 0xe7, 0x5c,
-	0x6b, 0xb7, 0x31, 0xe7, 0xb4, 0xdc, 0x68, 0xce, 0x69, 0xb5, 0x3c, 0xb7, 0xe6, 0xc4, 0x6e, 0xe0,
-	0xcf, 0xed, 0x3d, 0xe7, 0x78, 0xad, 0x1d, 0xe7, 0xb9, 0xb9, 0x06, 0xf1, 0x49, 0xe8, 0xc4, 0xa4,
-	0x3e, 0xdb, 0x0a, 0x83, 0x38, 0x40, 0xdf, 0xaa, 0xa9, 0xcd, 0x4a, 0x6a, 0xec, 0xc7, 0x07, 0x6b,
-	0xf5, 0xd9, 0xbd, 0x77, 0xce, 0xb6, 0x76, 0x1b, 0xb3, 0x94, 0xda, 0xac, 0x41, 0x6d, 0x56, 0x52,
-	0x3b, 0xf3, 0x0e, 0x63, 0x2c, 0x8d, 0xa0, 0x11, 0xcc, 0x31, 0xa2, 0x5b, 0xed, 0x6d, 0xf6, 0x8f,
-	0xfd, 0x61, 0xbf, 0x38, 0xb3, 0x33, 0xf6, 0xee, 0x0b, 0xd1, 0xac, 0x1b, 0xd0, 0xe1, 0xcd, 0xd5,
-	0x82, 0x90, 0xcc, 0xed, 0x75, 0x0d, 0xe8, 0xcc, 0x65, 0x8d, 0x43, 0xee, 0xc4, 0xc4, 0x8f, 0xdc,
-	0xc0, 0x8f, 0xde, 0x41, 0x87, 0x40, 0xc2, 0x3d, 0x12, 0x9a, 0x8f, 0x67, 0x20, 0x64, 0x51, 0x7a,
-	0x97, 0xa6, 0xd4, 0x74, 0x6a, 0x3b, 0xae, 0x4f, 0xc2, 0x8e, 0xee, 0xde, 0x24, 0xb1, 0x93, 0xd5,
-	0x6b, 0xae, 0x57, 0xaf, 0xb0, 0xed, 0xc7, 0x6e, 0x93, 0x74, 0x75, 0x78, 0xf7, 0x61, 0x1d, 0xa2,
-	0xda, 0x0e, 0x69, 0x3a, 0x5d, 0xfd, 0xde, 0xd9, 0xab, 0x5f, 0x3b, 0x76, 0xbd, 0x39, 0xd7, 0x8f,
-	0xa3, 0x38, 0x4c, 0x77, 0xb2, 0xff, 0x96, 0x05, 0xe3, 0xf3, 0x37, 0xab, 0xf3, 0xed, 0x78, 0x67,
-	0x31, 0xf0, 0xb7, 0xdd, 0x06, 0x7a, 0x1e, 0x46, 0x6b, 0x5e, 0x3b, 0x8a, 0x49, 0x78, 0xcd, 0x69,
-	0x92, 0x69, 0xeb, 0xbc, 0xf5, 0x4c, 0x65, 0xe1, 0xd1, 0xdf, 0xd8, 0x9f, 0x79, 0xe4, 0xee, 0xfe,
-	0xcc, 0xe8, 0xa2, 0x06, 0x61, 0x13, 0x0f, 0x7d, 0x23, 0x8c, 0x84, 0x81, 0x47, 0xe6, 0xf1, 0xb5,
-	0xe9, 0x02, 0xeb, 0x32, 0x29, 0xba, 0x8c, 0x60, 0xde, 0x8c, 0x25, 0x9c, 0xa2, 0xb6, 0xc2, 0x60,
-	0xdb, 0xf5, 0xc8, 0x74, 0x31, 0x89, 0xba, 0xc1, 0x9b, 0xb1, 0x84, 0xdb, 0x5f, 0x2e, 0xc0, 0xe4,
-	0x7c, 0xab, 0x75, 0x99, 0x38, 0x5e, 0xbc, 0x53, 0x8d, 0x9d, 0xb8, 0x1d, 0xa1, 0x10, 0x86, 0x23,
-	0xf6, 0x4b, 0x8c, 0xed, 0x65, 0xd1, 0x7b, 0x98, 0xc3, 0xdf, 0xd8, 0x9f, 0xb9, 0x7c, 0xd0, 0x8a,
-	0x6e, 0xb8, 0x71, 0xd0, 0x8a, 0xde, 0x41, 0xfc, 0x86, 0xeb, 0x13, 0xb9, 0xbe, 0x77, 0x18, 0x83,
-	0x59, 0x93, 0xcf, 0x62, 0x50, 0x27, 0x58, 0x70, 0xa2, 0x43, 0x6e, 0x92, 0x28, 0x72, 0x1a, 0x24,
-	0xfd, 0x74, 0x6b, 0xbc, 0x19, 0x4b, 0x38, 0x0a, 0x01, 0x79, 0x4e, 0x14, 0x6f, 0x86, 0x8e, 0x1f,
-	0xb9, 0x74, 0x75, 0x6f, 0xba, 0x4d, 0xfe, 0xa0, 0xa3, 0x17, 0xfe, 0xbf, 0x59, 0xfe, 0x8e, 0x66,
-	0xcd, 0x77, 0xa4, 0x3f, 0x09, 0xba, 0x84, 0x66, 0xf7, 0x9e, 0x9b, 0xa5, 0x3d, 0x16, 0x1e, 0xbb,
-	0xbb, 0x3f, 0x83, 0x56, 0xbb, 0x28, 0xe1, 0x0c, 0xea, 0xf6, 0xef, 0x15, 0x00, 0xe6, 0x5b, 0xad,
-	0x8d, 0x30, 0xb8, 0x45, 0x6a, 0x31, 0xfa, 0x10, 0x94, 0x29, 0xa9, 0xba, 0x13, 0x3b, 0x6c, 0x8e,
-	0x46, 0x2f, 0x7c, 0x53, 0x7f, 0x8c, 0xd7, 0xb7, 0x68, 0xff, 0x35, 0x12, 0x3b, 0x0b, 0x48, 0x3c,
-	0x20, 0xe8, 0x36, 0xac, 0xa8, 0x22, 0x1f, 0x86, 0xa2, 0x16, 0xa9, 0xb1, 0xc9, 0x18, 0xbd, 0xb0,
-	0x3a, 0x3b, 0xc8, 0x47, 0x3f, 0xab, 0x47, 0x5e, 0x6d, 0x91, 0xda, 0xc2, 0x98, 0xe0, 0x3c, 0x44,
-	0xff, 0x61, 0xc6, 0x07, 0xed, 0xa9, 0x77, 0xce, 0x27, 0xf2, 0x5a, 0x6e, 0x1c, 0x19, 0xd5, 0x85,
-	0x89, 0xe4, 0x1a, 0x92, 0xef, 0xdd, 0xfe, 0x63, 0x0b, 0x26, 0x34, 0xf2, 0xaa, 0x1b, 0xc5, 0xe8,
-	0xfd, 0x5d, 0x93, 0x3b, 0xdb, 0xdf, 0xe4, 0xd2, 0xde, 0x6c, 0x6a, 0x4f, 0x08, 0x66, 0x65, 0xd9,
-	0x62, 0x4c, 0x6c, 0x13, 0x4a, 0x6e, 0x4c, 0x9a, 0xd1, 0x74, 0xe1, 0x7c, 0xf1, 0x99, 0xd1, 0x0b,
-	0x97, 0xf3, 0x7a, 0xce, 0x85, 0x71, 0xc1, 0xb4, 0xb4, 0x42, 0xc9, 0x63, 0xce, 0xc5, 0xfe, 0xc3,
-	0x49, 0xf3, 0xf9, 0xe8, 0x84, 0xa3, 0xe7, 0x60, 0x34, 0x0a, 0xda, 0x61, 0x8d, 0x60, 0xd2, 0x0a,
-	0xe8, 0x37, 0x56, 0xa4, 0xcb, 0x9d, 0x7e, 0xfb, 0x55, 0xdd, 0x8c, 0x4d, 0x1c, 0xf4, 0x59, 0x0b,
-	0xc6, 0xea, 0x24, 0x8a, 0x5d, 0x9f, 0xf1, 0x97, 0x83, 0xdf, 0x1c, 0x78, 0xf0, 0xb2, 0x71, 0x49,
-	0x13, 0x5f, 0x38, 0x29, 0x1e, 0x64, 0xcc, 0x68, 0x8c, 0x70, 0x82, 0x3f, 0x95, 0x61, 0x75, 0x12,
-	0xd5, 0x42, 0xb7, 0x45, 0xff, 0x0b, 0x29, 0xa3, 0x64, 0xd8, 0x92, 0x06, 0x61, 0x13, 0x0f, 0xf9,
-	0x50, 0xa2, 0x32, 0x2a, 0x9a, 0x1e, 0x62, 0xe3, 0x5f, 0x19, 0x6c, 0xfc, 0x62, 0x52, 0xa9, 0xf8,
-	0xd3, 0xb3, 0x4f, 0xff, 0x45, 0x98, 0xb3, 0x41, 0xff, 0xc2, 0x82, 0x69, 0x21, 0x43, 0x31, 0xe1,
-	0x13, 0x7a, 0x73, 0xc7, 0x8d, 0x89, 0xe7, 0x46, 0xf1, 0x74, 0x89, 0x8d, 0xe1, 0xfd, 0x83, 0x8d,
-	0x61, 0x31, 0x49, 0x1d, 0x93, 0x28, 0x0e, 0xdd, 0x1a, 0xc5, 0xa1, 0xcb, 0x60, 0xe1, 0xbc, 0x18,
-	0xd6, 0xf4, 0x62, 0x8f, 0x51, 0xe0, 0x9e, 0xe3, 0x43, 0x5f, 0xb0, 0xe0, 0x8c, 0xef, 0x34, 0x49,
-	0xd4, 0x72, 0x18, 0x61, 0x06, 0x5e, 0xf0, 0x9c, 0xda, 0x2e, 0x1b, 0xfe, 0x30, 0x1b, 0xfe, 0x5c,
-	0x7f, 0x9f, 0xc6, 0x72, 0x18, 0xb4, 0x5b, 0x57, 0x5d, 0xbf, 0xbe, 0x60, 0x8b, 0x11, 0x9d, 0xb9,
-	0xd6, 0x93, 0x34, 0x3e, 0x80, 0x2d, 0xfa, 0x49, 0x0b, 0xa6, 0x82, 0xb0, 0xb5, 0xe3, 0xf8, 0xa4,
-	0x2e, 0xa1, 0xd1, 0xf4, 0x08, 0xfb, 0x4e, 0x3f, 0x30, 0xd8, 0x5c, 0xae, 0xa7, 0xc9, 0xae, 0x05,
-	0xbe, 0x1b, 0x07, 0x61, 0x95, 0xc4, 0xb1, 0xeb, 0x37, 0xa2, 0x85, 0x53, 0x77, 0xf7, 0x67, 0xa6,
-	0xba, 0xb0, 0x70, 0xf7, 0x78, 0xd0, 0x77, 0xc2, 0x68, 0xd4, 0xf1, 0x6b, 0x37, 0x5d, 0xbf, 0x1e,
-	0xdc, 0x8e, 0xa6, 0xcb, 0x79, 0x7c, 0xeb, 0x55, 0x45, 0x50, 0x7c, 0xad, 0x9a, 0x01, 0x36, 0xb9,
-	0x65, 0xbf, 0x38, 0xbd, 0xee, 0x2a, 0x79, 0xbf, 0x38, 0xbd, 0x98, 0x0e, 0x60, 0x8b, 0xbe, 0xdf,
-	0x82, 0xf1, 0xc8, 0x6d, 0xf8, 0x4e, 0xdc, 0x0e, 0xc9, 0x55, 0xd2, 0x89, 0xa6, 0x81, 0x0d, 0xe4,
-	0xca, 0x80, 0xb3, 0x62, 0x90, 0x5c, 0x38, 0x25, 0xc6, 0x38, 0x6e, 0xb6, 0x46, 0x38, 0xc9, 0x37,
-	0xeb, 0xab, 0xd4, 0xcb, 0x7a, 0xf4, 0x01, 0x7e, 0x95, 0xfa, 0x0b, 0xe8, 0x39, 0x3e, 0xf4, 0xd7,
-	0xe0, 0x04, 0x6f, 0x52, 0xaf, 0x21, 0x9a, 0x1e, 0x63, 0x22, 0xfc, 0xe4, 0xdd, 0xfd, 0x99, 0x13,
-	0xd5, 0x14, 0x0c, 0x77, 0x61, 0xa3, 0x57, 0x61, 0xa6, 0x45, 0xc2, 0xa6, 0x1b, 0xaf, 0xfb, 0x5e,
-	0x47, 0x2a, 0x86, 0x5a, 0xd0, 0x22, 0x75, 0x31, 0x9c, 0x68, 0x7a, 0xfc, 0xbc, 0xf5, 0x4c, 0x79,
-	0xe1, 0x6d, 0x62, 0x98, 0x33, 0x1b, 0x07, 0xa3, 0xe3, 0xc3, 0xe8, 0xa1, 0x5f, 0xb7, 0xe0, 0x8c,
-	0x21, 0xbf, 0xab, 0x24, 0xdc, 0x73, 0x6b, 0x64, 0xbe, 0x56, 0x0b, 0xda, 0x7e, 0x1c, 0x4d, 0x4f,
-	0xb0, 0x39, 0xdf, 0x3a, 0x0e, 0x6d, 0x92, 0x64, 0xa5, 0x17, 0x71, 0x4f, 0x94, 0x08, 0x1f, 0x30,
-	0x52, 0xf4, 0x19, 0x0b, 0x26, 0xf9, 0x84, 0xae, 0xf8, 0x31, 0x69, 0x84, 0x6e, 0xdc, 0x99, 0x9e,
-	0x64, 0xb2, 0x67, 0x6d, 0xc0, 0x65, 0x9c, 0x24, 0xba, 0xf0, 0xe8, 0xdd, 0xfd, 0x99, 0xc9, 0x54,
-	0x23, 0x4e, 0xb3, 0xb6, 0x7f, 0xb3, 0x00, 0x27, 0xd2, 0xa6, 0x0e, 0xfa, 0xbb, 0x16, 0x4c, 0xde,
-	0xba, 0x1d, 0x6f, 0x06, 0xbb, 0xc4, 0x8f, 0x16, 0x3a, 0x54, 0x21, 0x31, 0x25, 0x3f, 0x7a, 0xa1,
-	0x96, 0xaf, 0x51, 0x35, 0x7b, 0x25, 0xc9, 0xe5, 0xa2, 0x1f, 0x87, 0x9d, 0x85, 0xc7, 0xc5, 0x14,
-	0x4f, 0x5e, 0xb9, 0xb9, 0x69, 0x42, 0x71, 0x7a, 0x50, 0x67, 0x3e, 0x6d, 0xc1, 0xc9, 0x2c, 0x12,
-	0xe8, 0x04, 0x14, 0x77, 0x49, 0x87, 0x5b, 0xff, 0x98, 0xfe, 0x44, 0xaf, 0x40, 0x69, 0xcf, 0xf1,
-	0xda, 0x44, 0xd8, 0xa3, 0xcb, 0x83, 0x3d, 0x88, 0x1a, 0x19, 0xe6, 0x54, 0xbf, 0xa5, 0xf0, 0x82,
-	0x65, 0xff, 0x56, 0x11, 0x46, 0x8d, 0x35, 0x74, 0x1f, 0x6c, 0xec, 0x20, 0x61, 0x63, 0xaf, 0xe5,
-	0xb6, 0xfc, 0x7b, 0x1a, 0xd9, 0xb7, 0x53, 0x46, 0xf6, 0x7a, 0x7e, 0x2c, 0x0f, 0xb4, 0xb2, 0x51,
-	0x0c, 0x95, 0xa0, 0x45, 0xb7, 0xa5, 0xd4, 0x58, 0x1b, 0xca, 0xe3, 0x15, 0xae, 0x4b, 0x72, 0x0b,
-	0xe3, 0x77, 0xf7, 0x67, 0x2a, 0xea, 0x2f, 0xd6, 0x8c, 0xec, 0xdf, 0xb7, 0xe0, 0xa4, 0x31, 0xc6,
-	0xc5, 0xc0, 0xaf, 0xb3, 0x1d, 0x15, 0x3a, 0x0f, 0x43, 0x71, 0xa7, 0x25, 0xb7, 0xbe, 0x6a, 0xa6,
-	0x36, 0x3b, 0x2d, 0x82, 0x19, 0xe4, 0x61, 0xdf, 0x0e, 0x7e, 0xc1, 0x82, 0xc7, 0xb2, 0xe5, 0x1d,
-	0x7a, 0x1a, 0x86, 0xb9, 0xdf, 0x43, 0x3c, 0x9d, 0x7e, 0x25, 0xac, 0x15, 0x0b, 0x28, 0x9a, 0x83,
-	0x8a, 0x52, 0xd6, 0xe2, 0x19, 0xa7, 0x04, 0x6a, 0x45, 0x6b, 0x78, 0x8d, 0x43, 0x27, 0x8d, 0xfe,
-	0x11, 0xb6, 0xb6, 0x9a, 0x34, 0xe6, 0x28, 0x60, 0x10, 0xfb, 0x77, 0x2d, 0xf8, 0x86, 0x7e, 0xa4,
-	0xf0, 0xf1, 0x8d, 0xb1, 0x0a, 0xa7, 0xea, 0x64, 0xdb, 0x69, 0x7b, 0x71, 0x92, 0xa3, 0x18, 0xf4,
-	0x93, 0xa2, 0xf3, 0xa9, 0xa5, 0x2c, 0x24, 0x9c, 0xdd, 0xd7, 0xfe, 0x4f, 0x16, 0x73, 0x51, 0xc8,
-	0xc7, 0xba, 0x0f, 0x7b, 0x44, 0x3f, 0xb9, 0x47, 0x5c, 0xc9, 0xed, 0x33, 0xed, 0xb1, 0x49, 0xfc,
-	0x41, 0x0b, 0xce, 0x18, 0x58, 0x6b, 0x4e, 0x5c, 0xdb, 0xb9, 0x78, 0xa7, 0x15, 0x92, 0x28, 0xa2,
-	0x4b, 0xea, 0x49, 0x43, 0x1c, 0x2f, 0x8c, 0x0a, 0x0a, 0xc5, 0xab, 0xa4, 0xc3, 0x65, 0xf3, 0xb3,
-	0x50, 0xe6, 0xdf, 0x5c, 0x10, 0x8a, 0x97, 0xa4, 0x9e, 0x6d, 0x5d, 0xb4, 0x63, 0x85, 0x81, 0x6c,
-	0x18, 0x66, 0x32, 0x97, 0xca, 0x20, 0x6a, 0xb5, 0x00, 0x7d, 0xef, 0x37, 0x58, 0x0b, 0x16, 0x10,
-	0x3b, 0x4a, 0x0c, 0x67, 0x23, 0x24, 0x6c, 0x3d, 0xd4, 0x2f, 0xb9, 0xc4, 0xab, 0x47, 0x74, 0xff,
-	0xea, 0xf8, 0x7e, 0x10, 0x8b, 0xad, 0xa8, 0xb1, 0x7f, 0x9d, 0xd7, 0xcd, 0xd8, 0xc4, 0xa1, 0x4c,
-	0x3d, 0x67, 0x8b, 0x78, 0x7c, 0x46, 0x05, 0xd3, 0x55, 0xd6, 0x82, 0x05, 0xc4, 0xbe, 0x5b, 0x60,
-	0x3b, 0x65, 0x25, 0xd1, 0xc8, 0xfd, 0x70, 0xb3, 0x84, 0x09, 0x15, 0xb0, 0x91, 0x9f, 0x3c, 0x26,
-	0xbd, 0x5d, 0x2d, 0xaf, 0xa5, 0xb4, 0x00, 0xce, 0x95, 0xeb, 0xc1, 0xee, 0x96, 0x2f, 0x16, 0x61,
-	0x26, 0xd9, 0xa1, 0x4b, 0x89, 0xd0, 0xbd, 0xbd, 0xc1, 0x28, 0xed, 0x9f, 0x34, 0xf0, 0xb1, 0x89,
-	0xd7, 0x43, 0x0e, 0x17, 0x8e, 0x53, 0x0e, 0x9b, 0x6a, 0xa2, 0x78, 0x88, 0x9a, 0x58, 0x54, 0xb3,
-	0x3e, 0xc4, 0x30, 0xdf, 0xde, 0xe5, 0xd4, 0x3c, 0xbd, 0x11, 0x06, 0x0d, 0xf6, 0xcd, 0xed, 0x11,
-	0xba, 0xb7, 0xcb, 0xf0, 0x52, 0x9e, 0x87, 0xa1, 0x28, 0x26, 0xad, 0xe9, 0x52, 0x52, 0x06, 0x57,
-	0x63, 0xd2, 0xc2, 0x0c, 0x82, 0xbe, 0x0d, 0x26, 0x63, 0x27, 0x6c, 0x90, 0x38, 0x24, 0x7b, 0x2e,
-	0x73, 0x74, 0xb3, 0x8d, 0x7a, 0x85, 0x1b, 0x94, 0x9b, 0x0c, 0x84, 0x25, 0x08, 0xa7, 0x71, 0xed,
-	0xff, 0x5a, 0x80, 0xc7, 0x93, 0xef, 0x47, 0x6b, 0xcd, 0x6f, 0x4f, 0x68, 0xcd, 0xb7, 0x9b, 0x5a,
-	0xf3, 0x8d, 0xfd, 0x99, 0x27, 0x7a, 0x74, 0xfb, 0x9a, 0x51, 0xaa, 0x68, 0x39, 0xf5, 0x86, 0xe6,
-	0xba, 0xde, 0xd0, 0x93, 0x3d, 0x9e, 0x31, 0x65, 0xed, 0x3c, 0x0d, 0xc3, 0x21, 0x71, 0xa2, 0xc0,
-	0x17, 0xef, 0x49, 0x7d, 0x0c, 0x98, 0xb5, 0x62, 0x01, 0xb5, 0x7f, 0xa7, 0x92, 0x9e, 0xec, 0x65,
-	0xee, 0xbc, 0x0f, 0x42, 0xe4, 0xc2, 0x10, 0xdb, 0x8e, 0x72, 0xb1, 0x73, 0x75, 0xb0, 0x4f, 0x94,
-	0xaa, 0x18, 0x45, 0x7a, 0xa1, 0x4c, 0xdf, 0x1a, 0x6d, 0xc2, 0x8c, 0x05, 0xba, 0x03, 0xe5, 0x9a,
-	0xdc, 0xf8, 0x15, 0xf2, 0x70, 0xbe, 0x8a, 0x6d, 0x9f, 0xe6, 0x38, 0x46, 0x75, 0x81, 0xda, 0x2d,
-	0x2a, 0x6e, 0x88, 0x40, 0xb1, 0xe1, 0xc6, 0xe2, 0xb5, 0x0e, 0xe8, 0x07, 0x58, 0x76, 0x8d, 0x47,
-	0x1c, 0xa1, 0x0a, 0x6a, 0xd9, 0x8d, 0x31, 0xa5, 0x8f, 0x3e, 0x61, 0xc1, 0x68, 0x54, 0x6b, 0x6e,
-	0x84, 0xc1, 0x9e, 0x5b, 0x27, 0xa1, 0x30, 0x40, 0x07, 0x14, 0x7b, 0xd5, 0xc5, 0x35, 0x49, 0x50,
-	0xf3, 0xe5, 0x7e, 0x19, 0x0d, 0xc1, 0x26, 0x5f, 0xba, 0x31, 0x7b, 0x5c, 0x3c, 0xfb, 0x12, 0xa9,
-	0xb1, 0x2f, 0x4e, 0xee, 0xef, 0xd9, 0x4a, 0x19, 0xd8, 0x20, 0x5f, 0x6a, 0xd7, 0x76, 0xe9, 0xf7,
-	0xa6, 0x07, 0xf4, 0xc4, 0xdd, 0xfd, 0x99, 0xc7, 0x17, 0xb3, 0x79, 0xe2, 0x5e, 0x83, 0x61, 0x13,
-	0xd6, 0x6a, 0x7b, 0x1e, 0x26, 0xaf, 0xb6, 0x09, 0x73, 0xf5, 0xe5, 0x30, 0x61, 0x1b, 0x9a, 0x60,
-	0x6a, 0xc2, 0x0c, 0x08, 0x36, 0xf9, 0xa2, 0x57, 0x61, 0xb8, 0xe9, 0xc4, 0xa1, 0x7b, 0x47, 0xf8,
-	0xf7, 0x06, 0xdc, 0x22, 0xad, 0x31, 0x5a, 0x9a, 0x39, 0xb3, 0x02, 0x78, 0x23, 0x16, 0x8c, 0x50,
-	0x13, 0x4a, 0x4d, 0x12, 0x36, 0xc8, 0x74, 0x39, 0x8f, 0x83, 0x8f, 0x35, 0x4a, 0x4a, 0x33, 0xac,
-	0x50, 0xcb, 0x8b, 0xb5, 0x61, 0xce, 0x05, 0xbd, 0x02, 0xe5, 0x88, 0x78, 0xa4, 0x46, 0x6d, 0xa7,
-	0x0a, 0xe3, 0xf8, 0xce, 0x3e, 0xed, 0x48, 0x6a, 0xb4, 0x54, 0x45, 0x57, 0xfe, 0x81, 0xc9, 0x7f,
-	0x58, 0x91, 0xa4, 0x13, 0xd8, 0xf2, 0xda, 0x0d, 0xd7, 0x9f, 0x86, 0x3c, 0x26, 0x70, 0x83, 0xd1,
-	0x4a, 0x4d, 0x20, 0x6f, 0xc4, 0x82, 0x91, 0xfd, 0x5f, 0x2c, 0x40, 0x49, 0xa1, 0x76, 0x1f, 0x0c,
-	0xe6, 0x57, 0x93, 0x06, 0xf3, 0x6a, 0x9e, 0x16, 0x4d, 0x0f, 0x9b, 0xf9, 0x17, 0x2a, 0x90, 0x52,
-	0x07, 0xd7, 0x48, 0x14, 0x93, 0xfa, 0x9b, 0x22, 0xfc, 0x4d, 0x11, 0xfe, 0xa6, 0x08, 0x57, 0x22,
-	0x7c, 0x2b, 0x25, 0xc2, 0xdf, 0x63, 0x7c, 0xf5, 0x3a, 0x18, 0xe3, 0x83, 0x2a, 0x5a, 0xc3, 0x1c,
-	0x81, 0x81, 0x40, 0x25, 0xc1, 0x95, 0xea, 0xfa, 0xb5, 0x4c, 0x99, 0xfd, 0xc1, 0xa4, 0xcc, 0x1e,
-	0x94, 0xc5, 0x5f, 0x05, 0x29, 0xfd, 0xeb, 0x16, 0xbc, 0x2d, 0x29, 0xbd, 0xe4, 0xca, 0x59, 0x69,
-	0xf8, 0x41, 0x48, 0x96, 0xdc, 0xed, 0x6d, 0x12, 0x12, 0xbf, 0x46, 0x22, 0xe5, 0xf8, 0xb1, 0x7a,
-	0x39, 0x7e, 0xd0, 0xbb, 0x60, 0xec, 0x56, 0x14, 0xf8, 0x1b, 0x81, 0xeb, 0x0b, 0x11, 0x44, 0x77,
-	0x1c, 0x27, 0xee, 0xee, 0xcf, 0x8c, 0xd1, 0x19, 0x95, 0xed, 0x38, 0x81, 0x85, 0x16, 0x61, 0xea,
-	0xd6, 0xab, 0x1b, 0x4e, 0x6c, 0xb8, 0x1a, 0xa4, 0x53, 0x80, 0x1d, 0xb4, 0x5d, 0x79, 0x6f, 0x0a,
-	0x88, 0xbb, 0xf1, 0xed, 0xbf, 0x59, 0x80, 0xd3, 0xa9, 0x07, 0x09, 0x3c, 0x2f, 0x68, 0xc7, 0x74,
-	0x4f, 0x84, 0x7e, 0xdc, 0x82, 0x13, 0xcd, 0xa4, 0x37, 0x23, 0x12, 0xbe, 0xf0, 0xef, 0xc8, 0x4d,
-	0x47, 0xa4, 0xdc, 0x25, 0x0b, 0xd3, 0x62, 0x86, 0x4e, 0xa4, 0x00, 0x11, 0xee, 0x1a, 0x0b, 0x7a,
-	0x05, 0x2a, 0x4d, 0xe7, 0xce, 0xf5, 0x56, 0xdd, 0x89, 0xe5, 0x5e, 0xb5, 0xb7, 0x8b, 0xa1, 0x1d,
-	0xbb, 0xde, 0x2c, 0x0f, 0xf3, 0x99, 0x5d, 0xf1, 0xe3, 0xf5, 0xb0, 0x1a, 0x87, 0xae, 0xdf, 0xe0,
-	0x1e, 0xd0, 0x35, 0x49, 0x06, 0x6b, 0x8a, 0xf6, 0x17, 0xad, 0xb4, 0x92, 0x52, 0xb3, 0x13, 0x3a,
-	0x31, 0x69, 0x74, 0xd0, 0x87, 0xa1, 0x44, 0xf7, 0x8d, 0x72, 0x56, 0x6e, 0xe6, 0xa9, 0x39, 0x8d,
-	0x37, 0xa1, 0x95, 0x28, 0xfd, 0x17, 0x61, 0xce, 0xd4, 0xfe, 0xf1, 0x4a, 0xda, 0x58, 0x60, 0x11,
-	0x0a, 0x17, 0x00, 0x1a, 0xc1, 0x26, 0x69, 0xb6, 0x3c, 0x3a, 0x2d, 0x16, 0x3b, 0x8c, 0x52, 0x7e,
-	0x94, 0x65, 0x05, 0xc1, 0x06, 0x16, 0xfa, 0x94, 0x05, 0xd0, 0x90, 0x6b, 0x5e, 0x1a, 0x02, 0xd7,
-	0xf3, 0x7c, 0x1c, 0xfd, 0x45, 0xe9, 0xb1, 0x28, 0x86, 0xd8, 0x60, 0x8e, 0xbe, 0xc7, 0x82, 0x72,
-	0x2c, 0x87, 0xcf, 0x55, 0xe3, 0x66, 0x9e, 0x23, 0x91, 0x0f, 0xad, 0x6d, 0x22, 0x35, 0x25, 0x8a,
-	0x2f, 0xfa, 0x3e, 0x0b, 0x20, 0xea, 0xf8, 0xb5, 0x8d, 0xc0, 0x73, 0x6b, 0x1d, 0xa1, 0x31, 0x6f,
-	0xe4, 0xea, 0xeb, 0x51, 0xd4, 0x17, 0x26, 0xe8, 0x6c, 0xe8, 0xff, 0xd8, 0xe0, 0x8c, 0x3e, 0x02,
-	0xe5, 0x48, 0x2c, 0x37, 0xa1, 0x23, 0x37, 0xf3, 0xf5, 0x38, 0x71, 0xda, 0x42, 0xbc, 0x8a, 0x7f,
-	0x58, 0xf1, 0x44, 0x3f, 0x62, 0xc1, 0x64, 0x2b, 0xe9, 0x43, 0x14, 0xea, 0x30, 0x3f, 0x19, 0x90,
-	0xf2, 0x51, 0x72, 0x6f, 0x4b, 0xaa, 0x11, 0xa7, 0x47, 0x41, 0x25, 0xa0, 0x5e, 0xc1, 0xeb, 0x2d,
-	0xee, 0xcf, 0x1c, 0xd1, 0x12, 0x70, 0x39, 0x0d, 0xc4, 0xdd, 0xf8, 0x68, 0x03, 0x4e, 0xd2, 0xd1,
-	0x75, 0xb8, 0xf9, 0x29, 0xd5, 0x4b, 0xc4, 0x94, 0x61, 0x79, 0xe1, 0xac, 0x58, 0x21, 0xec, 0x20,
-	0x24, 0x8d, 0x83, 0x33, 0x7b, 0xa2, 0xdf, 0xb2, 0xe0, 0xac, 0xcb, 0xd4, 0x80, 0xe9, 0xcd, 0xd7,
-	0x1a, 0x41, 0x44, 0x10, 0x90, 0x5c, 0x65, 0x45, 0x2f, 0xf5, 0xb3, 0xf0, 0x0d, 0xe2, 0x09, 0xce,
-	0xae, 0x1c, 0x30, 0x24, 0x7c, 0xe0, 0x80, 0xd1, 0x37, 0xc3, 0xb8, 0xfc, 0x2e, 0x36, 0xa8, 0x08,
-	0x66, 0x8a, 0xb6, 0xb2, 0x30, 0x75, 0x77, 0x7f, 0x66, 0x7c, 0xd3, 0x04, 0xe0, 0x24, 0x9e, 0xfd,
-	0x97, 0x43, 0x89, 0x23, 0x24, 0xe5, 0xe0, 0x64, 0xe2, 0xa6, 0x26, 0xfd, 0x3f, 0x52, 0x7a, 0xe6,
-	0x2a, 0x6e, 0x94, 0x77, 0x49, 0x8b, 0x1b, 0xd5, 0x14, 0x61, 0x83, 0x39, 0x35, 0x4a, 0xa7, 0x9c,
-	0xb4, 0x1b, 0x55, 0x48, 0xc0, 0x57, 0xf2, 0x1c, 0x52, 0xf7, 0x81, 0xdf, 0x69, 0x31, 0xb4, 0xa9,
-	0x2e, 0x10, 0xee, 0x1e, 0x12, 0xfa, 0x2e, 0xa8, 0x84, 0x2a, 0x64, 0xa7, 0x98, 0xc7, 0x56, 0x4d,
-	0x2e, 0x1b, 0x31, 0x1c, 0x75, 0x3a, 0xa4, 0x83, 0x73, 0x34, 0x47, 0xf4, 0x1e, 0x98, 0x50, 0x7f,
-	0x16, 0xd9, 0xb1, 0x10, 0x15, 0x8a, 0xc5, 0x85, 0xc7, 0x44, 0xaf, 0x09, 0x9c, 0x80, 0xe2, 0x14,
-	0x36, 0x0a, 0x61, 0x98, 0x87, 0x91, 0x0a, 0x31, 0x36, 0xe0, 0x76, 0xc7, 0x8c, 0x45, 0xd5, 0x3e,
-	0x42, 0xde, 0x8a, 0x05, 0x27, 0xfb, 0x93, 0x85, 0xc4, 0x49, 0x9f, 0x21, 0xef, 0xfa, 0x38, 0xc5,
-	0xfc, 0xac, 0x05, 0xa3, 0x61, 0xe0, 0x79, 0xae, 0xdf, 0xa0, 0xb2, 0x59, 0x18, 0x18, 0xef, 0x3b,
-	0x16, 0x1d, 0x2f, 0x84, 0x30, 0xdb, 0x0d, 0x60, 0xcd, 0x13, 0x9b, 0x03, 0x40, 0x2f, 0xc2, 0x78,
-	0x9d, 0x78, 0x84, 0xf6, 0x5d, 0x0f, 0xe9, 0x3e, 0x8e, 0x7b, 0xcd, 0x55, 0xd8, 0xce, 0x92, 0x09,
-	0xc4, 0x49, 0x5c, 0xfb, 0x8f, 0x2d, 0x98, 0xee, 0xa5, 0x80, 0x10, 0x81, 0x27, 0xa4, 0x74, 0x55,
-	0x6f, 0x71, 0xdd, 0x97, 0xf4, 0x84, 0x0d, 0xf1, 0x94, 0xe0, 0xf3, 0xc4, 0x46, 0x6f, 0x54, 0x7c,
-	0x10, 0x1d, 0xf4, 0x32, 0x9c, 0x30, 0x26, 0x25, 0x52, 0xb3, 0x5a, 0x59, 0x98, 0xa5, 0x16, 0xdf,
-	0x7c, 0x0a, 0xf6, 0xc6, 0xfe, 0xcc, 0x63, 0xe9, 0x36, 0xa1, 0x21, 0xbb, 0xe8, 0xd8, 0x5f, 0xee,
-	0x7a, 0xd5, 0xca, 0xb8, 0x79, 0xdd, 0xea, 0x72, 0x9f, 0x7c, 0xc7, 0x71, 0x18, 0x14, 0xcc, 0xd1,
-	0xa2, 0x62, 0x64, 0x7a, 0xe3, 0x3c, 0xc0, 0x20, 0x06, 0xfb, 0x5f, 0x0f, 0xc1, 0x01, 0x23, 0xeb,
-	0x63, 0xb7, 0x72, 0xe4, 0x53, 0xe5, 0xcf, 0x58, 0xea, 0xf8, 0x90, 0x0b, 0xad, 0xfa, 0x71, 0xcd,
-	0x3d, 0xdf, 0x30, 0x46, 0x3c, 0x90, 0x46, 0x89, 0x84, 0xe4, 0x41, 0x25, 0xfa, 0x92, 0x95, 0x3c,
-	0x00, 0xe5, 0xb1, 0xac, 0xee, 0xb1, 0x8d, 0xc9, 0x38, 0x55, 0xe5, 0x03, 0xd3, 0x67, 0x71, 0xbd,
-	0xce, 0x5b, 0x67, 0x01, 0xb6, 0x5d, 0xdf, 0xf1, 0xdc, 0xd7, 0xe8, 0x76, 0xb0, 0xc4, 0x2c, 0x1a,
-	0x66, 0x22, 0x5e, 0x52, 0xad, 0xd8, 0xc0, 0x38, 0xf3, 0xff, 0xc3, 0xa8, 0xf1, 0xe4, 0x19, 0xf1,
-	0x3f, 0x27, 0xcd, 0xf8, 0x9f, 0x8a, 0x11, 0xb6, 0x73, 0xe6, 0x3d, 0x70, 0x22, 0x3d, 0xc0, 0xa3,
-	0xf4, 0xb7, 0xff, 0xf7, 0x48, 0xfa, 0x44, 0x72, 0x93, 0x84, 0x4d, 0x3a, 0xb4, 0x37, 0x3d, 0x79,
-	0x6f, 0x7a, 0xf2, 0xde, 0xf4, 0xe4, 0x99, 0x87, 0x31, 0xc2, 0x4b, 0x35, 0x72, 0x9f, 0xbc, 0x54,
-	0x09, 0xbf, 0x5b, 0x39, 0x77, 0xbf, 0x9b, 0xfd, 0x89, 0xae, 0xa3, 0x8a, 0xcd, 0x90, 0x10, 0x14,
-	0x40, 0xc9, 0x0f, 0xea, 0x44, 0x1a, 0xf5, 0x57, 0xf2, 0xb1, 0x50, 0xaf, 0x05, 0x75, 0x23, 0x4b,
-	0x80, 0xfe, 0x8b, 0x30, 0xe7, 0x63, 0xff, 0xaf, 0x2e, 0xc3, 0xe6, 0x26, 0xf3, 0x13, 0xed, 0x11,
-	0x3f, 0x46, 0x57, 0x13, 0x56, 0xde, 0x37, 0xa7, 0x4e, 0xdd, 0xdf, 0xd6, 0x2b, 0x25, 0xec, 0x36,
-	0xa5, 0x30, 0xcb, 0x48, 0x18, 0x06, 0xe1, 0x67, 0x2c, 0x98, 0x70, 0x12, 0x9c, 0x72, 0x4b, 0xf0,
-	0x31, 0x4f, 0x4c, 0x94, 0x41, 0x9d, 0xb2, 0x15, 0x53, 0xbc, 0xed, 0x7f, 0x36, 0x0c, 0x89, 0x8d,
-	0x03, 0x5f, 0xf0, 0xdf, 0x08, 0x23, 0x21, 0x69, 0x05, 0xd7, 0xf1, 0xaa, 0x78, 0x68, 0x9d, 0x68,
-	0xc6, 0x9b, 0xb1, 0x84, 0x53, 0x65, 0xdf, 0x72, 0xe2, 0x1d, 0xa1, 0xc5, 0x95, 0xb2, 0xdf, 0x70,
-	0xe2, 0x1d, 0xcc, 0x20, 0xd4, 0xe6, 0x8f, 0x13, 0x41, 0x0f, 0xe2, 0x70, 0x5f, 0x0d, 0x31, 0x19,
-	0x12, 0x81, 0x53, 0xd8, 0xe8, 0x55, 0x18, 0xda, 0x21, 0x5e, 0x53, 0xac, 0xf9, 0x6a, 0x7e, 0xd3,
-	0xc4, 0x9e, 0xf5, 0x32, 0xf1, 0x9a, 0x5c, 0x05, 0xd0, 0x5f, 0x98, 0xb1, 0xa2, 0x1f, 0x7c, 0x65,
-	0xb7, 0x1d, 0xc5, 0x41, 0xd3, 0x7d, 0x4d, 0xfa, 0xb4, 0xbf, 0x23, 0x67, 0xc6, 0x57, 0x25, 0x7d,
-	0xee, 0x3c, 0x54, 0x7f, 0xb1, 0xe6, 0xcc, 0xc6, 0x51, 0x77, 0x43, 0xf6, 0xad, 0x74, 0x84, 0x6b,
-	0x3a, 0xef, 0x71, 0x2c, 0x49, 0xfa, 0x7c, 0x1c, 0xea, 0x2f, 0xd6, 0x9c, 0x51, 0x47, 0x09, 0x9e,
-	0x51, 0x36, 0x86, 0xeb, 0x39, 0x8f, 0x81, 0x0b, 0x9d, 0x4c, 0x01, 0xf4, 0x14, 0x94, 0x6a, 0x3b,
-	0x4e, 0x18, 0x4f, 0x8f, 0xb1, 0x45, 0xa3, 0x3e, 0xdf, 0x45, 0xda, 0x88, 0x39, 0x0c, 0x3d, 0x09,
-	0xc5, 0x90, 0x6c, 0xb3, 0x98, 0x79, 0x23, 0x3c, 0x0e, 0x93, 0x6d, 0x4c, 0xdb, 0x95, 0x41, 0x3a,
-	0x71, 0x90, 0x41, 0x1a, 0x3b, 0x8d, 0x8d, 0x90, 0x6c, 0xbb, 0x77, 0x58, 0x34, 0xb9, 0x61, 0x90,
-	0x6e, 0x4a, 0x00, 0xd6, 0x38, 0xf6, 0xff, 0x29, 0x24, 0x4d, 0xe0, 0xe4, 0x54, 0xf2, 0x0f, 0xa8,
-	0xd6, 0x0e, 0x23, 0xe9, 0x3b, 0x35, 0x3e, 0x20, 0xd6, 0x8c, 0x25, 0x1c, 0x7d, 0xcc, 0x82, 0x91,
-	0x5b, 0x51, 0xe0, 0xfb, 0x4a, 0x12, 0xdc, 0xc8, 0x79, 0x76, 0xaf, 0x70, 0xea, 0x7a, 0x0c, 0xa2,
-	0x01, 0x4b, 0xbe, 0x74, 0xb8, 0xe4, 0x4e, 0xcd, 0x6b, 0xd7, 0xbb, 0x82, 0xa8, 0x2e, 0xf2, 0x66,
-	0x2c, 0xe1, 0x14, 0xd5, 0xf5, 0x39, 0xea, 0x50, 0x12, 0x75, 0xc5, 0x17, 0xa8, 0x02, 0x8e, 0x6e,
-	0xc0, 0x63, 0x75, 0x37, 0x72, 0xb6, 0x3c, 0x72, 0x51, 0x1e, 0xf1, 0x5c, 0x72, 0xbd, 0x98, 0x84,
-	0x4c, 0xbb, 0x97, 0x17, 0xce, 0x89, 0x9e, 0x8f, 0x2d, 0x65, 0x62, 0xe1, 0x1e, 0xbd, 0xed, 0x5f,
-	0x2a, 0xc3, 0xa9, 0xcc, 0xef, 0x98, 0x1a, 0xbd, 0xcc, 0xac, 0xbc, 0xe4, 0x7a, 0x44, 0x86, 0x25,
-	0x32, 0xa3, 0xf7, 0x86, 0x6a, 0xc5, 0x06, 0x06, 0xfa, 0x6e, 0x80, 0x96, 0x13, 0x3a, 0x4d, 0xa2,
-	0xce, 0x4c, 0x06, 0xb6, 0x2d, 0xe9, 0x38, 0x36, 0x24, 0x4d, 0xed, 0x37, 0x52, 0x4d, 0x11, 0x36,
-	0x58, 0xa2, 0xe7, 0x61, 0x34, 0x24, 0x1e, 0x71, 0x22, 0x96, 0x1d, 0x92, 0x4e, 0xa2, 0xc3, 0x1a,
-	0x84, 0x4d, 0x3c, 0xf4, 0xb4, 0x8a, 0xe0, 0x1c, 0x4a, 0x86, 0x37, 0x25, 0xa3, 0x38, 0xd1, 0xe7,
-	0x2c, 0x98, 0xd8, 0x76, 0x3d, 0xa2, 0xb9, 0x8b, 0x94, 0xb7, 0xf5, 0xc1, 0x1f, 0xf2, 0x92, 0x49,
-	0x57, 0x0b, 0xf3, 0x44, 0x73, 0x84, 0x53, 0xec, 0xe9, 0xf2, 0xd9, 0x23, 0x21, 0xd3, 0x02, 0xc3,
-	0xc9, 0xe5, 0x73, 0x83, 0x37, 0x63, 0x09, 0x47, 0xf3, 0x30, 0xd9, 0x72, 0xa2, 0x68, 0x31, 0x24,
-	0x75, 0xe2, 0xc7, 0xae, 0xe3, 0xf1, 0x1c, 0xb3, 0xb2, 0x4e, 0x6f, 0xd8, 0x48, 0x82, 0x71, 0x1a,
-	0x1f, 0xbd, 0x04, 0x8f, 0x73, 0xa7, 0xe4, 0x9a, 0x1b, 0x45, 0xae, 0xdf, 0xd0, 0xcb, 0x40, 0xf8,
-	0x66, 0x67, 0x04, 0xa9, 0xc7, 0x57, 0xb2, 0xd1, 0x70, 0xaf, 0xfe, 0xe8, 0x59, 0x28, 0x47, 0xbb,
-	0x6e, 0x6b, 0x31, 0xac, 0x47, 0xec, 0x40, 0xb2, 0xac, 0x4f, 0x02, 0xaa, 0xa2, 0x1d, 0x2b, 0x0c,
-	0x54, 0x83, 0x31, 0xfe, 0x4a, 0x78, 0x08, 0xaa, 0x10, 0xe5, 0xef, 0xe8, 0x69, 0x4a, 0x89, 0x34,
-	0xf4, 0x59, 0xec, 0xdc, 0x56, 0xab, 0x9f, 0x9f, 0xe6, 0xdd, 0x30, 0xc8, 0xe0, 0x04, 0xd1, 0xe4,
-	0xae, 0x7a, 0xb4, 0x8f, 0x5d, 0xf5, 0xf3, 0x30, 0xba, 0xdb, 0xde, 0x22, 0x62, 0xe6, 0x85, 0x84,
-	0x55, 0xab, 0xef, 0xaa, 0x06, 0x61, 0x13, 0x8f, 0x45, 0xff, 0xb6, 0x5c, 0xf1, 0x2f, 0x9a, 0x1e,
-	0x37, 0xa2, 0x7f, 0x37, 0x56, 0x64, 0x33, 0x36, 0x71, 0xe8, 0xd0, 0xe8, 0x5c, 0x6c, 0x92, 0x88,
-	0xe5, 0x1a, 0xd1, 0xe9, 0x52, 0x43, 0xab, 0x4a, 0x00, 0xd6, 0x38, 0x68, 0x03, 0x4e, 0xd2, 0x3f,
-	0x55, 0x96, 0x86, 0x7f, 0xc3, 0xf1, 0xdc, 0x3a, 0x0f, 0x45, 0x9d, 0x4c, 0xba, 0xd4, 0xab, 0x19,
-	0x38, 0x38, 0xb3, 0xe7, 0xb7, 0x94, 0x5f, 0xff, 0xd2, 0xcc, 0x23, 0x1f, 0xfd, 0xa3, 0xf3, 0x8f,
-	0xd8, 0x3f, 0x5a, 0x48, 0x1a, 0x7b, 0xa6, 0x90, 0x44, 0x11, 0x15, 0x85, 0xf1, 0x0d, 0x27, 0x94,
-	0xc6, 0xe7, 0x80, 0x29, 0x83, 0x82, 0xee, 0x0d, 0x27, 0x34, 0x85, 0x2a, 0x63, 0x80, 0x25, 0x27,
-	0x74, 0x0b, 0x86, 0x62, 0xcf, 0xc9, 0x29, 0x21, 0xd9, 0xe0, 0xa8, 0x3d, 0x92, 0xab, 0xf3, 0x11,
-	0x66, 0x3c, 0xd0, 0x59, 0xba, 0x93, 0xde, 0x92, 0xc7, 0xbc, 0x62, 0xf3, 0xbb, 0x15, 0x61, 0xd6,
-	0x6a, 0xff, 0xf5, 0xf1, 0x0c, 0xbd, 0xa6, 0x6c, 0x13, 0x74, 0x01, 0x80, 0x2e, 0x1f, 0xa1, 0x28,
-	0xb9, 0x6d, 0xa8, 0x64, 0xdc, 0x35, 0x05, 0xc1, 0x06, 0x96, 0xec, 0x53, 0x6d, 0x6f, 0xd3, 0x3e,
-	0x85, 0xee, 0x3e, 0x1c, 0x82, 0x0d, 0x2c, 0xf4, 0x2e, 0x18, 0x76, 0x9b, 0x4e, 0x43, 0x85, 0xa8,
-	0x9f, 0xa5, 0xc2, 0x6d, 0x85, 0xb5, 0xbc, 0xb1, 0x3f, 0x33, 0xa1, 0x06, 0xc4, 0x9a, 0xb0, 0xc0,
-	0x45, 0x5f, 0xb6, 0x60, 0xac, 0x16, 0x34, 0x9b, 0x81, 0xcf, 0x5d, 0x19, 0xc2, 0x2f, 0x73, 0xeb,
-	0xb8, 0x2c, 0xb7, 0xd9, 0x45, 0x83, 0x19, 0x77, 0xcc, 0xa8, 0xcc, 0x69, 0x13, 0x84, 0x13, 0xa3,
-	0x32, 0x65, 0x60, 0xe9, 0x10, 0x19, 0xf8, 0xf3, 0x16, 0x4c, 0xf1, 0xbe, 0x86, 0x87, 0x45, 0xe4,
-	0xfd, 0x06, 0xc7, 0xfc, 0x58, 0x5d, 0x4e, 0x27, 0x75, 0xd2, 0xd0, 0x05, 0xc7, 0xdd, 0x83, 0x44,
-	0xcb, 0x30, 0xb5, 0x1d, 0x84, 0x35, 0x62, 0x4e, 0x84, 0x10, 0xe0, 0x8a, 0xd0, 0xa5, 0x34, 0x02,
-	0xee, 0xee, 0x43, 0xcd, 0x08, 0xa3, 0xd1, 0x9c, 0x87, 0x72, 0xd2, 0x8c, 0xb8, 0x94, 0x89, 0x85,
-	0x7b, 0xf4, 0x4e, 0x8a, 0xcb, 0x4a, 0x1f, 0xe2, 0xf2, 0x83, 0x70, 0xba, 0xd6, 0x3d, 0x33, 0x7b,
-	0x51, 0x7b, 0x2b, 0xe2, 0x12, 0xbd, 0xbc, 0xf0, 0x16, 0x41, 0xe0, 0xf4, 0x62, 0x2f, 0x44, 0xdc,
-	0x9b, 0x06, 0xfa, 0x30, 0x94, 0x43, 0xc2, 0xde, 0x4a, 0x24, 0x92, 0x60, 0x07, 0xf4, 0x3c, 0xe9,
-	0x4d, 0x05, 0x27, 0xab, 0x75, 0x94, 0x68, 0x88, 0xb0, 0xe2, 0x88, 0x6e, 0xc3, 0x48, 0x8b, 0x6e,
-	0x59, 0x45, 0x36, 0xeb, 0xc0, 0x3b, 0x52, 0xc5, 0x9c, 0x9d, 0xe3, 0x19, 0x05, 0x48, 0x38, 0x13,
-	0x2c, 0xb9, 0x51, 0xab, 0xad, 0x16, 0x34, 0x5b, 0x81, 0x4f, 0xfc, 0x58, 0xaa, 0x93, 0x09, 0x7e,
-	0xd8, 0x26, 0x5b, 0xb1, 0x81, 0xd1, 0xa5, 0xd5, 0x35, 0xda, 0xf4, 0xd4, 0x01, 0x5a, 0xdd, 0xa0,
-	0xd6, 0xab, 0x3f, 0x55, 0x3b, 0xcc, 0xc5, 0x7b, 0xd3, 0x8d, 0x77, 0x82, 0x76, 0x2c, 0x3d, 0x16,
-	0x42, 0x65, 0x29, 0xb5, 0xb3, 0x9a, 0x81, 0x83, 0x33, 0x7b, 0xa6, 0x75, 0xec, 0xe4, 0xbd, 0xe9,
-	0xd8, 0x13, 0x7d, 0xe8, 0xd8, 0x2a, 0x9c, 0x62, 0x23, 0x10, 0x76, 0xb8, 0x74, 0x20, 0x47, 0xd3,
-	0x88, 0x0d, 0x5e, 0x65, 0x5e, 0xad, 0x66, 0x21, 0xe1, 0xec, 0xbe, 0x67, 0xbe, 0x1d, 0xa6, 0xba,
-	0x84, 0xdc, 0x91, 0x9c, 0xc3, 0x4b, 0xf0, 0x58, 0xb6, 0x38, 0x39, 0x92, 0x8b, 0xf8, 0x9f, 0xa6,
-	0x92, 0x22, 0x8c, 0x5d, 0x63, 0x1f, 0xc7, 0x0d, 0x0e, 0x14, 0x89, 0xbf, 0x27, 0xb4, 0xeb, 0xa5,
-	0xc1, 0x56, 0xf5, 0x45, 0x7f, 0x8f, 0x4b, 0x43, 0xe6, 0x53, 0xbd, 0xe8, 0xef, 0x61, 0x4a, 0x1b,
-	0xfd, 0xb0, 0x95, 0xd8, 0x4a, 0xf0, 0x43, 0x8a, 0x0f, 0x1c, 0xcb, 0x36, 0xb9, 0xef, 0xdd, 0x85,
-	0xfd, 0x6f, 0x0a, 0x70, 0xfe, 0x30, 0x22, 0x7d, 0x4c, 0xdf, 0x53, 0x30, 0x1c, 0xb1, 0x30, 0x27,
-	0xa1, 0xae, 0x46, 0xe9, 0x57, 0xcc, 0x03, 0x9f, 0x3e, 0x88, 0x05, 0x08, 0x79, 0x50, 0x6c, 0x3a,
-	0x2d, 0xe1, 0xbb, 0x5e, 0x19, 0x34, 0xb3, 0x94, 0xfe, 0x77, 0xbc, 0x35, 0xa7, 0xc5, 0xd7, 0xbc,
-	0xd1, 0x80, 0x29, 0x1b, 0x14, 0x43, 0xc9, 0x09, 0x43, 0x47, 0xc6, 0xd4, 0x5c, 0xcd, 0x87, 0xdf,
-	0x3c, 0x25, 0xc9, 0x43, 0x12, 0x12, 0x4d, 0x98, 0x33, 0xb3, 0x7f, 0xa4, 0x9c, 0x48, 0x43, 0x64,
-	0x81, 0x52, 0x11, 0x0c, 0x0b, 0x97, 0xb5, 0x95, 0x77, 0x42, 0x2f, 0x2f, 0x3b, 0xc0, 0x9c, 0x22,
-	0xa2, 0x2c, 0x8c, 0x60, 0x85, 0x3e, 0x6d, 0xb1, 0xe2, 0x2b, 0x32, 0xb7, 0x53, 0xf8, 0x0d, 0x8e,
-	0xa7, 0x16, 0x8c, 0x59, 0xd2, 0x45, 0x36, 0x62, 0x93, 0xbb, 0xa8, 0x35, 0xc5, 0xf6, 0x35, 0xdd,
-	0xb5, 0xa6, 0xd8, 0x3e, 0x45, 0xc2, 0xd1, 0x9d, 0x8c, 0x80, 0xa8, 0x1c, 0x6a, 0x72, 0xf4, 0x11,
-	0x02, 0xf5, 0x25, 0x0b, 0xa6, 0xdc, 0x74, 0x64, 0x8b, 0xd8, 0x0d, 0xdf, 0xcc, 0xc7, 0xbf, 0xdc,
-	0x1d, 0x38, 0xa3, 0x0c, 0x9d, 0x2e, 0x10, 0xee, 0x1e, 0x0c, 0xaa, 0xc3, 0x90, 0xeb, 0x6f, 0x07,
-	0xc2, 0xbc, 0x5b, 0x18, 0x6c, 0x50, 0x2b, 0xfe, 0x76, 0xa0, 0xbf, 0x66, 0xfa, 0x0f, 0x33, 0xea,
-	0x68, 0x15, 0x4e, 0xca, 0x64, 0xb3, 0xcb, 0x6e, 0x14, 0x07, 0x61, 0x67, 0xd5, 0x6d, 0xba, 0x31,
-	0x33, 0xcd, 0x8a, 0x0b, 0xd3, 0x54, 0xbd, 0xe1, 0x0c, 0x38, 0xce, 0xec, 0x85, 0x5e, 0x83, 0x11,
-	0x19, 0x4d, 0x52, 0xce, 0xc3, 0xb3, 0xd0, 0xbd, 0xfe, 0xd5, 0x62, 0xaa, 0x8a, 0x70, 0x12, 0xc9,
-	0x10, 0x7d, 0xd2, 0x82, 0x09, 0xfe, 0xfb, 0x72, 0xa7, 0xce, 0x93, 0x5f, 0x2b, 0x79, 0xb8, 0xd2,
-	0xab, 0x09, 0x9a, 0x0b, 0xe8, 0xee, 0xfe, 0xcc, 0x44, 0xb2, 0x0d, 0xa7, 0xf8, 0xda, 0x7f, 0x6f,
-	0x0c, 0xba, 0xe3, 0x6f, 0x92, 0xc1, 0x36, 0xd6, 0x7d, 0x0f, 0xb6, 0xb9, 0x05, 0x43, 0x91, 0x8e,
-	0x39, 0xc9, 0xe1, 0x33, 0x13, 0x5c, 0x75, 0x48, 0x40, 0xc7, 0xaf, 0x61, 0xc6, 0x03, 0xb5, 0x55,
-	0x60, 0x4e, 0x31, 0xa7, 0x28, 0x84, 0x7e, 0x62, 0x73, 0xd0, 0x1d, 0x18, 0xd9, 0xe1, 0xcb, 0x51,
-	0xec, 0xf5, 0xd6, 0x06, 0x9d, 0xdf, 0xc4, 0x1a, 0xd7, 0x8b, 0x4f, 0x34, 0x60, 0xc9, 0x8e, 0xc5,
-	0x76, 0x1a, 0xd1, 0x67, 0x5c, 0x90, 0xe4, 0x97, 0xc7, 0xdb, 0x7f, 0xe8, 0xd9, 0x87, 0x60, 0x2c,
-	0x24, 0xb5, 0xc0, 0xaf, 0xb9, 0x1e, 0xa9, 0xcf, 0xcb, 0xc3, 0xc9, 0xa3, 0x64, 0x68, 0x32, 0xbf,
-	0x12, 0x36, 0x68, 0xe0, 0x04, 0x45, 0xf6, 0x9d, 0xa9, 0x92, 0x0e, 0xf4, 0x85, 0x10, 0x71, 0x16,
-	0xb3, 0x9a, 0x53, 0x01, 0x09, 0x46, 0x93, 0x7f, 0x67, 0xc9, 0x36, 0x9c, 0xe2, 0x8b, 0x5e, 0x06,
-	0x08, 0xb6, 0x78, 0x00, 0xe7, 0x7c, 0x2c, 0x0e, 0x66, 0x8e, 0xf2, 0xa8, 0x13, 0x3c, 0x0d, 0x5c,
-	0x52, 0xc0, 0x06, 0x35, 0x74, 0x15, 0x80, 0x7f, 0x39, 0x9b, 0x9d, 0x96, 0xdc, 0x10, 0xca, 0x14,
-	0x5b, 0xa8, 0x2a, 0xc8, 0x1b, 0xfb, 0x33, 0xdd, 0xde, 0x67, 0x76, 0xc0, 0x67, 0x74, 0x47, 0xdf,
-	0x09, 0x23, 0x51, 0xbb, 0xd9, 0x74, 0xd4, 0xb1, 0x4d, 0x8e, 0x89, 0xe5, 0x9c, 0xae, 0x21, 0x18,
-	0x79, 0x03, 0x96, 0x1c, 0xd1, 0x2d, 0x2a, 0xe2, 0x85, 0x84, 0xe2, 0x5f, 0x11, 0xb7, 0x50, 0xb8,
-	0x4f, 0xf0, 0xdd, 0x72, 0x17, 0x83, 0x33, 0x70, 0xde, 0xd8, 0x9f, 0x79, 0x2c, 0xd9, 0xbe, 0x1a,
-	0x88, 0x54, 0xef, 0x4c, 0x9a, 0xe8, 0x8a, 0x2c, 0x65, 0x47, 0x1f, 0x5b, 0xd6, 0x41, 0x7a, 0x46,
-	0x97, 0xb2, 0x63, 0xcd, 0xbd, 0xe7, 0xcc, 0xec, 0x8c, 0xd6, 0xe0, 0xd1, 0x5a, 0xe0, 0xc7, 0x61,
-	0xe0, 0x79, 0xbc, 0xe2, 0x25, 0xdf, 0x9b, 0xf3, 0x63, 0x9d, 0x27, 0xc4, 0xb0, 0x1f, 0x5d, 0xec,
-	0x46, 0xc1, 0x59, 0xfd, 0xa8, 0x4d, 0x9e, 0xd6, 0x0f, 0x13, 0xb9, 0x84, 0x3a, 0x24, 0x68, 0x0a,
-	0x09, 0xa5, 0x1c, 0xe0, 0x87, 0x68, 0x8a, 0x9f, 0x4a, 0x9d, 0x78, 0x8b, 0x57, 0xf6, 0x2e, 0x18,
-	0x23, 0x77, 0x62, 0x12, 0xfa, 0x8e, 0x77, 0x1d, 0xaf, 0xca, 0xb3, 0x0b, 0xf6, 0x65, 0x5e, 0x34,
-	0xda, 0x71, 0x02, 0x0b, 0xd9, 0xca, 0x4d, 0x66, 0x14, 0x55, 0xe0, 0x6e, 0x32, 0xe5, 0x14, 0x7b,
-	0x1e, 0x46, 0xdd, 0x68, 0xbe, 0xd5, 0x5a, 0xdf, 0x9e, 0x6f, 0xb5, 0x78, 0xc1, 0x81, 0xb2, 0x36,
-	0xea, 0x56, 0x34, 0x08, 0x9b, 0x78, 0xf6, 0xcf, 0x16, 0x13, 0xb6, 0xee, 0x03, 0x39, 0x96, 0x67,
-	0x05, 0xcb, 0x64, 0x65, 0x37, 0x06, 0x10, 0x7b, 0xb8, 0x3c, 0x39, 0xab, 0xc8, 0xc7, 0x75, 0x93,
-	0x11, 0x4e, 0xf2, 0x45, 0xbb, 0x50, 0xda, 0x09, 0xa2, 0x58, 0xee, 0xec, 0x06, 0xdc, 0x44, 0x5e,
-	0x0e, 0xa2, 0x98, 0x19, 0x68, 0xea, 0xb1, 0x69, 0x4b, 0x84, 0x39, 0x0f, 0xfa, 0xca, 0xa2, 0x1d,
-	0x27, 0xac, 0x27, 0x42, 0x64, 0xd5, 0x2b, 0xab, 0x6a, 0x10, 0x36, 0xf1, 0xec, 0x3f, 0xb1, 0x12,
-	0xe7, 0x62, 0xc7, 0x15, 0xc1, 0xf0, 0x51, 0x2b, 0x59, 0x1d, 0xa2, 0x90, 0xc7, 0x96, 0xcf, 0xac,
-	0x90, 0x72, 0x68, 0xa1, 0x09, 0xfb, 0x87, 0x2d, 0x18, 0x59, 0x70, 0x6a, 0xbb, 0xc1, 0xf6, 0x36,
-	0x7a, 0x16, 0xca, 0xf5, 0x76, 0x68, 0x16, 0xaa, 0x50, 0x4e, 0xae, 0x25, 0xd1, 0x8e, 0x15, 0x06,
-	0xfd, 0x62, 0xb6, 0x9d, 0x9a, 0xac, 0x93, 0x52, 0xe4, 0x5f, 0xcc, 0x25, 0xd6, 0x82, 0x05, 0x84,
-	0x4e, 0x7f, 0xd3, 0xb9, 0x23, 0x3b, 0xa7, 0x0f, 0xe5, 0xd6, 0x34, 0x08, 0x9b, 0x78, 0xf6, 0xaf,
-	0x59, 0x30, 0xbd, 0xe0, 0x44, 0x6e, 0x6d, 0xbe, 0x1d, 0xef, 0x2c, 0xb8, 0xf1, 0x56, 0xbb, 0xb6,
-	0x4b, 0x62, 0x5e, 0x4f, 0x87, 0x8e, 0xb2, 0x1d, 0xd1, 0x0f, 0x57, 0xed, 0xb4, 0xd5, 0x28, 0xaf,
-	0x8b, 0x76, 0xac, 0x30, 0xd0, 0x6b, 0x30, 0xda, 0x72, 0xa2, 0xe8, 0x76, 0x10, 0xd6, 0x31, 0xd9,
-	0xce, 0xa7, 0xe2, 0x56, 0x95, 0xd4, 0x42, 0x12, 0x63, 0xb2, 0x2d, 0x82, 0x8c, 0x34, 0x7d, 0x6c,
-	0x32, 0xb3, 0x3f, 0x65, 0xc1, 0xc9, 0x05, 0xe2, 0x84, 0x24, 0x64, 0x05, 0xba, 0xd4, 0x83, 0xa0,
-	0x57, 0xa1, 0x1c, 0xd3, 0x16, 0x3a, 0x22, 0x2b, 0xdf, 0x11, 0xb1, 0xf0, 0xa0, 0x4d, 0x41, 0x1c,
-	0x2b, 0x36, 0xf6, 0x67, 0x2d, 0x38, 0x9d, 0x35, 0x96, 0x45, 0x2f, 0x68, 0xd7, 0x1f, 0xc4, 0x80,
-	0x7e, 0xcc, 0x82, 0x31, 0x16, 0x79, 0xb0, 0x44, 0x62, 0xc7, 0xf5, 0xba, 0xaa, 0xa0, 0x5a, 0x7d,
-	0x56, 0x41, 0x3d, 0x0f, 0x43, 0x3b, 0x41, 0x93, 0xa4, 0xa3, 0x66, 0x2e, 0x07, 0x4d, 0x82, 0x19,
-	0x04, 0x3d, 0x47, 0x17, 0xa1, 0xeb, 0xc7, 0x0e, 0xfd, 0x1c, 0xe5, 0x31, 0xc8, 0x24, 0x5f, 0x80,
-	0xaa, 0x19, 0x9b, 0x38, 0xf6, 0xbf, 0xac, 0xc0, 0x88, 0x88, 0x6d, 0xeb, 0xbb, 0xbe, 0x93, 0xf4,
-	0xfe, 0x14, 0x7a, 0x7a, 0x7f, 0x22, 0x18, 0xae, 0xb1, 0xaa, 0xd5, 0xc2, 0xb2, 0xbf, 0x9a, 0x4b,
-	0x30, 0x24, 0x2f, 0x84, 0xad, 0x87, 0xc5, 0xff, 0x63, 0xc1, 0x0a, 0x7d, 0xde, 0x82, 0xc9, 0x5a,
-	0xe0, 0xfb, 0xa4, 0xa6, 0x6d, 0xce, 0xa1, 0x3c, 0x36, 0x16, 0x8b, 0x49, 0xa2, 0xfa, 0x2c, 0x39,
-	0x05, 0xc0, 0x69, 0xf6, 0xe8, 0x45, 0x18, 0xe7, 0x73, 0x76, 0x23, 0x71, 0x76, 0xa3, 0xeb, 0x5d,
-	0x9a, 0x40, 0x9c, 0xc4, 0x45, 0xb3, 0xfc, 0x0c, 0x4c, 0x14, 0x8b, 0x1c, 0xd6, 0x2e, 0x6e, 0xa3,
-	0x4c, 0xa4, 0x81, 0x81, 0x42, 0x40, 0x21, 0xd9, 0x0e, 0x49, 0xb4, 0x23, 0x62, 0xff, 0x98, 0xbd,
-	0x3b, 0x72, 0x6f, 0xc5, 0x57, 0x70, 0x17, 0x25, 0x9c, 0x41, 0x1d, 0xed, 0x0a, 0xf7, 0x43, 0x39,
-	0x0f, 0x79, 0x2e, 0x5e, 0x73, 0x4f, 0x2f, 0xc4, 0x0c, 0x94, 0x98, 0xea, 0x62, 0x76, 0x76, 0x91,
-	0x27, 0xfc, 0x32, 0xc5, 0x86, 0x79, 0x3b, 0x5a, 0x82, 0x13, 0xa9, 0x02, 0x9c, 0x91, 0x38, 0x63,
-	0x51, 0xc9, 0x9d, 0xa9, 0xd2, 0x9d, 0x11, 0xee, 0xea, 0x61, 0xba, 0xa6, 0x46, 0x0f, 0x71, 0x4d,
-	0x75, 0x54, 0x84, 0x39, 0x3f, 0xfd, 0x78, 0x6f, 0x2e, 0x13, 0xd0, 0x57, 0x38, 0xf9, 0x0f, 0xa6,
-	0xc2, 0xc9, 0xc7, 0xd9, 0x00, 0x6e, 0xe4, 0x33, 0x80, 0xa3, 0xc7, 0x8e, 0x3f, 0xc8, 0x58, 0xf0,
-	0xff, 0x69, 0x81, 0x7c, 0xaf, 0x8b, 0x4e, 0x6d, 0x87, 0xd0, 0x25, 0x93, 0x91, 0x35, 0x64, 0x1d,
-	0x29, 0x6b, 0x68, 0x0e, 0x2a, 0x74, 0x9e, 0x78, 0x57, 0xae, 0xf7, 0x95, 0xe7, 0x64, 0x7e, 0x63,
-	0x45, 0xf4, 0xd2, 0x38, 0x28, 0x80, 0x29, 0xcf, 0x89, 0x62, 0x36, 0x82, 0x6a, 0xc7, 0xaf, 0xdd,
-	0x63, 0xe9, 0x23, 0x96, 0x41, 0xb8, 0x9a, 0x26, 0x84, 0xbb, 0x69, 0xdb, 0xff, 0xae, 0x04, 0xe3,
-	0x09, 0xc9, 0x78, 0x44, 0x83, 0xe1, 0x59, 0x28, 0x4b, 0x1d, 0x9e, 0x2e, 0x00, 0xa7, 0x14, 0xbd,
-	0xc2, 0xa0, 0x4a, 0x6b, 0x4b, 0x6b, 0xd5, 0xb4, 0x81, 0x63, 0x28, 0x5c, 0x6c, 0xe2, 0x31, 0xa1,
-	0x1c, 0x7b, 0xd1, 0xa2, 0xe7, 0x12, 0x3f, 0xe6, 0xc3, 0xcc, 0x47, 0x28, 0x6f, 0xae, 0x56, 0x4d,
-	0xa2, 0x5a, 0x28, 0xa7, 0x00, 0x38, 0xcd, 0x1e, 0x7d, 0xaf, 0x05, 0xe3, 0xce, 0xed, 0x48, 0x5f,
-	0xad, 0x20, 0x02, 0xc7, 0x07, 0x54, 0x52, 0x89, 0xdb, 0x1a, 0xf8, 0x81, 0x40, 0xa2, 0x09, 0x27,
-	0x99, 0xa2, 0xd7, 0x2d, 0x40, 0xe4, 0x0e, 0xa9, 0xc9, 0xd0, 0x76, 0x31, 0x96, 0xe1, 0x3c, 0x76,
-	0xfe, 0x17, 0xbb, 0xe8, 0x72, 0xa9, 0xde, 0xdd, 0x8e, 0x33, 0xc6, 0x80, 0xae, 0x00, 0x12, 0x61,
-	0x74, 0x8b, 0x41, 0x53, 0x66, 0xbd, 0x8b, 0x73, 0xf8, 0x33, 0x62, 0x9e, 0xd1, 0x52, 0x17, 0x06,
-	0xce, 0xe8, 0xc5, 0x56, 0x59, 0x18, 0xdc, 0xe9, 0x5c, 0x0f, 0x3d, 0xa6, 0x25, 0xcc, 0x55, 0x26,
-	0xda, 0xb1, 0xc2, 0xb0, 0xff, 0xb4, 0xa8, 0x3e, 0x65, 0x9d, 0xc7, 0xe1, 0x18, 0xf1, 0xe4, 0xd6,
-	0xbd, 0xc7, 0x93, 0xeb, 0x58, 0xab, 0xee, 0x5a, 0x0e, 0x89, 0xd4, 0xef, 0xc2, 0x03, 0x4a, 0xfd,
-	0xfe, 0x1e, 0x2b, 0x51, 0x64, 0x71, 0xf4, 0xc2, 0xcb, 0xf9, 0xe6, 0x90, 0xcc, 0xf2, 0x38, 0xb0,
-	0x94, 0x5e, 0x49, 0x85, 0xff, 0x3d, 0x0b, 0xe5, 0x6d, 0xcf, 0x61, 0xd5, 0x7f, 0xd8, 0x87, 0x6a,
-	0xc4, 0xa8, 0x5d, 0x12, 0xed, 0x58, 0x61, 0x50, 0xa9, 0x6f, 0x10, 0x3d, 0x92, 0xd4, 0xfe, 0x8f,
-	0x45, 0x18, 0x35, 0x34, 0x7e, 0xa6, 0xf9, 0x66, 0x3d, 0x64, 0xe6, 0x5b, 0xe1, 0x08, 0xe6, 0xdb,
-	0x77, 0x43, 0xa5, 0x26, 0xb5, 0x51, 0x3e, 0xb7, 0x63, 0xa4, 0x75, 0x9c, 0x56, 0x48, 0xaa, 0x09,
-	0x6b, 0x9e, 0x68, 0x39, 0x91, 0x5e, 0x9c, 0xf0, 0x0b, 0x64, 0xe5, 0xff, 0x0a, 0x8d, 0xd6, 0xdd,
-	0x27, 0x1d, 0x57, 0x50, 0x3a, 0x3c, 0xae, 0xc0, 0xfe, 0x7d, 0x4b, 0xbd, 0xdc, 0xfb, 0x50, 0x47,
-	0xea, 0x56, 0xb2, 0x8e, 0xd4, 0xc5, 0x5c, 0xa6, 0xb9, 0x47, 0x01, 0xa9, 0x4f, 0x59, 0x70, 0xee,
-	0xe0, 0x3a, 0xf1, 0xe8, 0x29, 0x28, 0x35, 0xc2, 0xa0, 0xdd, 0x12, 0x3a, 0x58, 0xd1, 0x61, 0x45,
-	0xf9, 0x31, 0x87, 0xd1, 0x4d, 0xd4, 0xae, 0xeb, 0xd7, 0xd3, 0x9b, 0xa8, 0xab, 0xae, 0x5f, 0xc7,
-	0x0c, 0xd2, 0x47, 0xe5, 0xde, 0x6b, 0x30, 0xb2, 0x18, 0x34, 0x9b, 0x8e, 0x5f, 0x47, 0x6f, 0x85,
-	0x91, 0x1a, 0xff, 0x29, 0xdc, 0x80, 0xec, 0xc0, 0x5d, 0x40, 0xb1, 0x84, 0xa1, 0xb3, 0x30, 0xe4,
-	0x84, 0x0d, 0xe9, 0xfa, 0x63, 0x81, 0x7c, 0xf3, 0x61, 0x23, 0xc2, 0xac, 0xd5, 0xfe, 0x73, 0x0b,
-	0x26, 0x68, 0x17, 0x97, 0x4d, 0x30, 0x9b, 0xda, 0xa7, 0x61, 0xd8, 0x69, 0xc7, 0x3b, 0x41, 0xd7,
-	0x9e, 0x70, 0x9e, 0xb5, 0x62, 0x01, 0xa5, 0x83, 0x55, 0xc5, 0x50, 0x8c, 0xc1, 0x2e, 0xd1, 0xef,
-	0x8a, 0x41, 0xa8, 0x59, 0x1d, 0xb5, 0xb7, 0xb2, 0x4e, 0x7c, 0xab, 0xbc, 0x19, 0x4b, 0x38, 0x25,
-	0xb6, 0x15, 0xd4, 0x3b, 0x22, 0x50, 0x59, 0x11, 0x5b, 0x08, 0xea, 0x1d, 0xcc, 0x20, 0xe8, 0x49,
-	0x28, 0x46, 0x3b, 0x8e, 0x8c, 0x2d, 0x90, 0xc1, 0xfb, 0xd5, 0xcb, 0xf3, 0x98, 0xb6, 0xab, 0x5c,
-	0x94, 0xd0, 0x4b, 0x47, 0x0c, 0x27, 0x73, 0x51, 0x42, 0xcf, 0xfe, 0x27, 0x43, 0xc0, 0x62, 0x86,
-	0x9c, 0x90, 0xd4, 0x37, 0x03, 0x56, 0x6b, 0xfb, 0x58, 0x8f, 0xe6, 0xf5, 0xa6, 0xfa, 0x61, 0x3e,
-	0x9e, 0x37, 0x8e, 0x68, 0x8b, 0xf7, 0xfb, 0x88, 0x36, 0xfb, 0xd4, 0x7d, 0xe8, 0x21, 0x3a, 0x75,
-	0xb7, 0x3f, 0x63, 0x01, 0x52, 0x11, 0x60, 0x3a, 0x2c, 0x66, 0x0e, 0x2a, 0x2a, 0xe4, 0x4c, 0x7c,
-	0x2f, 0x5a, 0x44, 0x4b, 0x00, 0xd6, 0x38, 0x7d, 0x78, 0x52, 0x9e, 0x92, 0xfa, 0xb3, 0x98, 0x94,
-	0x25, 0x4c, 0xeb, 0x0a, 0x75, 0x6a, 0xff, 0x4a, 0x01, 0x1e, 0xe3, 0xa6, 0xdb, 0x9a, 0xe3, 0x3b,
-	0x0d, 0xd2, 0xa4, 0xa3, 0xea, 0x37, 0xd0, 0xa9, 0x46, 0xb7, 0xf0, 0xae, 0xcc, 0x23, 0x19, 0x54,
-	0x76, 0x72, 0x39, 0xc3, 0x25, 0xcb, 0x8a, 0xef, 0xc6, 0x98, 0x11, 0x47, 0x11, 0x94, 0xe5, 0x0d,
-	0x67, 0x42, 0x17, 0xe6, 0xc4, 0x48, 0xa9, 0x05, 0x61, 0xe5, 0x10, 0xac, 0x18, 0x51, 0x53, 0xc6,
-	0x0b, 0x6a, 0xbb, 0xf4, 0x93, 0x4f, 0x9b, 0x32, 0xab, 0xa2, 0x1d, 0x2b, 0x0c, 0xbb, 0x09, 0x93,
-	0x72, 0x0e, 0x5b, 0x57, 0x49, 0x07, 0x93, 0x6d, 0xaa, 0xff, 0x6b, 0xb2, 0xc9, 0xb8, 0x74, 0x4d,
-	0xe9, 0xff, 0x45, 0x13, 0x88, 0x93, 0xb8, 0xb2, 0xfc, 0x76, 0x21, 0xbb, 0xfc, 0xb6, 0xfd, 0x2b,
-	0x16, 0xa4, 0x0d, 0x10, 0xe6, 0x80, 0x33, 0x6f, 0x50, 0xeb, 0x55, 0x97, 0xff, 0x08, 0x15, 0x79,
-	0xdf, 0x0f, 0xa3, 0x4e, 0x4c, 0x2d, 0x4c, 0xee, 0x0d, 0x2a, 0xde, 0xdb, 0xe9, 0xe7, 0x5a, 0x50,
-	0x77, 0xb7, 0x5d, 0xe6, 0x05, 0x32, 0xc9, 0xd9, 0x7f, 0xa3, 0x04, 0x95, 0xa5, 0xb0, 0x73, 0xf4,
-	0x0c, 0xc0, 0xee, 0xfc, 0xbe, 0xc2, 0x91, 0xf2, 0xfb, 0x64, 0x06, 0x61, 0xb1, 0x67, 0x06, 0xa1,
-	0xcc, 0x00, 0x1c, 0x7a, 0x50, 0x19, 0x80, 0xa5, 0x87, 0x24, 0x03, 0x70, 0xf8, 0x21, 0xc8, 0x00,
-	0x1c, 0xb9, 0xcf, 0x19, 0x80, 0xf6, 0x7f, 0x1f, 0x82, 0xa9, 0xae, 0x4c, 0x6e, 0xf4, 0x02, 0x8c,
-	0xa9, 0x6f, 0x54, 0x1e, 0x00, 0x54, 0xcc, 0xf0, 0x7b, 0x0d, 0xc3, 0x09, 0xcc, 0x3e, 0x04, 0xf5,
-	0x0a, 0x3c, 0x1a, 0x92, 0x57, 0xdb, 0xa4, 0x4d, 0xe6, 0xb7, 0x63, 0x12, 0x56, 0x49, 0x2d, 0xf0,
-	0xeb, 0xfc, 0xe8, 0xb4, 0xb8, 0xf0, 0xf8, 0xdd, 0xfd, 0x99, 0x47, 0x71, 0x37, 0x18, 0x67, 0xf5,
-	0x41, 0x2d, 0x18, 0xf7, 0xcc, 0x9d, 0xab, 0x58, 0xc3, 0xf7, 0xb4, 0xe9, 0x55, 0xb2, 0x2a, 0xd1,
-	0x8c, 0x93, 0x0c, 0x92, 0xdb, 0xdf, 0xd2, 0x03, 0xda, 0xfe, 0x7e, 0x5c, 0x6f, 0x7f, 0x79, 0x34,
-	0xdb, 0xfb, 0x72, 0xce, 0xe4, 0xef, 0x67, 0xff, 0x3b, 0xc8, 0x8e, 0xf6, 0xbd, 0x50, 0x96, 0x91,
-	0xbe, 0x7d, 0x45, 0xc8, 0x9a, 0x74, 0x7a, 0x68, 0xf6, 0x1f, 0x1d, 0x82, 0x0c, 0xa7, 0x0d, 0x95,
-	0xb4, 0xda, 0xda, 0x4f, 0x48, 0xda, 0xa3, 0x59, 0xfc, 0xe8, 0x0e, 0x8f, 0x72, 0xe6, 0x36, 0xde,
-	0x4b, 0x79, 0x3b, 0x9d, 0x74, 0xe0, 0xb3, 0xd2, 0x7f, 0x2a, 0xf8, 0xf9, 0x02, 0x80, 0xde, 0x30,
-	0x0a, 0x4b, 0x5f, 0x85, 0x2d, 0xe9, 0x7d, 0x25, 0x36, 0xb0, 0x58, 0x58, 0x82, 0x1f, 0xc5, 0x8e,
-	0xe7, 0x5d, 0x76, 0xfd, 0x58, 0x58, 0xff, 0x3a, 0x2c, 0x41, 0x83, 0xb0, 0x89, 0x87, 0xae, 0x00,
-	0x6a, 0xf1, 0x71, 0x19, 0xfe, 0x06, 0x26, 0x17, 0x0d, 0x77, 0xd6, 0x46, 0x17, 0x06, 0xce, 0xe8,
-	0x85, 0xde, 0xab, 0x4e, 0xb6, 0x46, 0xee, 0x25, 0x1d, 0x0f, 0xba, 0xcf, 0xad, 0xce, 0xbc, 0xdb,
-	0x58, 0x36, 0x47, 0x59, 0x6e, 0x3b, 0x70, 0x7a, 0xd9, 0x8d, 0x95, 0xe4, 0x55, 0xcb, 0x9c, 0xed,
-	0x41, 0xa5, 0x82, 0xb4, 0x7a, 0x2a, 0x48, 0x23, 0x7f, 0xb7, 0x90, 0x4c, 0x37, 0x4e, 0xe7, 0xef,
-	0xda, 0x35, 0x38, 0xb9, 0xec, 0xc6, 0x97, 0x5c, 0x8f, 0x1c, 0x23, 0x93, 0x5f, 0x1e, 0x86, 0x31,
-	0xb3, 0x00, 0xc9, 0x51, 0xcc, 0x89, 0xcf, 0xd2, 0xcd, 0x93, 0x98, 0x08, 0x57, 0x45, 0x7c, 0xdc,
-	0x1c, 0xb8, 0x1a, 0x4a, 0xf6, 0xe4, 0x1a, 0xfb, 0x27, 0xcd, 0x13, 0x9b, 0x03, 0x40, 0xb7, 0xa1,
-	0xb4, 0xcd, 0x52, 0x46, 0x8b, 0x79, 0xc4, 0xf8, 0x65, 0x4d, 0xbe, 0x16, 0x18, 0x3c, 0xe9, 0x94,
-	0xf3, 0xa3, 0x36, 0x6f, 0x98, 0x2c, 0x99, 0x60, 0xa4, 0xef, 0x08, 0x63, 0x4a, 0x61, 0xf4, 0x52,
-	0x5a, 0xa5, 0x7b, 0x50, 0x5a, 0x09, 0x15, 0x32, 0xfc, 0x80, 0x54, 0x08, 0x4b, 0xff, 0x8d, 0x77,
-	0xd8, 0x8e, 0x4c, 0xe4, 0x1b, 0x8e, 0xb0, 0x49, 0x30, 0xd2, 0x7f, 0x13, 0x60, 0x9c, 0xc6, 0x47,
-	0x1f, 0x51, 0x4a, 0xa8, 0x9c, 0xc7, 0x89, 0x9a, 0xb9, 0xa2, 0x8f, 0x5b, 0xff, 0x7c, 0xa6, 0x00,
-	0x13, 0xcb, 0x7e, 0x7b, 0x63, 0x79, 0xa3, 0xbd, 0xe5, 0xb9, 0xb5, 0xab, 0xa4, 0x43, 0x95, 0xcc,
-	0x2e, 0xe9, 0xac, 0x2c, 0xa5, 0x5d, 0x51, 0x57, 0x69, 0x23, 0xe6, 0x30, 0x2a, 0x56, 0xb7, 0x5d,
-	0xbf, 0x41, 0xc2, 0x56, 0xe8, 0x8a, 0xc3, 0x2e, 0x43, 0xac, 0x5e, 0xd2, 0x20, 0x6c, 0xe2, 0x51,
-	0xda, 0xc1, 0x6d, 0x5f, 0x55, 0x83, 0x53, 0xb4, 0xd7, 0x69, 0x23, 0xe6, 0x30, 0x8a, 0x14, 0x87,
-	0x6d, 0xe1, 0x4b, 0x36, 0x90, 0x36, 0x69, 0x23, 0xe6, 0x30, 0xe1, 0x1a, 0x62, 0x21, 0x94, 0xa5,
-	0x2e, 0xd7, 0x10, 0x8b, 0x22, 0x92, 0x70, 0x8a, 0xba, 0x4b, 0x3a, 0x4b, 0x4e, 0xec, 0xa4, 0x3d,
-	0x3b, 0x57, 0x79, 0x33, 0x96, 0x70, 0x56, 0xd2, 0x3e, 0x39, 0x1d, 0x5f, 0x73, 0x25, 0xed, 0x93,
-	0xc3, 0xef, 0xe1, 0x91, 0xfc, 0x89, 0x02, 0x8c, 0xbd, 0x79, 0x11, 0xf7, 0xc1, 0x37, 0xaf, 0xdd,
-	0x84, 0xa9, 0xae, 0xfa, 0x03, 0x7d, 0xd8, 0x68, 0x87, 0x16, 0xaa, 0xb1, 0x31, 0x8c, 0x52, 0xc2,
-	0xb2, 0xaa, 0xeb, 0x22, 0x4c, 0xf1, 0xef, 0x98, 0x72, 0x62, 0xe9, 0xe4, 0xaa, 0xa6, 0x04, 0x3b,
-	0xd8, 0xbd, 0x91, 0x06, 0xe2, 0x6e, 0x7c, 0xfb, 0x07, 0x2d, 0x18, 0x4f, 0x94, 0x84, 0xc8, 0xc9,
-	0x9a, 0x64, 0x1f, 0x7a, 0xc0, 0x32, 0x01, 0x58, 0x66, 0x56, 0x2a, 0xac, 0xf3, 0x92, 0x06, 0x61,
-	0x13, 0xcf, 0xfe, 0xcd, 0x22, 0x94, 0x65, 0xf4, 0x61, 0x1f, 0x43, 0xf9, 0xb4, 0x05, 0xe3, 0xea,
-	0x30, 0x9d, 0x99, 0x5a, 0x85, 0x3c, 0xf2, 0x52, 0xe9, 0x08, 0x94, 0xff, 0xce, 0xdf, 0x0e, 0xf4,
-	0xd6, 0x06, 0x9b, 0xcc, 0x70, 0x92, 0x37, 0xba, 0x01, 0x10, 0x75, 0xa2, 0x98, 0x34, 0x8d, 0x73,
-	0x18, 0xdb, 0x58, 0x65, 0xb3, 0xb5, 0x20, 0x24, 0x74, 0x4d, 0x5d, 0x0b, 0xea, 0xa4, 0xaa, 0x30,
-	0xb5, 0x2d, 0xaa, 0xdb, 0xb0, 0x41, 0x09, 0xbd, 0xa6, 0x42, 0x3f, 0x86, 0xf2, 0x50, 0xf1, 0x72,
-	0x7e, 0xfb, 0x89, 0xfd, 0x18, 0x20, 0xd6, 0xc2, 0xfe, 0x99, 0x02, 0x9c, 0x48, 0xcf, 0x24, 0x7a,
-	0x1f, 0x8c, 0xc9, 0x49, 0x33, 0xdc, 0x5c, 0x32, 0xe4, 0x73, 0x0c, 0x1b, 0xb0, 0x37, 0xf6, 0x67,
-	0x66, 0x74, 0xe8, 0xe7, 0x1c, 0x9d, 0xbc, 0xb9, 0x3d, 0x23, 0x3a, 0x96, 0x2e, 0x83, 0x04, 0x31,
-	0x1e, 0x88, 0x21, 0x22, 0x86, 0x16, 0x3a, 0xf3, 0xad, 0x96, 0x88, 0xa6, 0x30, 0x02, 0x31, 0x4c,
-	0x28, 0x4e, 0x61, 0xa3, 0x0d, 0x38, 0x69, 0xb4, 0x5c, 0x23, 0x6e, 0x63, 0x67, 0x2b, 0x08, 0xe5,
-	0xce, 0xfa, 0xac, 0x0e, 0x4c, 0xef, 0xc6, 0xc1, 0x99, 0x3d, 0xa9, 0x8d, 0x54, 0x73, 0x5a, 0x4e,
-	0xcd, 0x8d, 0x3b, 0xe2, 0x3c, 0x4c, 0x49, 0xf4, 0x45, 0xd1, 0x8e, 0x15, 0x86, 0xfd, 0x77, 0x86,
-	0xe0, 0x04, 0x8f, 0xc4, 0x26, 0x2a, 0xd1, 0x00, 0xbd, 0x0f, 0x2a, 0x51, 0xec, 0x84, 0xdc, 0xa9,
-	0x66, 0x1d, 0x59, 0x74, 0xe9, 0x3a, 0x16, 0x92, 0x08, 0xd6, 0xf4, 0xd0, 0xcb, 0xac, 0x0c, 0xa3,
-	0x1b, 0xed, 0x30, 0xea, 0x85, 0x7b, 0x73, 0xd9, 0x5d, 0x52, 0x14, 0xb0, 0x41, 0x0d, 0x7d, 0x2b,
-	0x94, 0x5a, 0x3b, 0x4e, 0x24, 0xfd, 0xc9, 0x4f, 0x4b, 0x39, 0xb1, 0x41, 0x1b, 0xdf, 0xd8, 0x9f,
-	0x39, 0x95, 0x7e, 0x54, 0x06, 0xc0, 0xbc, 0x93, 0x29, 0xe5, 0x87, 0x0e, 0x91, 0xf2, 0x4f, 0xc3,
-	0x70, 0x3d, 0xec, 0x54, 0x2f, 0xcf, 0xa7, 0x6f, 0xd3, 0x5a, 0x62, 0xad, 0x58, 0x40, 0xa9, 0x4c,
-	0xda, 0xe1, 0x2c, 0xeb, 0x14, 0x79, 0x38, 0x69, 0x7c, 0x5c, 0xd6, 0x20, 0x6c, 0xe2, 0xb1, 0x92,
-	0x68, 0xa9, 0x38, 0xfd, 0x91, 0x63, 0xc8, 0xe3, 0xea, 0x37, 0x42, 0xff, 0x22, 0x54, 0xc4, 0x50,
-	0x37, 0x03, 0xf4, 0x02, 0x8c, 0x71, 0x77, 0xe5, 0x42, 0xe8, 0xf8, 0xb5, 0x9d, 0xb4, 0x9b, 0x69,
-	0xd3, 0x80, 0xe1, 0x04, 0xa6, 0xbd, 0x06, 0x43, 0x7d, 0x0a, 0xd9, 0xbe, 0xbc, 0x07, 0xef, 0x85,
-	0x32, 0x25, 0x27, 0xf7, 0x6a, 0x79, 0x90, 0x0c, 0xa0, 0x2c, 0xaf, 0xe1, 0x45, 0x36, 0x14, 0x5d,
-	0x47, 0xc6, 0x55, 0xa9, 0x4f, 0x68, 0x25, 0x8a, 0xda, 0x6c, 0xd9, 0x51, 0x20, 0x7a, 0x0a, 0x8a,
-	0xe4, 0x4e, 0x2b, 0x1d, 0x40, 0x75, 0xf1, 0x4e, 0xcb, 0x0d, 0x49, 0x44, 0x91, 0xc8, 0x9d, 0x16,
-	0x3a, 0x03, 0x05, 0xb7, 0x2e, 0x56, 0x24, 0x08, 0x9c, 0xc2, 0xca, 0x12, 0x2e, 0xb8, 0x75, 0xfb,
-	0x0e, 0x54, 0xd4, 0xbd, 0xbf, 0x68, 0x57, 0x5a, 0x57, 0x56, 0x1e, 0x11, 0xf5, 0x92, 0x6e, 0x0f,
-	0xbb, 0xaa, 0x0d, 0xa0, 0xcb, 0xa2, 0xe4, 0xa5, 0x82, 0xcf, 0xc3, 0x50, 0x2d, 0x10, 0x25, 0xb3,
-	0xca, 0x9a, 0x0c, 0xb3, 0xa5, 0x18, 0xc4, 0xbe, 0x09, 0x13, 0x57, 0xfd, 0xe0, 0x36, 0xbb, 0x81,
-	0x8f, 0x15, 0x9c, 0xa7, 0x84, 0xb7, 0xe9, 0x8f, 0xb4, 0x11, 0xcf, 0xa0, 0x98, 0xc3, 0x54, 0x59,
-	0xe9, 0x42, 0xaf, 0xb2, 0xd2, 0xf6, 0x47, 0x2d, 0x18, 0x53, 0xfe, 0xe2, 0xe5, 0xbd, 0xdd, 0xfe,
-	0xce, 0xa9, 0x8d, 0xc2, 0x23, 0x85, 0x43, 0x0a, 0x8f, 0xc8, 0x23, 0xed, 0x62, 0xaf, 0x23, 0x6d,
-	0xfb, 0x2f, 0x2d, 0x38, 0xa1, 0x86, 0x20, 0x6d, 0xa6, 0x17, 0x60, 0x6c, 0xab, 0xed, 0x7a, 0x75,
-	0x59, 0x49, 0x3f, 0xf5, 0xb9, 0x2c, 0x18, 0x30, 0x9c, 0xc0, 0x44, 0x17, 0x00, 0xb6, 0x5c, 0xdf,
-	0x09, 0x3b, 0x1b, 0xda, 0x48, 0x53, 0x7a, 0x7b, 0x41, 0x41, 0xb0, 0x81, 0x85, 0x3e, 0x0c, 0xe5,
-	0x3d, 0x19, 0xc9, 0x50, 0xcc, 0xb5, 0x5e, 0x86, 0x98, 0x0f, 0xfd, 0x25, 0xa8, 0xd0, 0x08, 0xc5,
-	0xd1, 0xfe, 0x5c, 0x11, 0x26, 0x92, 0x35, 0x2e, 0xfa, 0x70, 0xa2, 0x3c, 0x05, 0x25, 0x56, 0xf6,
-	0x22, 0xbd, 0xb0, 0x78, 0xe9, 0x7b, 0x0e, 0x43, 0x11, 0x0c, 0x73, 0x51, 0x92, 0xcf, 0x25, 0xd1,
-	0x6a, 0x90, 0xca, 0x93, 0xcc, 0x5c, 0x57, 0xe2, 0x58, 0x46, 0xb0, 0x42, 0xdf, 0x6b, 0xc1, 0x48,
-	0xd0, 0x32, 0xeb, 0x19, 0xbf, 0x94, 0x67, 0xfd, 0x0f, 0x91, 0x64, 0x2f, 0xac, 0x21, 0xb5, 0xf0,
-	0xe4, 0x62, 0x90, 0xac, 0xcf, 0x7c, 0x0b, 0x8c, 0x99, 0x98, 0x87, 0x19, 0x44, 0x65, 0xd3, 0x20,
-	0xfa, 0xb4, 0xb9, 0x24, 0x45, 0x85, 0x93, 0x3e, 0x3e, 0xf6, 0xeb, 0x50, 0xaa, 0xa9, 0xd0, 0xd0,
-	0x7b, 0xba, 0xfd, 0x45, 0x15, 0x25, 0x64, 0x61, 0x37, 0x9c, 0x9a, 0xfd, 0xfb, 0x96, 0xb1, 0x3e,
-	0x30, 0x89, 0x56, 0xea, 0x28, 0x84, 0x62, 0x63, 0x6f, 0x57, 0x18, 0x19, 0x57, 0x72, 0x9a, 0xde,
-	0xe5, 0xbd, 0x5d, 0xfd, 0x85, 0x99, 0xad, 0x98, 0x32, 0xeb, 0xe3, 0xb8, 0x23, 0x51, 0x08, 0xa7,
-	0x78, 0x78, 0x21, 0x1c, 0xfb, 0xf5, 0x02, 0x4c, 0x75, 0x2d, 0x2a, 0xf4, 0x1a, 0x94, 0x42, 0xfa,
-	0x94, 0xe2, 0xf1, 0x56, 0x73, 0x2b, 0x5d, 0x13, 0xad, 0xd4, 0xb5, 0xf2, 0x4e, 0xb6, 0x63, 0xce,
-	0x12, 0x5d, 0x01, 0xa4, 0x03, 0x98, 0xd5, 0x59, 0x0b, 0x7f, 0x64, 0xe5, 0x16, 0x9e, 0xef, 0xc2,
-	0xc0, 0x19, 0xbd, 0xd0, 0x8b, 0xe9, 0x23, 0x9b, 0x54, 0x85, 0xfc, 0x83, 0x4e, 0x5f, 0xec, 0xcf,
-	0x9b, 0x4b, 0xf0, 0x86, 0x16, 0xa6, 0x83, 0x6e, 0x4e, 0xbb, 0x24, 0x6b, 0xb1, 0x5f, 0xc9, 0x6a,
-	0xff, 0x62, 0x01, 0xc6, 0x13, 0x15, 0xaf, 0x91, 0x07, 0x65, 0xe2, 0xb1, 0xc8, 0x02, 0xa9, 0x7d,
-	0x07, 0xbd, 0xb0, 0x4b, 0xc9, 0xc9, 0x8b, 0x82, 0x2e, 0x56, 0x1c, 0x1e, 0x8e, 0x78, 0xcc, 0x17,
-	0x60, 0x4c, 0x0e, 0xe8, 0x25, 0xa7, 0xe9, 0xa5, 0xa7, 0xef, 0xa2, 0x01, 0xc3, 0x09, 0x4c, 0xfb,
-	0x57, 0x8b, 0x30, 0xcd, 0x43, 0x31, 0xea, 0xea, 0x63, 0x50, 0x21, 0x55, 0x3f, 0xa0, 0xeb, 0xd2,
-	0xf3, 0x89, 0xdc, 0x1a, 0xf4, 0x7e, 0xcc, 0x6c, 0x46, 0x7d, 0xa5, 0x11, 0xfc, 0x78, 0x2a, 0x8d,
-	0x80, 0x6f, 0xd5, 0x1b, 0xc7, 0x34, 0xa2, 0xaf, 0xad, 0xbc, 0x82, 0xbf, 0x5f, 0x80, 0xc9, 0xd4,
-	0xe5, 0xa3, 0xe8, 0x73, 0xc9, 0xfb, 0xaa, 0xac, 0x3c, 0x0e, 0x2a, 0x0f, 0xbc, 0x8f, 0xf2, 0x68,
-	0xb7, 0x56, 0x3d, 0xa0, 0x4f, 0xc5, 0xfe, 0xdd, 0x02, 0x4c, 0x24, 0x6f, 0x4d, 0x7d, 0x08, 0x67,
-	0xea, 0xed, 0x50, 0x61, 0x17, 0x03, 0x5e, 0x25, 0x1d, 0x79, 0x1e, 0xca, 0xef, 0x60, 0x93, 0x8d,
-	0x58, 0xc3, 0x1f, 0x8a, 0xcb, 0xc0, 0xec, 0x7f, 0x68, 0xc1, 0x29, 0xfe, 0x94, 0xe9, 0x75, 0xf8,
-	0x43, 0x59, 0xb3, 0xfb, 0x4a, 0xbe, 0x03, 0x4c, 0xdd, 0xa7, 0x70, 0xd8, 0xfc, 0x52, 0xe3, 0xe5,
-	0xa4, 0x18, 0x6d, 0x72, 0x29, 0x3c, 0x84, 0x83, 0x3d, 0xd2, 0x62, 0xb0, 0xff, 0x7d, 0x01, 0x46,
-	0xd7, 0x17, 0x57, 0x94, 0x08, 0x9f, 0x83, 0x4a, 0x2d, 0x24, 0x8e, 0x76, 0xff, 0x98, 0x81, 0x7e,
-	0x12, 0x80, 0x35, 0x0e, 0xdd, 0x45, 0xf1, 0x40, 0xd9, 0x28, 0xbd, 0x8b, 0xe2, 0x71, 0xb4, 0x11,
-	0x96, 0x70, 0xf4, 0x2c, 0x94, 0x59, 0x16, 0xfe, 0xf5, 0x50, 0x6a, 0x1c, 0xbd, 0xb5, 0x66, 0xed,
-	0x78, 0x15, 0x2b, 0x0c, 0x4a, 0xb8, 0x1e, 0xd4, 0x22, 0x8a, 0x9c, 0xf2, 0xc8, 0x2c, 0xd1, 0x66,
-	0xbc, 0x8a, 0x25, 0x9c, 0xd5, 0x53, 0x65, 0x5e, 0x0b, 0x8a, 0x5c, 0x4a, 0x0e, 0x9a, 0xbb, 0x37,
-	0x28, 0xba, 0xc6, 0x39, 0x4a, 0xdd, 0xdd, 0x54, 0x4a, 0xeb, 0x48, 0x7f, 0x29, 0xad, 0xf6, 0xef,
-	0x16, 0xa1, 0xa2, 0x9d, 0x6a, 0xae, 0xa8, 0x3d, 0x93, 0xcb, 0x7d, 0x1d, 0xd5, 0x8e, 0x5f, 0x53,
-	0xa4, 0x79, 0xdc, 0x83, 0x51, 0x7a, 0xe6, 0xfb, 0x2d, 0x18, 0x75, 0x7d, 0x37, 0x76, 0x1d, 0xe6,
-	0x1b, 0x14, 0x72, 0x73, 0x23, 0xa7, 0xda, 0x24, 0x2b, 0x9c, 0x72, 0x10, 0x9a, 0xc1, 0x09, 0x8a,
-	0x19, 0x36, 0x39, 0xa3, 0x0f, 0x89, 0x0c, 0xca, 0x62, 0x6e, 0x05, 0x9c, 0xca, 0xa9, 0xb4, 0xc9,
-	0x16, 0xb5, 0xb1, 0xe3, 0x30, 0xa7, 0xba, 0x67, 0x98, 0x92, 0x52, 0xf7, 0x46, 0xa9, 0x5d, 0x0c,
-	0x6b, 0xc6, 0x9c, 0x91, 0x1d, 0x01, 0xea, 0x9e, 0x8b, 0x23, 0x66, 0xa7, 0xcd, 0x41, 0xc5, 0x69,
-	0xc7, 0x41, 0x93, 0x4e, 0x93, 0x88, 0x1d, 0xd0, 0xf9, 0x77, 0x12, 0x80, 0x35, 0x8e, 0xfd, 0x63,
-	0x25, 0x48, 0x55, 0x82, 0x41, 0x77, 0xa0, 0xa2, 0x6a, 0xc1, 0xe4, 0x93, 0xed, 0xad, 0x57, 0x94,
-	0x1a, 0x8c, 0x6a, 0xc2, 0x9a, 0x19, 0x0a, 0xa5, 0x9b, 0x95, 0x7f, 0xed, 0xef, 0x4f, 0xbb, 0x59,
-	0xaf, 0x1e, 0xf9, 0x00, 0x8e, 0x2e, 0xdb, 0x39, 0x5e, 0x06, 0x74, 0xf6, 0x50, 0xe7, 0x6c, 0xf1,
-	0x10, 0xe7, 0xec, 0xc7, 0xc4, 0x25, 0x93, 0x98, 0x44, 0x6d, 0x2f, 0x16, 0x0b, 0xe3, 0xbd, 0x39,
-	0x7e, 0x70, 0x9c, 0xb0, 0x2e, 0xae, 0xc6, 0xff, 0x63, 0x83, 0x69, 0xd2, 0x85, 0x3e, 0x7c, 0xac,
-	0x2e, 0xf4, 0x91, 0x5c, 0x5d, 0xe8, 0x17, 0x00, 0xd8, 0x32, 0xe7, 0x09, 0x35, 0x65, 0xe6, 0xd9,
-	0x54, 0xda, 0x06, 0x2b, 0x08, 0x36, 0xb0, 0xec, 0x6f, 0x82, 0x64, 0x75, 0x40, 0x34, 0x23, 0x8b,
-	0x11, 0xf2, 0xc3, 0x41, 0x96, 0xcb, 0x9c, 0xa8, 0x1b, 0xf8, 0xf3, 0x16, 0x98, 0x25, 0x0c, 0xd1,
-	0xab, 0xbc, 0x56, 0xa2, 0x95, 0xc7, 0x61, 0x93, 0x41, 0x77, 0x76, 0xcd, 0x69, 0xa5, 0x42, 0xb4,
-	0x64, 0xc1, 0xc4, 0x33, 0xef, 0x86, 0xb2, 0x84, 0x1e, 0xc9, 0x6e, 0xfe, 0x08, 0x3c, 0x2a, 0xeb,
-	0xa2, 0xc8, 0x73, 0x21, 0x11, 0x8b, 0x70, 0x7f, 0xd2, 0x62, 0x7e, 0xc1, 0x82, 0xf3, 0xe9, 0x01,
-	0x44, 0x6b, 0x81, 0xef, 0xc6, 0x41, 0x58, 0x25, 0x71, 0xec, 0xfa, 0x0d, 0x56, 0xd2, 0xfa, 0xb6,
-	0x13, 0xca, 0x2b, 0xe6, 0x98, 0xcc, 0xbc, 0xe9, 0x84, 0x3e, 0x66, 0xad, 0xa8, 0x03, 0xc3, 0x3c,
-	0xea, 0x5f, 0x6c, 0x88, 0x06, 0xfc, 0x36, 0x32, 0xa6, 0x43, 0xef, 0xc8, 0x78, 0xc6, 0x01, 0x16,
-	0x0c, 0xed, 0xaf, 0x58, 0x80, 0xd6, 0xf7, 0x48, 0x18, 0xba, 0x75, 0x23, 0x4f, 0x81, 0x5d, 0xd6,
-	0x6c, 0x5c, 0xca, 0x6c, 0x16, 0xfb, 0x49, 0x5d, 0xd6, 0x6c, 0xfc, 0xcb, 0xbe, 0xac, 0xb9, 0x70,
-	0xb4, 0xcb, 0x9a, 0xd1, 0x3a, 0x9c, 0x6a, 0xf2, 0x1d, 0x1d, 0xbf, 0x00, 0x95, 0x6f, 0xef, 0x54,
-	0x81, 0x89, 0xd3, 0x77, 0xf7, 0x67, 0x4e, 0xad, 0x65, 0x21, 0xe0, 0xec, 0x7e, 0xf6, 0xbb, 0x01,
-	0xf1, 0x78, 0xdd, 0xc5, 0xac, 0x18, 0xdb, 0x9e, 0x1e, 0x0f, 0xfb, 0x8b, 0x25, 0x98, 0x4c, 0x5d,
-	0x40, 0x44, 0x77, 0xd3, 0xdd, 0x41, 0xbd, 0x03, 0xab, 0xf2, 0xee, 0xe1, 0xf5, 0x15, 0x26, 0xec,
-	0x43, 0xc9, 0xf5, 0x5b, 0xed, 0x38, 0x9f, 0xfa, 0x36, 0x7c, 0x10, 0x2b, 0x94, 0xa0, 0x71, 0x44,
-	0x41, 0xff, 0x62, 0xce, 0x26, 0xcf, 0xa0, 0xe3, 0xc4, 0x7e, 0x67, 0xe8, 0x01, 0x79, 0x5c, 0x3e,
-	0xa6, 0x43, 0x80, 0x4b, 0x79, 0xb8, 0x93, 0x53, 0x8b, 0xe5, 0xb8, 0x03, 0xb0, 0x7e, 0xb6, 0x00,
-	0xa3, 0xc6, 0x4b, 0x43, 0x3f, 0x91, 0x2c, 0xf0, 0x6b, 0xe5, 0xf7, 0x48, 0x8c, 0xfe, 0xac, 0x2e,
-	0xe1, 0xcb, 0x1f, 0xe9, 0xe9, 0xee, 0xda, 0xbe, 0x6f, 0xec, 0xcf, 0x9c, 0x48, 0x55, 0xef, 0x4d,
-	0xd4, 0xfb, 0x3d, 0xf3, 0x5d, 0x30, 0x99, 0x22, 0x93, 0xf1, 0xc8, 0x9b, 0xe6, 0x23, 0x0f, 0xec,
-	0xf9, 0x33, 0xa7, 0xec, 0xa7, 0xe9, 0x94, 0x89, 0xb2, 0x1a, 0x81, 0x47, 0xfa, 0x70, 0x7b, 0xa6,
-	0xb6, 0x1a, 0x85, 0x3e, 0xab, 0xe7, 0x3c, 0x03, 0xe5, 0x56, 0xe0, 0xb9, 0x35, 0x57, 0xdd, 0x0f,
-	0xc0, 0xea, 0xf5, 0x6c, 0x88, 0x36, 0xac, 0xa0, 0xe8, 0x36, 0x54, 0x6e, 0xdd, 0x8e, 0xf9, 0x89,
-	0xa3, 0x38, 0xd5, 0xc8, 0xeb, 0xa0, 0x51, 0x19, 0x2d, 0xea, 0x48, 0x13, 0x6b, 0x5e, 0xc8, 0x86,
-	0x61, 0xa6, 0x04, 0x65, 0x8a, 0x2d, 0x3b, 0x71, 0x61, 0xda, 0x31, 0xc2, 0x02, 0x62, 0xff, 0xdb,
-	0x51, 0x38, 0x99, 0x75, 0x0b, 0x1c, 0xfa, 0x30, 0x0c, 0xf3, 0x31, 0xe6, 0x73, 0xd1, 0x68, 0x16,
-	0x8f, 0x65, 0x46, 0x50, 0x0c, 0x8b, 0xfd, 0xc6, 0x82, 0xa7, 0xe0, 0xee, 0x39, 0x5b, 0x62, 0x85,
-	0x1c, 0x0f, 0xf7, 0x55, 0x47, 0x73, 0x5f, 0x75, 0x38, 0x77, 0xcf, 0xd9, 0x42, 0x77, 0xa0, 0xd4,
-	0x70, 0x63, 0xe2, 0x08, 0x3f, 0xcd, 0xcd, 0x63, 0x61, 0x4e, 0x1c, 0x6e, 0xa5, 0xb1, 0x9f, 0x98,
-	0x33, 0x44, 0x5f, 0xb2, 0x60, 0x72, 0x2b, 0x59, 0xb6, 0x4b, 0x08, 0x4f, 0xe7, 0x18, 0x6e, 0xfa,
-	0x4b, 0x32, 0xe2, 0xb7, 0x95, 0xa7, 0x1a, 0x71, 0x7a, 0x38, 0xe8, 0xe3, 0x16, 0x8c, 0x6c, 0xb3,
-	0x4b, 0x90, 0xa4, 0x50, 0x3d, 0x86, 0x97, 0xc3, 0x6f, 0x59, 0xd2, 0x3b, 0x0e, 0xfe, 0x3f, 0xc2,
-	0x92, 0x73, 0x2f, 0x4d, 0x35, 0x3c, 0xa8, 0xa6, 0x1a, 0x79, 0x40, 0x9a, 0xea, 0x93, 0x16, 0x54,
-	0xd4, 0x4c, 0x8b, 0xf2, 0x47, 0xef, 0x3b, 0xc6, 0x57, 0xce, 0x9d, 0x53, 0xea, 0x2f, 0xd6, 0xcc,
-	0xd1, 0xe7, 0x2d, 0x18, 0x75, 0x5e, 0x6b, 0x87, 0xa4, 0x4e, 0xf6, 0x82, 0x56, 0x24, 0xea, 0x19,
-	0xbf, 0x92, 0xff, 0x60, 0xe6, 0x29, 0x93, 0x25, 0xb2, 0xb7, 0xde, 0x8a, 0x44, 0xfa, 0xbf, 0x6e,
-	0xc0, 0xe6, 0x10, 0xd0, 0xf7, 0x69, 0x3d, 0x0e, 0x79, 0x54, 0xb5, 0xcf, 0x1a, 0x4d, 0x5f, 0xd5,
-	0x2c, 0x08, 0x3c, 0x51, 0x0b, 0xfc, 0xd8, 0xf5, 0xdb, 0x64, 0xdd, 0xc7, 0xa4, 0x15, 0x5c, 0x0b,
-	0xe2, 0x4b, 0x41, 0xdb, 0xaf, 0x5f, 0x0c, 0xc3, 0x20, 0x64, 0xf5, 0x9d, 0x8c, 0xfb, 0xa5, 0x17,
-	0x7b, 0xa3, 0xe2, 0x83, 0xe8, 0x0c, 0x62, 0x33, 0xec, 0x17, 0x60, 0xe6, 0x90, 0xc9, 0x46, 0x2f,
-	0xc0, 0x58, 0x10, 0x36, 0x1c, 0xdf, 0x7d, 0xcd, 0x2c, 0x59, 0xa8, 0x0c, 0xd2, 0x75, 0x03, 0x86,
-	0x13, 0x98, 0x66, 0x2d, 0xab, 0xc2, 0x21, 0xb5, 0xac, 0xce, 0xc3, 0x50, 0x48, 0x5a, 0x41, 0x7a,
-	0x5f, 0xc5, 0xb2, 0x64, 0x19, 0x04, 0x3d, 0x09, 0x45, 0xa7, 0xe5, 0x0a, 0x3f, 0xa3, 0xda, 0x2e,
-	0xce, 0x6f, 0xac, 0x60, 0xda, 0x9e, 0x28, 0xad, 0x57, 0xba, 0x2f, 0xa5, 0xf5, 0xa8, 0xc6, 0x14,
-	0x27, 0x69, 0xc3, 0x5a, 0x63, 0x26, 0x4f, 0xb8, 0xec, 0xd7, 0x8b, 0xf0, 0xe4, 0x81, 0x9f, 0x96,
-	0x0e, 0x64, 0xb7, 0x0e, 0x08, 0x64, 0x97, 0xd3, 0x53, 0x38, 0x6c, 0x7a, 0x8a, 0x3d, 0xa6, 0xe7,
-	0xe3, 0x54, 0x62, 0xc8, 0x52, 0x8f, 0x42, 0x49, 0x0c, 0x98, 0x5c, 0xd0, 0xab, 0x72, 0xa4, 0x10,
-	0x16, 0x12, 0x8a, 0x35, 0x5f, 0xba, 0x5d, 0x4a, 0xd4, 0x71, 0x2a, 0xe5, 0xa1, 0x31, 0x7b, 0x96,
-	0x5b, 0xe4, 0x62, 0xa2, 0x57, 0x71, 0x28, 0xfb, 0x97, 0x86, 0xe0, 0xa9, 0x3e, 0x14, 0x9d, 0xb9,
-	0x8a, 0xad, 0x3e, 0x57, 0xf1, 0xd7, 0xf8, 0x6b, 0xfa, 0x44, 0xe6, 0x6b, 0xc2, 0xf9, 0xbf, 0xa6,
-	0x83, 0xdf, 0x10, 0x3b, 0x8c, 0xf0, 0x23, 0x52, 0x6b, 0x87, 0x44, 0x24, 0xcc, 0xe9, 0xc3, 0x08,
-	0xd1, 0x8e, 0x15, 0x06, 0xdd, 0xfe, 0xd6, 0x1c, 0xfa, 0xf9, 0x8f, 0xe4, 0x54, 0xb7, 0xc7, 0xcc,
-	0xc6, 0xe7, 0xd6, 0xd7, 0xe2, 0x3c, 0x95, 0x00, 0x9c, 0x8d, 0xfd, 0x6b, 0x16, 0x9c, 0xe9, 0x6d,
-	0x8d, 0xa0, 0xe7, 0x60, 0x74, 0x8b, 0xc5, 0x55, 0xae, 0xb1, 0xe8, 0x29, 0xb1, 0x74, 0xd8, 0xf3,
-	0xea, 0x66, 0x6c, 0xe2, 0xa0, 0x45, 0x98, 0x32, 0x03, 0x32, 0xd7, 0x8c, 0xb0, 0x2b, 0xe6, 0x2f,
-	0xd9, 0x4c, 0x03, 0x71, 0x37, 0x3e, 0x9a, 0x05, 0x88, 0xdd, 0xd8, 0x23, 0xbc, 0x37, 0x5f, 0x68,
-	0xcc, 0xa1, 0xb8, 0xa9, 0x5a, 0xb1, 0x81, 0x61, 0x7f, 0xb5, 0x98, 0xfd, 0x18, 0xdc, 0xca, 0x3d,
-	0xca, 0xea, 0x17, 0x6b, 0xbb, 0xd0, 0x87, 0x84, 0x2e, 0xde, 0x6f, 0x09, 0x3d, 0xd4, 0x4b, 0x42,
-	0xa3, 0x25, 0x38, 0x61, 0xdc, 0x58, 0xcd, 0x2b, 0x3f, 0xf1, 0xf3, 0x29, 0x55, 0xb6, 0x71, 0x23,
-	0x05, 0xc7, 0x5d, 0x3d, 0x1e, 0xf2, 0xa5, 0xfa, 0xeb, 0x05, 0x38, 0xdd, 0x73, 0x63, 0x71, 0x9f,
-	0x34, 0x90, 0xf9, 0xfa, 0x87, 0xee, 0xcf, 0xeb, 0x37, 0x5f, 0x4a, 0xe9, 0xd0, 0x97, 0xd2, 0x8f,
-	0x3a, 0xff, 0xbd, 0x42, 0xcf, 0x8f, 0x85, 0x6e, 0x44, 0xbf, 0x6e, 0x67, 0xf2, 0x45, 0x18, 0x77,
-	0x5a, 0x2d, 0x8e, 0xc7, 0x92, 0x34, 0x52, 0xa5, 0x64, 0xe7, 0x4d, 0x20, 0x4e, 0xe2, 0xf6, 0x35,
-	0xb1, 0x7f, 0x64, 0x41, 0x05, 0x93, 0x6d, 0x2e, 0xe1, 0xd0, 0x2d, 0x31, 0x45, 0x56, 0x1e, 0xf7,
-	0x80, 0xd0, 0x89, 0x8d, 0x5c, 0x56, 0x2d, 0x22, 0x6b, 0xb2, 0x07, 0x2d, 0x06, 0xa2, 0xae, 0x7b,
-	0x2e, 0xf6, 0xbe, 0xee, 0xd9, 0xfe, 0x8b, 0x31, 0xfa, 0x78, 0xad, 0x60, 0x31, 0x24, 0xf5, 0x88,
-	0xbe, 0xdf, 0x76, 0xe8, 0x89, 0x45, 0xa2, 0xde, 0xef, 0x75, 0xbc, 0x8a, 0x69, 0x7b, 0xe2, 0xa8,
-	0xb2, 0x70, 0xa4, 0x42, 0x9a, 0xc5, 0x43, 0x0b, 0x69, 0xbe, 0x08, 0xe3, 0x51, 0xb4, 0xb3, 0x11,
-	0xba, 0x7b, 0x4e, 0x4c, 0xae, 0x12, 0x59, 0xe5, 0x4a, 0x17, 0x95, 0xab, 0x5e, 0xd6, 0x40, 0x9c,
-	0xc4, 0x45, 0xcb, 0x30, 0xa5, 0xcb, 0x59, 0x92, 0x30, 0x66, 0x89, 0x90, 0x7c, 0x25, 0xa8, 0x0a,
-	0x46, 0xba, 0x00, 0xa6, 0x40, 0xc0, 0xdd, 0x7d, 0xa8, 0xcc, 0x4d, 0x34, 0xd2, 0x81, 0x0c, 0x27,
-	0x65, 0x6e, 0x82, 0x0e, 0x1d, 0x4b, 0x57, 0x0f, 0xb4, 0x06, 0x8f, 0xf2, 0x85, 0x31, 0xdf, 0x6a,
-	0x19, 0x4f, 0x34, 0x92, 0xbc, 0x7c, 0x61, 0xb9, 0x1b, 0x05, 0x67, 0xf5, 0x43, 0xcf, 0xc3, 0xa8,
-	0x6a, 0x5e, 0x59, 0x12, 0x47, 0x6b, 0xca, 0xb5, 0xa7, 0xc8, 0xac, 0xd4, 0xb1, 0x89, 0x87, 0x5e,
-	0x82, 0xc7, 0xf5, 0x5f, 0x9e, 0xf7, 0xcf, 0x8f, 0x9e, 0x97, 0x44, 0xa5, 0x60, 0x75, 0xb7, 0xdf,
-	0x72, 0x26, 0x5a, 0x1d, 0xf7, 0xea, 0x8f, 0xb6, 0xe0, 0x8c, 0x02, 0x5d, 0xf4, 0x63, 0x96, 0xfa,
-	0x1a, 0x91, 0x05, 0x27, 0x62, 0x41, 0x14, 0xc0, 0x9e, 0xd3, 0x16, 0xd4, 0xcf, 0x2c, 0xbb, 0xf1,
-	0xe5, 0x2c, 0x4c, 0xbc, 0x8a, 0x0f, 0xa0, 0x82, 0xe6, 0xa0, 0x42, 0x7c, 0x67, 0xcb, 0x23, 0xeb,
-	0x8b, 0x2b, 0x62, 0x47, 0xaa, 0x13, 0x25, 0x24, 0x00, 0x6b, 0x1c, 0x15, 0xea, 0x3f, 0xd6, 0x2b,
-	0xd4, 0x1f, 0x6d, 0xc0, 0xc9, 0x46, 0xad, 0x45, 0xad, 0x4c, 0xb7, 0x46, 0xe6, 0x6b, 0x2c, 0xb6,
-	0x98, 0xbe, 0x18, 0x7e, 0x2b, 0x86, 0xca, 0x99, 0x5a, 0x5e, 0xdc, 0xe8, 0xc2, 0xc1, 0x99, 0x3d,
-	0x59, 0x0c, 0x7a, 0x18, 0xdc, 0xe9, 0x4c, 0x3f, 0x9a, 0x8a, 0x41, 0xa7, 0x8d, 0x98, 0xc3, 0xd0,
-	0x15, 0x40, 0x2c, 0x6f, 0xf0, 0x72, 0x1c, 0xb7, 0x94, 0x59, 0x3b, 0x7d, 0x32, 0x59, 0x68, 0xe1,
-	0x52, 0x17, 0x06, 0xce, 0xe8, 0x45, 0xad, 0x1e, 0x3f, 0x60, 0xd4, 0xa7, 0x1f, 0x4f, 0x5a, 0x3d,
-	0xd7, 0x78, 0x33, 0x96, 0x70, 0xf4, 0x7e, 0x98, 0x6e, 0x47, 0x84, 0x6d, 0x98, 0x6f, 0x06, 0xe1,
-	0xae, 0x17, 0x38, 0xf5, 0x15, 0x76, 0x9d, 0x73, 0xdc, 0x99, 0x9e, 0x66, 0xcc, 0xcf, 0x8b, 0xbe,
-	0xd3, 0xd7, 0x7b, 0xe0, 0xe1, 0x9e, 0x14, 0xd2, 0x85, 0x6f, 0x4f, 0xf7, 0x59, 0xf8, 0x76, 0x03,
-	0x4e, 0x4a, 0xbd, 0xb6, 0xbe, 0xb8, 0xa2, 0x1e, 0x7a, 0xfa, 0x4c, 0xf2, 0x56, 0xc8, 0x95, 0x0c,
-	0x1c, 0x9c, 0xd9, 0x13, 0xed, 0xc2, 0x93, 0xcc, 0xc7, 0x22, 0x5e, 0xce, 0x46, 0xe8, 0xfa, 0x35,
-	0xb7, 0xe5, 0x78, 0xfc, 0x93, 0x5c, 0xa9, 0x4f, 0x3f, 0xc9, 0x86, 0xf6, 0x56, 0x41, 0xfa, 0xc9,
-	0xf9, 0x83, 0x90, 0xf1, 0xc1, 0xb4, 0xd0, 0x6d, 0x78, 0xcb, 0x01, 0x08, 0x5c, 0xb5, 0x4c, 0x9f,
-	0x63, 0x0c, 0xbf, 0x51, 0x30, 0x7c, 0xcb, 0xfc, 0x61, 0x1d, 0xf0, 0xe1, 0x34, 0x7b, 0x3e, 0xe5,
-	0x26, 0xf1, 0x1d, 0xf6, 0x94, 0x33, 0x7d, 0x3c, 0xa5, 0x44, 0xc6, 0x07, 0xd3, 0x42, 0x3b, 0x70,
-	0x96, 0x21, 0xcc, 0xd7, 0x62, 0x77, 0x4f, 0xd7, 0x34, 0xba, 0xe8, 0xd7, 0x5b, 0x81, 0xeb, 0xc7,
-	0xd3, 0xe7, 0x19, 0xaf, 0x6f, 0x10, 0xbc, 0xce, 0xce, 0x1f, 0x80, 0x8b, 0x0f, 0xa4, 0x64, 0xff,
-	0xa1, 0x05, 0xe3, 0x4a, 0xfd, 0xdc, 0x87, 0x3c, 0x74, 0x2f, 0x99, 0x87, 0xbe, 0x3c, 0xb8, 0x02,
-	0x67, 0x23, 0xef, 0x91, 0x2a, 0xf5, 0xdf, 0xa6, 0x00, 0xb4, 0x92, 0x57, 0xf6, 0x95, 0xd5, 0xd3,
-	0xbe, 0x7a, 0x68, 0x15, 0x6c, 0x56, 0x15, 0xda, 0xd2, 0x83, 0xad, 0x42, 0x5b, 0x85, 0x53, 0x52,
-	0x1e, 0xf0, 0x78, 0x80, 0xcb, 0x41, 0xa4, 0xf4, 0xb5, 0x71, 0x47, 0xeb, 0x4a, 0x16, 0x12, 0xce,
-	0xee, 0x9b, 0x30, 0xcc, 0x47, 0x0e, 0x35, 0xcc, 0x95, 0x8a, 0x5a, 0xdd, 0x96, 0x37, 0x28, 0xa7,
-	0x54, 0xd4, 0xea, 0xa5, 0x2a, 0xd6, 0x38, 0xd9, 0x76, 0x4a, 0x25, 0x27, 0x3b, 0x05, 0x8e, 0x6c,
-	0xa7, 0x48, 0x8d, 0x39, 0xda, 0x53, 0x63, 0xca, 0x73, 0xc7, 0xb1, 0x9e, 0xe7, 0x8e, 0xef, 0x81,
-	0x09, 0xd7, 0xdf, 0x21, 0xa1, 0x1b, 0x93, 0x3a, 0xfb, 0x16, 0x98, 0x36, 0x2d, 0x6b, 0x2b, 0x75,
-	0x25, 0x01, 0xc5, 0x29, 0xec, 0xa4, 0x9a, 0x9f, 0xe8, 0x43, 0xcd, 0xf7, 0x30, 0xae, 0x26, 0xf3,
-	0x31, 0xae, 0x4e, 0x0c, 0x6e, 0x5c, 0x4d, 0x1d, 0xab, 0x71, 0x85, 0x72, 0x31, 0xae, 0xfa, 0xb2,
-	0x5b, 0x0c, 0x0f, 0xcb, 0xc9, 0x43, 0x3c, 0x2c, 0xbd, 0x2c, 0xab, 0x53, 0xf7, 0x6c, 0x59, 0x65,
-	0x1b, 0x4d, 0x8f, 0xbd, 0x69, 0x34, 0xe5, 0x62, 0x34, 0x3d, 0x05, 0xa5, 0x3a, 0x69, 0xc5, 0x3b,
-	0xd3, 0x4f, 0xb0, 0xc5, 0xaa, 0xde, 0xff, 0x12, 0x6d, 0xc4, 0x1c, 0x86, 0x62, 0x38, 0x7f, 0x9b,
-	0x6c, 0xed, 0x04, 0xc1, 0xee, 0x9a, 0xe3, 0xbb, 0xdb, 0x44, 0xdc, 0xb4, 0x70, 0xd3, 0x09, 0x9b,
-	0xa2, 0xca, 0x7d, 0x7d, 0xfa, 0x2c, 0x1b, 0xc2, 0x33, 0xa2, 0xff, 0xf9, 0x9b, 0x87, 0xe0, 0xe3,
-	0x43, 0x29, 0xbe, 0x69, 0xcf, 0x7d, 0x2d, 0xdb, 0x73, 0x9f, 0x2c, 0xc0, 0x29, 0x6d, 0xf1, 0x50,
-	0x3d, 0xe3, 0x6e, 0x53, 0x9d, 0x4f, 0xd0, 0x05, 0x00, 0x1e, 0x05, 0x63, 0x94, 0xd2, 0xd0, 0xc5,
-	0x44, 0x14, 0x04, 0x1b, 0x58, 0xac, 0x22, 0x05, 0x09, 0xd9, 0x85, 0x69, 0x69, 0x73, 0x68, 0x51,
-	0xb4, 0x63, 0x85, 0x41, 0x3f, 0x2e, 0xfa, 0x5b, 0xd4, 0x46, 0x4a, 0x5f, 0xc5, 0xb1, 0xa8, 0x41,
-	0xd8, 0xc4, 0x43, 0xcf, 0x70, 0x26, 0x4c, 0x15, 0x53, 0x93, 0x68, 0x8c, 0xfb, 0x9a, 0x94, 0xf6,
-	0x55, 0x50, 0x39, 0x1c, 0x56, 0x31, 0xa5, 0xd4, 0x3d, 0x1c, 0x16, 0x5b, 0xae, 0x30, 0xec, 0xff,
-	0x61, 0xc1, 0xe9, 0xcc, 0xa9, 0xb8, 0x0f, 0x66, 0xee, 0x9d, 0xa4, 0x99, 0x5b, 0xcd, 0xcb, 0x4f,
-	0x65, 0x3c, 0x45, 0x0f, 0x93, 0xf7, 0x3f, 0x58, 0x30, 0xa1, 0xf1, 0xef, 0xc3, 0xa3, 0xba, 0xc9,
-	0x47, 0xcd, 0xcf, 0x25, 0x57, 0xe9, 0x7a, 0xb6, 0x5f, 0x2d, 0x80, 0xba, 0x1e, 0x67, 0xbe, 0x16,
-	0xf7, 0x97, 0x8e, 0xda, 0x81, 0x61, 0x16, 0x56, 0x16, 0xe5, 0x13, 0x32, 0x9b, 0xe4, 0xcf, 0x42,
-	0xd4, 0xf4, 0x29, 0x3f, 0xfb, 0x1b, 0x61, 0xc1, 0x90, 0x5d, 0xe7, 0x27, 0xe5, 0x74, 0x31, 0x69,
-	0xcc, 0x2a, 0x79, 0xac, 0x30, 0xa8, 0x21, 0xe6, 0xd6, 0x02, 0x7f, 0xd1, 0x73, 0xa2, 0x48, 0xec,
-	0x0d, 0x94, 0x21, 0xb6, 0x22, 0x01, 0x58, 0xe3, 0xb0, 0x88, 0x33, 0x37, 0x6a, 0x79, 0x4e, 0xc7,
-	0x70, 0xbc, 0x1a, 0x35, 0x00, 0x15, 0x08, 0x9b, 0x78, 0x76, 0x13, 0xa6, 0x93, 0x0f, 0xb1, 0x44,
-	0xb6, 0x59, 0xe6, 0x47, 0x5f, 0xd3, 0x39, 0x07, 0x15, 0x87, 0xf5, 0x5a, 0x6d, 0x3b, 0x42, 0x26,
-	0xe8, 0xfc, 0x07, 0x09, 0xc0, 0x1a, 0xc7, 0xfe, 0x66, 0x78, 0x34, 0x63, 0xce, 0xfa, 0x88, 0xaa,
-	0xfd, 0xc5, 0x02, 0x4c, 0x26, 0x7b, 0x46, 0x2c, 0x37, 0x9a, 0x8f, 0xd9, 0x8d, 0x6a, 0xc1, 0x1e,
-	0x09, 0x3b, 0x74, 0x18, 0x56, 0x2a, 0x37, 0xba, 0x0b, 0x03, 0x67, 0xf4, 0x62, 0x37, 0x55, 0xd5,
-	0xd5, 0xa3, 0xcb, 0xe5, 0x71, 0x23, 0xcf, 0xe5, 0xa1, 0x67, 0xd6, 0x8c, 0x04, 0x54, 0x2c, 0xb1,
-	0xc9, 0x9f, 0xda, 0xd5, 0x2c, 0xb3, 0x6b, 0xa1, 0xed, 0x7a, 0xb1, 0xeb, 0x8b, 0x47, 0x16, 0x0b,
-	0x47, 0xd9, 0xd5, 0x6b, 0xdd, 0x28, 0x38, 0xab, 0x9f, 0xfd, 0x95, 0x21, 0x50, 0x15, 0x92, 0x58,
-	0xa4, 0x76, 0x4e, 0x71, 0xee, 0x47, 0xcd, 0xb0, 0x57, 0x6f, 0x7a, 0xe8, 0xa0, 0xd0, 0x49, 0xee,
-	0x3a, 0x37, 0xcf, 0xd8, 0xd4, 0x84, 0x6d, 0x6a, 0x10, 0x36, 0xf1, 0xe8, 0x48, 0x3c, 0x77, 0x8f,
-	0xf0, 0x4e, 0xc3, 0xc9, 0x91, 0xac, 0x4a, 0x00, 0xd6, 0x38, 0xec, 0x32, 0x08, 0x77, 0x7b, 0x5b,
-	0xf8, 0x81, 0xf5, 0x65, 0x10, 0xee, 0xf6, 0x36, 0x66, 0x10, 0x7e, 0x97, 0x61, 0xb0, 0x2b, 0xf6,
-	0x92, 0xc6, 0x5d, 0x86, 0xc1, 0x2e, 0x66, 0x10, 0xfa, 0x96, 0xfc, 0x20, 0x6c, 0x3a, 0x9e, 0xfb,
-	0x1a, 0xa9, 0x2b, 0x2e, 0x62, 0x0f, 0xa9, 0xde, 0xd2, 0xb5, 0x6e, 0x14, 0x9c, 0xd5, 0x8f, 0xd7,
-	0x80, 0x25, 0x75, 0xb7, 0x16, 0x9b, 0xd4, 0x20, 0xb9, 0xa0, 0x37, 0xba, 0x30, 0x70, 0x46, 0x2f,
-	0x34, 0x0f, 0x93, 0xb2, 0xc2, 0x95, 0xac, 0x5f, 0x3b, 0x9a, 0xac, 0x32, 0x89, 0x93, 0x60, 0x9c,
-	0xc6, 0xa7, 0x12, 0xab, 0x29, 0x6a, 0xaa, 0xb3, 0x2d, 0xa7, 0x21, 0xb1, 0x64, 0xad, 0x75, 0xac,
-	0x30, 0xec, 0x8f, 0x15, 0xa9, 0x86, 0xed, 0x71, 0x75, 0xc1, 0x7d, 0xcb, 0xab, 0x48, 0xae, 0xc8,
-	0xa1, 0x3e, 0x56, 0xe4, 0xbb, 0x60, 0xec, 0x56, 0x14, 0xf8, 0x2a, 0x67, 0xa1, 0xd4, 0x33, 0x67,
-	0xc1, 0xc0, 0xca, 0xce, 0x59, 0x18, 0xce, 0x2b, 0x67, 0x61, 0xe4, 0x1e, 0x73, 0x16, 0xfe, 0x55,
-	0x09, 0xd4, 0x25, 0xd7, 0xd7, 0x48, 0x7c, 0x3b, 0x08, 0x77, 0x5d, 0xbf, 0xc1, 0xaa, 0x35, 0x7d,
-	0xc9, 0x92, 0x05, 0x9f, 0x56, 0xcd, 0xb4, 0xfe, 0xed, 0x9c, 0x2e, 0x1c, 0x4e, 0x30, 0x9b, 0xdd,
-	0x34, 0x18, 0xf1, 0xd8, 0xb7, 0x54, 0x61, 0x29, 0x71, 0xac, 0x97, 0x18, 0x11, 0xfa, 0x2e, 0x00,
-	0x79, 0x68, 0xb6, 0x2d, 0x25, 0xf0, 0x4a, 0x3e, 0xe3, 0xc3, 0x64, 0x5b, 0xdb, 0xb7, 0x9b, 0x8a,
-	0x09, 0x36, 0x18, 0xa2, 0x4f, 0xea, 0x92, 0x07, 0x3c, 0xcf, 0xf1, 0x43, 0xc7, 0x32, 0x37, 0xfd,
-	0x14, 0x3c, 0xc0, 0x30, 0xe2, 0xfa, 0x0d, 0xba, 0x4e, 0x44, 0x6c, 0xf7, 0xdb, 0xb2, 0x8a, 0x01,
-	0xae, 0x06, 0x4e, 0x7d, 0xc1, 0xf1, 0x1c, 0xbf, 0x46, 0xc2, 0x15, 0x8e, 0xae, 0xf7, 0xd2, 0xa2,
-	0x01, 0x4b, 0x42, 0x5d, 0x17, 0x71, 0x97, 0xfa, 0xb9, 0x88, 0xfb, 0xcc, 0xb7, 0xc3, 0x54, 0xd7,
-	0xcb, 0x3c, 0x52, 0x7d, 0x83, 0x01, 0xca, 0x00, 0xfe, 0xd2, 0xb0, 0x56, 0x5a, 0xd7, 0x82, 0x3a,
-	0xbf, 0xa0, 0x39, 0xd4, 0x6f, 0x54, 0xd8, 0xaf, 0x39, 0x2e, 0x11, 0xa5, 0x66, 0x8c, 0x46, 0x6c,
-	0xb2, 0xa4, 0x6b, 0xb4, 0xe5, 0x84, 0xc4, 0x3f, 0xee, 0x35, 0xba, 0xa1, 0x98, 0x60, 0x83, 0x21,
-	0xda, 0x49, 0x24, 0xe2, 0x5e, 0x1a, 0x3c, 0x11, 0x97, 0x55, 0x69, 0xce, 0xba, 0xc7, 0xf4, 0xf3,
-	0x16, 0x4c, 0xf8, 0x89, 0x95, 0x9b, 0x4f, 0xc2, 0x4d, 0xf6, 0x57, 0xb1, 0x80, 0xee, 0xee, 0xcf,
-	0x4c, 0x24, 0xdb, 0x70, 0x8a, 0x7f, 0x96, 0x4a, 0x2b, 0x1d, 0x51, 0xa5, 0xe9, 0x7b, 0xe5, 0x87,
-	0x7b, 0xde, 0x2b, 0xef, 0xc3, 0x30, 0x2f, 0x24, 0x2b, 0xc2, 0x6e, 0x06, 0x2c, 0x67, 0x64, 0x56,
-	0xa3, 0xe5, 0xfc, 0x78, 0x0b, 0x16, 0x5c, 0xd0, 0x4d, 0x33, 0x4f, 0xbf, 0x7c, 0xe4, 0x2c, 0xd0,
-	0xf1, 0x5e, 0xf9, 0xfc, 0xf6, 0xff, 0x1d, 0x82, 0x13, 0x72, 0x46, 0x64, 0xb2, 0x1e, 0xd5, 0x8f,
-	0x9c, 0xaf, 0xb6, 0x95, 0x95, 0x7e, 0xbc, 0x2c, 0x01, 0x58, 0xe3, 0x50, 0x7b, 0xac, 0x1d, 0x91,
-	0xf5, 0x16, 0xf1, 0x57, 0xdd, 0xad, 0x48, 0x04, 0xc8, 0xa8, 0x0f, 0xe5, 0xba, 0x06, 0x61, 0x13,
-	0x8f, 0x15, 0x13, 0xa8, 0x99, 0x15, 0x7d, 0x74, 0x31, 0x01, 0x61, 0xa8, 0x4a, 0x38, 0xfa, 0xd1,
-	0xcc, 0xbb, 0x94, 0xf2, 0xc9, 0x76, 0xef, 0xca, 0x51, 0x3c, 0xda, 0x25, 0x4a, 0xe8, 0xa7, 0x2c,
-	0x38, 0xc5, 0x5b, 0xe5, 0x4c, 0x5e, 0x6f, 0xd5, 0x9d, 0x98, 0x44, 0xf9, 0xdc, 0x81, 0x99, 0x31,
-	0x3e, 0x7d, 0x54, 0x92, 0xc5, 0x16, 0x67, 0x8f, 0x06, 0x7d, 0xce, 0x82, 0xc9, 0xdd, 0x44, 0x45,
-	0x3e, 0xa9, 0x3a, 0x06, 0x2d, 0x57, 0x95, 0x20, 0xaa, 0x3f, 0xb5, 0x64, 0x7b, 0x84, 0xd3, 0xdc,
-	0xed, 0x3f, 0xb7, 0xc0, 0x14, 0xa3, 0xf7, 0xbf, 0x90, 0xdf, 0xd1, 0x4d, 0x41, 0x69, 0x5d, 0x96,
-	0x7a, 0x5a, 0x97, 0x4f, 0x42, 0xb1, 0xed, 0xd6, 0xc5, 0xfe, 0x42, 0x87, 0xe4, 0xac, 0x2c, 0x61,
-	0xda, 0x6e, 0x7f, 0x6a, 0x58, 0xfb, 0x24, 0x44, 0x06, 0xf9, 0xd7, 0xc5, 0x63, 0xbf, 0xaa, 0x8a,
-	0x75, 0xf3, 0x27, 0x7f, 0xa9, 0xab, 0x58, 0xf7, 0xf2, 0x40, 0xb5, 0x02, 0xf8, 0x5c, 0xf5, 0xaa,
-	0xd5, 0x3d, 0x72, 0x48, 0xa1, 0x80, 0x36, 0x94, 0xe9, 0x6e, 0x8c, 0xf9, 0x19, 0xcb, 0x89, 0xf1,
-	0x95, 0x2f, 0x8b, 0xf6, 0x37, 0xf6, 0x67, 0x2e, 0x0e, 0x34, 0x42, 0x49, 0x08, 0x2b, 0x56, 0xe8,
-	0x23, 0x50, 0xa1, 0xbf, 0x59, 0x79, 0x03, 0xb1, 0xe5, 0xfb, 0x90, 0x92, 0xa4, 0x12, 0x90, 0x77,
-	0x19, 0x05, 0xcd, 0x12, 0x75, 0xa0, 0x42, 0x11, 0x39, 0x7f, 0xbe, 0x49, 0x7c, 0x9f, 0xaa, 0x37,
-	0x20, 0x01, 0x6f, 0xec, 0xcf, 0x5c, 0x1a, 0x88, 0xbf, 0xa2, 0x84, 0x35, 0x37, 0x43, 0x8d, 0x8e,
-	0xf6, 0x52, 0xa3, 0xf6, 0x5f, 0x0c, 0xe9, 0x6f, 0x41, 0xd4, 0x7c, 0xff, 0xba, 0xf8, 0x16, 0x5e,
-	0x48, 0x7d, 0x0b, 0xe7, 0xbb, 0xbe, 0x85, 0x09, 0x3a, 0x67, 0x19, 0xe5, 0xe7, 0xef, 0xb7, 0x61,
-	0x71, 0xb8, 0xff, 0x82, 0x59, 0x54, 0xaf, 0xb6, 0xdd, 0x90, 0x44, 0x1b, 0x61, 0xdb, 0x77, 0xfd,
-	0x06, 0x5b, 0xc8, 0x65, 0xd3, 0xa2, 0x4a, 0x80, 0x71, 0x1a, 0x1f, 0x3d, 0x0b, 0x65, 0xba, 0x2e,
-	0x6e, 0x3a, 0x7b, 0x7c, 0x11, 0x1a, 0x45, 0x76, 0xab, 0xa2, 0x1d, 0x2b, 0x0c, 0xb4, 0x03, 0x67,
-	0x25, 0x81, 0x25, 0xe2, 0x11, 0xfa, 0x40, 0x2c, 0x2c, 0x39, 0x6c, 0xf2, 0xa4, 0x21, 0x1e, 0x59,
-	0xa6, 0xce, 0x3e, 0xf0, 0x01, 0xb8, 0xf8, 0x40, 0x4a, 0xf6, 0x1f, 0xb0, 0x58, 0x16, 0xa3, 0x0c,
-	0x0c, 0x5d, 0x7d, 0x9e, 0xdb, 0x74, 0x65, 0x2d, 0x60, 0xb5, 0xfa, 0x56, 0x69, 0x23, 0xe6, 0x30,
-	0x74, 0x1b, 0x46, 0xb6, 0x9c, 0xda, 0x6e, 0xb0, 0xbd, 0x9d, 0xcf, 0x5d, 0x83, 0x0b, 0x9c, 0x18,
-	0xbb, 0x07, 0x60, 0x44, 0xfc, 0x79, 0x43, 0xff, 0xc4, 0x92, 0x1b, 0xbf, 0x48, 0x66, 0x3b, 0x24,
-	0xd1, 0x8e, 0x70, 0xf2, 0x19, 0x17, 0xc9, 0xb0, 0x66, 0x2c, 0xe1, 0xf6, 0xef, 0x94, 0x60, 0x52,
-	0xc6, 0x95, 0x5e, 0x76, 0x23, 0x16, 0xcd, 0x62, 0x5e, 0xa9, 0x52, 0x38, 0xf4, 0x4a, 0x95, 0x0f,
-	0x00, 0xd4, 0x49, 0xcb, 0x0b, 0x3a, 0xcc, 0xe6, 0x1c, 0x3a, 0xb2, 0xcd, 0xa9, 0xb6, 0x29, 0x4b,
-	0x8a, 0x0a, 0x36, 0x28, 0x8a, 0x5a, 0xc9, 0xfc, 0x86, 0x96, 0x54, 0xad, 0x64, 0xe3, 0xf2, 0xd2,
-	0xe1, 0xfb, 0x7b, 0x79, 0xa9, 0x0b, 0x93, 0x7c, 0x88, 0xaa, 0x18, 0xcb, 0x3d, 0xd4, 0x5c, 0x61,
-	0xe9, 0xac, 0x4b, 0x49, 0x32, 0x38, 0x4d, 0xd7, 0xbc, 0x99, 0xb4, 0x7c, 0xbf, 0x6f, 0x26, 0x7d,
-	0x3b, 0x54, 0xe4, 0x7b, 0x8e, 0xa6, 0x2b, 0xba, 0x66, 0x98, 0x5c, 0x06, 0x11, 0xd6, 0xf0, 0xae,
-	0x12, 0x53, 0xf0, 0xa0, 0x4a, 0x4c, 0xd9, 0xbf, 0xc8, 0x36, 0x2b, 0x7c, 0x5c, 0x47, 0xbe, 0xd8,
-	0xf7, 0xb2, 0x71, 0xb1, 0xef, 0xd1, 0xde, 0x67, 0x39, 0x75, 0x01, 0xf0, 0x59, 0x18, 0x8a, 0x9d,
-	0x86, 0xcc, 0xbe, 0x67, 0xd0, 0x4d, 0xa7, 0x11, 0x61, 0xd6, 0x7a, 0x94, 0xd2, 0xf2, 0x2f, 0xc2,
-	0x78, 0xe4, 0x36, 0x7c, 0x27, 0x6e, 0x87, 0xc4, 0x38, 0xa3, 0xd4, 0x01, 0x5e, 0x26, 0x10, 0x27,
-	0x71, 0xd1, 0xc7, 0x2d, 0x80, 0x90, 0xa8, 0xad, 0xd0, 0x70, 0x1e, 0x6b, 0x48, 0x89, 0x01, 0x49,
-	0xd7, 0xac, 0x07, 0xa4, 0xb6, 0x40, 0x06, 0x5b, 0xf4, 0x0f, 0x2c, 0x38, 0x25, 0x2f, 0x60, 0x88,
-	0x49, 0x23, 0x74, 0xe3, 0x8e, 0xa8, 0xc5, 0x34, 0x92, 0x47, 0xfe, 0x7c, 0x35, 0x49, 0x7a, 0x71,
-	0x87, 0xd4, 0x76, 0x45, 0x49, 0x26, 0xe6, 0xf9, 0xac, 0x66, 0xb1, 0xc6, 0xd9, 0x23, 0xb2, 0x3f,
-	0x61, 0xc1, 0x54, 0xd7, 0x13, 0xa2, 0x16, 0x0c, 0xd7, 0xd8, 0x55, 0xd1, 0xf9, 0x94, 0xee, 0x4d,
-	0x5e, 0x3b, 0x2d, 0xef, 0x49, 0xa3, 0x6d, 0x58, 0xf0, 0xb1, 0x7f, 0x79, 0x0c, 0x4e, 0x56, 0x17,
-	0xd7, 0xe4, 0x15, 0x73, 0xc7, 0x56, 0xfa, 0x20, 0x8b, 0xc7, 0xfd, 0x2b, 0x7d, 0xd0, 0x83, 0xbb,
-	0x67, 0x94, 0x3e, 0xf0, 0x8c, 0xd2, 0x07, 0xc9, 0x3c, 0xf4, 0x62, 0x1e, 0x79, 0xe8, 0x59, 0x23,
-	0xe8, 0x27, 0x0f, 0xfd, 0xd8, 0x6a, 0x21, 0x1c, 0x38, 0xa0, 0x23, 0xd5, 0x42, 0x50, 0x85, 0x22,
-	0x72, 0x49, 0x7b, 0xed, 0xf1, 0xaa, 0x32, 0x0b, 0x45, 0xa8, 0x24, 0x7d, 0x9e, 0xd2, 0x2d, 0x14,
-	0xf4, 0x2b, 0xf9, 0x0f, 0xa0, 0x8f, 0x24, 0x7d, 0x91, 0x55, 0x6e, 0x16, 0x86, 0x18, 0xc9, 0xa3,
-	0x30, 0x44, 0xd6, 0x70, 0x0e, 0x2d, 0x0c, 0xf1, 0x22, 0x8c, 0xd7, 0xbc, 0xc0, 0x27, 0x1b, 0x61,
-	0x10, 0x07, 0xb5, 0xc0, 0x13, 0xdb, 0x4c, 0x7d, 0xc7, 0xb2, 0x09, 0xc4, 0x49, 0xdc, 0x5e, 0x55,
-	0x25, 0x2a, 0x83, 0x56, 0x95, 0x80, 0x07, 0x54, 0x55, 0xc2, 0xa8, 0x9b, 0x30, 0x9a, 0x47, 0xdd,
-	0x84, 0xac, 0x37, 0xd2, 0x57, 0xdd, 0x84, 0xd7, 0x2d, 0x18, 0x77, 0x6e, 0xb3, 0x3d, 0x16, 0x97,
-	0xc2, 0xec, 0x94, 0x72, 0xf4, 0xc2, 0x07, 0x8f, 0x61, 0xc1, 0xde, 0xac, 0x6a, 0x36, 0x0b, 0x53,
-	0x2c, 0x97, 0xcd, 0x6c, 0xc2, 0xc9, 0x81, 0x0c, 0x52, 0x6b, 0xe1, 0x8b, 0x05, 0x78, 0xcb, 0xa1,
-	0x43, 0x40, 0xb7, 0x01, 0x62, 0xa7, 0x21, 0x16, 0xaa, 0x38, 0xcb, 0x1b, 0x30, 0x7e, 0x7e, 0x53,
-	0xd2, 0x13, 0x79, 0xc0, 0x8a, 0x3c, 0x36, 0x58, 0xb1, 0xb0, 0xf9, 0xc0, 0xeb, 0xaa, 0xba, 0x8f,
-	0x03, 0x8f, 0x60, 0x06, 0xa1, 0x46, 0x5b, 0x48, 0x1a, 0x74, 0x23, 0x52, 0x4c, 0x1a, 0x6d, 0x98,
-	0xb5, 0x62, 0x01, 0x45, 0xcf, 0xc3, 0xa8, 0xe3, 0x79, 0x3c, 0x27, 0x99, 0x44, 0xe2, 0xf2, 0x73,
-	0x5d, 0x6b, 0x5b, 0x83, 0xb0, 0x89, 0x67, 0xff, 0x59, 0x01, 0x66, 0x0e, 0x91, 0x29, 0x5d, 0xb5,
-	0x28, 0x4a, 0x7d, 0xd7, 0xa2, 0x10, 0x39, 0x95, 0xc3, 0x3d, 0x72, 0x2a, 0x9f, 0x87, 0xd1, 0x98,
-	0x38, 0x4d, 0x11, 0x71, 0x9b, 0x2e, 0x21, 0xbb, 0xa9, 0x41, 0xd8, 0xc4, 0xa3, 0x52, 0x6c, 0xc2,
-	0xa9, 0xd5, 0x48, 0x14, 0xc9, 0xa4, 0x49, 0xe1, 0xe8, 0xcf, 0x2d, 0x23, 0x93, 0x9d, 0x9f, 0xcc,
-	0x27, 0x58, 0xe0, 0x14, 0xcb, 0xf4, 0x84, 0x57, 0xfa, 0x9c, 0xf0, 0x9f, 0x2c, 0xc0, 0x93, 0x07,
-	0x6a, 0xb7, 0xbe, 0xf3, 0x59, 0xdb, 0x11, 0x09, 0xd3, 0x0b, 0xe7, 0x7a, 0x44, 0x42, 0xcc, 0x20,
-	0x7c, 0x96, 0x5a, 0x2d, 0x95, 0x2d, 0x91, 0x7f, 0x02, 0x38, 0x9f, 0xa5, 0x04, 0x0b, 0x9c, 0x62,
-	0x79, 0xaf, 0xcb, 0xf2, 0x77, 0x86, 0xe0, 0xa9, 0x3e, 0x6c, 0x80, 0x1c, 0x13, 0xe5, 0x93, 0x45,
-	0x20, 0x8a, 0x0f, 0xa8, 0x08, 0xc4, 0xbd, 0x4d, 0xd7, 0x9b, 0xb5, 0x23, 0xfa, 0x4a, 0xc8, 0xff,
-	0xe9, 0x02, 0x9c, 0xe9, 0x6d, 0xb0, 0xa0, 0x6f, 0x83, 0xc9, 0x50, 0x85, 0x48, 0x9a, 0xf5, 0x23,
-	0x1e, 0xe5, 0xae, 0xbb, 0x04, 0x08, 0xa7, 0x71, 0xd1, 0x2c, 0x40, 0xcb, 0x89, 0x77, 0xa2, 0x8b,
-	0x77, 0xdc, 0x28, 0x16, 0x05, 0x37, 0x27, 0xf8, 0xe1, 0xb3, 0x6c, 0xc5, 0x06, 0x06, 0x65, 0xc7,
-	0xfe, 0x2d, 0x05, 0xd7, 0x82, 0x98, 0x77, 0xe2, 0xdb, 0xe4, 0x47, 0xe5, 0xa5, 0xb5, 0x06, 0x08,
-	0xa7, 0x71, 0x29, 0x3b, 0x16, 0xde, 0xc0, 0x07, 0x3a, 0xa4, 0x2b, 0x4e, 0xac, 0xaa, 0x56, 0x6c,
-	0x60, 0xa4, 0x2b, 0x63, 0x94, 0x0e, 0xaf, 0x8c, 0x61, 0x7f, 0xaa, 0x08, 0xa7, 0x7b, 0x1a, 0xbc,
-	0xfd, 0x89, 0xa9, 0x87, 0xaf, 0x3a, 0xc5, 0x3d, 0x7e, 0x61, 0x47, 0xab, 0x6a, 0xb0, 0x01, 0x27,
-	0xc5, 0x1d, 0xd7, 0xf3, 0x61, 0x6d, 0xc7, 0xdd, 0x23, 0x75, 0xb6, 0x7c, 0xc4, 0x37, 0xa1, 0x92,
-	0x1a, 0x2e, 0x66, 0xe0, 0xe0, 0xcc, 0x9e, 0xf6, 0x3f, 0x2a, 0x66, 0xaf, 0x5d, 0x51, 0x03, 0xe1,
-	0xde, 0xcb, 0x45, 0x3d, 0x7c, 0x6f, 0xa8, 0xab, 0xec, 0xc1, 0xd0, 0x11, 0xca, 0x1e, 0xa4, 0x5e,
-	0x6f, 0xa9, 0xcf, 0xd7, 0x9b, 0xff, 0x0b, 0xfb, 0xb9, 0x52, 0xcf, 0x17, 0x46, 0x37, 0xf1, 0x7d,
-	0x1d, 0xde, 0x2c, 0xc1, 0x09, 0xd7, 0x67, 0xb4, 0xab, 0xed, 0x2d, 0x51, 0x27, 0x92, 0xd7, 0x45,
-	0x57, 0x99, 0x70, 0x2b, 0x29, 0x38, 0xee, 0xea, 0xf1, 0x10, 0x16, 0xb6, 0xb8, 0xc7, 0x97, 0x74,
-	0x34, 0xed, 0xb2, 0x0e, 0xa7, 0xe4, 0x54, 0xec, 0x38, 0x21, 0xa9, 0x0b, 0x83, 0x20, 0x12, 0xb9,
-	0x8f, 0xa7, 0x79, 0xfe, 0x64, 0x06, 0x02, 0xce, 0xee, 0xc7, 0xee, 0xb5, 0x0e, 0x5a, 0x6e, 0x4d,
-	0x6c, 0x57, 0xf5, 0xbd, 0xd6, 0xb4, 0x11, 0x73, 0x98, 0xd6, 0x69, 0x95, 0xfb, 0xa2, 0xd3, 0x78,
-	0xfa, 0x54, 0xc6, 0xc2, 0x85, 0x74, 0xfa, 0x54, 0xd6, 0xc2, 0xcd, 0xea, 0x69, 0x7f, 0x00, 0x2a,
-	0xea, 0x0d, 0xf2, 0xcc, 0x16, 0xf5, 0x21, 0x76, 0x65, 0xb6, 0xa8, 0xaf, 0xd0, 0xc0, 0xa2, 0xeb,
-	0x8d, 0x6e, 0xcf, 0x52, 0x12, 0x85, 0x3e, 0x01, 0x6d, 0xb7, 0xdf, 0x09, 0x63, 0xca, 0x5b, 0xdb,
-	0xef, 0x7d, 0xe5, 0xf6, 0x5f, 0x16, 0x20, 0x75, 0x1f, 0x27, 0xba, 0x03, 0x95, 0x7a, 0xd8, 0xe1,
-	0x8d, 0xf9, 0x54, 0xfa, 0x5f, 0x92, 0xe4, 0xf4, 0xa9, 0xa6, 0x6a, 0xc2, 0x9a, 0x19, 0xfa, 0x30,
-	0xaf, 0xa4, 0x2f, 0x58, 0x17, 0xf2, 0x28, 0x97, 0x52, 0x55, 0xf4, 0xcc, 0x5b, 0x88, 0x65, 0x1b,
-	0x36, 0xf8, 0xa1, 0x18, 0x2a, 0x3b, 0xf2, 0xde, 0xd1, 0x7c, 0x44, 0xb2, 0xba, 0xc6, 0x94, 0x1b,
-	0xa6, 0xea, 0x2f, 0xd6, 0x8c, 0xec, 0x9f, 0x2b, 0xc2, 0xc9, 0xe4, 0x0b, 0x10, 0xa7, 0xd0, 0x3f,
-	0x63, 0xc1, 0xe3, 0x9e, 0x13, 0xc5, 0xd5, 0x36, 0xdb, 0x1e, 0x6d, 0xb7, 0xbd, 0xf5, 0xd4, 0xfd,
-	0x0b, 0x83, 0xba, 0x98, 0x14, 0xe1, 0xf4, 0x3d, 0xb5, 0x0b, 0x4f, 0xdc, 0xdd, 0x9f, 0x79, 0x7c,
-	0x35, 0x9b, 0x39, 0xee, 0x35, 0x2a, 0xf4, 0x79, 0x0b, 0x4e, 0xd4, 0xda, 0x61, 0x48, 0xfc, 0x58,
-	0x0f, 0x95, 0xbf, 0xc5, 0x6b, 0xb9, 0x4c, 0xa4, 0x1e, 0xe0, 0x49, 0x2a, 0xa2, 0x17, 0x53, 0xbc,
-	0x70, 0x17, 0x77, 0xf4, 0x12, 0x9f, 0xc3, 0xc5, 0xa0, 0xd9, 0xa2, 0x22, 0x67, 0x29, 0xec, 0xa8,
-	0xba, 0x38, 0x5c, 0x6c, 0xab, 0x8c, 0xdb, 0xd5, 0x6c, 0x34, 0xdc, 0xab, 0xbf, 0xfd, 0x11, 0x98,
-	0x4c, 0xb9, 0xfe, 0xd1, 0x2e, 0x14, 0x1b, 0xca, 0x89, 0xbf, 0x91, 0xeb, 0xb1, 0xc3, 0xb2, 0x1b,
-	0x2f, 0x8c, 0xd0, 0xcf, 0x7d, 0xd9, 0x8d, 0x31, 0xe5, 0x62, 0xff, 0xa4, 0x05, 0x67, 0x7a, 0x9f,
-	0x4d, 0xa0, 0xef, 0xb5, 0x60, 0xb8, 0x46, 0xff, 0x4b, 0xb7, 0xcb, 0xfb, 0x8f, 0xeb, 0x18, 0x84,
-	0xc5, 0x66, 0x2a, 0xef, 0x09, 0x03, 0x44, 0x58, 0xf0, 0xb6, 0x3d, 0x38, 0x77, 0x70, 0xcf, 0x3e,
-	0xd2, 0x77, 0x9e, 0x81, 0x72, 0x2b, 0x0c, 0xb6, 0x3c, 0x99, 0xb0, 0x25, 0xcb, 0x4d, 0x8b, 0x36,
-	0xac, 0xa0, 0xf6, 0x8f, 0x58, 0x80, 0xba, 0x27, 0x0e, 0x7d, 0xd4, 0x32, 0x0a, 0x56, 0x5b, 0x79,
-	0xa4, 0xcc, 0x74, 0x33, 0x61, 0xc5, 0xaf, 0x3b, 0xbd, 0x0a, 0x61, 0xdb, 0x3f, 0x54, 0x80, 0xe9,
-	0x5e, 0x9d, 0xd0, 0x77, 0x43, 0x89, 0x6d, 0x7a, 0xc4, 0xd8, 0x5e, 0x3e, 0x9e, 0xb1, 0x51, 0x2d,
-	0x64, 0x5e, 0x32, 0x43, 0x35, 0x15, 0xe7, 0x8b, 0x62, 0x28, 0x36, 0x5a, 0x0d, 0xf1, 0xad, 0xbe,
-	0x74, 0x3c, 0xec, 0x97, 0x37, 0x96, 0xc5, 0x0a, 0xde, 0x58, 0xc6, 0x94, 0x9d, 0xfd, 0x51, 0x0b,
-	0x9e, 0x38, 0x00, 0x1b, 0x2d, 0xc2, 0x50, 0x33, 0xa8, 0xcb, 0x95, 0x31, 0x27, 0x57, 0xc6, 0x5a,
-	0x50, 0x67, 0x57, 0xa7, 0x1f, 0xd0, 0x75, 0x8d, 0x5d, 0x01, 0x4c, 0x3b, 0xa3, 0xb3, 0x30, 0xb4,
-	0x4b, 0x3a, 0x89, 0x93, 0x52, 0x76, 0x23, 0x15, 0x6b, 0xb5, 0xbf, 0x0d, 0xce, 0x1e, 0x34, 0x5d,
-	0x87, 0xd4, 0xb6, 0xb2, 0x7f, 0x80, 0x6e, 0x7c, 0x7b, 0x8a, 0xd1, 0xbf, 0x62, 0xf7, 0x76, 0xff,
-	0xc9, 0x30, 0x8c, 0x27, 0x2e, 0xae, 0x49, 0x44, 0x7b, 0x58, 0x87, 0x46, 0x7b, 0xb0, 0xf2, 0x02,
-	0x6d, 0x5f, 0xdc, 0x2b, 0x6b, 0x96, 0x17, 0x68, 0xfb, 0x04, 0x73, 0x98, 0x98, 0x52, 0xdc, 0xf6,
-	0x45, 0xf8, 0x89, 0x39, 0xa5, 0xb8, 0xed, 0x63, 0x01, 0xa5, 0x9f, 0xfc, 0x18, 0xd3, 0xed, 0x22,
-	0xac, 0x46, 0x58, 0xe0, 0x57, 0x72, 0xb0, 0x26, 0xe4, 0x7d, 0x4d, 0x2c, 0x27, 0xc1, 0x6c, 0xc1,
-	0x09, 0x8e, 0x54, 0x02, 0x57, 0x64, 0x60, 0xb7, 0x3c, 0x1c, 0xaf, 0xe6, 0x7b, 0x2f, 0x50, 0xca,
-	0xa8, 0x52, 0x17, 0xb4, 0x60, 0xcd, 0x18, 0x45, 0x2a, 0x90, 0x65, 0xe4, 0x78, 0x02, 0x59, 0x20,
-	0x23, 0x88, 0xe5, 0xed, 0x50, 0x69, 0x8a, 0x64, 0x7d, 0x1e, 0x5b, 0x22, 0x6f, 0x84, 0x93, 0x8d,
-	0x58, 0xc3, 0xd1, 0x73, 0x30, 0x1a, 0xb1, 0x07, 0x8b, 0x8d, 0x60, 0x10, 0xe6, 0x41, 0xa9, 0xea,
-	0x66, 0x6c, 0xe2, 0x98, 0x91, 0x2b, 0xf0, 0x40, 0x23, 0x57, 0x46, 0x0f, 0x89, 0x5c, 0xa9, 0xc2,
-	0x29, 0xa7, 0x1d, 0x07, 0x97, 0x89, 0xe3, 0xcd, 0xc7, 0x31, 0x69, 0xb6, 0xe2, 0x88, 0xdf, 0x75,
-	0x34, 0xc6, 0xce, 0xd5, 0x54, 0x14, 0x75, 0x95, 0x78, 0xdb, 0x5d, 0x48, 0x38, 0xbb, 0xaf, 0xfd,
-	0x8f, 0x2d, 0x38, 0x95, 0xb9, 0x14, 0x1e, 0xde, 0xfc, 0x35, 0xfb, 0x0b, 0x25, 0x78, 0x34, 0xe3,
-	0x5a, 0x2b, 0xd4, 0x31, 0x3f, 0x12, 0x2b, 0x8f, 0x50, 0xf0, 0x64, 0x64, 0xb3, 0x7c, 0x37, 0x19,
-	0x5f, 0xc6, 0xd1, 0x82, 0xd1, 0x74, 0x40, 0x58, 0xf1, 0xfe, 0x06, 0x84, 0x19, 0x6b, 0x7d, 0xe8,
-	0x81, 0xae, 0xf5, 0xd2, 0x21, 0x6b, 0xfd, 0x67, 0x2d, 0x98, 0x6e, 0xf6, 0xb8, 0xae, 0x56, 0x1c,
-	0xd2, 0xdf, 0x38, 0x9e, 0xcb, 0x70, 0x17, 0xce, 0xde, 0xdd, 0x9f, 0xe9, 0x79, 0x4b, 0x30, 0xee,
-	0x39, 0x2a, 0xfb, 0x2b, 0x45, 0x60, 0xdb, 0x41, 0x61, 0x88, 0x7d, 0xc4, 0xbc, 0x28, 0xcf, 0xca,
-	0xeb, 0x26, 0x37, 0x4e, 0x5c, 0x5d, 0xb4, 0xc7, 0x67, 0x30, 0xeb, 0xde, 0xbd, 0xb4, 0x24, 0x2c,
-	0xf4, 0x21, 0x09, 0x3d, 0x79, 0x23, 0x61, 0x31, 0xff, 0x1b, 0x09, 0x2b, 0xe9, 0xdb, 0x08, 0x0f,
-	0x7e, 0xc5, 0x43, 0x0f, 0xe5, 0x2b, 0xfe, 0xe7, 0x16, 0x17, 0x3c, 0xa9, 0xb7, 0x80, 0x66, 0xa4,
-	0xb9, 0xc1, 0x6f, 0x2d, 0xab, 0x74, 0x99, 0x1a, 0xcf, 0x40, 0x39, 0x12, 0x52, 0x59, 0x98, 0x24,
-	0xcc, 0xb8, 0x97, 0x92, 0x1a, 0x2b, 0x28, 0x9a, 0x05, 0x70, 0x3c, 0x2f, 0xb8, 0x7d, 0xb1, 0xd9,
-	0x8a, 0x3b, 0xd2, 0x30, 0xb9, 0xbb, 0x3f, 0x03, 0xf3, 0xaa, 0x15, 0x1b, 0x18, 0xe8, 0xad, 0x30,
-	0xc2, 0x4b, 0x53, 0xd5, 0x85, 0x9b, 0x7c, 0x94, 0x7e, 0x7c, 0xbc, 0x70, 0x55, 0x1d, 0x4b, 0x98,
-	0xfd, 0x05, 0x0b, 0x0c, 0x5f, 0x05, 0x7a, 0x41, 0x66, 0xc6, 0x72, 0xbf, 0x5d, 0xda, 0x15, 0x6d,
-	0x96, 0xd3, 0xc6, 0x09, 0xcc, 0x3e, 0x6e, 0x37, 0x67, 0x61, 0xbd, 0xad, 0xe0, 0x3a, 0x5e, 0x4d,
-	0xa7, 0x41, 0x61, 0xde, 0x8c, 0x25, 0xdc, 0xfe, 0xdb, 0x05, 0x31, 0x2a, 0xee, 0xa6, 0xd0, 0x71,
-	0xe6, 0xd6, 0x11, 0xe3, 0xcc, 0x3f, 0x0c, 0x50, 0x13, 0xfb, 0xea, 0xcd, 0x20, 0x1f, 0x6f, 0xcf,
-	0xa2, 0xa2, 0xa7, 0xbd, 0x3d, 0xba, 0x0d, 0x1b, 0xfc, 0x12, 0xc2, 0xbf, 0x78, 0xa8, 0xf0, 0x4f,
-	0xc8, 0xc1, 0xa1, 0x83, 0xe5, 0xa0, 0xfd, 0x67, 0x16, 0x24, 0xec, 0x42, 0xd4, 0x82, 0x12, 0x1d,
-	0x6e, 0x47, 0x88, 0x94, 0xf5, 0xfc, 0x8c, 0x50, 0x2a, 0xcb, 0xc5, 0x77, 0xca, 0x7e, 0x62, 0xce,
-	0x08, 0x79, 0x22, 0xa6, 0x3e, 0x17, 0xef, 0x8b, 0xc9, 0xf0, 0x72, 0x10, 0xec, 0xf2, 0x5d, 0x94,
-	0x8e, 0xcf, 0xb7, 0x5f, 0x80, 0xa9, 0xae, 0x41, 0x51, 0x53, 0x84, 0x55, 0xd5, 0x12, 0xdf, 0x97,
-	0x32, 0x45, 0x58, 0x3d, 0x29, 0xcc, 0x61, 0xf6, 0x4f, 0x5b, 0x70, 0x22, 0x4d, 0x1e, 0xbd, 0x6e,
-	0xc1, 0x54, 0x94, 0xa6, 0x77, 0x5c, 0x73, 0xa7, 0xf2, 0xec, 0xba, 0x40, 0xb8, 0x7b, 0x10, 0xf6,
-	0x97, 0x87, 0xf8, 0xe2, 0xbf, 0xe9, 0xfa, 0xf5, 0xe0, 0xb6, 0xb2, 0xa4, 0xac, 0x9e, 0x96, 0xd4,
-	0xb3, 0x50, 0x8e, 0x6a, 0x3b, 0xa4, 0xde, 0xf6, 0xba, 0xaa, 0x11, 0x55, 0x45, 0x3b, 0x56, 0x18,
-	0xac, 0xf8, 0x4a, 0x5b, 0x38, 0xce, 0x52, 0x8b, 0x72, 0x49, 0xb4, 0x63, 0x85, 0x81, 0xde, 0x05,
-	0x63, 0xc6, 0x43, 0xca, 0x75, 0xc9, 0xb6, 0x25, 0x86, 0x8e, 0x8f, 0x70, 0x02, 0x8b, 0x0a, 0x2b,
-	0x65, 0x95, 0x49, 0x9d, 0xce, 0x84, 0x95, 0x12, 0x9d, 0x11, 0x36, 0x30, 0x58, 0xa9, 0x23, 0xaf,
-	0x1d, 0xb1, 0x00, 0x9e, 0x61, 0xed, 0x7d, 0x59, 0x14, 0x6d, 0x58, 0x41, 0xd1, 0x05, 0x80, 0xa6,
-	0xe3, 0xb7, 0x1d, 0x8f, 0xce, 0x90, 0x38, 0x0d, 0x50, 0x9f, 0xe1, 0x9a, 0x82, 0x60, 0x03, 0x8b,
-	0x3e, 0x71, 0xec, 0x36, 0xc9, 0xcb, 0x81, 0x2f, 0x93, 0xa2, 0x74, 0x4c, 0x97, 0x68, 0xc7, 0x0a,
-	0x03, 0xbd, 0x00, 0xa3, 0x8e, 0x5f, 0xe7, 0x26, 0x64, 0x10, 0x8a, 0xd0, 0x10, 0xb5, 0x3f, 0xbd,
-	0x1e, 0x91, 0x79, 0x0d, 0xc5, 0x26, 0x6a, 0xfa, 0xa6, 0x33, 0xe8, 0xf3, 0xa6, 0xb3, 0xe7, 0x85,
-	0x42, 0xde, 0x23, 0x61, 0xd8, 0x96, 0x79, 0x1f, 0xaa, 0x5b, 0x55, 0x83, 0xb0, 0x89, 0x67, 0xff,
-	0xa9, 0x05, 0x93, 0xba, 0x94, 0x22, 0x3b, 0x6b, 0x48, 0x1c, 0xb2, 0x58, 0x87, 0x1e, 0xb2, 0x24,
-	0x2b, 0x5f, 0x15, 0xfa, 0xaa, 0x7c, 0x65, 0x16, 0xa5, 0x2a, 0x1e, 0x58, 0x94, 0xea, 0xad, 0x30,
-	0xb2, 0x4b, 0x3a, 0x46, 0xf5, 0x2a, 0xa6, 0x80, 0xae, 0xf2, 0x26, 0x2c, 0x61, 0xc8, 0x86, 0xe1,
-	0x9a, 0xa3, 0xca, 0x62, 0x8f, 0x89, 0x48, 0xe2, 0x79, 0x86, 0x24, 0x20, 0xf6, 0x3a, 0x54, 0x54,
-	0x08, 0x96, 0x3c, 0xa1, 0xb0, 0xb2, 0x4f, 0x28, 0xa8, 0x48, 0x30, 0xa2, 0xc9, 0xb4, 0x48, 0x60,
-	0x31, 0x68, 0x22, 0xb8, 0x6c, 0x61, 0xeb, 0x37, 0xbe, 0x7a, 0xee, 0x91, 0xdf, 0xfe, 0xea, 0xb9,
-	0x47, 0xfe, 0xe0, 0xab, 0xe7, 0x1e, 0xf9, 0xe8, 0xdd, 0x73, 0xd6, 0x6f, 0xdc, 0x3d, 0x67, 0xfd,
-	0xf6, 0xdd, 0x73, 0xd6, 0x1f, 0xdc, 0x3d, 0x67, 0x7d, 0xe5, 0xee, 0x39, 0xeb, 0xf3, 0xff, 0xf9,
-	0xdc, 0x23, 0x2f, 0x7f, 0xeb, 0x41, 0xd9, 0x62, 0x22, 0x3f, 0x8c, 0x8a, 0x81, 0x39, 0x63, 0xed,
-	0xcf, 0x49, 0x31, 0xf0, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6b, 0x59, 0xf7, 0x93, 0x0f, 0x12,
-	0x01, 0x00,
+	// 14758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x90, 0x24, 0xd9,
+	0x55, 0x1f, 0xac, 0xac, 0xea, 0xea, 0xc7, 0xed, 0x9e, 0xee, 0x99, 0xdc, 0x99, 0xdd, 0xda, 0xd9,
+	0xdd, 0x99, 0x51, 0x2e, 0x5a, 0x89, 0x0f, 0xa9, 0x07, 0xad, 0x5e, 0x8b, 0x00, 0xf1, 0xf5, 0x63,
+	0xa6, 0xa7, 0x77, 0xba, 0xa7, 0x7b, 0x4f, 0xf5, 0xce, 0xa0, 0xd5, 0xae, 0xa4, 0xec, 0xaa, 0xdb,
+	0xd5, 0xb9, 0x5d, 0x95, 0x59, 0x9b, 0x99, 0xd5, 0x33, 0xbd, 0x48, 0x62, 0x41, 0xe8, 0x43, 0x42,
+	0x02, 0xc4, 0xe3, 0xfb, 0x10, 0x1f, 0x16, 0x16, 0x06, 0x1c, 0xf8, 0x81, 0xc1, 0x76, 0x18, 0x08,
+	0x1b, 0x82, 0x30, 0x38, 0x78, 0x84, 0x1f, 0x60, 0x82, 0xb7, 0x61, 0x8c, 0xd6, 0x61, 0x43, 0x38,
+	0xc2, 0x38, 0xfc, 0x36, 0x1b, 0x04, 0x76, 0x9c, 0xfb, 0xbe, 0x99, 0x59, 0xdd, 0xd5, 0xd3, 0xd9,
+	0x33, 0x23, 0xbc, 0x7f, 0x75, 0xd7, 0x3d, 0xe7, 0xde, 0x73, 0xf3, 0xe6, 0xcd, 0x7b, 0xcf, 0x3d,
+	0xf7, 0x9c, 0xdf, 0x21, 0x2b, 0xed, 0x20, 0xdd, 0xee, 0x6f, 0xce, 0x36, 0xa3, 0xee, 0x45, 0x3f,
+	0x6e, 0x47, 0xbd, 0x38, 0x7a, 0x91, 0xfd, 0xf3, 0xb6, 0x66, 0xeb, 0xe2, 0xee, 0x3b, 0x2e, 0xf6,
+	0x76, 0xda, 0x17, 0xfd, 0x5e, 0x90, 0x5c, 0xf4, 0x7b, 0xbd, 0x4e, 0xd0, 0xf4, 0xd3, 0x20, 0x0a,
+	0x2f, 0xee, 0xbe, 0xdd, 0xef, 0xf4, 0xb6, 0xfd, 0xb7, 0x5f, 0x6c, 0xd3, 0x90, 0xc6, 0x7e, 0x4a,
+	0x5b, 0xb3, 0xbd, 0x38, 0x4a, 0x23, 0xf7, 0x6b, 0x74, 0x6b, 0xb3, 0xb2, 0x35, 0xf6, 0xcf, 0x87,
+	0x9a, 0xad, 0xd9, 0xdd, 0x77, 0xcc, 0xf6, 0x76, 0xda, 0xb3, 0xd8, 0xda, 0xac, 0xd1, 0xda, 0xac,
+	0x6c, 0xed, 0xec, 0xdb, 0x8c, 0xbe, 0xb4, 0xa3, 0x76, 0x74, 0x91, 0x35, 0xba, 0xd9, 0xdf, 0x62,
+	0xbf, 0xd8, 0x0f, 0xf6, 0x1f, 0x17, 0x76, 0xd6, 0xdb, 0x79, 0x2a, 0x99, 0x0d, 0x22, 0xec, 0xde,
+	0xc5, 0x66, 0x14, 0xd3, 0x8b, 0xbb, 0xb9, 0x0e, 0x9d, 0xbd, 0xa2, 0x79, 0xe8, 0xad, 0x94, 0x86,
+	0x49, 0x10, 0x85, 0xc9, 0xdb, 0xb0, 0x0b, 0x34, 0xde, 0xa5, 0xb1, 0xf9, 0x78, 0x06, 0x43, 0x51,
+	0x4b, 0xef, 0xd4, 0x2d, 0x75, 0xfd, 0xe6, 0x76, 0x10, 0xd2, 0x78, 0x4f, 0x57, 0xef, 0xd2, 0xd4,
+	0x2f, 0xaa, 0x75, 0x71, 0x50, 0xad, 0xb8, 0x1f, 0xa6, 0x41, 0x97, 0xe6, 0x2a, 0xbc, 0xfb, 0xa0,
+	0x0a, 0x49, 0x73, 0x9b, 0x76, 0xfd, 0x5c, 0xbd, 0x77, 0x0c, 0xaa, 0xd7, 0x4f, 0x83, 0xce, 0xc5,
+	0x20, 0x4c, 0x93, 0x34, 0xce, 0x56, 0xf2, 0xfe, 0x8a, 0x43, 0x4e, 0xcc, 0xdd, 0x68, 0xcc, 0xf5,
+	0xd3, 0xed, 0x85, 0x28, 0xdc, 0x0a, 0xda, 0xee, 0xbb, 0xc8, 0x64, 0xb3, 0xd3, 0x4f, 0x52, 0x1a,
+	0x5f, 0xf3, 0xbb, 0xb4, 0xee, 0x5c, 0x70, 0xde, 0x32, 0x31, 0xff, 0xc0, 0xaf, 0xdc, 0x3e, 0xff,
+	0x86, 0x57, 0x6f, 0x9f, 0x9f, 0x5c, 0xd0, 0x24, 0x30, 0xf9, 0xdc, 0x2f, 0x27, 0x63, 0x71, 0xd4,
+	0xa1, 0x73, 0x70, 0xad, 0x5e, 0x61, 0x55, 0x66, 0x44, 0x95, 0x31, 0xe0, 0xc5, 0x20, 0xe9, 0xc8,
+	0xda, 0x8b, 0xa3, 0xad, 0xa0, 0x43, 0xeb, 0x55, 0x9b, 0x75, 0x9d, 0x17, 0x83, 0xa4, 0x7b, 0x3f,
+	0x52, 0x21, 0x33, 0x73, 0xbd, 0xde, 0x15, 0xea, 0x77, 0xd2, 0xed, 0x46, 0xea, 0xa7, 0xfd, 0xc4,
+	0x8d, 0xc9, 0x68, 0xc2, 0xfe, 0x13, 0x7d, 0x7b, 0x4e, 0xd4, 0x1e, 0xe5, 0xf4, 0xd7, 0x6e, 0x9f,
+	0xbf, 0xb2, 0xdf, 0x8c, 0x6e, 0x07, 0x69, 0xd4, 0x4b, 0xde, 0x46, 0xc3, 0x76, 0x10, 0x52, 0x39,
+	0xbf, 0xb7, 0x99, 0x80, 0x59, 0x53, 0xce, 0x42, 0xd4, 0xa2, 0x20, 0x24, 0x61, 0x97, 0xbb, 0x34,
+	0x49, 0xfc, 0x36, 0xcd, 0x3e, 0xdd, 0x2a, 0x2f, 0x06, 0x49, 0x77, 0x63, 0xe2, 0x76, 0xfc, 0x24,
+	0xdd, 0x88, 0xfd, 0x30, 0x09, 0x70, 0x76, 0x6f, 0x04, 0x5d, 0xfe, 0xa0, 0x93, 0x4f, 0xfe, 0x5f,
+	0xb3, 0xfc, 0x1d, 0xcd, 0x9a, 0xef, 0x48, 0x7f, 0x12, 0x38, 0x85, 0x66, 0x77, 0xdf, 0x3e, 0x8b,
+	0x35, 0xe6, 0x1f, 0x7c, 0xf5, 0xf6, 0x79, 0x77, 0x25, 0xd7, 0x12, 0x14, 0xb4, 0xee, 0xfd, 0x76,
+	0x85, 0x90, 0xb9, 0x5e, 0x6f, 0x3d, 0x8e, 0x5e, 0xa4, 0xcd, 0xd4, 0xfd, 0x30, 0x19, 0xc7, 0xa6,
+	0x5a, 0x7e, 0xea, 0xb3, 0x31, 0x9a, 0x7c, 0xf2, 0x2b, 0x87, 0x13, 0xbc, 0xb6, 0x89, 0xf5, 0x57,
+	0x69, 0xea, 0xcf, 0xbb, 0xe2, 0x01, 0x89, 0x2e, 0x03, 0xd5, 0xaa, 0x1b, 0x92, 0x91, 0xa4, 0x47,
+	0x9b, 0x6c, 0x30, 0x26, 0x9f, 0x5c, 0x99, 0x3d, 0xca, 0x47, 0x3f, 0xab, 0x7b, 0xde, 0xe8, 0xd1,
+	0xe6, 0xfc, 0x94, 0x90, 0x3c, 0x82, 0xbf, 0x80, 0xc9, 0x71, 0x77, 0xd5, 0x3b, 0xe7, 0x03, 0x79,
+	0xad, 0x34, 0x89, 0xac, 0xd5, 0xf9, 0x69, 0x7b, 0x0e, 0xc9, 0xf7, 0xee, 0xfd, 0xa1, 0x43, 0xa6,
+	0x35, 0xf3, 0x4a, 0x90, 0xa4, 0xee, 0xf3, 0xb9, 0xc1, 0x9d, 0x1d, 0x6e, 0x70, 0xb1, 0x36, 0x1b,
+	0xda, 0x93, 0x42, 0xd8, 0xb8, 0x2c, 0x31, 0x06, 0xb6, 0x4b, 0x6a, 0x41, 0x4a, 0xbb, 0x49, 0xbd,
+	0x72, 0xa1, 0xfa, 0x96, 0xc9, 0x27, 0xaf, 0x94, 0xf5, 0x9c, 0xf3, 0x27, 0x84, 0xd0, 0xda, 0x32,
+	0x36, 0x0f, 0x5c, 0x8a, 0xf7, 0xcb, 0x0f, 0x98, 0xcf, 0x87, 0x03, 0xee, 0xbe, 0x9d, 0x4c, 0x26,
+	0x51, 0x3f, 0x6e, 0x52, 0xa0, 0xbd, 0x08, 0xbf, 0xb1, 0x2a, 0x4e, 0x77, 0xfc, 0xf6, 0x1b, 0xba,
+	0x18, 0x4c, 0x1e, 0xf7, 0x3b, 0x1c, 0x32, 0xd5, 0xa2, 0x49, 0x1a, 0x84, 0x4c, 0xbe, 0xec, 0xfc,
+	0xc6, 0x91, 0x3b, 0x2f, 0x0b, 0x17, 0x75, 0xe3, 0xf3, 0xa7, 0xc5, 0x83, 0x4c, 0x19, 0x85, 0x09,
+	0x58, 0xf2, 0x71, 0x0d, 0x6b, 0xd1, 0xa4, 0x19, 0x07, 0x3d, 0xfc, 0x5d, 0xaf, 0xda, 0x6b, 0xd8,
+	0xa2, 0x26, 0x81, 0xc9, 0xe7, 0x86, 0xa4, 0x86, 0x6b, 0x54, 0x52, 0x1f, 0x61, 0xfd, 0x5f, 0x3e,
+	0x5a, 0xff, 0xc5, 0xa0, 0xe2, 0xf2, 0xa7, 0x47, 0x1f, 0x7f, 0x25, 0xc0, 0xc5, 0xb8, 0xff, 0xd0,
+	0x21, 0x75, 0xb1, 0x86, 0x02, 0xe5, 0x03, 0x7a, 0x63, 0x3b, 0x48, 0x69, 0x27, 0x48, 0xd2, 0x7a,
+	0x8d, 0xf5, 0xe1, 0xf9, 0xa3, 0xf5, 0x61, 0xc1, 0x6e, 0x1d, 0x68, 0x92, 0xc6, 0x41, 0x13, 0x79,
+	0x70, 0x1a, 0xcc, 0x5f, 0x10, 0xdd, 0xaa, 0x2f, 0x0c, 0xe8, 0x05, 0x0c, 0xec, 0x9f, 0xfb, 0x0b,
+	0x0e, 0x39, 0x1b, 0xfa, 0x5d, 0x9a, 0xf4, 0xfc, 0x26, 0x95, 0xe4, 0xf9, 0x8e, 0xdf, 0xdc, 0x61,
+	0xdd, 0x1f, 0x65, 0xdd, 0xff, 0xe0, 0xd1, 0xba, 0x7f, 0x2d, 0xdb, 0x7e, 0xf6, 0x01, 0x3c, 0xf1,
+	0x00, 0x67, 0xaf, 0x0d, 0xec, 0x09, 0xec, 0xd3, 0x4b, 0xf7, 0x87, 0x1d, 0x72, 0x2a, 0x8a, 0x7b,
+	0xdb, 0x7e, 0x48, 0x5b, 0x92, 0x9a, 0xd4, 0xc7, 0x2e, 0x38, 0x47, 0xef, 0xfb, 0x5a, 0xb6, 0xd9,
+	0xd5, 0x28, 0x0c, 0xd2, 0x28, 0x6e, 0xd0, 0x34, 0x0d, 0xc2, 0x76, 0x32, 0x7f, 0xe6, 0xd5, 0xdb,
+	0xe7, 0x4f, 0xe5, 0xb8, 0x20, 0xdf, 0x1f, 0xf7, 0x1b, 0xc8, 0x64, 0xb2, 0x17, 0x36, 0x6f, 0x04,
+	0x61, 0x2b, 0xba, 0x99, 0xd4, 0xc7, 0xcb, 0x58, 0x1a, 0x1a, 0xaa, 0x41, 0xf1, 0x71, 0x6b, 0x01,
+	0x60, 0x4a, 0x2b, 0x7e, 0xcf, 0x7a, 0x9a, 0x4e, 0xdc, 0xe3, 0xf7, 0xac, 0xa7, 0xea, 0x3e, 0xbd,
+	0x74, 0xbf, 0xd5, 0x21, 0x27, 0x92, 0xa0, 0x1d, 0xfa, 0x69, 0x3f, 0xa6, 0x57, 0xe9, 0x5e, 0x52,
+	0x27, 0xac, 0xdf, 0x4f, 0x1f, 0x71, 0x10, 0x8d, 0x26, 0xe7, 0xcf, 0x88, 0x3e, 0x9e, 0x30, 0x4b,
+	0x13, 0xb0, 0xe5, 0x16, 0x7d, 0xf3, 0xfa, 0xa3, 0x99, 0xbc, 0x87, 0xdf, 0xbc, 0xfe, 0x60, 0x06,
+	0xf6, 0xcf, 0xfd, 0xbf, 0xc9, 0x49, 0x5e, 0xa4, 0x5e, 0x43, 0x52, 0x9f, 0x62, 0x1b, 0xc4, 0xe9,
+	0x57, 0x6f, 0x9f, 0x3f, 0xd9, 0xc8, 0xd0, 0x20, 0xc7, 0xed, 0xbe, 0x44, 0xce, 0xf7, 0x68, 0xdc,
+	0x0d, 0xd2, 0xb5, 0xb0, 0xb3, 0x27, 0xb7, 0x9d, 0x66, 0xd4, 0xa3, 0x2d, 0xd1, 0x9d, 0xa4, 0x7e,
+	0xe2, 0x82, 0xf3, 0x96, 0xf1, 0xf9, 0x37, 0x8b, 0x6e, 0x9e, 0x5f, 0xdf, 0x9f, 0x1d, 0x0e, 0x6a,
+	0xcf, 0xfd, 0x25, 0x87, 0x9c, 0x35, 0x76, 0x87, 0x06, 0x8d, 0x77, 0x83, 0x26, 0x9d, 0x6b, 0x36,
+	0xa3, 0x7e, 0x98, 0x26, 0xf5, 0x69, 0x36, 0xe6, 0x9b, 0xc7, 0xb1, 0x57, 0xd9, 0xa2, 0xf4, 0x24,
+	0x1e, 0xc8, 0x92, 0xc0, 0x3e, 0x3d, 0x75, 0x3f, 0xe3, 0x90, 0x19, 0x3e, 0xa0, 0xcb, 0x61, 0x4a,
+	0xdb, 0x71, 0x90, 0xee, 0xd5, 0x67, 0xd8, 0x52, 0xb5, 0x7a, 0xc4, 0x69, 0x6c, 0x37, 0x3a, 0xff,
+	0xc0, 0xab, 0xb7, 0xcf, 0xcf, 0x64, 0x0a, 0x21, 0x2b, 0xda, 0x7d, 0x96, 0x3c, 0xd4, 0x8b, 0xa3,
+	0x76, 0x4c, 0x93, 0x64, 0x91, 0xfa, 0xad, 0x4e, 0x10, 0xd2, 0x06, 0x6d, 0x46, 0x61, 0x2b, 0xa9,
+	0x9f, 0xbc, 0xe0, 0xbc, 0xa5, 0x3a, 0xff, 0xc8, 0xab, 0xb7, 0xcf, 0x3f, 0xb4, 0x5e, 0xcc, 0x02,
+	0x83, 0xea, 0xba, 0x11, 0x19, 0x7d, 0xa9, 0x1f, 0xa5, 0x7e, 0x52, 0x3f, 0xc5, 0x9e, 0xed, 0x6a,
+	0x29, 0xbb, 0xf0, 0x33, 0xac, 0xc9, 0x79, 0x82, 0x3a, 0x1e, 0xff, 0x1f, 0x84, 0x18, 0xf7, 0x07,
+	0x1c, 0x72, 0x4a, 0x2d, 0x1d, 0x1b, 0xb4, 0xdb, 0xeb, 0xf8, 0x29, 0xad, 0xbb, 0x4c, 0xf8, 0xf5,
+	0x52, 0x84, 0x5f, 0xcb, 0xb6, 0xce, 0xd7, 0xfe, 0x5c, 0x31, 0xe4, 0xfb, 0xe1, 0x3e, 0x41, 0x46,
+	0x7b, 0x7e, 0x4c, 0xc3, 0xb4, 0xfe, 0x00, 0xd3, 0x62, 0x94, 0xa6, 0xba, 0xce, 0x4a, 0x41, 0x50,
+	0xbd, 0x7f, 0x5c, 0x25, 0x27, 0xb3, 0x6a, 0xad, 0xfb, 0xd7, 0x1d, 0x32, 0xf3, 0xe2, 0xcd, 0x74,
+	0x23, 0xda, 0xa1, 0x61, 0x32, 0xbf, 0x87, 0xca, 0x07, 0x53, 0xe8, 0x26, 0x9f, 0x6c, 0x96, 0xab,
+	0x40, 0xcf, 0x3e, 0x6d, 0x4b, 0xb9, 0x14, 0xa6, 0xf1, 0xde, 0xfc, 0x43, 0xa2, 0xaf, 0x33, 0x4f,
+	0xdf, 0xd8, 0x30, 0xa9, 0x90, 0xed, 0x14, 0xea, 0xf7, 0xed, 0xd8, 0x0f, 0x53, 0xa9, 0x3a, 0x5e,
+	0x2b, 0x4d, 0xf5, 0x5a, 0xc2, 0x66, 0xf5, 0xa8, 0xb1, 0x9f, 0x09, 0x08, 0x69, 0x67, 0x3f, 0xed,
+	0x90, 0xd3, 0x45, 0x5d, 0x77, 0x4f, 0x92, 0xea, 0x0e, 0xdd, 0xe3, 0x27, 0x4c, 0xc0, 0x7f, 0xdd,
+	0x17, 0x48, 0x6d, 0xd7, 0xef, 0xf4, 0xa9, 0x38, 0xf3, 0x2c, 0x1d, 0xad, 0x87, 0x6a, 0x44, 0x80,
+	0xb7, 0xfa, 0xde, 0xca, 0x53, 0x8e, 0xf7, 0x6b, 0x55, 0x32, 0x69, 0xac, 0x24, 0x77, 0xe1, 0x1c,
+	0x17, 0x59, 0xe7, 0xb8, 0xd5, 0xd2, 0x16, 0xc1, 0x81, 0x07, 0xb9, 0x9b, 0x99, 0x83, 0xdc, 0x5a,
+	0x79, 0x22, 0xf7, 0x3d, 0xc9, 0xb9, 0x29, 0x99, 0x88, 0x7a, 0x34, 0x66, 0xac, 0xf5, 0x91, 0x32,
+	0x5e, 0xe1, 0x9a, 0x6c, 0x6e, 0xfe, 0xc4, 0xab, 0xb7, 0xcf, 0x4f, 0xa8, 0x9f, 0xa0, 0x05, 0x79,
+	0xbf, 0xe3, 0x90, 0xd3, 0x46, 0x1f, 0x17, 0xa2, 0xb0, 0xc5, 0x4e, 0xed, 0xee, 0x05, 0x32, 0x92,
+	0xee, 0xf5, 0xa4, 0x79, 0x45, 0x8d, 0xd4, 0xc6, 0x5e, 0x8f, 0x02, 0xa3, 0xdc, 0xef, 0x26, 0x87,
+	0x5f, 0x75, 0xc8, 0x19, 0x6b, 0xd7, 0xeb, 0xd1, 0xb0, 0x45, 0xc3, 0xe6, 0x1e, 0x3e, 0x5a, 0xe8,
+	0x77, 0x73, 0x8f, 0xc6, 0x4c, 0x46, 0x8c, 0xe2, 0x5e, 0x24, 0x13, 0x6a, 0xa1, 0x13, 0x0f, 0x77,
+	0x4a, 0xb0, 0x4d, 0x68, 0x05, 0x4f, 0xf3, 0xb8, 0x2f, 0x90, 0xf1, 0x84, 0x76, 0x68, 0x33, 0x8d,
+	0x62, 0xf1, 0x58, 0xef, 0x18, 0xf2, 0xcc, 0xed, 0x6f, 0xd2, 0x4e, 0x43, 0x54, 0x9d, 0x9f, 0xc2,
+	0x43, 0xb7, 0xfc, 0x05, 0xaa, 0x49, 0xef, 0x5b, 0x2b, 0xe4, 0xc1, 0xe2, 0x1d, 0x1c, 0x97, 0x5f,
+	0x6e, 0x27, 0x14, 0x8f, 0xa3, 0xa7, 0x17, 0x2b, 0x05, 0x41, 0x3d, 0xfc, 0x23, 0xc9, 0x51, 0xaa,
+	0x0e, 0x1c, 0xa5, 0x98, 0xcc, 0x08, 0x45, 0x4c, 0x76, 0xb9, 0x3e, 0x72, 0xe7, 0xcf, 0xce, 0xf6,
+	0xf4, 0x05, 0xbb, 0x3d, 0xc8, 0x0a, 0xf0, 0x7e, 0xd3, 0x21, 0x5f, 0x36, 0x8c, 0x2e, 0x73, 0x7c,
+	0xe3, 0xd2, 0x20, 0x67, 0x5a, 0x74, 0xcb, 0xef, 0x77, 0x52, 0x5b, 0xa2, 0x18, 0xa8, 0xc7, 0x44,
+	0xe5, 0x33, 0x8b, 0x45, 0x4c, 0x50, 0x5c, 0xd7, 0xfb, 0x31, 0x87, 0x9c, 0x34, 0x1e, 0xeb, 0x72,
+	0x87, 0xd2, 0xd4, 0xfd, 0x1e, 0x87, 0x4c, 0xc5, 0x51, 0xa7, 0x13, 0xf5, 0xd3, 0x46, 0x4a, 0x7b,
+	0x89, 0xd8, 0x19, 0xdf, 0x5f, 0xda, 0x8a, 0xc4, 0xc4, 0x80, 0x96, 0xa0, 0x4d, 0x17, 0x46, 0x61,
+	0x02, 0x56, 0x27, 0xbc, 0xcf, 0x55, 0xc9, 0x63, 0xd9, 0x36, 0xe4, 0x6b, 0xe3, 0x2b, 0xd9, 0xb0,
+	0x43, 0x2f, 0x67, 0x58, 0x65, 0xe0, 0x0c, 0x7b, 0x17, 0x99, 0x34, 0x9e, 0x21, 0x6b, 0x26, 0x31,
+	0x7a, 0x01, 0x26, 0x1f, 0x36, 0x9c, 0xa4, 0xb4, 0xc7, 0x66, 0x63, 0xd5, 0x58, 0xe5, 0x53, 0xda,
+	0x03, 0x46, 0x71, 0x9f, 0x24, 0x23, 0x78, 0x84, 0xac, 0xd7, 0x58, 0x8b, 0xe7, 0x14, 0xc7, 0x5e,
+	0xd8, 0x7c, 0xed, 0xf6, 0xf9, 0x69, 0xfc, 0x6b, 0x18, 0x59, 0x19, 0x2f, 0x9a, 0x75, 0xb9, 0x15,
+	0xb6, 0x3e, 0x6a, 0x9b, 0x75, 0xb9, 0x51, 0xb6, 0x5c, 0xb3, 0x2e, 0x27, 0xb8, 0x6f, 0x25, 0xe3,
+	0x31, 0xdd, 0x0d, 0x12, 0x7c, 0xfa, 0x31, 0x26, 0x55, 0xd9, 0xe6, 0x40, 0x94, 0x83, 0xe2, 0xf0,
+	0xfe, 0x5f, 0x87, 0x3c, 0xb2, 0xcf, 0xeb, 0x75, 0x77, 0xf3, 0x1f, 0xac, 0x73, 0xe7, 0x1f, 0xac,
+	0x52, 0x9e, 0x0e, 0xfc, 0x68, 0xff, 0xb5, 0x43, 0x66, 0x8c, 0x7e, 0xdd, 0x05, 0x2b, 0x65, 0x68,
+	0x5b, 0x29, 0x97, 0x4b, 0xfb, 0x64, 0x06, 0x98, 0x29, 0xbf, 0xdd, 0x21, 0x67, 0x0d, 0xae, 0x55,
+	0x3f, 0x6d, 0x6e, 0x5f, 0xba, 0xd5, 0xc3, 0xe3, 0x03, 0x4e, 0xc8, 0xc7, 0x0c, 0x65, 0x6d, 0x7e,
+	0x52, 0xb4, 0x50, 0xbd, 0x4a, 0xf7, 0xb8, 0xe6, 0xf6, 0x56, 0x32, 0xce, 0x77, 0xe4, 0x28, 0x16,
+	0x1f, 0x83, 0x7a, 0xb6, 0x35, 0x51, 0x0e, 0x8a, 0xc3, 0xf5, 0xc8, 0x28, 0xd3, 0xc8, 0x50, 0x43,
+	0xc1, 0x93, 0x2d, 0x3b, 0x32, 0x5c, 0x67, 0x25, 0x20, 0x28, 0x5e, 0x62, 0x75, 0x67, 0x3d, 0xa6,
+	0xec, 0x93, 0x6b, 0x5d, 0x0e, 0x68, 0xa7, 0x95, 0xa0, 0x05, 0xd5, 0x0f, 0xc3, 0x28, 0x15, 0xc6,
+	0x50, 0xc3, 0x82, 0x3a, 0xa7, 0x8b, 0xc1, 0xe4, 0x41, 0xa1, 0x1d, 0x7c, 0xfb, 0x7c, 0x44, 0x85,
+	0x50, 0x36, 0x1f, 0x12, 0x10, 0x14, 0xef, 0xd5, 0x0a, 0x99, 0x36, 0xa4, 0x36, 0xe8, 0xdd, 0x30,
+	0xf4, 0xc7, 0x96, 0x82, 0xb8, 0x5e, 0x9e, 0xb6, 0x46, 0x07, 0x1b, 0xfb, 0x5f, 0xce, 0xe8, 0x88,
+	0x50, 0xaa, 0xd4, 0xfd, 0x0d, 0xfe, 0x9f, 0xaf, 0x92, 0xf3, 0x76, 0x85, 0x9c, 0x8a, 0x99, 0x5d,
+	0x36, 0x9d, 0x21, 0x97, 0xcd, 0x62, 0x2d, 0xad, 0x72, 0x9c, 0x5a, 0x9a, 0xa9, 0x44, 0x56, 0x0f,
+	0x50, 0x22, 0x17, 0xd4, 0xa8, 0x8f, 0x30, 0xce, 0xaf, 0xc8, 0x5d, 0xab, 0x3d, 0x2c, 0xcf, 0xf2,
+	0xc1, 0x2e, 0xcd, 0x2c, 0xe1, 0xa2, 0xaa, 0xda, 0x1a, 0x6a, 0xf6, 0x9e, 0x63, 0x6c, 0x0d, 0x5f,
+	0x4b, 0x66, 0x52, 0x3f, 0x6e, 0xd3, 0x54, 0x2e, 0xab, 0x09, 0x33, 0x15, 0x4f, 0x70, 0x05, 0x65,
+	0x83, 0x91, 0xe4, 0xda, 0x9b, 0x40, 0x96, 0xd7, 0xfb, 0xf7, 0x15, 0xf2, 0x90, 0xfd, 0x7e, 0xb4,
+	0x4e, 0xfd, 0x75, 0x96, 0x4e, 0xfd, 0x15, 0xa6, 0x4e, 0xfd, 0xda, 0xed, 0xf3, 0x8f, 0x0c, 0xa8,
+	0xf6, 0x25, 0xa3, 0x72, 0xbb, 0x4b, 0x99, 0x37, 0x74, 0x31, 0xf7, 0x86, 0x1e, 0x1b, 0xf0, 0x8c,
+	0x99, 0xb3, 0xd0, 0x13, 0x64, 0x34, 0xa6, 0x7e, 0x12, 0x85, 0xe2, 0x3d, 0xa9, 0x8f, 0x01, 0x58,
+	0x29, 0x08, 0xaa, 0xf7, 0x1b, 0x13, 0xd9, 0xc1, 0x5e, 0xe2, 0xd7, 0xc7, 0x51, 0xec, 0x06, 0x64,
+	0x84, 0x99, 0x2c, 0x9d, 0x32, 0x8c, 0x34, 0xb8, 0xc5, 0xa8, 0xa6, 0xe7, 0xc7, 0xf1, 0xad, 0x61,
+	0x11, 0x30, 0x11, 0xee, 0x2d, 0x32, 0xde, 0x94, 0xc6, 0xc1, 0x4a, 0x19, 0xd7, 0x7f, 0x62, 0x47,
+	0xd5, 0x12, 0xd9, 0xc1, 0x40, 0x59, 0x14, 0x95, 0x34, 0x97, 0x92, 0x6a, 0x3b, 0x48, 0xc5, 0x6b,
+	0x3d, 0xa2, 0xad, 0x78, 0x29, 0x30, 0x1e, 0x71, 0x0c, 0x37, 0xa8, 0xa5, 0x20, 0x05, 0x6c, 0xdf,
+	0xfd, 0x84, 0x43, 0x26, 0x93, 0x66, 0x77, 0x3d, 0x8e, 0x76, 0x83, 0x16, 0x95, 0x6a, 0xfe, 0x11,
+	0x97, 0xbd, 0xc6, 0xc2, 0xaa, 0x6c, 0x50, 0xcb, 0xe5, 0xa6, 0x7e, 0x4d, 0x01, 0x53, 0x2e, 0x9a,
+	0x8b, 0x1e, 0x12, 0xcf, 0xbe, 0x48, 0x9b, 0x5c, 0xfd, 0x11, 0x36, 0xe0, 0x7a, 0xad, 0x8c, 0xe3,
+	0xfa, 0x62, 0xbf, 0xb9, 0x83, 0xdf, 0x9b, 0xee, 0x10, 0xb3, 0x11, 0x2e, 0x14, 0xcb, 0x84, 0x41,
+	0x9d, 0x61, 0x03, 0xd6, 0xeb, 0x77, 0x3a, 0x40, 0x5f, 0xea, 0x53, 0x76, 0xd9, 0x54, 0xc2, 0x80,
+	0xad, 0xeb, 0x06, 0x33, 0x03, 0x66, 0x50, 0xc0, 0x94, 0xeb, 0xbe, 0x44, 0x46, 0xbb, 0x7e, 0x1a,
+	0x07, 0xb7, 0xea, 0x63, 0x65, 0x18, 0x50, 0x56, 0x59, 0x5b, 0x5a, 0x38, 0xd3, 0x02, 0x78, 0x21,
+	0x08, 0x41, 0x78, 0x41, 0xdc, 0xa5, 0x71, 0x9b, 0xd6, 0xc7, 0xcb, 0xb8, 0x7a, 0x5f, 0xc5, 0xa6,
+	0xb4, 0xc0, 0x09, 0xd4, 0xbc, 0x58, 0x19, 0x70, 0x29, 0xd6, 0xc9, 0x7b, 0xa2, 0xf4, 0x93, 0x37,
+	0x0e, 0x60, 0xaf, 0xd3, 0x6f, 0x07, 0x61, 0x9d, 0x94, 0x31, 0x80, 0xeb, 0xac, 0xad, 0xcc, 0x00,
+	0xf2, 0x42, 0x10, 0x82, 0xbc, 0xdf, 0xb7, 0x0f, 0x58, 0xe6, 0xa2, 0x26, 0xf6, 0xf7, 0xc7, 0x49,
+	0x2d, 0x08, 0x5b, 0xf4, 0x16, 0x5b, 0xdb, 0xaa, 0x86, 0x4a, 0x8a, 0x85, 0xc0, 0x69, 0xca, 0x80,
+	0x53, 0x19, 0x68, 0xc0, 0x79, 0x17, 0x99, 0xec, 0xf9, 0xb1, 0xdf, 0x4d, 0x16, 0xd4, 0xf9, 0xb5,
+	0xaa, 0xd5, 0x84, 0x75, 0x4d, 0x02, 0x93, 0x0f, 0x55, 0x41, 0xfe, 0xb3, 0x3e, 0xa2, 0x55, 0x41,
+	0xce, 0x0d, 0x82, 0xe2, 0xce, 0x91, 0x19, 0xfe, 0xdf, 0x46, 0xdc, 0x0f, 0x9b, 0x7e, 0x4a, 0x5b,
+	0xec, 0xfb, 0x1c, 0xd7, 0x87, 0x86, 0x75, 0x9b, 0x0c, 0x59, 0x7e, 0x7c, 0x48, 0x1a, 0xc7, 0x51,
+	0x2c, 0x4e, 0x5b, 0xea, 0x21, 0x2f, 0x61, 0x21, 0x70, 0x9a, 0xbb, 0x4e, 0x4e, 0xb7, 0xfa, 0xdc,
+	0x94, 0xb5, 0x1a, 0x74, 0x3a, 0x41, 0x22, 0xec, 0xfb, 0x63, 0xec, 0x59, 0x1e, 0x15, 0x75, 0x4e,
+	0x2f, 0x16, 0xf0, 0x40, 0x61, 0x4d, 0xf7, 0x05, 0x32, 0xa9, 0x5c, 0x90, 0xe6, 0xd2, 0xfa, 0xf8,
+	0xa1, 0x37, 0x4c, 0xf6, 0x41, 0x2e, 0xe9, 0x26, 0xc0, 0x6c, 0xcf, 0xfb, 0xb7, 0x0e, 0x71, 0xed,
+	0x97, 0x7b, 0x17, 0x4e, 0x43, 0x2f, 0xd9, 0xa7, 0xa1, 0x95, 0x32, 0xd5, 0xd5, 0x01, 0x07, 0xa2,
+	0x7f, 0x34, 0x91, 0x9d, 0xc4, 0xd7, 0x68, 0x92, 0xd2, 0xd6, 0xeb, 0xfb, 0xf3, 0xeb, 0xfb, 0xf3,
+	0xeb, 0xfb, 0xb3, 0xfc, 0xe1, 0x6e, 0x66, 0xf6, 0xe7, 0xf7, 0x19, 0x5f, 0xbd, 0xf6, 0xf5, 0xfc,
+	0x90, 0x72, 0x06, 0x35, 0x7b, 0x60, 0x30, 0xe0, 0x4a, 0xf0, 0x74, 0x63, 0xed, 0x5a, 0xe1, 0x86,
+	0xfc, 0x21, 0x7b, 0x43, 0x3e, 0xaa, 0x88, 0xff, 0x13, 0xb6, 0xe0, 0x5f, 0x72, 0xc8, 0x9b, 0xed,
+	0xd5, 0x4b, 0xce, 0x9c, 0xe5, 0x76, 0x18, 0xc5, 0x74, 0x31, 0xd8, 0xda, 0xa2, 0x31, 0x0d, 0xd1,
+	0x61, 0xe0, 0xe0, 0xdb, 0x84, 0x77, 0x92, 0xa9, 0x17, 0x93, 0x28, 0x5c, 0x8f, 0x82, 0x50, 0x2c,
+	0x41, 0xb8, 0x6d, 0x9e, 0x44, 0x3b, 0x2b, 0x8e, 0xa8, 0x2c, 0x07, 0x8b, 0xcb, 0x5d, 0x20, 0xa7,
+	0x5e, 0x7c, 0x69, 0xdd, 0x4f, 0x0d, 0x3b, 0x92, 0xb4, 0xf8, 0xb0, 0xcb, 0xd9, 0xa7, 0x9f, 0xc9,
+	0x10, 0x21, 0xcf, 0xef, 0xfd, 0x40, 0x85, 0x3c, 0x9c, 0x79, 0x10, 0xc3, 0x1e, 0xf8, 0x83, 0x0e,
+	0x39, 0xd9, 0xb5, 0x4d, 0x55, 0xd2, 0xc8, 0xfc, 0xf5, 0xa5, 0xed, 0x11, 0x19, 0x5b, 0xd8, 0x7c,
+	0x5d, 0x8c, 0xd0, 0xc9, 0x0c, 0x21, 0x81, 0x5c, 0x5f, 0xdc, 0x17, 0xc8, 0x44, 0xd7, 0xbf, 0xf5,
+	0x6c, 0xaf, 0x85, 0x17, 0xde, 0x95, 0x03, 0xec, 0x47, 0xfd, 0x34, 0xe8, 0xcc, 0x72, 0x2f, 0xe2,
+	0xd9, 0xe5, 0x30, 0x5d, 0x8b, 0x1b, 0x69, 0x1c, 0x84, 0x6d, 0x7e, 0xf9, 0xb5, 0x2a, 0x9b, 0x01,
+	0xdd, 0xa2, 0xf7, 0x79, 0x87, 0x3c, 0x36, 0x60, 0x74, 0x70, 0xbf, 0x6e, 0xef, 0xb9, 0x1f, 0x21,
+	0xb5, 0xc4, 0x30, 0xbd, 0xdf, 0x28, 0x73, 0xe7, 0x34, 0x0d, 0xef, 0x6a, 0x13, 0xe5, 0x16, 0x77,
+	0x2e, 0xd4, 0xfb, 0xc1, 0x89, 0xac, 0xb2, 0xc0, 0x1c, 0x20, 0x9f, 0x24, 0xa4, 0x1d, 0x29, 0x3f,
+	0x00, 0x87, 0xe9, 0x55, 0xca, 0x48, 0xb6, 0xa4, 0x28, 0x60, 0x70, 0xb9, 0x9f, 0x72, 0x08, 0x69,
+	0xcb, 0x39, 0x2f, 0x15, 0x81, 0x67, 0xcb, 0x7c, 0x1c, 0xfd, 0x45, 0xe9, 0xbe, 0x28, 0x81, 0x60,
+	0x08, 0x77, 0xbf, 0xd9, 0x21, 0xe3, 0xa9, 0xec, 0x3e, 0xdf, 0x1a, 0x37, 0xca, 0xec, 0x89, 0x72,
+	0x62, 0x50, 0x3a, 0x91, 0x1a, 0x12, 0x25, 0xd7, 0xfd, 0x7f, 0x1c, 0x42, 0xd0, 0xac, 0xbf, 0x1e,
+	0x75, 0x82, 0xe6, 0x5e, 0x7d, 0xa4, 0x0c, 0x6f, 0x8a, 0xcc, 0xbb, 0x52, 0xad, 0xcf, 0x4f, 0xe3,
+	0x68, 0xe8, 0xdf, 0x60, 0x48, 0x76, 0x3f, 0x46, 0xc6, 0x13, 0x31, 0xdd, 0xea, 0xb5, 0xf2, 0x07,
+	0x43, 0x4e, 0x65, 0xb1, 0xbc, 0x8a, 0x5f, 0xa0, 0x64, 0xba, 0xdf, 0xe7, 0x90, 0x99, 0x9e, 0x6d,
+	0x20, 0x16, 0xdb, 0x61, 0x79, 0x6b, 0x40, 0xc6, 0x00, 0xcd, 0x4d, 0x69, 0x99, 0x42, 0xc8, 0xf6,
+	0x02, 0x57, 0x40, 0x3d, 0x83, 0xd7, 0x7a, 0xdc, 0x58, 0x3d, 0xa6, 0x57, 0xc0, 0xa5, 0x2c, 0x11,
+	0xf2, 0xfc, 0x78, 0x42, 0xc0, 0xde, 0xed, 0x71, 0xf5, 0x53, 0x6e, 0x2f, 0x09, 0xdb, 0x0c, 0xc7,
+	0xf5, 0x09, 0x61, 0xae, 0x80, 0x07, 0x0a, 0x6b, 0xba, 0xbf, 0xe6, 0x90, 0x47, 0x03, 0xb6, 0x0d,
+	0x98, 0x17, 0x91, 0x7a, 0x47, 0x10, 0x1e, 0x87, 0xb4, 0xd4, 0xb5, 0x62, 0xd0, 0xf6, 0x33, 0xff,
+	0x65, 0xe2, 0x09, 0x1e, 0x5d, 0xde, 0xa7, 0x4b, 0xb0, 0x6f, 0x87, 0xdd, 0xf7, 0x90, 0x13, 0xf2,
+	0xbb, 0x58, 0xc7, 0x25, 0x98, 0x6d, 0xb4, 0x13, 0xf3, 0xa7, 0xd0, 0x57, 0x70, 0xc3, 0x24, 0x80,
+	0xcd, 0xe7, 0x7d, 0x76, 0x94, 0x9c, 0xce, 0x4e, 0x37, 0x76, 0x42, 0xc5, 0xe5, 0xa6, 0x29, 0x8d,
+	0x7b, 0x72, 0xf5, 0x2c, 0x75, 0xb9, 0x51, 0xa6, 0x43, 0xbd, 0xdc, 0xa8, 0xa2, 0x04, 0x0c, 0xe1,
+	0xa8, 0x94, 0x9e, 0xf2, 0xb3, 0x36, 0x72, 0xb1, 0x02, 0xbe, 0x50, 0x66, 0x97, 0xf2, 0xbe, 0x1e,
+	0x0f, 0x8b, 0xae, 0x9d, 0xca, 0x91, 0x20, 0xdf, 0x25, 0xf7, 0xa3, 0x64, 0x22, 0x56, 0x2e, 0xbe,
+	0xd5, 0x32, 0x8e, 0x6a, 0x72, 0xda, 0x88, 0xee, 0xa8, 0x8b, 0x6d, 0xed, 0xcc, 0xab, 0x25, 0xba,
+	0xef, 0x23, 0xd3, 0xea, 0x07, 0xb7, 0x08, 0xf0, 0xfb, 0xd3, 0x07, 0x45, 0xad, 0x69, 0xb0, 0xa8,
+	0x90, 0xe1, 0x36, 0xee, 0x47, 0x6b, 0x65, 0x1c, 0x77, 0xcc, 0x3b, 0x51, 0x6d, 0x00, 0xe6, 0xa5,
+	0xea, 0x7e, 0xf4, 0x3b, 0xed, 0x6d, 0x8d, 0xfb, 0x74, 0x7f, 0xe0, 0x58, 0xb6, 0x35, 0xd1, 0x93,
+	0x03, 0x36, 0x37, 0xef, 0x93, 0xb6, 0xab, 0x86, 0xb1, 0x02, 0x0f, 0xe1, 0x52, 0xf3, 0x1d, 0x0e,
+	0x99, 0xc4, 0xcb, 0xf6, 0x20, 0x6c, 0xe3, 0x6e, 0x21, 0x54, 0x9e, 0x0f, 0x1c, 0x8b, 0xd6, 0x21,
+	0xb6, 0x05, 0x76, 0x3e, 0x01, 0x2d, 0x13, 0xcc, 0x0e, 0xb8, 0x5f, 0x4d, 0x4e, 0xb4, 0x68, 0x87,
+	0x62, 0xdd, 0xb5, 0x18, 0x4f, 0x96, 0xfc, 0x92, 0x46, 0x79, 0x12, 0x2f, 0x9a, 0x44, 0xb0, 0x79,
+	0x31, 0x36, 0xa5, 0x3e, 0x68, 0x4b, 0x74, 0x29, 0x79, 0x44, 0xae, 0xf7, 0x6a, 0x5e, 0xad, 0x85,
+	0xb2, 0x3d, 0xa1, 0xd5, 0x3c, 0x2e, 0xe4, 0x3c, 0xb2, 0x3e, 0x98, 0x15, 0xf6, 0x6b, 0xc7, 0x7d,
+	0x8e, 0x9c, 0x34, 0x06, 0x25, 0x51, 0xa3, 0x3a, 0x31, 0x3f, 0x8b, 0x3a, 0xe8, 0x5c, 0x86, 0xf6,
+	0xda, 0xed, 0xf3, 0x0f, 0x66, 0xcb, 0xc4, 0x9e, 0x9d, 0x6b, 0x07, 0x63, 0xbf, 0x1e, 0x2c, 0xd6,
+	0x3c, 0xdc, 0xcf, 0x39, 0x39, 0x83, 0xce, 0xd7, 0x1f, 0x87, 0x8a, 0xc3, 0x4c, 0x3f, 0xca, 0x6d,
+	0x77, 0x30, 0xcf, 0x3d, 0xf4, 0xa8, 0xf3, 0xfe, 0xd9, 0x08, 0xd9, 0xa7, 0x67, 0xc7, 0xe1, 0x8d,
+	0xf5, 0x19, 0x47, 0xdd, 0x56, 0xf3, 0x65, 0xb4, 0x75, 0x5c, 0x63, 0xcf, 0x8f, 0xb0, 0x09, 0xf7,
+	0x26, 0x55, 0x8b, 0x94, 0x7d, 0x2f, 0xee, 0x7e, 0xc1, 0xb1, 0xef, 0xdb, 0x79, 0xf0, 0x4e, 0x70,
+	0x6c, 0x7d, 0x32, 0x2e, 0xf1, 0x79, 0xc7, 0xf4, 0xd5, 0xef, 0xa0, 0xeb, 0xfd, 0x59, 0x42, 0xb6,
+	0x82, 0xd0, 0xef, 0x04, 0x2f, 0xe3, 0x01, 0xb5, 0xc6, 0x74, 0x2c, 0xa6, 0xb4, 0x5e, 0x56, 0xa5,
+	0x60, 0x70, 0x9c, 0xfd, 0x2a, 0x32, 0x69, 0x3c, 0x79, 0x81, 0x33, 0xea, 0x69, 0xd3, 0x19, 0x75,
+	0xc2, 0xf0, 0x21, 0x3d, 0xfb, 0x3e, 0x72, 0x32, 0xdb, 0xc1, 0xc3, 0xd4, 0xf7, 0xfe, 0xe7, 0x58,
+	0xf6, 0x02, 0x7c, 0x83, 0xc6, 0x5d, 0xec, 0xda, 0xeb, 0xb6, 0xc5, 0xd7, 0x6d, 0x8b, 0xaf, 0xdb,
+	0x16, 0xcd, 0xbb, 0x3f, 0x61, 0x37, 0x1b, 0xbb, 0x4b, 0x76, 0x33, 0xcb, 0x12, 0x38, 0x5e, 0xbe,
+	0x1b, 0xec, 0x27, 0x72, 0x97, 0x27, 0x1b, 0x31, 0xa5, 0x6e, 0x44, 0x6a, 0x61, 0xd4, 0xa2, 0xf2,
+	0x98, 0xf1, 0x74, 0x39, 0x3a, 0xf3, 0xb5, 0xa8, 0x65, 0x84, 0x45, 0xe2, 0xaf, 0x04, 0xb8, 0x1c,
+	0xef, 0xbf, 0xe7, 0x14, 0x9b, 0x1b, 0xcc, 0x72, 0xb5, 0x4b, 0xc3, 0xd4, 0xbd, 0x6a, 0x69, 0x79,
+	0xef, 0xc9, 0x38, 0x79, 0xbc, 0x79, 0x50, 0x0c, 0xfc, 0x4d, 0x6c, 0x61, 0x96, 0x35, 0x61, 0x28,
+	0x84, 0x9f, 0x71, 0xc8, 0xb4, 0x6f, 0x49, 0x2a, 0x2d, 0xa2, 0xd9, 0xbc, 0xc3, 0x51, 0x2a, 0xbe,
+	0x5d, 0x0e, 0x19, 0xd9, 0xde, 0x3f, 0x18, 0x25, 0xd6, 0x51, 0x86, 0x4f, 0x78, 0x8c, 0xac, 0xa7,
+	0xbd, 0xe8, 0x59, 0x58, 0xa9, 0x3b, 0xb6, 0x57, 0x0a, 0xf0, 0x62, 0x90, 0x74, 0xdc, 0xec, 0x7b,
+	0x7e, 0xba, 0x9d, 0xbd, 0x94, 0x44, 0xb3, 0x25, 0x30, 0x0a, 0x9e, 0x42, 0x52, 0xcb, 0xc7, 0x46,
+	0xf8, 0x92, 0xa8, 0x2e, 0xda, 0x1e, 0x38, 0x90, 0xe1, 0x76, 0x5f, 0x22, 0x23, 0xdb, 0xb4, 0xd3,
+	0x15, 0x73, 0xbe, 0x51, 0xde, 0x30, 0xb1, 0x67, 0xbd, 0x42, 0x3b, 0x5d, 0xbe, 0x05, 0xe0, 0x7f,
+	0xc0, 0x44, 0xe1, 0x07, 0x3f, 0xb1, 0xd3, 0x4f, 0xd2, 0xa8, 0x1b, 0xbc, 0x2c, 0xad, 0xec, 0x5f,
+	0x5f, 0xb2, 0xe0, 0xab, 0xb2, 0x7d, 0x6e, 0xce, 0x54, 0x3f, 0x41, 0x4b, 0x66, 0xfd, 0x68, 0x05,
+	0x31, 0xfb, 0x56, 0xf6, 0xea, 0xe4, 0x58, 0xfa, 0xb1, 0x28, 0xdb, 0xe7, 0xfd, 0x50, 0x3f, 0x41,
+	0x4b, 0x76, 0xf7, 0xd4, 0xc2, 0x33, 0x79, 0xc1, 0x29, 0xf7, 0xdc, 0xcf, 0xfa, 0xc0, 0x17, 0x9d,
+	0xc2, 0x05, 0xe8, 0x71, 0x52, 0x6b, 0x6e, 0xfb, 0x71, 0x5a, 0x9f, 0xb2, 0x2f, 0x8d, 0x17, 0xb0,
+	0x10, 0x38, 0x0d, 0xbd, 0x31, 0x63, 0xba, 0x55, 0x3f, 0x61, 0x7b, 0x63, 0x02, 0xdd, 0x02, 0x2c,
+	0x57, 0x0a, 0xe9, 0xf4, 0x7e, 0x0a, 0x69, 0xea, 0xb7, 0xd7, 0x63, 0xba, 0x15, 0xdc, 0xaa, 0xcf,
+	0xd8, 0x0a, 0xe9, 0x86, 0x24, 0x80, 0xe6, 0xf1, 0xfe, 0xac, 0x42, 0xce, 0xe6, 0x1e, 0x43, 0x8d,
+	0x1d, 0xff, 0x80, 0x9a, 0xfd, 0x38, 0x91, 0xd6, 0x5c, 0xe3, 0x03, 0x62, 0xc5, 0x20, 0xe9, 0xee,
+	0x37, 0x39, 0x64, 0x0c, 0xaf, 0x09, 0x42, 0xb5, 0x12, 0x5c, 0x2f, 0x79, 0x74, 0x9f, 0xe6, 0xad,
+	0xeb, 0x3e, 0x88, 0x02, 0x90, 0x72, 0xb1, 0xbb, 0xf4, 0x56, 0xb3, 0xd3, 0x6f, 0xe5, 0x7c, 0xf6,
+	0x2e, 0xf1, 0x62, 0x90, 0x74, 0x64, 0x0d, 0x42, 0xce, 0x3a, 0x62, 0xb3, 0x2e, 0x87, 0x82, 0x55,
+	0xd0, 0xdd, 0xeb, 0xe4, 0xc1, 0x56, 0x90, 0xf8, 0x9b, 0x1d, 0x7a, 0x49, 0x5e, 0x3a, 0x5d, 0x0e,
+	0x3a, 0x29, 0x8d, 0x85, 0xe7, 0x80, 0x74, 0xd2, 0x7e, 0x70, 0xb1, 0x90, 0x0b, 0x06, 0xd4, 0xf6,
+	0x7e, 0x6e, 0x9c, 0x9c, 0xc9, 0x3d, 0x24, 0x7e, 0xbd, 0xa8, 0xf4, 0x32, 0xb5, 0xf2, 0x72, 0xd0,
+	0xa1, 0xd2, 0x0b, 0x96, 0x29, 0xbd, 0xd7, 0x55, 0x29, 0x18, 0x1c, 0xee, 0x37, 0x12, 0xc2, 0x9c,
+	0x14, 0xa8, 0xba, 0xc5, 0x39, 0xb2, 0x6e, 0x89, 0xfd, 0x58, 0x97, 0x6d, 0x6a, 0xdb, 0x82, 0x2a,
+	0x4a, 0xc0, 0x10, 0x89, 0x0e, 0x1b, 0x31, 0xed, 0x50, 0x3f, 0x61, 0x01, 0xab, 0x59, 0x77, 0x78,
+	0xd0, 0x24, 0x30, 0xf9, 0xd0, 0x9b, 0x4e, 0x38, 0x0c, 0x8f, 0xd8, 0xde, 0x74, 0xb6, 0xd3, 0x30,
+	0x1a, 0x53, 0xa6, 0x11, 0xd4, 0x44, 0x4b, 0x17, 0x31, 0xfe, 0x6b, 0x47, 0x7f, 0xc8, 0xcb, 0x66,
+	0xbb, 0x7a, 0x31, 0xb7, 0x8a, 0x13, 0xc8, 0x88, 0xc7, 0xe9, 0xb3, 0x4b, 0x63, 0xb6, 0x0b, 0x8c,
+	0xda, 0xd3, 0xe7, 0x3a, 0x2f, 0x06, 0x49, 0xe7, 0x1e, 0x27, 0x49, 0xb2, 0x10, 0xd3, 0x16, 0x0d,
+	0xd3, 0xc0, 0xef, 0x70, 0x27, 0x10, 0xcb, 0xe3, 0xc4, 0x22, 0x43, 0x96, 0xdf, 0x7d, 0x3f, 0x79,
+	0x88, 0x9b, 0x49, 0x57, 0x83, 0x24, 0x09, 0xc2, 0xb6, 0x9e, 0x06, 0xc2, 0x5a, 0x7c, 0x5e, 0x34,
+	0xf5, 0xd0, 0x72, 0x31, 0x1b, 0x0c, 0xaa, 0x8f, 0x1e, 0xde, 0xc9, 0x4e, 0xd0, 0x5b, 0x88, 0x5b,
+	0x09, 0xbb, 0x22, 0x1d, 0xd7, 0x77, 0x13, 0x0d, 0x51, 0x0e, 0x8a, 0xc3, 0x6d, 0x92, 0x29, 0xfe,
+	0x4a, 0xb8, 0xc7, 0xb3, 0x58, 0xca, 0xdf, 0x36, 0x50, 0x95, 0x12, 0xb8, 0x3b, 0xb3, 0xe0, 0xdf,
+	0x54, 0xb3, 0x9f, 0xdf, 0x2f, 0x5e, 0x37, 0x9a, 0x01, 0xab, 0x51, 0xfb, 0x54, 0x3d, 0x39, 0xc4,
+	0xa9, 0xfa, 0x5d, 0x64, 0x72, 0xa7, 0xbf, 0x49, 0xc5, 0xc8, 0xd7, 0xa7, 0xec, 0xd9, 0x77, 0x55,
+	0x93, 0xc0, 0xe4, 0x63, 0xce, 0xe6, 0xbd, 0x40, 0xfc, 0xc2, 0xe0, 0x69, 0xed, 0x6c, 0xbe, 0xbe,
+	0x2c, 0x8b, 0xc1, 0xe4, 0xc1, 0xae, 0xe1, 0x58, 0x6c, 0xd0, 0x84, 0x85, 0x3f, 0xe3, 0x70, 0xa9,
+	0xae, 0x35, 0x24, 0x01, 0x34, 0x0f, 0x1a, 0xf9, 0xf1, 0x47, 0x83, 0xe1, 0x0e, 0x5d, 0xf7, 0x3b,
+	0x41, 0x8b, 0x7b, 0x3e, 0xcf, 0xd8, 0x46, 0xfe, 0x46, 0x01, 0x0f, 0x14, 0xd6, 0x7c, 0xef, 0xf8,
+	0xe7, 0xbe, 0x70, 0xfe, 0x0d, 0xaf, 0xfc, 0xc1, 0x85, 0x37, 0x78, 0xdf, 0x5f, 0x21, 0xf5, 0xdc,
+	0xfa, 0x21, 0xd6, 0x44, 0x37, 0xc1, 0xa5, 0x30, 0xbd, 0xee, 0xc7, 0x52, 0xf9, 0x3c, 0x22, 0xe8,
+	0x81, 0x68, 0xf7, 0xba, 0x1f, 0x9b, 0x8b, 0x2a, 0x13, 0x00, 0x52, 0x92, 0xfb, 0x22, 0x19, 0x49,
+	0x3b, 0x7e, 0x49, 0x08, 0x2c, 0x86, 0x44, 0x6d, 0x91, 0x5c, 0x99, 0x4b, 0x80, 0xc9, 0x70, 0x1f,
+	0xc5, 0x93, 0xf4, 0xa6, 0xbc, 0x78, 0x16, 0x87, 0xdf, 0xcd, 0x04, 0x58, 0xa9, 0xf7, 0xbd, 0x27,
+	0x0a, 0xf6, 0x35, 0xa5, 0x9b, 0xe0, 0x45, 0x25, 0x4e, 0x1f, 0xb1, 0x51, 0x72, 0xdd, 0x50, 0xad,
+	0x71, 0xd7, 0x14, 0x05, 0x0c, 0x2e, 0x59, 0xa7, 0xd1, 0xdf, 0xc2, 0x3a, 0x95, 0x7c, 0x1d, 0x4e,
+	0x01, 0x83, 0xcb, 0x7d, 0x27, 0x19, 0x0d, 0xba, 0x7e, 0x5b, 0x45, 0x44, 0x3c, 0x8a, 0x8b, 0xdb,
+	0x32, 0x2b, 0xc1, 0x68, 0x1e, 0xd5, 0x21, 0x56, 0x04, 0x82, 0xd7, 0xfd, 0x11, 0x87, 0x4c, 0x35,
+	0xa3, 0x6e, 0x37, 0x0a, 0xb9, 0x29, 0x43, 0xd8, 0x65, 0x5e, 0x3c, 0x2e, 0xcd, 0x6d, 0x76, 0xc1,
+	0x10, 0xc6, 0x0d, 0x33, 0x2a, 0xde, 0xca, 0x24, 0x81, 0xd5, 0x2b, 0x73, 0x0d, 0xac, 0x1d, 0xb0,
+	0x06, 0xfe, 0x8c, 0x43, 0x4e, 0xf1, 0xba, 0x86, 0x85, 0x45, 0x18, 0xc5, 0xa3, 0x63, 0x7e, 0xac,
+	0x9c, 0xd1, 0x49, 0xdd, 0x7d, 0xe4, 0xe8, 0x90, 0xef, 0xa4, 0xbb, 0x44, 0x4e, 0x6d, 0x45, 0x71,
+	0x93, 0x9a, 0x03, 0x21, 0x16, 0x70, 0xd5, 0xd0, 0xe5, 0x2c, 0x03, 0xe4, 0xeb, 0xa0, 0x1a, 0x61,
+	0x14, 0x9a, 0xe3, 0x30, 0x6e, 0xab, 0x11, 0x97, 0x0b, 0xb9, 0x60, 0x40, 0x6d, 0x7b, 0xb9, 0x9c,
+	0x18, 0x62, 0xb9, 0xfc, 0x10, 0x79, 0xb8, 0x99, 0x1f, 0x99, 0xdd, 0xa4, 0xbf, 0x99, 0xf0, 0x15,
+	0x7d, 0x7c, 0xfe, 0x8d, 0xa2, 0x81, 0x87, 0x17, 0x06, 0x31, 0xc2, 0xe0, 0x36, 0xdc, 0x8f, 0x60,
+	0x6c, 0x18, 0x7b, 0x2b, 0x89, 0xc0, 0xe5, 0x38, 0xa2, 0xe5, 0x49, 0x1f, 0x2a, 0x78, 0xb3, 0x66,
+	0xac, 0x19, 0x97, 0x03, 0x4a, 0xa2, 0x7b, 0x93, 0x8c, 0xf5, 0xf0, 0xc8, 0x2a, 0x00, 0x36, 0x8e,
+	0x7c, 0x22, 0x55, 0xc2, 0xd9, 0xcd, 0xa2, 0x81, 0xb8, 0xc6, 0x85, 0x80, 0x94, 0x86, 0x5a, 0x5b,
+	0x33, 0xea, 0xf6, 0xa2, 0x90, 0x86, 0xa9, 0xdc, 0x4e, 0xa6, 0xf9, 0xf5, 0x9f, 0x2c, 0x05, 0x83,
+	0x23, 0xb7, 0xab, 0x6b, 0xb6, 0xfa, 0xa9, 0x7d, 0x76, 0x75, 0xa3, 0xb5, 0x41, 0xf5, 0x71, 0xdb,
+	0x61, 0x26, 0xde, 0x1b, 0x41, 0xba, 0x8d, 0x77, 0x2a, 0xd2, 0xf4, 0x31, 0x6d, 0x6f, 0x3b, 0x2b,
+	0x05, 0x3c, 0x50, 0x58, 0x33, 0xbb, 0xc7, 0xce, 0xdc, 0xd9, 0x1e, 0x7b, 0x72, 0x88, 0x3d, 0xb6,
+	0x41, 0xce, 0xb0, 0x1e, 0x08, 0x3d, 0x5c, 0x1a, 0x90, 0x13, 0x86, 0x2b, 0x31, 0xae, 0xc3, 0x58,
+	0x57, 0x8a, 0x98, 0xa0, 0xb8, 0xee, 0xd9, 0xaf, 0x23, 0xa7, 0x72, 0x8b, 0xdc, 0xa1, 0x8c, 0xc3,
+	0x8b, 0xe4, 0xc1, 0xe2, 0xe5, 0xe4, 0x50, 0x26, 0xe2, 0xbf, 0x9f, 0x89, 0xc1, 0x31, 0x4e, 0x8d,
+	0x43, 0x5c, 0x37, 0xf8, 0xa4, 0x4a, 0xc3, 0x5d, 0xb1, 0xbb, 0x5e, 0x3e, 0xda, 0xac, 0xbe, 0x14,
+	0xee, 0xf2, 0xd5, 0x90, 0xd9, 0x54, 0x2f, 0x85, 0xbb, 0x80, 0x6d, 0xbb, 0xdf, 0xed, 0x58, 0x47,
+	0x89, 0x6a, 0x19, 0x10, 0x45, 0x03, 0x1e, 0x78, 0xe8, 0xd3, 0x85, 0xf7, 0xcf, 0x2b, 0xe4, 0xc2,
+	0x41, 0x8d, 0x0c, 0x31, 0x7c, 0x8f, 0x63, 0x10, 0x50, 0x1c, 0x84, 0x6d, 0xb1, 0x5d, 0x4d, 0xe2,
+	0x57, 0xcc, 0x5d, 0xb1, 0x3e, 0x04, 0x82, 0xe4, 0x76, 0x48, 0xb5, 0xeb, 0xf7, 0x84, 0xed, 0x7a,
+	0xf9, 0xa8, 0x30, 0x07, 0xf8, 0xdb, 0xef, 0xac, 0xfa, 0x3d, 0x3e, 0xe7, 0x8d, 0x02, 0x40, 0x31,
+	0x6e, 0x4a, 0x6a, 0x7e, 0x1c, 0xfb, 0xd2, 0xcb, 0xe7, 0x6a, 0x39, 0xf2, 0xe6, 0xb0, 0x49, 0xee,
+	0x24, 0x61, 0x15, 0x01, 0x17, 0xe6, 0xfd, 0x3b, 0x62, 0x45, 0xbd, 0x32, 0xd7, 0xad, 0x84, 0x8c,
+	0x0a, 0x93, 0xb5, 0x53, 0x36, 0xba, 0x04, 0x6b, 0x96, 0x1b, 0x45, 0xf8, 0xff, 0x20, 0x44, 0xb9,
+	0x9f, 0x76, 0x18, 0xda, 0x9c, 0x0c, 0x94, 0xaf, 0x57, 0x4a, 0xf6, 0x32, 0x32, 0xc1, 0xef, 0x4c,
+	0x0c, 0x3b, 0x59, 0x08, 0xa6, 0x74, 0x01, 0xae, 0xc9, 0xce, 0x35, 0x79, 0x70, 0x4d, 0x2c, 0x06,
+	0x49, 0x77, 0x6f, 0x15, 0xb8, 0x68, 0x95, 0x80, 0x2a, 0x36, 0x84, 0x53, 0xd6, 0x17, 0x1c, 0x72,
+	0x2a, 0xc8, 0xfa, 0xda, 0xd4, 0x6b, 0x65, 0x38, 0x01, 0x0e, 0x76, 0xe5, 0x51, 0x8a, 0x4e, 0x8e,
+	0x04, 0xf9, 0xce, 0xb8, 0x2d, 0x32, 0x12, 0x84, 0x5b, 0x91, 0x50, 0xef, 0xe6, 0x8f, 0xd6, 0xa9,
+	0xe5, 0x70, 0x2b, 0xd2, 0x5f, 0x33, 0xfe, 0x02, 0xd6, 0xba, 0xbb, 0x42, 0x4e, 0xcb, 0xd8, 0xc6,
+	0x2b, 0x41, 0x82, 0xd6, 0xaa, 0x95, 0xa0, 0x1b, 0xa4, 0x22, 0xc0, 0xa2, 0x8e, 0xdb, 0x1b, 0x14,
+	0xd0, 0xa1, 0xb0, 0x96, 0xfb, 0x32, 0x19, 0x93, 0xfe, 0x2d, 0xe3, 0x65, 0x58, 0x16, 0xf2, 0xf3,
+	0x5f, 0x4d, 0x26, 0xfe, 0x3b, 0x01, 0x29, 0xd0, 0xfd, 0xa4, 0x43, 0xa6, 0xf9, 0xff, 0x57, 0xf6,
+	0x5a, 0x3c, 0xd6, 0x7a, 0xa2, 0x0c, 0x53, 0x7a, 0xc3, 0x6a, 0x73, 0xde, 0x45, 0xb3, 0x86, 0x5d,
+	0x06, 0x19, 0xb9, 0xee, 0xb7, 0xa0, 0xa1, 0x96, 0xe1, 0x91, 0x24, 0x6b, 0xa1, 0x00, 0x7a, 0x6b,
+	0x94, 0xf8, 0x39, 0x4a, 0xa4, 0x13, 0xad, 0xa1, 0x2e, 0x4a, 0x69, 0xa0, 0x05, 0xef, 0x87, 0x8f,
+	0x35, 0x79, 0x24, 0x7c, 0xac, 0xda, 0x56, 0x87, 0x52, 0x6e, 0x83, 0x2d, 0x03, 0x09, 0xd5, 0xc2,
+	0x33, 0xe0, 0xfe, 0xe7, 0xec, 0x5f, 0xe0, 0x72, 0xbc, 0xbf, 0x79, 0x82, 0x9c, 0x9a, 0xdb, 0xdf,
+	0x9b, 0xca, 0xb9, 0xeb, 0xde, 0x54, 0x2f, 0x0a, 0x84, 0x89, 0x4a, 0x59, 0xab, 0x96, 0x90, 0x3a,
+	0x65, 0x62, 0x55, 0x08, 0x64, 0x8a, 0xbe, 0xf2, 0xbc, 0xaa, 0x96, 0xe4, 0xd4, 0x31, 0x94, 0xf3,
+	0xd5, 0x2d, 0x32, 0xb6, 0xcd, 0xbf, 0x6e, 0x71, 0x74, 0x5e, 0x3d, 0xea, 0xf8, 0x5a, 0x4b, 0x86,
+	0xfe, 0x96, 0x45, 0x01, 0x48, 0x71, 0xcc, 0x79, 0xd7, 0x70, 0x2f, 0xe4, 0xeb, 0x72, 0x79, 0x51,
+	0xf8, 0xc3, 0xfb, 0x16, 0x7e, 0x98, 0x4c, 0xc5, 0x38, 0xed, 0x9b, 0x41, 0x87, 0x85, 0x8b, 0x8d,
+	0x1e, 0x3a, 0x5c, 0x8c, 0x99, 0xe9, 0xc0, 0x68, 0x03, 0xac, 0x16, 0xd9, 0xb2, 0xa5, 0xe0, 0x9a,
+	0xf0, 0x85, 0x50, 0x71, 0xb5, 0xb5, 0x52, 0x12, 0x38, 0x14, 0x6b, 0x93, 0x2f, 0x5b, 0x76, 0x19,
+	0x64, 0xe4, 0xba, 0xcf, 0x11, 0x12, 0x6d, 0x72, 0x0f, 0xdd, 0x3b, 0x8a, 0x8c, 0x9b, 0xe6, 0x20,
+	0x0e, 0xb2, 0x05, 0x30, 0x5a, 0x73, 0xaf, 0x12, 0xc2, 0xbf, 0x1c, 0xbc, 0xfc, 0xac, 0x4f, 0x58,
+	0x01, 0xf2, 0xa4, 0xa1, 0x28, 0xaf, 0xdd, 0x3e, 0x9f, 0x37, 0xe6, 0x23, 0x01, 0x8c, 0xea, 0xee,
+	0x37, 0x90, 0xb1, 0xa4, 0xdf, 0xed, 0xfa, 0xea, 0x16, 0xac, 0x44, 0x58, 0x08, 0xde, 0xae, 0xb1,
+	0xcf, 0xf0, 0x02, 0x90, 0x12, 0xdd, 0x17, 0x71, 0xc7, 0x14, 0x0b, 0x3e, 0xff, 0x8a, 0xd8, 0xff,
+	0xc2, 0xc4, 0xfa, 0x6e, 0x79, 0x28, 0x84, 0x02, 0x1e, 0xf4, 0x3e, 0xb3, 0xcb, 0x57, 0xa2, 0xa6,
+	0xb0, 0x52, 0x16, 0xb5, 0xe9, 0x3e, 0x4d, 0x26, 0xf5, 0x63, 0x4b, 0xa4, 0xcb, 0xb7, 0x68, 0x28,
+	0x64, 0x56, 0x3c, 0x78, 0xcc, 0xcc, 0xca, 0xee, 0x2a, 0x79, 0xa0, 0x19, 0x85, 0x69, 0x1c, 0x75,
+	0x3a, 0x1c, 0x31, 0x9d, 0x9b, 0x3a, 0xf8, 0x2d, 0xd9, 0x23, 0xa2, 0xdb, 0x0f, 0x2c, 0xe4, 0x59,
+	0xa0, 0xa8, 0x1e, 0x1e, 0x71, 0xb2, 0xdb, 0xed, 0x74, 0x29, 0x9e, 0x23, 0x56, 0x9b, 0x62, 0x85,
+	0x52, 0xf7, 0x09, 0x07, 0x6c, 0xbc, 0xaf, 0x38, 0x72, 0x6f, 0x9a, 0x29, 0xd9, 0x53, 0x34, 0x0f,
+	0x83, 0xa4, 0x2f, 0x1f, 0xad, 0xcd, 0xea, 0x47, 0x33, 0x3e, 0x0c, 0x62, 0xd6, 0xbc, 0x93, 0x4c,
+	0x61, 0xac, 0x55, 0x1c, 0xfa, 0x9d, 0x67, 0x61, 0x45, 0xde, 0x46, 0xb1, 0xc5, 0xe1, 0x92, 0x51,
+	0x0e, 0x16, 0x17, 0x86, 0xe2, 0x0a, 0xc3, 0xa7, 0x81, 0xca, 0xc2, 0x0d, 0x9f, 0xca, 0xcc, 0xf9,
+	0x2e, 0x32, 0x19, 0x24, 0x73, 0xbd, 0xde, 0xda, 0xd6, 0x5c, 0xaf, 0xc7, 0x11, 0x4b, 0xc6, 0xb5,
+	0x9a, 0xbe, 0xac, 0x49, 0x60, 0xf2, 0x79, 0x3f, 0x59, 0xb5, 0x4e, 0x2f, 0xf7, 0xc4, 0xd1, 0x82,
+	0xa1, 0xe2, 0x4a, 0xb4, 0x61, 0x46, 0xa8, 0x57, 0x4a, 0x97, 0xac, 0x7c, 0x59, 0xd7, 0x4c, 0x41,
+	0x60, 0xcb, 0x75, 0x77, 0x48, 0x6d, 0x3b, 0x4a, 0x52, 0x79, 0x56, 0x3f, 0xa2, 0x59, 0xe0, 0x4a,
+	0x94, 0xa4, 0x4c, 0xe5, 0x56, 0x8f, 0x8d, 0x25, 0x09, 0x70, 0x19, 0xf8, 0xca, 0x92, 0x6d, 0x3f,
+	0x6e, 0x59, 0x6e, 0xd8, 0xea, 0x95, 0x35, 0x34, 0x09, 0x4c, 0x3e, 0xef, 0x8f, 0x6d, 0xc4, 0xbb,
+	0xe3, 0xf2, 0x49, 0x79, 0xc5, 0xb1, 0xe1, 0x65, 0x2a, 0x65, 0x1c, 0xe2, 0x8d, 0x7e, 0x1f, 0x8c,
+	0x54, 0xe3, 0xc5, 0xe4, 0xd4, 0x5c, 0x3f, 0x8d, 0xba, 0x2c, 0x72, 0x3c, 0xea, 0x74, 0x36, 0xfd,
+	0xe6, 0x0e, 0x5e, 0x73, 0xde, 0x64, 0x90, 0xd0, 0x59, 0xd8, 0x31, 0x0e, 0x14, 0x0d, 0x82, 0x8a,
+	0xa3, 0xdb, 0xf5, 0x6f, 0xcd, 0xa5, 0x29, 0xed, 0xf6, 0x52, 0xee, 0xb4, 0x67, 0x8c, 0xee, 0xaa,
+	0x26, 0x81, 0xc9, 0xe7, 0x7d, 0xb7, 0x43, 0xc6, 0xe6, 0xfd, 0xe6, 0x4e, 0xb4, 0xb5, 0x85, 0xd7,
+	0x79, 0x32, 0x78, 0x5c, 0x08, 0x53, 0xa6, 0x52, 0x19, 0x6a, 0x0e, 0x8a, 0x03, 0xbf, 0xd2, 0x2d,
+	0xbf, 0x29, 0xc1, 0x9d, 0xaa, 0xfc, 0x2b, 0xbd, 0xcc, 0x4a, 0x40, 0x50, 0x44, 0xa7, 0x64, 0xe5,
+	0xec, 0xd5, 0xee, 0xaa, 0x26, 0x81, 0xc9, 0xe7, 0xfd, 0x13, 0x87, 0xd4, 0xe7, 0xfd, 0x24, 0x68,
+	0x62, 0x7e, 0x8c, 0xf9, 0x20, 0xdd, 0xec, 0x37, 0x77, 0x68, 0xca, 0x71, 0xd6, 0xb0, 0x97, 0xfd,
+	0x84, 0xc6, 0x86, 0xbd, 0x46, 0xf5, 0xf2, 0x59, 0x51, 0x0e, 0x8a, 0xc3, 0x7d, 0x19, 0xd1, 0x00,
+	0x92, 0xe4, 0x66, 0x14, 0xb7, 0x80, 0x6e, 0x95, 0x03, 0x22, 0xda, 0xa0, 0xcd, 0x98, 0xa6, 0x40,
+	0xb7, 0x84, 0xab, 0x9a, 0x6e, 0x1f, 0x4c, 0x61, 0xde, 0xa7, 0x1c, 0x72, 0x7a, 0x9e, 0xfa, 0x31,
+	0x8d, 0x19, 0xe6, 0xa8, 0x7a, 0x10, 0xf7, 0x25, 0x32, 0x9e, 0x62, 0x09, 0xf6, 0xc8, 0x29, 0xb7,
+	0x47, 0xcc, 0xc9, 0x6c, 0x43, 0x34, 0x0e, 0x4a, 0x8c, 0xf7, 0x1d, 0x0e, 0x79, 0xb8, 0xa8, 0x2f,
+	0x0b, 0x9d, 0xa8, 0xdf, 0xba, 0x17, 0x1d, 0xfa, 0xff, 0x1d, 0x32, 0xc5, 0xfc, 0x57, 0x16, 0x69,
+	0xea, 0x07, 0x9d, 0x5c, 0xf2, 0x00, 0x67, 0xc8, 0xe4, 0x01, 0x17, 0xc8, 0xc8, 0x76, 0x94, 0x87,
+	0xdb, 0xbb, 0x12, 0xa1, 0xe9, 0x0e, 0x29, 0x68, 0x46, 0xee, 0xfa, 0x41, 0x98, 0xfa, 0xb8, 0x04,
+	0xc8, 0xcb, 0xb4, 0x19, 0x3e, 0x01, 0x55, 0x31, 0x98, 0x3c, 0xde, 0xb7, 0x13, 0x32, 0x26, 0xb6,
+	0xbd, 0x12, 0x71, 0xff, 0x12, 0x32, 0xda, 0x64, 0xc9, 0x5e, 0xea, 0xd5, 0x32, 0x2c, 0x76, 0xa2,
+	0x83, 0x3c, 0x7f, 0x8c, 0xee, 0x16, 0xff, 0x0d, 0x42, 0x94, 0xfb, 0x59, 0x87, 0xcc, 0x34, 0xa3,
+	0x30, 0xa4, 0x4d, 0xad, 0x6a, 0x8f, 0x94, 0x71, 0x9e, 0x5a, 0xb0, 0x1b, 0x35, 0x80, 0xf3, 0x6c,
+	0x02, 0x64, 0xc5, 0x63, 0xf8, 0x05, 0x1f, 0xb3, 0xeb, 0xd6, 0x0d, 0xa0, 0x06, 0x72, 0x37, 0x89,
+	0x60, 0xf3, 0xe2, 0x45, 0x49, 0xa8, 0x51, 0xd0, 0x47, 0xf5, 0x45, 0x89, 0x81, 0x7f, 0x6e, 0x70,
+	0x20, 0x62, 0x54, 0x4c, 0xb7, 0x62, 0x9a, 0x6c, 0x0b, 0x0f, 0x52, 0xa6, 0xe6, 0x8f, 0xdd, 0x19,
+	0x62, 0x14, 0xe4, 0x5a, 0x82, 0x82, 0xd6, 0xdd, 0x1d, 0x61, 0xc4, 0x1a, 0x2f, 0x63, 0x0f, 0x11,
+	0xaf, 0x79, 0xa0, 0x2d, 0xeb, 0x3c, 0xa9, 0xb1, 0xed, 0x92, 0x1d, 0x2f, 0xaa, 0xdc, 0x90, 0xc0,
+	0x36, 0x53, 0xe0, 0xe5, 0xee, 0x22, 0x39, 0x99, 0x41, 0x96, 0x4f, 0xc4, 0x4d, 0x9d, 0x0a, 0x5a,
+	0xce, 0x60, 0xd2, 0x27, 0x90, 0xab, 0x61, 0x1a, 0x38, 0x27, 0x0f, 0x30, 0x70, 0xee, 0xa9, 0x38,
+	0x05, 0x7e, 0x87, 0xf6, 0x4c, 0x29, 0x03, 0x30, 0x54, 0x50, 0xc2, 0xb7, 0x67, 0x82, 0x12, 0x4e,
+	0x5c, 0xa8, 0x1e, 0xdd, 0x99, 0x4c, 0x76, 0xe0, 0x0e, 0x22, 0x10, 0x9e, 0x26, 0x6e, 0xd7, 0xbf,
+	0xb5, 0x10, 0x85, 0xcd, 0x7e, 0x1c, 0xd3, 0x90, 0x85, 0x0a, 0x71, 0xe7, 0x8f, 0xea, 0xfc, 0x59,
+	0x51, 0xdb, 0x5d, 0xcd, 0x71, 0x40, 0x41, 0xad, 0x7b, 0x19, 0x9d, 0xf0, 0xd3, 0x15, 0x22, 0xe7,
+	0xc8, 0x82, 0xdf, 0xdc, 0xa6, 0x38, 0xfd, 0x0a, 0x22, 0xeb, 0x9c, 0x43, 0x45, 0xd6, 0x5d, 0x24,
+	0x13, 0x38, 0xe6, 0xbc, 0x2a, 0xd7, 0x21, 0x94, 0xf1, 0x69, 0x6e, 0x7d, 0x59, 0xd4, 0xd2, 0x3c,
+	0x6e, 0x44, 0x4e, 0x75, 0xfc, 0x24, 0x65, 0x3d, 0xc0, 0x21, 0xb9, 0x43, 0xec, 0x37, 0x16, 0x65,
+	0xbb, 0x92, 0x6d, 0x08, 0xf2, 0x6d, 0xa3, 0xf7, 0x15, 0x45, 0x35, 0x31, 0x59, 0xa7, 0xf1, 0x6a,
+	0x10, 0xf6, 0xc5, 0xd2, 0x59, 0xd5, 0x6b, 0xdd, 0x25, 0x9b, 0x0c, 0x59, 0x7e, 0xef, 0x5f, 0xd6,
+	0xc8, 0x09, 0x6b, 0xa1, 0x3e, 0xa4, 0xfe, 0xf2, 0x56, 0x32, 0x2e, 0x55, 0x8a, 0x2c, 0x88, 0xa6,
+	0xd2, 0x3b, 0x14, 0x07, 0xee, 0xa1, 0x9b, 0x7a, 0x93, 0xcf, 0xea, 0x5b, 0xc6, 0xfe, 0x0f, 0x26,
+	0x1f, 0xdb, 0x23, 0xd2, 0x4e, 0xb2, 0xd0, 0x09, 0x68, 0x98, 0xf2, 0x6e, 0x96, 0xb3, 0x47, 0x6c,
+	0xac, 0x34, 0xcc, 0x46, 0xf5, 0xb8, 0x65, 0x08, 0x90, 0x15, 0x8f, 0xc6, 0xe4, 0x13, 0xfe, 0xcd,
+	0x44, 0x27, 0x48, 0xab, 0xd7, 0xca, 0xd8, 0x33, 0xad, 0x9c, 0x6b, 0xfc, 0x96, 0xcb, 0x2a, 0x02,
+	0x5b, 0x28, 0x46, 0xbc, 0xb9, 0xf4, 0x16, 0x6d, 0xca, 0x78, 0x0d, 0xd1, 0x97, 0xd1, 0x32, 0xec,
+	0x2f, 0x97, 0x72, 0xed, 0xf2, 0x4d, 0x26, 0x5f, 0x0e, 0x05, 0x7d, 0xc0, 0xa5, 0x45, 0xf8, 0x86,
+	0xe2, 0xdd, 0xbd, 0x00, 0x97, 0x10, 0xce, 0x25, 0x6a, 0x69, 0x59, 0xcc, 0x71, 0x40, 0x41, 0x2d,
+	0x36, 0xcb, 0xe2, 0xe8, 0xd6, 0xde, 0xb3, 0x71, 0xa7, 0x3e, 0x9e, 0x99, 0x65, 0xa2, 0x1c, 0x14,
+	0x87, 0xf7, 0x27, 0x55, 0xb5, 0x1a, 0xe8, 0xe0, 0x24, 0xdf, 0x08, 0x92, 0x38, 0x02, 0xfc, 0xae,
+	0x92, 0x5b, 0x00, 0x99, 0x62, 0x21, 0x2c, 0x54, 0xee, 0x11, 0xc2, 0xc2, 0x37, 0x3b, 0x16, 0x50,
+	0xed, 0xe4, 0x93, 0xcf, 0x95, 0x1b, 0x18, 0x35, 0xcb, 0x9d, 0x1b, 0x33, 0xdb, 0x5c, 0xc6, 0xa7,
+	0xf5, 0xad, 0x64, 0x7c, 0xab, 0xe3, 0x33, 0x90, 0xad, 0xfa, 0x88, 0xed, 0x78, 0x79, 0x59, 0x94,
+	0x83, 0xe2, 0xc0, 0x8d, 0xc3, 0x68, 0xf4, 0x50, 0x0b, 0xff, 0xef, 0x57, 0xc9, 0xa4, 0xa1, 0x80,
+	0x14, 0x6a, 0x93, 0xce, 0x7d, 0xa6, 0x4d, 0x56, 0x0e, 0xa1, 0x4d, 0x7e, 0x23, 0x99, 0x68, 0xca,
+	0x0d, 0xad, 0x9c, 0x1c, 0x77, 0xd9, 0x6d, 0x52, 0xef, 0x69, 0xaa, 0x08, 0xb4, 0x4c, 0xf4, 0x10,
+	0x33, 0x9a, 0xb1, 0x4c, 0x23, 0x45, 0x61, 0xf6, 0x62, 0x53, 0xcc, 0xd7, 0xc9, 0x3a, 0xcb, 0xd4,
+	0x0e, 0x76, 0x96, 0xc1, 0x2c, 0x09, 0xf2, 0xe5, 0xde, 0x05, 0xb8, 0xb6, 0x17, 0x6d, 0xb8, 0xb6,
+	0x4b, 0xa5, 0x0c, 0xf3, 0x00, 0x9c, 0xb6, 0x4f, 0x39, 0xe4, 0xdc, 0xfe, 0xf9, 0x98, 0x30, 0xa6,
+	0xa2, 0x1d, 0x47, 0xfd, 0x9e, 0xd8, 0x83, 0x55, 0x3b, 0x4b, 0x58, 0x08, 0x9c, 0x86, 0x67, 0xba,
+	0x9d, 0x20, 0x6c, 0x65, 0xcf, 0x74, 0x57, 0x83, 0xb0, 0x05, 0x8c, 0x72, 0x70, 0x3e, 0x01, 0xef,
+	0x1a, 0x19, 0x43, 0xe7, 0x1f, 0x3f, 0x6c, 0xb9, 0x6f, 0x22, 0x63, 0x4d, 0xfe, 0xaf, 0xb0, 0x84,
+	0x32, 0x2f, 0x12, 0x41, 0x05, 0x49, 0x43, 0xef, 0x54, 0x3f, 0x6e, 0x4b, 0xeb, 0x27, 0xf3, 0x4e,
+	0x9d, 0x8b, 0xdb, 0x09, 0xb0, 0x52, 0xef, 0x3f, 0x39, 0x64, 0x1a, 0xab, 0x04, 0xe9, 0xaa, 0x1c,
+	0xda, 0x27, 0xc8, 0xa8, 0xdf, 0x4f, 0xb7, 0xa3, 0xdc, 0x11, 0x75, 0x8e, 0x95, 0x82, 0xa0, 0x62,
+	0x67, 0x15, 0xe6, 0x90, 0xd1, 0xd9, 0x45, 0xfc, 0xae, 0x18, 0x05, 0xb5, 0xfc, 0xa4, 0xbf, 0x59,
+	0xe4, 0xc6, 0xd0, 0xe0, 0xc5, 0x20, 0xe9, 0xd8, 0xd8, 0x66, 0xd4, 0xda, 0xab, 0x8f, 0xd8, 0x8d,
+	0xcd, 0x47, 0xad, 0x3d, 0x60, 0x14, 0x8c, 0x48, 0x49, 0xb6, 0x7d, 0xe9, 0x30, 0x23, 0x18, 0xaa,
+	0x8d, 0x2b, 0x73, 0x80, 0xe5, 0x2a, 0xc0, 0x2a, 0xee, 0xd4, 0x47, 0xf7, 0x0b, 0xb0, 0x8a, 0x3b,
+	0xde, 0xdf, 0x1b, 0x21, 0xcc, 0x11, 0xce, 0x8f, 0x69, 0x6b, 0x23, 0x62, 0xd9, 0x4c, 0x8e, 0xd5,
+	0xdf, 0x44, 0x9f, 0xf1, 0xef, 0x67, 0x9f, 0x13, 0xc3, 0xef, 0xa0, 0x7a, 0xb7, 0xfd, 0x0e, 0x8a,
+	0x5d, 0x49, 0x46, 0xee, 0x23, 0x57, 0x12, 0xef, 0x33, 0x0e, 0x71, 0x95, 0x5b, 0xa3, 0xf6, 0xf5,
+	0xba, 0x48, 0x26, 0x94, 0x1f, 0xa5, 0xf8, 0x5e, 0xf4, 0x12, 0x2d, 0x09, 0xa0, 0x79, 0x86, 0x30,
+	0xec, 0x3c, 0x2e, 0xf7, 0xcf, 0xaa, 0xbd, 0x96, 0xb0, 0x5d, 0x57, 0x6c, 0xa7, 0xde, 0x2f, 0x54,
+	0xc8, 0x83, 0x5c, 0x75, 0x5b, 0xf5, 0x43, 0xbf, 0x4d, 0xbb, 0xd8, 0xab, 0x61, 0xbd, 0xf7, 0x9a,
+	0x68, 0x51, 0x08, 0x64, 0x70, 0xd4, 0x51, 0xd7, 0x4e, 0xbe, 0xce, 0xf0, 0x95, 0x65, 0x39, 0x0c,
+	0x52, 0x60, 0x8d, 0xbb, 0x09, 0x19, 0x97, 0xa0, 0x9e, 0xf5, 0x6a, 0x99, 0x82, 0xd4, 0xb6, 0x20,
+	0x01, 0x44, 0x41, 0x09, 0x42, 0x55, 0xa6, 0x13, 0x35, 0x77, 0xf0, 0x93, 0xcf, 0xaa, 0x32, 0x2b,
+	0xa2, 0x1c, 0x14, 0x87, 0xd7, 0x25, 0x33, 0x72, 0x0c, 0x7b, 0x98, 0x68, 0x80, 0x6e, 0xe1, 0xfe,
+	0xdf, 0x94, 0x45, 0x46, 0xea, 0x64, 0xb5, 0xff, 0x2f, 0x98, 0x44, 0xb0, 0x79, 0x65, 0x0a, 0x83,
+	0x4a, 0x71, 0x0a, 0x03, 0xef, 0x17, 0x1c, 0x92, 0x55, 0x40, 0x98, 0x3d, 0xd0, 0xcc, 0x83, 0x3c,
+	0x28, 0xf3, 0xd1, 0x21, 0x50, 0xcd, 0x9f, 0x27, 0x93, 0x3e, 0x37, 0xc8, 0x33, 0xe3, 0x54, 0xf5,
+	0xce, 0xee, 0xa0, 0x57, 0xa3, 0x56, 0xb0, 0x15, 0x70, 0x70, 0x56, 0xa3, 0x39, 0xef, 0xff, 0xab,
+	0x91, 0x89, 0xc5, 0x78, 0xef, 0xf0, 0x61, 0xad, 0xf9, 0xa0, 0xd5, 0xca, 0xa1, 0x82, 0x56, 0x65,
+	0x58, 0x6c, 0x75, 0x60, 0x58, 0xac, 0x0c, 0x6b, 0x1d, 0xb9, 0x57, 0x61, 0xad, 0xb5, 0xfb, 0x24,
+	0xac, 0x75, 0xf4, 0x3e, 0x08, 0x6b, 0x1d, 0xbb, 0xcb, 0x61, 0xad, 0xde, 0x7f, 0x1e, 0x21, 0xa7,
+	0x72, 0xf0, 0x04, 0xee, 0x53, 0x64, 0x4a, 0x7d, 0xa3, 0xf2, 0x3e, 0x62, 0xc2, 0x8c, 0x29, 0xd1,
+	0x34, 0xb0, 0x38, 0x87, 0x58, 0xa8, 0x97, 0xc9, 0x03, 0x31, 0xda, 0x69, 0xfb, 0x74, 0x6e, 0x8b,
+	0xa5, 0x72, 0xe1, 0x7e, 0x61, 0x1c, 0x23, 0xfa, 0x21, 0xf4, 0x04, 0x80, 0x3c, 0x19, 0x8a, 0xea,
+	0xb8, 0x3d, 0x72, 0xa2, 0x63, 0x9e, 0x5c, 0x8f, 0x92, 0x24, 0x4a, 0xad, 0x55, 0x56, 0x31, 0xd8,
+	0x02, 0xec, 0xe3, 0x6f, 0xed, 0x1e, 0x1d, 0x7f, 0x3f, 0xae, 0x8f, 0xbf, 0xa5, 0xc0, 0x52, 0xe5,
+	0xde, 0xff, 0x30, 0xe7, 0xdf, 0xa3, 0x9c, 0x68, 0x9f, 0x21, 0xe3, 0xd2, 0x7d, 0x7d, 0x28, 0xb7,
+	0x6f, 0xb3, 0x9d, 0x01, 0x3b, 0xfb, 0xf7, 0x8f, 0x90, 0x02, 0xa3, 0x0d, 0xae, 0xb4, 0x5a, 0xdb,
+	0xb7, 0x56, 0xda, 0xc3, 0x69, 0xfc, 0xee, 0x2d, 0xee, 0xba, 0x5f, 0x2d, 0x23, 0x4f, 0x56, 0xbe,
+	0x9f, 0xda, 0x9b, 0x5f, 0xed, 0x7f, 0xca, 0xa3, 0xff, 0x49, 0x42, 0xf4, 0x81, 0x51, 0x68, 0xfa,
+	0xca, 0x79, 0x4c, 0x9f, 0x2b, 0xc1, 0xe0, 0x62, 0x9e, 0x19, 0x61, 0x92, 0xfa, 0x9d, 0xce, 0x95,
+	0x20, 0x4c, 0x85, 0xf6, 0xaf, 0x3d, 0x33, 0x34, 0x09, 0x4c, 0x3e, 0x34, 0x67, 0xf5, 0x78, 0xbf,
+	0x0c, 0x7b, 0x43, 0x7d, 0xd4, 0x36, 0x67, 0xad, 0xe7, 0x38, 0xa0, 0xa0, 0x96, 0xfb, 0x8c, 0xba,
+	0x68, 0x1b, 0xbb, 0x93, 0x18, 0x53, 0x92, 0xbf, 0x46, 0x3b, 0xfb, 0x6e, 0x63, 0xda, 0x1c, 0x66,
+	0xba, 0x6d, 0x93, 0x87, 0x97, 0x82, 0x54, 0xad, 0xbc, 0x6a, 0x9a, 0xb3, 0x33, 0xa8, 0xdc, 0x20,
+	0x9d, 0x81, 0x1b, 0xa4, 0x11, 0x94, 0x5e, 0xb1, 0x63, 0xe8, 0xb3, 0x41, 0xe9, 0x5e, 0x93, 0x9c,
+	0x5e, 0x0a, 0x52, 0x0c, 0xcc, 0x3d, 0x46, 0x21, 0x3f, 0x3f, 0x4a, 0xa6, 0x4c, 0x54, 0x9d, 0xc3,
+	0xa8, 0x13, 0x08, 0x03, 0x27, 0xf7, 0x9d, 0x40, 0x39, 0xbd, 0xdc, 0x38, 0x32, 0xc4, 0x4f, 0xf1,
+	0xe0, 0x1a, 0xe7, 0x27, 0x2d, 0x13, 0xcc, 0x0e, 0xb8, 0x37, 0x49, 0x6d, 0x8b, 0xc5, 0x41, 0x57,
+	0xcb, 0xf0, 0xb4, 0x2c, 0x1a, 0x7c, 0xc3, 0x5b, 0x2a, 0x60, 0x09, 0xe8, 0xb7, 0x64, 0xdc, 0x74,
+	0x6c, 0xe3, 0x80, 0xec, 0x93, 0xff, 0x6c, 0xd0, 0xa6, 0x55, 0xbb, 0x83, 0x4d, 0xcb, 0xda, 0x42,
+	0x46, 0xef, 0xd1, 0x16, 0xc2, 0x62, 0xda, 0xd3, 0x6d, 0x76, 0x22, 0x13, 0x41, 0xb4, 0x3c, 0x09,
+	0x9c, 0x11, 0xd3, 0x6e, 0x91, 0x21, 0xcb, 0xef, 0x7e, 0x4c, 0x6d, 0x42, 0xe3, 0x65, 0x5c, 0xf0,
+	0x99, 0x33, 0xfa, 0xb8, 0xf7, 0x9f, 0xcf, 0x54, 0xc8, 0xf4, 0x52, 0xd8, 0x5f, 0x5f, 0x5a, 0xef,
+	0x6f, 0x76, 0x82, 0xe6, 0x55, 0xba, 0x87, 0x9b, 0xcc, 0x0e, 0xdd, 0x5b, 0x5e, 0xcc, 0x9a, 0xa2,
+	0xae, 0x62, 0x21, 0x70, 0x1a, 0x2e, 0xab, 0x5b, 0x41, 0xd8, 0xa6, 0x71, 0x2f, 0x0e, 0xc4, 0x7d,
+	0x99, 0xb1, 0xac, 0x5e, 0xd6, 0x24, 0x30, 0xf9, 0xb0, 0xed, 0xe8, 0x66, 0xa8, 0x20, 0x0e, 0x55,
+	0xdb, 0x6b, 0x58, 0x08, 0x9c, 0x86, 0x4c, 0x69, 0xdc, 0x17, 0xb6, 0x64, 0x83, 0x69, 0x03, 0x0b,
+	0x81, 0xd3, 0x84, 0x69, 0x88, 0x39, 0xb2, 0xd6, 0x72, 0xa6, 0x21, 0x2c, 0x06, 0x49, 0x47, 0xd6,
+	0x1d, 0xba, 0xb7, 0x88, 0x76, 0xc4, 0x8c, 0x65, 0xe7, 0x2a, 0x2f, 0x06, 0x49, 0x67, 0x99, 0x23,
+	0xec, 0xe1, 0xf8, 0x92, 0xcb, 0x1c, 0x61, 0x77, 0x7f, 0x80, 0x45, 0xf2, 0x87, 0x2a, 0x64, 0xca,
+	0x74, 0x3f, 0x47, 0x5c, 0x51, 0xeb, 0x18, 0xf9, 0x5c, 0x2e, 0xab, 0x54, 0x89, 0x79, 0x17, 0x0f,
+	0x7f, 0x24, 0xbd, 0x17, 0xb9, 0x6d, 0x6f, 0x90, 0x53, 0x39, 0x50, 0x8d, 0x21, 0x74, 0xb4, 0x03,
+	0xd1, 0x97, 0x3c, 0x20, 0x93, 0xd8, 0xb0, 0x04, 0x4f, 0x5e, 0x20, 0xa7, 0xf8, 0x77, 0x8c, 0x92,
+	0x18, 0x46, 0x82, 0x02, 0x4a, 0x61, 0x77, 0xc3, 0xd7, 0xb3, 0x44, 0xc8, 0xf3, 0x63, 0x6e, 0xc4,
+	0x13, 0x16, 0xce, 0x49, 0x49, 0xda, 0x24, 0xfb, 0xd0, 0x23, 0x16, 0x8f, 0xc1, 0xc2, 0x0d, 0x33,
+	0x9e, 0xad, 0x97, 0x35, 0x09, 0x4c, 0x3e, 0xef, 0x57, 0xab, 0x64, 0x5c, 0x3a, 0x60, 0x0e, 0xd1,
+	0x95, 0x4f, 0x3b, 0xe4, 0x84, 0xba, 0x8f, 0x67, 0xaa, 0x56, 0x29, 0x19, 0xc0, 0xb1, 0x07, 0xca,
+	0x7e, 0x87, 0xb7, 0x1f, 0xea, 0x68, 0x03, 0xa6, 0x30, 0xb0, 0x65, 0xbb, 0xd7, 0x31, 0x24, 0x2e,
+	0x49, 0x69, 0xd7, 0xb8, 0x87, 0xf1, 0x8c, 0x59, 0x36, 0xdb, 0x8c, 0x62, 0x8a, 0x73, 0x0a, 0xdd,
+	0x56, 0x1b, 0x8a, 0x53, 0xeb, 0xa2, 0xba, 0x0c, 0x8c, 0x96, 0x30, 0xa5, 0x61, 0xc7, 0x44, 0x41,
+	0x80, 0x72, 0x1c, 0x5c, 0x87, 0x71, 0x45, 0x39, 0x82, 0xbb, 0x86, 0xf7, 0x13, 0x15, 0x72, 0x32,
+	0x3b, 0x92, 0xee, 0x07, 0x30, 0x28, 0x83, 0xff, 0x36, 0xcc, 0x5c, 0xef, 0x51, 0xb9, 0x6d, 0x0d,
+	0xda, 0x6b, 0xb7, 0xcf, 0x9f, 0xd7, 0xde, 0xaf, 0x17, 0x71, 0xf0, 0x2e, 0xee, 0x1a, 0x0e, 0xc2,
+	0x38, 0x0d, 0xac, 0xc6, 0xb8, 0x2f, 0x87, 0x70, 0x60, 0x9a, 0xdf, 0x9b, 0xeb, 0xf5, 0x84, 0x43,
+	0x86, 0xe1, 0xcb, 0x61, 0x52, 0x21, 0xc3, 0x8d, 0x31, 0xe3, 0x46, 0xc9, 0x35, 0x1a, 0xb4, 0xb7,
+	0x37, 0xa3, 0x58, 0x9e, 0xac, 0x1f, 0xd5, 0xe1, 0x01, 0x79, 0x1e, 0x28, 0xac, 0x89, 0x3a, 0x52,
+	0xd3, 0xef, 0xf9, 0xcd, 0x20, 0xdd, 0x13, 0xf7, 0x61, 0x6a, 0x45, 0x5f, 0x10, 0xe5, 0xa0, 0x38,
+	0xbc, 0xbf, 0x36, 0x42, 0x4e, 0x72, 0x7f, 0x78, 0xaa, 0xc2, 0x3d, 0xdc, 0x0f, 0x90, 0x89, 0x24,
+	0xf5, 0x63, 0x6e, 0x54, 0x73, 0x0e, 0xbd, 0x74, 0x69, 0x70, 0x16, 0xd9, 0x08, 0xe8, 0xf6, 0x30,
+	0x6c, 0x64, 0x2b, 0x08, 0x83, 0x64, 0x9b, 0xb5, 0x5e, 0xb9, 0x33, 0x93, 0xdd, 0x65, 0xd5, 0x02,
+	0x18, 0xad, 0xb9, 0x5f, 0x43, 0x6a, 0xbd, 0x6d, 0x3f, 0x91, 0xf6, 0xe4, 0x27, 0xe4, 0x3a, 0xb1,
+	0x8e, 0x85, 0x18, 0xf8, 0x90, 0x7d, 0x54, 0x46, 0x00, 0x5e, 0xc9, 0x5c, 0xe5, 0x47, 0x0e, 0x58,
+	0xe5, 0x9f, 0x20, 0xa3, 0xad, 0x78, 0xaf, 0x71, 0x65, 0x2e, 0x9b, 0x91, 0x70, 0x91, 0x95, 0x82,
+	0xa0, 0xe2, 0x9a, 0xb4, 0xcd, 0x45, 0xb6, 0x90, 0x79, 0xd4, 0x56, 0x3e, 0xae, 0x68, 0x12, 0x98,
+	0x7c, 0x0c, 0xe7, 0x2f, 0x13, 0x2d, 0x31, 0x76, 0x0c, 0xc1, 0x89, 0x43, 0xc6, 0x49, 0x78, 0x97,
+	0xc8, 0x04, 0xff, 0x9f, 0x6e, 0x44, 0x68, 0x66, 0xe2, 0xe6, 0xca, 0xf9, 0xd8, 0x0f, 0x9b, 0xdb,
+	0x59, 0x33, 0xd3, 0x86, 0x41, 0x03, 0x8b, 0xd3, 0x5b, 0x25, 0x23, 0x43, 0x2e, 0xb2, 0x43, 0x59,
+	0x0f, 0x9e, 0x21, 0xe3, 0xd8, 0x9c, 0x3c, 0xab, 0x95, 0xd1, 0x64, 0x44, 0xc6, 0x9f, 0xbe, 0xb1,
+	0xc1, 0x7d, 0x7b, 0x3c, 0x52, 0x0d, 0x7c, 0xe9, 0x9a, 0xa5, 0x3e, 0xa1, 0xe5, 0x24, 0xe9, 0xb3,
+	0x69, 0x87, 0x44, 0xf7, 0x71, 0x52, 0xa5, 0xb7, 0x7a, 0x59, 0x1f, 0xac, 0x4b, 0xb7, 0x7a, 0x41,
+	0x4c, 0x13, 0x64, 0xa2, 0xb7, 0x7a, 0xee, 0x59, 0x52, 0x09, 0x5a, 0x62, 0x46, 0x12, 0xc1, 0x53,
+	0x59, 0x5e, 0x84, 0x4a, 0xd0, 0xf2, 0x6e, 0x91, 0x09, 0x29, 0x90, 0x05, 0x15, 0x70, 0xed, 0xca,
+	0x29, 0x23, 0xa8, 0x40, 0xb6, 0x3b, 0x40, 0xaf, 0xea, 0x13, 0xa2, 0xb1, 0x7e, 0xca, 0xda, 0x82,
+	0x2f, 0x90, 0x91, 0x66, 0x24, 0x70, 0xe0, 0xc6, 0x75, 0x33, 0x3c, 0x6b, 0x36, 0x52, 0xbc, 0x1b,
+	0x64, 0xfa, 0x6a, 0x18, 0xdd, 0x64, 0x59, 0x4c, 0x59, 0x5e, 0x07, 0x6c, 0x78, 0x0b, 0xff, 0xc9,
+	0x2a, 0xf1, 0x8c, 0x0a, 0x9c, 0x76, 0x70, 0xf6, 0x3a, 0xef, 0x15, 0x87, 0x4c, 0x29, 0x7b, 0xf1,
+	0xd2, 0xee, 0xce, 0x70, 0xf7, 0xd4, 0x06, 0x9a, 0x4e, 0xe5, 0x00, 0x34, 0x1d, 0x79, 0xa5, 0x5d,
+	0x1d, 0x74, 0xa5, 0xed, 0xfd, 0x85, 0x43, 0x4e, 0xaa, 0x2e, 0x48, 0x9d, 0xe9, 0x29, 0x32, 0xb5,
+	0xd9, 0x0f, 0x3a, 0x2d, 0xf1, 0x3b, 0xfb, 0xb9, 0xcc, 0x1b, 0x34, 0xb0, 0x38, 0xd1, 0x86, 0xb4,
+	0x19, 0x84, 0x7e, 0xbc, 0xb7, 0xae, 0x95, 0x34, 0xb5, 0x6f, 0xcf, 0x2b, 0x0a, 0x18, 0x5c, 0x08,
+	0x02, 0xb3, 0x2b, 0x3d, 0x19, 0xaa, 0xa5, 0x82, 0xc0, 0x88, 0xf1, 0xd0, 0x5f, 0x82, 0x72, 0x8d,
+	0x50, 0x12, 0xbd, 0xef, 0xac, 0x92, 0x69, 0x1b, 0xb8, 0x65, 0x08, 0x23, 0xca, 0xe3, 0xa4, 0xc6,
+	0xb0, 0x5c, 0xb2, 0x13, 0x8b, 0xd5, 0x07, 0x4e, 0x43, 0x0f, 0x70, 0xbe, 0x94, 0x08, 0x1d, 0x67,
+	0xad, 0xa4, 0xa7, 0x52, 0x96, 0x64, 0x66, 0xba, 0x12, 0xd7, 0x32, 0x42, 0x14, 0xba, 0xd2, 0x8d,
+	0x45, 0x3d, 0x13, 0xa4, 0xfb, 0xfd, 0x65, 0x82, 0xda, 0x08, 0xe4, 0x08, 0xa1, 0x0d, 0xa9, 0x89,
+	0x27, 0x27, 0x83, 0x14, 0x7d, 0xf6, 0xbd, 0x64, 0xca, 0xe4, 0x3c, 0x48, 0x21, 0x1a, 0x37, 0x15,
+	0xa2, 0x4f, 0x9b, 0x53, 0x52, 0xc0, 0xf6, 0x0c, 0xf1, 0xb1, 0x3f, 0x4b, 0x6a, 0x4d, 0xe5, 0x5d,
+	0x7a, 0x47, 0x49, 0x96, 0x14, 0xd2, 0x26, 0x36, 0x03, 0xbc, 0x35, 0xf4, 0x9b, 0x99, 0x36, 0x7a,
+	0x93, 0x2c, 0xb7, 0xdc, 0x98, 0x54, 0xdb, 0xbb, 0x3b, 0x42, 0xc9, 0x78, 0xba, 0xa4, 0xe1, 0x5d,
+	0xda, 0xdd, 0xd1, 0x5f, 0x98, 0x59, 0x0a, 0x28, 0x6c, 0x88, 0xeb, 0x0e, 0x0b, 0xdd, 0xa9, 0x7a,
+	0x30, 0xba, 0x93, 0xf7, 0xb9, 0x0a, 0x39, 0x95, 0x9b, 0x54, 0xee, 0xcb, 0xa4, 0x16, 0xe3, 0x53,
+	0xd6, 0x9d, 0x32, 0x36, 0x6f, 0x7b, 0xe4, 0xf4, 0xe6, 0x6d, 0x97, 0x03, 0x17, 0x89, 0x66, 0x61,
+	0xed, 0x4f, 0xad, 0xee, 0x5a, 0xf8, 0x23, 0x2b, 0xb3, 0xf0, 0x5c, 0x8e, 0x03, 0x0a, 0x6a, 0xe1,
+	0x4d, 0xb1, 0x7d, 0x65, 0x93, 0x49, 0xfb, 0xb0, 0xdf, 0xed, 0x8b, 0xf7, 0x59, 0x73, 0x0a, 0x5e,
+	0xd7, 0x8b, 0xe9, 0x51, 0x0f, 0xa7, 0xb9, 0x95, 0xb5, 0x3a, 0xec, 0xca, 0xea, 0xfd, 0x6c, 0x85,
+	0x9c, 0xb0, 0x60, 0xdc, 0xdd, 0x0e, 0x19, 0xa7, 0x1d, 0xe6, 0x59, 0x20, 0x77, 0xdf, 0xa3, 0xe6,
+	0xc5, 0x53, 0xeb, 0xe4, 0x25, 0xd1, 0x2e, 0x28, 0x09, 0xf7, 0x87, 0x3f, 0xe6, 0x53, 0x64, 0x4a,
+	0x76, 0xe8, 0xfd, 0x7e, 0xb7, 0x93, 0x1d, 0xbe, 0x4b, 0x06, 0x0d, 0x2c, 0x4e, 0xef, 0x17, 0xab,
+	0xa4, 0xce, 0x5d, 0x31, 0x5a, 0xea, 0x63, 0x50, 0x2e, 0x55, 0xdf, 0xa6, 0x93, 0x2d, 0xf0, 0x81,
+	0xdc, 0x3c, 0x6a, 0x8e, 0xe1, 0x62, 0x41, 0x43, 0x45, 0x35, 0xfc, 0x60, 0x26, 0xaa, 0x81, 0x1f,
+	0xd5, 0xdb, 0xc7, 0xd4, 0xa3, 0xc3, 0x87, 0x39, 0xdc, 0xcb, 0xd0, 0x84, 0x1f, 0xab, 0x90, 0x99,
+	0x4c, 0x02, 0xe7, 0x6c, 0xfe, 0x1c, 0xa7, 0xfc, 0xfc, 0x39, 0x99, 0xb4, 0xaf, 0x87, 0x4b, 0x0e,
+	0x77, 0x8f, 0x3e, 0x15, 0xef, 0x37, 0x2b, 0x64, 0xda, 0xce, 0x3c, 0x7d, 0x1f, 0x8e, 0xd4, 0x57,
+	0x90, 0x09, 0x96, 0x7f, 0xf3, 0x2a, 0xdd, 0x93, 0xf7, 0xa1, 0x3c, 0xd5, 0xa1, 0x2c, 0x04, 0x4d,
+	0xbf, 0x2f, 0x72, 0xee, 0x79, 0x7f, 0xe6, 0x90, 0x0b, 0x7a, 0x37, 0xbd, 0xc7, 0xee, 0xa6, 0x6e,
+	0xa7, 0x44, 0xbf, 0x84, 0x53, 0x07, 0xee, 0x8a, 0x7f, 0xcb, 0x21, 0x67, 0xf8, 0x1b, 0xce, 0x7e,
+	0x83, 0xdf, 0x55, 0x34, 0xb3, 0x5e, 0x28, 0xf7, 0xe5, 0x64, 0x12, 0xa4, 0x1c, 0x98, 0xc5, 0xea,
+	0x77, 0x1c, 0x72, 0x5a, 0xf4, 0xd6, 0xfe, 0x0c, 0xee, 0xc3, 0xce, 0x1e, 0xea, 0x43, 0xf0, 0x7e,
+	0xab, 0x42, 0x26, 0xd7, 0x16, 0x96, 0xd5, 0xf6, 0x85, 0x4e, 0x8e, 0x31, 0xf5, 0xb5, 0xe9, 0xcb,
+	0x74, 0x72, 0x94, 0x04, 0xd0, 0x3c, 0x78, 0x82, 0xe4, 0x4e, 0xc2, 0x49, 0xf6, 0x04, 0xc9, 0x7d,
+	0x88, 0x13, 0x90, 0x74, 0xb4, 0xcc, 0x31, 0x10, 0x06, 0x74, 0xdc, 0xad, 0xda, 0xb7, 0x97, 0x0c,
+	0xa4, 0x01, 0x2f, 0x7d, 0x15, 0x07, 0x36, 0xdc, 0x8a, 0x9a, 0x09, 0x32, 0x67, 0xac, 0x51, 0x8b,
+	0x58, 0x8c, 0x17, 0xc4, 0x82, 0x8e, 0x9d, 0xe6, 0x1f, 0x0f, 0x32, 0xd7, 0xec, 0x4e, 0x73, 0xd3,
+	0x0e, 0xb2, 0x6b, 0x9e, 0xc3, 0x00, 0x69, 0x67, 0xa2, 0x8b, 0xc7, 0x86, 0x8b, 0x2e, 0xf6, 0xfe,
+	0xeb, 0x08, 0x99, 0xd0, 0x06, 0xc5, 0x40, 0xa0, 0x1f, 0x95, 0x92, 0x80, 0x07, 0xa3, 0xcc, 0x54,
+	0xd3, 0xdc, 0xe7, 0xc3, 0x00, 0x3f, 0xfa, 0x56, 0x07, 0xdd, 0x28, 0x82, 0x34, 0xf0, 0x99, 0x5d,
+	0xb4, 0x5e, 0x29, 0x23, 0xe2, 0x48, 0x89, 0x5b, 0xe6, 0x2d, 0x47, 0xb1, 0xe9, 0x98, 0xa1, 0x84,
+	0x81, 0x29, 0xd9, 0xfd, 0xb0, 0x08, 0x66, 0xad, 0x96, 0x86, 0xc8, 0x36, 0x9e, 0x89, 0x60, 0xed,
+	0xe1, 0xf9, 0x22, 0x8d, 0x4b, 0x02, 0x32, 0x04, 0x6c, 0x4a, 0x25, 0x82, 0x53, 0x0b, 0x2d, 0x2b,
+	0x06, 0x2e, 0xc8, 0xfd, 0x3c, 0x26, 0x4f, 0xcc, 0x42, 0x2d, 0x94, 0xe3, 0x0c, 0xa8, 0xc6, 0x38,
+	0x07, 0xe5, 0xc0, 0x2f, 0x97, 0x72, 0xc5, 0x90, 0xef, 0x89, 0xf7, 0xeb, 0x0e, 0x39, 0x3b, 0xb8,
+	0x21, 0x5c, 0x1a, 0xa4, 0x77, 0x80, 0xbc, 0xb8, 0x3a, 0xc1, 0x21, 0xbb, 0x44, 0x21, 0x68, 0x3a,
+	0x7e, 0x55, 0x02, 0x60, 0x6a, 0x79, 0x31, 0x6b, 0xe2, 0xbb, 0x22, 0x09, 0xa0, 0x79, 0xd8, 0x52,
+	0xc0, 0xdd, 0x47, 0x85, 0xf9, 0x5e, 0x2f, 0x05, 0xbc, 0x18, 0x24, 0xfd, 0x10, 0xa6, 0x66, 0x2f,
+	0x21, 0x6e, 0x7e, 0xfa, 0x1d, 0x32, 0x18, 0x12, 0x23, 0x46, 0xe5, 0x60, 0x08, 0x57, 0x15, 0x1d,
+	0x31, 0xaa, 0x46, 0x49, 0xf3, 0x78, 0x7f, 0x56, 0x23, 0x19, 0xf8, 0x27, 0xf7, 0x16, 0x99, 0x50,
+	0x00, 0x50, 0xe5, 0x60, 0x1d, 0xe8, 0x8f, 0x58, 0x75, 0x46, 0x15, 0x81, 0x16, 0xe6, 0xc6, 0xd2,
+	0xaa, 0xcf, 0x17, 0xd8, 0xe7, 0xb3, 0x56, 0xfd, 0xab, 0x87, 0xbe, 0xef, 0xc5, 0x95, 0xe2, 0x22,
+	0x87, 0x52, 0x9e, 0x3d, 0xf0, 0x2e, 0xa0, 0x7a, 0xc0, 0x5d, 0xc0, 0x37, 0x89, 0xd4, 0xc1, 0x40,
+	0x93, 0x7e, 0x27, 0x15, 0xdf, 0xe2, 0x33, 0x25, 0xae, 0x71, 0xbc, 0x61, 0x0d, 0x50, 0xc9, 0x7f,
+	0x83, 0x21, 0xd4, 0xbe, 0xb1, 0x19, 0x3d, 0xd6, 0x1b, 0x9b, 0xb1, 0x52, 0x6f, 0x6c, 0x9e, 0x24,
+	0x84, 0xad, 0x2c, 0x3c, 0x7e, 0x6b, 0x9c, 0x7d, 0x36, 0x6a, 0x83, 0x07, 0x45, 0x01, 0x83, 0xcb,
+	0x7d, 0x01, 0x33, 0x90, 0x6d, 0xd3, 0x56, 0x9f, 0x83, 0xac, 0x4d, 0x1c, 0xba, 0x43, 0x3c, 0xb3,
+	0x98, 0x6e, 0x02, 0xcc, 0xf6, 0xbc, 0xaf, 0x24, 0x36, 0x80, 0x2b, 0x02, 0x05, 0x70, 0xbc, 0x58,
+	0xbe, 0x62, 0x30, 0xa0, 0x00, 0x0b, 0xda, 0xf5, 0x67, 0x1c, 0x62, 0xa2, 0xcc, 0xba, 0x2f, 0x71,
+	0x38, 0x5b, 0xa7, 0x8c, 0xab, 0x53, 0xa3, 0xdd, 0xd9, 0x55, 0xbf, 0x97, 0x71, 0x38, 0x94, 0x98,
+	0xb6, 0xe8, 0x66, 0x27, 0xa9, 0x87, 0x3a, 0x05, 0x7e, 0x8c, 0x3c, 0x20, 0x81, 0x8e, 0xa4, 0x06,
+	0x2e, 0x3c, 0x6b, 0xee, 0x4e, 0x90, 0xd7, 0x7f, 0xab, 0x90, 0x0b, 0xd9, 0x0e, 0x24, 0xab, 0x51,
+	0x18, 0xa4, 0x51, 0xdc, 0xa0, 0x69, 0x1a, 0x84, 0x6d, 0x96, 0x75, 0xe0, 0xa6, 0x1f, 0xcb, 0x2c,
+	0xa0, 0x6c, 0x17, 0xbc, 0xe1, 0xc7, 0x21, 0xb0, 0x52, 0x74, 0xc4, 0xe6, 0x31, 0x2c, 0xe2, 0x78,
+	0x7f, 0xc4, 0x4f, 0xaf, 0x60, 0x38, 0xb4, 0x7d, 0x81, 0xc7, 0xcf, 0x80, 0x10, 0xe8, 0x7e, 0x15,
+	0x19, 0xe9, 0x46, 0x2a, 0xef, 0xcd, 0x9b, 0xe4, 0xf3, 0xad, 0x46, 0x2d, 0x76, 0xdb, 0x58, 0xf0,
+	0x60, 0x78, 0x11, 0x82, 0x55, 0xdc, 0x8f, 0x92, 0x5a, 0x2f, 0xee, 0x87, 0x12, 0x53, 0xe4, 0xf9,
+	0x72, 0x3b, 0x9d, 0xac, 0x63, 0xdb, 0x72, 0x00, 0xf9, 0x94, 0x65, 0x45, 0xc0, 0xa5, 0xa2, 0x37,
+	0xd5, 0xb9, 0xfd, 0x2b, 0xa1, 0x05, 0xb1, 0x1d, 0xfb, 0x4d, 0xba, 0x4e, 0xe3, 0x20, 0x6a, 0x49,
+	0xef, 0x39, 0xc7, 0x86, 0x60, 0x58, 0xca, 0x71, 0x40, 0x41, 0x2d, 0x77, 0x97, 0xd4, 0xfc, 0x4e,
+	0x27, 0xba, 0x79, 0x7c, 0xaf, 0x48, 0x4d, 0xd1, 0x39, 0x94, 0x03, 0x5c, 0x9c, 0xb8, 0xa7, 0x85,
+	0x7e, 0x28, 0xae, 0xa4, 0xcc, 0x7b, 0x5a, 0xe8, 0x87, 0x20, 0xa8, 0xde, 0x6f, 0x57, 0x88, 0xbb,
+	0xb6, 0x4b, 0xe3, 0x38, 0x68, 0x19, 0xe1, 0x53, 0x08, 0xc3, 0xf6, 0x62, 0x63, 0xed, 0xda, 0x7a,
+	0x14, 0x84, 0x0c, 0x99, 0xdb, 0x80, 0x61, 0x7b, 0xda, 0x28, 0x07, 0x8b, 0x0b, 0xdd, 0x64, 0x5e,
+	0x7c, 0x09, 0x0d, 0x95, 0x97, 0x6e, 0xc9, 0x40, 0x71, 0x79, 0x10, 0x61, 0x9a, 0xcc, 0xd3, 0xcf,
+	0x64, 0x88, 0x90, 0xe7, 0x77, 0xd7, 0xc8, 0x99, 0x2e, 0x37, 0x34, 0xf1, 0xf4, 0xe7, 0xdc, 0xea,
+	0xa4, 0x60, 0x78, 0x1e, 0x46, 0x30, 0xf6, 0xd5, 0x22, 0x06, 0x28, 0xae, 0xe7, 0xbe, 0x97, 0x4c,
+	0x37, 0x69, 0xc7, 0xec, 0xd2, 0x08, 0x6b, 0x89, 0x41, 0x3d, 0x2e, 0x5c, 0x5a, 0x31, 0xfb, 0x93,
+	0xe1, 0x64, 0xa1, 0x05, 0xb4, 0xa3, 0x40, 0x2f, 0xeb, 0x35, 0xdb, 0x56, 0xb8, 0x70, 0x69, 0x45,
+	0xd1, 0xc0, 0xe2, 0xf4, 0xde, 0x4d, 0x5c, 0x1e, 0xbc, 0xb0, 0x50, 0x14, 0x70, 0x30, 0xd0, 0xfc,
+	0xeb, 0x7d, 0xbe, 0x46, 0x66, 0x32, 0x29, 0x06, 0xd1, 0xb4, 0x98, 0x8f, 0x70, 0x38, 0xb2, 0x6e,
+	0x9f, 0xef, 0xde, 0x50, 0x31, 0x13, 0x21, 0xa9, 0x05, 0x61, 0xaf, 0x9f, 0x96, 0x83, 0x77, 0xc6,
+	0x3b, 0xb1, 0x8c, 0x0d, 0x1a, 0xf7, 0xb5, 0xf8, 0x13, 0xb8, 0x98, 0x32, 0x23, 0x30, 0x2c, 0xe3,
+	0xcf, 0xc8, 0x3d, 0x32, 0x3f, 0x7f, 0x93, 0x8e, 0x87, 0xa8, 0x95, 0x71, 0xb7, 0x96, 0x99, 0x2c,
+	0xc7, 0xed, 0x8d, 0xfa, 0x93, 0x15, 0x32, 0x69, 0xbc, 0x34, 0xf7, 0x87, 0x6c, 0x08, 0x7f, 0xa7,
+	0xbc, 0x47, 0x62, 0xed, 0xcf, 0x6a, 0x90, 0x7e, 0xfe, 0x48, 0x4f, 0xe4, 0xd1, 0xfb, 0x5f, 0xbb,
+	0x7d, 0xfe, 0x64, 0x06, 0x9f, 0xdf, 0x42, 0xf4, 0x3f, 0xfb, 0x51, 0x32, 0x93, 0x69, 0xa6, 0xe0,
+	0x91, 0x37, 0xcc, 0x47, 0x3e, 0xf2, 0x35, 0x88, 0x39, 0x64, 0xbf, 0x55, 0x23, 0x75, 0x01, 0x79,
+	0xa4, 0xac, 0x7e, 0x2a, 0x43, 0x76, 0xd9, 0xd7, 0x06, 0x83, 0x04, 0xdd, 0xbb, 0x6b, 0x83, 0x81,
+	0x3d, 0xba, 0x03, 0x74, 0xa4, 0x0f, 0x6a, 0x1f, 0xc4, 0x67, 0xfa, 0x51, 0xea, 0x0b, 0x13, 0xee,
+	0x9b, 0x8a, 0x3c, 0xff, 0xc0, 0x64, 0x64, 0x59, 0xb9, 0x4f, 0x99, 0x6e, 0x85, 0xac, 0x18, 0xec,
+	0xe6, 0x5c, 0x20, 0xa4, 0xc3, 0x70, 0xdb, 0xfd, 0xb0, 0x2d, 0x97, 0x88, 0x42, 0xb7, 0xc2, 0x15,
+	0xc5, 0xc5, 0x5a, 0x66, 0x9a, 0xbe, 0x2e, 0x03, 0xa3, 0x15, 0xf7, 0x79, 0x52, 0x6f, 0xd1, 0x2d,
+	0xbf, 0xdf, 0x49, 0x17, 0x69, 0xb8, 0x77, 0x8d, 0xa6, 0x37, 0xa3, 0x78, 0x47, 0x60, 0xf9, 0xf3,
+	0x94, 0x7e, 0x17, 0xc4, 0x73, 0xd7, 0x17, 0x07, 0xf0, 0xc1, 0xc0, 0x16, 0xee, 0xe5, 0x45, 0xca,
+	0xf7, 0x38, 0xe4, 0x94, 0x78, 0xb9, 0x6c, 0xf4, 0x9e, 0x65, 0xa7, 0xc2, 0xa7, 0xc8, 0x94, 0x31,
+	0x0d, 0xa4, 0xde, 0xa4, 0x76, 0x16, 0x13, 0x9a, 0x02, 0x2c, 0x4e, 0x84, 0x1d, 0x13, 0x3b, 0xb8,
+	0x86, 0x1d, 0xe3, 0xe6, 0x07, 0x05, 0x3b, 0xb6, 0x9a, 0xa1, 0x43, 0xae, 0x86, 0xf7, 0x3b, 0x15,
+	0x72, 0xc2, 0xec, 0x55, 0x82, 0xe1, 0x03, 0x08, 0x60, 0x99, 0xef, 0x94, 0x0a, 0x1f, 0x58, 0xb5,
+	0xc9, 0x90, 0xe5, 0x77, 0x37, 0xc9, 0xd9, 0xae, 0x7f, 0x4b, 0xeb, 0x8b, 0x34, 0x9e, 0xcb, 0x20,
+	0x7f, 0x56, 0x75, 0xfa, 0xf9, 0xd5, 0x81, 0x9c, 0xb0, 0x4f, 0x2b, 0x08, 0x3c, 0xdc, 0xf5, 0x6f,
+	0x65, 0x9f, 0x50, 0x6c, 0x74, 0x0a, 0x78, 0x78, 0x35, 0xcf, 0x02, 0x45, 0xf5, 0xd0, 0xc1, 0x92,
+	0x17, 0x07, 0x5b, 0x34, 0x49, 0x1b, 0x18, 0x5b, 0xba, 0x97, 0x8a, 0xdc, 0x87, 0x86, 0x83, 0xe5,
+	0x6a, 0x01, 0x0f, 0x14, 0xd6, 0xf4, 0xfe, 0x17, 0x2e, 0xfd, 0x7c, 0x64, 0x21, 0xea, 0xd0, 0x21,
+	0xee, 0xb2, 0x33, 0x36, 0xd4, 0xca, 0x90, 0x08, 0x8d, 0x6f, 0x21, 0xe3, 0x3d, 0x9c, 0xdd, 0x81,
+	0xca, 0x64, 0xc6, 0x30, 0x21, 0xd7, 0x45, 0x19, 0x28, 0xaa, 0x7b, 0x93, 0x4c, 0xbc, 0x78, 0x33,
+	0xe5, 0x6e, 0x64, 0xf5, 0x91, 0x52, 0xbd, 0xc7, 0x94, 0x69, 0x40, 0x96, 0x24, 0xa0, 0x65, 0x21,
+	0x96, 0x29, 0x3b, 0x0b, 0x4a, 0xdc, 0x14, 0xe6, 0x46, 0xc3, 0x0e, 0x89, 0x09, 0x08, 0x8a, 0x74,
+	0x71, 0x0d, 0x62, 0xba, 0x14, 0xfb, 0x61, 0x3a, 0xd7, 0xc3, 0xc0, 0x33, 0xbf, 0x23, 0x42, 0xd4,
+	0x2c, 0x17, 0xd7, 0x2c, 0x0f, 0x14, 0xd6, 0xf4, 0x7e, 0xb9, 0x4a, 0x4e, 0x1a, 0x6f, 0x80, 0x11,
+	0x85, 0x9b, 0x9d, 0x53, 0xe4, 0x66, 0x87, 0xaf, 0x28, 0x8e, 0x3a, 0x39, 0x8f, 0x0f, 0xac, 0x0c,
+	0x8c, 0x72, 0x88, 0xb1, 0xd6, 0x8f, 0x3c, 0x32, 0xf0, 0x91, 0x4d, 0x40, 0xd8, 0xda, 0x81, 0x80,
+	0xb0, 0x4f, 0x90, 0xd1, 0x98, 0xfa, 0x89, 0x32, 0xc6, 0xab, 0x6d, 0x09, 0x58, 0x29, 0x08, 0x2a,
+	0xcf, 0xf7, 0xa9, 0x3c, 0x7e, 0xb3, 0xa6, 0x78, 0xc3, 0x45, 0x18, 0x4c, 0x3e, 0xab, 0xda, 0x9c,
+	0xb4, 0xb1, 0xe4, 0xab, 0xa1, 0x19, 0xc4, 0xe0, 0xe3, 0x21, 0x8c, 0x38, 0xe0, 0x4c, 0xd8, 0x44,
+	0x26, 0x84, 0x51, 0x51, 0xc0, 0xe0, 0x42, 0x3b, 0x23, 0x95, 0xce, 0x8f, 0x75, 0x62, 0x9b, 0x4c,
+	0xb5, 0x57, 0xa4, 0xe6, 0xf1, 0x3e, 0xa1, 0xd7, 0x4e, 0xe8, 0x77, 0xe8, 0x5a, 0x1c, 0x88, 0xb4,
+	0xbd, 0x43, 0xb9, 0x04, 0xc6, 0xfd, 0x82, 0x77, 0xda, 0x67, 0xef, 0xb4, 0xdf, 0xa1, 0x87, 0x48,
+	0x2d, 0xe3, 0xfd, 0x8b, 0x49, 0x72, 0xba, 0x28, 0xa7, 0xba, 0xfb, 0x11, 0x32, 0xca, 0xbf, 0xa3,
+	0xba, 0x53, 0x86, 0x91, 0xbb, 0x48, 0xc6, 0x12, 0x6b, 0x50, 0xcc, 0x23, 0xf6, 0x3f, 0x08, 0x99,
+	0x42, 0x7a, 0xc7, 0xdf, 0xac, 0x57, 0x8e, 0x51, 0xfa, 0x8a, 0xaf, 0xa5, 0xaf, 0xf8, 0x5c, 0x7a,
+	0xc7, 0xdf, 0x74, 0x6f, 0x91, 0x5a, 0x3b, 0x48, 0xa9, 0xd4, 0x2e, 0x6e, 0x1c, 0x8b, 0x70, 0xea,
+	0x73, 0xeb, 0x04, 0xfb, 0x17, 0xb8, 0x40, 0x04, 0x49, 0x99, 0xd9, 0xb4, 0xe1, 0x8b, 0x85, 0x16,
+	0xe2, 0x97, 0xdf, 0x89, 0x0c, 0x4e, 0xf2, 0xfc, 0x03, 0xb8, 0x15, 0x66, 0x0a, 0x21, 0xdb, 0x1d,
+	0xf7, 0xe3, 0x0e, 0x19, 0xdb, 0x62, 0x29, 0x85, 0xe5, 0x01, 0xe6, 0x18, 0x5e, 0x0e, 0xcf, 0x59,
+	0xac, 0xe7, 0x2d, 0xff, 0x9d, 0x80, 0x94, 0x3c, 0xe8, 0x54, 0x38, 0x7a, 0xd4, 0x53, 0xe1, 0xd8,
+	0x3d, 0x3a, 0x15, 0x7e, 0xd2, 0x21, 0x13, 0x6a, 0xa4, 0x05, 0x0c, 0xec, 0x07, 0x8e, 0xf1, 0x95,
+	0xf3, 0xeb, 0x1f, 0xf5, 0x13, 0xb4, 0x70, 0x44, 0x6c, 0x9b, 0xf4, 0x5f, 0xee, 0xc7, 0xb4, 0x45,
+	0x77, 0xa3, 0x5e, 0x22, 0xcc, 0xcc, 0x2f, 0x94, 0xdf, 0x99, 0x39, 0x14, 0xb2, 0x48, 0x77, 0xd7,
+	0x7a, 0x89, 0xc0, 0x1d, 0xd3, 0x05, 0x60, 0x76, 0x01, 0xf3, 0x9c, 0xc8, 0x33, 0x33, 0x29, 0x23,
+	0x47, 0x5c, 0x51, 0x6f, 0x86, 0x82, 0xd1, 0xa3, 0xe4, 0x91, 0x66, 0x14, 0xa6, 0x41, 0xd8, 0xa7,
+	0x6b, 0x21, 0xd0, 0x5e, 0x74, 0x2d, 0x4a, 0x2f, 0x47, 0xfd, 0xb0, 0x75, 0x29, 0x8e, 0xa3, 0x98,
+	0xe1, 0xdc, 0x8e, 0xcf, 0x3f, 0x2e, 0x2a, 0x3f, 0xb2, 0x30, 0x98, 0x15, 0xf6, 0x6b, 0xe7, 0x28,
+	0xe7, 0xf3, 0xdb, 0x15, 0x72, 0xfe, 0x80, 0xc1, 0x46, 0x15, 0x3d, 0x8a, 0xdb, 0x7e, 0x18, 0xbc,
+	0x6c, 0x42, 0xb7, 0x2b, 0x15, 0x7d, 0xcd, 0xa0, 0x81, 0xc5, 0x69, 0xee, 0x2c, 0x95, 0x03, 0x30,
+	0x7d, 0x71, 0x9b, 0xa2, 0xbd, 0x28, 0x6b, 0x02, 0xc7, 0x87, 0x05, 0x46, 0x41, 0x28, 0x1d, 0xbf,
+	0x17, 0x88, 0x7b, 0x40, 0x65, 0xd9, 0x9f, 0x5b, 0x5f, 0x06, 0x2c, 0xb7, 0x20, 0xc6, 0x6b, 0x77,
+	0x05, 0x62, 0x1c, 0x55, 0x1c, 0x71, 0x16, 0x1f, 0xd5, 0x2a, 0x8e, 0x7d, 0x46, 0xf6, 0x3e, 0x57,
+	0x25, 0x8f, 0xed, 0xfb, 0x69, 0xe9, 0x08, 0x5a, 0x67, 0x9f, 0x08, 0x5a, 0x39, 0x3c, 0x95, 0x83,
+	0x86, 0xa7, 0x3a, 0x60, 0x78, 0x3e, 0x8e, 0x2b, 0x86, 0x84, 0xbc, 0x17, 0x9b, 0xc4, 0x11, 0xa3,
+	0x9a, 0x07, 0x21, 0xe8, 0x8b, 0xc5, 0x42, 0x52, 0x41, 0xcb, 0x45, 0xf3, 0x85, 0x05, 0x20, 0x5b,
+	0x2b, 0x63, 0xc7, 0x1c, 0x08, 0x3b, 0xcf, 0x97, 0x89, 0x41, 0xa8, 0xb4, 0xde, 0xcf, 0x8d, 0x90,
+	0xc7, 0x87, 0xd8, 0xe8, 0xcc, 0x59, 0xec, 0x0c, 0x39, 0x8b, 0xbf, 0xc4, 0x5f, 0xd3, 0x27, 0x0a,
+	0x5f, 0x13, 0x94, 0xff, 0x9a, 0xf6, 0x7f, 0x43, 0xcc, 0x13, 0x28, 0x4c, 0x68, 0xb3, 0x1f, 0x53,
+	0x71, 0x0c, 0xd2, 0x9e, 0x40, 0xa2, 0x1c, 0x14, 0x07, 0x9a, 0x9a, 0x9b, 0x3e, 0x7e, 0xfe, 0x63,
+	0x25, 0x01, 0x86, 0x9a, 0x30, 0x60, 0x5c, 0xfb, 0x5a, 0x98, 0xc3, 0x15, 0x80, 0x8b, 0xc1, 0x2c,
+	0x12, 0x67, 0x07, 0x6b, 0x23, 0x08, 0x98, 0xb9, 0xc9, 0x02, 0xba, 0x56, 0x59, 0xd8, 0x86, 0x98,
+	0x3a, 0xec, 0x79, 0x75, 0x31, 0x98, 0x3c, 0x78, 0x23, 0x62, 0x46, 0x82, 0xad, 0x1a, 0xf1, 0x1e,
+	0xec, 0x46, 0x64, 0x23, 0x4b, 0x84, 0x3c, 0x3f, 0x02, 0xd8, 0xa7, 0x41, 0xda, 0xa1, 0xbc, 0x36,
+	0x9f, 0x68, 0xcc, 0xe0, 0xb4, 0xa1, 0x4a, 0xc1, 0xe0, 0xf0, 0xbe, 0x58, 0x2d, 0x7e, 0x0c, 0xae,
+	0xe5, 0x1e, 0x66, 0xf6, 0x8b, 0xb9, 0x5d, 0x19, 0x62, 0x85, 0xae, 0xde, 0xed, 0x15, 0x7a, 0x64,
+	0xd0, 0x0a, 0x8d, 0x76, 0xa4, 0x9e, 0x7e, 0x7c, 0x0e, 0x39, 0xcb, 0x0f, 0xa3, 0xca, 0x8e, 0xb4,
+	0x9e, 0xa1, 0x43, 0xae, 0xc6, 0x7d, 0x3e, 0x55, 0x7f, 0xa9, 0x42, 0x1e, 0x1e, 0x78, 0xb0, 0xb8,
+	0x4b, 0x3b, 0x90, 0xf9, 0xfa, 0x47, 0xee, 0xce, 0xeb, 0x37, 0x5f, 0x4a, 0xed, 0xc0, 0x97, 0x32,
+	0xcc, 0x76, 0xfe, 0xdb, 0x95, 0x81, 0x1f, 0x0b, 0x1e, 0x44, 0xff, 0xd2, 0x8e, 0xe4, 0x57, 0x93,
+	0x13, 0x7e, 0xaf, 0xc7, 0xf9, 0x58, 0x74, 0x78, 0x26, 0xa5, 0xc6, 0x9c, 0x49, 0x04, 0x9b, 0x77,
+	0xa8, 0x81, 0xfd, 0x03, 0x87, 0x4c, 0x00, 0xdd, 0xe2, 0x2b, 0x1c, 0xa6, 0x81, 0x64, 0x43, 0xe4,
+	0x94, 0x91, 0x06, 0x12, 0x07, 0x36, 0x09, 0x18, 0x4c, 0x5d, 0xd1, 0x60, 0x1f, 0x15, 0x85, 0xf0,
+	0x71, 0x52, 0x6b, 0x6e, 0xfb, 0x71, 0x9a, 0x45, 0x40, 0x61, 0xc9, 0x67, 0x80, 0xd3, 0xbc, 0x3f,
+	0x9f, 0xc2, 0xc7, 0xeb, 0x45, 0x0b, 0x31, 0x6d, 0x25, 0xf8, 0x7e, 0xfb, 0x71, 0xa7, 0xee, 0xd8,
+	0xef, 0x17, 0x9d, 0x4f, 0xb1, 0xdc, 0x72, 0x5a, 0xab, 0x1c, 0x0a, 0xc1, 0xbf, 0x7a, 0x20, 0x82,
+	0x3f, 0xa2, 0x59, 0x27, 0xdb, 0xeb, 0x71, 0xb0, 0xeb, 0xa7, 0xe8, 0x10, 0x50, 0x1f, 0xb1, 0x5f,
+	0x64, 0xa3, 0x71, 0x45, 0x13, 0xc1, 0xe6, 0x45, 0x30, 0x69, 0x8d, 0xa3, 0x4f, 0xe3, 0x94, 0x21,
+	0xb0, 0xf0, 0x99, 0xa0, 0xa0, 0x53, 0x35, 0xf2, 0xbe, 0x60, 0x80, 0x7c, 0x1d, 0x5c, 0x73, 0xad,
+	0x42, 0xec, 0xc8, 0xa8, 0xbd, 0xe6, 0x5a, 0xed, 0x60, 0x5f, 0x72, 0x35, 0xd0, 0x04, 0xce, 0x27,
+	0xc6, 0x5c, 0xaf, 0x67, 0x3c, 0xd1, 0x98, 0x9d, 0x7b, 0x6f, 0x29, 0xcf, 0x02, 0x45, 0xf5, 0xd0,
+	0x00, 0xa8, 0x8a, 0x97, 0x17, 0xb3, 0x06, 0x40, 0xd5, 0xcc, 0x72, 0x0b, 0x4c, 0x3e, 0xcc, 0x94,
+	0xaf, 0x7f, 0x72, 0xc0, 0x31, 0xee, 0x84, 0xb8, 0x28, 0x32, 0xa6, 0xa8, 0x4c, 0xf9, 0x4b, 0x85,
+	0x6c, 0x2d, 0x18, 0x54, 0x1f, 0xef, 0x11, 0x14, 0xe9, 0x52, 0x98, 0x32, 0xcc, 0x9d, 0x84, 0xce,
+	0xfb, 0x09, 0xf3, 0x60, 0x26, 0xec, 0x39, 0xd5, 0x3d, 0xc2, 0x52, 0x90, 0x5e, 0x29, 0xe2, 0x84,
+	0x15, 0xd8, 0xa7, 0x15, 0x66, 0x8b, 0x0c, 0xfd, 0xcd, 0x0e, 0x5d, 0x5b, 0x58, 0x16, 0x27, 0x52,
+	0x6d, 0x8b, 0x94, 0x04, 0xd0, 0x3c, 0x2a, 0xc6, 0x78, 0x6a, 0x50, 0x8c, 0x31, 0x5a, 0xb2, 0xdb,
+	0xcd, 0x1e, 0x6a, 0x99, 0x41, 0x93, 0xce, 0x35, 0x59, 0x50, 0x23, 0xbe, 0x18, 0x9e, 0x14, 0x51,
+	0x59, 0xb2, 0x97, 0x16, 0xd6, 0x73, 0x3c, 0x50, 0x58, 0x93, 0x05, 0xbf, 0x62, 0x76, 0x80, 0xfa,
+	0x03, 0x99, 0xe0, 0x57, 0x2c, 0x04, 0x4e, 0x43, 0x47, 0x1c, 0x06, 0x58, 0x72, 0x25, 0x4d, 0x7b,
+	0x4a, 0xad, 0xad, 0x9f, 0xb6, 0x11, 0xde, 0x2e, 0xe7, 0x38, 0xa0, 0xa0, 0x16, 0x6a, 0x3d, 0x61,
+	0xc4, 0x5a, 0xaf, 0x3f, 0x64, 0x6b, 0x3d, 0xd7, 0x78, 0x31, 0x48, 0x3a, 0x5e, 0xd8, 0xf5, 0x13,
+	0xca, 0x0e, 0xcc, 0x37, 0xa2, 0x78, 0xa7, 0x13, 0xf9, 0xad, 0xe5, 0x16, 0x0d, 0x53, 0x04, 0x96,
+	0xa8, 0xdb, 0x17, 0x76, 0xcf, 0x0e, 0xe0, 0x83, 0x81, 0x2d, 0x64, 0x33, 0x6e, 0x3c, 0x3c, 0x64,
+	0xc6, 0x8d, 0x75, 0x72, 0x5a, 0xee, 0x6b, 0x6b, 0x0b, 0xcb, 0xea, 0xa1, 0xeb, 0x67, 0xed, 0xcb,
+	0x84, 0xe5, 0x02, 0x1e, 0x28, 0xac, 0xe9, 0xee, 0x90, 0xc7, 0x98, 0x8d, 0x45, 0xbc, 0x9c, 0xf5,
+	0x38, 0x08, 0x9b, 0x41, 0xcf, 0xef, 0xf0, 0x4f, 0x72, 0xb9, 0x55, 0x7f, 0xcc, 0x72, 0xee, 0x7a,
+	0x6c, 0x6e, 0x3f, 0x66, 0xd8, 0xbf, 0x2d, 0xf7, 0x26, 0x79, 0xe3, 0x3e, 0x0c, 0x7c, 0x6b, 0xa9,
+	0x9f, 0x63, 0x02, 0xbf, 0x5c, 0x08, 0x7c, 0xe3, 0xdc, 0x41, 0x15, 0xe0, 0xe0, 0x36, 0x07, 0x3e,
+	0xe5, 0x06, 0x0d, 0x7d, 0xf6, 0x94, 0xe7, 0x87, 0x78, 0x4a, 0xc9, 0x0c, 0xfb, 0xb7, 0xe5, 0x6e,
+	0x93, 0x47, 0x19, 0xc3, 0x5c, 0x33, 0x0d, 0x76, 0x35, 0x98, 0xea, 0xa5, 0xb0, 0xd5, 0x8b, 0x82,
+	0x30, 0xad, 0x5f, 0x60, 0xb2, 0xbe, 0x4c, 0xc8, 0x7a, 0x74, 0x6e, 0x1f, 0x5e, 0xd8, 0xb7, 0x25,
+	0xef, 0x5f, 0x39, 0xe4, 0x84, 0xda, 0x7e, 0xee, 0x02, 0x00, 0x56, 0xc7, 0x06, 0xc0, 0x5a, 0x3a,
+	0xfa, 0x06, 0xce, 0x7a, 0x3e, 0x00, 0xa3, 0xe1, 0x3f, 0x9e, 0x22, 0x44, 0x6f, 0xf2, 0x4a, 0xbf,
+	0x72, 0x06, 0xea, 0x57, 0xf7, 0xed, 0x06, 0x5b, 0x94, 0xfe, 0xa2, 0x76, 0x6f, 0xd3, 0x5f, 0x34,
+	0xc8, 0x19, 0xb9, 0x1e, 0x70, 0x8f, 0x3f, 0x04, 0x0e, 0x92, 0xfb, 0xf5, 0xf8, 0xfc, 0x63, 0xa2,
+	0xa1, 0x33, 0xcb, 0x45, 0x4c, 0x50, 0x5c, 0xd7, 0x52, 0xcc, 0xc7, 0x0e, 0x54, 0xcc, 0xd5, 0x16,
+	0xb5, 0xb2, 0x95, 0xd4, 0xc7, 0x8b, 0xb6, 0xa8, 0x95, 0xcb, 0x0d, 0xd0, 0x3c, 0xc5, 0x7a, 0xca,
+	0x44, 0x49, 0x7a, 0x0a, 0x39, 0xb4, 0x9e, 0x22, 0x77, 0xcc, 0xc9, 0x81, 0x3b, 0xa6, 0xbc, 0x1b,
+	0x9f, 0x1a, 0x78, 0x37, 0xfe, 0x3e, 0x32, 0x1d, 0x84, 0xdb, 0x34, 0x0e, 0x52, 0xda, 0x62, 0xdf,
+	0x02, 0xdb, 0x4d, 0xc7, 0xb5, 0x96, 0xba, 0x6c, 0x51, 0x21, 0xc3, 0x6d, 0x6f, 0xf3, 0xd3, 0x43,
+	0x6c, 0xf3, 0x03, 0x94, 0xab, 0x99, 0x72, 0x94, 0xab, 0x93, 0x47, 0x57, 0xae, 0x4e, 0x1d, 0xab,
+	0x72, 0xe5, 0x96, 0xa2, 0x5c, 0x0d, 0xa5, 0xb7, 0x18, 0x16, 0x96, 0xd3, 0x07, 0x58, 0x58, 0x06,
+	0x69, 0x56, 0x67, 0xee, 0x58, 0xb3, 0x2a, 0x56, 0x9a, 0x1e, 0x7c, 0x5d, 0x69, 0x2a, 0x45, 0x69,
+	0x7a, 0x9c, 0xd4, 0x5a, 0xb4, 0x97, 0x6e, 0xd7, 0x1f, 0x61, 0x93, 0x55, 0xbd, 0xff, 0x45, 0x2c,
+	0x04, 0x4e, 0x73, 0x53, 0x72, 0xe1, 0x26, 0xdd, 0xdc, 0x8e, 0xa2, 0x1d, 0xe9, 0x44, 0xc3, 0x32,
+	0xf9, 0xdc, 0xf0, 0xe3, 0xae, 0x48, 0xaf, 0xd5, 0xaa, 0x3f, 0xca, 0xba, 0xf0, 0x16, 0x51, 0xff,
+	0xc2, 0x8d, 0x03, 0xf8, 0xe1, 0xc0, 0x16, 0x5f, 0xd7, 0xe7, 0xbe, 0x94, 0xf5, 0xb9, 0x4f, 0x56,
+	0xc8, 0x19, 0xad, 0xf1, 0xe0, 0x3e, 0x13, 0x6c, 0xe1, 0x9e, 0x4f, 0xd1, 0x1d, 0x85, 0x7b, 0x9c,
+	0x1a, 0x18, 0x7e, 0x1a, 0xc5, 0x50, 0x51, 0xc0, 0xe0, 0x62, 0x50, 0x78, 0x34, 0x66, 0xc9, 0xaa,
+	0xb3, 0xea, 0xd0, 0x82, 0x28, 0x07, 0xc5, 0x81, 0x1f, 0x17, 0xfe, 0x2f, 0x40, 0x59, 0xb3, 0x39,
+	0x00, 0x17, 0x34, 0x09, 0x4c, 0x3e, 0xf4, 0x1c, 0x6a, 0xca, 0xad, 0x18, 0x55, 0xa2, 0x29, 0x6e,
+	0x6b, 0x52, 0xbb, 0xaf, 0xa2, 0xca, 0xee, 0x30, 0xa8, 0xc6, 0x5a, 0xbe, 0x3b, 0x58, 0x0e, 0x8a,
+	0xc3, 0xfb, 0x2f, 0x0e, 0x79, 0xb8, 0x70, 0x28, 0xee, 0x82, 0x9a, 0x7b, 0xcb, 0x56, 0x73, 0x1b,
+	0x65, 0xd9, 0xa9, 0x8c, 0xa7, 0x18, 0xa0, 0xf2, 0xfe, 0x9e, 0x43, 0xa6, 0x35, 0xff, 0x5d, 0x78,
+	0xd4, 0xc0, 0x7e, 0xd4, 0xf2, 0x4c, 0x72, 0x13, 0xb9, 0x67, 0xfb, 0xc5, 0x0a, 0x51, 0xa9, 0x3d,
+	0xe7, 0x9a, 0xe9, 0x70, 0x38, 0x38, 0x98, 0x66, 0xc2, 0x8f, 0xfd, 0x6e, 0x52, 0x4e, 0xe8, 0x8c,
+	0x2d, 0x9f, 0xb9, 0x83, 0xeb, 0x5b, 0x7e, 0xf6, 0x33, 0x01, 0x21, 0x90, 0x79, 0xb1, 0xc9, 0x75,
+	0xba, 0x6a, 0x2b, 0xb3, 0x6a, 0x3d, 0x56, 0x1c, 0xa8, 0x88, 0x05, 0xcd, 0x28, 0x5c, 0xe8, 0xf8,
+	0x49, 0x22, 0xce, 0x06, 0x4a, 0x11, 0x5b, 0x96, 0x04, 0xd0, 0x3c, 0xcc, 0x2b, 0x32, 0x48, 0x7a,
+	0x1d, 0x7f, 0xcf, 0x30, 0xbc, 0x1a, 0xe0, 0xe3, 0x8a, 0x04, 0x26, 0x9f, 0xd7, 0x25, 0x75, 0xfb,
+	0x21, 0x16, 0xe9, 0x16, 0x0b, 0xbb, 0x1e, 0x6a, 0x38, 0x31, 0x12, 0x96, 0xd5, 0x5a, 0xe9, 0xfb,
+	0xf5, 0x8a, 0xdd, 0xcb, 0x39, 0x49, 0x00, 0xcd, 0xe3, 0xbd, 0x87, 0x3c, 0x50, 0x30, 0x66, 0x43,
+	0x44, 0xb0, 0xfc, 0x6c, 0x85, 0xcc, 0xd8, 0x35, 0x59, 0x48, 0x15, 0x6f, 0x79, 0x31, 0x48, 0x9a,
+	0xd1, 0x2e, 0x8d, 0xf7, 0xb0, 0x1b, 0x4e, 0x06, 0x94, 0x29, 0xc7, 0x01, 0x05, 0xb5, 0x58, 0xc6,
+	0xde, 0x96, 0x7a, 0x74, 0x39, 0x3d, 0xae, 0x97, 0x39, 0x3d, 0xf4, 0xc8, 0x1a, 0xef, 0x45, 0x8b,
+	0x04, 0x53, 0x3e, 0xf3, 0xdb, 0xa5, 0x71, 0x9b, 0x22, 0xee, 0x52, 0x1a, 0x84, 0xe2, 0x91, 0xc5,
+	0xc4, 0xd1, 0x7e, 0xbb, 0x79, 0x16, 0x28, 0xaa, 0xe7, 0xfd, 0xd1, 0x08, 0x51, 0xd0, 0xac, 0x2c,
+	0x16, 0xab, 0xa4, 0x90, 0xc4, 0xc3, 0x42, 0x7b, 0xa9, 0x37, 0x3d, 0xb2, 0x9f, 0x7b, 0x2f, 0x37,
+	0x9d, 0x9b, 0x77, 0x6c, 0x6a, 0xc0, 0x36, 0x34, 0x09, 0x4c, 0x3e, 0xec, 0x49, 0x27, 0xd8, 0xa5,
+	0xbc, 0xd2, 0xa8, 0xdd, 0x93, 0x15, 0x49, 0x00, 0xcd, 0x83, 0x3d, 0x69, 0x05, 0x5b, 0x5b, 0xf5,
+	0x31, 0xbb, 0x27, 0x38, 0x3a, 0xc0, 0x28, 0x3c, 0xa7, 0x7b, 0xb4, 0x23, 0xce, 0x92, 0x46, 0x4e,
+	0xf7, 0x68, 0x07, 0x18, 0x05, 0xdf, 0x52, 0x18, 0xc5, 0x5d, 0xbf, 0x13, 0xbc, 0x4c, 0x5b, 0x4a,
+	0x8a, 0x38, 0x43, 0xaa, 0xb7, 0x74, 0x2d, 0xcf, 0x02, 0x45, 0xf5, 0x78, 0xf2, 0x09, 0xda, 0x0a,
+	0x9a, 0xa9, 0xd9, 0x1a, 0xb1, 0x27, 0xf4, 0x7a, 0x8e, 0x03, 0x0a, 0x6a, 0xa1, 0x7f, 0xba, 0x8c,
+	0x42, 0x90, 0x89, 0x33, 0x26, 0x6d, 0x78, 0x7b, 0xb0, 0xc9, 0x90, 0xe5, 0xc7, 0x15, 0xab, 0x2b,
+	0x92, 0x39, 0xd5, 0xa7, 0xec, 0x15, 0x4b, 0x26, 0x79, 0x02, 0xc5, 0xe1, 0xfd, 0x8f, 0x2a, 0xee,
+	0xb0, 0x03, 0x72, 0xa6, 0xdd, 0x3d, 0xe0, 0x19, 0x6b, 0x46, 0x8e, 0x0c, 0x31, 0x23, 0x31, 0x2a,
+	0x31, 0x89, 0x42, 0x15, 0x95, 0x58, 0x1b, 0x18, 0x95, 0x68, 0x70, 0x15, 0x47, 0x25, 0x8e, 0x96,
+	0x15, 0x95, 0x38, 0x56, 0x5a, 0x54, 0xe2, 0xf8, 0x1d, 0x47, 0x25, 0x4e, 0x0c, 0x1d, 0x95, 0xf8,
+	0x4f, 0x6b, 0xe4, 0x41, 0x05, 0xea, 0xcc, 0xe3, 0x48, 0x82, 0xb0, 0xcd, 0xc0, 0x69, 0xbf, 0xe0,
+	0x48, 0x7c, 0xdb, 0x15, 0x33, 0x1c, 0x69, 0xab, 0x9c, 0x75, 0xd5, 0x16, 0x36, 0xbb, 0x61, 0x08,
+	0xe2, 0x1e, 0x77, 0x19, 0x1c, 0x5d, 0x4e, 0x02, 0xab, 0x47, 0xee, 0x47, 0x09, 0x91, 0x57, 0x75,
+	0x5b, 0x72, 0xdd, 0x5f, 0x2e, 0xa7, 0x7f, 0x78, 0x55, 0xaa, 0xb4, 0xea, 0x0d, 0x25, 0x04, 0x0c,
+	0x81, 0xe8, 0xa3, 0x29, 0xaf, 0x3d, 0x39, 0xb4, 0xc9, 0x87, 0x8f, 0x65, 0x6c, 0x86, 0x09, 0xd4,
+	0x02, 0x32, 0x16, 0x84, 0x6d, 0x9c, 0x10, 0x22, 0xea, 0xe1, 0xcd, 0x85, 0x41, 0x4a, 0x91, 0xdf,
+	0x9a, 0xf7, 0x3b, 0x7e, 0xd8, 0xc4, 0x34, 0x37, 0x8c, 0x5d, 0x9f, 0xe0, 0x45, 0x01, 0xc8, 0x86,
+	0xf0, 0xeb, 0xc2, 0x38, 0xb6, 0x38, 0xf4, 0x3b, 0xcf, 0xc2, 0x8a, 0xf5, 0x75, 0x5d, 0x32, 0xca,
+	0xc1, 0xe2, 0x3a, 0xfb, 0x75, 0xe4, 0x54, 0xee, 0x65, 0x1e, 0x2a, 0x0a, 0xe9, 0x08, 0xa8, 0xe7,
+	0x3f, 0x37, 0xaa, 0xb7, 0x4a, 0xc4, 0x79, 0x77, 0x5f, 0x71, 0xd0, 0xe5, 0x5f, 0xbd, 0x51, 0xa1,
+	0x35, 0x97, 0x38, 0x45, 0x8c, 0xe8, 0x01, 0x55, 0x08, 0xa6, 0x48, 0x9c, 0xa3, 0x3d, 0x3f, 0xa6,
+	0xe1, 0x71, 0xcf, 0xd1, 0x75, 0x25, 0x04, 0x0c, 0x81, 0xee, 0xb6, 0x85, 0xbd, 0x73, 0xf9, 0xe8,
+	0xd8, 0x3b, 0x2c, 0x29, 0x8d, 0x5a, 0xbd, 0x0d, 0x0c, 0x9e, 0xcf, 0x3a, 0x64, 0x3a, 0xb4, 0x66,
+	0x6e, 0x39, 0x21, 0xb5, 0xc5, 0x5f, 0x05, 0x5f, 0x17, 0xed, 0x32, 0xc8, 0xc8, 0x2f, 0xda, 0x48,
+	0x6b, 0x87, 0xdc, 0x48, 0x3d, 0x32, 0xca, 0x80, 0xa8, 0x2c, 0xcf, 0x06, 0x06, 0x52, 0x95, 0x80,
+	0xa0, 0xb8, 0x21, 0x19, 0xe5, 0x79, 0x33, 0xea, 0x63, 0x65, 0xa0, 0xb7, 0x9a, 0xc9, 0x37, 0xb8,
+	0x3c, 0x5e, 0x02, 0x42, 0x8a, 0x7b, 0xc3, 0x84, 0xe6, 0x1a, 0x3f, 0x34, 0xe8, 0xc7, 0x89, 0x41,
+	0x10, 0x5e, 0xde, 0xdf, 0xae, 0x91, 0x93, 0x72, 0x44, 0x24, 0x08, 0x00, 0x43, 0xff, 0x61, 0x72,
+	0xb5, 0x86, 0xae, 0xd1, 0x7f, 0x24, 0x01, 0x34, 0x0f, 0x6a, 0x81, 0xfd, 0x04, 0x91, 0xe5, 0xc3,
+	0x95, 0x60, 0x33, 0x11, 0x6e, 0x39, 0xea, 0x43, 0x79, 0x56, 0x93, 0xc0, 0xe4, 0xd3, 0x72, 0x16,
+	0x2e, 0xad, 0xd4, 0xc7, 0x8a, 0xe4, 0x2c, 0x5c, 0x5a, 0x01, 0xcd, 0xc3, 0x50, 0x86, 0x9a, 0x26,
+	0xe2, 0xa9, 0x46, 0x19, 0x6a, 0x0a, 0xe4, 0x60, 0x41, 0x77, 0xbf, 0xbf, 0x30, 0xd7, 0x6c, 0x39,
+	0x88, 0x58, 0x39, 0xb0, 0x84, 0xc3, 0x25, 0x99, 0x75, 0x7f, 0xd4, 0x21, 0x67, 0x78, 0xa9, 0x1c,
+	0xfa, 0x67, 0x7b, 0x2d, 0x3f, 0xa5, 0x49, 0x7d, 0xf4, 0x98, 0xfa, 0xa7, 0x6f, 0x74, 0x8a, 0xc4,
+	0x42, 0x71, 0x6f, 0x10, 0xe8, 0x71, 0x66, 0xc7, 0x42, 0x2c, 0x97, 0x7b, 0xcd, 0x51, 0xe1, 0x7c,
+	0xad, 0x46, 0xf5, 0xb7, 0x69, 0x97, 0x27, 0x90, 0x95, 0x8e, 0x79, 0xac, 0xcd, 0x75, 0xf7, 0xee,
+	0x03, 0x9d, 0x1f, 0x5e, 0x63, 0x95, 0x4a, 0x70, 0x6d, 0xa0, 0x12, 0x8c, 0x9e, 0x43, 0x41, 0xab,
+	0x3e, 0x9a, 0xf1, 0x1c, 0x5a, 0x5e, 0x04, 0x2c, 0xf7, 0x5e, 0x1d, 0xd5, 0xa6, 0x13, 0x01, 0x79,
+	0xf4, 0x97, 0xe2, 0xb1, 0x5f, 0x52, 0xc9, 0x8c, 0xf8, 0x93, 0xbf, 0x3f, 0x97, 0xcc, 0x68, 0xe9,
+	0x48, 0xe0, 0x56, 0x7c, 0xac, 0x06, 0xe5, 0x32, 0x1a, 0x3b, 0x00, 0xd9, 0xaa, 0x4f, 0xc6, 0xf1,
+	0xd0, 0xc8, 0xcc, 0xa1, 0xe3, 0x56, 0xff, 0xc6, 0xaf, 0x88, 0xf2, 0xd7, 0x6e, 0x9f, 0xbf, 0x74,
+	0xa4, 0x1e, 0xca, 0x86, 0x40, 0x89, 0x72, 0x3f, 0x46, 0x26, 0xf0, 0x7f, 0x86, 0xc7, 0x25, 0x94,
+	0xfa, 0x0f, 0xab, 0x25, 0x51, 0x12, 0xca, 0xc6, 0xfd, 0xd2, 0x22, 0xdd, 0x3d, 0x32, 0x81, 0x8c,
+	0x5c, 0x3e, 0x3f, 0xcb, 0x7e, 0x40, 0xca, 0x6f, 0x48, 0xc2, 0x6b, 0xb7, 0xcf, 0x5f, 0x3e, 0x92,
+	0x7c, 0xd5, 0x12, 0x68, 0x69, 0xc6, 0xbe, 0x3b, 0x39, 0x70, 0xdf, 0xbd, 0x61, 0x62, 0x7d, 0x4d,
+	0xdd, 0xd9, 0x3e, 0x58, 0x84, 0xf3, 0xe5, 0xfd, 0xf9, 0x88, 0xfe, 0xc8, 0x44, 0xb2, 0xad, 0xbf,
+	0x14, 0x1f, 0xd9, 0x53, 0x99, 0x8f, 0xec, 0x42, 0xee, 0x23, 0x9b, 0xc6, 0x97, 0x51, 0x90, 0xf7,
+	0xeb, 0x6e, 0xab, 0x38, 0x07, 0xdb, 0x6f, 0x98, 0x6e, 0xc7, 0x42, 0xa2, 0x19, 0x5a, 0x13, 0x26,
+	0xba, 0x9a, 0x60, 0xcc, 0x86, 0x6e, 0x67, 0x91, 0x21, 0xcb, 0x8f, 0x46, 0x12, 0x9c, 0x70, 0x37,
+	0xfc, 0x5d, 0x2a, 0x62, 0x74, 0x95, 0x91, 0xa4, 0x21, 0xca, 0x41, 0x71, 0xe0, 0xdd, 0x8f, 0x6c,
+	0x60, 0x91, 0x76, 0x28, 0x3e, 0x10, 0x73, 0xcb, 0x8e, 0xbb, 0x7e, 0x2a, 0x4d, 0x34, 0xe3, 0xfa,
+	0xee, 0x07, 0xf6, 0xe1, 0x85, 0x7d, 0x5b, 0xf2, 0x7e, 0x97, 0xf9, 0xf2, 0x18, 0x18, 0x94, 0x38,
+	0xfb, 0x18, 0x80, 0x84, 0xc0, 0x29, 0x50, 0xb3, 0x8f, 0x23, 0x4c, 0x70, 0x9a, 0x7b, 0x93, 0x8c,
+	0x21, 0xb6, 0x63, 0xb4, 0xb5, 0x55, 0x4e, 0x92, 0xf7, 0x79, 0xde, 0x18, 0x4b, 0xc0, 0x36, 0x26,
+	0x7e, 0xbc, 0xa6, 0xff, 0x05, 0x29, 0x8d, 0x67, 0xf0, 0xdc, 0x8a, 0x69, 0xb2, 0x2d, 0x8c, 0x9c,
+	0x46, 0x06, 0x4f, 0x56, 0x0c, 0x92, 0xee, 0xfd, 0xde, 0x28, 0x99, 0x91, 0x7e, 0xb5, 0x02, 0x3a,
+	0xd2, 0xca, 0x65, 0x59, 0x39, 0x30, 0x97, 0xe5, 0x07, 0x09, 0x69, 0xd1, 0x5e, 0x27, 0xda, 0x63,
+	0x5f, 0xfd, 0xc8, 0xa1, 0xbf, 0x7a, 0x75, 0x60, 0x5a, 0x54, 0xad, 0x80, 0xd1, 0xa2, 0x88, 0x9e,
+	0xe7, 0xa9, 0x31, 0xb3, 0xd1, 0xf3, 0x37, 0xc9, 0x28, 0x5f, 0x14, 0xea, 0xa3, 0x65, 0x24, 0xdf,
+	0xc8, 0x65, 0xba, 0x36, 0x32, 0xc1, 0xb3, 0xdf, 0x20, 0xc4, 0xb9, 0x01, 0x99, 0xe1, 0x5d, 0x54,
+	0xcb, 0xd5, 0x1d, 0xa0, 0x0f, 0xb2, 0x70, 0xde, 0x45, 0xbb, 0x19, 0xc8, 0xb6, 0xeb, 0xbe, 0x4c,
+	0xc6, 0x24, 0xd2, 0x04, 0xcf, 0x8c, 0x59, 0xfa, 0x43, 0xea, 0xac, 0x8f, 0x5c, 0x0e, 0x48, 0x81,
+	0x36, 0x2a, 0xe9, 0xc4, 0x01, 0xa8, 0xa4, 0x59, 0x7c, 0x5b, 0x72, 0xcf, 0xf0, 0x6d, 0xbf, 0xc5,
+	0x21, 0x53, 0xdb, 0xc6, 0x8a, 0x56, 0x9f, 0xb4, 0xb6, 0x6a, 0x2b, 0x6b, 0x63, 0xa9, 0x59, 0x19,
+	0x2d, 0xa9, 0xde, 0xcf, 0x8e, 0xe0, 0xe9, 0x8d, 0x0f, 0x8f, 0x82, 0x71, 0x7e, 0x82, 0x8c, 0x72,
+	0xd4, 0x65, 0xb1, 0x71, 0xa9, 0x19, 0xc6, 0x41, 0x99, 0x41, 0x50, 0xdd, 0x2b, 0x64, 0xa4, 0xa5,
+	0x91, 0xe5, 0x0f, 0x33, 0xad, 0x18, 0x0a, 0xe1, 0xa2, 0x9f, 0x52, 0x60, 0x2d, 0x20, 0x46, 0x61,
+	0xea, 0xb7, 0x25, 0x78, 0x04, 0xa3, 0x6e, 0xf8, 0x98, 0x89, 0x1a, 0x4b, 0x0f, 0x93, 0x5a, 0x0c,
+	0xfd, 0xec, 0x82, 0x76, 0xe8, 0xa7, 0xe8, 0x5c, 0xa6, 0xaf, 0x8a, 0xb5, 0x9f, 0x9d, 0x49, 0x04,
+	0x9b, 0xd7, 0xfd, 0xb8, 0x83, 0x78, 0x9a, 0xea, 0xa8, 0x37, 0x5a, 0xc6, 0x54, 0x56, 0xab, 0x91,
+	0x6c, 0xd7, 0x04, 0xe8, 0x54, 0x47, 0x3c, 0x43, 0xac, 0xfb, 0x37, 0x1c, 0x72, 0x46, 0x26, 0xe0,
+	0x4b, 0x69, 0x3b, 0x46, 0xa7, 0x16, 0x0e, 0x8e, 0x3a, 0x56, 0x06, 0x8c, 0x41, 0xc3, 0x6e, 0x7a,
+	0x61, 0x9b, 0x36, 0x77, 0x78, 0xfb, 0xdc, 0x00, 0xdd, 0x28, 0x12, 0x0d, 0xc5, 0x3d, 0x62, 0x08,
+	0x14, 0xb9, 0x27, 0x74, 0x7b, 0x98, 0x08, 0xbb, 0x2b, 0xb7, 0x9e, 0x23, 0x9f, 0xf5, 0x16, 0x58,
+	0x5b, 0x72, 0x76, 0xca, 0x3c, 0xd9, 0x58, 0x06, 0x42, 0x8e, 0xf7, 0xf3, 0x53, 0xe4, 0x74, 0x63,
+	0x61, 0x55, 0xa6, 0x18, 0x3f, 0x36, 0x04, 0x8a, 0x22, 0x19, 0x77, 0x0f, 0x81, 0x62, 0x80, 0xf4,
+	0x8e, 0x81, 0x40, 0xd1, 0x31, 0x10, 0x28, 0x6c, 0x38, 0x80, 0x6a, 0x19, 0x70, 0x00, 0x45, 0x3d,
+	0x18, 0x06, 0x0e, 0xe0, 0xd8, 0x20, 0x29, 0xf6, 0xed, 0xd0, 0xa1, 0x20, 0x29, 0x14, 0x5e, 0x47,
+	0x29, 0xd1, 0xc7, 0x03, 0x5e, 0x55, 0x21, 0x5e, 0x87, 0xc2, 0x4a, 0xe0, 0x91, 0xf5, 0xf5, 0xd1,
+	0x32, 0xb0, 0x12, 0x8a, 0x3a, 0x30, 0x04, 0x56, 0x02, 0xff, 0x61, 0xe1, 0x73, 0x8c, 0x95, 0x81,
+	0xcf, 0x51, 0xd4, 0x9d, 0x03, 0xf1, 0x39, 0xbe, 0x9a, 0x9c, 0x68, 0x76, 0xa2, 0x90, 0xae, 0xc7,
+	0x51, 0x1a, 0x35, 0xa3, 0x4e, 0x7d, 0xdc, 0x5e, 0xcc, 0x17, 0x4c, 0x22, 0xd8, 0xbc, 0x83, 0xc0,
+	0x3d, 0x26, 0x8e, 0x0a, 0xee, 0x41, 0xee, 0x11, 0xb8, 0x87, 0x01, 0x5f, 0x31, 0x59, 0x06, 0x7c,
+	0x45, 0xd1, 0x1b, 0x19, 0x0a, 0xbe, 0xe2, 0x73, 0x0e, 0x39, 0xe1, 0xdf, 0x64, 0xca, 0x04, 0x5f,
+	0x85, 0xc5, 0x31, 0xfa, 0x43, 0xc7, 0x30, 0x61, 0x6f, 0x34, 0xb4, 0x18, 0x8e, 0xbc, 0x67, 0x15,
+	0x81, 0xdd, 0x91, 0xa3, 0x40, 0x5e, 0x7c, 0xbe, 0x42, 0xde, 0x78, 0x60, 0x17, 0xdc, 0x9b, 0x78,
+	0x79, 0xd8, 0x16, 0x13, 0xb5, 0xee, 0x94, 0x11, 0xc6, 0xb0, 0x21, 0xdb, 0x13, 0xe1, 0xd8, 0xaa,
+	0x79, 0x30, 0x44, 0x0d, 0x81, 0xc1, 0xc5, 0x70, 0xb0, 0xda, 0x78, 0x1e, 0xaa, 0x66, 0x71, 0xb0,
+	0xda, 0x01, 0xc7, 0xc1, 0x6a, 0x8b, 0x94, 0x14, 0x7e, 0xa7, 0xc3, 0x43, 0xc3, 0x05, 0x92, 0x9b,
+	0x61, 0x69, 0x9f, 0xd3, 0x24, 0x30, 0xf9, 0xbc, 0x3f, 0xad, 0x90, 0xf3, 0x07, 0xac, 0x29, 0x39,
+	0x48, 0x90, 0xda, 0xd0, 0x90, 0x20, 0x22, 0xb4, 0x75, 0x74, 0x40, 0x68, 0x2b, 0xfa, 0x88, 0x50,
+	0x4c, 0xc3, 0xcf, 0xfd, 0xa1, 0x33, 0xd8, 0x5d, 0x1b, 0x9a, 0x04, 0x26, 0x1f, 0xae, 0x62, 0xd3,
+	0x7e, 0xb3, 0x49, 0x93, 0x44, 0xc6, 0xae, 0x8a, 0x9b, 0x8f, 0xd2, 0x02, 0x63, 0xd9, 0x85, 0xd2,
+	0x9c, 0x25, 0x02, 0x32, 0x22, 0xb3, 0x03, 0x3e, 0x31, 0xe4, 0x80, 0xff, 0x70, 0x85, 0x3c, 0xb6,
+	0xef, 0xee, 0x36, 0x74, 0x58, 0x71, 0x3f, 0xa1, 0x71, 0x76, 0xe2, 0x60, 0x40, 0x0b, 0x30, 0x0a,
+	0x1f, 0xa5, 0x5e, 0x4f, 0x05, 0xad, 0x94, 0x1f, 0x87, 0xcf, 0x47, 0xc9, 0x12, 0x01, 0x19, 0x91,
+	0x77, 0x3a, 0x2d, 0x7f, 0x63, 0x84, 0x3c, 0x3e, 0x84, 0x0e, 0x50, 0x22, 0x5e, 0x81, 0x8d, 0xc5,
+	0x51, 0xbd, 0x47, 0x58, 0x1c, 0x77, 0x36, 0x5c, 0xaf, 0x43, 0x78, 0x0c, 0x85, 0x8b, 0xf0, 0xe3,
+	0x15, 0x72, 0x76, 0xb0, 0xc2, 0xe2, 0x7e, 0x2d, 0x5a, 0x11, 0xa5, 0xa7, 0xaa, 0x09, 0xe3, 0xf1,
+	0x00, 0xb7, 0x20, 0x5a, 0x24, 0xc8, 0xf2, 0x22, 0x12, 0x47, 0xcf, 0x4f, 0xb7, 0x93, 0x4b, 0xb7,
+	0x82, 0x24, 0x15, 0xc8, 0xe6, 0xd3, 0xfc, 0x36, 0x5e, 0x96, 0x82, 0xc1, 0x81, 0xe2, 0xd8, 0xaf,
+	0x45, 0xc4, 0x77, 0xe2, 0x95, 0xf8, 0x31, 0x99, 0x89, 0x5b, 0xb7, 0x49, 0x90, 0xe5, 0x45, 0x71,
+	0xcc, 0xdf, 0x83, 0x77, 0x74, 0x44, 0x03, 0x7f, 0xac, 0xa8, 0x52, 0x30, 0x38, 0xb2, 0x00, 0x25,
+	0xb5, 0x83, 0x01, 0x4a, 0xbc, 0x4f, 0x55, 0xc9, 0xc3, 0x03, 0x15, 0xde, 0xe1, 0x96, 0xa9, 0xfb,
+	0x0f, 0x24, 0xe4, 0x0e, 0xbf, 0xb0, 0xc3, 0x81, 0x4b, 0xac, 0x93, 0xd3, 0xf4, 0x56, 0xb3, 0xd3,
+	0x6f, 0xd1, 0xb9, 0xb8, 0xb9, 0x1d, 0xec, 0xd2, 0x16, 0x9b, 0x3e, 0x59, 0x74, 0xcf, 0x4b, 0x05,
+	0x3c, 0x50, 0x58, 0xd3, 0xfb, 0x3b, 0xd5, 0xe2, 0xb9, 0x2b, 0xa0, 0x28, 0xee, 0x1c, 0xb5, 0xeb,
+	0xfe, 0x7b, 0x43, 0x39, 0xf4, 0x89, 0x91, 0x43, 0xa0, 0x4f, 0x64, 0x5e, 0x6f, 0x6d, 0xc8, 0xd7,
+	0x5b, 0xfe, 0x0b, 0xfb, 0xa9, 0xda, 0xc0, 0x17, 0x86, 0x87, 0xf8, 0xa1, 0xee, 0x90, 0x16, 0xc9,
+	0xc9, 0x20, 0x64, 0x6d, 0x37, 0xfa, 0x9b, 0x02, 0x5f, 0x95, 0x27, 0x2a, 0x52, 0x01, 0x89, 0xcb,
+	0x19, 0x3a, 0xe4, 0x6a, 0xdc, 0x87, 0xf8, 0x22, 0x77, 0xf8, 0x92, 0x0e, 0xb7, 0xbb, 0xac, 0x91,
+	0x33, 0x72, 0x28, 0xb6, 0xfd, 0x98, 0xb6, 0x84, 0x42, 0x90, 0x88, 0x10, 0xd4, 0x87, 0x79, 0x18,
+	0x6b, 0x01, 0x03, 0x14, 0xd7, 0xc3, 0x57, 0x96, 0x46, 0xbd, 0xa0, 0x59, 0x1f, 0xb7, 0x5f, 0xd9,
+	0x06, 0x16, 0x02, 0xa7, 0xe9, 0x3d, 0x6d, 0xe2, 0xae, 0xec, 0x69, 0x3c, 0x8a, 0xad, 0x60, 0xe2,
+	0x92, 0x6c, 0x14, 0x5b, 0xd1, 0xc4, 0x2d, 0xaa, 0xe9, 0x7d, 0x90, 0x4c, 0xa8, 0x37, 0xc8, 0x03,
+	0x8c, 0xd4, 0x87, 0x98, 0x0b, 0x30, 0x52, 0x5f, 0xa1, 0xc1, 0xe5, 0x3e, 0xc6, 0x8f, 0x67, 0x99,
+	0x15, 0x05, 0x9f, 0x00, 0xcb, 0xbd, 0x77, 0x90, 0x29, 0x65, 0xad, 0x15, 0x68, 0x0f, 0x3b, 0x14,
+	0xb3, 0x89, 0x65, 0xbe, 0x84, 0xab, 0x58, 0x08, 0x9c, 0xe6, 0xfd, 0x45, 0x85, 0x4c, 0x73, 0x0b,
+	0xe6, 0x95, 0xbd, 0x16, 0x37, 0x01, 0xde, 0x22, 0x13, 0xad, 0x78, 0x8f, 0x17, 0x96, 0x93, 0x7a,
+	0x6b, 0x51, 0x36, 0xa7, 0x2f, 0x57, 0x55, 0x11, 0x68, 0x61, 0xee, 0x47, 0x78, 0x6a, 0x2b, 0x21,
+	0xba, 0x52, 0x06, 0x6a, 0x4d, 0x43, 0xb5, 0x67, 0x0c, 0xaf, 0x2a, 0x03, 0x43, 0x9e, 0x9b, 0x92,
+	0x89, 0x6d, 0x36, 0x06, 0x74, 0x23, 0x2a, 0x67, 0x49, 0xbe, 0x22, 0x9b, 0xe3, 0x8a, 0xa9, 0xfa,
+	0x09, 0x5a, 0x90, 0xf7, 0x53, 0x55, 0x72, 0xda, 0x7e, 0x01, 0xe2, 0x32, 0xfc, 0x27, 0x1c, 0xf2,
+	0x50, 0xc7, 0x4f, 0xd2, 0x46, 0x9f, 0x1d, 0x8f, 0xb6, 0xfa, 0x9d, 0xb5, 0x4c, 0x42, 0xb4, 0xa3,
+	0x9a, 0x98, 0x54, 0xc3, 0xa2, 0x63, 0xaa, 0xfd, 0xf9, 0x47, 0x30, 0x14, 0x78, 0xa5, 0x58, 0x38,
+	0x0c, 0xea, 0x15, 0xda, 0xe5, 0x4e, 0x36, 0xfb, 0x71, 0x4c, 0xc3, 0x54, 0x77, 0x95, 0xbf, 0xc5,
+	0x6b, 0xa5, 0x0c, 0xa4, 0xee, 0xe0, 0x69, 0x5c, 0xa2, 0x17, 0x32, 0xb2, 0x20, 0x27, 0x1d, 0x03,
+	0x9f, 0xb1, 0xb7, 0x0b, 0x51, 0xb7, 0x87, 0x4b, 0x0e, 0xe6, 0xe1, 0x91, 0xb7, 0xa0, 0x7c, 0xd9,
+	0x56, 0x81, 0xcf, 0x2b, 0xc5, 0x6c, 0x30, 0xa8, 0xbe, 0xf7, 0x31, 0x32, 0x93, 0x31, 0xfd, 0xbb,
+	0x3b, 0xa4, 0xda, 0x56, 0x46, 0xfc, 0xf5, 0x52, 0xaf, 0x1d, 0x96, 0x82, 0x74, 0x7e, 0x0c, 0x3f,
+	0xf7, 0xa5, 0x20, 0x05, 0x94, 0xe2, 0xfd, 0xb0, 0x43, 0xce, 0x0e, 0xbe, 0x9b, 0xc0, 0xfb, 0xb2,
+	0xd1, 0x26, 0xfe, 0x96, 0x66, 0x97, 0xe7, 0x8f, 0xeb, 0x1a, 0x84, 0x39, 0xab, 0x2a, 0xeb, 0x09,
+	0x23, 0x24, 0x20, 0x64, 0x7b, 0x1d, 0x72, 0x6e, 0xff, 0x9a, 0x43, 0x44, 0x51, 0x21, 0x5a, 0x7a,
+	0x1c, 0x6d, 0x76, 0x64, 0xdc, 0x9c, 0x44, 0x4b, 0x17, 0x65, 0xa0, 0xa8, 0xde, 0xf7, 0x39, 0xc4,
+	0xcd, 0x0f, 0x1c, 0x7a, 0x28, 0x6b, 0xbc, 0x75, 0xa7, 0x8c, 0xc8, 0xa5, 0xbc, 0x10, 0x9e, 0x19,
+	0x62, 0x10, 0x8e, 0xbb, 0xf7, 0x5d, 0x15, 0x52, 0x1f, 0x54, 0xc9, 0xfd, 0x46, 0xcc, 0xac, 0xd9,
+	0x8b, 0x64, 0xdf, 0x9e, 0x3b, 0x9e, 0xbe, 0xe1, 0x2e, 0x64, 0x26, 0xda, 0xc4, 0x9d, 0x8a, 0xcb,
+	0x75, 0x53, 0x52, 0x6d, 0xf7, 0xda, 0xe2, 0x5b, 0x7d, 0xff, 0xf1, 0x88, 0x5f, 0x5a, 0x5f, 0x12,
+	0x33, 0x78, 0x7d, 0x09, 0x50, 0x9c, 0xf7, 0x8a, 0x43, 0x1e, 0xd9, 0x87, 0xdb, 0x5d, 0x10, 0xf9,
+	0xce, 0xf8, 0xcc, 0xb8, 0x98, 0xc9, 0x77, 0x76, 0x7e, 0x9f, 0xaa, 0x46, 0xe6, 0xb3, 0x47, 0xc9,
+	0xc8, 0x0e, 0xa6, 0xe6, 0x35, 0x6e, 0x4a, 0x59, 0x56, 0x5e, 0x56, 0xea, 0x7d, 0x2d, 0x79, 0x74,
+	0xbf, 0xe1, 0x3a, 0x00, 0x62, 0xcc, 0xfb, 0x36, 0x3c, 0xf8, 0x0e, 0x5c, 0x46, 0x45, 0x3e, 0xb0,
+	0xc6, 0x95, 0x39, 0x71, 0x2a, 0x34, 0xf3, 0x81, 0x35, 0xae, 0xcc, 0x81, 0xa0, 0xa2, 0xda, 0x26,
+	0x36, 0x84, 0x16, 0x32, 0x8f, 0xda, 0xe6, 0xba, 0x2b, 0x9a, 0x04, 0x26, 0x9f, 0xfb, 0x19, 0x87,
+	0x4c, 0x27, 0xd6, 0xd6, 0x51, 0x1f, 0x2b, 0xe3, 0xfe, 0xd0, 0xde, 0x8e, 0x34, 0x36, 0x86, 0x5d,
+	0x0e, 0x19, 0xd9, 0xde, 0x1f, 0x8e, 0x91, 0x13, 0x56, 0x26, 0x49, 0xcb, 0xe9, 0xc4, 0x39, 0xd0,
+	0xe9, 0xe4, 0x71, 0x99, 0xa3, 0x8e, 0x6b, 0xe2, 0x06, 0xca, 0x83, 0xce, 0x24, 0x37, 0x6c, 0x8a,
+	0x35, 0xfc, 0xe4, 0xa7, 0xd8, 0xde, 0x2e, 0xbc, 0x7b, 0xea, 0x23, 0x65, 0xb8, 0x54, 0x35, 0x8c,
+	0x16, 0x79, 0x90, 0x86, 0x59, 0x02, 0x96, 0x44, 0x5c, 0x81, 0x27, 0x62, 0x95, 0x51, 0x64, 0xb4,
+	0x8c, 0xd8, 0xe6, 0x6c, 0xa2, 0xce, 0x8c, 0x52, 0xa5, 0x93, 0x93, 0x68, 0xc1, 0x6e, 0xa2, 0xfc,
+	0x69, 0xc6, 0x8e, 0xc7, 0x9f, 0x86, 0x14, 0xf8, 0xd2, 0x60, 0x56, 0x6c, 0x81, 0x99, 0x20, 0x63,
+	0xac, 0x78, 0x56, 0x6c, 0x59, 0x08, 0x9a, 0x8e, 0x16, 0x94, 0x84, 0x3d, 0x58, 0x6a, 0xf8, 0xa4,
+	0xf0, 0xac, 0x99, 0xba, 0x18, 0x4c, 0x1e, 0xd3, 0x81, 0x86, 0xdc, 0x53, 0x07, 0x9a, 0xc9, 0x03,
+	0x1c, 0x68, 0x1a, 0xe4, 0x8c, 0xdf, 0x4f, 0x23, 0xf4, 0x2a, 0x11, 0x69, 0x79, 0x13, 0x9e, 0x7c,
+	0x74, 0x8a, 0xdd, 0xab, 0x29, 0x2f, 0xf1, 0x06, 0xed, 0x6c, 0xe5, 0x98, 0xa0, 0xb8, 0xae, 0x9b,
+	0x92, 0xf1, 0x34, 0xe8, 0xd2, 0xa8, 0x9f, 0x72, 0xfc, 0x9a, 0x52, 0xe6, 0xf5, 0x86, 0x68, 0x51,
+	0x1c, 0x2e, 0xc5, 0x2f, 0x50, 0x92, 0xbc, 0xbf, 0xeb, 0x90, 0x33, 0x85, 0x13, 0xf0, 0xfe, 0x0d,
+	0x5e, 0xf4, 0xbe, 0xa7, 0x46, 0x1e, 0x28, 0xc8, 0x6e, 0x8b, 0x4e, 0xb7, 0xfa, 0xd3, 0x74, 0xca,
+	0x70, 0xb0, 0xb7, 0xfd, 0xc5, 0xe5, 0x8c, 0x28, 0xf8, 0x1e, 0x0f, 0xe7, 0x89, 0xa7, 0xbd, 0xe1,
+	0xaa, 0x77, 0xd7, 0x1b, 0xce, 0xf8, 0xc2, 0x46, 0xee, 0xe9, 0x17, 0x56, 0x3b, 0xe0, 0x0b, 0xfb,
+	0x49, 0x87, 0xd4, 0x45, 0xb4, 0xa7, 0x9a, 0x02, 0xd2, 0xf7, 0x45, 0xb8, 0x06, 0x1c, 0x51, 0xd9,
+	0x5b, 0x1d, 0xd0, 0xfa, 0xfc, 0xa3, 0x08, 0xac, 0x33, 0x88, 0x0a, 0x03, 0x7b, 0xe5, 0xfd, 0x87,
+	0x1a, 0x61, 0x87, 0x50, 0xa1, 0xfe, 0x7d, 0xcc, 0xcc, 0x97, 0xed, 0x94, 0x95, 0xd0, 0x99, 0x37,
+	0xae, 0xf2, 0x6d, 0xf3, 0x11, 0x2c, 0x4a, 0xbf, 0x9d, 0x5d, 0x7f, 0x2b, 0x43, 0xac, 0xbf, 0x1d,
+	0x99, 0x0b, 0xbe, 0x5a, 0x7e, 0x2e, 0xf8, 0x89, 0x5c, 0x1e, 0xf8, 0x7d, 0x5f, 0xf1, 0xc8, 0xfd,
+	0xf8, 0x8a, 0xdd, 0xef, 0xdd, 0x27, 0x75, 0xfd, 0x51, 0xbf, 0xa4, 0x3b, 0xcf, 0x58, 0x6f, 0xed,
+	0x1c, 0xa3, 0x77, 0x6d, 0xe7, 0xf8, 0x69, 0x87, 0x3c, 0x50, 0x30, 0x25, 0x31, 0xd5, 0x35, 0xd7,
+	0xf8, 0x78, 0xaa, 0xe5, 0x5c, 0xde, 0x60, 0x3c, 0xf9, 0x25, 0x62, 0x63, 0x14, 0x5a, 0x21, 0x13,
+	0x21, 0x37, 0x4b, 0x50, 0x54, 0xbc, 0xb5, 0x61, 0x39, 0x78, 0x2f, 0x75, 0x7b, 0xe9, 0x9e, 0xd4,
+	0x0d, 0x59, 0xee, 0x28, 0x55, 0x0a, 0x06, 0x87, 0xfb, 0x26, 0x32, 0xc6, 0x41, 0xda, 0x5a, 0xe2,
+	0xa6, 0x62, 0x12, 0x57, 0x22, 0x0e, 0xe1, 0xd6, 0x02, 0x49, 0xc3, 0x6c, 0x7b, 0x86, 0xb9, 0x08,
+	0x6f, 0x03, 0x4c, 0xa4, 0xf8, 0xec, 0x6d, 0x80, 0x09, 0x2c, 0x0f, 0x16, 0x27, 0xee, 0x6d, 0x78,
+	0xd1, 0x94, 0xdd, 0xfd, 0xf0, 0x36, 0x0a, 0x18, 0x85, 0x3b, 0x78, 0xf7, 0xa2, 0x67, 0x61, 0x25,
+	0x1b, 0x69, 0x07, 0xbc, 0x18, 0x24, 0xdd, 0xfb, 0xab, 0x15, 0xd1, 0x2b, 0x6e, 0x29, 0xd2, 0x11,
+	0x07, 0xce, 0x21, 0x23, 0x0e, 0x3e, 0x42, 0x48, 0x53, 0x98, 0x36, 0x36, 0xa2, 0x72, 0x0c, 0x6e,
+	0x0b, 0xaa, 0x3d, 0x6d, 0x70, 0xd3, 0x65, 0x60, 0xc8, 0xb3, 0x76, 0xc2, 0xea, 0x81, 0x3b, 0xa1,
+	0xb5, 0x29, 0x8c, 0xec, 0xbf, 0x29, 0x78, 0x7f, 0xea, 0x10, 0x4b, 0x35, 0x77, 0x7b, 0xa4, 0x86,
+	0xdd, 0xdd, 0xab, 0x3b, 0x65, 0x7c, 0x82, 0x66, 0xd3, 0xb8, 0xb1, 0x89, 0x45, 0x8b, 0xfd, 0x0b,
+	0x5c, 0x90, 0xdb, 0x11, 0xd1, 0x15, 0xa5, 0x18, 0xc0, 0x4c, 0x81, 0x18, 0x9f, 0xc1, 0x0f, 0xb2,
+	0x3a, 0x52, 0xc3, 0x7b, 0x8a, 0x9c, 0xca, 0x75, 0x8a, 0x65, 0x36, 0x8b, 0xa4, 0x15, 0xd7, 0x38,
+	0x51, 0x31, 0x64, 0x35, 0xe0, 0x34, 0xef, 0xc7, 0x1d, 0x72, 0x32, 0xdb, 0x3c, 0xfa, 0x2c, 0x9d,
+	0x4a, 0xb2, 0xed, 0x1d, 0xd7, 0xd8, 0xa9, 0x50, 0xce, 0x1c, 0x09, 0xf2, 0x9d, 0xf0, 0xda, 0xfc,
+	0xcd, 0xca, 0x65, 0x06, 0x75, 0xc2, 0xc8, 0x32, 0x8c, 0x1a, 0x3a, 0xa1, 0x56, 0xfb, 0x34, 0x0f,
+	0x1e, 0x21, 0x45, 0x98, 0x4d, 0xc5, 0x3e, 0x95, 0xdb, 0xe1, 0x31, 0xde, 0x17, 0xc4, 0x2e, 0x7d,
+	0x23, 0x08, 0x5b, 0xd1, 0x4d, 0xa5, 0xbf, 0x3a, 0x03, 0xf5, 0x57, 0x0c, 0x75, 0x11, 0x99, 0xfd,
+	0xb3, 0x9a, 0x9d, 0x4c, 0xff, 0x0f, 0x8a, 0xc3, 0xca, 0xda, 0x57, 0x3d, 0x30, 0x6b, 0xdf, 0x3b,
+	0x33, 0x09, 0x3e, 0x47, 0x34, 0x4e, 0xc0, 0x3e, 0xc9, 0x3d, 0x67, 0x09, 0x51, 0xba, 0xb0, 0xd4,
+	0xa4, 0xd8, 0xaa, 0xa8, 0x36, 0xac, 0x04, 0x0c, 0x0e, 0x86, 0x2e, 0xd6, 0xe9, 0x27, 0xcc, 0x59,
+	0x6b, 0x54, 0x5b, 0xda, 0x16, 0x44, 0x19, 0x28, 0x2a, 0xde, 0x5f, 0x74, 0xfd, 0xb0, 0xef, 0x77,
+	0x70, 0x84, 0xc4, 0xcd, 0x8f, 0xfa, 0xde, 0x57, 0x15, 0x05, 0x0c, 0x2e, 0x7c, 0x62, 0xdc, 0x12,
+	0x9e, 0x8b, 0x42, 0x19, 0xe0, 0xa7, 0xfd, 0xf7, 0x44, 0x39, 0x28, 0x0e, 0xf7, 0x29, 0xcc, 0x8a,
+	0xdb, 0xe2, 0x6f, 0x30, 0x8a, 0x85, 0x1b, 0x90, 0xb2, 0x45, 0x20, 0x9c, 0xa1, 0xa6, 0x82, 0xc9,
+	0x9a, 0x4d, 0x80, 0x49, 0x86, 0x4c, 0x80, 0xf9, 0x2e, 0xa1, 0x06, 0xed, 0xd2, 0x38, 0xee, 0xcb,
+	0x50, 0x23, 0x55, 0xad, 0xa1, 0x49, 0x60, 0xf2, 0xb9, 0x2f, 0x93, 0xf1, 0xa6, 0xdf, 0xa1, 0x61,
+	0xcb, 0x8f, 0xeb, 0x53, 0xa5, 0x58, 0x7e, 0xd5, 0x9c, 0x5b, 0x10, 0xed, 0x8a, 0xb7, 0x20, 0x7e,
+	0x81, 0x92, 0xe7, 0xfd, 0x31, 0xda, 0x3b, 0x73, 0xec, 0x68, 0xb5, 0x0a, 0x9a, 0x49, 0xd6, 0x6a,
+	0xb5, 0xbc, 0xd0, 0x00, 0x2c, 0x77, 0xbf, 0x3b, 0x9b, 0xd9, 0x9c, 0x2f, 0x51, 0xcf, 0x97, 0xdd,
+	0x6d, 0x2b, 0xcb, 0xf9, 0xc9, 0x03, 0x32, 0x9c, 0x0b, 0x4b, 0x5b, 0x75, 0x80, 0xa5, 0xcd, 0x27,
+	0xe7, 0xf6, 0x17, 0x30, 0x84, 0x1d, 0xf9, 0x80, 0xfb, 0xb3, 0x3f, 0x74, 0xc8, 0x69, 0x2d, 0x63,
+	0xad, 0xc9, 0x2f, 0x13, 0x9a, 0x78, 0xe1, 0x59, 0x63, 0x61, 0x8b, 0x75, 0xe7, 0xd0, 0x71, 0x1b,
+	0x6a, 0xc9, 0x65, 0xb1, 0x3f, 0xc0, 0xdb, 0x71, 0x97, 0x49, 0x95, 0x8a, 0x93, 0xf0, 0xe1, 0x9a,
+	0x53, 0x9d, 0xbe, 0x14, 0xb6, 0x00, 0xdb, 0x40, 0xad, 0x21, 0xe9, 0x77, 0xbb, 0x7e, 0xbc, 0x97,
+	0xd5, 0x1a, 0x1a, 0xbc, 0x18, 0x24, 0xdd, 0xfb, 0x13, 0x87, 0xcc, 0x68, 0x98, 0x5d, 0x36, 0x74,
+	0xd6, 0xcd, 0xaf, 0x73, 0xe0, 0xcd, 0xaf, 0x8d, 0x8a, 0x58, 0x19, 0x0a, 0x15, 0xd1, 0x04, 0x2c,
+	0xac, 0xee, 0x0b, 0x58, 0xf8, 0x26, 0x32, 0xb6, 0x43, 0xf7, 0x0c, 0x64, 0x43, 0xa6, 0x92, 0x5d,
+	0xe5, 0x45, 0x20, 0x69, 0x18, 0xb4, 0xda, 0xf4, 0x55, 0xca, 0x84, 0x29, 0x11, 0xde, 0x30, 0xc7,
+	0x98, 0x04, 0xc5, 0x5b, 0x23, 0x13, 0xca, 0x2f, 0x54, 0xbe, 0x76, 0xa7, 0xf8, 0xb5, 0xe3, 0x26,
+	0x69, 0xb8, 0xb8, 0xea, 0x37, 0xc6, 0x1c, 0x63, 0x85, 0xc7, 0xeb, 0xfc, 0xe6, 0xaf, 0x7c, 0xf1,
+	0xdc, 0x1b, 0x7e, 0xfd, 0x8b, 0xe7, 0xde, 0xf0, 0xbb, 0x5f, 0x3c, 0xf7, 0x86, 0x57, 0x5e, 0x3d,
+	0xe7, 0xfc, 0xca, 0xab, 0xe7, 0x9c, 0x5f, 0x7f, 0xf5, 0x9c, 0xf3, 0xbb, 0xaf, 0x9e, 0x73, 0xfe,
+	0xe8, 0xd5, 0x73, 0xce, 0x67, 0xff, 0xcd, 0xb9, 0x37, 0x3c, 0xf7, 0x35, 0xfb, 0x05, 0x1d, 0x89,
+	0x30, 0x23, 0x7c, 0xa9, 0x17, 0x8d, 0x0f, 0xe8, 0xa2, 0xfc, 0x80, 0xfe, 0xf7, 0x00, 0x53, 0xc2,
+	0xb0, 0xc5, 0x6a, 0x30, 0x01, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -6207,6 +6828,48 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Parent)
+	copy(dAtA[i:], m.Parent)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Parent)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.NamespaceTemplate != nil {
+		{
+			size, err := m.NamespaceTemplate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.Quotas != nil {
+		{
+			size, err := m.Quotas.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ProgressDeadlineSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ProgressDeadlineSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.SourceIntegrity != nil {
 		{
 			size, err := m.SourceIntegrity.MarshalToSizedBuffer(dAtA[:i])
@@ -6411,6 +7074,20 @@ func (m *AppProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.JWTTokensByRole) > 0 {
 		keysForJWTTokensByRole := make([]string, 0, len(m.JWTTokensByRole))
 		for k := range m.JWTTokensByRole {
@@ -6553,6 +7230,51 @@ func (m *ApplicationCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationDependency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDependency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Selector != nil {
+		{
+			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationDestination) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6573,6 +7295,18 @@ func (m *ApplicationDestination) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ClusterSelector != nil {
+		{
+			size, err := m.ClusterSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	i -= len(m.Name)
 	copy(dAtA[i:], m.Name)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
@@ -6629,6 +7363,132 @@ func (m *ApplicationDestinationServiceAccount) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationFleet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationFleet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationFleet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RolloutSteps) > 0 {
+		for iNdEx := len(m.RolloutSteps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RolloutSteps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationFleetClusterStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationFleetClusterStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationFleetClusterStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Revision)
+	copy(dAtA[i:], m.Revision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Health)
+	copy(dAtA[i:], m.Health)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Health)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Sync)
+	copy(dAtA[i:], m.Sync)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Sync)))
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Step))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Application)
+	copy(dAtA[i:], m.Application)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Application)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Server)
+	copy(dAtA[i:], m.Server)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Server)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationFleetRolloutStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationFleetRolloutStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationFleetRolloutStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClusterSelector.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7074,6 +7934,77 @@ func (m *ApplicationSetGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetGeneratorStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetGeneratorStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetGeneratorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GeneratedAt != nil {
+		{
+			size, err := m.GeneratedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DurationMilliseconds))
+	i--
+	dAtA[i] = 0x38
+	i -= len(m.Error)
+	copy(dAtA[i:], m.Error)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Error)))
+	i--
+	dAtA[i] = 0x32
+	i--
+	if m.ParamsTruncated {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Params[iNdEx])
+			copy(dAtA[i:], m.Params[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Params[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ParamsCount))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Index))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSetList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7545,6 +8476,20 @@ func (m *ApplicationSetStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Generators) > 0 {
+		for iNdEx := len(m.Generators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Generators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -8724,6 +9669,37 @@ func (m *ApplicationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Fleet != nil {
+		{
+			size, err := m.Fleet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ProgressDeadlineSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ProgressDeadlineSeconds))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DependsOn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.SourceHydrator != nil {
 		{
 			size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
@@ -8845,6 +9821,20 @@ func (m *ApplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Fleet) > 0 {
+		for iNdEx := len(m.Fleet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fleet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	{
 		size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -9145,6 +10135,37 @@ func (m *ApplicationWatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutomatedRollback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutomatedRollback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutomatedRollback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxAttempts))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Window)
+	copy(dAtA[i:], m.Window)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Window)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Backoff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9355,6 +10376,9 @@ func (m *Cluster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxConcurrentSyncs))
+	i--
+	dAtA[i] = 0x70
 	if len(m.Annotations) > 0 {
 		keysForAnnotations := make([]string, 0, len(m.Annotations))
 		for k := range m.Annotations {
@@ -9510,6 +10534,9 @@ func (m *ClusterCacheInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.EventsPerMinute))
+	i--
+	dAtA[i] = 0x20
 	if m.LastCacheSyncTime != nil {
 		{
 			size, err := m.LastCacheSyncTime.MarshalToSizedBuffer(dAtA[:i])
@@ -11867,6 +12894,56 @@ func (m *MergeGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NamespaceResourceRestrictionItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceResourceRestrictionItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceResourceRestrictionItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LabelSelector != nil {
+		{
+			size, err := m.LabelSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *NestedMatrixGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12028,6 +13105,18 @@ func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutomatedRollback != nil {
+		{
+			size, err := m.AutomatedRollback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -12077,6 +13166,49 @@ func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OperationAutomatedRollback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationAutomatedRollback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationAutomatedRollback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Attempt))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.HistoryID))
+	i--
+	dAtA[i] = 0x10
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revisions[iNdEx])
+			copy(dAtA[i:], m.Revisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revisions[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OperationInitiator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12133,6 +13265,18 @@ func (m *OperationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ScheduledAt != nil {
+		{
+			size, err := m.ScheduledAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.RetryCount))
 	i--
 	dAtA[i] = 0x40
@@ -12330,6 +13474,23 @@ func (m *OrphanedResourcesMonitorSettings) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.Prune != nil {
+		{
+			size, err := m.Prune.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Mode)
+	copy(dAtA[i:], m.Mode)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Mode)))
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Ignore) > 0 {
 		for iNdEx := len(m.Ignore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -12357,6 +13518,54 @@ func (m *OrphanedResourcesMonitorSettings) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *OrphanedResourcesPruneSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrphanedResourcesPruneSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrphanedResourcesPruneSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	if len(m.Allow) > 0 {
+		for iNdEx := len(m.Allow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.GracePeriodSeconds))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *OverrideIgnoreDiff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12377,6 +13586,20 @@ func (m *OverrideIgnoreDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.CELCondition)
+	copy(dAtA[i:], m.CELCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CELCondition)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.CELExpressions) > 0 {
+		for iNdEx := len(m.CELExpressions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CELExpressions[iNdEx])
+			copy(dAtA[i:], m.CELExpressions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.CELExpressions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ManagedFieldsManagers) > 0 {
 		for iNdEx := len(m.ManagedFieldsManagers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ManagedFieldsManagers[iNdEx])
@@ -12569,6 +13792,173 @@ func (m *PluginInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectNamespaceTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectNamespaceTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectNamespaceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.DefaultDenyNetworkPolicy {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	if m.LimitRange != nil {
+		{
+			size, err := m.LimitRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ResourceQuota != nil {
+		{
+			size, err := m.ResourceQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Annotations[string(keysForAnnotations[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAnnotations[iNdEx])
+			copy(dAtA[i:], keysForAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Labels[string(keysForLabels[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLabels[iNdEx])
+			copy(dAtA[i:], keysForLabels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectQuotaUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectQuotaUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectQuotaUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ManagedResources))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Applications))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectQuotas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectQuotas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectQuotas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxManifestSizeBytes))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxManagedResources))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxResourcesPerApplication))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxApplications))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12589,6 +13979,14 @@ func (m *ProjectRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.RequireGrantApproval {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
 	if len(m.Groups) > 0 {
 		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Groups[iNdEx])
@@ -12634,6 +14032,121 @@ func (m *ProjectRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectRoleGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectRoleGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectRoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ExpiresAt))
+	i--
+	dAtA[i] = 0x50
+	i -= len(m.ApprovedBy)
+	copy(dAtA[i:], m.ApprovedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ApprovedBy)))
+	i--
+	dAtA[i] = 0x4a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RequestedAt))
+	i--
+	dAtA[i] = 0x40
+	i -= len(m.RequestedBy)
+	copy(dAtA[i:], m.RequestedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RequestedBy)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Groups[iNdEx])
+			copy(dAtA[i:], m.Groups[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Groups[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Policies[iNdEx])
+			copy(dAtA[i:], m.Policies[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Policies[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Role)
+	copy(dAtA[i:], m.Role)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Role)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ID)
+	copy(dAtA[i:], m.ID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectRuleOrigin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectRuleOrigin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectRuleOrigin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Project)
+	copy(dAtA[i:], m.Project)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Project)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Rule)
+	copy(dAtA[i:], m.Rule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Rule)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Field)
+	copy(dAtA[i:], m.Field)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Field)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PullRequestGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14175,6 +15688,20 @@ func (m *ResourceIgnoreDifferences) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.CELCondition)
+	copy(dAtA[i:], m.CELCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CELCondition)))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.CELExpressions) > 0 {
+		for iNdEx := len(m.CELExpressions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CELExpressions[iNdEx])
+			copy(dAtA[i:], m.CELExpressions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.CELExpressions[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.ManagedFieldsManagers) > 0 {
 		for iNdEx := len(m.ManagedFieldsManagers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ManagedFieldsManagers[iNdEx])
@@ -14464,6 +15991,11 @@ func (m *ResourceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.HealthCEL)
+	copy(dAtA[i:], m.HealthCEL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HealthCEL)))
+	i--
+	dAtA[i] = 0x3a
 	{
 		size, err := m.IgnoreResourceUpdates.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -14592,6 +16124,18 @@ func (m *ResourceResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.Images) > 0 {
 		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Images[iNdEx])
@@ -14812,6 +16356,11 @@ func (m *RevisionHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.HealthStatus)
+	copy(dAtA[i:], m.HealthStatus)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HealthStatus)))
+	i--
+	dAtA[i] = 0x5a
 	{
 		size, err := m.InitiatedBy.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -16216,6 +17765,18 @@ func (m *SyncOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Timeouts != nil {
+		{
+			size, err := m.Timeouts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.SelfHealAttemptsCount))
 	i--
 	dAtA[i] = 0x60
@@ -16472,6 +18033,30 @@ func (m *SyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Timeouts != nil {
+		{
+			size, err := m.Timeouts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AutomatedRollback != nil {
+		{
+			size, err := m.AutomatedRollback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ManagedNamespaceMetadata != nil {
 		{
 			size, err := m.ManagedNamespaceMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -16784,6 +18369,39 @@ func (m *SyncStrategyHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncTimeouts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncTimeouts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncTimeouts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Health)
+	copy(dAtA[i:], m.Health)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Health)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Operation)
+	copy(dAtA[i:], m.Operation)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operation)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -16804,6 +18422,18 @@ func (m *SyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Calendar != nil {
+		{
+			size, err := m.Calendar.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	i--
 	if m.SyncOverrun {
 		dAtA[i] = 1
@@ -16883,6 +18513,132 @@ func (m *SyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncWindowCalendar) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowCalendar) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowCalendar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x1a
+	if m.ConfigMapRef != nil {
+		{
+			size, err := m.ConfigMapRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.ICS)
+	copy(dAtA[i:], m.ICS)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ICS)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncWindowCalendarConfigMapRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowCalendarConfigMapRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowCalendarConfigMapRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncWindowOccurrence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowOccurrence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowOccurrence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Summary)
+	copy(dAtA[i:], m.Summary)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Summary)))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TLSClientConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -17131,6 +18887,19 @@ func (m *AppProjectSpec) Size() (n int) {
 		l = m.SourceIntegrity.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ProgressDeadlineSeconds != nil {
+		n += 2 + sovGenerated(uint64(*m.ProgressDeadlineSeconds))
+	}
+	if m.Quotas != nil {
+		l = m.Quotas.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.NamespaceTemplate != nil {
+		l = m.NamespaceTemplate.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Parent)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -17149,6 +18918,12 @@ func (m *AppProjectStatus) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17188,6 +18963,23 @@ func (m *ApplicationCondition) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ApplicationDestination) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17200,6 +18992,10 @@ func (m *ApplicationDestination) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.ClusterSelector != nil {
+		l = m.ClusterSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17218,6 +19014,54 @@ func (m *ApplicationDestinationServiceAccount) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationFleet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RolloutSteps) > 0 {
+		for _, e := range m.RolloutSteps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplicationFleetClusterStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Server)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Application)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Step))
+	l = len(m.Sync)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Health)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ApplicationFleetRolloutStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClusterSelector.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ApplicationList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17387,6 +19231,33 @@ func (m *ApplicationSetGenerator) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSetGeneratorStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Index))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ParamsCount))
+	if len(m.Params) > 0 {
+		for _, s := range m.Params {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	l = len(m.Error)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.DurationMilliseconds))
+	if m.GeneratedAt != nil {
+		l = m.GeneratedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ApplicationSetList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17584,6 +19455,12 @@ func (m *ApplicationSetStatus) Size() (n int) {
 	n += 1 + sovGenerated(uint64(m.ResourcesCount))
 	l = m.Health.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Generators) > 0 {
+		for _, e := range m.Generators {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -18030,6 +19907,19 @@ func (m *ApplicationSpec) Size() (n int) {
 		l = m.SourceHydrator.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.DependsOn) > 0 {
+		for _, e := range m.DependsOn {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ProgressDeadlineSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.ProgressDeadlineSeconds))
+	}
+	if m.Fleet != nil {
+		l = m.Fleet.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18089,6 +19979,12 @@ func (m *ApplicationStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.SourceHydrator.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Fleet) > 0 {
+		for _, e := range m.Fleet {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -18155,6 +20051,18 @@ func (m *ApplicationWatchEvent) Size() (n int) {
 	return n
 }
 
+func (m *AutomatedRollback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Window)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxAttempts))
+	return n
+}
+
 func (m *Backoff) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18281,6 +20189,7 @@ func (m *Cluster) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	n += 1 + sovGenerated(uint64(m.MaxConcurrentSyncs))
 	return n
 }
 
@@ -18296,6 +20205,7 @@ func (m *ClusterCacheInfo) Size() (n int) {
 		l = m.LastCacheSyncTime.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 1 + sovGenerated(uint64(m.EventsPerMinute))
 	return n
 }
 
@@ -19159,6 +21069,25 @@ func (m *MergeGenerator) Size() (n int) {
 	return n
 }
 
+func (m *NamespaceResourceRestrictionItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.LabelSelector != nil {
+		l = m.LabelSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *NestedMatrixGenerator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19238,6 +21167,29 @@ func (m *Operation) Size() (n int) {
 	}
 	l = m.Retry.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.AutomatedRollback != nil {
+		l = m.AutomatedRollback.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *OperationAutomatedRollback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.HistoryID))
+	n += 1 + sovGenerated(uint64(m.Attempt))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19276,6 +21228,10 @@ func (m *OperationState) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 1 + sovGenerated(uint64(m.RetryCount))
+	if m.ScheduledAt != nil {
+		l = m.ScheduledAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19341,6 +21297,29 @@ func (m *OrphanedResourcesMonitorSettings) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.Mode)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Prune != nil {
+		l = m.Prune.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *OrphanedResourcesPruneSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.GracePeriodSeconds))
+	if len(m.Allow) > 0 {
+		for _, e := range m.Allow {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
 	return n
 }
 
@@ -19368,6 +21347,14 @@ func (m *OverrideIgnoreDiff) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.CELExpressions) > 0 {
+		for _, s := range m.CELExpressions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.CELCondition)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19426,6 +21413,64 @@ func (m *PluginInput) Size() (n int) {
 	return n
 }
 
+func (m *ProjectNamespaceTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.ResourceQuota != nil {
+		l = m.ResourceQuota.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LimitRange != nil {
+		l = m.LimitRange.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
+func (m *ProjectQuotaUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Applications))
+	n += 1 + sovGenerated(uint64(m.ManagedResources))
+	return n
+}
+
+func (m *ProjectQuotas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxApplications))
+	n += 1 + sovGenerated(uint64(m.MaxResourcesPerApplication))
+	n += 1 + sovGenerated(uint64(m.MaxManagedResources))
+	n += 1 + sovGenerated(uint64(m.MaxManifestSizeBytes))
+	return n
+}
+
 func (m *ProjectRole) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19454,6 +21499,57 @@ func (m *ProjectRole) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	n += 2
+	return n
+}
+
+func (m *ProjectRoleGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Role)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Policies) > 0 {
+		for _, s := range m.Policies {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RequestedBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.RequestedAt))
+	l = len(m.ApprovedBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ExpiresAt))
+	return n
+}
+
+func (m *ProjectRuleOrigin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Rule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Project)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -20016,6 +22112,14 @@ func (m *ResourceIgnoreDifferences) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.CELExpressions) > 0 {
+		for _, s := range m.CELExpressions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.CELCondition)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -20126,6 +22230,8 @@ func (m *ResourceOverride) Size() (n int) {
 	n += 2
 	l = m.IgnoreResourceUpdates.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HealthCEL)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -20182,6 +22288,10 @@ func (m *ResourceResult) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -20260,6 +22370,8 @@ func (m *RevisionHistory) Size() (n int) {
 	}
 	l = m.InitiatedBy.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HealthStatus)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -20789,6 +22901,10 @@ func (m *SyncOperation) Size() (n int) {
 		}
 	}
 	n += 1 + sovGenerated(uint64(m.SelfHealAttemptsCount))
+	if m.Timeouts != nil {
+		l = m.Timeouts.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -20868,6 +22984,14 @@ func (m *SyncPolicy) Size() (n int) {
 		l = m.ManagedNamespaceMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.AutomatedRollback != nil {
+		l = m.AutomatedRollback.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Timeouts != nil {
+		l = m.Timeouts.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -20966,6 +23090,19 @@ func (m *SyncStrategyHook) Size() (n int) {
 	return n
 }
 
+func (m *SyncTimeouts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operation)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Health)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SyncWindow) Size() (n int) {
 	if m == nil {
 		return 0
@@ -21003,6 +23140,55 @@ func (m *SyncWindow) Size() (n int) {
 	l = len(m.Description)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
+	if m.Calendar != nil {
+		l = m.Calendar.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncWindowCalendar) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ICS)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ConfigMapRef != nil {
+		l = m.ConfigMapRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SyncWindowCalendarConfigMapRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SyncWindowOccurrence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Start.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.End.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Summary)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -21120,9 +23306,9 @@ func (this *AppProjectSpec) String() string {
 		repeatedStringForClusterResourceWhitelist += strings.Replace(strings.Replace(f.String(), "ClusterResourceRestrictionItem", "ClusterResourceRestrictionItem", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForClusterResourceWhitelist += "}"
-	repeatedStringForNamespaceResourceBlacklist := "[]GroupKind{"
+	repeatedStringForNamespaceResourceBlacklist := "[]NamespaceResourceRestrictionItem{"
 	for _, f := range this.NamespaceResourceBlacklist {
-		repeatedStringForNamespaceResourceBlacklist += fmt.Sprintf("%v", f) + ","
+		repeatedStringForNamespaceResourceBlacklist += strings.Replace(strings.Replace(f.String(), "NamespaceResourceRestrictionItem", "NamespaceResourceRestrictionItem", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForNamespaceResourceBlacklist += "}"
 	repeatedStringForSyncWindows := "[]*SyncWindow{"
@@ -21130,9 +23316,9 @@ func (this *AppProjectSpec) String() string {
 		repeatedStringForSyncWindows += strings.Replace(f.String(), "SyncWindow", "SyncWindow", 1) + ","
 	}
 	repeatedStringForSyncWindows += "}"
-	repeatedStringForNamespaceResourceWhitelist := "[]GroupKind{"
+	repeatedStringForNamespaceResourceWhitelist := "[]NamespaceResourceRestrictionItem{"
 	for _, f := range this.NamespaceResourceWhitelist {
-		repeatedStringForNamespaceResourceWhitelist += fmt.Sprintf("%v", f) + ","
+		repeatedStringForNamespaceResourceWhitelist += strings.Replace(strings.Replace(f.String(), "NamespaceResourceRestrictionItem", "NamespaceResourceRestrictionItem", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForNamespaceResourceWhitelist += "}"
 	repeatedStringForSignatureKeys := "[]SignatureKey{"
@@ -21166,6 +23352,10 @@ func (this *AppProjectSpec) String() string {
 		`PermitOnlyProjectScopedClusters:` + fmt.Sprintf("%v", this.PermitOnlyProjectScopedClusters) + `,`,
 		`DestinationServiceAccounts:` + repeatedStringForDestinationServiceAccounts + `,`,
 		`SourceIntegrity:` + strings.Replace(this.SourceIntegrity.String(), "SourceIntegrity", "SourceIntegrity", 1) + `,`,
+		`ProgressDeadlineSeconds:` + valueToStringGenerated(this.ProgressDeadlineSeconds) + `,`,
+		`Quotas:` + strings.Replace(this.Quotas.String(), "ProjectQuotas", "ProjectQuotas", 1) + `,`,
+		`NamespaceTemplate:` + strings.Replace(this.NamespaceTemplate.String(), "ProjectNamespaceTemplate", "ProjectNamespaceTemplate", 1) + `,`,
+		`Parent:` + fmt.Sprintf("%v", this.Parent) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21174,6 +23364,11 @@ func (this *AppProjectStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForGrants := "[]ProjectRoleGrant{"
+	for _, f := range this.Grants {
+		repeatedStringForGrants += strings.Replace(strings.Replace(f.String(), "ProjectRoleGrant", "ProjectRoleGrant", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForGrants += "}"
 	keysForJWTTokensByRole := make([]string, 0, len(this.JWTTokensByRole))
 	for k := range this.JWTTokensByRole {
 		keysForJWTTokensByRole = append(keysForJWTTokensByRole, k)
@@ -21186,6 +23381,7 @@ func (this *AppProjectStatus) String() string {
 	mapStringForJWTTokensByRole += "}"
 	s := strings.Join([]string{`&AppProjectStatus{`,
 		`JWTTokensByRole:` + mapStringForJWTTokensByRole + `,`,
+		`Grants:` + repeatedStringForGrants + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21215,6 +23411,18 @@ func (this *ApplicationCondition) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationDependency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDependency{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationDestination) String() string {
 	if this == nil {
 		return "nil"
@@ -21223,6 +23431,7 @@ func (this *ApplicationDestination) String() string {
 		`Server:` + fmt.Sprintf("%v", this.Server) + `,`,
 		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ClusterSelector:` + strings.Replace(fmt.Sprintf("%v", this.ClusterSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21239,6 +23448,47 @@ func (this *ApplicationDestinationServiceAccount) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationFleet) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRolloutSteps := "[]ApplicationFleetRolloutStep{"
+	for _, f := range this.RolloutSteps {
+		repeatedStringForRolloutSteps += strings.Replace(strings.Replace(f.String(), "ApplicationFleetRolloutStep", "ApplicationFleetRolloutStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRolloutSteps += "}"
+	s := strings.Join([]string{`&ApplicationFleet{`,
+		`RolloutSteps:` + repeatedStringForRolloutSteps + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationFleetClusterStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationFleetClusterStatus{`,
+		`Server:` + fmt.Sprintf("%v", this.Server) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Application:` + fmt.Sprintf("%v", this.Application) + `,`,
+		`Step:` + fmt.Sprintf("%v", this.Step) + `,`,
+		`Sync:` + fmt.Sprintf("%v", this.Sync) + `,`,
+		`Health:` + fmt.Sprintf("%v", this.Health) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationFleetRolloutStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationFleetRolloutStep{`,
+		`ClusterSelector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ClusterSelector), "LabelSelector", "v1.LabelSelector", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationList) String() string {
 	if this == nil {
 		return "nil"
@@ -21338,6 +23588,23 @@ func (this *ApplicationSetGenerator) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationSetGeneratorStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetGeneratorStatus{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ParamsCount:` + fmt.Sprintf("%v", this.ParamsCount) + `,`,
+		`Params:` + fmt.Sprintf("%v", this.Params) + `,`,
+		`ParamsTruncated:` + fmt.Sprintf("%v", this.ParamsTruncated) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`DurationMilliseconds:` + fmt.Sprintf("%v", this.DurationMilliseconds) + `,`,
+		`GeneratedAt:` + strings.Replace(fmt.Sprintf("%v", this.GeneratedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationSetList) String() string {
 	if this == nil {
 		return "nil"
@@ -21464,12 +23731,18 @@ func (this *ApplicationSetStatus) String() string {
 		repeatedStringForResources += strings.Replace(strings.Replace(f.String(), "ResourceStatus", "ResourceStatus", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForResources += "}"
+	repeatedStringForGenerators := "[]ApplicationSetGeneratorStatus{"
+	for _, f := range this.Generators {
+		repeatedStringForGenerators += strings.Replace(strings.Replace(f.String(), "ApplicationSetGeneratorStatus", "ApplicationSetGeneratorStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForGenerators += "}"
 	s := strings.Join([]string{`&ApplicationSetStatus{`,
 		`Conditions:` + repeatedStringForConditions + `,`,
 		`ApplicationStatus:` + repeatedStringForApplicationStatus + `,`,
 		`Resources:` + repeatedStringForResources + `,`,
 		`ResourcesCount:` + fmt.Sprintf("%v", this.ResourcesCount) + `,`,
 		`Health:` + strings.Replace(strings.Replace(this.Health.String(), "HealthStatus", "HealthStatus", 1), `&`, ``, 1) + `,`,
+		`Generators:` + repeatedStringForGenerators + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21752,6 +24025,11 @@ func (this *ApplicationSpec) String() string {
 		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForSources += "}"
+	repeatedStringForDependsOn := "[]ApplicationDependency{"
+	for _, f := range this.DependsOn {
+		repeatedStringForDependsOn += strings.Replace(strings.Replace(f.String(), "ApplicationDependency", "ApplicationDependency", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDependsOn += "}"
 	s := strings.Join([]string{`&ApplicationSpec{`,
 		`Source:` + strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1) + `,`,
 		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
@@ -21762,6 +24040,9 @@ func (this *ApplicationSpec) String() string {
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
 		`Sources:` + repeatedStringForSources + `,`,
 		`SourceHydrator:` + strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1) + `,`,
+		`DependsOn:` + repeatedStringForDependsOn + `,`,
+		`ProgressDeadlineSeconds:` + valueToStringGenerated(this.ProgressDeadlineSeconds) + `,`,
+		`Fleet:` + strings.Replace(this.Fleet.String(), "ApplicationFleet", "ApplicationFleet", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21785,6 +24066,11 @@ func (this *ApplicationStatus) String() string {
 		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "ApplicationCondition", "ApplicationCondition", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForConditions += "}"
+	repeatedStringForFleet := "[]ApplicationFleetClusterStatus{"
+	for _, f := range this.Fleet {
+		repeatedStringForFleet += strings.Replace(strings.Replace(f.String(), "ApplicationFleetClusterStatus", "ApplicationFleetClusterStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForFleet += "}"
 	s := strings.Join([]string{`&ApplicationStatus{`,
 		`Resources:` + repeatedStringForResources + `,`,
 		`Sync:` + strings.Replace(strings.Replace(this.Sync.String(), "SyncStatus", "SyncStatus", 1), `&`, ``, 1) + `,`,
@@ -21800,6 +24086,7 @@ func (this *ApplicationStatus) String() string {
 		`SourceTypes:` + fmt.Sprintf("%v", this.SourceTypes) + `,`,
 		`ControllerNamespace:` + fmt.Sprintf("%v", this.ControllerNamespace) + `,`,
 		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydratorStatus", "SourceHydratorStatus", 1), `&`, ``, 1) + `,`,
+		`Fleet:` + repeatedStringForFleet + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21855,6 +24142,17 @@ func (this *ApplicationWatchEvent) String() string {
 	}, "")
 	return s
 }
+func (this *AutomatedRollback) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AutomatedRollback{`,
+		`Window:` + fmt.Sprintf("%v", this.Window) + `,`,
+		`MaxAttempts:` + fmt.Sprintf("%v", this.MaxAttempts) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Backoff) String() string {
 	if this == nil {
 		return "nil"
@@ -21948,6 +24246,7 @@ func (this *Cluster) String() string {
 		`Project:` + fmt.Sprintf("%v", this.Project) + `,`,
 		`Labels:` + mapStringForLabels + `,`,
 		`Annotations:` + mapStringForAnnotations + `,`,
+		`MaxConcurrentSyncs:` + fmt.Sprintf("%v", this.MaxConcurrentSyncs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21960,6 +24259,7 @@ func (this *ClusterCacheInfo) String() string {
 		`ResourcesCount:` + fmt.Sprintf("%v", this.ResourcesCount) + `,`,
 		`APIsCount:` + fmt.Sprintf("%v", this.APIsCount) + `,`,
 		`LastCacheSyncTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCacheSyncTime), "Time", "v1.Time", 1) + `,`,
+		`EventsPerMinute:` + fmt.Sprintf("%v", this.EventsPerMinute) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22675,6 +24975,19 @@ func (this *MergeGenerator) String() string {
 	}, "")
 	return s
 }
+func (this *NamespaceResourceRestrictionItem) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceResourceRestrictionItem{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`LabelSelector:` + strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NestedMatrixGenerator) String() string {
 	if this == nil {
 		return "nil"
@@ -22736,6 +25049,20 @@ func (this *Operation) String() string {
 		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
 		`Info:` + repeatedStringForInfo + `,`,
 		`Retry:` + strings.Replace(strings.Replace(this.Retry.String(), "RetryStrategy", "RetryStrategy", 1), `&`, ``, 1) + `,`,
+		`AutomatedRollback:` + strings.Replace(this.AutomatedRollback.String(), "OperationAutomatedRollback", "OperationAutomatedRollback", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OperationAutomatedRollback) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OperationAutomatedRollback{`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
+		`HistoryID:` + fmt.Sprintf("%v", this.HistoryID) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22763,6 +25090,7 @@ func (this *OperationState) String() string {
 		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
 		`RetryCount:` + fmt.Sprintf("%v", this.RetryCount) + `,`,
+		`ScheduledAt:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledAt), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22821,6 +25149,25 @@ func (this *OrphanedResourcesMonitorSettings) String() string {
 	s := strings.Join([]string{`&OrphanedResourcesMonitorSettings{`,
 		`Warn:` + valueToStringGenerated(this.Warn) + `,`,
 		`Ignore:` + repeatedStringForIgnore + `,`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`Prune:` + strings.Replace(this.Prune.String(), "OrphanedResourcesPruneSettings", "OrphanedResourcesPruneSettings", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OrphanedResourcesPruneSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAllow := "[]OrphanedResourceKey{"
+	for _, f := range this.Allow {
+		repeatedStringForAllow += strings.Replace(strings.Replace(f.String(), "OrphanedResourceKey", "OrphanedResourceKey", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAllow += "}"
+	s := strings.Join([]string{`&OrphanedResourcesPruneSettings{`,
+		`GracePeriodSeconds:` + fmt.Sprintf("%v", this.GracePeriodSeconds) + `,`,
+		`Allow:` + repeatedStringForAllow + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22833,6 +25180,8 @@ func (this *OverrideIgnoreDiff) String() string {
 		`JSONPointers:` + fmt.Sprintf("%v", this.JSONPointers) + `,`,
 		`JQPathExpressions:` + fmt.Sprintf("%v", this.JQPathExpressions) + `,`,
 		`ManagedFieldsManagers:` + fmt.Sprintf("%v", this.ManagedFieldsManagers) + `,`,
+		`CELExpressions:` + fmt.Sprintf("%v", this.CELExpressions) + `,`,
+		`CELCondition:` + fmt.Sprintf("%v", this.CELCondition) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22891,6 +25240,64 @@ func (this *PluginInput) String() string {
 	}, "")
 	return s
 }
+func (this *ProjectNamespaceTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&ProjectNamespaceTemplate{`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`ResourceQuota:` + strings.Replace(fmt.Sprintf("%v", this.ResourceQuota), "ResourceQuotaSpec", "v12.ResourceQuotaSpec", 1) + `,`,
+		`LimitRange:` + strings.Replace(fmt.Sprintf("%v", this.LimitRange), "LimitRangeSpec", "v12.LimitRangeSpec", 1) + `,`,
+		`DefaultDenyNetworkPolicy:` + fmt.Sprintf("%v", this.DefaultDenyNetworkPolicy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectQuotaUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectQuotaUsage{`,
+		`Applications:` + fmt.Sprintf("%v", this.Applications) + `,`,
+		`ManagedResources:` + fmt.Sprintf("%v", this.ManagedResources) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectQuotas) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectQuotas{`,
+		`MaxApplications:` + fmt.Sprintf("%v", this.MaxApplications) + `,`,
+		`MaxResourcesPerApplication:` + fmt.Sprintf("%v", this.MaxResourcesPerApplication) + `,`,
+		`MaxManagedResources:` + fmt.Sprintf("%v", this.MaxManagedResources) + `,`,
+		`MaxManifestSizeBytes:` + fmt.Sprintf("%v", this.MaxManifestSizeBytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ProjectRole) String() string {
 	if this == nil {
 		return "nil"
@@ -22906,6 +25313,38 @@ func (this *ProjectRole) String() string {
 		`Policies:` + fmt.Sprintf("%v", this.Policies) + `,`,
 		`JWTTokens:` + repeatedStringForJWTTokens + `,`,
 		`Groups:` + fmt.Sprintf("%v", this.Groups) + `,`,
+		`RequireGrantApproval:` + fmt.Sprintf("%v", this.RequireGrantApproval) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectRoleGrant) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectRoleGrant{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Policies:` + fmt.Sprintf("%v", this.Policies) + `,`,
+		`Groups:` + fmt.Sprintf("%v", this.Groups) + `,`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`RequestedBy:` + fmt.Sprintf("%v", this.RequestedBy) + `,`,
+		`RequestedAt:` + fmt.Sprintf("%v", this.RequestedAt) + `,`,
+		`ApprovedBy:` + fmt.Sprintf("%v", this.ApprovedBy) + `,`,
+		`ExpiresAt:` + fmt.Sprintf("%v", this.ExpiresAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectRuleOrigin) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectRuleOrigin{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Rule:` + fmt.Sprintf("%v", this.Rule) + `,`,
+		`Project:` + fmt.Sprintf("%v", this.Project) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23285,6 +25724,8 @@ func (this *ResourceIgnoreDifferences) String() string {
 		`JSONPointers:` + fmt.Sprintf("%v", this.JSONPointers) + `,`,
 		`JQPathExpressions:` + fmt.Sprintf("%v", this.JQPathExpressions) + `,`,
 		`ManagedFieldsManagers:` + fmt.Sprintf("%v", this.ManagedFieldsManagers) + `,`,
+		`CELExpressions:` + fmt.Sprintf("%v", this.CELExpressions) + `,`,
+		`CELCondition:` + fmt.Sprintf("%v", this.CELCondition) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23376,6 +25817,7 @@ func (this *ResourceOverride) String() string {
 		`KnownTypeFields:` + repeatedStringForKnownTypeFields + `,`,
 		`UseOpenLibs:` + fmt.Sprintf("%v", this.UseOpenLibs) + `,`,
 		`IgnoreResourceUpdates:` + strings.Replace(strings.Replace(this.IgnoreResourceUpdates.String(), "OverrideIgnoreDiff", "OverrideIgnoreDiff", 1), `&`, ``, 1) + `,`,
+		`HealthCEL:` + fmt.Sprintf("%v", this.HealthCEL) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23411,6 +25853,7 @@ func (this *ResourceResult) String() string {
 		`HookPhase:` + fmt.Sprintf("%v", this.HookPhase) + `,`,
 		`SyncPhase:` + fmt.Sprintf("%v", this.SyncPhase) + `,`,
 		`Images:` + fmt.Sprintf("%v", this.Images) + `,`,
+		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23465,6 +25908,7 @@ func (this *RevisionHistory) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`HealthStatus:` + fmt.Sprintf("%v", this.HealthStatus) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23831,6 +26275,7 @@ func (this *SyncOperation) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`SelfHealAttemptsCount:` + fmt.Sprintf("%v", this.SelfHealAttemptsCount) + `,`,
+		`Timeouts:` + strings.Replace(this.Timeouts.String(), "SyncTimeouts", "SyncTimeouts", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23882,6 +26327,8 @@ func (this *SyncPolicy) String() string {
 		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
 		`Retry:` + strings.Replace(this.Retry.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
 		`ManagedNamespaceMetadata:` + strings.Replace(this.ManagedNamespaceMetadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`AutomatedRollback:` + strings.Replace(this.AutomatedRollback.String(), "AutomatedRollback", "AutomatedRollback", 1) + `,`,
+		`Timeouts:` + strings.Replace(this.Timeouts.String(), "SyncTimeouts", "SyncTimeouts", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23955,6 +26402,17 @@ func (this *SyncStrategyHook) String() string {
 	}, "")
 	return s
 }
+func (this *SyncTimeouts) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncTimeouts{`,
+		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
+		`Health:` + fmt.Sprintf("%v", this.Health) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SyncWindow) String() string {
 	if this == nil {
 		return "nil"
@@ -23971,6 +26429,42 @@ func (this *SyncWindow) String() string {
 		`UseAndOperator:` + fmt.Sprintf("%v", this.UseAndOperator) + `,`,
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
 		`SyncOverrun:` + fmt.Sprintf("%v", this.SyncOverrun) + `,`,
+		`Calendar:` + strings.Replace(this.Calendar.String(), "SyncWindowCalendar", "SyncWindowCalendar", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncWindowCalendar) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWindowCalendar{`,
+		`ICS:` + fmt.Sprintf("%v", this.ICS) + `,`,
+		`ConfigMapRef:` + strings.Replace(this.ConfigMapRef.String(), "SyncWindowCalendarConfigMapRef", "SyncWindowCalendarConfigMapRef", 1) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncWindowCalendarConfigMapRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWindowCalendarConfigMapRef{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncWindowOccurrence) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWindowOccurrence{`,
+		`Start:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Start), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`End:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.End), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Summary:` + fmt.Sprintf("%v", this.Summary) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24794,7 +27288,7 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceResourceBlacklist = append(m.NamespaceResourceBlacklist, v1.GroupKind{})
+			m.NamespaceResourceBlacklist = append(m.NamespaceResourceBlacklist, NamespaceResourceRestrictionItem{})
 			if err := m.NamespaceResourceBlacklist[len(m.NamespaceResourceBlacklist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -24898,7 +27392,7 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceResourceWhitelist = append(m.NamespaceResourceWhitelist, v1.GroupKind{})
+			m.NamespaceResourceWhitelist = append(m.NamespaceResourceWhitelist, NamespaceResourceRestrictionItem{})
 			if err := m.NamespaceResourceWhitelist[len(m.NamespaceResourceWhitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -25093,6 +27587,130 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProgressDeadlineSeconds = &v
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quotas == nil {
+				m.Quotas = &ProjectQuotas{}
+			}
+			if err := m.Quotas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NamespaceTemplate == nil {
+				m.NamespaceTemplate = &ProjectNamespaceTemplate{}
+			}
+			if err := m.NamespaceTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25272,6 +27890,40 @@ func (m *AppProjectStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.JWTTokensByRole[mapkey] = *mapvalue
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ProjectRoleGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25628,7 +28280,7 @@ func (m *ApplicationCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDependency) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25651,15 +28303,15 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationDestination: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDependency: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDependency: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25687,7 +28339,7 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -25723,9 +28375,9 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25735,23 +28387,27 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25774,7 +28430,7 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25797,10 +28453,10 @@ func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDestination: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDestination: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -25869,7 +28525,7 @@ func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultServiceAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25897,61 +28553,11 @@ func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefaultServiceAccount = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25978,41 +28584,10 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.ClusterSelector == nil {
+				m.ClusterSelector = &v1.LabelSelector{}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, Application{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClusterSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26037,7 +28612,7 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26060,15 +28635,15 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationMatchExpression: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationMatchExpression: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26096,11 +28671,11 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Server = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26128,125 +28703,11 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationPreservedFields: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationPreservedFields: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Annotations = append(m.Annotations, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultServiceAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26274,7 +28735,7 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			m.DefaultServiceAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26297,7 +28758,7 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
+func (m *ApplicationFleet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26320,15 +28781,15 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationFleet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationFleet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutSteps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26355,73 +28816,8 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RolloutSteps = append(m.RolloutSteps, ApplicationFleetRolloutStep{})
+			if err := m.RolloutSteps[len(m.RolloutSteps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26446,7 +28842,7 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
+func (m *ApplicationFleetClusterStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26469,15 +28865,15 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetApplicationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationFleetClusterStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationFleetClusterStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26505,13 +28901,13 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Application = string(dAtA[iNdEx:postIndex])
+			m.Server = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26521,31 +28917,27 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastTransitionTime == nil {
-				m.LastTransitionTime = &v1.Time{}
-			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26573,11 +28965,30 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Application = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26605,11 +29016,11 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ProgressiveSyncStatusCode(dAtA[iNdEx:postIndex])
+			m.Sync = SyncStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26637,11 +29048,11 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Step = string(dAtA[iNdEx:postIndex])
+			m.Health = github_com_argoproj_argo_cd_gitops_engine_v3_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26669,7 +29080,7 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetRevisions = append(m.TargetRevisions, string(dAtA[iNdEx:postIndex]))
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26692,7 +29103,7 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
+func (m *ApplicationFleetRolloutStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26715,17 +29126,17 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationFleetRolloutStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationFleetRolloutStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterSelector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26735,29 +29146,80 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ApplicationSetConditionType(dAtA[iNdEx:postIndex])
+			if err := m.ClusterSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26767,27 +29229,28 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26814,16 +29277,96 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastTransitionTime == nil {
-				m.LastTransitionTime = &v1.Time{}
+			m.Items = append(m.Items, Application{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationMatchExpression: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationMatchExpression: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26851,11 +29394,11 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ApplicationSetConditionStatus(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26883,7 +29426,7 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26906,7 +29449,7 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
+func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26929,17 +29472,17 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationPreservedFields: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationPreservedFields: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26949,33 +29492,29 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.List == nil {
-				m.List = &ListGenerator{}
-			}
-			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Annotations = append(m.Annotations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26985,31 +29524,77 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clusters == nil {
-				m.Clusters = &ClusterGenerator{}
-			}
-			if err := m.Clusters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27036,16 +29621,13 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitGenerator{}
-			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SCMProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27072,16 +29654,13 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SCMProvider == nil {
-				m.SCMProvider = &SCMProviderGenerator{}
-			}
-			if err := m.SCMProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDecisionResource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27108,18 +29687,65 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClusterDecisionResource == nil {
-				m.ClusterDecisionResource = &DuckTypeGenerator{}
-			}
-			if err := m.ClusterDecisionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetApplicationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27129,31 +29755,27 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PullRequest == nil {
-				m.PullRequest = &PullRequestGenerator{}
-			}
-			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Application = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Matrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27180,18 +29802,18 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Matrix == nil {
-				m.Matrix = &MatrixGenerator{}
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
 			}
-			if err := m.Matrix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27201,33 +29823,29 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Merge == nil {
-				m.Merge = &MergeGenerator{}
-			}
-			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27237,33 +29855,29 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Selector == nil {
-				m.Selector = &v1.LabelSelector{}
-			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = ProgressiveSyncStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27273,27 +29887,55 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &PluginGenerator{}
+			m.Step = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevisions", wireType)
 			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRevisions = append(m.TargetRevisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27316,7 +29958,7 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27339,17 +29981,17 @@ func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27359,28 +30001,59 @@ func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = ApplicationSetConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27407,11 +30080,77 @@ func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ApplicationSet{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ApplicationSetConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27433,7 +30172,7 @@ func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27456,10 +30195,10 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetNestedGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetNestedGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -27708,7 +30447,7 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Matrix == nil {
-				m.Matrix = &v11.JSON{}
+				m.Matrix = &MatrixGenerator{}
 			}
 			if err := m.Matrix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -27744,7 +30483,7 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Merge == nil {
-				m.Merge = &v11.JSON{}
+				m.Merge = &MergeGenerator{}
 			}
 			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -27843,7 +30582,7 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetGeneratorStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27866,17 +30605,17 @@ func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetResourceIgnoreDifferences: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetGeneratorStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetGeneratorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27886,27 +30625,14 @@ func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27934,11 +30660,30 @@ func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsCount", wireType)
+			}
+			m.ParamsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27966,63 +30711,33 @@ func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
+			m.Params = append(m.Params, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsTruncated", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetRolloutStep: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetRolloutStep: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ParamsTruncated = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatchExpressions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28032,29 +30747,46 @@ func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MatchExpressions = append(m.MatchExpressions, ApplicationMatchExpression{})
-			if err := m.MatchExpressions[len(m.MatchExpressions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMilliseconds", wireType)
+			}
+			m.DurationMilliseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationMilliseconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneratedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28081,10 +30813,10 @@ func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxUpdate == nil {
-				m.MaxUpdate = &intstr.IntOrString{}
+			if m.GeneratedAt == nil {
+				m.GeneratedAt = &v1.Time{}
 			}
-			if err := m.MaxUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GeneratedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28109,7 +30841,7 @@ func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28132,15 +30864,15 @@ func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28167,8 +30899,41 @@ func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, ApplicationSetRolloutStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ApplicationSet{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28193,7 +30958,7 @@ func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28216,17 +30981,17 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetNestedGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetNestedGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28236,15 +31001,31 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.GoTemplate = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.List == nil {
+				m.List = &ListGenerator{}
+			}
+			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28271,14 +31052,16 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Clusters == nil {
+				m.Clusters = &ClusterGenerator{}
+			}
+			if err := m.Clusters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28305,13 +31088,16 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Git == nil {
+				m.Git = &GitGenerator{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SCMProvider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28338,16 +31124,16 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncPolicy == nil {
-				m.SyncPolicy = &ApplicationSetSyncPolicy{}
+			if m.SCMProvider == nil {
+				m.SCMProvider = &SCMProviderGenerator{}
 			}
-			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SCMProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDecisionResource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28374,16 +31160,16 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Strategy == nil {
-				m.Strategy = &ApplicationSetStrategy{}
+			if m.ClusterDecisionResource == nil {
+				m.ClusterDecisionResource = &DuckTypeGenerator{}
 			}
-			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClusterDecisionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreservedFields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28410,18 +31196,18 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreservedFields == nil {
-				m.PreservedFields = &ApplicationPreservedFields{}
+			if m.PullRequest == nil {
+				m.PullRequest = &PullRequestGenerator{}
 			}
-			if err := m.PreservedFields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplateOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Matrix", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28431,47 +31217,31 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoTemplateOptions = append(m.GoTemplateOptions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplyNestedSelectors", wireType)
+			if m.Matrix == nil {
+				m.Matrix = &v11.JSON{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Matrix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ApplyNestedSelectors = bool(v != 0)
-		case 9:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreApplicationDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28498,16 +31268,54 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreApplicationDifferences = append(m.IgnoreApplicationDifferences, ApplicationSetResourceIgnoreDifferences{})
-			if err := m.IgnoreApplicationDifferences[len(m.IgnoreApplicationDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Merge == nil {
+				m.Merge = &v11.JSON{}
+			}
+			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplatePatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28517,24 +31325,27 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.TemplatePatch = &s
+			if m.Plugin == nil {
+				m.Plugin = &PluginGenerator{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28557,7 +31368,7 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28580,17 +31391,17 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetResourceIgnoreDifferences: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28600,31 +31411,29 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, ApplicationSetCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28634,84 +31443,29 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApplicationStatus = append(m.ApplicationStatus, ApplicationSetApplicationStatus{})
-			if err := m.ApplicationStatus[len(m.ApplicationStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Resources = append(m.Resources, ResourceStatus{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesCount", wireType)
-			}
-			m.ResourcesCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ResourcesCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28721,24 +31475,23 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28761,7 +31514,7 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28784,17 +31537,17 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetRolloutStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetRolloutStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchExpressions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28804,27 +31557,29 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.MatchExpressions = append(m.MatchExpressions, ApplicationMatchExpression{})
+			if err := m.MatchExpressions[len(m.MatchExpressions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollingSync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28851,45 +31606,13 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RollingSync == nil {
-				m.RollingSync = &ApplicationSetRolloutStrategy{}
+			if m.MaxUpdate == nil {
+				m.MaxUpdate = &intstr.IntOrString{}
 			}
-			if err := m.RollingSync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletionOrder", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeletionOrder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28911,7 +31634,7 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28934,37 +31657,17 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetSyncPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetSyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreserveResourcesOnDeletion", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.PreserveResourcesOnDeletion = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsSync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28974,24 +31677,25 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := ApplicationsSyncPolicy(dAtA[iNdEx:postIndex])
-			m.ApplicationsSync = &s
+			m.Steps = append(m.Steps, ApplicationSetRolloutStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29014,7 +31718,7 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29037,15 +31741,35 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GoTemplate = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSetTemplateMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29072,13 +31796,14 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationSetTemplateMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Generators = append(m.Generators, ApplicationSetGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29105,65 +31830,15 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTemplateMeta: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTemplateMeta: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29173,29 +31848,33 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.SyncPolicy == nil {
+				m.SyncPolicy = &ApplicationSetSyncPolicy{}
+			}
+			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29205,27 +31884,31 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.Strategy == nil {
+				m.Strategy = &ApplicationSetStrategy{}
+			}
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreservedFields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29252,109 +31935,18 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.PreservedFields == nil {
+				m.PreservedFields = &ApplicationPreservedFields{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.PreservedFields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplateOptions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29364,122 +31956,81 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			m.GoTemplateOptions = append(m.GoTemplateOptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyNestedSelectors", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			m.ApplyNestedSelectors = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreApplicationDifferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgnoreApplicationDifferences = append(m.IgnoreApplicationDifferences, ApplicationSetResourceIgnoreDifferences{})
+			if err := m.IgnoreApplicationDifferences[len(m.IgnoreApplicationDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplatePatch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29507,7 +32058,8 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Finalizers = append(m.Finalizers, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.TemplatePatch = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29530,7 +32082,7 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29553,15 +32105,15 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTerminalGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTerminalGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29588,16 +32140,14 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.List == nil {
-				m.List = &ListGenerator{}
-			}
-			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, ApplicationSetCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29624,16 +32174,14 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clusters == nil {
-				m.Clusters = &ClusterGenerator{}
-			}
-			if err := m.Clusters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ApplicationStatus = append(m.ApplicationStatus, ApplicationSetApplicationStatus{})
+			if err := m.ApplicationStatus[len(m.ApplicationStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29660,16 +32208,33 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitGenerator{}
-			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Resources = append(m.Resources, ResourceStatus{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesCount", wireType)
+			}
+			m.ResourcesCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourcesCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SCMProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29696,16 +32261,13 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SCMProvider == nil {
-				m.SCMProvider = &SCMProviderGenerator{}
-			}
-			if err := m.SCMProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDecisionResource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29732,18 +32294,66 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClusterDecisionResource == nil {
-				m.ClusterDecisionResource = &DuckTypeGenerator{}
-			}
-			if err := m.ClusterDecisionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Generators = append(m.Generators, ApplicationSetGeneratorStatus{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29753,31 +32363,27 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PullRequest == nil {
-				m.PullRequest = &PullRequestGenerator{}
-			}
-			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollingSync", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29804,18 +32410,18 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &PluginGenerator{}
+			if m.RollingSync == nil {
+				m.RollingSync = &ApplicationSetRolloutStrategy{}
 			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RollingSync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionOrder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29825,27 +32431,23 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Selector == nil {
-				m.Selector = &v1.LabelSelector{}
-			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DeletionOrder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29868,7 +32470,7 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetTree) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29891,17 +32493,37 @@ func (m *ApplicationSetTree) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTree: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetSyncPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTree: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetSyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreserveResourcesOnDeletion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PreserveResourcesOnDeletion = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsSync", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29911,25 +32533,24 @@ func (m *ApplicationSetTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, ResourceNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := ApplicationsSyncPolicy(dAtA[iNdEx:postIndex])
+			m.ApplicationsSync = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29952,7 +32573,7 @@ func (m *ApplicationSetTree) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetWatchEvent) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29975,17 +32596,17 @@ func (m *ApplicationSetWatchEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetWatchEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSetTemplateMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29995,27 +32616,28 @@ func (m *ApplicationSetWatchEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = k8s_io_apimachinery_pkg_watch.EventType(dAtA[iNdEx:postIndex])
+			if err := m.ApplicationSetTemplateMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30042,7 +32664,7 @@ func (m *ApplicationSetWatchEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30067,7 +32689,7 @@ func (m *ApplicationSetWatchEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30090,15 +32712,15 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetTemplateMeta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetTemplateMeta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30126,43 +32748,11 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30190,47 +32780,11 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetRevision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Helm == nil {
-				m.Helm = &ApplicationSourceHelm{}
-			}
-			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30257,24 +32811,2349 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kustomize == nil {
-				m.Kustomize = &ApplicationSourceKustomize{}
-			}
-			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Finalizers = append(m.Finalizers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetTerminalGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetTerminalGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.List == nil {
+				m.List = &ListGenerator{}
+			}
+			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Clusters == nil {
+				m.Clusters = &ClusterGenerator{}
+			}
+			if err := m.Clusters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Git == nil {
+				m.Git = &GitGenerator{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SCMProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SCMProvider == nil {
+				m.SCMProvider = &SCMProviderGenerator{}
+			}
+			if err := m.SCMProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDecisionResource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterDecisionResource == nil {
+				m.ClusterDecisionResource = &DuckTypeGenerator{}
+			}
+			if err := m.ClusterDecisionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &PullRequestGenerator{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = &PluginGenerator{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetTree) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetTree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetTree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, ResourceNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetWatchEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetWatchEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = k8s_io_apimachinery_pkg_watch.EventType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Helm == nil {
+				m.Helm = &ApplicationSourceHelm{}
+			}
+			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kustomize == nil {
+				m.Kustomize = &ApplicationSourceKustomize{}
+			}
+			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Directory == nil {
+				m.Directory = &ApplicationSourceDirectory{}
+			}
+			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = &ApplicationSourcePlugin{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chart = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceDirectory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceDirectory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recurse = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jsonnet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Jsonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exclude = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Include = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableExtensionFilter", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableExtensionFilter = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceHelm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceHelm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, HelmParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileParameters = append(m.FileParameters, HelmFileParameter{})
+			if err := m.FileParameters[len(m.FileParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassCredentials", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PassCredentials = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreMissingValueFiles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreMissingValueFiles = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipCrds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipCrds = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesObject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValuesObject == nil {
+				m.ValuesObject = &runtime.RawExtension{}
+			}
+			if err := m.ValuesObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipTests", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipTests = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipSchemaValidation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipSchemaValidation = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceJsonnet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceJsonnet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtVars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtVars = append(m.ExtVars, JsonnetVar{})
+			if err := m.ExtVars[len(m.ExtVars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLAs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLAs = append(m.TLAs, JsonnetVar{})
+			if err := m.TLAs[len(m.TLAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Libs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Libs = append(m.Libs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceKustomize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceKustomize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSuffix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSuffix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, KustomizeImage(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommonLabels == nil {
+				m.CommonLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CommonLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAnnotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
@@ -30293,16 +35172,199 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Directory == nil {
-				m.Directory = &ApplicationSourceDirectory{}
+			if m.CommonAnnotations == nil {
+				m.CommonAnnotations = make(map[string]string)
 			}
-			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CommonAnnotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceCommonLabels", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceCommonLabels = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceCommonAnnotations", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceCommonAnnotations = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAnnotationsEnvsubst", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommonAnnotationsEnvsubst = bool(v != 0)
 		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30329,16 +35391,132 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &ApplicationSourcePlugin{}
-			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Replicas = append(m.Replicas, KustomizeReplica{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patches = append(m.Patches, KustomizePatch{})
+			if err := m.Patches[len(m.Patches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelWithoutSelector", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LabelWithoutSelector = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30363,14 +35541,104 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreMissingComponents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreMissingComponents = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelIncludeTemplates", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LabelIncludeTemplates = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chart = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourcePlugin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourcePlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30398,13 +35666,13 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ref = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30414,29 +35682,31 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Env = append(m.Env, &EnvEntry{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TagPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30446,23 +35716,25 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TagPrefix = string(dAtA[iNdEx:postIndex])
+			m.Parameters = append(m.Parameters, ApplicationSourcePluginParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30485,7 +35757,7 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30508,17 +35780,17 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceDirectory: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSourcePluginParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceDirectory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSourcePluginParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recurse", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30528,15 +35800,27 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Recurse = bool(v != 0)
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jsonnet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalMap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30563,15 +35847,18 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Jsonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OptionalMap == nil {
+				m.OptionalMap = &OptionalMap{}
+			}
+			if err := m.OptionalMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalArray", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30581,27 +35868,31 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exclude = string(dAtA[iNdEx:postIndex])
+			if m.OptionalArray == nil {
+				m.OptionalArray = &OptionalArray{}
+			}
+			if err := m.OptionalArray.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30629,28 +35920,9 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Include = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.String_ = &s
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableExtensionFilter", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DisableExtensionFilter = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30672,7 +35944,7 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30695,17 +35967,17 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceHelm: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceHelm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30715,27 +35987,31 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
+			if m.Source == nil {
+				m.Source = &ApplicationSource{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30762,14 +36038,13 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, HelmParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30797,13 +36072,13 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReleaseName = string(dAtA[iNdEx:postIndex])
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30813,27 +36088,31 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = string(dAtA[iNdEx:postIndex])
+			if m.SyncPolicy == nil {
+				m.SyncPolicy = &SyncPolicy{}
+			}
+			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30860,16 +36139,16 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileParameters = append(m.FileParameters, HelmFileParameter{})
-			if err := m.FileParameters[len(m.FileParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
+			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30879,29 +36158,31 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Info = append(m.Info, Info{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PassCredentials", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30911,55 +36192,15 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.PassCredentials = bool(v != 0)
+			m.RevisionHistoryLimit = &v
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreMissingValueFiles", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IgnoreMissingValueFiles = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipCrds", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SkipCrds = bool(v != 0)
-		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValuesObject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30986,18 +36227,16 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValuesObject == nil {
-				m.ValuesObject = &runtime.RawExtension{}
-			}
-			if err := m.ValuesObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31007,61 +36246,33 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.SourceHydrator == nil {
+				m.SourceHydrator = &SourceHydrator{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.KubeVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31071,49 +36282,31 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipTests", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.DependsOn = append(m.DependsOn, ApplicationDependency{})
+			if err := m.DependsOn[len(m.DependsOn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SkipTests = bool(v != 0)
-		case 15:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipSchemaValidation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31123,65 +36316,15 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SkipSchemaValidation = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceJsonnet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceJsonnet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ProgressDeadlineSeconds = &v
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtVars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fleet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31208,77 +36351,13 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtVars = append(m.ExtVars, JsonnetVar{})
-			if err := m.ExtVars[len(m.ExtVars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLAs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Fleet == nil {
+				m.Fleet = &ApplicationFleet{}
 			}
-			m.TLAs = append(m.TLAs, JsonnetVar{})
-			if err := m.TLAs[len(m.TLAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fleet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Libs", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Libs = append(m.Libs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31300,7 +36379,7 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
+func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31323,17 +36402,17 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceKustomize: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceKustomize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31343,29 +36422,31 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamePrefix = string(dAtA[iNdEx:postIndex])
+			m.Resources = append(m.Resources, ResourceStatus{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NameSuffix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31375,29 +36456,30 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NameSuffix = string(dAtA[iNdEx:postIndex])
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31407,27 +36489,28 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, KustomizeImage(dAtA[iNdEx:postIndex]))
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonLabels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31454,109 +36537,16 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommonLabels == nil {
-				m.CommonLabels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.History = append(m.History, RevisionHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.CommonLabels[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31566,27 +36556,29 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Conditions = append(m.Conditions, ApplicationCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonAnnotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReconciledAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31613,109 +36605,18 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommonAnnotations == nil {
-				m.CommonAnnotations = make(map[string]string)
+			if m.ReconciledAt == nil {
+				m.ReconciledAt = &v1.Time{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.ReconciledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.CommonAnnotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceCommonLabels", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationState", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31725,17 +36626,33 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceCommonLabels = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OperationState == nil {
+				m.OperationState = &OperationState{}
+			}
+			if err := m.OperationState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceCommonAnnotations", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedAt", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31745,15 +36662,31 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceCommonAnnotations = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObservedAt == nil {
+				m.ObservedAt = &v1.Time{}
+			}
+			if err := m.ObservedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31781,31 +36714,11 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.SourceType = ApplicationSourceType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonAnnotationsEnvsubst", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.CommonAnnotationsEnvsubst = bool(v != 0)
-		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31832,16 +36745,15 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Replicas = append(m.Replicas, KustomizeReplica{})
-			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHealthSource", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31851,29 +36763,27 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Patches = append(m.Patches, KustomizePatch{})
-			if err := m.Patches[len(m.Patches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ResourceHealthSource = ResourceHealthLocation(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31901,31 +36811,11 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Components = append(m.Components, string(dAtA[iNdEx:postIndex]))
+			m.SourceTypes = append(m.SourceTypes, ApplicationSourceType(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelWithoutSelector", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.LabelWithoutSelector = bool(v != 0)
-		case 15:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31953,13 +36843,13 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubeVersion = string(dAtA[iNdEx:postIndex])
+			m.ControllerNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31969,29 +36859,30 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreMissingComponents", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fleet", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32001,32 +36892,26 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IgnoreMissingComponents = bool(v != 0)
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelIncludeTemplates", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.LabelIncludeTemplates = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fleet = append(m.Fleet, ApplicationFleetClusterStatus{})
+			if err := m.Fleet[len(m.Fleet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32048,7 +36933,7 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32071,15 +36956,15 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourcePlugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourcePlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32107,13 +36992,13 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32123,31 +37008,29 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, &EnvEntry{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAppOfApps", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32157,26 +37040,12 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parameters = append(m.Parameters, ApplicationSourcePluginParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IsAppOfApps = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32198,7 +37067,7 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
+func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32221,17 +37090,17 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourcePluginParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationTree: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourcePluginParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationTree: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32241,27 +37110,29 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Nodes = append(m.Nodes, ResourceNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionalMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedNodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32288,16 +37159,14 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OptionalMap == nil {
-				m.OptionalMap = &OptionalMap{}
-			}
-			if err := m.OptionalMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OrphanedNodes = append(m.OrphanedNodes, ResourceNode{})
+			if err := m.OrphanedNodes[len(m.OrphanedNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionalArray", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32324,18 +37193,16 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OptionalArray == nil {
-				m.OptionalArray = &OptionalArray{}
-			}
-			if err := m.OptionalArray.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Hosts = append(m.Hosts, HostInfo{})
+			if err := m.Hosts[len(m.Hosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardsCount", wireType)
 			}
-			var stringLen uint64
+			m.ShardsCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32345,25 +37212,11 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ShardsCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.String_ = &s
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32385,7 +37238,7 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
+func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32408,17 +37261,17 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationWatchEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32428,31 +37281,27 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &ApplicationSource{}
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = k8s_io_apimachinery_pkg_watch.EventType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32479,13 +37328,63 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutomatedRollback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutomatedRollback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutomatedRollback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32513,13 +37412,13 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.Window = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
 			}
-			var msglen int
+			m.MaxAttempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32529,67 +37428,66 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxAttempts |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncPolicy == nil {
-				m.SyncPolicy = &SyncPolicy{}
-			}
-			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Backoff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
-			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32599,29 +37497,27 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, Info{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
 			}
 			var v int64
 			for shift := uint(0); ; shift += 7 {
@@ -32638,46 +37534,12 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.RevisionHistoryLimit = &v
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+			m.Factor = &v
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32687,27 +37549,23 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SourceHydrator == nil {
-				m.SourceHydrator = &SourceHydrator{}
-			}
-			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32730,7 +37588,7 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
+func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32753,17 +37611,17 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: BasicAuthBitbucketServer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BasicAuthBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32773,29 +37631,27 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, ResourceStatus{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32822,13 +37678,66 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PasswordRef == nil {
+				m.PasswordRef = &SecretRef{}
+			}
+			if err := m.PasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BearerTokenBitbucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BearerTokenBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32855,13 +37764,66 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BearerTokenBitbucketCloud: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BearerTokenBitbucketCloud: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32888,16 +37850,68 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.History = append(m.History, RevisionHistory{})
-			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32907,31 +37921,29 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, ApplicationCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReconciledAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Home", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32941,33 +37953,29 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReconciledAt == nil {
-				m.ReconciledAt = &v1.Time{}
-			}
-			if err := m.ReconciledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Home = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32977,33 +37985,79 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OperationState == nil {
-				m.OperationState = &OperationState{}
-			}
-			if err := m.OperationState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Maintainers = append(m.Maintainers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cluster) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33013,31 +38067,27 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ObservedAt == nil {
-				m.ObservedAt = &v1.Time{}
-			}
-			if err := m.ObservedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Server = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33065,11 +38115,11 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceType = ApplicationSourceType(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33096,15 +38146,15 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHealthSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33114,27 +38164,28 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceHealthSource = ResourceHealthLocation(dAtA[iNdEx:postIndex])
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33162,11 +38213,11 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceTypes = append(m.SourceTypes, ApplicationSourceType(dAtA[iNdEx:postIndex]))
+			m.ServerVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControllerNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33194,11 +38245,11 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ControllerNamespace = string(dAtA[iNdEx:postIndex])
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 14:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshRequestedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33225,65 +38276,18 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.RefreshRequestedAt == nil {
+				m.RefreshRequestedAt = &v1.Time{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.RefreshRequestedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSummary: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSummary: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33293,29 +38297,30 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33325,27 +38330,15 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
+			m.Shard = &v
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsAppOfApps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterResources", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -33362,62 +38355,12 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsAppOfApps = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationTree: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationTree: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ClusterResources = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33427,29 +38370,27 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, ResourceNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33476,14 +38417,107 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrphanedNodes = append(m.OrphanedNodes, ResourceNode{})
-			if err := m.OrphanedNodes[len(m.OrphanedNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33500,26 +38534,119 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hosts = append(m.Hosts, HostInfo{})
-			if err := m.Hosts[len(m.Hosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardsCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConcurrentSyncs", wireType)
 			}
-			m.ShardsCount = 0
+			m.MaxConcurrentSyncs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33529,7 +38656,7 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardsCount |= int64(b&0x7F) << shift
+				m.MaxConcurrentSyncs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -33555,7 +38682,7 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
+func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33578,17 +38705,17 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWatchEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCacheInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCacheInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesCount", wireType)
 			}
-			var stringLen uint64
+			m.ResourcesCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33598,27 +38725,33 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ResourcesCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIsCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.APIsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.APIsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Type = k8s_io_apimachinery_pkg_watch.EventType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCacheSyncTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33645,10 +38778,32 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastCacheSyncTime == nil {
+				m.LastCacheSyncTime = &v1.Time{}
+			}
+			if err := m.LastCacheSyncTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsPerMinute", wireType)
+			}
+			m.EventsPerMinute = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventsPerMinute |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33670,7 +38825,7 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Backoff) Unmarshal(dAtA []byte) error {
+func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33693,15 +38848,15 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33729,13 +38884,13 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var v int64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33745,15 +38900,27 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Factor = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33781,63 +38948,46 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			m.BearerToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientConfig", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TLSClientConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BasicAuthBitbucketServer: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasicAuthBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSAuthConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33847,27 +38997,31 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			if m.AWSAuthConfig == nil {
+				m.AWSAuthConfig = &AWSAuthConfig{}
+			}
+			if err := m.AWSAuthConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecProviderConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33894,68 +39048,38 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordRef == nil {
-				m.PasswordRef = &SecretRef{}
+			if m.ExecProviderConfig == nil {
+				m.ExecProviderConfig = &ExecProviderConfig{}
 			}
-			if err := m.PasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableCompression", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BearerTokenBitbucket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BearerTokenBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DisableCompression = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33965,27 +39089,23 @@ func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProxyUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34008,7 +39128,7 @@ func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
+func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34031,15 +39151,15 @@ func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BearerTokenBitbucketCloud: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BearerTokenBitbucketCloud: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34066,68 +39186,15 @@ func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChartDetails) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChartDetails: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartDetails: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34137,29 +39204,30 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Home", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34169,29 +39237,124 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Home = string(dAtA[iNdEx:postIndex])
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatList", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34201,24 +39364,12 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Maintainers = append(m.Maintainers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.FlatList = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34240,7 +39391,7 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cluster) Unmarshal(dAtA []byte) error {
+func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34263,17 +39414,17 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34283,27 +39434,28 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34331,11 +39483,11 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ServerVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34362,15 +39514,15 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CacheInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsCount", wireType)
 			}
-			var msglen int
+			m.ApplicationsCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34380,28 +39532,14 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ApplicationsCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34429,43 +39567,61 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerVersion = string(dAtA[iNdEx:postIndex])
+			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshRequestedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34492,16 +39648,13 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RefreshRequestedAt == nil {
-				m.RefreshRequestedAt = &v1.Time{}
-			}
-			if err := m.RefreshRequestedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34528,53 +39681,64 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Cluster{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Shard = &v
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterResources", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterResourceRestrictionItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			m.ClusterResources = bool(v != 0)
-		case 11:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterResourceRestrictionItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterResourceRestrictionItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34602,13 +39766,13 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34618,124 +39782,29 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34745,118 +39814,23 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Annotations[mapkey] = mapvalue
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34879,7 +39853,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
+func (m *Command) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34902,17 +39876,17 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCacheInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCacheInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
-			m.ResourcesCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34922,35 +39896,29 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResourcesCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIsCount", wireType)
-			}
-			m.APIsCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.APIsCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 3:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCacheSyncTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34960,27 +39928,23 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCacheSyncTime == nil {
-				m.LastCacheSyncTime = &v1.Time{}
-			}
-			if err := m.LastCacheSyncTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35003,7 +39967,7 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
+func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35026,15 +39990,15 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35062,11 +40026,11 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Author = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35094,11 +40058,11 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Date = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35126,13 +40090,13 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BearerToken = string(dAtA[iNdEx:postIndex])
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35142,30 +40106,29 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TLSClientConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AWSAuthConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SHA", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35175,87 +40138,27 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AWSAuthConfig == nil {
-				m.AWSAuthConfig = &AWSAuthConfig{}
-			}
-			if err := m.AWSAuthConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecProviderConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExecProviderConfig == nil {
-				m.ExecProviderConfig = &ExecProviderConfig{}
-			}
-			if err := m.ExecProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableCompression", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DisableCompression = bool(v != 0)
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35283,7 +40186,7 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProxyUrl = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35306,7 +40209,7 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
+func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35329,15 +40232,15 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ComparedTo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ComparedTo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35364,13 +40267,13 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35397,13 +40300,13 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35430,109 +40333,16 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Values == nil {
-				m.Values = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlatList", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35542,12 +40352,26 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FlatList = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
+			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35569,7 +40393,7 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
+func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35592,17 +40416,17 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35612,28 +40436,27 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Component = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35661,63 +40484,11 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerVersion = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CacheInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsCount", wireType)
-			}
-			m.ApplicationsCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ApplicationsCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35745,7 +40516,7 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35768,7 +40539,7 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterList) Unmarshal(dAtA []byte) error {
+func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35791,17 +40562,17 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigManagementPlugin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigManagementPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35811,28 +40582,27 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35859,98 +40629,18 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Cluster{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Init == nil {
+				m.Init = &Command{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Init.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterResourceRestrictionItem) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterResourceRestrictionItem: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterResourceRestrictionItem: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35960,29 +40650,30 @@ func (m *ClusterResourceRestrictionItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if err := m.Generate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRepo", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35992,24 +40683,12 @@ func (m *ClusterResourceRestrictionItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.LockRepo = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36031,7 +40710,7 @@ func (m *ClusterResourceRestrictionItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Command) Unmarshal(dAtA []byte) error {
+func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36054,15 +40733,15 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Command: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigMapKeyRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigMapKeyRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36090,11 +40769,11 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36122,7 +40801,7 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36145,7 +40824,7 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
+func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36168,15 +40847,15 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36204,11 +40883,11 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36236,13 +40915,13 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Date = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36252,27 +40931,81 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			if m.ModifiedAt == nil {
+				m.ModifiedAt = &v1.Time{}
+			}
+			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrySource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrySource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrySource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36300,11 +41033,11 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Body = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SHA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36332,11 +41065,11 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SHA = string(dAtA[iNdEx:postIndex])
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36364,61 +41097,11 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ComparedTo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ComparedTo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComparedTo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36445,13 +41128,16 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Helm == nil {
+				m.Helm = &ApplicationSourceHelm{}
+			}
+			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36478,13 +41164,16 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Kustomize == nil {
+				m.Kustomize = &ApplicationSourceKustomize{}
+			}
+			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36511,14 +41200,16 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Directory == nil {
+				m.Directory = &ApplicationSourceDirectory{}
+			}
+			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36545,8 +41236,10 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
-			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Plugin == nil {
+				m.Plugin = &ApplicationSourcePlugin{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -36571,7 +41264,7 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
+func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36594,15 +41287,15 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: DuckTypeGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DuckTypeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36630,7 +41323,7 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Component = string(dAtA[iNdEx:postIndex])
+			m.ConfigMapRef = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -36665,10 +41358,10 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36678,79 +41371,17 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigManagementPlugin: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigManagementPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.RequeueAfterSeconds = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36760,27 +41391,28 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36807,16 +41439,13 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Init == nil {
-				m.Init = &Command{}
-			}
-			if err := m.Init.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36833,40 +41462,114 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Generate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockRepo", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.LockRepo = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36888,7 +41591,7 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
+func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36911,15 +41614,15 @@ func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigMapKeyRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnvEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigMapKeyRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnvEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36947,11 +41650,11 @@ func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36979,7 +41682,7 @@ func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37002,7 +41705,7 @@ func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37025,15 +41728,15 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecProviderConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37061,11 +41764,11 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Command = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37093,11 +41796,11 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37124,66 +41827,107 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ModifiedAt == nil {
-				m.ModifiedAt = &v1.Time{}
+			if m.Env == nil {
+				m.Env = make(map[string]string)
 			}
-			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Env[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DrySource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DrySource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DrySource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37211,11 +41955,11 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallHint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37243,13 +41987,13 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			m.InstallHint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvideClusterInfo", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37259,27 +42003,15 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.ProvideClusterInfo = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37306,18 +42038,68 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Helm == nil {
-				m.Helm = &ApplicationSourceHelm{}
+			if m.Config == nil {
+				m.Config = &runtime.RawExtension{}
 			}
-			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitDirectoryGeneratorItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitDirectoryGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37327,33 +42109,29 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kustomize == nil {
-				m.Kustomize = &ApplicationSourceKustomize{}
-			}
-			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37363,33 +42141,67 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Exclude = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Directory == nil {
-				m.Directory = &ApplicationSourceDirectory{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitFileGeneratorItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitFileGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37399,28 +42211,44 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &ApplicationSourcePlugin{}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Exclude = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37442,7 +42270,7 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
+func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37452,30 +42280,96 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenerated
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Directories = append(m.Directories, GitDirectoryGeneratorItem{})
+			if err := m.Directories[len(m.Directories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DuckTypeGenerator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DuckTypeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37485,27 +42379,29 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMapRef = string(dAtA[iNdEx:postIndex])
+			m.Files = append(m.Files, GitFileGeneratorItem{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37533,9 +42429,9 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
@@ -37555,9 +42451,9 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RequeueAfterSeconds = &v
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37584,15 +42480,15 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathParamPrefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37602,26 +42498,25 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PathParamPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
@@ -37769,7 +42664,7 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnvEntry) Unmarshal(dAtA []byte) error {
+func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37792,15 +42687,15 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnvEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: GnuPGPublicKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnvEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GnuPGPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37828,11 +42723,11 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.KeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37860,61 +42755,75 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecProviderConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trust = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37942,11 +42851,11 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = string(dAtA[iNdEx:postIndex])
+			m.SubType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37974,11 +42883,61 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.KeyData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GnuPGPublicKeyList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GnuPGPublicKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38005,109 +42964,15 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Env == nil {
-				m.Env = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Env[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38117,27 +42982,79 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, GnuPGPublicKey{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HealthStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstallHint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38165,13 +43082,13 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InstallHint = string(dAtA[iNdEx:postIndex])
+			m.Status = github_com_argoproj_argo_cd_gitops_engine_v3_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvideClusterInfo", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38181,15 +43098,27 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ProvideClusterInfo = bool(v != 0)
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38216,10 +43145,10 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &runtime.RawExtension{}
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -38244,7 +43173,7 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
+func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38267,13 +43196,45 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitDirectoryGeneratorItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmFileParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitDirectoryGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmFileParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
@@ -38305,26 +43266,6 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Exclude = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38346,7 +43287,7 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
+func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38369,15 +43310,15 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitFileGeneratorItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitFileGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFileSchemes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38405,28 +43346,8 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.ValuesFileSchemes = append(m.ValuesFileSchemes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Exclude = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38448,7 +43369,7 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitGenerator) Unmarshal(dAtA []byte) error {
+func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38471,15 +43392,15 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38507,13 +43428,13 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38523,31 +43444,29 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Directories = append(m.Directories, GitDirectoryGeneratorItem{})
-			if err := m.Directories[len(m.Directories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38557,29 +43476,65 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.ForceString = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Files = append(m.Files, GitFileGeneratorItem{})
-			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38607,31 +43562,11 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequeueAfterSeconds = &v
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38658,15 +43593,16 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
+			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathParamPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38676,27 +43612,28 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathParamPrefix = string(dAtA[iNdEx:postIndex])
+			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38723,8 +43660,8 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Values == nil {
-				m.Values = make(map[string]string)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -38819,7 +43756,7 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Values[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38842,7 +43779,7 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
+func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38865,15 +43802,15 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GnuPGPublicKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GnuPGPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38901,13 +43838,120 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = string(dAtA[iNdEx:postIndex])
+			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
+			}
+			m.RequestedByApp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedByApp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
+			}
+			m.RequestedByNeighbors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedByNeighbors |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HydrateOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HydrateOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38917,29 +43961,30 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38949,27 +43994,31 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38997,11 +44046,11 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trust = string(dAtA[iNdEx:postIndex])
+			m.Phase = HydrateOperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39029,11 +44078,11 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubType = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39061,63 +44110,13 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyData = string(dAtA[iNdEx:postIndex])
+			m.DrySHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GnuPGPublicKeyList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GnuPGPublicKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39127,28 +44126,27 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39175,8 +44173,7 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, GnuPGPublicKey{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -39201,7 +44198,7 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthStatus) Unmarshal(dAtA []byte) error {
+func (m *HydrateTo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39224,15 +44221,15 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydrateTo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydrateTo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39260,11 +44257,61 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = github_com_argoproj_argo_cd_gitops_engine_v3_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
+			m.TargetBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Info) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39292,13 +44339,13 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39308,27 +44355,23 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastTransitionTime == nil {
-				m.LastTransitionTime = &v1.Time{}
-			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39351,7 +44394,7 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
+func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39374,10 +44417,10 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmFileParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmFileParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -39414,7 +44457,7 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39442,7 +44485,7 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39465,7 +44508,7 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmOptions) Unmarshal(dAtA []byte) error {
+func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39488,15 +44531,53 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFileSchemes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39524,7 +44605,7 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValuesFileSchemes = append(m.ValuesFileSchemes, string(dAtA[iNdEx:postIndex]))
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39547,7 +44628,7 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmParameter) Unmarshal(dAtA []byte) error {
+func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39570,17 +44651,17 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39590,76 +44671,26 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, JWTToken{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ForceString = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39681,7 +44712,7 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostInfo) Unmarshal(dAtA []byte) error {
+func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39704,10 +44735,10 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -39744,9 +44775,9 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39756,64 +44787,29 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
-			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39823,119 +44819,12 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39957,7 +44846,7 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
+func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39980,15 +44869,15 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40016,13 +44905,13 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.RequestedByApp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40032,49 +44921,24 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestedByApp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
-			}
-			m.RequestedByNeighbors = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestedByNeighbors |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Capacity = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Capacity |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40096,7 +44960,7 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
+func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40116,53 +44980,20 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 				break
 			}
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HydrateOperation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HydrateOperation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeGvk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeGvk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40172,31 +45003,27 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40224,11 +45051,11 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = HydrateOperationPhase(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40256,11 +45083,61 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40288,11 +45165,11 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DrySHA = string(dAtA[iNdEx:postIndex])
+			m.BuildOptions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40320,11 +45197,11 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
+			m.BinaryPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40351,7 +45228,8 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Versions = append(m.Versions, KustomizeVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -40376,7 +45254,7 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HydrateTo) Unmarshal(dAtA []byte) error {
+func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40399,15 +45277,15 @@ func (m *HydrateTo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HydrateTo: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizePatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HydrateTo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizePatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40435,63 +45313,45 @@ func (m *HydrateTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetBranch = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Info) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Patch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40501,29 +45361,33 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Target == nil {
+				m.Target = &KustomizeSelector{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40533,23 +45397,106 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if m.Options == nil {
+				m.Options = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Options[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -40572,7 +45519,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoItem) Unmarshal(dAtA []byte) error {
+func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40595,10 +45542,10 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeReplica: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeReplica: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -40635,9 +45582,9 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40647,23 +45594,24 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -40686,7 +45634,7 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTToken) Unmarshal(dAtA []byte) error {
+func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40709,17 +45657,17 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeResId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeResId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeGvk", wireType)
 			}
-			m.IssuedAt = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40729,16 +45677,30 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuedAt |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KustomizeGvk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.ExpiresAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40748,14 +45710,27 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40783,7 +45758,7 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -40806,7 +45781,7 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTTokens) Unmarshal(dAtA []byte) error {
+func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40829,15 +45804,15 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeSelector: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeSelector: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeResId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40864,64 +45839,13 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, JWTToken{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KustomizeResId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40949,11 +45873,11 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.AnnotationSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40981,28 +45905,8 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -41024,7 +45928,7 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
+func (m *KustomizeVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41047,15 +45951,47 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41083,11 +46019,11 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41115,7 +46051,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.BuildOptions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41138,7 +46074,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
+func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41161,17 +46097,17 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeGvk: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeGvk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41181,29 +46117,31 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Elements = append(m.Elements, v11.JSON{})
+			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41213,27 +46151,28 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ElementsYaml", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41261,7 +46200,7 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.ElementsYaml = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41284,7 +46223,7 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
+func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41307,17 +46246,17 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedNamespaceMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedNamespaceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41327,59 +46266,122 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildOptions = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BinaryPath = string(dAtA[iNdEx:postIndex])
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41406,10 +46408,103 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = append(m.Versions, KustomizeVersion{})
-			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41432,7 +46527,7 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
+func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41455,17 +46550,17 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizePatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MatrixGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizePatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41475,29 +46570,31 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41507,27 +46604,78 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Patch = string(dAtA[iNdEx:postIndex])
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41554,16 +46702,46 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Target == nil {
-				m.Target = &KustomizeSelector{}
-			}
-			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41590,91 +46768,9 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Options == nil {
-				m.Options = make(map[string]bool)
-			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Options[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41697,7 +46793,7 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
+func (m *NamespaceResourceRestrictionItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41720,13 +46816,77 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeReplica: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespaceResourceRestrictionItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeReplica: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespaceResourceRestrictionItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -41758,9 +46918,9 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41787,7 +46947,10 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LabelSelector == nil {
+				m.LabelSelector = &v1.LabelSelector{}
+			}
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -41812,7 +46975,7 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
+func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41835,15 +46998,15 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeResId: wiretype end group for non-group")
+			return fmt.Errorf("proto: NestedMatrixGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeResId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NestedMatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeGvk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41870,15 +47033,66 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KustomizeGvk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NestedMergeGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NestedMergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41888,27 +47102,29 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41936,7 +47152,7 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41959,7 +47175,7 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
+func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41982,17 +47198,17 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeSelector: wiretype end group for non-group")
+			return fmt.Errorf("proto: OCIMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OCIMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeResId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42002,28 +47218,27 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KustomizeResId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authors", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42051,11 +47266,11 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnnotationSelector = string(dAtA[iNdEx:postIndex])
+			m.Authors = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42083,61 +47298,43 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LabelSelector = string(dAtA[iNdEx:postIndex])
+			m.ImageURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocsURL", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KustomizeVersion) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeVersion: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeVersion: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DocsURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42165,11 +47362,11 @@ func (m *KustomizeVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.SourceURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42197,11 +47394,11 @@ func (m *KustomizeVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42229,7 +47426,7 @@ func (m *KustomizeVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildOptions = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42252,7 +47449,7 @@ func (m *KustomizeVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListGenerator) Unmarshal(dAtA []byte) error {
+func (m *Operation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42275,15 +47472,15 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42310,14 +47507,16 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Elements = append(m.Elements, v11.JSON{})
-			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Sync == nil {
+				m.Sync = &SyncOperation{}
+			}
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42344,15 +47543,15 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElementsYaml", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42362,23 +47561,94 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ElementsYaml = string(dAtA[iNdEx:postIndex])
+			m.Info = append(m.Info, &Info{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutomatedRollback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutomatedRollback == nil {
+				m.AutomatedRollback = &OperationAutomatedRollback{}
+			}
+			if err := m.AutomatedRollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42401,7 +47671,7 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
+func (m *OperationAutomatedRollback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42424,17 +47694,17 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedNamespaceMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationAutomatedRollback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedNamespaceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationAutomatedRollback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42444,124 +47714,67 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryID", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.HistoryID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42571,118 +47784,23 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Annotations[mapkey] = mapvalue
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42705,7 +47823,7 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
+func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42728,17 +47846,17 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MatrixGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationInitiator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationInitiator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42748,31 +47866,29 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42782,25 +47898,12 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Automated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -42822,7 +47925,7 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
+func (m *OperationState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42845,15 +47948,15 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42880,14 +47983,13 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42915,11 +48017,43 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			m.Phase = github_com_argoproj_argo_cd_gitops_engine_v3_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42946,7 +48080,134 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SyncResult == nil {
+				m.SyncResult = &SyncOperationResult{}
+			}
+			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+			}
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledAt == nil {
+				m.ScheduledAt = &v1.Time{}
+			}
+			if err := m.ScheduledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -42971,7 +48232,7 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
+func (m *OptionalArray) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42994,15 +48255,97 @@ func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NestedMatrixGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: OptionalArray: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NestedMatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OptionalArray: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Array", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Array = append(m.Array, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptionalMap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionalMap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionalMap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43029,10 +48372,103 @@ func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Map == nil {
+				m.Map = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Map[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -43055,7 +48491,7 @@ func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43078,17 +48514,17 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NestedMergeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResourceKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NestedMergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResourceKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43098,29 +48534,27 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43148,7 +48582,39 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -43171,7 +48637,7 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43194,17 +48660,17 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OCIMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OCIMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43214,29 +48680,18 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.Warn = &b
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43246,27 +48701,29 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authors = string(dAtA[iNdEx:postIndex])
+			m.Ignore = append(m.Ignore, OrphanedResourceKey{})
+			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43294,13 +48751,13 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageURL = string(dAtA[iNdEx:postIndex])
+			m.Mode = OrphanedResourcesMode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocsURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43310,29 +48767,83 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocsURL = string(dAtA[iNdEx:postIndex])
+			if m.Prune == nil {
+				m.Prune = &OrphanedResourcesPruneSettings{}
+			}
+			if err := m.Prune.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceURL", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrphanedResourcesPruneSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrphanedResourcesPruneSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrphanedResourcesPruneSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodSeconds", wireType)
+			}
+			m.GracePeriodSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43342,29 +48853,16 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GracePeriodSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43374,29 +48872,31 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Allow = append(m.Allow, OrphanedResourceKey{})
+			if err := m.Allow[len(m.Allow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43406,24 +48906,12 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -43445,7 +48933,7 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation) Unmarshal(dAtA []byte) error {
+func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43468,17 +48956,17 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+			return fmt.Errorf("proto: OverrideIgnoreDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OverrideIgnoreDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43488,33 +48976,29 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sync == nil {
-				m.Sync = &SyncOperation{}
-			}
-			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43524,30 +49008,29 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedFieldsManagers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43557,31 +49040,29 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, &Info{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ManagedFieldsManagers = append(m.ManagedFieldsManagers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CELExpressions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43591,24 +49072,55 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CELExpressions = append(m.CELExpressions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CELCondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.CELCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -43631,7 +49143,7 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
+func (m *PluginConfigMapRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43654,15 +49166,15 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationInitiator: wiretype end group for non-group")
+			return fmt.Errorf("proto: PluginConfigMapRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationInitiator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PluginConfigMapRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43690,28 +49202,8 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Automated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -43733,7 +49225,7 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationState) Unmarshal(dAtA []byte) error {
+func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43756,15 +49248,15 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PluginGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PluginGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43791,15 +49283,15 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43809,29 +49301,30 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = github_com_argoproj_argo_cd_gitops_engine_v3_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43841,27 +49334,15 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.RequeueAfterSeconds = &v
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43888,16 +49369,13 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncResult == nil {
-				m.SyncResult = &SyncOperationResult{}
-			}
-			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43924,65 +49402,104 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			if m.Values == nil {
+				m.Values = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
-			}
-			m.RetryCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RetryCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -44004,7 +49521,7 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OptionalArray) Unmarshal(dAtA []byte) error {
+func (m *PluginInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44027,17 +49544,17 @@ func (m *OptionalArray) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OptionalArray: wiretype end group for non-group")
+			return fmt.Errorf("proto: PluginInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OptionalArray: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PluginInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Array", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44047,23 +49564,120 @@ func (m *OptionalArray) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Array = append(m.Array, string(dAtA[iNdEx:postIndex]))
+			if m.Parameters == nil {
+				m.Parameters = make(PluginParameters)
+			}
+			var mapkey string
+			mapvalue := &v11.JSON{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.JSON{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Parameters[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -44086,7 +49700,7 @@ func (m *OptionalArray) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OptionalMap) Unmarshal(dAtA []byte) error {
+func (m *ProjectNamespaceTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44109,15 +49723,15 @@ func (m *OptionalMap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OptionalMap: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectNamespaceTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OptionalMap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectNamespaceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -44144,8 +49758,8 @@ func (m *OptionalMap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Map == nil {
-				m.Map = make(map[string]string)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -44240,8 +49854,227 @@ func (m *OptionalMap) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Map[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceQuota == nil {
+				m.ResourceQuota = &v12.ResourceQuotaSpec{}
+			}
+			if err := m.ResourceQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LimitRange == nil {
+				m.LimitRange = &v12.LimitRangeSpec{}
+			}
+			if err := m.LimitRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultDenyNetworkPolicy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DefaultDenyNetworkPolicy = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -44263,7 +50096,7 @@ func (m *OptionalMap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
+func (m *ProjectQuotaUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44286,17 +50119,17 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrphanedResourceKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectQuotaUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrphanedResourceKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectQuotaUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
 			}
-			var stringLen uint64
+			m.Applications = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44306,61 +50139,16 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Applications |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Group = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedResources", wireType)
 			}
-			var stringLen uint64
+			m.ManagedResources = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44370,24 +50158,11 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ManagedResources |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -44409,7 +50184,7 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
+func (m *ProjectQuotas) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44432,17 +50207,17 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectQuotas: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectQuotas: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxApplications", wireType)
 			}
-			var v int
+			m.MaxApplications = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44452,18 +50227,16 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxApplications |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Warn = &b
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResourcesPerApplication", wireType)
 			}
-			var msglen int
+			m.MaxResourcesPerApplication = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44473,26 +50246,49 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxResourcesPerApplication |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxManagedResources", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.MaxManagedResources = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxManagedResources |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxManifestSizeBytes", wireType)
 			}
-			m.Ignore = append(m.Ignore, OrphanedResourceKey{})
-			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MaxManifestSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxManifestSizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -44514,7 +50310,7 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
+func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44537,15 +50333,15 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OverrideIgnoreDiff: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OverrideIgnoreDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44573,11 +50369,11 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44605,11 +50401,11 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedFieldsManagers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44637,61 +50433,45 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManagedFieldsManagers = append(m.ManagedFieldsManagers, string(dAtA[iNdEx:postIndex]))
+			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PluginConfigMapRef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.JWTTokens = append(m.JWTTokens, JWTToken{})
+			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PluginConfigMapRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginConfigMapRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44719,8 +50499,28 @@ func (m *PluginConfigMapRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireGrantApproval", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireGrantApproval = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -44742,7 +50542,7 @@ func (m *PluginConfigMapRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
+func (m *ProjectRoleGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44765,17 +50565,17 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PluginGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectRoleGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectRoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44785,30 +50585,29 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44818,50 +50617,29 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequeueAfterSeconds = &v
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44871,30 +50649,29 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44904,174 +50681,29 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Values == nil {
-				m.Values = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Values[mapkey] = mapvalue
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PluginInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PluginInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45081,174 +50713,27 @@ func (m *PluginInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parameters == nil {
-				m.Parameters = make(PluginParameters)
-			}
-			var mapkey string
-			mapvalue := &v11.JSON{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v11.JSON{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Parameters[mapkey] = *mapvalue
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProjectRole) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45276,11 +50761,11 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45308,11 +50793,30 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.RequestedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedAt", wireType)
+			}
+			m.RequestedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45340,13 +50844,82 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
+			m.ApprovedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectRuleOrigin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectRuleOrigin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectRuleOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45356,29 +50929,59 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JWTTokens = append(m.JWTTokens, JWTToken{})
-			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Rule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45406,7 +51009,7 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -51111,6 +56714,70 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			}
 			m.ManagedFieldsManagers = append(m.ManagedFieldsManagers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CELExpressions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CELExpressions = append(m.CELExpressions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CELCondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CELCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -52014,36 +57681,69 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KnownTypeFields = append(m.KnownTypeFields, KnownTypeField{})
-			if err := m.KnownTypeFields[len(m.KnownTypeFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KnownTypeFields = append(m.KnownTypeFields, KnownTypeField{})
+			if err := m.KnownTypeFields[len(m.KnownTypeFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseOpenLibs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseOpenLibs = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreResourceUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IgnoreResourceUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseOpenLibs", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseOpenLibs = bool(v != 0)
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreResourceUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthCEL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52053,24 +57753,23 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IgnoreResourceUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HealthCEL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -52716,6 +58415,42 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			}
 			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -53500,6 +59235,38 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthStatus = github_com_argoproj_argo_cd_gitops_engine_v3_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -57894,6 +63661,42 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeouts == nil {
+				m.Timeouts = &SyncTimeouts{}
+			}
+			if err := m.Timeouts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -58513,6 +64316,78 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutomatedRollback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutomatedRollback == nil {
+				m.AutomatedRollback = &AutomatedRollback{}
+			}
+			if err := m.AutomatedRollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeouts == nil {
+				m.Timeouts = &SyncTimeouts{}
+			}
+			if err := m.Timeouts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -59093,7 +64968,77 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 			if err := m.Hook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStrategyApply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStrategyApply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -59115,7 +65060,7 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
+func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -59138,17 +65083,17 @@ func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategyApply: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStrategyHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategyApply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStrategyHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategyApply", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -59158,12 +65103,25 @@ func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SyncStrategyApply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -59185,7 +65143,7 @@ func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
+func (m *SyncTimeouts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -59208,17 +65166,17 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategyHook: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncTimeouts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategyHook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncTimeouts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategyApply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -59228,24 +65186,55 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SyncStrategyApply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Health = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -59613,6 +65602,454 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SyncOverrun = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calendar", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Calendar == nil {
+				m.Calendar = &SyncWindowCalendar{}
+			}
+			if err := m.Calendar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWindowCalendar) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowCalendar: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowCalendar: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ICS", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ICS = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigMapRef == nil {
+				m.ConfigMapRef = &SyncWindowCalendarConfigMapRef{}
+			}
+			if err := m.ConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWindowCalendarConfigMapRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowCalendarConfigMapRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowCalendarConfigMapRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWindowOccurrence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowOccurrence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowOccurrence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33,6 +33,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -47,8 +48,10 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/util/env"
+	"github.com/argoproj/argo-cd/v3/util/glob"
 	"github.com/argoproj/argo-cd/v3/util/helm"
 	utilhttp "github.com/argoproj/argo-cd/v3/util/http"
+	"github.com/argoproj/argo-cd/v3/util/ical"
 	"github.com/argoproj/argo-cd/v3/util/security"
 )
 
@@ -100,6 +103,59 @@ type ApplicationSpec struct {
 
 	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
 	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,9,opt,name=sourceHydrator"`
+
+	// DependsOn is a list of references to Applications which must be Synced and Healthy before this application is synced
+	DependsOn []ApplicationDependency `json:"dependsOn,omitempty" protobuf:"bytes,10,rep,name=dependsOn"`
+
+	// ProgressDeadlineSeconds is the number of seconds after which resources which are still Progressing are reported
+	// as Degraded. It overrides the progress deadline of the project, zero disables it.
+	ProgressDeadlineSeconds *int64 `json:"progressDeadlineSeconds,omitempty" protobuf:"varint,11,opt,name=progressDeadlineSeconds"`
+
+	// Fleet configures the rollout of the application when its destination selects the clusters with a cluster selector
+	Fleet *ApplicationFleet `json:"fleet,omitempty" protobuf:"bytes,12,opt,name=fleet"`
+}
+
+// ApplicationFleet configures the rollout of a fleet application, i.e. an application whose destination selects the
+// clusters with a cluster selector and which is deployed by an application per selected cluster
+type ApplicationFleet struct {
+	// RolloutSteps is the ordered list of the steps of the rollout. The applications of the clusters of a step are only
+	// synced automatically once the applications of the previous steps are Synced and Healthy. The clusters which are not
+	// selected by any step are rolled out last.
+	RolloutSteps []ApplicationFleetRolloutStep `json:"rolloutSteps,omitempty" protobuf:"bytes,1,rep,name=rolloutSteps"`
+}
+
+// ApplicationFleetRolloutStep is a step of the rollout of a fleet application
+type ApplicationFleetRolloutStep struct {
+	// ClusterSelector selects the clusters of the step by the labels of their cluster secret
+	ClusterSelector metav1.LabelSelector `json:"clusterSelector" protobuf:"bytes,1,opt,name=clusterSelector"`
+}
+
+// ApplicationFleetClusterStatus is the status of a fleet application on one of its clusters
+type ApplicationFleetClusterStatus struct {
+	// Server is the URL of the cluster
+	Server string `json:"server" protobuf:"bytes,1,opt,name=server"`
+	// Name is the name of the cluster
+	Name string `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
+	// Application is the name of the application which deploys the fleet application to the cluster
+	Application string `json:"application" protobuf:"bytes,3,opt,name=application"`
+	// Step is the index of the rollout step of the cluster
+	Step int64 `json:"step" protobuf:"varint,4,opt,name=step"`
+	// Sync is the sync status of the application of the cluster
+	Sync SyncStatusCode `json:"sync,omitempty" protobuf:"bytes,5,opt,name=sync,casttype=SyncStatusCode"`
+	// Health is the health status of the application of the cluster
+	Health health.HealthStatusCode `json:"health,omitempty" protobuf:"bytes,6,opt,name=health"`
+	// Revision is the revision the application of the cluster is synced to
+	Revision string `json:"revision,omitempty" protobuf:"bytes,7,opt,name=revision"`
+}
+
+// ApplicationDependency references one or more Applications an application depends on, either by name or by label selector
+type ApplicationDependency struct {
+	// Name is the name of the Application this application depends on
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace of the referenced Applications. Defaults to the namespace of the application.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// Selector selects the Applications this application depends on by label
+	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,3,opt,name=selector"`
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -131,6 +187,32 @@ type ResourceIgnoreDifferences struct {
 	// ManagedFieldsManagers is a list of trusted managers. Fields mutated by those managers will take precedence over the
 	// desired state defined in the SCM and won't be displayed in diffs
 	ManagedFieldsManagers []string `json:"managedFieldsManagers,omitempty" protobuf:"bytes,7,opt,name=managedFieldsManagers"`
+	// CELExpressions is a list of CEL expressions returning the JSON pointer, or a list of JSON pointers, of the fields
+	// to ignore. The resource is available to the expressions as `object`.
+	CELExpressions []string `json:"celExpressions,omitempty" protobuf:"bytes,8,opt,name=celExpressions"`
+	// CELCondition is a CEL expression which has to return true for the fields returned by the CEL expressions to be
+	// ignored. Only the resource itself is available to the expression, as `object`. It can only be used along with
+	// CELExpressions, not with JSONPointers, JQPathExpressions or ManagedFieldsManagers.
+	CELCondition string `json:"celCondition,omitempty" protobuf:"bytes,9,opt,name=celCondition"`
+}
+
+// ValidateCELCondition returns an error if the CEL condition is used without CEL expressions or along with the other
+// ways of ignoring differences, which the condition does not apply to
+func (r ResourceIgnoreDifferences) ValidateCELCondition() error {
+	return validateCELCondition(r.CELCondition, r.CELExpressions, r.JSONPointers, r.JQPathExpressions, r.ManagedFieldsManagers)
+}
+
+func validateCELCondition(condition string, celExpressions, jsonPointers, jqPathExpressions, managedFieldsManagers []string) error {
+	if condition == "" {
+		return nil
+	}
+	if len(celExpressions) == 0 {
+		return errors.New("celCondition requires celExpressions")
+	}
+	if len(jsonPointers) > 0 || len(jqPathExpressions) > 0 || len(managedFieldsManagers) > 0 {
+		return errors.New("celCondition only applies to celExpressions and cannot be used along with jsonPointers, jqPathExpressions or managedFieldsManagers")
+	}
+	return nil
 }
 
 // EnvEntry represents an entry in the application's environment
@@ -295,6 +377,12 @@ func (spec *ApplicationSpec) HasMultipleSources() bool {
 	return spec.SourceHydrator == nil && len(spec.Sources) > 0
 }
 
+// IsFleet returns true if the destination of the application selects the clusters with a cluster selector, in which case
+// the application is deployed by an application per selected cluster
+func (spec *ApplicationSpec) IsFleet() bool {
+	return spec.Destination.ClusterSelector != nil
+}
+
 // GetSourcePtrByPosition returns the source pointer by position.
 // The position is 1-based index.
 // If the source is using hydrator, use 0 to return the dry source or 1 for the sync source.
@@ -1206,6 +1294,11 @@ type ApplicationDestination struct {
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
 	// Name is an alternate way of specifying the target cluster by its symbolic name. This must be set if Server is not set.
 	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+	// ClusterSelector selects the target clusters by the labels of their cluster secret. In the destinations of an
+	// AppProject, it matches any cluster whose labels match the selector, in addition to Server and Name if they are set.
+	// In an Application, it must be set instead of Server and Name and deploys the application to all the selected
+	// clusters, see ApplicationSpec.Fleet.
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty" protobuf:"bytes,4,opt,name=clusterSelector"`
 }
 
 type ResourceHealthLocation string
@@ -1247,6 +1340,8 @@ type ApplicationStatus struct {
 	ControllerNamespace string `json:"controllerNamespace,omitempty" protobuf:"bytes,13,opt,name=controllerNamespace"`
 	// SourceHydrator stores information about the current state of source hydration
 	SourceHydrator SourceHydratorStatus `json:"sourceHydrator,omitempty" protobuf:"bytes,14,opt,name=sourceHydrator"`
+	// Fleet is the status of a fleet application on each of its clusters
+	Fleet []ApplicationFleetClusterStatus `json:"fleet,omitempty" protobuf:"bytes,15,rep,name=fleet"`
 }
 
 // SourceHydratorStatus contains information about the current state of source hydration
@@ -1360,6 +1455,20 @@ type Operation struct {
 	Info []*Info `json:"info,omitempty" protobuf:"bytes,3,name=info"`
 	// Retry controls the strategy to apply if a sync fails
 	Retry RetryStrategy `json:"retry,omitempty" protobuf:"bytes,4,opt,name=retry"`
+	// AutomatedRollback contains information about the automated rollback performed by the operation, if any
+	AutomatedRollback *OperationAutomatedRollback `json:"automatedRollback,omitempty" protobuf:"bytes,5,opt,name=automatedRollback"`
+}
+
+// OperationAutomatedRollback contains information about an automated rollback to a previously healthy revision
+type OperationAutomatedRollback struct {
+	// Revisions holds the revisions which were rolled back. Automated sync is paused while they are the target revisions.
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,1,rep,name=revisions"`
+	// HistoryID is the ID of the revision history entry the application is rolled back to
+	HistoryID int64 `json:"historyID" protobuf:"bytes,2,opt,name=historyID"`
+	// Attempt is the number of consecutive automated rollbacks away from Revisions
+	Attempt int64 `json:"attempt,omitempty" protobuf:"bytes,3,opt,name=attempt"`
+	// Message describes why the rollback was performed
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
 }
 
 // DryRun returns true if an operation was requested to be performed in dry run mode
@@ -1446,6 +1555,8 @@ type SyncOperation struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,11,opt,name=revisions"`
 	// SelfHealAttemptsCount contains the number of auto-heal attempts
 	SelfHealAttemptsCount int64 `json:"autoHealAttemptsCount,omitempty" protobuf:"bytes,12,opt,name=autoHealAttemptsCount"`
+	// Timeouts overrides the timeouts of the sync policy for this operation
+	Timeouts *SyncTimeouts `json:"timeouts,omitempty" protobuf:"bytes,13,opt,name=timeouts"`
 }
 
 // IsApplyStrategy returns true if the sync strategy is "apply"
@@ -1469,8 +1580,14 @@ type OperationState struct {
 	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
 	// RetryCount contains time of operation retries
 	RetryCount int64 `json:"retryCount,omitempty" protobuf:"bytes,8,opt,name=retryCount"`
+	// ScheduledAt contains the time a sync operation queued by a sync window is scheduled to start
+	ScheduledAt *metav1.Time `json:"scheduledAt,omitempty" protobuf:"bytes,9,opt,name=scheduledAt"`
 }
 
+// OperationPendingWindow is the phase of a sync operation which is blocked by a sync window and waits for a sync
+// window to allow it, see SyncOptionQueueUntilSyncWindow
+const OperationPendingWindow synccommon.OperationPhase = "PendingWindow"
+
 type Info struct {
 	Name  string `json:"name" protobuf:"bytes,1,name=name"`
 	Value string `json:"value" protobuf:"bytes,2,name=value"`
@@ -1478,6 +1595,10 @@ type Info struct {
 
 type SyncOptions []string
 
+// SyncOptionQueueUntilSyncWindow is the sync option which queues a sync blocked by a sync window until a sync window
+// allows it, instead of rejecting it
+const SyncOptionQueueUntilSyncWindow = "QueueUntilSyncWindow=true"
+
 // AddOption adds a sync option to the list of sync options and returns the modified list.
 // If option was already set, returns the unmodified list of sync options.
 func (o SyncOptions) AddOption(option string) SyncOptions {
@@ -1530,6 +1651,10 @@ type SyncPolicy struct {
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
 	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+	// AutomatedRollback controls the automated rollback to the last healthy revision when the application becomes Degraded after a sync
+	AutomatedRollback *AutomatedRollback `json:"automatedRollback,omitempty" protobuf:"bytes,5,opt,name=automatedRollback"`
+	// Timeouts controls the maximum duration of sync operations and of the health assessment of each sync wave
+	Timeouts *SyncTimeouts `json:"timeouts,omitempty" protobuf:"bytes,6,opt,name=timeouts"`
 	// If you add a field here, be sure to update IsZero.
 }
 
@@ -1543,7 +1668,65 @@ func (p *SyncPolicy) IsAutomatedSyncEnabled() bool {
 
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil && p.AutomatedRollback == nil && p.Timeouts == nil)
+}
+
+// AutomatedRollback controls the automated rollback of an application whose health degrades after a sync
+type AutomatedRollback struct {
+	// Window is the amount of time after a sync during which the application becoming Degraded triggers a rollback.
+	// Default unit is seconds, but could also be a duration (e.g. "2m", "1h"). Defaults to 5m.
+	Window string `json:"window,omitempty" protobuf:"bytes,1,opt,name=window"`
+	// MaxAttempts is the maximum number of consecutive rollbacks, each one to an older healthy revision, performed
+	// when the application keeps becoming Degraded. Defaults to 1.
+	MaxAttempts int64 `json:"maxAttempts,omitempty" protobuf:"bytes,2,opt,name=maxAttempts"`
+}
+
+const (
+	defaultAutomatedRollbackWindow      = 5 * time.Minute
+	defaultAutomatedRollbackMaxAttempts = 1
+)
+
+// WindowDuration returns the duration of the window after a sync during which a degradation triggers a rollback
+func (r *AutomatedRollback) WindowDuration() (time.Duration, error) {
+	if r.Window == "" {
+		return defaultAutomatedRollbackWindow, nil
+	}
+	return parseStringToDuration(r.Window)
+}
+
+// GetMaxAttempts returns the maximum number of consecutive automated rollbacks
+func (r *AutomatedRollback) GetMaxAttempts() int64 {
+	if r.MaxAttempts <= 0 {
+		return defaultAutomatedRollbackMaxAttempts
+	}
+	return r.MaxAttempts
+}
+
+// SyncTimeouts controls the timeouts of sync operations. Once a timeout is exceeded, running hooks are terminated, the
+// operation fails and the SyncFail hooks are executed.
+type SyncTimeouts struct {
+	// Operation is the maximum duration of a sync operation.
+	// Default unit is seconds, but could also be a duration (e.g. "2m", "1h"). No timeout if omitted.
+	Operation string `json:"operation,omitempty" protobuf:"bytes,1,opt,name=operation"`
+	// Health is the maximum duration the resources of a sync wave are allowed to take to become healthy after they were applied.
+	// Default unit is seconds, but could also be a duration (e.g. "2m", "1h"). No timeout if omitted.
+	Health string `json:"health,omitempty" protobuf:"bytes,2,opt,name=health"`
+}
+
+// OperationDuration returns the maximum duration of a sync operation, zero if there is none
+func (t *SyncTimeouts) OperationDuration() (time.Duration, error) {
+	if t == nil || t.Operation == "" {
+		return 0, nil
+	}
+	return parseStringToDuration(t.Operation)
+}
+
+// HealthDuration returns the maximum duration of the health assessment of a sync wave, zero if there is none
+func (t *SyncTimeouts) HealthDuration() (time.Duration, error) {
+	if t == nil || t.Health == "" {
+		return 0, nil
+	}
+	return parseStringToDuration(t.Health)
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -1800,6 +1983,8 @@ type ResourceResult struct {
 	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,10,opt,name=syncPhase"`
 	// Images contains the images related to the ResourceResult
 	Images []string `json:"images,omitempty" protobuf:"bytes,11,opt,name=images"`
+	// StartedAt is the time the resource was applied or the hook was created during the operation
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,12,opt,name=startedAt"`
 }
 
 // GroupVersionKind returns the GVK schema information for a given resource within a sync result
@@ -1853,6 +2038,9 @@ type RevisionHistory struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,9,opt,name=revisions"`
 	// InitiatedBy contains information about who initiated the operations
 	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,10,opt,name=initiatedBy"`
+	// HealthStatus is the health of the application observed at the end of the automated rollback window after the
+	// sync operation, or Degraded if the application was rolled back. Only recorded when automated rollback is enabled.
+	HealthStatus health.HealthStatusCode `json:"healthStatus,omitempty" protobuf:"bytes,11,opt,name=healthStatus"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -1920,6 +2108,12 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionDependencyWarning indicates that the sync of the application is held until its dependencies are Synced and Healthy
+	ApplicationConditionDependencyWarning = "DependencyWarning"
+	// ApplicationConditionDependencyError indicates that the dependencies of the application cannot be satisfied, e.g. because they form a cycle
+	ApplicationConditionDependencyError = "DependencyError"
+	// ApplicationConditionFleetError indicates that the controller failed to reconcile the applications of a fleet application
+	ApplicationConditionFleetError = "FleetError"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -2356,6 +2550,9 @@ type Cluster struct {
 	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,12,opt,name=labels"`
 	// Annotations for cluster secret metadata
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,13,opt,name=annotations"`
+	// MaxConcurrentSyncs is the maximum number of sync operations the application controller runs concurrently against
+	// the cluster. Overrides the default limit of the application controller if greater than zero.
+	MaxConcurrentSyncs int64 `json:"maxConcurrentSyncs,omitempty" protobuf:"bytes,14,opt,name=maxConcurrentSyncs"`
 
 	// The embedded metav1.ObjectMeta field is purely here to please the informer when converting from a v1.Secret to a Cluster.
 	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
@@ -2374,6 +2571,7 @@ func (c *Cluster) Sanitized() *Cluster {
 		Labels:             c.Labels,
 		Annotations:        c.Annotations,
 		ClusterResources:   c.ClusterResources,
+		MaxConcurrentSyncs: c.MaxConcurrentSyncs,
 		Info:               c.Info,
 		RefreshRequestedAt: c.RefreshRequestedAt,
 		Config: ClusterConfig{
@@ -2420,6 +2618,10 @@ func (c *Cluster) Equals(other *Cluster) bool {
 		return false
 	}
 
+	if c.MaxConcurrentSyncs != other.MaxConcurrentSyncs {
+		return false
+	}
+
 	if !maps.Equal(c.Annotations, other.Annotations) {
 		return false
 	}
@@ -2461,6 +2663,8 @@ type ClusterCacheInfo struct {
 	APIsCount int64 `json:"apisCount,omitempty" protobuf:"bytes,2,opt,name=apisCount"`
 	// LastCacheSyncTime holds time of most recent cache synchronization
 	LastCacheSyncTime *metav1.Time `json:"lastCacheSyncTime,omitempty" protobuf:"bytes,3,opt,name=lastCacheSyncTime"`
+	// EventsPerMinute holds the rate of watch events received from the cluster
+	EventsPerMinute int64 `json:"eventsPerMinute,omitempty" protobuf:"bytes,4,opt,name=eventsPerMinute"`
 }
 
 // ClusterList is a collection of Clusters.
@@ -2584,11 +2788,25 @@ type OverrideIgnoreDiff struct {
 	// ManagedFieldsManagers is a list of trusted managers. Fields mutated by those managers will take precedence over the
 	// desired state defined in the SCM and won't be displayed in diffs
 	ManagedFieldsManagers []string `json:"managedFieldsManagers" protobuf:"bytes,3,opt,name=managedFieldsManagers"`
+	// CELExpressions is a list of CEL expressions returning the JSON pointer, or a list of JSON pointers, of the fields
+	// to ignore. The resource is available to the expressions as `object`.
+	CELExpressions []string `json:"celExpressions,omitempty" protobuf:"bytes,4,opt,name=celExpressions"`
+	// CELCondition is a CEL expression which has to return true for the fields returned by the CEL expressions to be
+	// ignored. Only the resource itself is available to the expression, as `object`. It can only be used along with
+	// CELExpressions, not with JSONPointers, JQPathExpressions or ManagedFieldsManagers.
+	CELCondition string `json:"celCondition,omitempty" protobuf:"bytes,5,opt,name=celCondition"`
+}
+
+// ValidateCELCondition returns an error if the CEL condition is used without CEL expressions or along with the other
+// ways of ignoring differences, which the condition does not apply to
+func (o OverrideIgnoreDiff) ValidateCELCondition() error {
+	return validateCELCondition(o.CELCondition, o.CELExpressions, o.JSONPointers, o.JQPathExpressions, o.ManagedFieldsManagers)
 }
 
 type rawResourceOverride struct {
 	HealthLua             string           `json:"health.lua,omitempty"`
 	UseOpenLibs           bool             `json:"health.lua.useOpenLibs,omitempty"`
+	HealthCEL             string           `json:"healthCEL,omitempty"`
 	Actions               string           `json:"actions,omitempty"`
 	IgnoreDifferences     string           `json:"ignoreDifferences,omitempty"`
 	IgnoreResourceUpdates string           `json:"ignoreResourceUpdates,omitempty"`
@@ -2601,6 +2819,9 @@ type ResourceOverride struct {
 	HealthLua string `protobuf:"bytes,1,opt,name=healthLua"`
 	// UseOpenLibs indicates whether to use open-source libraries for the resource.
 	UseOpenLibs bool `protobuf:"bytes,5,opt,name=useOpenLibs"`
+	// HealthCEL contains a CEL expression that defines custom health checks for the resource. It is used instead of
+	// HealthLua if both are set.
+	HealthCEL string `protobuf:"bytes,7,opt,name=healthCEL"`
 	// Actions defines the set of actions that can be performed on the resource, as a Lua script.
 	Actions string `protobuf:"bytes,3,opt,name=actions"`
 	// IgnoreDifferences contains configuration for which differences should be ignored during the resource diffing.
@@ -2622,6 +2843,7 @@ func (ro *ResourceOverride) UnmarshalJSON(data []byte) error {
 	ro.KnownTypeFields = raw.KnownTypeFields
 	ro.HealthLua = raw.HealthLua
 	ro.UseOpenLibs = raw.UseOpenLibs
+	ro.HealthCEL = raw.HealthCEL
 	ro.Actions = raw.Actions
 	err := yaml.Unmarshal([]byte(raw.IgnoreDifferences), &ro.IgnoreDifferences)
 	if err != nil {
@@ -2645,7 +2867,7 @@ func (ro ResourceOverride) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	raw := &rawResourceOverride{ro.HealthLua, ro.UseOpenLibs, ro.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), ro.KnownTypeFields}
+	raw := &rawResourceOverride{ro.HealthLua, ro.UseOpenLibs, ro.HealthCEL, ro.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), ro.KnownTypeFields}
 	return json.Marshal(raw)
 }
 
@@ -2813,6 +3035,36 @@ type OrphanedResourcesMonitorSettings struct {
 	Warn *bool `json:"warn,omitempty" protobuf:"bytes,1,name=warn"`
 	// Ignore contains a list of resources that are to be excluded from orphaned resources monitoring
 	Ignore []OrphanedResourceKey `json:"ignore,omitempty" protobuf:"bytes,2,opt,name=ignore"`
+	// Mode is the action taken on orphaned resources in addition to monitoring them, either prune or adopt.
+	// Orphaned resources are only monitored if it is not set.
+	// +kubebuilder:validation:Enum=prune;adopt
+	Mode OrphanedResourcesMode `json:"mode,omitempty" protobuf:"bytes,3,opt,name=mode,casttype=OrphanedResourcesMode"`
+	// Prune configures the pruning of orphaned resources in the prune mode
+	Prune *OrphanedResourcesPruneSettings `json:"prune,omitempty" protobuf:"bytes,4,opt,name=prune"`
+}
+
+// OrphanedResourcesMode is the action taken on orphaned resources
+type OrphanedResourcesMode string
+
+const (
+	// OrphanedResourcesModePrune deletes orphaned resources once they have been orphaned for the grace period
+	OrphanedResourcesModePrune OrphanedResourcesMode = "prune"
+	// OrphanedResourcesModeAdopt adds the tracking of the application to orphaned resources, so that they are shown
+	// as extraneous resources of the application
+	OrphanedResourcesModeAdopt OrphanedResourcesMode = "adopt"
+)
+
+// OrphanedResourcesPruneSettings holds the settings of the pruning of orphaned resources
+type OrphanedResourcesPruneSettings struct {
+	// GracePeriodSeconds is the number of seconds a resource has to be orphaned before it is pruned. Defaults to 300
+	// seconds if it is not set.
+	GracePeriodSeconds int64 `json:"gracePeriodSeconds,omitempty" protobuf:"varint,1,opt,name=gracePeriodSeconds"`
+	// Allow contains a list of resources which may be pruned. None of the orphaned resources are
+	// pruned if it is empty.
+	Allow []OrphanedResourceKey `json:"allow,omitempty" protobuf:"bytes,2,rep,name=allow"`
+	// DryRun only reports the orphaned resources which would be pruned as events of the application, instead of
+	// pruning them
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,3,opt,name=dryRun"`
 }
 
 // OrphanedResourceKey is a reference to a resource to be ignored from
@@ -2822,11 +3074,50 @@ type OrphanedResourceKey struct {
 	Name  string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
 }
 
+// Matches returns true if the resource with the given group, kind and name matches the key. The fields of the key
+// support glob patterns, and an empty kind or name matches any kind or name.
+func (k OrphanedResourceKey) Matches(group, kind, name string) bool {
+	return (k.Kind == "" || glob.Match(k.Kind, kind)) &&
+		glob.Match(k.Group, group) &&
+		(k.Name == "" || glob.Match(k.Name, name))
+}
+
 // IsWarn returns true if warnings are enabled for orphan resources monitoring
 func (s *OrphanedResourcesMonitorSettings) IsWarn() bool {
 	return s.Warn != nil && *s.Warn
 }
 
+// DefaultOrphanedResourcesPruneGracePeriod is the duration a resource has to be orphaned before it is pruned if the
+// grace period is not set
+const DefaultOrphanedResourcesPruneGracePeriod = 5 * time.Minute
+
+// GetPruneGracePeriod returns the duration a resource has to be orphaned before it is pruned
+func (s *OrphanedResourcesMonitorSettings) GetPruneGracePeriod() time.Duration {
+	if s.Prune == nil || s.Prune.GracePeriodSeconds <= 0 {
+		return DefaultOrphanedResourcesPruneGracePeriod
+	}
+	return time.Duration(s.Prune.GracePeriodSeconds) * time.Second
+}
+
+// IsPruneAllowed returns true if the orphaned resource with the given group, kind and name may be pruned. Only the
+// resources matching the allow list may be pruned.
+func (s *OrphanedResourcesMonitorSettings) IsPruneAllowed(group, kind, name string) bool {
+	if s.Prune == nil {
+		return false
+	}
+	for _, item := range s.Prune.Allow {
+		if item.Matches(group, kind, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPruneDryRun returns true if the orphaned resources which would be pruned are only reported
+func (s *OrphanedResourcesMonitorSettings) IsPruneDryRun() bool {
+	return s.Prune != nil && s.Prune.DryRun
+}
+
 // SignatureKey is the specification of a key required to verify commit signatures with
 //
 // Deprecated: Use SourceIntegrity instead. SignatureKeys will be removed with the next major version.
@@ -2849,13 +3140,13 @@ type AppProjectSpec struct {
 	// ClusterResourceWhitelist contains list of whitelisted cluster level resources
 	ClusterResourceWhitelist []ClusterResourceRestrictionItem `json:"clusterResourceWhitelist,omitempty" protobuf:"bytes,5,opt,name=clusterResourceWhitelist"`
 	// NamespaceResourceBlacklist contains list of blacklisted namespace level resources
-	NamespaceResourceBlacklist []metav1.GroupKind `json:"namespaceResourceBlacklist,omitempty" protobuf:"bytes,6,opt,name=namespaceResourceBlacklist"`
+	NamespaceResourceBlacklist []NamespaceResourceRestrictionItem `json:"namespaceResourceBlacklist,omitempty" protobuf:"bytes,6,opt,name=namespaceResourceBlacklist"`
 	// OrphanedResources specifies if controller should monitor orphaned resources of apps in this project
 	OrphanedResources *OrphanedResourcesMonitorSettings `json:"orphanedResources,omitempty" protobuf:"bytes,7,opt,name=orphanedResources"`
 	// SyncWindows controls when syncs can be run for apps in this project
 	SyncWindows SyncWindows `json:"syncWindows,omitempty" protobuf:"bytes,8,opt,name=syncWindows"`
 	// NamespaceResourceWhitelist contains list of whitelisted namespace level resources
-	NamespaceResourceWhitelist []metav1.GroupKind `json:"namespaceResourceWhitelist,omitempty" protobuf:"bytes,9,opt,name=namespaceResourceWhitelist"`
+	NamespaceResourceWhitelist []NamespaceResourceRestrictionItem `json:"namespaceResourceWhitelist,omitempty" protobuf:"bytes,9,opt,name=namespaceResourceWhitelist"`
 	// SignatureKeys contains a list of PGP key IDs that commits in Git must be signed with in order to be allowed for sync
 	//
 	// Deprecated: Use SourceIntegrity instead. SignatureKeys will be removed with the next major version.
@@ -2871,6 +3162,72 @@ type AppProjectSpec struct {
 	// SourceIntegrity represents a constraint on manifest sources integrity to be met before they can be used.
 	// Do not access directly, use EffectiveSourceIntegrity() for correct backwards compatibility handling.
 	SourceIntegrity *SourceIntegrity `json:"sourceIntegrity,omitempty" protobuf:"bytes,15,name=sourceIntegrity"`
+	// ProgressDeadlineSeconds is the number of seconds after which resources of the applications in this project which
+	// are still Progressing are reported as Degraded
+	ProgressDeadlineSeconds *int64 `json:"progressDeadlineSeconds,omitempty" protobuf:"varint,16,opt,name=progressDeadlineSeconds"`
+	// Quotas limits the number of applications in this project and the amount of resources they manage
+	Quotas *ProjectQuotas `json:"quotas,omitempty" protobuf:"bytes,17,opt,name=quotas"`
+	// NamespaceTemplate defines the mandatory metadata and resources of the namespaces created by the applications of this project
+	NamespaceTemplate *ProjectNamespaceTemplate `json:"namespaceTemplate,omitempty" protobuf:"bytes,18,opt,name=namespaceTemplate"`
+	// Parent is the name of the project, in the same namespace, from which this project inherits its policies. The
+	// inherited policies can only be restricted by this project, never widened.
+	Parent string `json:"parent,omitempty" protobuf:"bytes,19,opt,name=parent"`
+}
+
+// ProjectRuleOrigin identifies the project of a project hierarchy which defines a rule of the effective project
+type ProjectRuleOrigin struct {
+	// Field is the field of the project spec which contains the rule
+	Field string `json:"field" protobuf:"bytes,1,opt,name=field"`
+	// Rule is a description of the rule
+	Rule string `json:"rule" protobuf:"bytes,2,opt,name=rule"`
+	// Project is the name of the project which defines the rule
+	Project string `json:"project" protobuf:"bytes,3,opt,name=project"`
+}
+
+// ProjectNamespaceTemplate defines the mandatory metadata and resources of the namespaces which are created by the
+// applications of a project with the CreateNamespace=true sync option. Its labels and annotations take precedence over
+// the ones of the managed namespace metadata of the applications.
+type ProjectNamespaceTemplate struct {
+	// Labels are set on the namespaces created by the applications of the project
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,1,opt,name=labels"`
+	// Annotations are set on the namespaces created by the applications of the project
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,2,opt,name=annotations"`
+	// ResourceQuota is the spec of a ResourceQuota which is created in the namespaces
+	ResourceQuota *corev1.ResourceQuotaSpec `json:"resourceQuota,omitempty" protobuf:"bytes,3,opt,name=resourceQuota"`
+	// LimitRange is the spec of a LimitRange which is created in the namespaces
+	LimitRange *corev1.LimitRangeSpec `json:"limitRange,omitempty" protobuf:"bytes,4,opt,name=limitRange"`
+	// DefaultDenyNetworkPolicy creates a NetworkPolicy in the namespaces which denies all ingress and egress traffic
+	DefaultDenyNetworkPolicy bool `json:"defaultDenyNetworkPolicy,omitempty" protobuf:"varint,5,opt,name=defaultDenyNetworkPolicy"`
+}
+
+// HasMetadata returns true if the namespace template defines labels or annotations
+func (t *ProjectNamespaceTemplate) HasMetadata() bool {
+	return t != nil && (len(t.Labels) > 0 || len(t.Annotations) > 0)
+}
+
+// ProjectQuotas limits the number of applications of a project and the amount of resources managed by them. A zero or
+// unset limit means that the quantity is not limited.
+type ProjectQuotas struct {
+	// MaxApplications is the maximum number of applications in the project
+	// +kubebuilder:validation:Minimum=0
+	MaxApplications int64 `json:"maxApplications,omitempty" protobuf:"varint,1,opt,name=maxApplications"`
+	// MaxResourcesPerApplication is the maximum number of resources rendered by a single application of the project
+	// +kubebuilder:validation:Minimum=0
+	MaxResourcesPerApplication int64 `json:"maxResourcesPerApplication,omitempty" protobuf:"varint,2,opt,name=maxResourcesPerApplication"`
+	// MaxManagedResources is the maximum number of resources managed by all the applications of the project
+	// +kubebuilder:validation:Minimum=0
+	MaxManagedResources int64 `json:"maxManagedResources,omitempty" protobuf:"varint,3,opt,name=maxManagedResources"`
+	// MaxManifestSizeBytes is the maximum size, in bytes, of the manifests rendered by a single application of the project
+	// +kubebuilder:validation:Minimum=0
+	MaxManifestSizeBytes int64 `json:"maxManifestSizeBytes,omitempty" protobuf:"varint,4,opt,name=maxManifestSizeBytes"`
+}
+
+// ProjectQuotaUsage is the current usage of the quotas of a project
+type ProjectQuotaUsage struct {
+	// Applications is the number of applications in the project
+	Applications int64 `json:"applications,omitempty" protobuf:"varint,1,opt,name=applications"`
+	// ManagedResources is the number of resources managed by all the applications of the project
+	ManagedResources int64 `json:"managedResources,omitempty" protobuf:"varint,2,opt,name=managedResources"`
 }
 
 // EffectiveSourceIntegrity incorporates the legacy SignatureKeys into SourceIntegrity, if possible
@@ -2923,6 +3280,18 @@ type ClusterResourceRestrictionItem struct {
 	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
 }
 
+// NamespaceResourceRestrictionItem is a namespaced resource that is restricted by the project's whitelist or blacklist
+type NamespaceResourceRestrictionItem struct {
+	Group string `json:"group" protobuf:"bytes,1,opt,name=group"`
+	Kind  string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Name is the name of the restricted resource. Glob patterns using Go's filepath.Match syntax are supported.
+	// Unlike the group and kind fields, if no name is specified, all resources of the specified group/kind are matched.
+	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+	// LabelSelector restricts the item to the resources whose labels match the selector. If no selector is specified,
+	// the resources are matched regardless of their labels.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty" protobuf:"bytes,4,opt,name=labelSelector"`
+}
+
 // SyncWindows is a collection of sync windows in this project
 type SyncWindows []*SyncWindow
 
@@ -2952,6 +3321,92 @@ type SyncWindow struct {
 	// For deny windows: allows syncs that started before the deny window became active to continue running
 	// For allow windows: allows syncs that started during the allow window to continue after the window ends
 	SyncOverrun bool `json:"syncOverrun,omitempty" protobuf:"bytes,11,opt,name=syncOverrun"`
+	// Calendar references an iCalendar source whose events define when the window is active. It replaces Schedule and Duration.
+	Calendar *SyncWindowCalendar `json:"calendar,omitempty" protobuf:"bytes,12,opt,name=calendar"`
+}
+
+// SyncWindowCalendar references an iCalendar (RFC 5545) source. Every event of the calendar is an interval during which
+// the sync window is active. Exactly one of ICS, ConfigMapRef and URL must be set.
+type SyncWindowCalendar struct {
+	// ICS contains the iCalendar data inline
+	ICS string `json:"ics,omitempty" protobuf:"bytes,1,opt,name=ics"`
+	// ConfigMapRef references a key of a ConfigMap in the Argo CD namespace which contains the iCalendar data
+	ConfigMapRef *SyncWindowCalendarConfigMapRef `json:"configMapRef,omitempty" protobuf:"bytes,2,opt,name=configMapRef"`
+	// URL is the address the iCalendar data is fetched from. The data is cached by Argo CD.
+	URL string `json:"url,omitempty" protobuf:"bytes,3,opt,name=url"`
+}
+
+// SyncWindowCalendarConfigMapRef references a key of a ConfigMap in the Argo CD namespace
+type SyncWindowCalendarConfigMapRef struct {
+	// Name of the ConfigMap
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Key of the ConfigMap which contains the iCalendar data
+	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
+}
+
+// SyncWindowOccurrence is an interval during which a sync window is active
+type SyncWindowOccurrence struct {
+	// Start is the time the window becomes active
+	Start metav1.Time `json:"start" protobuf:"bytes,1,opt,name=start"`
+	// End is the time the window becomes inactive
+	End metav1.Time `json:"end" protobuf:"bytes,2,opt,name=end"`
+	// Summary describes the occurrence, e.g. the summary of the calendar event
+	Summary string `json:"summary,omitempty" protobuf:"bytes,3,opt,name=summary"`
+}
+
+// Validate checks whether the calendar has a valid configuration
+func (c *SyncWindowCalendar) Validate() error {
+	sources := 0
+	if c.ICS != "" {
+		sources++
+	}
+	if c.ConfigMapRef != nil {
+		sources++
+		if c.ConfigMapRef.Name == "" || c.ConfigMapRef.Key == "" {
+			return errors.New("calendar configMapRef requires name and key")
+		}
+	}
+	if c.URL != "" {
+		sources++
+		u, err := url.Parse(c.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return fmt.Errorf("calendar url '%s' must be a http or https URL", c.URL)
+		}
+	}
+	if sources != 1 {
+		return errors.New("calendar requires exactly one of ics, configMapRef and url")
+	}
+	if c.ICS != "" {
+		if _, err := ical.Parse(c.ICS, time.UTC); err != nil {
+			return fmt.Errorf("cannot parse calendar: %w", err)
+		}
+	}
+	return nil
+}
+
+// Source returns a short description of the calendar source
+func (c *SyncWindowCalendar) Source() string {
+	switch {
+	case c.ConfigMapRef != nil:
+		return fmt.Sprintf("configmap:%s/%s", c.ConfigMapRef.Name, c.ConfigMapRef.Key)
+	case c.URL != "":
+		return c.URL
+	default:
+		return "inline"
+	}
+}
+
+// events returns the events of the calendar. The calendars which reference a ConfigMap or a URL can only be evaluated
+// once Argo CD resolved their data, see syncwindow.CalendarLoader.
+func (c *SyncWindowCalendar) events(loc *time.Location) ([]ical.Event, error) {
+	if c.ICS == "" {
+		return nil, fmt.Errorf("cannot load calendar %s: calendars referencing a ConfigMap or a URL can only be evaluated by Argo CD", c.Source())
+	}
+	events, err := ical.ParseCached(c.ICS, loc)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse calendar %s: %w", c.Source(), err)
+	}
+	return events, nil
 }
 
 // HasWindows returns true if SyncWindows has one or more SyncWindow
@@ -2965,27 +3420,14 @@ func (w *SyncWindows) Active() (*SyncWindows, error) {
 }
 
 func (w *SyncWindows) active(currentTime time.Time) (*SyncWindows, error) {
-	// If SyncWindows.Active() is called outside of a UTC locale, it should be
-	// first converted to UTC before we scan through the SyncWindows.
-	currentTime = currentTime.In(time.UTC)
-
 	if w.HasWindows() {
 		var active SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *w {
-			schedule, sErr := specParser.Parse(w.Schedule)
-			if sErr != nil {
-				return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
-			}
-			duration, dErr := time.ParseDuration(w.Duration)
-			if dErr != nil {
-				return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+			isActive, err := w.active(currentTime)
+			if err != nil {
+				return nil, err
 			}
-
-			// Offset the nextWindow time to consider the timeZone of the sync window
-			timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-			nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-			if nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			if isActive {
 				active = append(active, w)
 			}
 		}
@@ -3004,30 +3446,17 @@ func (w *SyncWindows) InactiveAllows() (*SyncWindows, error) {
 }
 
 func (w *SyncWindows) inactiveAllows(currentTime time.Time) (*SyncWindows, error) {
-	// If SyncWindows.InactiveAllows() is called outside of a UTC locale, it should be
-	// first converted to UTC before we scan through the SyncWindows.
-	currentTime = currentTime.In(time.UTC)
-
 	if w.HasWindows() {
 		var inactive SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *w {
 			if w.Kind != "allow" {
 				continue
 			}
-			schedule, sErr := specParser.Parse(w.Schedule)
-			if sErr != nil {
-				return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
-			}
-			duration, dErr := time.ParseDuration(w.Duration)
-			if dErr != nil {
-				return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+			isActive, err := w.active(currentTime)
+			if err != nil {
+				return nil, err
 			}
-			// Offset the nextWindow time to consider the timeZone of the sync window
-			timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-			nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-
-			if !nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			if !isActive {
 				inactive = append(inactive, w)
 			}
 		}
@@ -3333,6 +3762,44 @@ func (w *SyncWindows) inactiveAllowsAllowOverrun() bool {
 	return hasAllow
 }
 
+// syncWindowNextSyncOccurrences is the number of upcoming occurrences of every window considered by NextSyncTime
+const syncWindowNextSyncOccurrences = 100
+
+// NextSyncTime returns the first time after the given time at which a sync is allowed by the sync windows, or nil if
+// no such time is found within the upcoming occurrences of the windows
+func (w *SyncWindows) NextSyncTime(isManual bool, from time.Time) (*time.Time, error) {
+	if !w.HasWindows() {
+		return &from, nil
+	}
+	// whether a sync is allowed can only change when a window becomes active or inactive
+	var boundaries []time.Time
+	for _, window := range *w {
+		occurrences, err := window.UpcomingOccurrences(from, syncWindowNextSyncOccurrences)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sync windows: %w", err)
+		}
+		for _, occurrence := range occurrences {
+			for _, t := range []time.Time{occurrence.Start.Time, occurrence.End.Time} {
+				if t.After(from) {
+					boundaries = append(boundaries, t)
+				}
+			}
+		}
+	}
+	slices.SortFunc(boundaries, func(a, b time.Time) int { return a.Compare(b) })
+	for _, boundary := range boundaries {
+		// schedules become active right after their start time, see active()
+		canSync, err := w.canSyncAtTime(isManual, boundary.Add(time.Second))
+		if err != nil {
+			return nil, err
+		}
+		if canSync {
+			return &boundary, nil
+		}
+	}
+	return nil, nil
+}
+
 // canSyncAtTime checks if a sync would have been allowed at a specific time
 func (w *SyncWindows) canSyncAtTime(isManual bool, checkTime time.Time) (bool, error) {
 	if !w.HasWindows() {
@@ -3376,6 +3843,14 @@ func (w SyncWindow) Active() (bool, error) {
 }
 
 func (w SyncWindow) active(currentTime time.Time) (bool, error) {
+	if w.Calendar != nil {
+		occurrences, err := w.calendarOccurrences(currentTime, currentTime.Add(time.Nanosecond))
+		if err != nil {
+			return false, err
+		}
+		return len(occurrences) > 0, nil
+	}
+
 	// If SyncWindow.Active() is called outside of a UTC locale, it should be
 	// first converted to UTC before search
 	currentTime = currentTime.UTC()
@@ -3397,6 +3872,68 @@ func (w SyncWindow) active(currentTime time.Time) (bool, error) {
 	return nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)), nil
 }
 
+// UpcomingOccurrences returns the next intervals, at most limit of them, during which the sync window is active,
+// starting with the current one if the window is active at the given time
+func (w SyncWindow) UpcomingOccurrences(from time.Time, limit int) ([]*SyncWindowOccurrence, error) {
+	if w.Calendar != nil {
+		occurrences, err := w.calendarOccurrences(from, from.AddDate(syncWindowOccurrenceHorizonYears, 0, 0))
+		if err != nil {
+			return nil, err
+		}
+		if len(occurrences) > limit {
+			occurrences = occurrences[:limit]
+		}
+		return occurrences, nil
+	}
+
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, err := specParser.Parse(w.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, err)
+	}
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, err)
+	}
+	// the schedule is evaluated in UTC, offset by the time zone of the window, see active()
+	timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
+	var occurrences []*SyncWindowOccurrence
+	next := from.UTC().Add(timeZoneOffsetDuration - duration)
+	for len(occurrences) < limit {
+		next = schedule.Next(next)
+		if next.IsZero() {
+			break
+		}
+		start := next.Add(-timeZoneOffsetDuration)
+		occurrences = append(occurrences, &SyncWindowOccurrence{Start: metav1.NewTime(start), End: metav1.NewTime(start.Add(duration))})
+	}
+	return occurrences, nil
+}
+
+// syncWindowOccurrenceHorizonYears limits how far in the future the occurrences of a calendar are looked up
+const syncWindowOccurrenceHorizonYears = 2
+
+func (w SyncWindow) calendarOccurrences(from time.Time, to time.Time) ([]*SyncWindowOccurrence, error) {
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	events, err := w.Calendar.events(loc)
+	if err != nil {
+		return nil, err
+	}
+	intervals := ical.Occurrences(events, from, to)
+	occurrences := make([]*SyncWindowOccurrence, 0, len(intervals))
+	for _, interval := range intervals {
+		occurrences = append(occurrences, &SyncWindowOccurrence{
+			Start:   metav1.NewTime(interval.Start),
+			End:     metav1.NewTime(interval.End),
+			Summary: interval.Summary,
+		})
+	}
+	return occurrences, nil
+}
+
 // Update updates a sync window's settings with the given parameter
 func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []string, tz string, description string) error {
 	if s == "" && d == "" && len(a) == 0 && len(n) == 0 && len(c) == 0 && description == "" {
@@ -3448,6 +3985,18 @@ func (w *SyncWindow) Validate() error {
 	if w.Kind != "allow" && w.Kind != "deny" {
 		return fmt.Errorf("kind '%s' mismatch: can only be allow or deny", w.Kind)
 	}
+	if w.Calendar != nil {
+		if w.Schedule != "" || w.Duration != "" {
+			return errors.New("calendar cannot be combined with schedule and duration")
+		}
+		if err := w.Calendar.Validate(); err != nil {
+			return err
+		}
+		if len(w.Description) > 255 {
+			return errors.New("description must not exceed 255 characters")
+		}
+		return nil
+	}
 	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 	_, err := specParser.Parse(w.Schedule)
 	if err != nil {
@@ -3477,6 +4026,7 @@ func (w *SyncWindow) HashIdentity() (uint64, error) {
 		Clusters:       w.Clusters,
 		TimeZone:       w.TimeZone,
 		UseAndOperator: w.UseAndOperator,
+		Calendar:       w.Calendar,
 		// ManualSync and Description are excluded as they don't affect window identity
 	}
 
@@ -3512,6 +4062,33 @@ type ProjectRole struct {
 	JWTTokens []JWTToken `json:"jwtTokens,omitempty" protobuf:"bytes,4,rep,name=jwtTokens"`
 	// Groups are a list of OIDC group claims bound to this role
 	Groups []string `json:"groups,omitempty" protobuf:"bytes,5,rep,name=groups"`
+	// RequireGrantApproval requires the just-in-time grants of this role to be approved by a second user before they
+	// take effect. Members of the role groups can only request grants for roles which require approval.
+	RequireGrantApproval bool `json:"requireGrantApproval,omitempty" protobuf:"varint,6,opt,name=requireGrantApproval"`
+}
+
+// ProjectRoleGrant is a time-bound, just-in-time grant of additional policies or group bindings to a project role
+type ProjectRoleGrant struct {
+	// ID is the unique identifier of the grant
+	ID string `json:"id" protobuf:"bytes,1,opt,name=id"`
+	// Role is the name of the project role the grant applies to
+	Role string `json:"role" protobuf:"bytes,2,opt,name=role"`
+	// Policies are casbin formatted policies added to the role while the grant is active
+	Policies []string `json:"policies,omitempty" protobuf:"bytes,3,rep,name=policies"`
+	// Groups are OIDC group claims or user names bound to the role while the grant is active
+	Groups []string `json:"groups,omitempty" protobuf:"bytes,4,rep,name=groups"`
+	// Duration is how long the grant is active once it takes effect, e.g. 2h
+	Duration string `json:"duration" protobuf:"bytes,5,opt,name=duration"`
+	// Reason is the justification given for the grant
+	Reason string `json:"reason" protobuf:"bytes,6,opt,name=reason"`
+	// RequestedBy is the user who requested the grant
+	RequestedBy string `json:"requestedBy,omitempty" protobuf:"bytes,7,opt,name=requestedBy"`
+	// RequestedAt is the time the grant was requested, in seconds since epoch
+	RequestedAt int64 `json:"requestedAt,omitempty" protobuf:"int64,8,opt,name=requestedAt"`
+	// ApprovedBy is the user who approved the grant, if the role requires grants to be approved
+	ApprovedBy string `json:"approvedBy,omitempty" protobuf:"bytes,9,opt,name=approvedBy"`
+	// ExpiresAt is the time the grant expires, in seconds since epoch. It is only set once the grant takes effect.
+	ExpiresAt int64 `json:"expiresAt,omitempty" protobuf:"int64,10,opt,name=expiresAt"`
 }
 
 // JWTToken holds the issuedAt and expiresAt values of a token
@@ -3845,14 +4422,20 @@ func (spec ApplicationSpec) GetRevisionHistoryLimit() int {
 	return RevisionHistoryLimit
 }
 
-func isResourceInList(res metav1.GroupKind, list []metav1.GroupKind) bool {
+func isNamespacedResourceInList(res metav1.GroupKind, name string, resourceLabels map[string]string, list []NamespaceResourceRestrictionItem) bool {
 	for _, item := range list {
-		ok, err := filepath.Match(item.Kind, res.Kind)
-		if ok && err == nil {
-			ok, err = filepath.Match(item.Group, res.Group)
-			if ok && err == nil {
-				return true
-			}
+		if !isNamedResourceInList(res, name, []ClusterResourceRestrictionItem{{Group: item.Group, Kind: item.Kind, Name: item.Name}}) {
+			continue
+		}
+		if item.LabelSelector == nil {
+			return true
+		}
+		selector, err := metav1.LabelSelectorAsSelector(item.LabelSelector)
+		if err != nil {
+			continue
+		}
+		if selector.Matches(labels.Set(resourceLabels)) {
+			return true
 		}
 	}
 	return false
@@ -4159,6 +4742,16 @@ func (app *Application) RBACName(defaultNS string) string {
 	return security.RBACName(defaultNS, app.Spec.GetProject(), app.Namespace, app.Name)
 }
 
+// RBACAttributes returns the attributes of the application which the conditions of RBAC policies are evaluated against.
+func (app *Application) RBACAttributes() *rbac.Attributes {
+	return &rbac.Attributes{
+		Labels:    app.Labels,
+		Server:    app.Spec.Destination.Server,
+		Cluster:   app.Spec.Destination.Name,
+		Namespace: app.Spec.Destination.Namespace,
+	}
+}
+
 // GetAnnotation returns the value of the specified annotation if it exists,
 // e.g., a.GetAnnotation("argocd.argoproj.io/manifest-generate-paths").
 // If the annotation does not exist, it returns an empty string.
@@ -16,6 +16,7 @@ import (
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -503,11 +504,58 @@ func TestAppProject_IsDestinationPermitted_PermitOnlyProjectScopedClusters(t *te
 	assert.ErrorContains(t, err, "could not retrieve project clusters")
 }
 
+func TestAppProject_IsDestinationPermitted_ClusterSelector(t *testing.T) {
+	proj := AppProject{
+		Spec: AppProjectSpec{
+			Destinations: []ApplicationDestination{{
+				Namespace:       "team-a-*",
+				ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "shared"}},
+			}, {
+				Server:          "https://eu-*",
+				Namespace:       "*",
+				ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+			}, {
+				Server:          "https://eu-1",
+				Namespace:       "!kube-system",
+				ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+			}},
+		},
+	}
+	noProjectClusters := func(_ string) ([]*Cluster, error) {
+		return []*Cluster{}, nil
+	}
+	sharedCluster := &Cluster{Server: "https://shared-1", Name: "shared-1", Labels: map[string]string{"tier": "shared"}}
+	dedicatedCluster := &Cluster{Server: "https://dedicated-1", Name: "dedicated-1", Labels: map[string]string{"tier": "dedicated"}}
+
+	testData := []struct {
+		name        string
+		cluster     *Cluster
+		namespace   string
+		isPermitted bool
+	}{
+		{name: "labels and namespace match", cluster: sharedCluster, namespace: "team-a-frontend", isPermitted: true},
+		{name: "namespace does not match", cluster: sharedCluster, namespace: "team-b-frontend", isPermitted: false},
+		{name: "labels do not match", cluster: dedicatedCluster, namespace: "team-a-frontend", isPermitted: false},
+		{name: "cluster without labels", cluster: &Cluster{Server: "https://shared-2"}, namespace: "team-a-frontend", isPermitted: false},
+		{name: "labels and server match", cluster: &Cluster{Server: "https://eu-1", Labels: map[string]string{"env": "dev"}}, namespace: "default", isPermitted: true},
+		{name: "labels match but server does not match", cluster: &Cluster{Server: "https://us-1", Labels: map[string]string{"env": "dev"}}, namespace: "default", isPermitted: false},
+		{name: "denied namespace on selected cluster", cluster: &Cluster{Server: "https://eu-1", Labels: map[string]string{"env": "dev"}}, namespace: "kube-system", isPermitted: false},
+		{name: "denied namespace on other cluster", cluster: &Cluster{Server: "https://eu-2", Labels: map[string]string{"env": "dev"}}, namespace: "kube-system", isPermitted: true},
+	}
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			permitted, err := proj.IsDestinationPermitted(data.cluster, data.namespace, noProjectClusters)
+			require.NoError(t, err)
+			assert.Equal(t, data.isPermitted, permitted)
+		})
+	}
+}
+
 func TestAppProject_IsGroupKindPermitted(t *testing.T) {
 	proj := AppProject{
 		Spec: AppProjectSpec{
-			NamespaceResourceWhitelist: []metav1.GroupKind{},
-			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "apps", Kind: "Deployment"}},
+			NamespaceResourceWhitelist: []NamespaceResourceRestrictionItem{},
+			NamespaceResourceBlacklist: []NamespaceResourceRestrictionItem{{Group: "apps", Kind: "Deployment"}},
 		},
 	}
 	assert.False(t, proj.IsGroupKindNamePermitted(schema.GroupKind{Group: "apps", Kind: "ReplicaSet"}, "", true))
@@ -515,8 +563,8 @@ func TestAppProject_IsGroupKindPermitted(t *testing.T) {
 
 	proj2 := AppProject{
 		Spec: AppProjectSpec{
-			NamespaceResourceWhitelist: []metav1.GroupKind{{Group: "apps", Kind: "ReplicaSet"}},
-			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "apps", Kind: "Deployment"}},
+			NamespaceResourceWhitelist: []NamespaceResourceRestrictionItem{{Group: "apps", Kind: "ReplicaSet"}},
+			NamespaceResourceBlacklist: []NamespaceResourceRestrictionItem{{Group: "apps", Kind: "Deployment"}},
 		},
 	}
 	assert.True(t, proj2.IsGroupKindNamePermitted(schema.GroupKind{Group: "apps", Kind: "ReplicaSet"}, "", true))
@@ -541,7 +589,7 @@ func TestAppProject_IsGroupKindPermitted(t *testing.T) {
 	proj5 := AppProject{
 		Spec: AppProjectSpec{
 			ClusterResourceWhitelist:   []ClusterResourceRestrictionItem{},
-			NamespaceResourceWhitelist: []metav1.GroupKind{{Group: "*", Kind: "*"}},
+			NamespaceResourceWhitelist: []NamespaceResourceRestrictionItem{{Group: "*", Kind: "*"}},
 		},
 	}
 	assert.False(t, proj5.IsGroupKindNamePermitted(schema.GroupKind{Group: "", Kind: "Namespace"}, "", false))
@@ -562,6 +610,53 @@ func TestAppProject_IsGroupKindPermitted(t *testing.T) {
 	assert.True(t, proj7.IsGroupKindNamePermitted(schema.GroupKind{Group: "", Kind: "Namespace"}, "team1-namespace", false))
 }
 
+func TestAppProject_IsGroupKindNameLabelsPermitted(t *testing.T) {
+	proj := AppProject{
+		Spec: AppProjectSpec{
+			Destinations: []ApplicationDestination{{Server: "*", Namespace: "*"}},
+			NamespaceResourceWhitelist: []NamespaceResourceRestrictionItem{
+				{Group: "", Kind: "ConfigMap"},
+				{Group: "", Kind: "Secret", LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "foo"}}},
+			},
+			NamespaceResourceBlacklist: []NamespaceResourceRestrictionItem{
+				{Group: "", Kind: "ConfigMap", Name: "aws-*"},
+				{Group: "", Kind: "ConfigMap", LabelSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "protected", Operator: metav1.LabelSelectorOpExists}},
+				}},
+			},
+		},
+	}
+	configMap := schema.GroupKind{Group: "", Kind: "ConfigMap"}
+	secret := schema.GroupKind{Group: "", Kind: "Secret"}
+
+	assert.True(t, proj.IsGroupKindNameLabelsPermitted(configMap, "my-config", nil, true))
+	assert.False(t, proj.IsGroupKindNameLabelsPermitted(configMap, "aws-auth", nil, true))
+	assert.False(t, proj.IsGroupKindNameLabelsPermitted(configMap, "my-config", map[string]string{"protected": "true"}, true))
+	assert.True(t, proj.IsGroupKindNameLabelsPermitted(secret, "my-secret", map[string]string{"tenant": "foo"}, true))
+	assert.False(t, proj.IsGroupKindNameLabelsPermitted(secret, "my-secret", map[string]string{"tenant": "bar"}, true))
+	// label selectors are evaluated against a resource without labels when the labels are unknown
+	assert.False(t, proj.IsGroupKindNamePermitted(secret, "my-secret", true))
+
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "Secret"})
+	live.SetName("my-secret")
+	live.SetNamespace("default")
+	live.SetLabels(map[string]string{"tenant": "foo"})
+	permitted, err := proj.IsLiveResourcePermitted(live, &Cluster{Server: "https://kubernetes.default.svc"}, func(_ string) ([]*Cluster, error) {
+		return nil, nil
+	})
+	require.NoError(t, err)
+	assert.True(t, permitted)
+}
+
+func TestAppProject_ValidateNamespaceResourceLabelSelector(t *testing.T) {
+	p := newTestProject()
+	p.Spec.NamespaceResourceBlacklist = []NamespaceResourceRestrictionItem{{Kind: "Secret", LabelSelector: &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tenant", Operator: "Unknown"}},
+	}}}
+	require.ErrorContains(t, p.ValidateProject(), "label selector of namespaced resource '/Secret' has an invalid format")
+}
+
 func TestGlobMatch(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -817,6 +912,31 @@ func TestAppProject_ValidateDestinations(t *testing.T) {
 	err = p.ValidateProject()
 	require.NoError(t, err)
 
+	sharedDestination := ApplicationDestination{
+		Namespace:       "some-namespace",
+		ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "shared"}},
+	}
+	devDestination := ApplicationDestination{
+		Namespace:       "some-namespace",
+		ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+	}
+	// allow multiple destinations with blank server and name but unique cluster selector
+	p.Spec.Destinations = []ApplicationDestination{sharedDestination, devDestination}
+	err = p.ValidateProject()
+	require.NoError(t, err)
+
+	p.Spec.Destinations = []ApplicationDestination{sharedDestination, sharedDestination}
+	err = p.ValidateProject()
+	require.ErrorContains(t, err, "destination '/some-namespace[tier=shared]' already added")
+
+	p.Spec.Destinations = []ApplicationDestination{{
+		Namespace:       "some-namespace",
+		ClusterSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Bad"}}},
+	}}
+	err = p.ValidateProject()
+	require.ErrorContains(t, err, "cluster selector of destination '/some-namespace' has an invalid format")
+	p.Spec.Destinations = []ApplicationDestination{validDestination}
+
 	t.Run("must reject duplicate source namespaces", func(t *testing.T) {
 		p.Spec.SourceNamespaces = []string{"argocd", "argocd"}
 		err = p.ValidateProject()
@@ -825,6 +945,16 @@ func TestAppProject_ValidateDestinations(t *testing.T) {
 }
 
 // TestValidateRoleName tests for an invalid role name
+func TestAppProject_ValidateParent(t *testing.T) {
+	p := newTestProject()
+	p.Spec.Parent = "parent-proj"
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.Parent = p.Name
+	err := p.ValidateProject()
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = project 'my-proj' cannot be its own parent")
+}
+
 func TestAppProject_ValidateRoleName(t *testing.T) {
 	p := newTestProject()
 	err := p.ValidateProject()
@@ -890,6 +1020,48 @@ func TestAppProject_ValidateGroupName(t *testing.T) {
 	}
 }
 
+func TestAppProject_ValidateRoleGrants(t *testing.T) {
+	p := newTestProject()
+	p.Status.Grants = []ProjectRoleGrant{
+		{ID: "1", Role: "my-role", Policies: []string{"p, proj:my-proj:my-role, applications, sync, my-proj/*, allow"}, Duration: "2h"},
+		{ID: "2", Role: "my-role", Groups: []string{"my-org:sre"}, Duration: "30m"},
+	}
+	require.NoError(t, p.ValidateProject())
+
+	p.Status.Grants[1].ID = "1"
+	require.ErrorContains(t, p.ValidateProject(), "grant '1' already exists")
+	p.Status.Grants[1].ID = "2"
+
+	p.Status.Grants[1].Duration = "-1h"
+	require.ErrorContains(t, p.ValidateProject(), "grant '2' has an invalid duration '-1h'")
+	p.Status.Grants[1].Duration = "30m"
+
+	p.Status.Grants[1].Groups = nil
+	require.ErrorContains(t, p.ValidateProject(), "grant '2' must have at least one policy or group")
+	p.Status.Grants[1].Groups = []string{"my-org:sre"}
+
+	p.Status.Grants[0].Policies = []string{"p, proj:other-proj:my-role, applications, sync, my-proj/*, allow"}
+	require.Error(t, p.ValidateProject())
+}
+
+func TestAppProject_ProjectPoliciesStringWithGrants(t *testing.T) {
+	p := newTestProject()
+	now := time.Now()
+	p.Status.Grants = []ProjectRoleGrant{
+		{ID: "active", Role: "my-role", Policies: []string{"p, proj:my-proj:my-role, applications, sync, my-proj/*, allow"}, Groups: []string{"my-org:sre"}, Duration: "1h", ExpiresAt: now.Add(time.Hour).Unix()},
+		{ID: "expired", Role: "my-role", Groups: []string{"my-org:dev"}, Duration: "1h", ExpiresAt: now.Add(-time.Hour).Unix()},
+		{ID: "pending", Role: "my-role", Groups: []string{"my-org:qa"}, Duration: "1h"},
+	}
+	assert.Equal(t, []ProjectRoleGrant{p.Status.Grants[0]}, p.ActiveRoleGrants(now))
+	assert.Equal(t, "p, proj:my-proj:my-role, projects, get, my-proj, allow\n"+
+		"p, proj:my-proj:my-role, applications, sync, my-proj/*, allow\n"+
+		"g, my-org:sre, proj:my-proj:my-role", p.ProjectPoliciesString())
+
+	assert.True(t, p.RemoveExpiredRoleGrants(now))
+	assert.Equal(t, []string{"active", "pending"}, []string{p.Status.Grants[0].ID, p.Status.Grants[1].ID})
+	assert.False(t, p.RemoveExpiredRoleGrants(now))
+}
+
 func TestAppProject_ValidateSyncWindowList(t *testing.T) {
 	t.Run("WorkingSyncWindow", func(t *testing.T) {
 		p := newTestProjectWithSyncWindows()
@@ -3282,6 +3454,45 @@ func TestSyncWindow_Active(t *testing.T) {
 	}
 }
 
+func TestSyncWindows_NextSyncTime(t *testing.T) {
+	now := time.Date(2026, 10, 18, 10, 30, 0, 0, time.UTC)
+	t.Run("NoWindows", func(t *testing.T) {
+		next, err := (&SyncWindows{}).NextSyncTime(false, now)
+		require.NoError(t, err)
+		assert.Equal(t, now, *next)
+	})
+	t.Run("InactiveAllow", func(t *testing.T) {
+		windows := SyncWindows{{Kind: "allow", Schedule: "0 22 * * *", Duration: "1h"}}
+		next, err := windows.NextSyncTime(false, now)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 10, 18, 22, 0, 0, 0, time.UTC), *next)
+	})
+	t.Run("ActiveDeny", func(t *testing.T) {
+		windows := SyncWindows{{Kind: "deny", Schedule: "0 10 * * *", Duration: "2h"}}
+		next, err := windows.NextSyncTime(false, now)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC), *next)
+	})
+	t.Run("DenyOverlappingAllow", func(t *testing.T) {
+		windows := SyncWindows{
+			{Kind: "allow", Schedule: "0 11 * * *", Duration: "2h"},
+			{Kind: "deny", Schedule: "0 10 * * *", Duration: "2h"},
+		}
+		next, err := windows.NextSyncTime(false, now)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC), *next)
+	})
+	t.Run("ManualSync", func(t *testing.T) {
+		windows := SyncWindows{{Kind: "deny", Schedule: "* * * * *", Duration: "1h", ManualSync: true}}
+		next, err := windows.NextSyncTime(false, now)
+		require.NoError(t, err)
+		assert.Nil(t, next)
+		next, err = windows.NextSyncTime(true, now)
+		require.NoError(t, err)
+		assert.NotNil(t, next)
+	})
+}
+
 func TestSyncWindow_Update(t *testing.T) {
 	e := SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h", Applications: []string{"app1"}}
 	t.Run("AddApplication", func(t *testing.T) {
@@ -3342,6 +3553,93 @@ func TestSyncWindow_Validate(t *testing.T) {
 	})
 }
 
+const testSyncWindowCalendar = `BEGIN:VCALENDAR
+BEGIN:VEVENT
+SUMMARY:Christmas
+DTSTART;VALUE=DATE:20261224
+DTEND;VALUE=DATE:20261227
+RRULE:FREQ=YEARLY
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Release
+DTSTART:20261020T080000
+DURATION:PT2H
+END:VEVENT
+END:VCALENDAR`
+
+func TestSyncWindow_Calendar(t *testing.T) {
+	deny := SyncWindow{Kind: "deny", Applications: []string{"*"}, Calendar: &SyncWindowCalendar{ICS: testSyncWindowCalendar}, TimeZone: "Europe/Berlin"}
+
+	t.Run("Active", func(t *testing.T) {
+		active, err := deny.active(time.Date(2026, 12, 25, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.True(t, active)
+		active, err = deny.active(time.Date(2026, 12, 27, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.False(t, active)
+		// floating times are interpreted in the time zone of the window
+		active, err = deny.active(time.Date(2026, 10, 20, 6, 30, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.True(t, active)
+	})
+	t.Run("CanSync", func(t *testing.T) {
+		windows := SyncWindows{&deny}
+		canSync, err := windows.canSyncAtTime(false, time.Date(2027, 12, 24, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.False(t, canSync)
+		canSync, err = windows.canSyncAtTime(false, time.Date(2027, 12, 28, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.True(t, canSync)
+	})
+	t.Run("UpcomingOccurrences", func(t *testing.T) {
+		occurrences, err := deny.UpcomingOccurrences(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), 3)
+		require.NoError(t, err)
+		require.Len(t, occurrences, 3)
+		assert.Equal(t, "Release", occurrences[0].Summary)
+		assert.Equal(t, time.Date(2026, 10, 20, 6, 0, 0, 0, time.UTC), occurrences[0].Start.UTC())
+		assert.Equal(t, "Christmas", occurrences[1].Summary)
+		assert.Equal(t, time.Date(2027, 12, 23, 23, 0, 0, 0, time.UTC), occurrences[2].Start.UTC())
+	})
+	t.Run("UpcomingOccurrencesOfSchedule", func(t *testing.T) {
+		window := SyncWindow{Kind: "allow", Schedule: "0 8 * * *", Duration: "2h"}
+		occurrences, err := window.UpcomingOccurrences(time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC), 2)
+		require.NoError(t, err)
+		require.Len(t, occurrences, 2)
+		assert.Equal(t, time.Date(2026, 10, 18, 8, 0, 0, 0, time.UTC), occurrences[0].Start.UTC())
+		assert.Equal(t, time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC), occurrences[1].End.UTC())
+	})
+	t.Run("Reference", func(t *testing.T) {
+		window := SyncWindow{Kind: "deny", Calendar: &SyncWindowCalendar{URL: "https://calendar.example.com/freeze.ics"}}
+		_, err := window.active(time.Date(2026, 12, 25, 12, 0, 0, 0, time.UTC))
+		require.ErrorContains(t, err, "can only be evaluated by Argo CD")
+
+		// a calendar resolved by Argo CD keeps its reference along with its data
+		window.Calendar.ICS = testSyncWindowCalendar
+		active, err := window.active(time.Date(2026, 12, 25, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.True(t, active)
+
+		window.Calendar.ICS = "BEGIN:VEVENT\nEND:VEVENT"
+		_, err = window.active(time.Date(2026, 12, 25, 12, 0, 0, 0, time.UTC))
+		require.ErrorContains(t, err, "cannot parse calendar https://calendar.example.com/freeze.ics")
+	})
+	t.Run("Validate", func(t *testing.T) {
+		require.NoError(t, deny.Validate())
+		for name, window := range map[string]SyncWindow{
+			"schedule":         {Kind: "deny", Schedule: "* * * * *", Calendar: &SyncWindowCalendar{ICS: testSyncWindowCalendar}},
+			"no source":        {Kind: "deny", Calendar: &SyncWindowCalendar{}},
+			"multiple sources": {Kind: "deny", Calendar: &SyncWindowCalendar{ICS: testSyncWindowCalendar, URL: "https://calendar.example.com/freeze.ics"}},
+			"invalid url":      {Kind: "deny", Calendar: &SyncWindowCalendar{URL: "file:///etc/freeze.ics"}},
+			"missing key":      {Kind: "deny", Calendar: &SyncWindowCalendar{ConfigMapRef: &SyncWindowCalendarConfigMapRef{Name: "freeze"}}},
+			"invalid ics":      {Kind: "deny", Calendar: &SyncWindowCalendar{ICS: "BEGIN:VEVENT\nEND:VEVENT"}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				require.Error(t, window.Validate())
+			})
+		}
+	})
+}
+
 func TestApplicationStatus_GetConditions(t *testing.T) {
 	status := ApplicationStatus{
 		Conditions: []ApplicationCondition{
@@ -6230,3 +6528,38 @@ func TestGetDrySource_PreservesAllFields(t *testing.T) {
 		})
 	}
 }
+
+func TestAutomatedRollback(t *testing.T) {
+	rollback := &AutomatedRollback{}
+	window, err := rollback.WindowDuration()
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, window)
+	assert.Equal(t, int64(1), rollback.GetMaxAttempts())
+
+	rollback = &AutomatedRollback{Window: "90", MaxAttempts: 3}
+	window, err = rollback.WindowDuration()
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, window)
+	assert.Equal(t, int64(3), rollback.GetMaxAttempts())
+
+	_, err = (&AutomatedRollback{Window: "invalid"}).WindowDuration()
+	require.Error(t, err)
+}
+
+func TestSyncTimeouts(t *testing.T) {
+	var timeouts *SyncTimeouts
+	operation, err := timeouts.OperationDuration()
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), operation)
+
+	timeouts = &SyncTimeouts{Operation: "1h", Health: "300"}
+	operation, err = timeouts.OperationDuration()
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, operation)
+	health, err := timeouts.HealthDuration()
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, health)
+
+	_, err = (&SyncTimeouts{Health: "invalid"}).HealthDuration()
+	require.Error(t, err)
+}
@@ -134,7 +134,9 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 	if in.Destinations != nil {
 		in, out := &in.Destinations, &out.Destinations
 		*out = make([]ApplicationDestination, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Roles != nil {
 		in, out := &in.Roles, &out.Roles
@@ -150,8 +152,10 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 	}
 	if in.NamespaceResourceBlacklist != nil {
 		in, out := &in.NamespaceResourceBlacklist, &out.NamespaceResourceBlacklist
-		*out = make([]v1.GroupKind, len(*in))
-		copy(*out, *in)
+		*out = make([]NamespaceResourceRestrictionItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.OrphanedResources != nil {
 		in, out := &in.OrphanedResources, &out.OrphanedResources
@@ -171,8 +175,10 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 	}
 	if in.NamespaceResourceWhitelist != nil {
 		in, out := &in.NamespaceResourceWhitelist, &out.NamespaceResourceWhitelist
-		*out = make([]v1.GroupKind, len(*in))
-		copy(*out, *in)
+		*out = make([]NamespaceResourceRestrictionItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.SignatureKeys != nil {
 		in, out := &in.SignatureKeys, &out.SignatureKeys
@@ -199,6 +205,21 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = new(SourceIntegrity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProgressDeadlineSeconds != nil {
+		in, out := &in.ProgressDeadlineSeconds, &out.ProgressDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = new(ProjectQuotas)
+		**out = **in
+	}
+	if in.NamespaceTemplate != nil {
+		in, out := &in.NamespaceTemplate, &out.NamespaceTemplate
+		*out = new(ProjectNamespaceTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -222,6 +243,13 @@ func (in *AppProjectStatus) DeepCopyInto(out *AppProjectStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]ProjectRoleGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -288,9 +316,35 @@ func (in *ApplicationCondition) DeepCopy() *ApplicationCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDependency) DeepCopyInto(out *ApplicationDependency) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDependency.
+func (in *ApplicationDependency) DeepCopy() *ApplicationDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationDestination) DeepCopyInto(out *ApplicationDestination) {
 	*out = *in
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -320,6 +374,62 @@ func (in *ApplicationDestinationServiceAccount) DeepCopy() *ApplicationDestinati
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationFleet) DeepCopyInto(out *ApplicationFleet) {
+	*out = *in
+	if in.RolloutSteps != nil {
+		in, out := &in.RolloutSteps, &out.RolloutSteps
+		*out = make([]ApplicationFleetRolloutStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationFleet.
+func (in *ApplicationFleet) DeepCopy() *ApplicationFleet {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationFleet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationFleetClusterStatus) DeepCopyInto(out *ApplicationFleetClusterStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationFleetClusterStatus.
+func (in *ApplicationFleetClusterStatus) DeepCopy() *ApplicationFleetClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationFleetClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationFleetRolloutStep) DeepCopyInto(out *ApplicationFleetRolloutStep) {
+	*out = *in
+	in.ClusterSelector.DeepCopyInto(&out.ClusterSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationFleetRolloutStep.
+func (in *ApplicationFleetRolloutStep) DeepCopy() *ApplicationFleetRolloutStep {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationFleetRolloutStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationList) DeepCopyInto(out *ApplicationList) {
 	*out = *in
@@ -539,6 +649,31 @@ func (in *ApplicationSetGenerator) DeepCopy() *ApplicationSetGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetGeneratorStatus) DeepCopyInto(out *ApplicationSetGeneratorStatus) {
+	*out = *in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GeneratedAt != nil {
+		in, out := &in.GeneratedAt, &out.GeneratedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetGeneratorStatus.
+func (in *ApplicationSetGeneratorStatus) DeepCopy() *ApplicationSetGeneratorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetGeneratorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in ApplicationSetIgnoreDifferences) DeepCopyInto(out *ApplicationSetIgnoreDifferences) {
 	{
@@ -840,6 +975,13 @@ func (in *ApplicationSetStatus) DeepCopyInto(out *ApplicationSetStatus) {
 		}
 	}
 	in.Health.DeepCopyInto(&out.Health)
+	if in.Generators != nil {
+		in, out := &in.Generators, &out.Generators
+		*out = make([]ApplicationSetGeneratorStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1365,7 +1507,7 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(ApplicationSource)
 		(*in).DeepCopyInto(*out)
 	}
-	out.Destination = in.Destination
+	in.Destination.DeepCopyInto(&out.Destination)
 	if in.SyncPolicy != nil {
 		in, out := &in.SyncPolicy, &out.SyncPolicy
 		*out = new(SyncPolicy)
@@ -1400,6 +1542,23 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(SourceHydrator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ApplicationDependency, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProgressDeadlineSeconds != nil {
+		in, out := &in.ProgressDeadlineSeconds, &out.ProgressDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Fleet != nil {
+		in, out := &in.Fleet, &out.Fleet
+		*out = new(ApplicationFleet)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1459,6 +1618,11 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		copy(*out, *in)
 	}
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.Fleet != nil {
+		in, out := &in.Fleet, &out.Fleet
+		*out = make([]ApplicationFleetClusterStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1552,6 +1716,22 @@ func (in *ApplicationWatchEvent) DeepCopy() *ApplicationWatchEvent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutomatedRollback) DeepCopyInto(out *AutomatedRollback) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutomatedRollback.
+func (in *AutomatedRollback) DeepCopy() *AutomatedRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(AutomatedRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backoff) DeepCopyInto(out *Backoff) {
 	*out = *in
@@ -1886,7 +2066,7 @@ func (in *CommitMetadata) DeepCopy() *CommitMetadata {
 func (in *ComparedTo) DeepCopyInto(out *ComparedTo) {
 	*out = *in
 	in.Source.DeepCopyInto(&out.Source)
-	out.Destination = in.Destination
+	in.Destination.DeepCopyInto(&out.Destination)
 	if in.Sources != nil {
 		in, out := &in.Sources, &out.Sources
 		*out = make(ApplicationSources, len(*in))
@@ -2818,6 +2998,27 @@ func (in *MergeGenerator) DeepCopy() *MergeGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceResourceRestrictionItem) DeepCopyInto(out *NamespaceResourceRestrictionItem) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceResourceRestrictionItem.
+func (in *NamespaceResourceRestrictionItem) DeepCopy() *NamespaceResourceRestrictionItem {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceResourceRestrictionItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NestedMatrixGenerator) DeepCopyInto(out *NestedMatrixGenerator) {
 	*out = *in
@@ -2906,6 +3107,11 @@ func (in *Operation) DeepCopyInto(out *Operation) {
 		}
 	}
 	in.Retry.DeepCopyInto(&out.Retry)
+	if in.AutomatedRollback != nil {
+		in, out := &in.AutomatedRollback, &out.AutomatedRollback
+		*out = new(OperationAutomatedRollback)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2919,6 +3125,27 @@ func (in *Operation) DeepCopy() *Operation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationAutomatedRollback) DeepCopyInto(out *OperationAutomatedRollback) {
+	*out = *in
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationAutomatedRollback.
+func (in *OperationAutomatedRollback) DeepCopy() *OperationAutomatedRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationAutomatedRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperationInitiator) DeepCopyInto(out *OperationInitiator) {
 	*out = *in
@@ -2949,6 +3176,10 @@ func (in *OperationState) DeepCopyInto(out *OperationState) {
 		in, out := &in.FinishedAt, &out.FinishedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.ScheduledAt != nil {
+		in, out := &in.ScheduledAt, &out.ScheduledAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -3035,6 +3266,11 @@ func (in *OrphanedResourcesMonitorSettings) DeepCopyInto(out *OrphanedResourcesM
 		*out = make([]OrphanedResourceKey, len(*in))
 		copy(*out, *in)
 	}
+	if in.Prune != nil {
+		in, out := &in.Prune, &out.Prune
+		*out = new(OrphanedResourcesPruneSettings)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3048,6 +3284,27 @@ func (in *OrphanedResourcesMonitorSettings) DeepCopy() *OrphanedResourcesMonitor
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedResourcesPruneSettings) DeepCopyInto(out *OrphanedResourcesPruneSettings) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]OrphanedResourceKey, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedResourcesPruneSettings.
+func (in *OrphanedResourcesPruneSettings) DeepCopy() *OrphanedResourcesPruneSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedResourcesPruneSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OverrideIgnoreDiff) DeepCopyInto(out *OverrideIgnoreDiff) {
 	*out = *in
@@ -3066,6 +3323,11 @@ func (in *OverrideIgnoreDiff) DeepCopyInto(out *OverrideIgnoreDiff) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CELExpressions != nil {
+		in, out := &in.CELExpressions, &out.CELExpressions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3171,6 +3433,78 @@ func (in PluginParameters) DeepCopy() PluginParameters {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectNamespaceTemplate) DeepCopyInto(out *ProjectNamespaceTemplate) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		*out = new(corev1.ResourceQuotaSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LimitRange != nil {
+		in, out := &in.LimitRange, &out.LimitRange
+		*out = new(corev1.LimitRangeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNamespaceTemplate.
+func (in *ProjectNamespaceTemplate) DeepCopy() *ProjectNamespaceTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectNamespaceTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectQuotaUsage) DeepCopyInto(out *ProjectQuotaUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectQuotaUsage.
+func (in *ProjectQuotaUsage) DeepCopy() *ProjectQuotaUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectQuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectQuotas) DeepCopyInto(out *ProjectQuotas) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectQuotas.
+func (in *ProjectQuotas) DeepCopy() *ProjectQuotas {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectQuotas)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectRole) DeepCopyInto(out *ProjectRole) {
 	*out = *in
@@ -3202,6 +3536,48 @@ func (in *ProjectRole) DeepCopy() *ProjectRole {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRoleGrant) DeepCopyInto(out *ProjectRoleGrant) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRoleGrant.
+func (in *ProjectRoleGrant) DeepCopy() *ProjectRoleGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRoleGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRuleOrigin) DeepCopyInto(out *ProjectRuleOrigin) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRuleOrigin.
+func (in *ProjectRuleOrigin) DeepCopy() *ProjectRuleOrigin {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRuleOrigin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestGenerator) DeepCopyInto(out *PullRequestGenerator) {
 	*out = *in
@@ -3774,6 +4150,11 @@ func (in *ResourceIgnoreDifferences) DeepCopyInto(out *ResourceIgnoreDifferences
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CELExpressions != nil {
+		in, out := &in.CELExpressions, &out.CELExpressions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3927,6 +4308,10 @@ func (in *ResourceResult) DeepCopyInto(out *ResourceResult) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -4701,6 +5086,11 @@ func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(SyncTimeouts)
+		**out = **in
+	}
 	return
 }
 
@@ -4818,6 +5208,16 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AutomatedRollback != nil {
+		in, out := &in.AutomatedRollback, &out.AutomatedRollback
+		*out = new(AutomatedRollback)
+		**out = **in
+	}
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(SyncTimeouts)
+		**out = **in
+	}
 	return
 }
 
@@ -4964,6 +5364,22 @@ func (in *SyncStrategyHook) DeepCopy() *SyncStrategyHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncTimeouts) DeepCopyInto(out *SyncTimeouts) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncTimeouts.
+func (in *SyncTimeouts) DeepCopy() *SyncTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 	*out = *in
@@ -4982,6 +5398,11 @@ func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Calendar != nil {
+		in, out := &in.Calendar, &out.Calendar
+		*out = new(SyncWindowCalendar)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4995,6 +5416,61 @@ func (in *SyncWindow) DeepCopy() *SyncWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindowCalendar) DeepCopyInto(out *SyncWindowCalendar) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(SyncWindowCalendarConfigMapRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindowCalendar.
+func (in *SyncWindowCalendar) DeepCopy() *SyncWindowCalendar {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindowCalendar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindowCalendarConfigMapRef) DeepCopyInto(out *SyncWindowCalendarConfigMapRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindowCalendarConfigMapRef.
+func (in *SyncWindowCalendarConfigMapRef) DeepCopy() *SyncWindowCalendarConfigMapRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindowCalendarConfigMapRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindowOccurrence) DeepCopyInto(out *SyncWindowOccurrence) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindowOccurrence.
+func (in *SyncWindowOccurrence) DeepCopy() *SyncWindowOccurrence {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindowOccurrence)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in SyncWindows) DeepCopyInto(out *SyncWindows) {
 	{
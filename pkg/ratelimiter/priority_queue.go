@@ -0,0 +1,216 @@
+package ratelimiter
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Priority is the priority of a work queue item. Items of a higher priority are handed out first.
+type Priority int
+
+const (
+	// PriorityNormal is the priority of items added by periodic or event driven processing
+	PriorityNormal Priority = iota
+	// PriorityHigh is the priority of items added on behalf of a user, e.g. a refresh or a sync requested via the API
+	PriorityHigh
+
+	numPriorities = int(PriorityHigh) + 1
+)
+
+func (p Priority) String() string {
+	if p == PriorityHigh {
+		return "high"
+	}
+	return "normal"
+}
+
+// maxConsecutiveHighPriority is the number of high priority items handed out in a row while normal priority items are
+// waiting. The next item is taken from the normal priority items then, so that they are not starved.
+const maxConsecutiveHighPriority = 10
+
+// PriorityQueueMetrics receives the depth and the wait time of the items of a priority queue per priority
+type PriorityQueueMetrics interface {
+	SetQueueDepth(queue string, priority string, depth int)
+	ObserveQueueWait(queue string, priority string, wait time.Duration)
+}
+
+type priorityQueueEntry[T comparable] struct {
+	item T
+	seq  uint64
+}
+
+type queuedItem struct {
+	priority Priority
+	seq      uint64
+	since    time.Time
+}
+
+// PriorityQueue is the storage of a work queue which hands out items of a higher priority first, and items of the
+// same priority in the order in which they were added. Items are added with normal priority unless a higher priority
+// was requested using Prioritize before adding them.
+type PriorityQueue[T comparable] struct {
+	name    string
+	metrics PriorityQueueMetrics
+	now     func() time.Time
+
+	lock sync.Mutex
+	// lanes holds the queued items per priority. Items moved to a higher priority leave a stale entry behind, which is
+	// skipped since its sequence number does not match the one of the queued item anymore.
+	lanes           [numPriorities][]priorityQueueEntry[T]
+	depth           [numPriorities]int
+	queued          map[T]queuedItem
+	requested       map[T]Priority
+	seq             uint64
+	consecutiveHigh int
+}
+
+var _ workqueue.Queue[string] = &PriorityQueue[string]{}
+
+// NewPriorityQueue creates a priority queue with the given name. The metrics are optional.
+func NewPriorityQueue[T comparable](name string, metrics PriorityQueueMetrics) *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		name:      name,
+		metrics:   metrics,
+		now:       time.Now,
+		queued:    map[T]queuedItem{},
+		requested: map[T]Priority{},
+	}
+}
+
+// priorityRateLimitingQueue is a rate limited work queue which drops the priority requested for an item once the item
+// is done or forgotten, so that the priorities requested for items which are never added again are not kept forever
+type priorityRateLimitingQueue[T comparable] struct {
+	workqueue.TypedRateLimitingInterface[T]
+	priorities *PriorityQueue[T]
+}
+
+// NewPriorityRateLimitingQueue creates a rate limited work queue which hands out its items in the order of the given
+// priority queue
+func NewPriorityRateLimitingQueue[T comparable](rateLimiter workqueue.TypedRateLimiter[T], name string, queue *PriorityQueue[T]) workqueue.TypedRateLimitingInterface[T] {
+	return &priorityRateLimitingQueue[T]{
+		TypedRateLimitingInterface: workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[T]{
+			DelayingQueue: workqueue.NewTypedDelayingQueueWithConfig(workqueue.TypedDelayingQueueConfig[T]{
+				Name:  name,
+				Queue: workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[T]{Name: name, Queue: queue}),
+			}),
+		}),
+		priorities: queue,
+	}
+}
+
+// Done marks the item as done. An item added again while it was processed is queued by Done with the priority
+// requested for it, so the request is only dropped afterwards.
+func (q *priorityRateLimitingQueue[T]) Done(item T) {
+	q.TypedRateLimitingInterface.Done(item)
+	q.priorities.forget(item)
+}
+
+// Forget stops the rate limiter from tracking the item and drops the priority requested for it
+func (q *priorityRateLimitingQueue[T]) Forget(item T) {
+	q.TypedRateLimitingInterface.Forget(item)
+	q.priorities.forget(item)
+}
+
+// Prioritize requests the given priority for the item. The priority is applied once the item is added to the work
+// queue, which moves an already queued item ahead if the priority is higher than the one it was queued with.
+func (q *PriorityQueue[T]) Prioritize(item T, priority Priority) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if priority > q.requested[item] {
+		q.requested[item] = priority
+	}
+}
+
+// forget drops the priority requested for the item, if it has not been applied yet
+func (q *PriorityQueue[T]) forget(item T) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	delete(q.requested, item)
+}
+
+// Touch is called by the work queue if a queued item is added again
+func (q *PriorityQueue[T]) Touch(item T) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	priority, ok := q.requested[item]
+	if !ok {
+		return
+	}
+	delete(q.requested, item)
+	if current, ok := q.queued[item]; ok && priority > current.priority {
+		q.enqueue(item, priority, current.since)
+	}
+}
+
+// Push is called by the work queue to add an item which is not queued yet
+func (q *PriorityQueue[T]) Push(item T) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	priority := q.requested[item]
+	delete(q.requested, item)
+	q.enqueue(item, priority, q.now())
+}
+
+// Len returns the number of queued items
+func (q *PriorityQueue[T]) Len() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return len(q.queued)
+}
+
+// Pop is called by the work queue to retrieve the next item. The work queue only calls it if items are queued.
+func (q *PriorityQueue[T]) Pop() T {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	priority := q.nextPriority()
+	for {
+		entry := q.lanes[priority][0]
+		q.lanes[priority][0] = priorityQueueEntry[T]{}
+		q.lanes[priority] = q.lanes[priority][1:]
+		queued, ok := q.queued[entry.item]
+		if !ok || queued.seq != entry.seq {
+			continue
+		}
+
+		delete(q.queued, entry.item)
+		q.depth[priority]--
+		if priority == PriorityHigh && q.depth[PriorityNormal] > 0 {
+			q.consecutiveHigh++
+		} else {
+			q.consecutiveHigh = 0
+		}
+		if q.metrics != nil {
+			q.metrics.SetQueueDepth(q.name, priority.String(), q.depth[priority])
+			q.metrics.ObserveQueueWait(q.name, priority.String(), q.now().Sub(queued.since))
+		}
+		return entry.item
+	}
+}
+
+// nextPriority returns the highest priority with queued items, unless the normal priority items waited for too many
+// high priority items already
+func (q *PriorityQueue[T]) nextPriority() Priority {
+	if q.depth[PriorityNormal] > 0 && (q.depth[PriorityHigh] == 0 || q.consecutiveHigh >= maxConsecutiveHighPriority) {
+		return PriorityNormal
+	}
+	return PriorityHigh
+}
+
+func (q *PriorityQueue[T]) enqueue(item T, priority Priority, since time.Time) {
+	if current, ok := q.queued[item]; ok {
+		q.depth[current.priority]--
+		if q.metrics != nil {
+			q.metrics.SetQueueDepth(q.name, current.priority.String(), q.depth[current.priority])
+		}
+	}
+	q.seq++
+	q.queued[item] = queuedItem{priority: priority, seq: q.seq, since: since}
+	q.lanes[priority] = append(q.lanes[priority], priorityQueueEntry[T]{item: item, seq: q.seq})
+	q.depth[priority]++
+	if q.metrics != nil {
+		q.metrics.SetQueueDepth(q.name, priority.String(), q.depth[priority])
+	}
+}
@@ -0,0 +1,115 @@
+package ratelimiter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/util/workqueue"
+)
+
+type fakeQueueMetrics struct {
+	depth map[string]int
+	waits map[string]int
+}
+
+func (m *fakeQueueMetrics) SetQueueDepth(_ string, priority string, depth int) {
+	m.depth[priority] = depth
+}
+
+func (m *fakeQueueMetrics) ObserveQueueWait(_ string, priority string, _ time.Duration) {
+	m.waits[priority]++
+}
+
+func newTestQueue(metrics PriorityQueueMetrics) (*PriorityQueue[string], *workqueue.Typed[string]) {
+	priorities := NewPriorityQueue[string]("test", metrics)
+	return priorities, workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[string]{Queue: priorities})
+}
+
+func getItems(queue *workqueue.Typed[string], count int) []string {
+	var items []string
+	for range count {
+		item, _ := queue.Get()
+		queue.Done(item)
+		items = append(items, item)
+	}
+	return items
+}
+
+func TestPriorityQueue_HighPriorityFirst(t *testing.T) {
+	metrics := &fakeQueueMetrics{depth: map[string]int{}, waits: map[string]int{}}
+	priorities, queue := newTestQueue(metrics)
+
+	queue.Add("a")
+	queue.Add("b")
+	priorities.Prioritize("c", PriorityHigh)
+	queue.Add("c")
+	assert.Equal(t, map[string]int{"normal": 2, "high": 1}, metrics.depth)
+
+	// an already queued item is moved ahead once it is added again
+	priorities.Prioritize("b", PriorityHigh)
+	queue.Add("b")
+	assert.Equal(t, 3, queue.Len())
+
+	assert.Equal(t, []string{"c", "b", "a"}, getItems(queue, 3))
+	assert.Equal(t, map[string]int{"normal": 0, "high": 0}, metrics.depth)
+	assert.Equal(t, map[string]int{"normal": 1, "high": 2}, metrics.waits)
+
+	// the priority applies only once
+	queue.Add("c")
+	queue.Add("a")
+	assert.Equal(t, []string{"c", "a"}, getItems(queue, 2))
+}
+
+func TestPriorityQueue_ItemInProcessing(t *testing.T) {
+	priorities, queue := newTestQueue(nil)
+
+	queue.Add("a")
+	item, _ := queue.Get()
+	queue.Add("b")
+	priorities.Prioritize("a", PriorityHigh)
+	queue.Add("a")
+	queue.Done(item)
+
+	assert.Equal(t, []string{"a", "b"}, getItems(queue, 2))
+}
+
+func TestPriorityQueue_NoStarvation(t *testing.T) {
+	priorities, queue := newTestQueue(nil)
+
+	queue.Add("normal")
+	for i := range maxConsecutiveHighPriority + 2 {
+		item := string(rune('a' + i))
+		priorities.Prioritize(item, PriorityHigh)
+		queue.Add(item)
+	}
+
+	items := getItems(queue, maxConsecutiveHighPriority+3)
+	assert.Equal(t, "normal", items[maxConsecutiveHighPriority])
+	assert.Equal(t, []string{"k", "l"}, items[maxConsecutiveHighPriority+1:])
+}
+
+func TestPriorityRateLimitingQueue_DropsUnusedPriorities(t *testing.T) {
+	priorities := NewPriorityQueue[string]("test", nil)
+	queue := NewPriorityRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string](), "test", priorities)
+	defer queue.ShutDown()
+
+	// the priority requested while the item is processed applies once it is done
+	queue.Add("a")
+	item, _ := queue.Get()
+	queue.Add("b")
+	priorities.Prioritize("a", PriorityHigh)
+	queue.Add("a")
+	queue.Done(item)
+	item, _ = queue.Get()
+	assert.Equal(t, "a", item)
+	assert.Empty(t, priorities.requested)
+
+	// the priority of an item which is not added again is dropped once it is done or forgotten
+	priorities.Prioritize("a", PriorityHigh)
+	queue.Done(item)
+	assert.Empty(t, priorities.requested)
+	priorities.Prioritize("c", PriorityHigh)
+	queue.Forget("c")
+	assert.Empty(t, priorities.requested)
+}
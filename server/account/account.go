@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -18,6 +19,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
 	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
+	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
 	"github.com/argoproj/argo-cd/v3/util/password"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/security"
@@ -30,12 +32,13 @@ type Server struct {
 	sessionMgr  *session.SessionManager
 	settingsMgr *settings.SettingsManager
 	enf         *rbac.Enforcer
+	policyEnf   *rbacpolicy.RBACPolicyEnforcer
 	namespace   string
 }
 
 // NewServer returns a new instance of the Session service
-func NewServer(sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager, enf *rbac.Enforcer, namespace string) *Server {
-	return &Server{sessionMgr, settingsMgr, enf, namespace}
+func NewServer(sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager, enf *rbac.Enforcer, policyEnf *rbacpolicy.RBACPolicyEnforcer, namespace string) *Server {
+	return &Server{sessionMgr, settingsMgr, enf, policyEnf, namespace}
 }
 
 // UpdatePassword updates the password of the currently authenticated account or the account specified in the request.
@@ -61,7 +64,7 @@ func (s *Server) UpdatePassword(ctx context.Context, q *account.UpdatePasswordRe
 
 		// user is changing own password.
 		// ensure token belongs to a user, not project
-		if q.Name == "" && rbacpolicy.IsProjectSubject(username) {
+		if q.Name == "" && (rbacpolicy.IsProjectSubject(username) || session.IsPersonalToken(ctx)) {
 			return nil, status.Errorf(codes.InvalidArgument, "password can only be changed for local users, not user %q", username)
 		}
 
@@ -122,23 +125,57 @@ func (s *Server) UpdatePassword(ctx context.Context, q *account.UpdatePasswordRe
 
 // CanI checks if the current account has permission to perform an action
 func (s *Server) CanI(ctx context.Context, r *account.CanIRequest) (*account.CanIResponse, error) {
+	resource, action, subresource, err := s.canIRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	ok := s.enf.Enforce(ctx.Value("claims"), resource, action, subresource)
+	if ok {
+		return &account.CanIResponse{Value: "yes"}, nil
+	}
+	return &account.CanIResponse{Value: "no"}, nil
+}
+
+// ExplainCanI traces how the permission of the current account to perform an action is evaluated. Only the policies
+// of the account, of its groups and of their roles are part of the explanation.
+func (s *Server) ExplainCanI(ctx context.Context, r *account.CanIRequest) (*account.CanIExplanation, error) {
+	resource, action, subresource, err := s.canIRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := s.enf.Explain(ctx.Value("claims"), resource, action, subresource)
+	res := &account.CanIExplanation{Allowed: explanation.Allowed}
+	if explanation.DefaultRole != nil {
+		res.DefaultRole = toAPISubjectExplanation(*explanation.DefaultRole)
+	}
+	for _, subject := range explanation.Subjects {
+		res.Subjects = append(res.Subjects, toAPISubjectExplanation(subject))
+	}
+	return res, nil
+}
+
+// canIRequest validates a CanI request and returns the resource, action and subresource to enforce
+func (s *Server) canIRequest(r *account.CanIRequest) (string, string, string, error) {
 	if !slices.Contains(rbac.Actions, r.Action) {
-		return nil, status.Errorf(codes.InvalidArgument, "%v does not contain %s", rbac.Actions, r.Action)
+		return "", "", "", status.Errorf(codes.InvalidArgument, "%v does not contain %s", rbac.Actions, r.Action)
 	}
 	if !slices.Contains(rbac.Resources, r.Resource) {
-		return nil, status.Errorf(codes.InvalidArgument, "%v does not contain %s", rbac.Resources, r.Resource)
+		return "", "", "", status.Errorf(codes.InvalidArgument, "%v does not contain %s", rbac.Resources, r.Resource)
 	}
 
+	action := r.Action
 	// When server.rbac.rollback.enforce.enable is false (the default), rollback falls back
 	// to the sync permission for backwards compatibility. Mirror that here so can-i
 	// accurately reflects whether the caller can actually perform a rollback.
-	if r.Action == rbac.ActionRollback {
+	if action == rbac.ActionRollback {
 		rollbackEnforceEnable, err := s.settingsMgr.GetServerRBACRollbackEnforceEnable()
 		if err != nil {
-			return nil, err
+			return "", "", "", err
 		}
 		if !rollbackEnforceEnable {
-			r.Action = rbac.ActionSync
+			action = rbac.ActionSync
 		}
 	}
 
@@ -156,12 +193,21 @@ func (s *Server) CanI(ctx context.Context, r *account.CanIRequest) (*account.Can
 		// if 2 parts, always assume the default namespace
 		// else: keep as-is (wildcards, etc.)
 	}
+	return r.Resource, action, subresource, nil
+}
 
-	ok := s.enf.Enforce(ctx.Value("claims"), r.Resource, r.Action, subresource)
-	if ok {
-		return &account.CanIResponse{Value: "yes"}, nil
+func toAPISubjectExplanation(e rbac.SubjectExplanation) *account.SubjectExplanation {
+	var policies []*account.MatchedPolicy
+	for _, p := range e.Policies {
+		policies = append(policies, &account.MatchedPolicy{Source: p.Source, Policy: p.Policy, Effect: p.Effect})
+	}
+	return &account.SubjectExplanation{
+		Subject:  e.Subject,
+		Roles:    e.Roles,
+		Policies: policies,
+		Allowed:  e.Allowed,
+		Skipped:  e.Skipped,
 	}
-	return &account.CanIResponse{Value: "no"}, nil
 }
 
 func toAPIAccount(name string, a settings.Account) *account.Account {
@@ -187,8 +233,8 @@ func toAPIAccount(name string, a settings.Account) *account.Account {
 func (s *Server) ensureHasAccountPermission(ctx context.Context, action string, account string) error {
 	id := session.GetUserIdentifier(ctx)
 
-	// account has always has access to itself
-	if id == account && session.Iss(ctx) == session.SessionManagerClaimsIssuer {
+	// account has always has access to itself, but not SSO users with the same name using a personal token
+	if id == account && session.Iss(ctx) == session.SessionManagerClaimsIssuer && !session.IsPersonalToken(ctx) {
 		return nil
 	}
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceAccounts, action, account); err != nil {
@@ -293,3 +339,153 @@ func (s *Server) DeleteToken(ctx context.Context, r *account.DeleteTokenRequest)
 	}
 	return &account.EmptyResponse{}, nil
 }
+
+func toAPIPersonalToken(t settings.PersonalToken) *account.PersonalToken {
+	var scopes []*account.PersonalTokenScope
+	for _, scope := range t.Scopes {
+		scopes = append(scopes, &account.PersonalTokenScope{Resource: scope.Resource, Action: scope.Action, Object: scope.Object})
+	}
+	return &account.PersonalToken{
+		Id:          t.ID,
+		Subject:     t.Subject,
+		Description: t.Description,
+		IssuedAt:    t.IssuedAt,
+		ExpiresAt:   t.ExpiresAt,
+		Groups:      t.Groups,
+		Scopes:      scopes,
+	}
+}
+
+// isSSOSession returns whether the request is authenticated with the token of an SSO session, i.e. neither with a
+// token of a local account or project, nor with a personal token
+func isSSOSession(ctx context.Context) bool {
+	return session.GetUserIdentifier(ctx) != "" && session.Iss(ctx) != session.SessionManagerClaimsIssuer
+}
+
+func (s *Server) ensureHasPersonalTokenPermission(ctx context.Context, action string, subject string) error {
+	// SSO users always have access to their own tokens
+	if isSSOSession(ctx) && session.GetUserIdentifier(ctx) == subject {
+		return nil
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceAccounts, action, subject); err != nil {
+		return fmt.Errorf("permission denied for personal tokens of %s with action %s: %w", subject, action, err)
+	}
+	return nil
+}
+
+// CreatePersonalToken creates a personal token for the current SSO user. The lifetime of the token is limited by the
+// users.personalTokens.maxLifetime setting.
+//
+// The token carries the group claims of the session it is created with: the group memberships of the user are frozen
+// until the token expires, and are not updated if the user is removed from a group or from the identity provider.
+// Administrators must revoke the personal tokens of a user when the user is offboarded.
+func (s *Server) CreatePersonalToken(ctx context.Context, r *account.CreatePersonalTokenRequest) (*account.CreateTokenResponse, error) {
+	// Personal tokens can't be created with personal tokens, so that they can't outlive the session of the user
+	if !isSSOSession(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "personal tokens can only be created by SSO users")
+	}
+	if r.ExpiresIn <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "personal tokens must expire")
+	}
+	if maxLifetime := s.settingsMgr.GetPersonalTokenMaxLifetime(); time.Duration(r.ExpiresIn)*time.Second > maxLifetime {
+		return nil, status.Errorf(codes.InvalidArgument, "personal tokens must expire within %s", maxLifetime)
+	}
+	var scopes []rbac.TokenScope
+	for _, apiScope := range r.Scopes {
+		scope := rbac.TokenScope{Resource: apiScope.Resource, Action: apiScope.Action, Object: apiScope.Object}
+		if err := scope.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		scopes = append(scopes, scope)
+	}
+
+	uniqueId, err := uuid.NewRandom()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate unique ID: %w", err)
+	}
+	id := uniqueId.String()
+	subject := session.GetUserIdentifier(ctx)
+
+	// The token carries the group claims of the session, so that it is a member of the same groups
+	claims, err := jwtutil.MapClaims(jwtutil.Claims(ctx.Value("claims")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get claims: %w", err)
+	}
+	groupClaims := jwt.MapClaims{}
+	for _, scope := range s.policyEnf.GetScopes() {
+		if val, ok := claims[scope]; ok {
+			groupClaims[scope] = val
+		}
+	}
+
+	now := time.Now()
+	tokenString, err := s.sessionMgr.CreatePersonalToken(subject, r.ExpiresIn, id, groupClaims, scopes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token: %w", err)
+	}
+	err = s.settingsMgr.UpdatePersonalTokens(func(tokens []settings.PersonalToken) ([]settings.PersonalToken, error) {
+		return append(tokens, settings.PersonalToken{
+			ID:          id,
+			Subject:     subject,
+			Description: r.Description,
+			IssuedAt:    now.Unix(),
+			ExpiresAt:   now.Add(time.Duration(r.ExpiresIn) * time.Second).Unix(),
+			Groups:      jwtutil.GetScopeValues(groupClaims, s.policyEnf.GetScopes()),
+			Scopes:      scopes,
+		}), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save personal token: %w", err)
+	}
+	log.Infof("user '%s' created personal token '%s'", subject, id)
+	return &account.CreateTokenResponse{Token: tokenString}, nil
+}
+
+// ListPersonalTokens returns the personal tokens of SSO users
+func (s *Server) ListPersonalTokens(ctx context.Context, r *account.ListPersonalTokensRequest) (*account.PersonalTokensList, error) {
+	tokens, err := s.settingsMgr.GetPersonalTokens()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get personal tokens: %w", err)
+	}
+	resp := account.PersonalTokensList{}
+	for _, t := range tokens {
+		if r.Subject != "" && t.Subject != r.Subject {
+			continue
+		}
+		if err := s.ensureHasPersonalTokenPermission(ctx, rbac.ActionGet, t.Subject); err == nil {
+			resp.Items = append(resp.Items, toAPIPersonalToken(t))
+		}
+	}
+	sort.Slice(resp.Items, func(i, j int) bool {
+		return resp.Items[i].IssuedAt > resp.Items[j].IssuedAt
+	})
+	return &resp, nil
+}
+
+// DeletePersonalToken deletes and revokes a personal token
+func (s *Server) DeletePersonalToken(ctx context.Context, r *account.DeletePersonalTokenRequest) (*account.EmptyResponse, error) {
+	token, err := s.settingsMgr.GetPersonalToken(r.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get personal token %s: %w", r.Id, err)
+	}
+	if err := s.ensureHasPersonalTokenPermission(ctx, rbac.ActionUpdate, token.Subject); err != nil {
+		return nil, fmt.Errorf("permission denied to delete personal token %s: %w", r.Id, err)
+	}
+
+	// The token is revoked first, so that it is rejected right away, rather than once the deletion is observed
+	if expiresIn := time.Until(time.Unix(token.ExpiresAt, 0)); expiresIn > 0 {
+		if err := s.sessionMgr.RevokeToken(ctx, r.Id, expiresIn); err != nil {
+			return nil, fmt.Errorf("failed to revoke personal token %s: %w", r.Id, err)
+		}
+	}
+	err = s.settingsMgr.UpdatePersonalTokens(func(tokens []settings.PersonalToken) ([]settings.PersonalToken, error) {
+		return slices.DeleteFunc(tokens, func(t settings.PersonalToken) bool {
+			return t.ID == r.Id
+		}), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete personal token %s: %w", r.Id, err)
+	}
+	log.Infof("user '%s' deleted personal token '%s' of '%s'", session.Username(ctx), r.Id, token.Subject)
+	return &account.EmptyResponse{}, nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
 	sessionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
+	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v3/server/session"
 	"github.com/argoproj/argo-cd/v3/test"
 	"github.com/argoproj/argo-cd/v3/util/password"
@@ -66,11 +67,13 @@ func newTestAccountServerExt(t *testing.T, ctx context.Context, enforceFn rbac.C
 	}
 	kubeclientset := fake.NewClientset(cm, secret)
 	settingsMgr := settings.NewSettingsManager(ctx, kubeclientset, testNamespace)
-	sessionMgr := sessionutil.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, sessionutil.NewUserStateStorage(nil))
+	redisClient, closer := test.NewInMemoryRedis()
+	t.Cleanup(closer)
+	sessionMgr := sessionutil.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, sessionutil.NewUserStateStorage(redisClient))
 	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
 	enforcer.SetClaimsEnforcerFunc(enforceFn)
 
-	return NewServer(sessionMgr, settingsMgr, enforcer, testNamespace), session.NewServer(sessionMgr, settingsMgr, nil, nil, nil)
+	return NewServer(sessionMgr, settingsMgr, enforcer, rbacpolicy.NewRBACPolicyEnforcer(enforcer, nil), testNamespace), session.NewServer(sessionMgr, settingsMgr, nil, nil, nil)
 }
 
 func getAdminAccount(mgr *settings.SettingsManager) (*settings.Account, error) {
@@ -545,3 +548,141 @@ func TestCanI_NonProjectScopedResource(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "yes", resp.Value)
 }
+
+func TestExplainCanI(t *testing.T) {
+	t.Parallel()
+	accountServer, _ := newTestAccountServerExt(t, t.Context(), nil)
+	require.NoError(t, accountServer.enf.SetBuiltinPolicy("p, role:readonly, logs, get, */*, allow"))
+	require.NoError(t, accountServer.enf.SetUserPolicy("p, admin, logs, get, myproject/*, deny"))
+	accountServer.enf.SetDefaultRole("role:readonly")
+	accountServer.enf.SetClaimsExplainerFunc(func(claims jwt.Claims, rvals ...any) []rbac.SubjectExplanation {
+		subject, _ := claims.GetSubject()
+		return []rbac.SubjectExplanation{accountServer.enf.ExplainRuntimePolicy("", "", append([]any{subject}, rvals[1:]...)...)}
+	})
+
+	resp, err := accountServer.ExplainCanI(adminContext(t.Context()), &account.CanIRequest{
+		Resource:    "logs",
+		Action:      "get",
+		Subresource: "myproject/default/myapp",
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Allowed)
+	require.NotNil(t, resp.DefaultRole)
+	assert.Equal(t, &account.SubjectExplanation{
+		Subject:  "role:readonly",
+		Policies: []*account.MatchedPolicy{{Source: rbac.BuiltinPolicySource, Policy: "p, role:readonly, logs, get, */*, allow", Effect: "allow"}},
+		Allowed:  true,
+	}, resp.DefaultRole)
+	require.Len(t, resp.Subjects, 1)
+	assert.Equal(t, &account.SubjectExplanation{
+		Subject:  "admin",
+		Policies: []*account.MatchedPolicy{{Source: rbac.ConfigMapPolicyCSVKey, Policy: "p, admin, logs, get, myproject/*, deny", Effect: "deny"}},
+	}, resp.Subjects[0])
+
+	_, err = accountServer.ExplainCanI(adminContext(t.Context()), &account.CanIRequest{Resource: "logs", Action: "unknown"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func ssoUserContext(ctx context.Context, user string) context.Context {
+	//nolint:staticcheck
+	return context.WithValue(ctx, "claims", jwt.MapClaims{
+		"sub":    user,
+		"iss":    "https://myargocdhost.com/api/dex",
+		"groups": []any{"team-a"},
+	})
+}
+
+func TestPersonalTokens(t *testing.T) {
+	t.Parallel()
+	accountServer, _ := newTestAccountServerExt(t, t.Context(), func(claims jwt.Claims, _ ...any) bool {
+		subject, _ := claims.GetSubject()
+		return subject == "admin"
+	})
+	aliceCtx := ssoUserContext(t.Context(), "alice")
+
+	_, err := accountServer.CreatePersonalToken(adminContext(t.Context()), &account.CreatePersonalTokenRequest{ExpiresIn: 3600})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = accountServer.CreatePersonalToken(aliceCtx, &account.CreatePersonalTokenRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = accountServer.CreatePersonalToken(aliceCtx, &account.CreatePersonalTokenRequest{ExpiresIn: 3600, Scopes: []*account.PersonalTokenScope{{Resource: "applications"}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = accountServer.CreatePersonalToken(aliceCtx, &account.CreatePersonalTokenRequest{ExpiresIn: int64((settings.DefaultPersonalTokenMaxLifetime + time.Second).Seconds())})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := accountServer.CreatePersonalToken(aliceCtx, &account.CreatePersonalTokenRequest{
+		ExpiresIn:   3600,
+		Description: "ci",
+		Scopes:      []*account.PersonalTokenScope{{Resource: "applications", Action: "sync", Object: "default/*"}},
+	})
+	require.NoError(t, err)
+
+	claims, _, err := accountServer.sessionMgr.Parse(resp.Token)
+	require.NoError(t, err)
+	mapClaims := *claims.(*jwt.MapClaims)
+	assert.Equal(t, "alice", mapClaims["sub"])
+	assert.Equal(t, []any{"team-a"}, mapClaims["groups"])
+	scopes, narrowed := rbac.GetTokenScopes(claims)
+	assert.True(t, narrowed)
+	assert.Equal(t, []rbac.TokenScope{{Resource: "applications", Action: "sync", Object: "default/*"}}, scopes)
+
+	// personal tokens can't be used to create personal tokens
+	//nolint:staticcheck
+	patCtx := context.WithValue(t.Context(), "claims", claims)
+	_, err = accountServer.CreatePersonalToken(patCtx, &account.CreatePersonalTokenRequest{ExpiresIn: 3600})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	list, err := accountServer.ListPersonalTokens(aliceCtx, &account.ListPersonalTokensRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	token := list.Items[0]
+	assert.Equal(t, "alice", token.Subject)
+	assert.Equal(t, "ci", token.Description)
+	assert.Equal(t, []string{"team-a"}, token.Groups)
+	assert.Equal(t, []*account.PersonalTokenScope{{Resource: "applications", Action: "sync", Object: "default/*"}}, token.Scopes)
+	assert.Equal(t, token.IssuedAt+3600, token.ExpiresAt)
+
+	list, err = accountServer.ListPersonalTokens(ssoUserContext(t.Context(), "bob"), &account.ListPersonalTokensRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, list.Items)
+	list, err = accountServer.ListPersonalTokens(adminContext(t.Context()), &account.ListPersonalTokensRequest{Subject: "alice"})
+	require.NoError(t, err)
+	assert.Len(t, list.Items, 1)
+
+	_, err = accountServer.DeletePersonalToken(ssoUserContext(t.Context(), "bob"), &account.DeletePersonalTokenRequest{Id: token.Id})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = accountServer.DeletePersonalToken(adminContext(t.Context()), &account.DeletePersonalTokenRequest{Id: token.Id})
+	require.NoError(t, err)
+
+	_, _, err = accountServer.sessionMgr.Parse(resp.Token)
+	require.Error(t, err)
+	list, err = accountServer.ListPersonalTokens(aliceCtx, &account.ListPersonalTokensRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, list.Items)
+}
+
+func TestCreatePersonalToken_MaxLifetime(t *testing.T) {
+	t.Parallel()
+	accountServer, _ := newTestAccountServerExt(t, t.Context(), func(_ jwt.Claims, _ ...any) bool {
+		return false
+	}, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["users.personalTokens.maxLifetime"] = "1d"
+	})
+	aliceCtx := ssoUserContext(t.Context(), "alice")
+
+	_, err := accountServer.CreatePersonalToken(aliceCtx, &account.CreatePersonalTokenRequest{ExpiresIn: 86400})
+	require.NoError(t, err)
+	_, err = accountServer.CreatePersonalToken(aliceCtx, &account.CreatePersonalTokenRequest{ExpiresIn: 86401})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "personal tokens must expire within 24h0m0s")
+}
+
+func TestGetAccount_PersonalTokenOfSameName(t *testing.T) {
+	t.Parallel()
+	accountServer, _ := newTestAccountServerExt(t, t.Context(), func(_ jwt.Claims, _ ...any) bool {
+		return false
+	})
+	//nolint:staticcheck
+	ctx := context.WithValue(t.Context(), "claims", jwt.MapClaims{"sub": "admin", "iss": sessionutil.SessionManagerClaimsIssuer, sessionutil.PersonalTokenClaim: true})
+	_, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "admin"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -66,6 +66,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/security"
 	"github.com/argoproj/argo-cd/v3/util/session"
 	"github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/argoproj/argo-cd/v3/util/syncwindow"
 
 	resourceutil "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/resource"
 
@@ -105,6 +106,7 @@ type Server struct {
 	projInformer           cache.SharedIndexInformer
 	enabledNamespaces      []string
 	syncWithReplaceAllowed bool
+	calendarLoader         *syncwindow.CalendarLoader
 }
 
 // NewServer returns a new instance of the Application service
@@ -126,6 +128,7 @@ func NewServer(
 	enabledNamespaces []string,
 	enableK8sEvent []string,
 	syncWithReplaceAllowed bool,
+	calendarLoader *syncwindow.CalendarLoader,
 ) (application.ApplicationServiceServer, AppResourceTreeFn) {
 	if appBroadcaster == nil {
 		appBroadcaster = broadcast.NewHandler[v1alpha1.Application, v1alpha1.ApplicationWatchEvent](
@@ -159,6 +162,7 @@ func NewServer(
 		projInformer:           projInformer,
 		enabledNamespaces:      enabledNamespaces,
 		syncWithReplaceAllowed: syncWithReplaceAllowed,
+		calendarLoader:         calendarLoader,
 	}
 	return s, s.getAppResources
 }
@@ -173,7 +177,9 @@ func NewServer(
 //
 // If the user does provide a "project," we can respond more specifically. If the user does not have access to the given
 // app name in the given project, we return "permission denied." If the app exists, but the project is different from
-func (s *Server) getAppEnforceRBAC(ctx context.Context, action, project, namespace, name string, getApp func() (*v1alpha1.Application, error)) (*v1alpha1.Application, *v1alpha1.AppProject, error) {
+//
+// If a resource is given, the conditions of the RBAC policies are also evaluated against its group and kind.
+func (s *Server) getAppEnforceRBAC(ctx context.Context, action, project, namespace, name string, resource schema.GroupKind, getApp func() (*v1alpha1.Application, error)) (*v1alpha1.Application, *v1alpha1.AppProject, error) {
 	user := session.Username(ctx)
 	if user == "" {
 		user = "Unknown user"
@@ -184,9 +190,10 @@ func (s *Server) getAppEnforceRBAC(ctx context.Context, action, project, namespa
 		"namespace":   namespace,
 	})
 	if project != "" {
-		// The user has provided everything we need to perform an initial RBAC check.
+		// The user has provided everything we need to perform an initial RBAC check. The attributes of the app are not
+		// known yet, they are checked along with the actual project of the app below.
 		givenRBACName := security.RBACName(s.ns, project, namespace, name)
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, action, givenRBACName); err != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, action, givenRBACName, rbac.UnknownAttributes); err != nil {
 			logCtx.WithFields(map[string]any{
 				"project":                project,
 				argocommon.SecurityField: argocommon.SecurityMedium,
@@ -216,7 +223,9 @@ func (s *Server) getAppEnforceRBAC(ctx context.Context, action, project, namespa
 	// Even if we performed an initial RBAC check (because the request was fully parameterized), we still need to
 	// perform a second RBAC check to ensure that the user has access to the actual Application's project (not just the
 	// project they specified in the request).
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, action, a.RBACName(s.ns)); err != nil {
+	attrs := a.RBACAttributes()
+	attrs.Group, attrs.Kind = resource.Group, resource.Kind
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, action, a.RBACName(s.ns), attrs); err != nil {
 		logCtx.WithFields(map[string]any{
 			"project":                a.Spec.Project,
 			argocommon.SecurityField: argocommon.SecurityMedium,
@@ -256,8 +265,14 @@ func (s *Server) getAppEnforceRBAC(ctx context.Context, action, project, namespa
 // denied, or any other error occurs when getting the app, we return a permission denied error to obscure any sensitive
 // information.
 func (s *Server) getApplicationEnforceRBACInformer(ctx context.Context, action, project, namespace, name string) (*v1alpha1.Application, *v1alpha1.AppProject, error) {
+	return s.getApplicationResourceEnforceRBACInformer(ctx, action, project, namespace, name, schema.GroupKind{})
+}
+
+// getApplicationResourceEnforceRBACInformer is getApplicationEnforceRBACInformer for a request about the given resource
+// of the application.
+func (s *Server) getApplicationResourceEnforceRBACInformer(ctx context.Context, action, project, namespace, name string, resource schema.GroupKind) (*v1alpha1.Application, *v1alpha1.AppProject, error) {
 	namespaceOrDefault := s.appNamespaceOrDefault(namespace)
-	return s.getAppEnforceRBAC(ctx, action, project, namespaceOrDefault, name, func() (*v1alpha1.Application, error) {
+	return s.getAppEnforceRBAC(ctx, action, project, namespaceOrDefault, name, resource, func() (*v1alpha1.Application, error) {
 		if !s.isNamespaceEnabled(namespaceOrDefault) {
 			return nil, security.NamespaceNotPermittedError(namespaceOrDefault)
 		}
@@ -270,7 +285,7 @@ func (s *Server) getApplicationEnforceRBACInformer(ctx context.Context, action,
 // information.
 func (s *Server) getApplicationEnforceRBACClient(ctx context.Context, action, project, namespace, name, resourceVersion string) (*v1alpha1.Application, *v1alpha1.AppProject, error) {
 	namespaceOrDefault := s.appNamespaceOrDefault(namespace)
-	return s.getAppEnforceRBAC(ctx, action, project, namespaceOrDefault, name, func() (*v1alpha1.Application, error) {
+	return s.getAppEnforceRBAC(ctx, action, project, namespaceOrDefault, name, schema.GroupKind{}, func() (*v1alpha1.Application, error) {
 		if !s.isNamespaceEnabled(namespaceOrDefault) {
 			return nil, security.NamespaceNotPermittedError(namespaceOrDefault)
 		}
@@ -319,7 +334,7 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*v1
 		if !s.isNamespaceEnabled(a.Namespace) {
 			continue
 		}
-		if s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, a.RBACName(s.ns)) {
+		if s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, a.RBACName(s.ns), a.RBACAttributes()) {
 			// Create a deep copy to ensure all metadata fields including annotations are preserved
 			appCopy := a.DeepCopy()
 			// Explicitly copy annotations in case DeepCopy does not preserve them
@@ -351,7 +366,7 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	}
 	a := q.GetApplication()
 
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionCreate, a.RBACName(s.ns)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionCreate, a.RBACName(s.ns), a.RBACAttributes()); err != nil {
 		return nil, err
 	}
 
@@ -379,6 +394,10 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 		return nil, security.NamespaceNotPermittedError(appNs)
 	}
 
+	if err := s.checkApplicationsQuota(proj, appNs, a.Name); err != nil {
+		return nil, err
+	}
+
 	// Don't let the app creator set the operation explicitly. Those requests should always go through the Sync API.
 	if a.Operation != nil {
 		log.WithFields(applog.GetAppLogFields(a)).
@@ -416,7 +435,7 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	if q.Upsert == nil || !*q.Upsert {
 		return nil, status.Errorf(codes.InvalidArgument, "existing application spec is different, use upsert flag to force update")
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, a.RBACName(s.ns)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, a.RBACName(s.ns), a.RBACAttributes()); err != nil {
 		return nil, err
 	}
 	updated, err := s.updateApp(ctx, existing, a, true)
@@ -969,6 +988,10 @@ func (s *Server) validateAndUpdateApp(ctx context.Context, newApp *v1alpha1.Appl
 	if err != nil {
 		return nil, err
 	}
+	// the conditions of the RBAC policies must also be satisfied by the labels and destination of the updated app
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, action, app.RBACName(s.ns), newApp.RBACAttributes()); err != nil {
+		return nil, err
+	}
 
 	err = s.validateAndNormalizeApp(ctx, newApp, proj, validate)
 	if err != nil {
@@ -1053,7 +1076,7 @@ func (s *Server) Update(ctx context.Context, q *application.ApplicationUpdateReq
 		return nil, errors.New("error updating application: application is nil in request")
 	}
 	a := q.GetApplication()
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, a.RBACName(s.ns)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, a.RBACName(s.ns), a.RBACAttributes()); err != nil {
 		return nil, err
 	}
 
@@ -1093,7 +1116,7 @@ func (s *Server) Patch(ctx context.Context, q *application.ApplicationPatchReque
 		return nil, err
 	}
 
-	err = s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, app.RBACName(s.ns))
+	err = s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, app.RBACName(s.ns), app.RBACAttributes())
 	if err != nil {
 		return nil, err
 	}
@@ -1132,6 +1155,26 @@ func (s *Server) Patch(ctx context.Context, q *application.ApplicationPatchReque
 	return s.validateAndUpdateApp(ctx, newApp, false, true, rbac.ActionUpdate, q.GetProject())
 }
 
+// checkApplicationsQuota returns an error if creating the given application would exceed the maximum number of
+// applications of the project
+func (s *Server) checkApplicationsQuota(proj *v1alpha1.AppProject, appNs string, appName string) error {
+	if proj.Spec.Quotas == nil || proj.Spec.Quotas.MaxApplications <= 0 {
+		return nil
+	}
+	// an existing application of the project is updated rather than created
+	if existing, err := s.appLister.Applications(appNs).Get(appName); err == nil && existing.Spec.GetProject() == proj.Name {
+		return nil
+	}
+	apps, err := s.appLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("error listing applications: %w", err)
+	}
+	if usage := argo.GetProjectQuotaUsage(proj.Name, apps); usage.Applications >= proj.Spec.Quotas.MaxApplications {
+		return status.Errorf(codes.ResourceExhausted, "project %q has reached its quota of %d applications", proj.Name, proj.Spec.Quotas.MaxApplications)
+	}
+	return nil
+}
+
 func (s *Server) getAppProject(ctx context.Context, a *v1alpha1.Application, logCtx *log.Entry) (*v1alpha1.AppProject, error) {
 	proj, err := argo.GetAppProject(ctx, a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db)
 	if err == nil {
@@ -1169,7 +1212,7 @@ func (s *Server) Delete(ctx context.Context, q *application.ApplicationDeleteReq
 	s.projectLock.RLock(a.Spec.Project)
 	defer s.projectLock.RUnlock(a.Spec.Project)
 
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionDelete, a.RBACName(s.ns)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionDelete, a.RBACName(s.ns), a.RBACAttributes()); err != nil {
 		return nil, err
 	}
 
@@ -1239,7 +1282,7 @@ func (s *Server) isApplicationPermitted(selector labels.Selector, minVersion int
 		return false
 	}
 
-	if !s.enf.Enforce(claims, rbac.ResourceApplications, rbac.ActionGet, a.RBACName(s.ns)) {
+	if !s.enf.Enforce(claims, rbac.ResourceApplications, rbac.ActionGet, a.RBACName(s.ns), a.RBACAttributes()) {
 		// do not emit apps user does not have accessing
 		return false
 	}
@@ -1346,11 +1389,11 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Appl
 	if currApp != nil && currApp.Spec.GetProject() != app.Spec.GetProject() {
 		// When changing projects, caller must have application create & update privileges in new project
 		// NOTE: the update check was already verified in the caller to this function
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionCreate, app.RBACName(s.ns)); err != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionCreate, app.RBACName(s.ns), app.RBACAttributes()); err != nil {
 			return err
 		}
 		// They also need 'update' privileges in the old project
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, currApp.RBACName(s.ns)); err != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, currApp.RBACName(s.ns), currApp.RBACAttributes()); err != nil {
 			return err
 		}
 		// Validate that the new project exists and the application is allowed to use it
@@ -1359,15 +1402,23 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Appl
 			return err
 		}
 		proj = newProj
+		// moving the application into the project counts towards its quota of applications
+		if err := s.checkApplicationsQuota(proj, appNs, app.Name); err != nil {
+			return err
+		}
 	}
 
-	if _, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, s.db); err != nil {
-		return status.Errorf(codes.InvalidArgument, "application destination spec for %s is invalid: %s", app.Name, err.Error())
+	// the destination clusters and the manifests of a fleet application are validated by the applications of the fleet
+	isFleet := app.Spec.IsFleet()
+	if !isFleet {
+		if _, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, s.db); err != nil {
+			return status.Errorf(codes.InvalidArgument, "application destination spec for %s is invalid: %s", app.Name, err.Error())
+		}
 	}
 
 	var conditions []v1alpha1.ApplicationCondition
 
-	if validate {
+	if validate && !isFleet {
 		conditions := make([]v1alpha1.ApplicationCondition, 0)
 		condition, err := argo.ValidateRepo(ctx, app, s.repoClientset, s.db, s.kubectl, proj, s.settingsMgr)
 		if err != nil {
@@ -1490,10 +1541,11 @@ func (s *Server) getAppLiveResource(ctx context.Context, action string, q *appli
 	if fineGrainedInheritanceDisabled && (action == rbac.ActionDelete || action == rbac.ActionUpdate) {
 		action = fmt.Sprintf("%s/%s/%s/%s/%s", action, q.GetGroup(), q.GetKind(), q.GetNamespace(), q.GetResourceName())
 	}
-	a, p, err := s.getApplicationEnforceRBACInformer(ctx, action, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	resource := schema.GroupKind{Group: q.GetGroup(), Kind: q.GetKind()}
+	a, p, err := s.getApplicationResourceEnforceRBACInformer(ctx, action, q.GetProject(), q.GetAppNamespace(), q.GetName(), resource)
 	if !fineGrainedInheritanceDisabled && err != nil && errors.Is(err, argocommon.PermissionDeniedAPIError) && (action == rbac.ActionDelete || action == rbac.ActionUpdate) {
 		action = fmt.Sprintf("%s/%s/%s/%s/%s", action, q.GetGroup(), q.GetKind(), q.GetNamespace(), q.GetResourceName())
-		a, _, err = s.getApplicationEnforceRBACInformer(ctx, action, q.GetProject(), q.GetAppNamespace(), q.GetName())
+		a, _, err = s.getApplicationResourceEnforceRBACInformer(ctx, action, q.GetProject(), q.GetAppNamespace(), q.GetName(), resource)
 	}
 	if err != nil {
 		return nil, nil, nil, err
@@ -1890,7 +1942,7 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 		return err
 	}
 
-	if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbac.ResourceLogs, rbac.ActionGet, a.RBACName(s.ns)); err != nil {
+	if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbac.ResourceLogs, rbac.ActionGet, a.RBACName(s.ns), a.RBACAttributes()); err != nil {
 		return err
 	}
 
@@ -2088,20 +2140,25 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 
 	s.inferResourcesStatusHealth(a)
 
-	canSync, err := proj.Spec.SyncWindows.Matches(a).CanSync(true, nil)
+	windows, err := s.calendarLoader.Resolve(proj.Spec.SyncWindows.Matches(a))
+	if err != nil {
+		return a, status.Errorf(codes.Unavailable, "cannot sync: %v", err)
+	}
+	canSync, err := windows.CanSync(true, nil)
 	if err != nil {
 		return a, status.Errorf(codes.PermissionDenied, "cannot sync: invalid sync window: %v", err)
 	}
-	if !canSync {
+	queued := !canSync && queueUntilSyncWindow(a, syncReq)
+	if !canSync && !queued {
 		return a, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by sync window")
 	}
 
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionSync, a.RBACName(s.ns)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionSync, a.RBACName(s.ns), a.RBACAttributes()); err != nil {
 		return nil, err
 	}
 
 	if syncReq.Manifests != nil {
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns)); err != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns), a.RBACAttributes()); err != nil {
 			return nil, err
 		}
 		if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.IsAutomatedSyncEnabled() && !syncReq.GetDryRun() {
@@ -2192,10 +2249,21 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if syncReq.Manifests != nil {
 		reason = fmt.Sprintf("initiated %ssync locally", partial)
 	}
+	if queued {
+		reason += " queued until the next sync window"
+	}
 	s.logAppEvent(ctx, a, argo.EventReasonOperationStarted, reason)
 	return a, nil
 }
 
+// queueUntilSyncWindow returns true if a sync blocked by a sync window should be queued until a sync window allows it
+func queueUntilSyncWindow(a *v1alpha1.Application, syncReq *application.ApplicationSyncRequest) bool {
+	if syncReq.SyncOptions != nil {
+		return v1alpha1.SyncOptions(syncReq.SyncOptions.Items).HasOption(v1alpha1.SyncOptionQueueUntilSyncWindow)
+	}
+	return a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.SyncOptions.HasOption(v1alpha1.SyncOptionQueueUntilSyncWindow)
+}
+
 func (s *Server) resolveSourceRevisions(ctx context.Context, a *v1alpha1.Application, syncReq *application.ApplicationSyncRequest) (string, string, []string, []string, error) {
 	requireOverridePrivilegeForRevisionSync, err := s.settingsMgr.RequireOverridePrivilegeForRevisionSync()
 	if err != nil {
@@ -2219,7 +2287,7 @@ func (s *Server) resolveSourceRevisions(ctx context.Context, a *v1alpha1.Applica
 				// User is trying to sync to a different revision than the ones specified in the app sources
 				// Enforce that they have the 'override' privilege if the setting is enabled
 				if requireOverridePrivilegeForRevisionSync {
-					if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns)); err != nil {
+					if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns), a.RBACAttributes()); err != nil {
 						return "", "", nil, nil, err
 					}
 				}
@@ -2244,7 +2312,7 @@ func (s *Server) resolveSourceRevisions(ctx context.Context, a *v1alpha1.Applica
 		// User is trying to sync to a different revision than the one specified in the app spec
 		// Enforce that they have the 'override' privilege if the setting is enabled
 		if requireOverridePrivilegeForRevisionSync {
-			if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns)); err != nil {
+			if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns), a.RBACAttributes()); err != nil {
 				return "", "", nil, nil, err
 			}
 		}
@@ -2590,7 +2658,8 @@ func (s *Server) ListResourceActions(ctx context.Context, q *application.Applica
 func (s *Server) getUnstructuredLiveResourceOrApp(ctx context.Context, rbacRequest string, q *application.ApplicationResourceRequest) (obj *unstructured.Unstructured, res *v1alpha1.ResourceNode, app *v1alpha1.Application, config *rest.Config, err error) {
 	if q.GetKind() == applicationType.ApplicationKind && q.GetGroup() == applicationType.Group && q.GetName() == q.GetResourceName() {
 		var p *v1alpha1.AppProject
-		app, p, err = s.getApplicationEnforceRBACInformer(ctx, rbacRequest, q.GetProject(), q.GetAppNamespace(), q.GetName())
+		resource := schema.GroupKind{Group: applicationType.Group, Kind: applicationType.ApplicationKind}
+		app, p, err = s.getApplicationResourceEnforceRBACInformer(ctx, rbacRequest, q.GetProject(), q.GetAppNamespace(), q.GetName(), resource)
 		if err != nil {
 			return nil, nil, nil, nil, err
 		}
@@ -2599,7 +2668,9 @@ func (s *Server) getUnstructuredLiveResourceOrApp(ctx context.Context, rbacReque
 			Version: v1alpha1.SchemeGroupVersion.Version,
 			Kind:    applicationType.ApplicationKind,
 		})
-		err = s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbacRequest, app.RBACName(s.ns))
+		attrs := app.RBACAttributes()
+		attrs.Group, attrs.Kind = resource.Group, resource.Kind
+		err = s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbacRequest, app.RBACName(s.ns), attrs)
 		if err != nil {
 			return nil, nil, nil, nil, err
 		}
@@ -2826,7 +2897,7 @@ func (s *Server) patchResource(ctx context.Context, config *rest.Config, liveObj
 }
 
 func (s *Server) verifyResourcePermitted(ctx context.Context, destCluster *v1alpha1.Cluster, proj *v1alpha1.AppProject, obj *unstructured.Unstructured) error {
-	permitted, err := proj.IsResourcePermitted(schema.GroupKind{Group: obj.GroupVersionKind().Group, Kind: obj.GroupVersionKind().Kind}, obj.GetName(), obj.GetNamespace(), destCluster, func(project string) ([]*v1alpha1.Cluster, error) {
+	permitted, err := proj.IsLiveResourcePermitted(obj, destCluster, func(project string) ([]*v1alpha1.Cluster, error) {
 		clusters, err := s.db.GetProjectClusters(ctx, project)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get project clusters: %w", err)
@@ -2891,12 +2962,16 @@ func (s *Server) GetApplicationSyncWindows(ctx context.Context, q *application.A
 	}
 
 	windows := proj.Spec.SyncWindows.Matches(a)
-	sync, err := windows.CanSync(true, nil)
+	resolvedWindows, err := s.calendarLoader.Resolve(windows)
+	if err != nil {
+		return nil, err
+	}
+	sync, err := resolvedWindows.CanSync(true, nil)
 	if err != nil {
 		return nil, fmt.Errorf("invalid sync windows: %w", err)
 	}
 
-	activeWindows, err := windows.Active()
+	activeWindows, err := resolvedWindows.Active()
 	if err != nil {
 		return nil, fmt.Errorf("invalid sync windows: %w", err)
 	}
@@ -311,6 +311,7 @@ func newTestAppServerWithEnforcerConfigure(t *testing.T, f func(*rbac.Enforcer),
 		[]string{},
 		testEnableEventList,
 		true,
+		nil,
 	)
 	return server.(*Server)
 }
@@ -495,6 +496,7 @@ func newTestAppServerWithEnforcerConfigureWithBenchmark(b *testing.B, f func(*rb
 		[]string{},
 		testEnableEventList,
 		true,
+		nil,
 	)
 	return server.(*Server)
 }
@@ -1521,6 +1523,61 @@ func TestCreateApp(t *testing.T) {
 	assert.Equal(t, "default", app.Spec.Project)
 }
 
+func TestCreateApp_ApplicationsQuota(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "proj-quota", Namespace: "default"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			Quotas:       &v1alpha1.ProjectQuotas{MaxApplications: 1},
+		},
+	}
+	existingApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Project = proj.Name
+	})
+	appServer := newTestAppServer(t, proj, existingApp)
+
+	// updating an existing application does not count against the quota
+	_, err := appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: existingApp.DeepCopy()})
+	require.NoError(t, err)
+
+	newApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "new-app"
+		app.Spec.Project = proj.Name
+	})
+	_, err = appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: newApp})
+	require.EqualError(t, err, `rpc error: code = ResourceExhausted desc = project "proj-quota" has reached its quota of 1 applications`)
+}
+
+func TestUpdateApp_ApplicationsQuota(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "proj-quota", Namespace: "default"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			Quotas:       &v1alpha1.ProjectQuotas{MaxApplications: 1},
+		},
+	}
+	otherApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "other-app"
+		app.Spec.Project = proj.Name
+	})
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, proj, otherApp, testApp)
+
+	// moving an application into a project counts against its quota
+	updateApp := testApp.DeepCopy()
+	updateApp.Spec.Project = proj.Name
+	_, err := appServer.Update(t.Context(), &application.ApplicationUpdateRequest{Application: updateApp})
+	require.ErrorContains(t, err, `project "proj-quota" has reached its quota of 1 applications`)
+
+	// updating an application which stays in its project does not
+	updateApp = otherApp.DeepCopy()
+	updateApp.Spec.Source.Path = "updated"
+	_, err = appServer.Update(t.Context(), &application.ApplicationUpdateRequest{Application: updateApp})
+	require.NoError(t, err)
+}
+
 func TestCreateAppWithDestName(t *testing.T) {
 	appServer := newTestAppServer(t)
 	testApp := newTestAppWithDestName()
@@ -2351,6 +2408,33 @@ func TestSyncMultiSource_PosTooSmall(t *testing.T) {
 		"should fail because source position is less than 1")
 }
 
+func TestSync_BlockedBySyncWindow(t *testing.T) {
+	ctx := t.Context()
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "proj-freeze", Namespace: "default"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SyncWindows:  v1alpha1.SyncWindows{{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"*"}}},
+		},
+	}
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Project = proj.Name
+	})
+	appServer := newTestAppServer(t, proj, testApp)
+
+	_, err := appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &testApp.Name})
+	require.EqualError(t, err, "rpc error: code = PermissionDenied desc = cannot sync: blocked by sync window")
+
+	syncedApp, err := appServer.Sync(ctx, &application.ApplicationSyncRequest{
+		Name:        &testApp.Name,
+		SyncOptions: &application.SyncOptions{Items: []string{v1alpha1.SyncOptionQueueUntilSyncWindow}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, syncedApp.Operation)
+	assert.True(t, syncedApp.Operation.Sync.SyncOptions.HasOption(v1alpha1.SyncOptionQueueUntilSyncWindow))
+}
+
 func TestSync_SyncWithoutSyncPermissionShouldFail(t *testing.T) {
 	ctx := t.Context()
 	//nolint:staticcheck
@@ -2647,6 +2731,32 @@ func TestSyncRBACOverrideNotRequired_DiffRevisionWithAutosyncPrevented(t *testin
 		"should not be able to sync to different revision with auto-sync enabled, multi-source app")
 }
 
+func TestSyncRBACConditions(t *testing.T) {
+	newLabelledApp := func(name string, env string) *v1alpha1.Application {
+		app := newTestApp()
+		app.Name = name
+		app.Labels = map[string]string{"env": env}
+		return app
+	}
+	devApp := newLabelledApp("dev-app", "dev")
+	prodApp := newLabelledApp("prod-app", "prod")
+	appServer := newTestAppServerWithEnforcerConfigure(t, func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		_ = enf.SetUserPolicy(`p, user1, applications, get, default/*, allow
+p, user1, applications, sync, default/*, allow
+p, user1, applications, sync, default/*, deny, label('env') == 'prod'`)
+	}, map[string]string{}, devApp, prodApp)
+
+	//nolint:staticcheck
+	userCtx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": "user1"})
+	app, err := appServer.Sync(userCtx, &application.ApplicationSyncRequest{Name: &devApp.Name, Project: new("default")})
+	require.NoError(t, err)
+	assert.NotNil(t, app.Operation)
+
+	_, err = appServer.Sync(userCtx, &application.ApplicationSyncRequest{Name: &prodApp.Name, Project: new("default")})
+	require.ErrorContains(t, err, "permission denied")
+}
+
 func TestSyncAndTerminate(t *testing.T) {
 	ctx := t.Context()
 	appServer := newTestAppServer(t)
@@ -3561,7 +3671,7 @@ func TestRunNewStyleResourceAction(t *testing.T) {
 		Spec: v1alpha1.AppProjectSpec{
 			SourceRepos:                []string{"*"},
 			Destinations:               []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
-			NamespaceResourceWhitelist: []metav1.GroupKind{{Group: "never", Kind: "mind"}},
+			NamespaceResourceWhitelist: []v1alpha1.NamespaceResourceRestrictionItem{{Group: "never", Kind: "mind"}},
 		},
 	}
 
@@ -291,6 +291,9 @@ var clusterFieldsByPath = map[string]func(updated *appv1.Cluster, existing *appv
 	"project": func(updated *appv1.Cluster, existing *appv1.Cluster) {
 		updated.Project = existing.Project
 	},
+	"maxConcurrentSyncs": func(updated *appv1.Cluster, existing *appv1.Cluster) {
+		updated.MaxConcurrentSyncs = existing.MaxConcurrentSyncs
+	},
 }
 
 // Update updates a cluster
@@ -682,7 +682,7 @@ func (m *Manager) authorize(ctx context.Context, rr *RequestResources, extName s
 		return nil, errors.New("rbac enforcer not set in extension manager")
 	}
 	appRBACName := security.RBACName(rr.ApplicationNamespace, rr.ProjectName, rr.ApplicationNamespace, rr.ApplicationName)
-	if err := m.rbac.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, appRBACName); err != nil {
+	if err := m.rbac.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, appRBACName, rbac.UnknownAttributes); err != nil {
 		return nil, fmt.Errorf("application authorization error: %w", err)
 	}
 
@@ -702,6 +702,9 @@ func (m *Manager) authorize(ctx context.Context, rr *RequestResources, extName s
 	if app.Spec.GetProject() != rr.ProjectName {
 		return nil, fmt.Errorf("project mismatch provided in the %q header", HeaderArgoCDProjectName)
 	}
+	if err := m.rbac.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, appRBACName, app.RBACAttributes()); err != nil {
+		return nil, fmt.Errorf("application authorization error: %w", err)
+	}
 
 	proj, err := m.project.Get(app.Spec.GetProject())
 	if err != nil {
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 	"github.com/argoproj/pkg/v2/sync"
@@ -17,6 +19,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
@@ -36,36 +39,42 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/session"
 	"github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/argoproj/argo-cd/v3/util/syncwindow"
 )
 
 const (
 	// JWTTokenSubFormat format of the JWT token subject that Argo CD vends out.
 	JWTTokenSubFormat = "proj:%s:%s"
+	// upcomingSyncWindowOccurrences is the number of upcoming occurrences returned for every sync window
+	upcomingSyncWindowOccurrences = 5
 )
 
 // Server provides a Project service
 type Server struct {
-	ns            string
-	enf           *rbac.Enforcer
-	policyEnf     *rbacpolicy.RBACPolicyEnforcer
-	appclientset  appclientset.Interface
-	kubeclientset kubernetes.Interface
-	auditLogger   *argo.AuditLogger
-	projectLock   sync.KeyLock
-	sessionMgr    *session.SessionManager
-	projInformer  cache.SharedIndexInformer
-	settingsMgr   *settings.SettingsManager
-	db            db.ArgoDB
+	ns             string
+	enf            *rbac.Enforcer
+	policyEnf      *rbacpolicy.RBACPolicyEnforcer
+	appclientset   appclientset.Interface
+	kubeclientset  kubernetes.Interface
+	auditLogger    *argo.AuditLogger
+	projectLock    sync.KeyLock
+	sessionMgr     *session.SessionManager
+	projInformer   cache.SharedIndexInformer
+	appLister      listersv1alpha1.ApplicationLister
+	settingsMgr    *settings.SettingsManager
+	db             db.ArgoDB
+	calendarLoader *syncwindow.CalendarLoader
 }
 
 // NewServer returns a new instance of the Project service
 func NewServer(ns string, kubeclientset kubernetes.Interface, appclientset appclientset.Interface, enf *rbac.Enforcer, projectLock sync.KeyLock, sessionMgr *session.SessionManager, policyEnf *rbacpolicy.RBACPolicyEnforcer,
-	projInformer cache.SharedIndexInformer, settingsMgr *settings.SettingsManager, db db.ArgoDB, enableK8sEvent []string,
+	projInformer cache.SharedIndexInformer, appLister listersv1alpha1.ApplicationLister, settingsMgr *settings.SettingsManager, db db.ArgoDB, enableK8sEvent []string,
+	calendarLoader *syncwindow.CalendarLoader,
 ) *Server {
 	auditLogger := argo.NewAuditLogger(kubeclientset, ns, "argocd-server", enableK8sEvent)
 	return &Server{
 		enf: enf, policyEnf: policyEnf, appclientset: appclientset, kubeclientset: kubeclientset, ns: ns, projectLock: projectLock, auditLogger: auditLogger, sessionMgr: sessionMgr,
-		projInformer: projInformer, settingsMgr: settingsMgr, db: db,
+		projInformer: projInformer, appLister: appLister, settingsMgr: settingsMgr, db: db, calendarLoader: calendarLoader,
 	}
 }
 
@@ -81,6 +90,20 @@ func validateProject(proj *v1alpha1.AppProject) error {
 	return nil
 }
 
+// validateProjectParent checks that the parent project of a project exists and does not create a cycle in the project
+// hierarchy
+func (s *Server) validateProjectParent(proj *v1alpha1.AppProject) error {
+	if proj.Spec.Parent == "" {
+		return nil
+	}
+	projWithNs := proj.DeepCopy()
+	projWithNs.Namespace = s.ns
+	if _, err := argo.GetProjectAncestors(projWithNs, listersv1alpha1.NewAppProjectLister(s.projInformer.GetIndexer())); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid parent project: %v", err)
+	}
+	return nil
+}
+
 // CreateToken creates a new token to access a project
 func (s *Server) CreateToken(ctx context.Context, q *project.ProjectTokenCreateRequest) (*project.ProjectTokenResponse, error) {
 	var resp *project.ProjectTokenResponse
@@ -248,6 +271,167 @@ func (s *Server) deleteToken(ctx context.Context, q *project.ProjectTokenDeleteR
 	return &project.EmptyResponse{}, nil
 }
 
+// CreateRoleGrant creates a new just-in-time grant for a project role
+func (s *Server) CreateRoleGrant(ctx context.Context, q *project.ProjectRoleGrantCreateRequest) (*v1alpha1.ProjectRoleGrant, error) {
+	var grant *v1alpha1.ProjectRoleGrant
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var createErr error
+		grant, createErr = s.createRoleGrant(ctx, q)
+		return createErr
+	})
+	return grant, err
+}
+
+func (s *Server) createRoleGrant(ctx context.Context, q *project.ProjectRoleGrantCreateRequest) (*v1alpha1.ProjectRoleGrant, error) {
+	prj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Project, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	s.projectLock.Lock(q.Project)
+	defer s.projectLock.Unlock(q.Project)
+
+	role, _, err := prj.GetRoleByName(q.Role)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "project '%s' does not have role '%s'", q.Project, q.Role)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionUpdate, q.Project); err != nil {
+		// members of the role can only request grants which have to be approved by a second user
+		if !role.RequireGrantApproval || !jwtutil.IsMember(jwtutil.Claims(ctx.Value("claims")), role.Groups, s.policyEnf.GetScopes()) {
+			return nil, err
+		}
+	}
+	if strings.TrimSpace(q.Reason) == "" {
+		return nil, status.Error(codes.InvalidArgument, "a reason is required to grant access to a project role")
+	}
+
+	now := time.Now()
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, fmt.Errorf("error generating grant id: %w", err)
+	}
+	grant := v1alpha1.ProjectRoleGrant{
+		ID:          id.String(),
+		Role:        q.Role,
+		Policies:    q.Policies,
+		Groups:      q.Groups,
+		Duration:    q.Duration,
+		Reason:      q.Reason,
+		RequestedBy: session.Username(ctx),
+		RequestedAt: now.Unix(),
+	}
+	prj.RemoveExpiredRoleGrants(now)
+	prj.Status.Grants = append(prj.Status.Grants, grant)
+	prj.NormalizePolicies()
+	if err := validateProject(prj); err != nil {
+		return nil, err
+	}
+	res := &prj.Status.Grants[len(prj.Status.Grants)-1]
+	if !role.RequireGrantApproval {
+		if err := res.Activate(now); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	_, err = s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, prj, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(ctx, prj, argo.EventReasonResourceCreated, fmt.Sprintf("created grant '%s' of role '%s' for %s: %s", res.ID, res.Role, res.Duration, res.Reason))
+	return res, nil
+}
+
+// ApproveRoleGrant approves a just-in-time grant of a project role, which puts it into effect
+func (s *Server) ApproveRoleGrant(ctx context.Context, q *project.ProjectRoleGrantRequest) (*v1alpha1.ProjectRoleGrant, error) {
+	var grant *v1alpha1.ProjectRoleGrant
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var approveErr error
+		grant, approveErr = s.approveRoleGrant(ctx, q)
+		return approveErr
+	})
+	return grant, err
+}
+
+func (s *Server) approveRoleGrant(ctx context.Context, q *project.ProjectRoleGrantRequest) (*v1alpha1.ProjectRoleGrant, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionUpdate, q.Project); err != nil {
+		return nil, err
+	}
+	prj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Project, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	s.projectLock.Lock(q.Project)
+	defer s.projectLock.Unlock(q.Project)
+
+	grant, grantIndex, err := prj.GetRoleGrant(q.Role, q.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if !grant.IsPending() {
+		return nil, status.Errorf(codes.FailedPrecondition, "grant '%s' is not waiting for approval", grant.ID)
+	}
+	user := session.Username(ctx)
+	if user == grant.RequestedBy {
+		return nil, status.Errorf(codes.PermissionDenied, "grant '%s' must be approved by another user than the one who requested it", grant.ID)
+	}
+	grant.ApprovedBy = user
+	now := time.Now()
+	if err := grant.Activate(now); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	prj.Status.Grants[grantIndex] = *grant
+	prj.RemoveExpiredRoleGrants(now)
+
+	_, err = s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, prj, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(ctx, prj, argo.EventReasonResourceUpdated, fmt.Sprintf("approved grant '%s' of role '%s' requested by %s", grant.ID, grant.Role, grant.RequestedBy))
+	return grant, nil
+}
+
+// DeleteRoleGrant revokes a just-in-time grant of a project role
+func (s *Server) DeleteRoleGrant(ctx context.Context, q *project.ProjectRoleGrantRequest) (*project.EmptyResponse, error) {
+	var resp *project.EmptyResponse
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var deleteErr error
+		resp, deleteErr = s.deleteRoleGrant(ctx, q)
+		return deleteErr
+	})
+	return resp, err
+}
+
+func (s *Server) deleteRoleGrant(ctx context.Context, q *project.ProjectRoleGrantRequest) (*project.EmptyResponse, error) {
+	prj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Project, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	s.projectLock.Lock(q.Project)
+	defer s.projectLock.Unlock(q.Project)
+
+	grant, grantIndex, err := prj.GetRoleGrant(q.Role, q.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	// the user who requested a grant can revoke it
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionUpdate, q.Project); err != nil {
+		if session.Username(ctx) != grant.RequestedBy {
+			return nil, err
+		}
+	}
+	prj.Status.Grants = slices.Delete(prj.Status.Grants, grantIndex, grantIndex+1)
+	prj.RemoveExpiredRoleGrants(time.Now())
+
+	_, err = s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, prj, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(ctx, prj, argo.EventReasonResourceDeleted, fmt.Sprintf("revoked grant '%s' of role '%s'", grant.ID, grant.Role))
+	return &project.EmptyResponse{}, nil
+}
+
 // Create a new project
 func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*v1alpha1.AppProject, error) {
 	if q.Project == nil {
@@ -261,6 +445,9 @@ func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*
 	if err != nil {
 		return nil, fmt.Errorf("error validating project: %w", err)
 	}
+	if err := s.validateProjectParent(q.Project); err != nil {
+		return nil, err
+	}
 	res, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Create(ctx, q.Project, metav1.CreateOptions{})
 	if apierrors.IsAlreadyExists(err) {
 		existing, getErr := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Project.Name, metav1.GetOptions{})
@@ -320,12 +507,18 @@ func (s *Server) GetDetailedProject(ctx context.Context, q *project.ProjectQuery
 	for _, cluster := range clusters {
 		apiClusters = append(apiClusters, cluster.Sanitized())
 	}
+	// the applications of the project may live in any of the allowed application namespaces
+	apps, err := s.appLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("error listing applications: %w", err)
+	}
 
 	return &project.DetailedProjectsResponse{
 		GlobalProjects: globalProjects,
 		Project:        proj,
 		Repositories:   apiRepos,
 		Clusters:       apiClusters,
+		QuotaUsage:     argo.GetProjectQuotaUsage(proj.Name, apps),
 	}, err
 }
 
@@ -342,6 +535,19 @@ func (s *Server) Get(ctx context.Context, q *project.ProjectQuery) (*v1alpha1.Ap
 	return proj, err
 }
 
+// GetEffectiveProject returns a project merged with the policies inherited from its parent projects
+func (s *Server) GetEffectiveProject(ctx context.Context, q *project.ProjectQuery) (*project.EffectiveProjectResponse, error) {
+	proj, err := s.Get(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	effective, origins, err := argo.GetEffectiveProject(proj, listersv1alpha1.NewAppProjectLister(s.projInformer.GetIndexer()))
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "error getting effective project: %v", err)
+	}
+	return &project.EffectiveProjectResponse{Project: effective, Origins: origins}, nil
+}
+
 // GetGlobalProjects returns global projects
 func (s *Server) GetGlobalProjects(ctx context.Context, q *project.ProjectQuery) (*project.GlobalProjectsResponse, error) {
 	projOrig, err := s.Get(ctx, q)
@@ -370,6 +576,9 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	if err != nil {
 		return nil, err
 	}
+	if err := s.validateProjectParent(q.Project); err != nil {
+		return nil, err
+	}
 	s.projectLock.Lock(q.Project.Name)
 	defer s.projectLock.Unlock(q.Project.Name)
 
@@ -377,6 +586,15 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	if err != nil {
 		return nil, err
 	}
+	// the grants are only changed by the grant APIs, which check who may request and approve them
+	q.Project.Status.Grants = oldProj.Status.Grants
+
+	// detaching a project from its parent widens the policies of the project
+	if oldProj.Spec.Parent != "" && oldProj.Spec.Parent != q.Project.Spec.Parent {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionUpdate, oldProj.Spec.Parent); err != nil {
+			return nil, err
+		}
+	}
 
 	for _, cluster := range difference(q.Project.Spec.DestinationClusters(), oldProj.Spec.DestinationClusters()) {
 		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceClusters, rbac.ActionUpdate, cluster); err != nil {
@@ -486,6 +704,13 @@ func (s *Server) Delete(ctx context.Context, q *project.ProjectQuery) (*project.
 	if len(apps) > 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "project is referenced by %d applications", len(apps))
 	}
+	projs, err := listersv1alpha1.NewAppProjectLister(s.projInformer.GetIndexer()).AppProjects(s.ns).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	if children := argo.GetProjectDescendants(q.Name, projs); len(children) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "project is the parent of %d projects", len(children))
+	}
 	err = s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Delete(ctx, q.Name, metav1.DeleteOptions{})
 	if err == nil {
 		s.logEvent(ctx, p, argo.EventReasonResourceDeleted, "deleted project")
@@ -532,16 +757,27 @@ func (s *Server) GetSyncWindowsState(ctx context.Context, q *project.SyncWindows
 		return nil, err
 	}
 
-	res := &project.SyncWindowsResponse{}
+	res := &project.SyncWindowsResponse{Windows: []*v1alpha1.SyncWindow{}}
 
-	windows, err := proj.Spec.SyncWindows.Active()
-	if err != nil {
-		return nil, err
-	}
-	if windows.HasWindows() {
-		res.Windows = *windows
-	} else {
-		res.Windows = []*v1alpha1.SyncWindow{}
+	// windows are evaluated one by one so that a window whose calendar cannot be loaded does not hide the others
+	now := time.Now()
+	for i, window := range proj.Spec.SyncWindows {
+		// the resolved window is only evaluated, the data of its calendar is not returned
+		schedule := &project.SyncWindowSchedule{Index: int32(i), Window: window}
+		resolved, err := s.calendarLoader.ResolveWindow(window)
+		if err != nil {
+			schedule.Error = err.Error()
+			res.Schedules = append(res.Schedules, schedule)
+			continue
+		}
+		occurrences, err := resolved.UpcomingOccurrences(now, upcomingSyncWindowOccurrences)
+		if err != nil {
+			schedule.Error = err.Error()
+		} else if active, err := resolved.Active(); err == nil && active {
+			res.Windows = append(res.Windows, window)
+		}
+		schedule.Occurrences = occurrences
+		res.Schedules = append(res.Schedules, schedule)
 	}
 
 	return res, nil
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/db"
@@ -117,6 +118,7 @@ func TestProjectServer(t *testing.T) {
 	fakeAppsClientset := apps.NewSimpleClientset()
 	factory := informer.NewSharedInformerFactoryWithOptions(fakeAppsClientset, 0, informer.WithNamespace(""), informer.WithTweakListOptions(func(_ *metav1.ListOptions) {}))
 	projInformer := factory.Argoproj().V1alpha1().AppProjects().Informer()
+	appLister := factory.Argoproj().V1alpha1().Applications().Lister()
 	go projInformer.Run(ctx.Done())
 	if !k8scache.WaitForCacheSync(ctx.Done(), projInformer.HasSynced) {
 		panic("Timed out waiting forfff caches to sync")
@@ -129,7 +131,7 @@ func TestProjectServer(t *testing.T) {
 		role1 := v1alpha1.ProjectRole{Name: roleName, JWTTokens: []v1alpha1.JWTToken{{IssuedAt: 1}}}
 		projectWithRole.Spec.Roles = append(projectWithRole.Spec.Roles, role1)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewClientset(), apps.NewSimpleClientset(projectWithRole), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewClientset(), apps.NewSimpleClientset(projectWithRole), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		err := projectServer.NormalizeProjs()
 		require.NoError(t, err)
 
@@ -143,7 +145,7 @@ func TestProjectServer(t *testing.T) {
 		enforcer.SetDefaultRole("role:projects")
 		_ = enforcer.SetBuiltinPolicy("p, role:projects, projects, update, *, allow")
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		updatedProj := existingProj.DeepCopy()
 		updatedProj.Spec.Destinations = nil
@@ -157,7 +159,7 @@ func TestProjectServer(t *testing.T) {
 		enforcer.SetDefaultRole("role:projects")
 		_ = enforcer.SetBuiltinPolicy("p, role:projects, projects, update, *, allow")
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		updatedProj := existingProj.DeepCopy()
 		updatedProj.Spec.SourceRepos = nil
@@ -171,7 +173,7 @@ func TestProjectServer(t *testing.T) {
 		enforcer.SetDefaultRole("role:projects")
 		_ = enforcer.SetBuiltinPolicy("p, role:projects, projects, update, *, allow")
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		updatedProj := existingProj.DeepCopy()
 		updatedProj.Spec.ClusterResourceWhitelist = []v1alpha1.ClusterResourceRestrictionItem{{}}
@@ -185,10 +187,10 @@ func TestProjectServer(t *testing.T) {
 		enforcer.SetDefaultRole("role:projects")
 		_ = enforcer.SetBuiltinPolicy("p, role:projects, projects, update, *, allow")
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		updatedProj := existingProj.DeepCopy()
-		updatedProj.Spec.NamespaceResourceBlacklist = []metav1.GroupKind{{}}
+		updatedProj.Spec.NamespaceResourceBlacklist = []v1alpha1.NamespaceResourceRestrictionItem{{}}
 
 		_, err := projectServer.Update(t.Context(), &project.ProjectUpdateRequest{Project: updatedProj})
 
@@ -204,7 +206,7 @@ func TestProjectServer(t *testing.T) {
 		}
 
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		updatedProj := existingProj.DeepCopy()
 		updatedProj.Spec.Destinations = updatedProj.Spec.Destinations[1:]
@@ -221,7 +223,7 @@ func TestProjectServer(t *testing.T) {
 		}
 
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		updatedProj := existingProj.DeepCopy()
 		updatedProj.Spec.Destinations = updatedProj.Spec.Destinations[1:]
@@ -241,7 +243,7 @@ func TestProjectServer(t *testing.T) {
 		}
 
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		updatedProj := existingProj.DeepCopy()
 		updatedProj.Spec.SourceRepos = []string{}
@@ -258,7 +260,7 @@ func TestProjectServer(t *testing.T) {
 		}
 
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		updatedProj := existingProj.DeepCopy()
 		updatedProj.Spec.SourceRepos = []string{}
@@ -279,7 +281,7 @@ func TestProjectServer(t *testing.T) {
 			Spec:       v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Server: "https://server1"}, Project: "test", Source: &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd.git"}},
 		}
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(proj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(proj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		updatedProj := proj.DeepCopy()
 		updatedProj.Spec.SourceRepos = []string{"https://github.com/argoproj/*"}
@@ -306,7 +308,7 @@ func TestProjectServer(t *testing.T) {
 
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
 
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(proj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(proj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		updatedProj := proj.DeepCopy()
 		updatedProj.Spec.Destinations = []v1alpha1.ApplicationDestination{
@@ -321,7 +323,7 @@ func TestProjectServer(t *testing.T) {
 
 	t.Run("TestDeleteProjectSuccessful", func(t *testing.T) {
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		_, err := projectServer.Delete(t.Context(), &project.ProjectQuery{Name: "test"})
 
@@ -334,7 +336,7 @@ func TestProjectServer(t *testing.T) {
 			Spec:       v1alpha1.AppProjectSpec{},
 		}
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&defaultProj), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&defaultProj), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		_, err := projectServer.Delete(t.Context(), &project.ProjectQuery{Name: defaultProj.Name})
 		statusCode, _ := status.FromError(err)
@@ -348,7 +350,7 @@ func TestProjectServer(t *testing.T) {
 		}
 
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		_, err := projectServer.Delete(t.Context(), &project.ProjectQuery{Name: "test"})
 
@@ -376,7 +378,7 @@ func TestProjectServer(t *testing.T) {
 		projectWithRole.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName}}
 
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithRole), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithRole), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		_, err := projectServer.CreateToken(ctx, &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, ExpiresIn: 1})
 		assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: projects, update, test")
 	})
@@ -386,7 +388,7 @@ func TestProjectServer(t *testing.T) {
 		projectWithRole := existingProj.DeepCopy()
 		projectWithRole.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName, Groups: []string{"my-group"}}}
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithRole), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithRole), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		_, err := projectServer.CreateToken(ctx, &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, ExpiresIn: 1})
 		require.NoError(t, err)
 	})
@@ -400,7 +402,7 @@ func TestProjectServer(t *testing.T) {
 
 		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjListerFromInterface(clientset.ArgoprojV1alpha1().AppProjects("default")), "", nil, session.NewUserStateStorage(nil))
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), clientset, enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), clientset, enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		tokenResponse, err := projectServer.CreateToken(t.Context(), &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, ExpiresIn: 100})
 		require.NoError(t, err)
 		claims, _, err := sessionMgr.Parse(tokenResponse.Token)
@@ -421,7 +423,7 @@ func TestProjectServer(t *testing.T) {
 
 		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjListerFromInterface(clientset.ArgoprojV1alpha1().AppProjects("default")), "", nil, session.NewUserStateStorage(nil))
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), clientset, enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), clientset, enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		tokenResponse, err := projectServer.CreateToken(t.Context(), &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, ExpiresIn: 1, Id: id})
 		require.NoError(t, err)
 		claims, _, err := sessionMgr.Parse(tokenResponse.Token)
@@ -442,7 +444,7 @@ func TestProjectServer(t *testing.T) {
 
 		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjListerFromInterface(clientset.ArgoprojV1alpha1().AppProjects("default")), "", nil, session.NewUserStateStorage(nil))
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), clientset, enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), clientset, enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		tokenResponse, err := projectServer.CreateToken(t.Context(), &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, ExpiresIn: 1, Id: id})
 
 		require.NoError(t, err)
@@ -471,7 +473,7 @@ func TestProjectServer(t *testing.T) {
 		token := v1alpha1.ProjectRole{Name: tokenName, JWTTokens: []v1alpha1.JWTToken{{IssuedAt: issuedAt}, {IssuedAt: secondIssuedAt}}}
 		projWithToken.Spec.Roles = append(projWithToken.Spec.Roles, token)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithToken), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithToken), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		_, err := projectServer.DeleteToken(ctx, &project.ProjectTokenDeleteRequest{Project: projWithToken.Name, Role: tokenName, Iat: issuedAt})
 		assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: projects, update, test")
 	})
@@ -484,7 +486,7 @@ func TestProjectServer(t *testing.T) {
 		token := v1alpha1.ProjectRole{Name: tokenName, Groups: []string{"my-group"}, JWTTokens: []v1alpha1.JWTToken{{IssuedAt: issuedAt}, {IssuedAt: secondIssuedAt}}}
 		projWithToken.Spec.Roles = append(projWithToken.Spec.Roles, token)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithToken), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithToken), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		_, err := projectServer.DeleteToken(ctx, &project.ProjectTokenDeleteRequest{Project: projWithToken.Name, Role: tokenName, Iat: issuedAt})
 		require.NoError(t, err)
 	})
@@ -500,7 +502,7 @@ p, role:admin, projects, update, *, allow`)
 		token := v1alpha1.ProjectRole{Name: tokenName, JWTTokens: []v1alpha1.JWTToken{{IssuedAt: issuedAt}, {IssuedAt: secondIssuedAt}}}
 		projWithToken.Spec.Roles = append(projWithToken.Spec.Roles, token)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithToken), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithToken), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		_, err := projectServer.DeleteToken(ctx, &project.ProjectTokenDeleteRequest{Project: projWithToken.Name, Role: tokenName, Iat: issuedAt})
 		require.NoError(t, err)
 		projWithoutToken, err := projectServer.Get(t.Context(), &project.ProjectQuery{Name: projWithToken.Name})
@@ -524,7 +526,7 @@ p, role:admin, projects, update, *, allow`)
 		token := v1alpha1.ProjectRole{Name: tokenName, JWTTokens: []v1alpha1.JWTToken{{IssuedAt: issuedAt, ID: id}, {IssuedAt: secondIssuedAt, ID: secondId}}}
 		projWithToken.Spec.Roles = append(projWithToken.Spec.Roles, token)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithToken), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithToken), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		_, err := projectServer.DeleteToken(ctx, &project.ProjectTokenDeleteRequest{Project: projWithToken.Name, Role: tokenName, Iat: secondIssuedAt, Id: id})
 		require.NoError(t, err)
 		projWithoutToken, err := projectServer.Get(t.Context(), &project.ProjectQuery{Name: projWithToken.Name})
@@ -543,7 +545,7 @@ p, role:admin, projects, update, *, allow`)
 		token := v1alpha1.ProjectRole{Name: tokenName, JWTTokens: []v1alpha1.JWTToken{{IssuedAt: 1}}}
 		projWithToken.Spec.Roles = append(projWithToken.Spec.Roles, token)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithToken), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithToken), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		_, err := projectServer.CreateToken(t.Context(), &project.ProjectTokenCreateRequest{Project: projWithToken.Name, Role: tokenName})
 		require.NoError(t, err)
 		projWithTwoTokens, err := projectServer.Get(t.Context(), &project.ProjectQuery{Name: projWithToken.Name})
@@ -557,7 +559,7 @@ p, role:admin, projects, update, *, allow`)
 		wildSourceRepo := "*"
 		proj.Spec.SourceRepos = append(proj.Spec.SourceRepos, wildSourceRepo)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(proj), enforcer, sync.NewKeyLock(), nil, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(proj), enforcer, sync.NewKeyLock(), nil, policyEnf, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		request := &project.ProjectUpdateRequest{Project: proj}
 		updatedProj, err := projectServer.Update(t.Context(), request)
 		require.NoError(t, err)
@@ -576,7 +578,7 @@ p, role:admin, projects, update, *, allow`)
 		role.Policies = append(role.Policies, policy)
 		projWithRole.Spec.Roles = append(projWithRole.Spec.Roles, role)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithRole), enforcer, sync.NewKeyLock(), nil, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithRole), enforcer, sync.NewKeyLock(), nil, policyEnf, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		request := &project.ProjectUpdateRequest{Project: projWithRole}
 		_, err := projectServer.Update(t.Context(), request)
 		require.NoError(t, err)
@@ -598,7 +600,7 @@ p, role:admin, projects, update, *, allow`)
 		role.Policies = append(role.Policies, policy)
 		projWithRole.Spec.Roles = append(projWithRole.Spec.Roles, role)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithRole), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithRole), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		request := &project.ProjectUpdateRequest{Project: projWithRole}
 		_, err := projectServer.Update(t.Context(), request)
 		expectedErr := fmt.Sprintf("rpc error: code = AlreadyExists desc = policy '%s' already exists for role '%s'", policy, roleName)
@@ -618,7 +620,7 @@ p, role:admin, projects, update, *, allow`)
 		role.Policies = append(role.Policies, policy)
 		projWithRole.Spec.Roles = append(projWithRole.Spec.Roles, role)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithRole), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithRole), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		request := &project.ProjectUpdateRequest{Project: projWithRole}
 		_, err := projectServer.Update(t.Context(), request)
 		assert.ErrorContains(t, err, "object must be of form 'test/*', 'test[/<NAMESPACE>]/<APPNAME>' or 'test/<APPNAME>'")
@@ -637,7 +639,7 @@ p, role:admin, projects, update, *, allow`)
 		role.Policies = append(role.Policies, invalidPolicy)
 		projWithRole.Spec.Roles = append(projWithRole.Spec.Roles, role)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithRole), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithRole), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		request := &project.ProjectUpdateRequest{Project: projWithRole}
 		_, err := projectServer.Update(t.Context(), request)
 		assert.ErrorContains(t, err, "policy subject must be: 'proj:test:testRole'")
@@ -656,7 +658,7 @@ p, role:admin, projects, update, *, allow`)
 		role.Policies = append(role.Policies, invalidPolicy)
 		projWithRole.Spec.Roles = append(projWithRole.Spec.Roles, role)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithRole), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithRole), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		request := &project.ProjectUpdateRequest{Project: projWithRole}
 		_, err := projectServer.Update(t.Context(), request)
 		assert.ErrorContains(t, err, "policy subject must be: 'proj:test:testRole'")
@@ -674,7 +676,7 @@ p, role:admin, projects, update, *, allow`)
 		role.Policies = append(role.Policies, invalidPolicy)
 		projWithRole.Spec.Roles = append(projWithRole.Spec.Roles, role)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithRole), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithRole), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		request := &project.ProjectUpdateRequest{Project: projWithRole}
 		_, err := projectServer.Update(t.Context(), request)
 		assert.ErrorContains(t, err, "effect must be: 'allow' or 'deny'")
@@ -693,7 +695,7 @@ p, role:admin, projects, update, *, allow`)
 		role.Policies = append(role.Policies, invalidPolicy)
 		projWithRole.Spec.Roles = append(projWithRole.Spec.Roles, role)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithRole), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithRole), enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		request := &project.ProjectUpdateRequest{Project: projWithRole}
 		updateProj, err := projectServer.Update(t.Context(), request)
 		require.NoError(t, err)
@@ -708,12 +710,33 @@ p, role:admin, projects, update, *, allow`)
 		win := &v1alpha1.SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h"}
 		projectWithSyncWindows.Spec.SyncWindows = append(projectWithSyncWindows.Spec.SyncWindows, win)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithSyncWindows), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithSyncWindows), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		res, err := projectServer.GetSyncWindowsState(ctx, &project.SyncWindowsQuery{Name: projectWithSyncWindows.Name})
 		require.NoError(t, err)
 		assert.Len(t, res.Windows, 1)
 	})
 
+	t.Run("TestGetSyncWindowsStateSchedules", func(t *testing.T) {
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		projectWithSyncWindows := existingProj.DeepCopy()
+		projectWithSyncWindows.Spec.SyncWindows = v1alpha1.SyncWindows{
+			{Kind: "allow", Schedule: "0 0 * * *", Duration: "1h"},
+			{Kind: "deny", Calendar: &v1alpha1.SyncWindowCalendar{ICS: "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20001225\nRRULE:FREQ=YEARLY\nEND:VEVENT"}},
+			{Kind: "deny", Calendar: &v1alpha1.SyncWindowCalendar{ConfigMapRef: &v1alpha1.SyncWindowCalendarConfigMapRef{Name: "freeze", Key: "freeze.ics"}}},
+		}
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithSyncWindows), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
+		res, err := projectServer.GetSyncWindowsState(ctx, &project.SyncWindowsQuery{Name: projectWithSyncWindows.Name})
+		require.NoError(t, err)
+		require.Len(t, res.Schedules, 3)
+		assert.Len(t, res.Schedules[0].Occurrences, upcomingSyncWindowOccurrences)
+		assert.Empty(t, res.Schedules[0].Error)
+		assert.Equal(t, int32(1), res.Schedules[1].Index)
+		require.Len(t, res.Schedules[1].Occurrences, 2)
+		assert.Equal(t, time.December, res.Schedules[1].Occurrences[0].Start.Month())
+		assert.Contains(t, res.Schedules[2].Error, "cannot load calendar configmap:freeze/freeze.ics")
+	})
+
 	t.Run("TestGetSyncWindowsStateCannotGetProjectDetails", func(t *testing.T) {
 		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
 		projectWithSyncWindows := existingProj.DeepCopy()
@@ -721,7 +744,7 @@ p, role:admin, projects, update, *, allow`)
 		win := &v1alpha1.SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h"}
 		projectWithSyncWindows.Spec.SyncWindows = append(projectWithSyncWindows.Spec.SyncWindows, win)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithSyncWindows), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithSyncWindows), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		res, err := projectServer.GetSyncWindowsState(ctx, &project.SyncWindowsQuery{Name: "incorrect"})
 		require.ErrorContains(t, err, "not found")
 		assert.Nil(t, res)
@@ -739,7 +762,7 @@ p, role:admin, projects, update, *, allow`)
 		win := &v1alpha1.SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h"}
 		projectWithSyncWindows.Spec.SyncWindows = append(projectWithSyncWindows.Spec.SyncWindows, win)
 		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithSyncWindows), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithSyncWindows), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 		_, err := projectServer.GetSyncWindowsState(ctx, &project.SyncWindowsQuery{Name: projectWithSyncWindows.Name})
 		assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: projects, get, test")
 	})
@@ -755,7 +778,7 @@ p, role:admin, projects, update, *, allow`)
 			ObjectMeta: metav1.ObjectMeta{Name: "test-invalid", Namespace: "default"},
 			Spec:       v1alpha1.ApplicationSpec{Source: &v1alpha1.ApplicationSource{}, Project: "test", Destination: v1alpha1.ApplicationDestination{Namespace: "ns3", Server: "https://server4"}},
 		}
-		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithAppWithInvalidCluster, &invalidApp), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithAppWithInvalidCluster, &invalidApp), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		// Add sync window
 		syncWindow := v1alpha1.SyncWindow{
@@ -784,6 +807,137 @@ func newEnforcer(kubeclientset *fake.Clientset) *rbac.Enforcer {
 	return enforcer
 }
 
+func TestProjectServer_RoleGrants(t *testing.T) {
+	enforcer := rbac.NewEnforcer(fake.NewClientset(test.NewFakeConfigMap()), testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	_ = enforcer.SetBuiltinPolicy(`p, bob, projects, update, test, allow`)
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: testNamespace},
+		Spec: v1alpha1.AppProjectSpec{Roles: []v1alpha1.ProjectRole{
+			{Name: "on-call", Groups: []string{"my-org:on-call"}, RequireGrantApproval: true},
+			{Name: "deployer", Groups: []string{"my-org:on-call"}},
+		}},
+	}
+	policyEnf := rbacpolicy.NewRBACPolicyEnforcer(enforcer, test.NewFakeProjLister(proj))
+	policyEnf.SetScopes([]string{"groups"})
+	enforcer.SetClaimsEnforcerFunc(policyEnf.EnforceClaims)
+	projectServer := NewServer(testNamespace, fake.NewClientset(), apps.NewSimpleClientset(proj), enforcer, sync.NewKeyLock(), nil, policyEnf, nil, nil, nil, nil, testEnableEventList, nil)
+	//nolint:staticcheck
+	aliceCtx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": "alice", "groups": []string{"my-org:on-call"}})
+	//nolint:staticcheck
+	bobCtx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": "bob"})
+	syncPolicy := "p, proj:test:on-call, applications, sync, test/*, allow"
+
+	t.Run("Members of the role can only request grants which require approval", func(t *testing.T) {
+		_, err := projectServer.CreateRoleGrant(aliceCtx, &project.ProjectRoleGrantCreateRequest{
+			Project: "test", Role: "deployer", Duration: "2h", Reason: "INC-1234", Policies: []string{"p, proj:test:deployer, applications, sync, test/*, allow"},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Reason is required", func(t *testing.T) {
+		_, err := projectServer.CreateRoleGrant(aliceCtx, &project.ProjectRoleGrantCreateRequest{
+			Project: "test", Role: "on-call", Duration: "2h", Policies: []string{syncPolicy},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Invalid duration", func(t *testing.T) {
+		_, err := projectServer.CreateRoleGrant(aliceCtx, &project.ProjectRoleGrantCreateRequest{
+			Project: "test", Role: "on-call", Duration: "forever", Reason: "INC-1234", Policies: []string{syncPolicy},
+		})
+		assert.ErrorContains(t, err, "has an invalid duration 'forever'")
+	})
+
+	t.Run("Grant is approved by a second user", func(t *testing.T) {
+		grant, err := projectServer.CreateRoleGrant(aliceCtx, &project.ProjectRoleGrantCreateRequest{
+			Project: "test", Role: "on-call", Duration: "2h", Reason: "INC-1234", Policies: []string{syncPolicy},
+		})
+		require.NoError(t, err)
+		assert.True(t, grant.IsPending())
+		assert.Equal(t, "alice", grant.RequestedBy)
+
+		_, err = projectServer.ApproveRoleGrant(aliceCtx, &project.ProjectRoleGrantRequest{Project: "test", Role: "on-call", Id: grant.ID})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		approved, err := projectServer.ApproveRoleGrant(bobCtx, &project.ProjectRoleGrantRequest{Project: "test", Role: "on-call", Id: grant.ID})
+		require.NoError(t, err)
+		assert.Equal(t, "bob", approved.ApprovedBy)
+		assert.InDelta(t, time.Now().Add(2*time.Hour).Unix(), approved.ExpiresAt, 5)
+
+		_, err = projectServer.ApproveRoleGrant(bobCtx, &project.ProjectRoleGrantRequest{Project: "test", Role: "on-call", Id: grant.ID})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		updatedProj, err := projectServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Get(t.Context(), "test", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Contains(t, updatedProj.ProjectPoliciesString(), syncPolicy)
+
+		// the user who requested the grant can revoke it
+		_, err = projectServer.DeleteRoleGrant(aliceCtx, &project.ProjectRoleGrantRequest{Project: "test", Role: "on-call", Id: grant.ID})
+		require.NoError(t, err)
+		updatedProj, err = projectServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Get(t.Context(), "test", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, updatedProj.Status.Grants)
+	})
+
+	t.Run("Grant of a role without approval is active immediately", func(t *testing.T) {
+		grant, err := projectServer.CreateRoleGrant(bobCtx, &project.ProjectRoleGrantCreateRequest{
+			Project: "test", Role: "deployer", Duration: "30m", Reason: "INC-1234", Groups: []string{"my-org:sre"},
+		})
+		require.NoError(t, err)
+		assert.True(t, grant.IsActive(time.Now()))
+		assert.Empty(t, grant.ApprovedBy)
+	})
+
+	t.Run("Grants are not changed by a project update", func(t *testing.T) {
+		existing, err := projectServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Get(t.Context(), "test", metav1.GetOptions{})
+		require.NoError(t, err)
+		updated := existing.DeepCopy()
+		updated.Status.Grants = append(updated.Status.Grants, v1alpha1.ProjectRoleGrant{
+			ID: "injected", Role: "on-call", Policies: []string{syncPolicy}, Duration: "1h", Reason: "INC-1234", ExpiresAt: time.Now().Add(time.Hour).Unix(),
+		})
+		res, err := projectServer.Update(bobCtx, &project.ProjectUpdateRequest{Project: updated})
+		require.NoError(t, err)
+		assert.Equal(t, existing.Status.Grants, res.Status.Grants)
+	})
+
+	t.Run("Expired grants are removed on approve and revoke", func(t *testing.T) {
+		addExpiredGrant := func() {
+			existing, err := projectServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Get(t.Context(), "test", metav1.GetOptions{})
+			require.NoError(t, err)
+			existing.Status.Grants = append(existing.Status.Grants, v1alpha1.ProjectRoleGrant{
+				ID: "expired", Role: "on-call", Policies: []string{syncPolicy}, Duration: "1h", Reason: "INC-1234", ExpiresAt: time.Now().Add(-time.Hour).Unix(),
+			})
+			_, err = projectServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Update(t.Context(), existing, metav1.UpdateOptions{})
+			require.NoError(t, err)
+		}
+		grantIDs := func() []string {
+			updatedProj, err := projectServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Get(t.Context(), "test", metav1.GetOptions{})
+			require.NoError(t, err)
+			var ids []string
+			for _, grant := range updatedProj.Status.Grants {
+				ids = append(ids, grant.ID)
+			}
+			return ids
+		}
+
+		grant, err := projectServer.CreateRoleGrant(aliceCtx, &project.ProjectRoleGrantCreateRequest{
+			Project: "test", Role: "on-call", Duration: "2h", Reason: "INC-1234", Policies: []string{syncPolicy},
+		})
+		require.NoError(t, err)
+		addExpiredGrant()
+		_, err = projectServer.ApproveRoleGrant(bobCtx, &project.ProjectRoleGrantRequest{Project: "test", Role: "on-call", Id: grant.ID})
+		require.NoError(t, err)
+		assert.NotContains(t, grantIDs(), "expired")
+		assert.Contains(t, grantIDs(), grant.ID)
+
+		addExpiredGrant()
+		_, err = projectServer.DeleteRoleGrant(aliceCtx, &project.ProjectRoleGrantRequest{Project: "test", Role: "on-call", Id: grant.ID})
+		require.NoError(t, err)
+		assert.NotContains(t, grantIDs(), "expired")
+		assert.NotContains(t, grantIDs(), grant.ID)
+	})
+}
+
 func TestListEvents(t *testing.T) {
 	t.Parallel()
 	existingProj := &v1alpha1.AppProject{
@@ -797,6 +951,7 @@ func TestListEvents(t *testing.T) {
 			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
 		},
 	}
+	appLister := informer.NewSharedInformerFactory(apps.NewSimpleClientset(), 0).Argoproj().V1alpha1().Applications().Lister()
 
 	t.Run("ListEvents returns empty list for project without events", func(t *testing.T) {
 		t.Parallel()
@@ -819,7 +974,7 @@ func TestListEvents(t *testing.T) {
 		go projInformer.Run(t.Context().Done())
 		k8scache.WaitForCacheSync(t.Context().Done(), projInformer.HasSynced)
 
-		projectServer := NewServer(testNamespace, kubeclientset, apps.NewSimpleClientset(existingProj), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer(testNamespace, kubeclientset, apps.NewSimpleClientset(existingProj), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		res, err := projectServer.ListEvents(t.Context(), &project.ProjectQuery{Name: existingProj.Name})
 		require.NoError(t, err)
@@ -879,7 +1034,7 @@ func TestListEvents(t *testing.T) {
 		go projInformer.Run(t.Context().Done())
 		k8scache.WaitForCacheSync(t.Context().Done(), projInformer.HasSynced)
 
-		projectServer := NewServer(testNamespace, kubeclientset, apps.NewSimpleClientset(existingProj), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer(testNamespace, kubeclientset, apps.NewSimpleClientset(existingProj), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		res, err := projectServer.ListEvents(t.Context(), &project.ProjectQuery{Name: existingProj.Name})
 		require.NoError(t, err)
@@ -917,7 +1072,7 @@ func TestListEvents(t *testing.T) {
 		go projInformer.Run(t.Context().Done())
 		k8scache.WaitForCacheSync(t.Context().Done(), projInformer.HasSynced)
 
-		projectServer := NewServer(testNamespace, kubeclientset, apps.NewSimpleClientset(existingProj), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer(testNamespace, kubeclientset, apps.NewSimpleClientset(existingProj), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		_, err := projectServer.ListEvents(t.Context(), &project.ProjectQuery{Name: "non-existent"})
 		require.Error(t, err)
@@ -947,7 +1102,7 @@ func TestListEvents(t *testing.T) {
 		go projInformer.Run(t.Context().Done())
 		k8scache.WaitForCacheSync(t.Context().Done(), projInformer.HasSynced)
 
-		projectServer := NewServer(testNamespace, kubeclientset, apps.NewSimpleClientset(existingProj), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projectServer := NewServer(testNamespace, kubeclientset, apps.NewSimpleClientset(existingProj), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
 
 		//nolint:staticcheck
 		ctx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"groups": []string{"my-group"}})
@@ -958,3 +1113,144 @@ func TestListEvents(t *testing.T) {
 		assert.Equal(t, codes.PermissionDenied, statusErr.Code())
 	})
 }
+
+func TestProjectServer_ProjectHierarchy(t *testing.T) {
+	kubeclientset := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "argocd-cm",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-secret",
+			Namespace: testNamespace,
+		},
+		Data: map[string][]byte{
+			"server.secretkey": []byte("test"),
+		},
+	})
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeclientset, testNamespace)
+	enforcer := newEnforcer(kubeclientset)
+	argoDB := db.NewDB(testNamespace, settingsMgr, kubeclientset)
+
+	rootProj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: testNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:              []string{"https://github.com/org/*"},
+			ClusterResourceBlacklist: []v1alpha1.ClusterResourceRestrictionItem{{Group: "", Kind: "Namespace"}},
+		},
+	}
+	teamProj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: testNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			Parent:      "root",
+			SourceRepos: []string{"*"},
+		},
+	}
+	appClientset := apps.NewSimpleClientset(rootProj, teamProj)
+	factory := informer.NewSharedInformerFactoryWithOptions(appClientset, 0, informer.WithNamespace(testNamespace))
+	projInformer := factory.Argoproj().V1alpha1().AppProjects().Informer()
+	appLister := factory.Argoproj().V1alpha1().Applications().Lister()
+	go projInformer.Run(t.Context().Done())
+	require.True(t, k8scache.WaitForCacheSync(t.Context().Done(), projInformer.HasSynced))
+	projectServer := NewServer(testNamespace, kubeclientset, appClientset, enforcer, sync.NewKeyLock(), nil, nil, projInformer, appLister, settingsMgr, argoDB, testEnableEventList, nil)
+
+	t.Run("GetEffectiveProject", func(t *testing.T) {
+		res, err := projectServer.GetEffectiveProject(t.Context(), &project.ProjectQuery{Name: "team"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://github.com/org/*"}, res.Project.Spec.SourceRepos)
+		assert.Equal(t, rootProj.Spec.ClusterResourceBlacklist, res.Project.Spec.ClusterResourceBlacklist)
+		assert.ElementsMatch(t, []*v1alpha1.ProjectRuleOrigin{
+			{Field: "sourceRepos", Rule: "https://github.com/org/*", Project: "root"},
+			{Field: "clusterResourceBlacklist", Rule: "/Namespace", Project: "root"},
+		}, res.Origins)
+	})
+
+	t.Run("CreateWithMissingParent", func(t *testing.T) {
+		proj := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "orphan", Namespace: testNamespace},
+			Spec:       v1alpha1.AppProjectSpec{Parent: "missing"},
+		}
+		_, err := projectServer.Create(t.Context(), &project.ProjectCreateRequest{Project: proj})
+		statusCode, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, statusCode.Code())
+		assert.Contains(t, statusCode.Message(), "invalid parent project")
+	})
+
+	t.Run("UpdateWithParentCycle", func(t *testing.T) {
+		proj := rootProj.DeepCopy()
+		proj.Spec.Parent = "team"
+		_, err := projectServer.Update(t.Context(), &project.ProjectUpdateRequest{Project: proj})
+		statusCode, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, statusCode.Code())
+		assert.Contains(t, statusCode.Message(), "creates a cycle in the project hierarchy")
+	})
+
+	t.Run("DeleteParentProject", func(t *testing.T) {
+		_, err := projectServer.Delete(t.Context(), &project.ProjectQuery{Name: "root"})
+		statusCode, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, statusCode.Code())
+		assert.Equal(t, "project is the parent of 1 projects", statusCode.Message())
+	})
+}
+
+func TestProjectServer_GetDetailedProject(t *testing.T) {
+	kubeclientset := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "argocd-cm",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-secret",
+			Namespace: testNamespace,
+		},
+		Data: map[string][]byte{
+			"server.secretkey": []byte("test"),
+		},
+	})
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeclientset, testNamespace)
+	enforcer := newEnforcer(kubeclientset)
+	argoDB := db.NewDB(testNamespace, settingsMgr, kubeclientset)
+
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: testNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceNamespaces: []string{"team-a"},
+			Quotas:           &v1alpha1.ProjectQuotas{MaxApplications: 5},
+		},
+	}
+	newApp := func(namespace, name, project string, resources int) *v1alpha1.Application {
+		app := &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       v1alpha1.ApplicationSpec{Project: project},
+		}
+		for i := range resources {
+			app.Status.Resources = append(app.Status.Resources, v1alpha1.ResourceStatus{Kind: "ConfigMap", Name: fmt.Sprintf("cm-%d", i)})
+		}
+		return app
+	}
+	appClientset := apps.NewSimpleClientset(proj,
+		newApp(testNamespace, "app-1", "test", 2),
+		newApp("team-a", "app-2", "test", 3),
+		newApp("team-a", "app-3", "default", 1),
+	)
+	factory := informer.NewSharedInformerFactoryWithOptions(appClientset, 0, informer.WithNamespace(""))
+	projInformer := factory.Argoproj().V1alpha1().AppProjects().Informer()
+	appInformer := factory.Argoproj().V1alpha1().Applications().Informer()
+	go projInformer.Run(t.Context().Done())
+	go appInformer.Run(t.Context().Done())
+	require.True(t, k8scache.WaitForCacheSync(t.Context().Done(), projInformer.HasSynced, appInformer.HasSynced))
+	projectServer := NewServer(testNamespace, kubeclientset, appClientset, enforcer, sync.NewKeyLock(), nil, nil, projInformer, factory.Argoproj().V1alpha1().Applications().Lister(), settingsMgr, argoDB, testEnableEventList, nil)
+
+	res, err := projectServer.GetDetailedProject(t.Context(), &project.ProjectQuery{Name: "test"})
+	require.NoError(t, err)
+	// the applications of the project in the other allowed namespaces are counted as well
+	assert.Equal(t, &v1alpha1.ProjectQuotaUsage{Applications: 2, ManagedResources: 5}, res.QuotaUsage)
+}
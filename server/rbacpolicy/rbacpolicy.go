@@ -1,35 +1,60 @@
 package rbacpolicy
 
 import (
+	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	gocache "github.com/patrickmn/go-cache"
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	applister "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
 	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 )
 
+const (
+	// grantUseEventInterval is the minimum interval between two events recording the same use of a project role grant
+	grantUseEventInterval = 10 * time.Minute
+	// grantEnforcerExpiration is the duration for which the enforcers used to find the grant allowing a request are kept
+	grantEnforcerExpiration = time.Minute
+)
+
 // RBACPolicyEnforcer provides an RBAC Claims Enforcer which additionally consults AppProject
 // roles, jwt tokens, and groups. It is backed by a AppProject informer/lister cache and does not
 // make any API calls during enforcement.
 type RBACPolicyEnforcer struct {
-	enf        *rbac.Enforcer
-	projLister applister.AppProjectNamespaceLister
-	scopes     []string
+	enf         *rbac.Enforcer
+	projLister  applister.AppProjectNamespaceLister
+	scopes      []string
+	auditLogger *argo.AuditLogger
+	grantUses   *gocache.Cache
+	// grantEnforcers holds the enforcers of the policies of a project with a single grant, by project and policy. They
+	// are kept apart from the enforcers of rbac.Enforcer, which only caches the latest policy of each project.
+	grantEnforcers *gocache.Cache
 }
 
 // NewRBACPolicyEnforcer returns a new RBAC Enforcer for the Argo CD API Server
 func NewRBACPolicyEnforcer(enf *rbac.Enforcer, projLister applister.AppProjectNamespaceLister) *RBACPolicyEnforcer {
 	return &RBACPolicyEnforcer{
-		enf:        enf,
-		projLister: projLister,
-		scopes:     nil,
+		enf:            enf,
+		projLister:     projLister,
+		scopes:         nil,
+		grantUses:      gocache.New(grantUseEventInterval, grantUseEventInterval),
+		grantEnforcers: gocache.New(grantEnforcerExpiration, grantEnforcerExpiration),
 	}
 }
 
+// SetAuditLogger sets the audit logger used to record the uses of project role grants
+func (p *RBACPolicyEnforcer) SetAuditLogger(auditLogger *argo.AuditLogger) {
+	p.auditLogger = auditLogger
+}
+
 func (p *RBACPolicyEnforcer) SetScopes(scopes []string) {
 	p.scopes = scopes
 }
@@ -65,20 +90,32 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...any) bool
 	subject := jwtutil.GetUserIdentifier(mapClaims)
 	// Check if the request is for an application resource. We have special enforcement which takes
 	// into consideration the project's token and group bindings
-	var runtimePolicy string
-	var projName string
 	proj := p.getProjectFromRequest(rvals...)
+	if proj == nil {
+		return p.enforceClaims(mapClaims, subject, nil, p.enf.CreateEnforcerWithRuntimePolicy("", ""), rvals...)
+	}
+	grants := proj.ActiveRoleGrants(time.Now())
+	// NOTE: This calls prevent multiple creation of the wrapped enforcer
+	enforcer := p.enf.CreateEnforcerWithRuntimePolicy(proj.Name, proj.ProjectPoliciesStringWithGrants(grants))
+	if !p.enforceClaims(mapClaims, subject, proj, enforcer, rvals...) {
+		return false
+	}
+	if len(grants) > 0 {
+		p.recordGrantUse(mapClaims, subject, proj, grants, rvals...)
+	}
+	return true
+}
+
+// enforceClaims enforces the claims with the given enforcer of the run-time policy of the project
+func (p *RBACPolicyEnforcer) enforceClaims(mapClaims jwt.MapClaims, subject string, proj *v1alpha1.AppProject, enforcer rbac.CasbinEnforcer, rvals ...any) bool {
+	var projName string
 	if proj != nil {
 		if IsProjectSubject(subject) {
-			return p.enforceProjectToken(subject, proj, rvals...)
+			return p.enforceProjectToken(subject, proj, enforcer, rvals...)
 		}
-		runtimePolicy = proj.ProjectPoliciesString()
 		projName = proj.Name
 	}
 
-	// NOTE: This calls prevent multiple creation of the wrapped enforcer
-	enforcer := p.enf.CreateEnforcerWithRuntimePolicy(projName, runtimePolicy)
-
 	// Check the subject. This is typically the 'admin' case.
 	// NOTE: the call to EnforceWithCustomEnforcer will also consider the default role
 	vals := append([]any{subject}, rvals[1:]...)
@@ -111,15 +148,115 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...any) bool
 			}
 		}
 	}
-	logCtx := log.WithFields(log.Fields{"claims": claims, "rval": rvals, "subject": subject, "groups": groups, "project": projName, "scopes": scopes})
+	logCtx := log.WithFields(log.Fields{"claims": mapClaims, "rval": rvals, "subject": subject, "groups": groups, "project": projName, "scopes": scopes})
 	logCtx.Debug("enforce failed")
 	return false
 }
 
+// ExplainClaims traces the evaluation of a request by EnforceClaims: for the subject of the claims and for its groups,
+// against the policies of the project of the request. It is an rbac.ClaimsExplainerFunc.
+func (p *RBACPolicyEnforcer) ExplainClaims(claims jwt.Claims, rvals ...any) []rbac.SubjectExplanation {
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return nil
+	}
+
+	subject := jwtutil.GetUserIdentifier(mapClaims)
+	var projName, runtimePolicy string
+	if proj := p.getProjectFromRequest(rvals...); proj != nil {
+		runtimePolicy = proj.ProjectPoliciesStringWithGrants(proj.ActiveRoleGrants(time.Now()))
+		if IsProjectSubject(subject) {
+			return []rbac.SubjectExplanation{p.explainProjectToken(subject, proj, runtimePolicy, rvals...)}
+		}
+		projName = proj.Name
+	}
+
+	explanations := []rbac.SubjectExplanation{p.enf.ExplainRuntimePolicy(projName, runtimePolicy, append([]any{subject}, rvals[1:]...)...)}
+
+	// Only the groups of the user which are bound to roles by grouping policies are evaluated
+	groupingPolicies, err := p.enf.CreateEnforcerWithRuntimePolicy(projName, runtimePolicy).GetGroupingPolicy()
+	if err != nil {
+		log.WithError(err).Error("failed to get grouping policy")
+		return explanations
+	}
+	for _, group := range jwtutil.GetScopeValues(mapClaims, p.GetScopes()) {
+		bound := slices.ContainsFunc(groupingPolicies, func(groupingPolicy []string) bool {
+			return groupingPolicy[0] == group
+		})
+		if !bound {
+			explanations = append(explanations, rbac.SubjectExplanation{Subject: group, Skipped: "the group is not bound to any role by a grouping policy"})
+			continue
+		}
+		explanations = append(explanations, p.enf.ExplainRuntimePolicy(projName, runtimePolicy, append([]any{group}, rvals[1:]...)...))
+	}
+	return explanations
+}
+
+// recordGrantUse records an event if the request is only allowed by one of the given active grants of the project. The
+// same use of a grant is recorded at most once per grantUseEventInterval. The grant is looked up and the event is
+// recorded in the background, so that the enforcement does not wait for them.
+func (p *RBACPolicyEnforcer) recordGrantUse(mapClaims jwt.MapClaims, subject string, proj *v1alpha1.AppProject, grants []v1alpha1.ProjectRoleGrant, rvals ...any) {
+	if p.auditLogger == nil {
+		return
+	}
+	key := fmt.Sprintf("%s/%s/%v", proj.Name, subject, rvals[1:])
+	if p.grantUses.Add(key, true, gocache.DefaultExpiration) != nil {
+		return
+	}
+	rvals = slices.Clone(rvals)
+	go func() {
+		grant := p.getUsedGrant(mapClaims, subject, proj, grants, rvals...)
+		if grant == nil {
+			return
+		}
+		message := fmt.Sprintf("%s used grant '%s' of role '%s' to %v %v '%v'", subject, grant.ID, grant.Role, rvals[2], rvals[1], rvals[3])
+		p.auditLogger.LogAppProjEvent(proj, argo.EventInfo{Type: corev1.EventTypeNormal, Reason: argo.EventReasonProjectRoleGrantUsed}, message, subject)
+	}()
+}
+
+// getUsedGrant returns the first of the given grants which allows the request, or nil if the request is allowed without
+// any grant
+func (p *RBACPolicyEnforcer) getUsedGrant(mapClaims jwt.MapClaims, subject string, proj *v1alpha1.AppProject, grants []v1alpha1.ProjectRoleGrant, rvals ...any) *v1alpha1.ProjectRoleGrant {
+	enforcer, err := p.getGrantEnforcer(proj.Name, proj.ProjectPoliciesStringWithGrants(nil))
+	if err != nil {
+		log.WithError(err).Warnf("failed to find the grant used by %s in project '%s'", subject, proj.Name)
+		return nil
+	}
+	if p.enforceClaims(mapClaims, subject, proj, enforcer, rvals...) {
+		return nil
+	}
+	for i := range grants {
+		enforcer, err := p.getGrantEnforcer(proj.Name, proj.ProjectPoliciesStringWithGrants(grants[i:i+1]))
+		if err != nil {
+			log.WithError(err).Warnf("failed to find the grant used by %s in project '%s'", subject, proj.Name)
+			return nil
+		}
+		if p.enforceClaims(mapClaims, subject, proj, enforcer, rvals...) {
+			return &grants[i]
+		}
+	}
+	return nil
+}
+
+// getGrantEnforcer returns the enforcer of the given run-time policy of a project, which is used to find the grant
+// allowing a request
+func (p *RBACPolicyEnforcer) getGrantEnforcer(projName string, policy string) (rbac.CasbinEnforcer, error) {
+	key := projName + "\n" + policy
+	if enforcer, ok := p.grantEnforcers.Get(key); ok {
+		return enforcer.(rbac.CasbinEnforcer), nil
+	}
+	enforcer, err := p.enf.NewEnforcerWithRuntimePolicy(projName, policy)
+	if err != nil {
+		return nil, err
+	}
+	p.grantEnforcers.SetDefault(key, enforcer)
+	return enforcer, nil
+}
+
 // getProjectFromRequest parses the project name from the RBAC request and returns the associated
 // project (if it exists)
 func (p *RBACPolicyEnforcer) getProjectFromRequest(rvals ...any) *v1alpha1.AppProject {
-	if len(rvals) != 4 {
+	if len(rvals) != 4 && len(rvals) != 5 {
 		return nil
 	}
 	getProjectByName := func(projName string) *v1alpha1.AppProject {
@@ -145,8 +282,16 @@ func (p *RBACPolicyEnforcer) getProjectFromRequest(rvals ...any) *v1alpha1.AppPr
 	return nil
 }
 
+// explainProjectToken traces the evaluation of a request by enforceProjectToken
+func (p *RBACPolicyEnforcer) explainProjectToken(subject string, proj *v1alpha1.AppProject, runtimePolicy string, rvals ...any) rbac.SubjectExplanation {
+	if projName, _, _ := GetProjectRoleFromSubject(subject); projName != proj.Name {
+		return rbac.SubjectExplanation{Subject: subject, Skipped: fmt.Sprintf("the project role is not a role of project '%s'", proj.Name)}
+	}
+	return p.enf.ExplainRuntimePolicy(proj.Name, runtimePolicy, append([]any{subject}, rvals[1:]...)...)
+}
+
 // enforceProjectToken will check to see the valid token has not yet been revoked in the project
-func (p *RBACPolicyEnforcer) enforceProjectToken(subject string, proj *v1alpha1.AppProject, rvals ...any) bool {
+func (p *RBACPolicyEnforcer) enforceProjectToken(subject string, proj *v1alpha1.AppProject, enforcer rbac.CasbinEnforcer, rvals ...any) bool {
 	subjectSplit := strings.Split(subject, ":")
 	if len(subjectSplit) != 3 {
 		return false
@@ -158,5 +303,5 @@ func (p *RBACPolicyEnforcer) enforceProjectToken(subject string, proj *v1alpha1.
 	}
 
 	vals := append([]any{subject}, rvals[1:]...)
-	return p.enf.EnforceRuntimePolicy(proj.Name, proj.ProjectPoliciesString(), vals...)
+	return p.enf.EnforceWithCustomEnforcer(enforcer, vals...)
 }
@@ -3,6 +3,7 @@ package rbacpolicy
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
@@ -13,6 +14,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	settings_util "github.com/argoproj/argo-cd/v3/util/settings"
 )
@@ -179,6 +181,120 @@ func TestInvalidatedCache(t *testing.T) {
 	assert.False(t, enf.Enforce(claims, "exec", "create", "my-proj/my-app"))
 }
 
+func TestEnforceProjectRoleGrants(t *testing.T) {
+	t.Parallel()
+	kubeclientset := fake.NewClientset(test.NewFakeConfigMap())
+	proj := newFakeProj()
+	now := time.Now()
+	proj.Status.Grants = []argoappv1.ProjectRoleGrant{
+		{ID: "active", Role: "my-role", Policies: []string{"p, proj:my-proj:my-role, applications, sync, my-proj/*, allow"}, Duration: "1h", ExpiresAt: now.Add(time.Hour).Unix()},
+		{ID: "expired", Role: "my-role", Groups: []string{"my-org:sre"}, Duration: "1h", ExpiresAt: now.Add(-time.Hour).Unix()},
+		{ID: "pending", Role: "my-role", Groups: []string{"my-org:dev"}, Duration: "1h"},
+	}
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	rbacEnf := NewRBACPolicyEnforcer(enf, test.NewFakeProjLister(proj))
+	rbacEnf.SetAuditLogger(argo.NewAuditLogger(kubeclientset, test.FakeArgoCDNamespace, "argocd-server", argo.DefaultEnableEventList()))
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+
+	grantUseEvents := func() []string {
+		events, err := kubeclientset.CoreV1().Events(test.FakeArgoCDNamespace).List(t.Context(), metav1.ListOptions{})
+		require.NoError(t, err)
+		var messages []string
+		for _, event := range events.Items {
+			if event.Reason == argo.EventReasonProjectRoleGrantUsed {
+				messages = append(messages, event.Message)
+			}
+		}
+		return messages
+	}
+
+	claims := jwt.MapClaims{"sub": "alice", "groups": []string{"my-org:my-team"}}
+	// the grant uses are looked up without replacing the cached enforcer of the project
+	projEnforcer := enf.CreateEnforcerWithRuntimePolicy(proj.Name, proj.ProjectPoliciesStringWithGrants(proj.ActiveRoleGrants(now)))
+	// policies of the role are not recorded as grant uses
+	assert.True(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
+	// policies of the active grant are added to the role
+	assert.True(t, enf.Enforce(claims, "applications", "sync", "my-proj/my-app"))
+	assert.True(t, enf.Enforce(claims, "applications", "sync", "my-proj/my-app"))
+	expected := []string{"alice used grant 'active' of role 'my-role' to sync applications 'my-proj/my-app'"}
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual(expected, grantUseEvents())
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Same(t, projEnforcer, enf.CreateEnforcerWithRuntimePolicy(proj.Name, proj.ProjectPoliciesStringWithGrants(proj.ActiveRoleGrants(now))))
+
+	// group bindings of expired or pending grants are ignored
+	claims = jwt.MapClaims{"sub": "bob", "groups": []string{"my-org:sre", "my-org:dev"}}
+	assert.False(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
+}
+
+func TestExplainClaims(t *testing.T) {
+	t.Parallel()
+	kubeclientset := fake.NewClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(`p, role:readonly, applications, get, */*, allow`)
+	_ = enf.SetUserPolicySources([]rbac.PolicySource{
+		{Name: "policy.csv", Policy: "p, role:dev, applications, create, my-proj/*, allow\ng, alice, role:dev"},
+		{Name: "policy.overlay.csv", Policy: "p, alice, applications, create, my-proj/*, deny"},
+	})
+	enf.SetDefaultRole("role:readonly")
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+	enf.SetClaimsExplainerFunc(rbacEnf.ExplainClaims)
+
+	t.Run("subjects", func(t *testing.T) {
+		explanation := enf.Explain(jwt.MapClaims{"sub": "alice", "groups": []string{"my-org:my-team", "my-org:other-team"}}, "applications", "create", "my-proj/my-app")
+		// a deny only takes precedence over the allow policies of the same subject
+		assert.True(t, explanation.Allowed)
+		require.NotNil(t, explanation.DefaultRole)
+		assert.Equal(t, "role:readonly", explanation.DefaultRole.Subject)
+		assert.False(t, explanation.DefaultRole.Allowed)
+
+		require.Len(t, explanation.Subjects, 3)
+		assert.Equal(t, "alice", explanation.Subjects[0].Subject)
+		assert.Equal(t, []string{"role:dev"}, explanation.Subjects[0].Roles)
+		assert.False(t, explanation.Subjects[0].Allowed)
+		assert.Equal(t, []rbac.MatchedPolicy{
+			{Source: "policy.csv", Policy: "p, role:dev, applications, create, my-proj/*, allow", Effect: "allow"},
+			{Source: "policy.overlay.csv", Policy: "p, alice, applications, create, my-proj/*, deny", Effect: "deny"},
+		}, explanation.Subjects[0].Policies)
+
+		// the group is bound to the project role
+		assert.Equal(t, "my-org:my-team", explanation.Subjects[1].Subject)
+		assert.Equal(t, []string{"proj:my-proj:my-role"}, explanation.Subjects[1].Roles)
+		assert.True(t, explanation.Subjects[1].Allowed)
+		assert.Equal(t, []rbac.MatchedPolicy{
+			{Source: "project my-proj", Policy: "p, proj:my-proj:my-role, applications, create, my-proj/*, allow", Effect: "allow"},
+		}, explanation.Subjects[1].Policies)
+
+		assert.Equal(t, "my-org:other-team", explanation.Subjects[2].Subject)
+		assert.NotEmpty(t, explanation.Subjects[2].Skipped)
+	})
+
+	t.Run("default role", func(t *testing.T) {
+		explanation := enf.Explain(jwt.MapClaims{"sub": "bob"}, "applications", "get", "my-proj/my-app")
+		assert.True(t, explanation.Allowed)
+		require.NotNil(t, explanation.DefaultRole)
+		assert.True(t, explanation.DefaultRole.Allowed)
+		assert.Equal(t, []rbac.MatchedPolicy{
+			{Source: rbac.BuiltinPolicySource, Policy: "p, role:readonly, applications, get, */*, allow", Effect: "allow"},
+		}, explanation.DefaultRole.Policies)
+		require.Len(t, explanation.Subjects, 1)
+		assert.False(t, explanation.Subjects[0].Allowed)
+		assert.Empty(t, explanation.Subjects[0].Policies)
+	})
+
+	t.Run("project token", func(t *testing.T) {
+		explanation := enf.Explain(jwt.MapClaims{"sub": "proj:my-proj:my-role", "iat": 1234}, "logs", "get", "my-proj/my-app")
+		assert.True(t, explanation.Allowed)
+		require.Len(t, explanation.Subjects, 1)
+		assert.True(t, explanation.Subjects[0].Allowed)
+		assert.Equal(t, []rbac.MatchedPolicy{
+			{Source: "project my-proj", Policy: "p, proj:my-proj:my-role, logs, get, my-proj/*, allow", Effect: "allow"},
+		}, explanation.Subjects[0].Policies)
+	})
+}
+
 func TestGetScopes_DefaultScopes(t *testing.T) {
 	t.Parallel()
 	rbacEnforcer := NewRBACPolicyEnforcer(nil, nil)
@@ -111,6 +111,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/server/settings"
 	"github.com/argoproj/argo-cd/v3/server/version"
 	"github.com/argoproj/argo-cd/v3/ui"
+	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/assets"
 	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 	"github.com/argoproj/argo-cd/v3/util/db"
@@ -133,6 +134,7 @@ import (
 	util_session "github.com/argoproj/argo-cd/v3/util/session"
 	settings_util "github.com/argoproj/argo-cd/v3/util/settings"
 	"github.com/argoproj/argo-cd/v3/util/swagger"
+	"github.com/argoproj/argo-cd/v3/util/syncwindow"
 	tlsutil "github.com/argoproj/argo-cd/v3/util/tls"
 	"github.com/argoproj/argo-cd/v3/util/webhook"
 )
@@ -203,6 +205,7 @@ type ArgoCDServer struct {
 	appsetInformer  cache.SharedIndexInformer
 	appsetLister    applisters.ApplicationSetLister
 	db              db.ArgoDB
+	calendarLoader  *syncwindow.CalendarLoader
 
 	// stopCh is the channel which when closed, will shutdown the Argo CD server
 	stopCh             chan os.Signal
@@ -357,7 +360,9 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 	enf.EnableLog(os.Getenv(common.EnvVarRBACDebug) == "1")
 
 	policyEnf := rbacpolicy.NewRBACPolicyEnforcer(enf, projLister)
+	policyEnf.SetAuditLogger(argo.NewAuditLogger(opts.KubeClientset, opts.Namespace, "argocd-server", opts.EnableK8sEvent))
 	enf.SetClaimsEnforcerFunc(policyEnf.EnforceClaims)
+	enf.SetClaimsExplainerFunc(policyEnf.ExplainClaims)
 
 	staticFS, err := fs.Sub(ui.Embedded, "dist/app")
 	errorsutil.CheckError(err)
@@ -412,6 +417,7 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 		userStateStorage:   userStateStorage,
 		staticAssets:       http.FS(staticFS),
 		db:                 dbInstance,
+		calendarLoader:     syncwindow.NewCalendarLoader(opts.KubeClientset, settingsMgr, opts.Namespace),
 		apiFactory:         apiFactory,
 		secretInformer:     secretInformer,
 		configMapInformer:  configMapInformer,
@@ -571,6 +577,7 @@ func (server *ArgoCDServer) Init(ctx context.Context) {
 	go server.clusterInformer.Run(ctx.Done())
 	go server.configMapInformer.Run(ctx.Done())
 	go server.secretInformer.Run(ctx.Done())
+	go server.calendarLoader.Run(ctx)
 }
 
 // Run runs the API Server
@@ -1066,6 +1073,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.ApplicationNamespaces,
 		a.EnableK8sEvent,
 		a.SyncWithReplaceAllowed,
+		a.calendarLoader,
 	)
 
 	applicationSetService := applicationset.NewServer(
@@ -1092,10 +1100,10 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.clusterInformer,
 	)
 
-	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock, a.sessionMgr, a.policyEnforcer, a.projInformer, a.settingsMgr, a.db, a.EnableK8sEvent)
+	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock, a.sessionMgr, a.policyEnforcer, a.projInformer, a.appLister, a.settingsMgr, a.db, a.EnableK8sEvent, a.calendarLoader)
 	appsInAnyNamespaceEnabled := len(a.ApplicationNamespaces) > 0
 	settingsService := settings.NewServer(a.settingsMgr, a.RepoClientset, a, a.DisableAuth, appsInAnyNamespaceEnabled, a.HydratorEnabled, a.SyncWithReplaceAllowed)
-	accountService := account.NewServer(a.sessionMgr, a.settingsMgr, a.enf, a.Namespace)
+	accountService := account.NewServer(a.sessionMgr, a.settingsMgr, a.enf, a.policyEnforcer, a.Namespace)
 
 	notificationService := notification.NewServer(a.apiFactory)
 	certificateService := certificate.NewServer(a.db, a.enf)
@@ -1157,7 +1157,7 @@ func TestNamespacedPermissions(t *testing.T) {
 				AddDestination("*", "*").
 				AddSource("*").
 				UpdateProject(func(proj *AppProject) {
-					proj.Spec.NamespaceResourceBlacklist = []metav1.GroupKind{{Group: "*", Kind: "Deployment"}}
+					proj.Spec.NamespaceResourceBlacklist = []NamespaceResourceRestrictionItem{{Group: "*", Kind: "Deployment"}}
 				})
 		}).
 		Refresh(RefreshTypeNormal).
@@ -1547,7 +1547,7 @@ func TestNamespacedNotPermittedResources(t *testing.T) {
 		SourceRepos:      []string{"*"},
 		Destinations:     []ApplicationDestination{{Namespace: ctx.DeploymentNamespace(), Server: "*"}},
 		SourceNamespaces: []string{fixture.AppNamespace()},
-		NamespaceResourceBlacklist: []metav1.GroupKind{
+		NamespaceResourceBlacklist: []NamespaceResourceRestrictionItem{
 			{Group: "", Kind: "Service"},
 		},
 	}).
@@ -874,7 +874,7 @@ func TestNewStyleResourceActionPermitted(t *testing.T) {
 		ProjectSpec(AppProjectSpec{
 			SourceRepos:  []string{"*"},
 			Destinations: []ApplicationDestination{{Namespace: "*", Server: "*"}},
-			NamespaceResourceWhitelist: []metav1.GroupKind{
+			NamespaceResourceWhitelist: []NamespaceResourceRestrictionItem{
 				{Group: "batch", Kind: "Job"},
 				{Group: "batch", Kind: "CronJob"},
 			},
@@ -987,7 +987,7 @@ func TestNewStyleResourceActionMixedOk(t *testing.T) {
 		ProjectSpec(AppProjectSpec{
 			SourceRepos:  []string{"*"},
 			Destinations: []ApplicationDestination{{Namespace: "*", Server: "*"}},
-			NamespaceResourceWhitelist: []metav1.GroupKind{
+			NamespaceResourceWhitelist: []NamespaceResourceRestrictionItem{
 				{Group: "batch", Kind: "Job"},
 				{Group: "batch", Kind: "CronJob"},
 			},
@@ -1303,7 +1303,7 @@ func TestPermissions(t *testing.T) {
 				AddDestination("*", "*").
 				AddSource("*").
 				UpdateProject(func(proj *AppProject) {
-					proj.Spec.NamespaceResourceBlacklist = []metav1.GroupKind{{Group: "*", Kind: "Deployment"}}
+					proj.Spec.NamespaceResourceBlacklist = []NamespaceResourceRestrictionItem{{Group: "*", Kind: "Deployment"}}
 				})
 		}).
 		Refresh(RefreshTypeNormal).
@@ -1799,7 +1799,7 @@ func TestNotPermittedResources(t *testing.T) {
 	ctx.ProjectSpec(AppProjectSpec{
 		SourceRepos:  []string{"*"},
 		Destinations: []ApplicationDestination{{Namespace: ctx.DeploymentNamespace(), Server: "*"}},
-		NamespaceResourceBlacklist: []metav1.GroupKind{
+		NamespaceResourceBlacklist: []NamespaceResourceRestrictionItem{
 			{Group: "", Kind: "Service"},
 		},
 	}).
@@ -462,11 +462,11 @@ func createAndConfigGlobalProject(ctx context.Context, testName string) error {
 		return err
 	}
 
-	projGlobal.Spec.NamespaceResourceBlacklist = []metav1.GroupKind{
+	projGlobal.Spec.NamespaceResourceBlacklist = []v1alpha1.NamespaceResourceRestrictionItem{
 		{Group: "", Kind: "Service"},
 	}
 
-	projGlobal.Spec.NamespaceResourceWhitelist = []metav1.GroupKind{
+	projGlobal.Spec.NamespaceResourceWhitelist = []v1alpha1.NamespaceResourceRestrictionItem{
 		{Group: "", Kind: "Deployment"},
 	}
 
@@ -131,6 +131,16 @@ func FilterByProjectsP(apps []*argoappv1.Application, projects []string) []*argo
 	return items
 }
 
+// GetProjectQuotaUsage returns the usage of the quotas of the given project by the given applications
+func GetProjectQuotaUsage(project string, apps []*argoappv1.Application) *argoappv1.ProjectQuotaUsage {
+	usage := &argoappv1.ProjectQuotaUsage{}
+	for _, a := range FilterByProjectsP(apps, []string{project}) {
+		usage.Applications++
+		usage.ManagedResources += int64(len(a.Status.Resources))
+	}
+	return usage
+}
+
 // FilterAppSetsByProjects returns applications which belongs to the specified project
 func FilterAppSetsByProjects(appsets []argoappv1.ApplicationSet, projects []string) []argoappv1.ApplicationSet {
 	if len(projects) == 0 {
@@ -649,6 +659,32 @@ func validateSourceHydrator(hydrator *argoappv1.SourceHydrator) []argoappv1.Appl
 	return conditions
 }
 
+func validateDependencies(dependencies []argoappv1.ApplicationDependency) []argoappv1.ApplicationCondition {
+	var conditions []argoappv1.ApplicationCondition
+	for i, dependency := range dependencies {
+		switch {
+		case dependency.Name == "" && dependency.Selector == nil:
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.dependsOn[%d] must specify either name or selector", i),
+			})
+		case dependency.Name != "" && dependency.Selector != nil:
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.dependsOn[%d] must not specify both name and selector", i),
+			})
+		case dependency.Selector != nil:
+			if _, err := metav1.LabelSelectorAsSelector(dependency.Selector); err != nil {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("spec.dependsOn[%d].selector is invalid: %v", i, err),
+				})
+			}
+		}
+	}
+	return conditions
+}
+
 // ValidatePermissions ensures that the referenced cluster has been added to Argo CD and the app source repo and destination namespace/cluster are permitted in app project
 func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
 	conditions := []argoappv1.ApplicationCondition{}
@@ -702,6 +738,44 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 		}
 	}
 
+	conditions = append(conditions, validateDependencies(spec.DependsOn)...)
+	if spec.SyncPolicy != nil && spec.SyncPolicy.AutomatedRollback != nil {
+		if _, err := spec.SyncPolicy.AutomatedRollback.WindowDuration(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.syncPolicy.automatedRollback.window is invalid: %v", err),
+			})
+		}
+	}
+	for i, ignoreDiff := range spec.IgnoreDifferences {
+		if err := ignoreDiff.ValidateCELCondition(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.ignoreDifferences[%d] is invalid: %v", i, err),
+			})
+		}
+	}
+	if spec.SyncPolicy != nil && spec.SyncPolicy.Timeouts != nil {
+		if _, err := spec.SyncPolicy.Timeouts.OperationDuration(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.syncPolicy.timeouts.operation is invalid: %v", err),
+			})
+		}
+		if _, err := spec.SyncPolicy.Timeouts.HealthDuration(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.syncPolicy.timeouts.health is invalid: %v", err),
+			})
+		}
+	}
+
+	if spec.IsFleet() {
+		// the destinations of the applications of a fleet are validated when they are reconciled
+		conditions = append(conditions, validateFleet(spec)...)
+		return conditions, nil
+	}
+
 	destCluster, err := GetDestinationCluster(ctx, spec.Destination, db)
 	if err != nil {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
@@ -729,6 +803,34 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 	return conditions, nil
 }
 
+// validateFleet validates the destination and the rollout steps of a fleet application
+func validateFleet(spec *argoappv1.ApplicationSpec) []argoappv1.ApplicationCondition {
+	var conditions []argoappv1.ApplicationCondition
+	if spec.Destination.Server != "" || spec.Destination.Name != "" {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: "application destination can't have both a cluster selector and a server or name defined",
+		})
+	}
+	if _, err := metav1.LabelSelectorAsSelector(spec.Destination.ClusterSelector); err != nil {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("application destination cluster selector is invalid: %v", err),
+		})
+	}
+	if spec.Fleet != nil {
+		for i, step := range spec.Fleet.RolloutSteps {
+			if _, err := metav1.LabelSelectorAsSelector(&step.ClusterSelector); err != nil {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("spec.fleet.rolloutSteps[%d].clusterSelector is invalid: %v", i, err),
+				})
+			}
+		}
+	}
+	return conditions
+}
+
 // APIResourcesToStrings converts list of API Resources list into string list
 func APIResourcesToStrings(resources []kube.APIResourceInfo, includeKinds bool) []string {
 	resMap := map[string]bool{}
@@ -1185,7 +1287,10 @@ func GetGlobalProjects(proj *argoappv1.AppProject, projLister applicationsv1.App
 }
 
 func GetAppVirtualProject(proj *argoappv1.AppProject, projLister applicationsv1.AppProjectLister, settingsManager *settings.SettingsManager) (*argoappv1.AppProject, error) {
-	virtualProj := proj.DeepCopy()
+	virtualProj, _, err := GetEffectiveProject(proj, projLister)
+	if err != nil {
+		return nil, fmt.Errorf("error getting effective project: %w", err)
+	}
 	globalProjects := GetGlobalProjects(proj, projLister, settingsManager)
 
 	for _, gp := range globalProjects {
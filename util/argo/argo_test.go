@@ -352,6 +352,49 @@ func TestValidatePermissionsEmptyDestination(t *testing.T) {
 	assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "Destination server missing from app spec"}})
 }
 
+func TestValidatePermissionsFleet(t *testing.T) {
+	t.Parallel()
+	proj := &argoappv1.AppProject{
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	spec := argoappv1.ApplicationSpec{
+		Source: &argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd", Path: "."},
+		Destination: argoappv1.ApplicationDestination{
+			Namespace:       "default",
+			ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "shared"}},
+		},
+		Fleet: &argoappv1.ApplicationFleet{RolloutSteps: []argoappv1.ApplicationFleetRolloutStep{
+			{ClusterSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}}},
+		}},
+	}
+
+	t.Run("Valid fleet", func(t *testing.T) {
+		conditions, err := ValidatePermissions(t.Context(), &spec, proj, nil)
+		require.NoError(t, err)
+		assert.Empty(t, conditions)
+	})
+
+	t.Run("Cluster selector with server", func(t *testing.T) {
+		invalidSpec := spec.DeepCopy()
+		invalidSpec.Destination.Server = "https://kubernetes.default.svc"
+		conditions, err := ValidatePermissions(t.Context(), invalidSpec, proj, nil)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "application destination can't have both a cluster selector and a server or name defined"}})
+	})
+
+	t.Run("Invalid rollout step", func(t *testing.T) {
+		invalidSpec := spec.DeepCopy()
+		invalidSpec.Fleet.RolloutSteps[0].ClusterSelector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "env", Operator: "Bad"}}
+		conditions, err := ValidatePermissions(t.Context(), invalidSpec, proj, nil)
+		require.NoError(t, err)
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "spec.fleet.rolloutSteps[0].clusterSelector is invalid")
+	})
+}
+
 func TestValidateChartWithoutRevision(t *testing.T) {
 	t.Parallel()
 	appSpec := &argoappv1.ApplicationSpec{
@@ -671,6 +714,27 @@ func TestFilterByProjects(t *testing.T) {
 	})
 }
 
+func TestGetProjectQuotaUsage(t *testing.T) {
+	t.Parallel()
+	apps := []*argoappv1.Application{
+		{
+			Spec:   argoappv1.ApplicationSpec{Project: "fooproj"},
+			Status: argoappv1.ApplicationStatus{Resources: []argoappv1.ResourceStatus{{Name: "a"}, {Name: "b"}}},
+		},
+		{
+			Spec:   argoappv1.ApplicationSpec{Project: "fooproj"},
+			Status: argoappv1.ApplicationStatus{Resources: []argoappv1.ResourceStatus{{Name: "c"}}},
+		},
+		{
+			Spec:   argoappv1.ApplicationSpec{Project: "barproj"},
+			Status: argoappv1.ApplicationStatus{Resources: []argoappv1.ResourceStatus{{Name: "d"}}},
+		},
+	}
+
+	assert.Equal(t, &argoappv1.ProjectQuotaUsage{Applications: 2, ManagedResources: 3}, GetProjectQuotaUsage("fooproj", apps))
+	assert.Equal(t, &argoappv1.ProjectQuotaUsage{}, GetProjectQuotaUsage("foobarproj", apps))
+}
+
 func TestFilterByProjectsP(t *testing.T) {
 	t.Parallel()
 	apps := []*argoappv1.Application{
@@ -1579,8 +1643,8 @@ func Test_mergeVirtualProject(t *testing.T) {
 				},
 			},
 			Destinations:               []argoappv1.ApplicationDestination{{Server: "test", Namespace: "test"}},
-			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "", Kind: "Service"}},
-			NamespaceResourceWhitelist: []metav1.GroupKind{{Group: "", Kind: "Pod"}},
+			NamespaceResourceBlacklist: []argoappv1.NamespaceResourceRestrictionItem{{Group: "", Kind: "Service"}},
+			NamespaceResourceWhitelist: []argoappv1.NamespaceResourceRestrictionItem{{Group: "", Kind: "Pod"}},
 			SourceRepos:                []string{"http://some/where"},
 			SyncWindows: argoappv1.SyncWindows{
 				{
@@ -1607,8 +1671,8 @@ func Test_mergeVirtualProject(t *testing.T) {
 				},
 			},
 			Destinations:               []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
-			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "*", Kind: "*"}},
-			NamespaceResourceWhitelist: []metav1.GroupKind{{Group: "*", Kind: "*"}},
+			NamespaceResourceBlacklist: []argoappv1.NamespaceResourceRestrictionItem{{Group: "*", Kind: "*"}},
+			NamespaceResourceWhitelist: []argoappv1.NamespaceResourceRestrictionItem{{Group: "*", Kind: "*"}},
 			SourceRepos:                []string{"http://some/where/else"},
 			SyncWindows: argoappv1.SyncWindows{
 				{
@@ -1640,8 +1704,8 @@ func Test_mergeVirtualProject(t *testing.T) {
 				},
 			},
 			Destinations:               []argoappv1.ApplicationDestination{{Server: "test", Namespace: "test"}, {Server: "*", Namespace: "*"}},
-			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "", Kind: "Service"}, {Group: "*", Kind: "*"}},
-			NamespaceResourceWhitelist: []metav1.GroupKind{{Group: "", Kind: "Pod"}, {Group: "*", Kind: "*"}},
+			NamespaceResourceBlacklist: []argoappv1.NamespaceResourceRestrictionItem{{Group: "", Kind: "Service"}, {Group: "*", Kind: "*"}},
+			NamespaceResourceWhitelist: []argoappv1.NamespaceResourceRestrictionItem{{Group: "", Kind: "Pod"}, {Group: "*", Kind: "*"}},
 			SourceRepos:                []string{"http://some/where", "http://some/where/else"},
 			SyncWindows: argoappv1.SyncWindows{
 				{
@@ -2562,3 +2626,109 @@ func Test_GetRefSourcesAndSyncedRefSources_SingleSourceWithRef(t *testing.T) {
 	}
 	assert.Equal(t, expected, synced)
 }
+
+func TestValidateDependencies(t *testing.T) {
+	t.Parallel()
+	conditions := validateDependencies([]argoappv1.ApplicationDependency{
+		{Name: "crds"},
+		{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "infra"}}},
+		{},
+		{Name: "crds", Selector: &metav1.LabelSelector{}},
+		{Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Invalid"}}}},
+	})
+	require.Len(t, conditions, 3)
+	assert.Equal(t, "spec.dependsOn[2] must specify either name or selector", conditions[0].Message)
+	assert.Equal(t, "spec.dependsOn[3] must not specify both name and selector", conditions[1].Message)
+	assert.Contains(t, conditions[2].Message, "spec.dependsOn[4].selector is invalid")
+	for _, condition := range conditions {
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, condition.Type)
+	}
+}
+
+func TestValidatePermissions_AutomatedRollbackWindow(t *testing.T) {
+	t.Parallel()
+	spec := argoappv1.ApplicationSpec{
+		Source: &argoappv1.ApplicationSource{RepoURL: "https://example.com/repo", Path: "app"},
+		Destination: argoappv1.ApplicationDestination{
+			Server:    "https://127.0.0.1:6443",
+			Namespace: "default",
+		},
+		SyncPolicy: &argoappv1.SyncPolicy{AutomatedRollback: &argoappv1.AutomatedRollback{Window: "invalid"}},
+	}
+	proj := argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: argoappv1.AppProjectSpec{
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SourceRepos:  []string{"*"},
+		},
+	}
+	db := &dbmocks.ArgoDB{}
+	db.EXPECT().GetCluster(mock.Anything, spec.Destination.Server).Return(&argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}, nil).Maybe()
+
+	conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+	require.NoError(t, err)
+	require.Len(t, conditions, 1)
+	assert.Equal(t, "spec.syncPolicy.automatedRollback.window is invalid: unable to parse invalid as a duration", conditions[0].Message)
+}
+
+func TestValidatePermissions_IgnoreDifferencesCELCondition(t *testing.T) {
+	t.Parallel()
+	spec := argoappv1.ApplicationSpec{
+		Source: &argoappv1.ApplicationSource{RepoURL: "https://example.com/repo", Path: "app"},
+		Destination: argoappv1.ApplicationDestination{
+			Server:    "https://127.0.0.1:6443",
+			Namespace: "default",
+		},
+		IgnoreDifferences: argoappv1.IgnoreDifferences{{
+			Group:          "apps",
+			Kind:           "Deployment",
+			CELExpressions: []string{`"/spec/replicas"`},
+			CELCondition:   `object.metadata.name == "foo"`,
+		}, {
+			Group:             "apps",
+			Kind:              "Deployment",
+			JQPathExpressions: []string{".spec.replicas"},
+			CELCondition:      `object.metadata.name == "foo"`,
+		}},
+	}
+	proj := argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: argoappv1.AppProjectSpec{
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SourceRepos:  []string{"*"},
+		},
+	}
+	db := &dbmocks.ArgoDB{}
+	db.EXPECT().GetCluster(mock.Anything, spec.Destination.Server).Return(&argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}, nil).Maybe()
+
+	conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+	require.NoError(t, err)
+	require.Len(t, conditions, 1)
+	assert.Equal(t, "spec.ignoreDifferences[1] is invalid: celCondition requires celExpressions", conditions[0].Message)
+}
+
+func TestValidatePermissions_SyncTimeouts(t *testing.T) {
+	t.Parallel()
+	spec := argoappv1.ApplicationSpec{
+		Source: &argoappv1.ApplicationSource{RepoURL: "https://example.com/repo", Path: "app"},
+		Destination: argoappv1.ApplicationDestination{
+			Server:    "https://127.0.0.1:6443",
+			Namespace: "default",
+		},
+		SyncPolicy: &argoappv1.SyncPolicy{Timeouts: &argoappv1.SyncTimeouts{Operation: "30m", Health: "invalid"}},
+	}
+	proj := argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: argoappv1.AppProjectSpec{
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SourceRepos:  []string{"*"},
+		},
+	}
+	db := &dbmocks.ArgoDB{}
+	db.EXPECT().GetCluster(mock.Anything, spec.Destination.Server).Return(&argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}, nil).Maybe()
+
+	conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+	require.NoError(t, err)
+	require.Len(t, conditions, 1)
+	assert.Equal(t, "spec.syncPolicy.timeouts.health is invalid: unable to parse invalid as a duration", conditions[0].Message)
+}
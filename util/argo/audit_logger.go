@@ -37,13 +37,19 @@ type ObjectRef struct {
 }
 
 const (
-	EventReasonStatusRefreshed    = "StatusRefreshed"
-	EventReasonResourceCreated    = "ResourceCreated"
-	EventReasonResourceUpdated    = "ResourceUpdated"
-	EventReasonResourceDeleted    = "ResourceDeleted"
-	EventReasonResourceActionRan  = "ResourceActionRan"
-	EventReasonOperationStarted   = "OperationStarted"
-	EventReasonOperationCompleted = "OperationCompleted"
+	EventReasonStatusRefreshed             = "StatusRefreshed"
+	EventReasonResourceCreated             = "ResourceCreated"
+	EventReasonResourceUpdated             = "ResourceUpdated"
+	EventReasonResourceDeleted             = "ResourceDeleted"
+	EventReasonResourceActionRan           = "ResourceActionRan"
+	EventReasonOperationStarted            = "OperationStarted"
+	EventReasonOperationCompleted          = "OperationCompleted"
+	EventReasonAutomatedRollback           = "AutomatedRollback"
+	EventReasonOrphanedResourcePruned      = "OrphanedResourcePruned"
+	EventReasonOrphanedResourcePruneDryRun = "OrphanedResourcePruneDryRun"
+	EventReasonOrphanedResourceAdopted     = "OrphanedResourceAdopted"
+	EventReasonOrphanedResourceFailed      = "OrphanedResourceFailed"
+	EventReasonProjectRoleGrantUsed        = "ProjectRoleGrantUsed"
 )
 
 func (l *AuditLogger) logEvent(objMeta ObjectRef, gvk schema.GroupVersionKind, info EventInfo, message string, logFields map[string]string, eventLabels map[string]string) {
@@ -16,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/cel"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 )
 
@@ -28,6 +29,8 @@ type normalizerPatch interface {
 	GetGroupKind() schema.GroupKind
 	GetNamespace() string
 	GetName() string
+	// GetCondition returns the CEL condition the resource has to satisfy, or nil if the patch applies unconditionally
+	GetCondition() *cel.Program
 	// Apply(un *unstructured.Unstructured) (error)
 	Apply(data []byte) ([]byte, error)
 }
@@ -36,6 +39,7 @@ type baseNormalizerPatch struct {
 	groupKind schema.GroupKind
 	namespace string
 	name      string
+	condition *cel.Program
 }
 
 func (np *baseNormalizerPatch) GetGroupKind() schema.GroupKind {
@@ -50,6 +54,10 @@ func (np *baseNormalizerPatch) GetName() string {
 	return np.name
 }
 
+func (np *baseNormalizerPatch) GetCondition() *cel.Program {
+	return np.condition
+}
+
 type jsonPatchNormalizerPatch struct {
 	baseNormalizerPatch
 	patch *jsonpatch.Patch
@@ -102,6 +110,43 @@ func (np *jqNormalizerPatch) Apply(data []byte) ([]byte, error) {
 	return patchedData, err
 }
 
+type celNormalizerPatch struct {
+	baseNormalizerPatch
+	program *cel.Program
+}
+
+func (np *celNormalizerPatch) Apply(data []byte) ([]byte, error) {
+	dataJSON := make(map[string]any)
+	err := json.Unmarshal(data, &dataJSON)
+	if err != nil {
+		return nil, err
+	}
+	paths, err := np.program.EvalStrings(dataJSON)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		patchData, err := json.Marshal([]map[string]string{{"op": "remove", "path": path}})
+		if err != nil {
+			return nil, err
+		}
+		patch, err := jsonpatch.DecodePatch(patchData)
+		if err != nil {
+			return nil, err
+		}
+		patchedData, err := patch.Apply(data)
+		if err != nil {
+			// the expression may return fields which are only set in either the desired or the live state
+			if shouldLogError(err) {
+				return nil, err
+			}
+			continue
+		}
+		data = patchedData
+	}
+	return data, nil
+}
+
 type ignoreNormalizer struct {
 	patches []normalizerPatch
 }
@@ -124,10 +169,15 @@ func NewIgnoreNormalizer(ignore []v1alpha1.ResourceIgnoreDifferences, overrides
 		if err != nil {
 			log.Warn(err)
 		}
-		if len(override.IgnoreDifferences.JSONPointers) > 0 || len(override.IgnoreDifferences.JQPathExpressions) > 0 {
+		if err := override.IgnoreDifferences.ValidateCELCondition(); err != nil {
+			return nil, fmt.Errorf("invalid ignoreDifferences of the resource override %q: %w", key, err)
+		}
+		if len(override.IgnoreDifferences.JSONPointers) > 0 || len(override.IgnoreDifferences.JQPathExpressions) > 0 || len(override.IgnoreDifferences.CELExpressions) > 0 {
 			resourceIgnoreDifference := v1alpha1.ResourceIgnoreDifferences{
-				Group: group,
-				Kind:  kind,
+				Group:          group,
+				Kind:           kind,
+				CELExpressions: override.IgnoreDifferences.CELExpressions,
+				CELCondition:   override.IgnoreDifferences.CELCondition,
 			}
 			if len(override.IgnoreDifferences.JSONPointers) > 0 {
 				resourceIgnoreDifference.JSONPointers = override.IgnoreDifferences.JSONPointers
@@ -140,6 +190,17 @@ func NewIgnoreNormalizer(ignore []v1alpha1.ResourceIgnoreDifferences, overrides
 	}
 	patches := make([]normalizerPatch, 0)
 	for i := range ignore {
+		if err := ignore[i].ValidateCELCondition(); err != nil {
+			return nil, fmt.Errorf("invalid ignoreDifferences of %s: %w", schema.GroupKind{Group: ignore[i].Group, Kind: ignore[i].Kind}, err)
+		}
+		var condition *cel.Program
+		if ignore[i].CELCondition != "" {
+			var err error
+			condition, err = cel.Compile(ignore[i].CELCondition)
+			if err != nil {
+				return nil, err
+			}
+		}
 		for _, path := range ignore[i].JSONPointers {
 			patchData, err := json.Marshal([]map[string]string{{"op": "remove", "path": path}})
 			if err != nil {
@@ -177,6 +238,21 @@ func NewIgnoreNormalizer(ignore []v1alpha1.ResourceIgnoreDifferences, overrides
 				jqExecutionTimeout: opts.getJQExecutionTimeout(),
 			})
 		}
+		for _, expression := range ignore[i].CELExpressions {
+			program, err := cel.Compile(expression)
+			if err != nil {
+				return nil, err
+			}
+			patches = append(patches, &celNormalizerPatch{
+				baseNormalizerPatch: baseNormalizerPatch{
+					groupKind: schema.GroupKind{Group: ignore[i].Group, Kind: ignore[i].Kind},
+					name:      ignore[i].Name,
+					namespace: ignore[i].Namespace,
+					condition: condition,
+				},
+				program: program,
+			})
+		}
 	}
 	return &ignoreNormalizer{patches: patches}, nil
 }
@@ -187,13 +263,15 @@ func (n *ignoreNormalizer) Normalize(un *unstructured.Unstructured) error {
 		return errors.New("invalid argument: unstructured is nil")
 	}
 	matched := make([]normalizerPatch, 0)
+	conditions := make(map[*cel.Program]bool)
 	for _, patch := range n.patches {
 		groupKind := un.GroupVersionKind().GroupKind()
 
 		if glob.Match(patch.GetGroupKind().Group, groupKind.Group) &&
 			glob.Match(patch.GetGroupKind().Kind, groupKind.Kind) &&
 			(patch.GetName() == "" || patch.GetName() == un.GetName()) &&
-			(patch.GetNamespace() == "" || patch.GetNamespace() == un.GetNamespace()) {
+			(patch.GetNamespace() == "" || patch.GetNamespace() == un.GetNamespace()) &&
+			n.matchesCondition(un, patch.GetCondition(), conditions) {
 			matched = append(matched, patch)
 		}
 	}
@@ -230,6 +308,29 @@ func (n *ignoreNormalizer) Normalize(un *unstructured.Unstructured) error {
 	return nil
 }
 
+// matchesCondition returns whether the resource satisfies the given CEL condition. The results are recorded in the
+// given map, so that each condition is evaluated once per resource.
+func (n *ignoreNormalizer) matchesCondition(un *unstructured.Unstructured, condition *cel.Program, results map[*cel.Program]bool) bool {
+	if condition == nil {
+		return true
+	}
+	if matches, ok := results[condition]; ok {
+		return matches
+	}
+	matches, err := condition.EvalBool(un.Object)
+	if err != nil {
+		gvk := un.GroupVersionKind()
+		log.WithFields(log.Fields{
+			"group":     gvk.Group,
+			"kind":      gvk.Kind,
+			"name":      un.GetName(),
+			"namespace": un.GetNamespace(),
+		}).Debugf("Failed to evaluate normalization condition: %v", err)
+	}
+	results[condition] = matches
+	return matches
+}
+
 func shouldLogError(e error) bool {
 	if strings.Contains(e.Error(), "Unable to remove nonexistent key") {
 		return false
@@ -197,6 +197,88 @@ func TestNormalizeJQPathExpression(t *testing.T) {
 	assert.Equal(t, "init-container-1", actualInitContainerName)
 }
 
+func TestNormalizeCELExpression(t *testing.T) {
+	t.Parallel()
+	normalizer, err := NewIgnoreNormalizer([]v1alpha1.ResourceIgnoreDifferences{{
+		Group: "apps",
+		Kind:  "Deployment",
+		CELExpressions: []string{
+			`object.metadata.annotations.filter(k, k.startsWith("example.com/")).map(k, "/metadata/annotations/" + k.replace("/", "~1"))`,
+			`"/spec/missing"`,
+		},
+	}}, make(map[string]v1alpha1.ResourceOverride), IgnoreNormalizerOpts{})
+	require.NoError(t, err)
+
+	deployment := test.NewDeployment()
+	deployment.SetAnnotations(map[string]string{"example.com/revision": "1", "example.com/owner": "me", "other": "kept"})
+
+	err = normalizer.Normalize(deployment)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"other": "kept"}, deployment.GetAnnotations())
+}
+
+func TestNormalizeCELCondition(t *testing.T) {
+	t.Parallel()
+	normalizer, err := NewIgnoreNormalizer(nil, map[string]v1alpha1.ResourceOverride{
+		"apps/Deployment": {
+			IgnoreDifferences: v1alpha1.OverrideIgnoreDiff{
+				CELExpressions: []string{`"/spec/replicas"`},
+				CELCondition:   `has(object.metadata.labels) && object.metadata.labels["autoscaling"] == "enabled"`,
+			},
+		},
+	}, IgnoreNormalizerOpts{})
+	require.NoError(t, err)
+
+	deployment := test.NewDeployment()
+	err = normalizer.Normalize(deployment)
+	require.NoError(t, err)
+	_, has, err := unstructured.NestedFieldNoCopy(deployment.Object, "spec", "replicas")
+	require.NoError(t, err)
+	assert.True(t, has, "replicas must not be ignored unless the condition is met")
+
+	deployment.SetLabels(map[string]string{"autoscaling": "enabled"})
+	err = normalizer.Normalize(deployment)
+	require.NoError(t, err)
+	_, has, err = unstructured.NestedFieldNoCopy(deployment.Object, "spec", "replicas")
+	require.NoError(t, err)
+	assert.False(t, has)
+}
+
+func TestNormalizeIllegalCELExpression(t *testing.T) {
+	t.Parallel()
+	_, err := NewIgnoreNormalizer([]v1alpha1.ResourceIgnoreDifferences{{
+		Kind:           "Deployment",
+		CELExpressions: []string{`object.metadata.name +`},
+	}}, make(map[string]v1alpha1.ResourceOverride), IgnoreNormalizerOpts{})
+	require.Error(t, err)
+
+	_, err = NewIgnoreNormalizer([]v1alpha1.ResourceIgnoreDifferences{{
+		Kind:           "Deployment",
+		CELExpressions: []string{`"/spec/replicas"`},
+		CELCondition:   `object.metadata.name ==`,
+	}}, make(map[string]v1alpha1.ResourceOverride), IgnoreNormalizerOpts{})
+	require.Error(t, err)
+
+	// the condition only applies to CEL expressions
+	_, err = NewIgnoreNormalizer([]v1alpha1.ResourceIgnoreDifferences{{
+		Kind:           "Deployment",
+		JSONPointers:   []string{"/metadata/annotations"},
+		CELExpressions: []string{`"/spec/replicas"`},
+		CELCondition:   `object.metadata.name == "foo"`,
+	}}, make(map[string]v1alpha1.ResourceOverride), IgnoreNormalizerOpts{})
+	require.ErrorContains(t, err, "cannot be used along with jsonPointers")
+
+	_, err = NewIgnoreNormalizer(nil, map[string]v1alpha1.ResourceOverride{
+		"apps/Deployment": {
+			IgnoreDifferences: v1alpha1.OverrideIgnoreDiff{
+				ManagedFieldsManagers: []string{"kube-controller-manager"},
+				CELCondition:          `object.metadata.name == "foo"`,
+			},
+		},
+	}, IgnoreNormalizerOpts{})
+	require.ErrorContains(t, err, "celCondition requires celExpressions")
+}
+
 func TestNormalizeIllegalJQPathExpression(t *testing.T) {
 	t.Parallel()
 	_, err := NewIgnoreNormalizer([]v1alpha1.ResourceIgnoreDifferences{{
@@ -0,0 +1,484 @@
+package argo
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applicationsv1 "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+)
+
+// GetProjectAncestors returns the ancestors of a project, from its parent to the root of its hierarchy. It fails if a
+// parent project does not exist or if the hierarchy contains a cycle.
+func GetProjectAncestors(proj *argoappv1.AppProject, projLister applicationsv1.AppProjectLister) ([]*argoappv1.AppProject, error) {
+	var ancestors []*argoappv1.AppProject
+	visited := map[string]bool{proj.Name: true}
+	current := proj
+	for current.Spec.Parent != "" {
+		if visited[current.Spec.Parent] {
+			return nil, fmt.Errorf("parent project %q of project %q creates a cycle in the project hierarchy", current.Spec.Parent, current.Name)
+		}
+		parent, err := projLister.AppProjects(proj.Namespace).Get(current.Spec.Parent)
+		if err != nil {
+			return nil, fmt.Errorf("error getting parent project %q of project %q: %w", current.Spec.Parent, current.Name, err)
+		}
+		visited[parent.Name] = true
+		ancestors = append(ancestors, parent)
+		current = parent
+	}
+	return ancestors, nil
+}
+
+// GetProjectDescendants returns the names of the projects which inherit, directly or indirectly, from the given project
+func GetProjectDescendants(name string, projects []*argoappv1.AppProject) []string {
+	children := map[string][]string{}
+	for _, proj := range projects {
+		if proj.Spec.Parent != "" {
+			children[proj.Spec.Parent] = append(children[proj.Spec.Parent], proj.Name)
+		}
+	}
+	var descendants []string
+	visited := map[string]bool{name: true}
+	queue := []string{name}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, child := range children[current] {
+			if visited[child] {
+				continue
+			}
+			visited[child] = true
+			descendants = append(descendants, child)
+			queue = append(queue, child)
+		}
+	}
+	return descendants
+}
+
+// GetEffectiveProject returns the project merged with the policies it inherits from its ancestors, along with the
+// project of the hierarchy which defines each rule of the effective project.
+func GetEffectiveProject(proj *argoappv1.AppProject, projLister applicationsv1.AppProjectLister) (*argoappv1.AppProject, []*argoappv1.ProjectRuleOrigin, error) {
+	ancestors, err := GetProjectAncestors(proj, projLister)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the hierarchy is merged from its root down to the project
+	hierarchy := slices.Clone(ancestors)
+	slices.Reverse(hierarchy)
+	hierarchy = append(hierarchy, proj)
+
+	origins := map[projectRule]string{}
+	var spec *argoappv1.AppProjectSpec
+	for _, p := range hierarchy {
+		for _, rule := range getProjectRules(&p.Spec) {
+			if _, ok := origins[rule]; !ok {
+				origins[rule] = p.Name
+			}
+		}
+		childSpec := p.Spec.DeepCopy()
+		if spec != nil {
+			inheritProjectSpec(childSpec, spec)
+		}
+		spec = childSpec
+	}
+
+	effective := proj.DeepCopy()
+	effective.Spec = *spec
+	var res []*argoappv1.ProjectRuleOrigin
+	for _, rule := range getProjectRules(&effective.Spec) {
+		project, ok := origins[rule]
+		if !ok {
+			project = proj.Name
+		}
+		res = append(res, &argoappv1.ProjectRuleOrigin{Field: rule.field, Rule: rule.rule, Project: project})
+	}
+	return effective, res, nil
+}
+
+// inheritProjectSpec merges the effective spec of the parent project into the spec of a child project. The allow lists
+// are intersected, the deny lists and sync windows are merged, and the settings of the parent take precedence over the
+// ones of the child, so that the child can only restrict the policies of its parent.
+func inheritProjectSpec(spec *argoappv1.AppProjectSpec, parent *argoappv1.AppProjectSpec) {
+	spec.SourceRepos = intersectRules(parent.SourceRepos, spec.SourceRepos, isDenyPattern, coversPattern)
+	spec.Destinations = intersectRules(parent.Destinations, spec.Destinations, isDenyDestination, coversDestination)
+	spec.SourceNamespaces = intersectRules(parent.SourceNamespaces, spec.SourceNamespaces, isDenyPattern, coversSourceNamespace)
+	spec.ClusterResourceWhitelist = intersectRules(parent.ClusterResourceWhitelist, spec.ClusterResourceWhitelist, nil, coversClusterResource)
+	// unlike the other allow lists, a namespace resource whitelist which is not set allows all the resources, while an
+	// empty one allows none of them
+	if parent.NamespaceResourceWhitelist != nil {
+		spec.NamespaceResourceWhitelist = intersectRules(parent.NamespaceResourceWhitelist, spec.NamespaceResourceWhitelist, nil, coversNamespaceResource)
+	}
+	spec.ClusterResourceBlacklist = unionRules(parent.ClusterResourceBlacklist, spec.ClusterResourceBlacklist)
+	spec.NamespaceResourceBlacklist = unionRules(parent.NamespaceResourceBlacklist, spec.NamespaceResourceBlacklist)
+	spec.SyncWindows = inheritSyncWindows(parent.SyncWindows, spec.SyncWindows)
+	spec.SourceIntegrity = inheritSourceIntegrity(parent, spec)
+	spec.SignatureKeys = inheritSignatureKeys(parent.SignatureKeys, spec.SignatureKeys)
+	if parent.OrphanedResources != nil {
+		spec.OrphanedResources = parent.OrphanedResources.DeepCopy()
+	}
+	spec.PermitOnlyProjectScopedClusters = spec.PermitOnlyProjectScopedClusters || parent.PermitOnlyProjectScopedClusters
+	spec.Quotas = inheritQuotas(parent.Quotas, spec.Quotas)
+	spec.NamespaceTemplate = inheritNamespaceTemplate(parent.NamespaceTemplate, spec.NamespaceTemplate)
+}
+
+// intersectRules returns the rules of an allow list which are permitted by both the parent and the child. An empty list
+// in the parent allows nothing, and a list which is not set in the child is inherited, while an empty one is kept
+// empty. The deny rules of both lists are kept, and an allow rule is kept if it is covered by an allow rule of the other
+// list. The result is never nil, so that an empty intersection is not mistaken for a list which is not set.
+func intersectRules[T any](parent []T, child []T, isDeny func(T) bool, covers func(T, T) bool) []T {
+	if len(parent) == 0 {
+		return []T{}
+	}
+	if child == nil {
+		return slices.Clone(parent)
+	}
+	isAllow := func(rule T) bool {
+		return isDeny == nil || !isDeny(rule)
+	}
+	res := []T{}
+	for _, rule := range parent {
+		if !isAllow(rule) {
+			res = append(res, rule)
+		}
+	}
+	for _, rule := range child {
+		if !isAllow(rule) {
+			res = appendRule(res, rule)
+		}
+	}
+	for _, c := range child {
+		if !isAllow(c) {
+			continue
+		}
+		for _, p := range parent {
+			if isAllow(p) && covers(p, c) {
+				res = appendRule(res, c)
+				break
+			}
+		}
+	}
+	for _, p := range parent {
+		if !isAllow(p) {
+			continue
+		}
+		for _, c := range child {
+			// rules which cover each other have already been added from the child
+			if isAllow(c) && covers(c, p) && !covers(p, c) {
+				res = appendRule(res, p)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// unionRules returns the rules of a deny list of the parent, followed by the additional ones of the child
+func unionRules[T any](parent []T, child []T) []T {
+	res := slices.Clone(parent)
+	for _, rule := range child {
+		res = appendRule(res, rule)
+	}
+	return res
+}
+
+func appendRule[T any](rules []T, rule T) []T {
+	for _, r := range rules {
+		if reflect.DeepEqual(r, rule) {
+			return rules
+		}
+	}
+	return append(rules, rule)
+}
+
+func isDenyPattern(pattern string) bool {
+	return strings.HasPrefix(pattern, "!")
+}
+
+// coversPattern returns true if every value matched by the child pattern is matched by the parent pattern
+func coversPattern(parent string, child string) bool {
+	return parent == child || parent == "*" || glob.Match(parent, child)
+}
+
+func isDenyDestination(dest argoappv1.ApplicationDestination) bool {
+	return isDenyPattern(dest.Server) || isDenyPattern(dest.Name) || isDenyPattern(dest.Namespace)
+}
+
+func coversDestination(parent argoappv1.ApplicationDestination, child argoappv1.ApplicationDestination) bool {
+	if !coversPattern(parent.Namespace, child.Namespace) {
+		return false
+	}
+	if parent.ClusterSelector != nil {
+		// a cluster selector only covers the destinations which use the same selector
+		if child.ClusterSelector == nil || metav1.FormatLabelSelector(parent.ClusterSelector) != metav1.FormatLabelSelector(child.ClusterSelector) {
+			return false
+		}
+		return (parent.Server == "" || coversPattern(parent.Server, child.Server)) && (parent.Name == "" || coversPattern(parent.Name, child.Name))
+	}
+	childServer, childName := child.Server, child.Name
+	if child.ClusterSelector != nil && childServer == "" && childName == "" {
+		// a cluster selector without server and name can select any cluster
+		childServer, childName = "*", "*"
+	}
+	serverCovered := parent.Server != "" && childServer != "" && coversPattern(parent.Server, childServer)
+	nameCovered := parent.Name != "" && childName != "" && coversPattern(parent.Name, childName)
+	return serverCovered || nameCovered
+}
+
+func coversSourceNamespace(parent string, child string) bool {
+	return parent == child || glob.MatchStringInList([]string{parent}, child, glob.REGEXP)
+}
+
+func coversResourcePattern(parent string, child string) bool {
+	if parent == "" {
+		parent = "*"
+	}
+	if child == "" {
+		child = "*"
+	}
+	return parent == child || parent == "*" || glob.Match(parent, child)
+}
+
+func coversClusterResource(parent argoappv1.ClusterResourceRestrictionItem, child argoappv1.ClusterResourceRestrictionItem) bool {
+	return coversResourcePattern(parent.Group, child.Group) && coversResourcePattern(parent.Kind, child.Kind) && coversResourcePattern(parent.Name, child.Name)
+}
+
+func coversNamespaceResource(parent argoappv1.NamespaceResourceRestrictionItem, child argoappv1.NamespaceResourceRestrictionItem) bool {
+	if parent.LabelSelector != nil && !reflect.DeepEqual(parent.LabelSelector, child.LabelSelector) {
+		return false
+	}
+	return coversResourcePattern(parent.Group, child.Group) && coversResourcePattern(parent.Kind, child.Kind) && coversResourcePattern(parent.Name, child.Name)
+}
+
+// inheritSyncWindows returns the sync windows of the parent, followed by the deny windows of the child. The allow
+// windows of the child are only kept if the parent has no allow window, since they would otherwise widen the periods
+// during which syncs are allowed.
+func inheritSyncWindows(parent argoappv1.SyncWindows, child argoappv1.SyncWindows) argoappv1.SyncWindows {
+	var res argoappv1.SyncWindows
+	parentHasAllows := false
+	for _, w := range parent {
+		res = append(res, w.DeepCopy())
+		if w.Kind == "allow" {
+			parentHasAllows = true
+		}
+	}
+	for _, w := range child {
+		if w.Kind == "allow" && parentHasAllows {
+			continue
+		}
+		res = appendRule(res, w)
+	}
+	return res
+}
+
+// inheritSourceIntegrity returns the git policies of the parent followed by the ones of the child. Since several
+// policies matching the same repository fail the verification, the child cannot weaken the policies of its parent.
+func inheritSourceIntegrity(parentSpec *argoappv1.AppProjectSpec, childSpec *argoappv1.AppProjectSpec) *argoappv1.SourceIntegrity {
+	parent, child := parentSpec.SourceIntegrity, childSpec.SourceIntegrity
+	if (parent == nil || parent.Git == nil) && len(parentSpec.SignatureKeys) > 0 && child != nil && child.Git != nil {
+		// the signature keys are ignored once git policies are defined, so the ones of the parent are migrated to a
+		// git policy rather than being dropped in favor of the policies of the child
+		parent = (&argoappv1.AppProject{Spec: *parentSpec}).EffectiveSourceIntegrity()
+	}
+	if parent == nil || parent.Git == nil {
+		return child
+	}
+	res := parent.DeepCopy()
+	if child != nil && child.Git != nil {
+		for _, policy := range child.Git.Policies {
+			res.Git.Policies = appendRule(res.Git.Policies, policy)
+		}
+	}
+	return res
+}
+
+// inheritSignatureKeys returns the deprecated signature keys of the child which are also keys of the parent. The keys
+// of the parent are inherited if the child has none, or none of the keys of the parent.
+func inheritSignatureKeys(parent []argoappv1.SignatureKey, child []argoappv1.SignatureKey) []argoappv1.SignatureKey {
+	if len(parent) == 0 {
+		return child
+	}
+	var res []argoappv1.SignatureKey
+	for _, key := range child {
+		if slices.Contains(parent, key) {
+			res = appendRule(res, key)
+		}
+	}
+	if len(res) == 0 {
+		return slices.Clone(parent)
+	}
+	return res
+}
+
+// inheritQuotas returns the lowest of the limits of the parent and of the child
+func inheritQuotas(parent *argoappv1.ProjectQuotas, child *argoappv1.ProjectQuotas) *argoappv1.ProjectQuotas {
+	if parent == nil {
+		return child
+	}
+	if child == nil {
+		return parent.DeepCopy()
+	}
+	minLimit := func(parent int64, child int64) int64 {
+		if parent > 0 && (child <= 0 || parent < child) {
+			return parent
+		}
+		return child
+	}
+	return &argoappv1.ProjectQuotas{
+		MaxApplications:            minLimit(parent.MaxApplications, child.MaxApplications),
+		MaxResourcesPerApplication: minLimit(parent.MaxResourcesPerApplication, child.MaxResourcesPerApplication),
+		MaxManagedResources:        minLimit(parent.MaxManagedResources, child.MaxManagedResources),
+		MaxManifestSizeBytes:       minLimit(parent.MaxManifestSizeBytes, child.MaxManifestSizeBytes),
+	}
+}
+
+// inheritNamespaceTemplate returns the namespace template of the child, in which the labels, annotations and resources
+// defined by the parent take precedence
+func inheritNamespaceTemplate(parent *argoappv1.ProjectNamespaceTemplate, child *argoappv1.ProjectNamespaceTemplate) *argoappv1.ProjectNamespaceTemplate {
+	if parent == nil {
+		return child
+	}
+	if child == nil {
+		return parent.DeepCopy()
+	}
+	res := child.DeepCopy()
+	if len(parent.Labels) > 0 {
+		res.Labels = mergeStringMaps(res.Labels, parent.Labels)
+	}
+	if len(parent.Annotations) > 0 {
+		res.Annotations = mergeStringMaps(res.Annotations, parent.Annotations)
+	}
+	if parent.ResourceQuota != nil {
+		res.ResourceQuota = parent.ResourceQuota.DeepCopy()
+	}
+	if parent.LimitRange != nil {
+		res.LimitRange = parent.LimitRange.DeepCopy()
+	}
+	res.DefaultDenyNetworkPolicy = res.DefaultDenyNetworkPolicy || parent.DefaultDenyNetworkPolicy
+	return res
+}
+
+// mergeStringMaps returns the entries of both maps, the ones of the second map taking precedence
+func mergeStringMaps(a map[string]string, b map[string]string) map[string]string {
+	res := map[string]string{}
+	maps.Copy(res, a)
+	maps.Copy(res, b)
+	return res
+}
+
+// projectRule is a rule of one of the inherited fields of a project spec
+type projectRule struct {
+	field string
+	rule  string
+}
+
+// getProjectRules returns the rules of the inherited fields of a project spec
+func getProjectRules(spec *argoappv1.AppProjectSpec) []projectRule {
+	var rules []projectRule
+	add := func(field string, rule string) {
+		rules = append(rules, projectRule{field: field, rule: rule})
+	}
+	for _, repo := range spec.SourceRepos {
+		add("sourceRepos", repo)
+	}
+	for _, dest := range spec.Destinations {
+		server := dest.Server
+		if server == "" {
+			server = dest.Name
+		}
+		if dest.ClusterSelector != nil {
+			server = fmt.Sprintf("%s[%s]", server, metav1.FormatLabelSelector(dest.ClusterSelector))
+		}
+		add("destinations", fmt.Sprintf("%s,%s", server, dest.Namespace))
+	}
+	for _, ns := range spec.SourceNamespaces {
+		add("sourceNamespaces", ns)
+	}
+	for _, item := range spec.ClusterResourceWhitelist {
+		add("clusterResourceWhitelist", formatRestrictionItem(item.Group, item.Kind, item.Name, nil))
+	}
+	for _, item := range spec.ClusterResourceBlacklist {
+		add("clusterResourceBlacklist", formatRestrictionItem(item.Group, item.Kind, item.Name, nil))
+	}
+	for _, item := range spec.NamespaceResourceWhitelist {
+		add("namespaceResourceWhitelist", formatRestrictionItem(item.Group, item.Kind, item.Name, item.LabelSelector))
+	}
+	for _, item := range spec.NamespaceResourceBlacklist {
+		add("namespaceResourceBlacklist", formatRestrictionItem(item.Group, item.Kind, item.Name, item.LabelSelector))
+	}
+	for _, w := range spec.SyncWindows {
+		add("syncWindows", fmt.Sprintf("%s %s %s", w.Kind, w.Schedule, w.Duration))
+	}
+	if spec.SourceIntegrity != nil && spec.SourceIntegrity.Git != nil {
+		for _, policy := range spec.SourceIntegrity.Git.Policies {
+			var repos []string
+			for _, repo := range policy.Repos {
+				repos = append(repos, repo.URL)
+			}
+			mode := ""
+			if policy.GPG != nil {
+				mode = string(policy.GPG.Mode)
+			}
+			add("sourceIntegrity.git.policies", fmt.Sprintf("%s gpg=%s", strings.Join(repos, ","), mode))
+		}
+	}
+	for _, key := range spec.SignatureKeys {
+		add("signatureKeys", key.KeyID)
+	}
+	if spec.OrphanedResources != nil {
+		add("orphanedResources", fmt.Sprintf("warn=%t mode=%s", spec.OrphanedResources.IsWarn(), spec.OrphanedResources.Mode))
+	}
+	if spec.PermitOnlyProjectScopedClusters {
+		add("permitOnlyProjectScopedClusters", "true")
+	}
+	if spec.Quotas != nil {
+		for _, quota := range []struct {
+			field string
+			limit int64
+		}{
+			{"quotas.maxApplications", spec.Quotas.MaxApplications},
+			{"quotas.maxResourcesPerApplication", spec.Quotas.MaxResourcesPerApplication},
+			{"quotas.maxManagedResources", spec.Quotas.MaxManagedResources},
+			{"quotas.maxManifestSizeBytes", spec.Quotas.MaxManifestSizeBytes},
+		} {
+			if quota.limit > 0 {
+				add(quota.field, strconv.FormatInt(quota.limit, 10))
+			}
+		}
+	}
+	if spec.NamespaceTemplate != nil {
+		for _, k := range slices.Sorted(maps.Keys(spec.NamespaceTemplate.Labels)) {
+			add("namespaceTemplate.labels", fmt.Sprintf("%s=%s", k, spec.NamespaceTemplate.Labels[k]))
+		}
+		for _, k := range slices.Sorted(maps.Keys(spec.NamespaceTemplate.Annotations)) {
+			add("namespaceTemplate.annotations", fmt.Sprintf("%s=%s", k, spec.NamespaceTemplate.Annotations[k]))
+		}
+		if spec.NamespaceTemplate.ResourceQuota != nil {
+			add("namespaceTemplate.resourceQuota", spec.NamespaceTemplate.ResourceQuota.String())
+		}
+		if spec.NamespaceTemplate.LimitRange != nil {
+			add("namespaceTemplate.limitRange", spec.NamespaceTemplate.LimitRange.String())
+		}
+		if spec.NamespaceTemplate.DefaultDenyNetworkPolicy {
+			add("namespaceTemplate.defaultDenyNetworkPolicy", "true")
+		}
+	}
+	return rules
+}
+
+func formatRestrictionItem(group string, kind string, name string, selector *metav1.LabelSelector) string {
+	res := fmt.Sprintf("%s/%s", group, kind)
+	if name != "" {
+		res = fmt.Sprintf("%s/%s", res, name)
+	}
+	if selector != nil {
+		res = fmt.Sprintf("%s %s", res, metav1.FormatLabelSelector(selector))
+	}
+	return res
+}
@@ -0,0 +1,298 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newProjLister(t *testing.T, projs ...*argoappv1.AppProject) applisters.AppProjectLister {
+	t.Helper()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, proj := range projs {
+		require.NoError(t, indexer.Add(proj))
+	}
+	return applisters.NewAppProjectLister(indexer)
+}
+
+func newHierarchyProject(name string, parent string, spec argoappv1.AppProjectSpec) *argoappv1.AppProject {
+	spec.Parent = parent
+	return &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: test.FakeArgoCDNamespace},
+		Spec:       spec,
+	}
+}
+
+func TestGetProjectAncestors(t *testing.T) {
+	root := newHierarchyProject("root", "", argoappv1.AppProjectSpec{})
+	team := newHierarchyProject("team", "root", argoappv1.AppProjectSpec{})
+	app := newHierarchyProject("app", "team", argoappv1.AppProjectSpec{})
+
+	t.Run("Ancestors from parent to root", func(t *testing.T) {
+		ancestors, err := GetProjectAncestors(app, newProjLister(t, root, team, app))
+		require.NoError(t, err)
+		require.Len(t, ancestors, 2)
+		assert.Equal(t, "team", ancestors[0].Name)
+		assert.Equal(t, "root", ancestors[1].Name)
+	})
+
+	t.Run("Missing parent", func(t *testing.T) {
+		_, err := GetProjectAncestors(app, newProjLister(t, team, app))
+		assert.ErrorContains(t, err, `error getting parent project "root" of project "team"`)
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		cyclicRoot := newHierarchyProject("root", "app", argoappv1.AppProjectSpec{})
+		_, err := GetProjectAncestors(app, newProjLister(t, cyclicRoot, team, app))
+		assert.ErrorContains(t, err, `parent project "app" of project "root" creates a cycle in the project hierarchy`)
+	})
+}
+
+func TestGetProjectDescendants(t *testing.T) {
+	projs := []*argoappv1.AppProject{
+		newHierarchyProject("root", "", argoappv1.AppProjectSpec{}),
+		newHierarchyProject("team-a", "root", argoappv1.AppProjectSpec{}),
+		newHierarchyProject("team-b", "root", argoappv1.AppProjectSpec{}),
+		newHierarchyProject("app", "team-a", argoappv1.AppProjectSpec{}),
+		newHierarchyProject("other", "", argoappv1.AppProjectSpec{}),
+	}
+	assert.ElementsMatch(t, []string{"team-a", "team-b", "app"}, GetProjectDescendants("root", projs))
+	assert.Equal(t, []string{"app"}, GetProjectDescendants("team-a", projs))
+	assert.Empty(t, GetProjectDescendants("other", projs))
+}
+
+func TestGetEffectiveProject(t *testing.T) {
+	warn := true
+	root := newHierarchyProject("root", "", argoappv1.AppProjectSpec{
+		SourceRepos: []string{"https://github.com/org/*", "!https://github.com/org/secret"},
+		Destinations: []argoappv1.ApplicationDestination{
+			{Server: "https://kubernetes.default.svc", Namespace: "team-*"},
+		},
+		ClusterResourceBlacklist: []argoappv1.ClusterResourceRestrictionItem{{Group: "", Kind: "Namespace"}},
+		SyncWindows: argoappv1.SyncWindows{
+			{Kind: "allow", Schedule: "0 8 * * *", Duration: "10h", Applications: []string{"*"}},
+		},
+		OrphanedResources: &argoappv1.OrphanedResourcesMonitorSettings{Warn: &warn},
+		Quotas:            &argoappv1.ProjectQuotas{MaxApplications: 10, MaxManagedResources: 1000},
+	})
+	team := newHierarchyProject("team", "root", argoappv1.AppProjectSpec{
+		Description: "team project",
+		SourceRepos: []string{"*"},
+		Destinations: []argoappv1.ApplicationDestination{
+			{Server: "*", Namespace: "*"},
+			{Server: "https://kubernetes.default.svc", Namespace: "team-a"},
+		},
+		ClusterResourceWhitelist: []argoappv1.ClusterResourceRestrictionItem{{Group: "*", Kind: "*"}},
+		ClusterResourceBlacklist: []argoappv1.ClusterResourceRestrictionItem{{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}},
+		SyncWindows: argoappv1.SyncWindows{
+			{Kind: "allow", Schedule: "* * * * *", Duration: "1h", Applications: []string{"*"}},
+			{Kind: "deny", Schedule: "0 22 * * *", Duration: "1h", Applications: []string{"*"}},
+		},
+		OrphanedResources: &argoappv1.OrphanedResourcesMonitorSettings{},
+		Quotas:            &argoappv1.ProjectQuotas{MaxApplications: 20, MaxResourcesPerApplication: 100},
+	})
+
+	effective, origins, err := GetEffectiveProject(team, newProjLister(t, root, team))
+	require.NoError(t, err)
+
+	// fields which are not inherited are the ones of the project
+	assert.Equal(t, "team", effective.Name)
+	assert.Equal(t, "team project", effective.Spec.Description)
+	assert.Equal(t, "root", effective.Spec.Parent)
+	// allow lists are intersected and deny rules are kept
+	assert.Equal(t, []string{"!https://github.com/org/secret", "https://github.com/org/*"}, effective.Spec.SourceRepos)
+	assert.Equal(t, []argoappv1.ApplicationDestination{
+		{Server: "https://kubernetes.default.svc", Namespace: "team-a"},
+		{Server: "https://kubernetes.default.svc", Namespace: "team-*"},
+	}, effective.Spec.Destinations)
+	// an allow list which is not set in the parent allows nothing
+	assert.Empty(t, effective.Spec.ClusterResourceWhitelist)
+	// deny lists are merged
+	assert.Equal(t, []argoappv1.ClusterResourceRestrictionItem{
+		{Group: "", Kind: "Namespace"},
+		{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"},
+	}, effective.Spec.ClusterResourceBlacklist)
+	// allow windows of the child would widen the allow windows of the parent
+	require.Len(t, effective.Spec.SyncWindows, 2)
+	assert.Equal(t, "0 8 * * *", effective.Spec.SyncWindows[0].Schedule)
+	assert.Equal(t, "deny", effective.Spec.SyncWindows[1].Kind)
+	// settings of the parent take precedence
+	assert.True(t, effective.Spec.OrphanedResources.IsWarn())
+	assert.Equal(t, &argoappv1.ProjectQuotas{MaxApplications: 10, MaxResourcesPerApplication: 100, MaxManagedResources: 1000}, effective.Spec.Quotas)
+	// the projects are left untouched
+	assert.Equal(t, []string{"*"}, team.Spec.SourceRepos)
+
+	originsByRule := map[string]string{}
+	for _, origin := range origins {
+		originsByRule[origin.Field+" "+origin.Rule] = origin.Project
+	}
+	assert.Equal(t, "root", originsByRule["sourceRepos https://github.com/org/*"])
+	assert.Equal(t, "team", originsByRule["destinations https://kubernetes.default.svc,team-a"])
+	assert.Equal(t, "root", originsByRule["clusterResourceBlacklist /Namespace"])
+	assert.Equal(t, "team", originsByRule["clusterResourceBlacklist rbac.authorization.k8s.io/ClusterRole"])
+	assert.Equal(t, "team", originsByRule["syncWindows deny 0 22 * * * 1h"])
+	assert.Equal(t, "root", originsByRule["orphanedResources warn=true mode="])
+	assert.Equal(t, "root", originsByRule["quotas.maxApplications 10"])
+	assert.Equal(t, "team", originsByRule["quotas.maxResourcesPerApplication 100"])
+}
+
+func TestGetEffectiveProject_NoParent(t *testing.T) {
+	proj := newHierarchyProject("proj", "", argoappv1.AppProjectSpec{SourceRepos: []string{"*"}})
+	effective, origins, err := GetEffectiveProject(proj, newProjLister(t, proj))
+	require.NoError(t, err)
+	assert.Equal(t, proj, effective)
+	assert.Equal(t, []*argoappv1.ProjectRuleOrigin{{Field: "sourceRepos", Rule: "*", Project: "proj"}}, origins)
+}
+
+func TestGetEffectiveProject_ParentDeniesEverything(t *testing.T) {
+	root := newHierarchyProject("root", "", argoappv1.AppProjectSpec{})
+	team := newHierarchyProject("team", "root", argoappv1.AppProjectSpec{
+		SourceRepos:                []string{"*"},
+		Destinations:               []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		SourceNamespaces:           []string{"*"},
+		ClusterResourceWhitelist:   []argoappv1.ClusterResourceRestrictionItem{{Group: "*", Kind: "*"}},
+		NamespaceResourceWhitelist: []argoappv1.NamespaceResourceRestrictionItem{{Group: "apps", Kind: "Deployment"}},
+	})
+	effective, _, err := GetEffectiveProject(team, newProjLister(t, root, team))
+	require.NoError(t, err)
+
+	assert.Empty(t, effective.Spec.SourceRepos)
+	assert.Empty(t, effective.Spec.Destinations)
+	assert.Empty(t, effective.Spec.SourceNamespaces)
+	assert.Empty(t, effective.Spec.ClusterResourceWhitelist)
+	// a namespace resource whitelist which is not set allows all the resources
+	assert.Equal(t, team.Spec.NamespaceResourceWhitelist, effective.Spec.NamespaceResourceWhitelist)
+}
+
+func TestGetEffectiveProject_EmptyNamespaceResourceWhitelist(t *testing.T) {
+	configMap := schema.GroupKind{Kind: "ConfigMap"}
+
+	// an empty whitelist of the parent denies all the resources, whatever the whitelist of the child
+	root := newHierarchyProject("root", "", argoappv1.AppProjectSpec{
+		NamespaceResourceWhitelist: []argoappv1.NamespaceResourceRestrictionItem{},
+	})
+	team := newHierarchyProject("team", "root", argoappv1.AppProjectSpec{
+		NamespaceResourceWhitelist: []argoappv1.NamespaceResourceRestrictionItem{{Group: "*", Kind: "*"}},
+	})
+	effective, _, err := GetEffectiveProject(team, newProjLister(t, root, team))
+	require.NoError(t, err)
+	assert.NotNil(t, effective.Spec.NamespaceResourceWhitelist)
+	assert.False(t, effective.IsGroupKindNamePermitted(configMap, "cm", true))
+
+	// an empty whitelist of the child is not replaced by the whitelist of the parent
+	root = newHierarchyProject("root", "", argoappv1.AppProjectSpec{
+		NamespaceResourceWhitelist: []argoappv1.NamespaceResourceRestrictionItem{{Kind: "ConfigMap"}},
+	})
+	team = newHierarchyProject("team", "root", argoappv1.AppProjectSpec{
+		NamespaceResourceWhitelist: []argoappv1.NamespaceResourceRestrictionItem{},
+	})
+	effective, _, err = GetEffectiveProject(team, newProjLister(t, root, team))
+	require.NoError(t, err)
+	assert.NotNil(t, effective.Spec.NamespaceResourceWhitelist)
+	assert.False(t, effective.IsGroupKindNamePermitted(configMap, "cm", true))
+
+	// an intersection without common resources denies all the resources
+	team = newHierarchyProject("team", "root", argoappv1.AppProjectSpec{
+		NamespaceResourceWhitelist: []argoappv1.NamespaceResourceRestrictionItem{{Kind: "Secret"}},
+	})
+	effective, _, err = GetEffectiveProject(team, newProjLister(t, root, team))
+	require.NoError(t, err)
+	assert.False(t, effective.IsGroupKindNamePermitted(configMap, "cm", true))
+	assert.False(t, effective.IsGroupKindNamePermitted(schema.GroupKind{Kind: "Secret"}, "secret", true))
+}
+
+func TestGetEffectiveProject_SignatureKeys(t *testing.T) {
+	root := newHierarchyProject("root", "", argoappv1.AppProjectSpec{
+		SignatureKeys: []argoappv1.SignatureKey{{KeyID: "A"}, {KeyID: "B"}},
+	})
+	inherited := newHierarchyProject("inherited", "root", argoappv1.AppProjectSpec{})
+	narrowed := newHierarchyProject("narrowed", "root", argoappv1.AppProjectSpec{
+		SignatureKeys: []argoappv1.SignatureKey{{KeyID: "B"}, {KeyID: "C"}},
+	})
+	unrelated := newHierarchyProject("unrelated", "root", argoappv1.AppProjectSpec{
+		SignatureKeys: []argoappv1.SignatureKey{{KeyID: "C"}},
+	})
+	withPolicies := newHierarchyProject("with-policies", "root", argoappv1.AppProjectSpec{
+		SourceIntegrity: &argoappv1.SourceIntegrity{Git: &argoappv1.SourceIntegrityGit{Policies: []*argoappv1.SourceIntegrityGitPolicy{{
+			Repos: []argoappv1.SourceIntegrityGitPolicyRepo{{URL: "https://github.com/org/*"}},
+			GPG:   &argoappv1.SourceIntegrityGitPolicyGPG{Mode: argoappv1.SourceIntegrityGitPolicyGPGModeHead, Keys: []string{"C"}},
+		}}}},
+	})
+	lister := newProjLister(t, root, inherited, narrowed, unrelated, withPolicies)
+
+	effective, origins, err := GetEffectiveProject(inherited, lister)
+	require.NoError(t, err)
+	assert.Equal(t, root.Spec.SignatureKeys, effective.Spec.SignatureKeys)
+	assert.Contains(t, origins, &argoappv1.ProjectRuleOrigin{Field: "signatureKeys", Rule: "A", Project: "root"})
+
+	effective, _, err = GetEffectiveProject(narrowed, lister)
+	require.NoError(t, err)
+	assert.Equal(t, []argoappv1.SignatureKey{{KeyID: "B"}}, effective.Spec.SignatureKeys)
+
+	effective, _, err = GetEffectiveProject(unrelated, lister)
+	require.NoError(t, err)
+	assert.Equal(t, root.Spec.SignatureKeys, effective.Spec.SignatureKeys)
+
+	// the keys of the parent are not ignored because of the git policies of the child
+	effective, _, err = GetEffectiveProject(withPolicies, lister)
+	require.NoError(t, err)
+	policies := effective.EffectiveSourceIntegrity().Git.Policies
+	require.Len(t, policies, 2)
+	assert.Equal(t, []string{"A", "B"}, policies[0].GPG.Keys)
+	assert.Equal(t, []string{"C"}, policies[1].GPG.Keys)
+}
+
+func Test_intersectRules(t *testing.T) {
+	assert.Empty(t, intersectRules(nil, []string{"a/*"}, isDenyPattern, coversPattern))
+	assert.Equal(t, []string{"a/*"}, intersectRules([]string{"a/*"}, nil, isDenyPattern, coversPattern))
+	// an empty list of the child is kept empty rather than inherited
+	assert.Equal(t, []string{}, intersectRules([]string{"a/*"}, []string{}, isDenyPattern, coversPattern))
+	// rules which are not covered by the other list are dropped
+	assert.Equal(t, []string{"a/b"}, intersectRules([]string{"a/*"}, []string{"a/b", "c/*"}, isDenyPattern, coversPattern))
+	assert.Empty(t, intersectRules([]string{"a/*"}, []string{"c/*"}, isDenyPattern, coversPattern))
+}
+
+func Test_coversDestination(t *testing.T) {
+	shared := &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "shared"}}
+	dev := &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}}
+
+	assert.True(t, coversDestination(
+		argoappv1.ApplicationDestination{Server: "*", Namespace: "*"},
+		argoappv1.ApplicationDestination{Namespace: "team-a", ClusterSelector: shared}))
+	assert.False(t, coversDestination(
+		argoappv1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "*"},
+		argoappv1.ApplicationDestination{Namespace: "team-a", ClusterSelector: shared}))
+	assert.True(t, coversDestination(
+		argoappv1.ApplicationDestination{Server: "https://eu-*", Namespace: "*"},
+		argoappv1.ApplicationDestination{Server: "https://eu-1", Namespace: "team-a", ClusterSelector: shared}))
+	// a cluster selector only covers destinations with the same selector
+	assert.True(t, coversDestination(
+		argoappv1.ApplicationDestination{Namespace: "team-*", ClusterSelector: shared},
+		argoappv1.ApplicationDestination{Namespace: "team-a", ClusterSelector: shared}))
+	assert.False(t, coversDestination(
+		argoappv1.ApplicationDestination{Namespace: "team-*", ClusterSelector: shared},
+		argoappv1.ApplicationDestination{Namespace: "team-a", ClusterSelector: dev}))
+	assert.False(t, coversDestination(
+		argoappv1.ApplicationDestination{Namespace: "team-*", ClusterSelector: shared},
+		argoappv1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-a"}))
+	assert.False(t, coversDestination(
+		argoappv1.ApplicationDestination{Server: "https://eu-*", Namespace: "team-*", ClusterSelector: shared},
+		argoappv1.ApplicationDestination{Namespace: "team-a", ClusterSelector: shared}))
+}
+
+func Test_inheritNamespaceTemplate(t *testing.T) {
+	parent := &argoappv1.ProjectNamespaceTemplate{Labels: map[string]string{"team": "parent"}, DefaultDenyNetworkPolicy: true}
+	child := &argoappv1.ProjectNamespaceTemplate{Labels: map[string]string{"team": "child", "env": "dev"}}
+	res := inheritNamespaceTemplate(parent, child)
+	assert.Equal(t, map[string]string{"team": "parent", "env": "dev"}, res.Labels)
+	assert.True(t, res.DefaultDenyNetworkPolicy)
+	assert.Equal(t, "child", child.Labels["team"])
+}
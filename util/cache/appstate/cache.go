@@ -19,7 +19,8 @@ var (
 )
 
 const (
-	clusterInfoCacheExpiration = 10 * time.Minute
+	clusterInfoCacheExpiration            = 10 * time.Minute
+	clusterShardAssignmentCacheExpiration = 24 * time.Hour
 )
 
 type Cache struct {
@@ -129,3 +130,19 @@ func (c *Cache) GetClusterInfo(server string, res *appv1.ClusterInfo) error {
 	err := c.GetItem(clusterInfoKey(server), &res)
 	return err
 }
+
+func clusterShardAssignmentKey() string {
+	return "cluster|shard-assignment"
+}
+
+// SetClusterShardAssignment stores the shard of each cluster by server computed by the resource weighted sharding
+// algorithm, so that all application controller shards use the same assignment
+func (c *Cache) SetClusterShardAssignment(assignment map[string]int) error {
+	return c.SetItem(clusterShardAssignmentKey(), assignment, clusterShardAssignmentCacheExpiration, assignment == nil)
+}
+
+func (c *Cache) GetClusterShardAssignment() (map[string]int, error) {
+	var assignment map[string]int
+	err := c.GetItem(clusterShardAssignmentKey(), &assignment)
+	return assignment, err
+}
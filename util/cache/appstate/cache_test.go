@@ -80,6 +80,21 @@ func TestCache_GetClusterInfo(t *testing.T) {
 	assert.Equal(t, &ClusterInfo{ServerVersion: "0.24.0"}, res)
 }
 
+func TestCache_GetClusterShardAssignment(t *testing.T) {
+	t.Parallel()
+	cache := newFixtures().Cache
+	// cache miss
+	_, err := cache.GetClusterShardAssignment()
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	err = cache.SetClusterShardAssignment(map[string]int{"http://kind-cluster": 1})
+	require.NoError(t, err)
+	// cache hit
+	assignment, err := cache.GetClusterShardAssignment()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"http://kind-cluster": 1}, assignment)
+}
+
 func TestAddCacheFlagsToCmd(t *testing.T) {
 	t.Parallel()
 	cache, err := AddCacheFlagsToCmd(&cobra.Command{})()
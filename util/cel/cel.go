@@ -0,0 +1,177 @@
+// Package cel evaluates CEL (Common Expression Language) expressions against Kubernetes resources.
+package cel
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/golang/groupcache/lru"
+	celgo "github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+)
+
+const (
+	// ObjectVariable is the name of the variable holding the resource an expression is evaluated against
+	ObjectVariable = "object"
+
+	// compiledProgramCacheSize bounds the number of compiled CEL programs retained in memory.
+	// The working set (expressions of Application specs and argocd-cm customizations) is well
+	// under this, so eviction is only a safety valve against pathological churn.
+	compiledProgramCacheSize = 1024
+
+	// costLimit bounds the cost of a single evaluation, so that an expression cannot block the
+	// caller, e.g. by iterating nested comprehensions over large lists
+	costLimit = 1000000
+)
+
+var (
+	// compiledPrograms is a process-wide, content-addressed cache of compiled CEL programs.
+	// The cache key is the raw expression, so any change to an expression is a distinct key
+	// and a cache miss - no explicit invalidation is required.
+	compiledPrograms = newCompiledProgramCache()
+
+	objectEnv     *celgo.Env
+	objectEnvErr  error
+	objectEnvOnce sync.Once
+)
+
+// compiledProgramCache is a bounded, content-addressed cache of compiled CEL programs.
+// A CEL program is stateless and safe for concurrent use, so a single compiled program can
+// back any number of concurrent evaluations.
+//
+// It is backed by github.com/golang/groupcache/lru. That cache's Get promotes the accessed
+// entry (a mutation), so a sync.Mutex (not RWMutex) guards all access.
+type compiledProgramCache struct {
+	mu    sync.Mutex
+	cache *lru.Cache
+}
+
+func newCompiledProgramCache() *compiledProgramCache {
+	return &compiledProgramCache{
+		cache: lru.New(compiledProgramCacheSize),
+	}
+}
+
+func (c *compiledProgramCache) get(key string) (*Program, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if v, ok := c.cache.Get(key); ok {
+		return v.(*Program), true
+	}
+	return nil, false
+}
+
+func (c *compiledProgramCache) add(key string, program *Program) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Keep the first compiled program for a given expression. Concurrent misses may both
+	// compile, but the programs are equivalent, so retaining the earliest avoids needless churn.
+	if _, ok := c.cache.Get(key); ok {
+		return
+	}
+	c.cache.Add(key, program)
+}
+
+// Program is a compiled CEL expression which is evaluated against a resource, available to the
+// expression as the `object` variable
+type Program struct {
+	expression string
+	program    celgo.Program
+	// vars returns the variables of the expression for the resource
+	vars func(obj map[string]any) map[string]any
+}
+
+func getObjectEnv() (*celgo.Env, error) {
+	objectEnvOnce.Do(func() {
+		objectEnv, objectEnvErr = celgo.NewEnv(
+			celgo.Variable(ObjectVariable, celgo.DynType),
+			ext.Strings(),
+			ext.Lists(),
+			ext.Sets(),
+		)
+	})
+	return objectEnv, objectEnvErr
+}
+
+// Compile returns the compiled program of the given expression from the compiled-program
+// cache, compiling it on a cache miss. Compilation failures are never cached so that a fresh
+// error is surfaced on each call.
+func Compile(expression string) (*Program, error) {
+	return compile(expression, compiledPrograms, getObjectEnv, objectVars)
+}
+
+// compile returns the compiled program of the given expression from the given cache, compiling it in the environment
+// returned by getEnv on a cache miss. Each environment has its own cache, since the same expression compiles to
+// different programs in different environments.
+func compile(expression string, programs *compiledProgramCache, getEnv func() (*celgo.Env, error), vars func(obj map[string]any) map[string]any) (*Program, error) {
+	if program, ok := programs.get(expression); ok {
+		return program, nil
+	}
+	env, err := getEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed to compile CEL expression %q: %w", expression, issues.Err())
+	}
+	program, err := env.Program(ast, celgo.CostLimit(costLimit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL program for expression %q: %w", expression, err)
+	}
+	compiled := &Program{expression: expression, program: program, vars: vars}
+	programs.add(expression, compiled)
+	return compiled, nil
+}
+
+func objectVars(obj map[string]any) map[string]any {
+	return map[string]any{ObjectVariable: obj}
+}
+
+// Expression returns the source of the program
+func (p *Program) Expression() string {
+	return p.expression
+}
+
+// Eval evaluates the program against the given resource
+func (p *Program) Eval(obj map[string]any) (ref.Val, error) {
+	val, _, err := p.program.Eval(p.vars(obj))
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate CEL expression %q: %w", p.expression, err)
+	}
+	return val, nil
+}
+
+// EvalBool evaluates the program against the given resource and returns its result, which has to be a boolean
+func (p *Program) EvalBool(obj map[string]any) (bool, error) {
+	val, err := p.Eval(obj)
+	if err != nil {
+		return false, err
+	}
+	result, ok := val.(types.Bool)
+	if !ok {
+		return false, fmt.Errorf("CEL expression %q returned %s instead of a boolean", p.expression, val.Type().TypeName())
+	}
+	return bool(result), nil
+}
+
+// EvalStrings evaluates the program against the given resource and returns its result, which has to be a string or a
+// list of strings
+func (p *Program) EvalStrings(obj map[string]any) ([]string, error) {
+	val, err := p.Eval(obj)
+	if err != nil {
+		return nil, err
+	}
+	if str, ok := val.(types.String); ok {
+		return []string{string(str)}, nil
+	}
+	native, err := val.ConvertToNative(reflect.TypeFor[[]string]())
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("CEL expression %q returned %s instead of a string or a list of strings", p.expression, val.Type().TypeName()), err)
+	}
+	return native.([]string), nil
+}
@@ -0,0 +1,82 @@
+package cel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompiledProgramCache_ContentAddressed(t *testing.T) {
+	c := newCompiledProgramCache()
+
+	_, ok := c.get("true")
+	assert.False(t, ok, "expected miss for never-seen expression")
+
+	program := &Program{expression: "true"}
+	c.add("true", program)
+
+	got, ok := c.get("true")
+	require.True(t, ok, "expected hit after add")
+	assert.Same(t, program, got)
+
+	// Adding the same key again is a no-op (keeps the original program).
+	c.add("true", &Program{expression: "true"})
+	got, _ = c.get("true")
+	assert.Same(t, program, got)
+}
+
+func TestCompile_CachesPrograms(t *testing.T) {
+	program, err := Compile(`object.metadata.name == "foo"`)
+	require.NoError(t, err)
+	cached, err := Compile(`object.metadata.name == "foo"`)
+	require.NoError(t, err)
+	assert.Same(t, program, cached)
+
+	// compilation failures are not cached
+	_, err = Compile(`object.metadata.name ==`)
+	require.Error(t, err)
+	_, ok := compiledPrograms.get(`object.metadata.name ==`)
+	assert.False(t, ok)
+}
+
+func TestProgram_EvalBool(t *testing.T) {
+	obj := map[string]any{"metadata": map[string]any{"name": "foo", "generation": int64(2)}}
+
+	program, err := Compile(`object.metadata.name == "foo" && object.metadata.generation > 1`)
+	require.NoError(t, err)
+	result, err := program.EvalBool(obj)
+	require.NoError(t, err)
+	assert.True(t, result)
+
+	program, err = Compile(`object.metadata.name`)
+	require.NoError(t, err)
+	_, err = program.EvalBool(obj)
+	require.ErrorContains(t, err, "instead of a boolean")
+
+	program, err = Compile(`object.spec.replicas > 1`)
+	require.NoError(t, err)
+	_, err = program.EvalBool(obj)
+	require.ErrorContains(t, err, "no such key")
+}
+
+func TestProgram_EvalStrings(t *testing.T) {
+	obj := map[string]any{"metadata": map[string]any{"labels": map[string]any{"b": "2", "a": "1"}}}
+
+	program, err := Compile(`"/spec/replicas"`)
+	require.NoError(t, err)
+	result, err := program.EvalStrings(obj)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/spec/replicas"}, result)
+
+	program, err = Compile(`object.metadata.labels.map(k, "/metadata/labels/" + k).sort()`)
+	require.NoError(t, err)
+	result, err = program.EvalStrings(obj)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/metadata/labels/a", "/metadata/labels/b"}, result)
+
+	program, err = Compile(`1`)
+	require.NoError(t, err)
+	_, err = program.EvalStrings(obj)
+	require.ErrorContains(t, err, "instead of a string or a list of strings")
+}
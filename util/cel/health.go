@@ -0,0 +1,110 @@
+package cel
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	celgo "github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const invalidHealthStatus = "CEL returned an invalid health status"
+
+var (
+	// healthPrograms is the cache of the compiled health check expressions, which are compiled in their own
+	// environment
+	healthPrograms = newCompiledProgramCache()
+
+	// resourceFields are the top-level fields of a resource which are available to health check expressions as
+	// variables in addition to `object`, e.g. `status.conditions` instead of `object.status.conditions`, along with
+	// the values of the fields which are not set in the resource
+	resourceFields = map[string]any{
+		"apiVersion": "",
+		"kind":       "",
+		"metadata":   map[string]any{},
+		"spec":       map[string]any{},
+		"status":     map[string]any{},
+	}
+
+	healthEnv     *celgo.Env
+	healthEnvErr  error
+	healthEnvOnce sync.Once
+)
+
+func getHealthEnv() (*celgo.Env, error) {
+	healthEnvOnce.Do(func() {
+		opts := []celgo.EnvOption{
+			celgo.Variable(ObjectVariable, celgo.DynType),
+			ext.Strings(),
+			ext.Lists(),
+			ext.Sets(),
+		}
+		for name := range resourceFields {
+			opts = append(opts, celgo.Variable(name, celgo.DynType))
+		}
+		healthEnv, healthEnvErr = celgo.NewEnv(opts...)
+	})
+	return healthEnv, healthEnvErr
+}
+
+func healthVars(obj map[string]any) map[string]any {
+	vars := objectVars(obj)
+	for name, defaultValue := range resourceFields {
+		if value, ok := obj[name]; ok && value != nil {
+			vars[name] = value
+		} else {
+			vars[name] = defaultValue
+		}
+	}
+	return vars
+}
+
+// compileHealth returns the compiled program of the given health check expression. Unlike the programs returned by
+// Compile, the top-level fields of the resource are available to the expression as variables of the same name.
+func compileHealth(expression string) (*Program, error) {
+	return compile(expression, healthPrograms, getHealthEnv, healthVars)
+}
+
+// GetResourceHealth evaluates the given health check expression against the resource. The expression has to return
+// the health status, or a map with the health `status` and an optional `message`.
+func GetResourceHealth(obj *unstructured.Unstructured, expression string) (*health.HealthStatus, error) {
+	program, err := compileHealth(expression)
+	if err != nil {
+		return nil, err
+	}
+	val, err := program.Eval(obj.Object)
+	if err != nil {
+		return nil, err
+	}
+	healthStatus := &health.HealthStatus{}
+	if status, ok := val.(types.String); ok {
+		healthStatus.Status = health.HealthStatusCode(status)
+	} else {
+		native, err := val.ConvertToNative(reflect.TypeFor[map[string]string]())
+		if err != nil {
+			return nil, fmt.Errorf("CEL expression %q returned %s instead of a health status or a map of strings: %w", expression, val.Type().TypeName(), err)
+		}
+		fields := native.(map[string]string)
+		healthStatus.Status = health.HealthStatusCode(fields["status"])
+		healthStatus.Message = fields["message"]
+	}
+	if !isValidHealthStatusCode(healthStatus.Status) {
+		return &health.HealthStatus{
+			Status:  health.HealthStatusUnknown,
+			Message: invalidHealthStatus,
+		}, nil
+	}
+	return healthStatus, nil
+}
+
+func isValidHealthStatusCode(statusCode health.HealthStatusCode) bool {
+	switch statusCode {
+	case health.HealthStatusUnknown, health.HealthStatusProgressing, health.HealthStatusSuspended, health.HealthStatusHealthy, health.HealthStatusDegraded, health.HealthStatusMissing:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package cel
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newHealthTestResource(conditions ...any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "example.com/v1",
+		"kind":       "Example",
+		"metadata":   map[string]any{"name": "example"},
+	}}
+	if conditions != nil {
+		obj.Object["status"] = map[string]any{"conditions": conditions}
+	}
+	return obj
+}
+
+func TestGetResourceHealth(t *testing.T) {
+	const readyExpression = `has(status.conditions) && status.conditions.exists(c, c.type == "Ready" && c.status == "True") ? "Healthy" : "Progressing"`
+
+	result, err := GetResourceHealth(newHealthTestResource(map[string]any{"type": "Ready", "status": "True"}), readyExpression)
+	require.NoError(t, err)
+	assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy}, result)
+
+	// the status is not set yet
+	result, err = GetResourceHealth(newHealthTestResource(), readyExpression)
+	require.NoError(t, err)
+	assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusProgressing}, result)
+
+	result, err = GetResourceHealth(newHealthTestResource(), `{"status": "Degraded", "message": object.metadata.name + " failed"}`)
+	require.NoError(t, err)
+	assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "example failed"}, result)
+}
+
+func TestGetResourceHealth_InvalidResult(t *testing.T) {
+	result, err := GetResourceHealth(newHealthTestResource(), `"Unhealthy"`)
+	require.NoError(t, err)
+	assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusUnknown, Message: invalidHealthStatus}, result)
+
+	_, err = GetResourceHealth(newHealthTestResource(), `1`)
+	require.ErrorContains(t, err, "instead of a health status")
+
+	_, err = GetResourceHealth(newHealthTestResource(), `status.conditions[0]`)
+	require.ErrorContains(t, err, "no such key")
+}
+
+func TestGetResourceHealth_OwnEnvironment(t *testing.T) {
+	const expression = `status.phase == "Ready" ? "Healthy" : "Progressing"`
+
+	obj := newHealthTestResource()
+	obj.Object["status"] = map[string]any{"phase": "Ready"}
+	result, err := GetResourceHealth(obj, expression)
+	require.NoError(t, err)
+	assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy}, result)
+
+	// the top-level fields are not available to the other expressions, even once the health check is compiled
+	_, err = Compile(expression)
+	require.ErrorContains(t, err, "undeclared reference to 'status'")
+}
@@ -397,6 +397,9 @@ func clusterToSecret(c *appv1.Cluster, secret *corev1.Secret) error {
 	if c.Project != "" {
 		data["project"] = []byte(c.Project)
 	}
+	if c.MaxConcurrentSyncs > 0 {
+		data["maxConcurrentSyncs"] = []byte(strconv.FormatInt(c.MaxConcurrentSyncs, 10))
+	}
 	secret.Data = data
 
 	secret.Labels = c.Labels
@@ -452,6 +455,15 @@ func SecretToCluster(s *corev1.Secret) (*appv1.Cluster, error) {
 		}
 	}
 
+	var maxConcurrentSyncs int64
+	if v := s.Data["maxConcurrentSyncs"]; v != nil {
+		if val, err := strconv.ParseInt(string(v), 10, 64); err != nil {
+			log.Warnf("Error while parsing maxConcurrentSyncs in cluster secret '%s': %v", s.Name, err)
+		} else {
+			maxConcurrentSyncs = val
+		}
+	}
+
 	// copy labels and annotations excluding system ones
 	labels := map[string]string{}
 	if s.Labels != nil {
@@ -476,6 +488,7 @@ func SecretToCluster(s *corev1.Secret) (*appv1.Cluster, error) {
 		RefreshRequestedAt: refreshRequestedAt,
 		Shard:              shard,
 		Project:            string(s.Data["project"]),
+		MaxConcurrentSyncs: maxConcurrentSyncs,
 		Labels:             labels,
 		Annotations:        annotations,
 	}
@@ -117,6 +117,17 @@ func TestClusterToSecret(t *testing.T) {
 	assert.Equal(t, cluster.Labels, s.Labels)
 }
 
+func TestClusterToSecret_MaxConcurrentSyncs(t *testing.T) {
+	cluster := &v1alpha1.Cluster{Server: "http://mycluster", Name: "test", MaxConcurrentSyncs: 3}
+	s := &corev1.Secret{}
+	require.NoError(t, clusterToSecret(cluster, s))
+	assert.Equal(t, []byte("3"), s.Data["maxConcurrentSyncs"])
+
+	converted, err := SecretToCluster(s)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), converted.MaxConcurrentSyncs)
+}
+
 func TestClusterToSecret_LastAppliedConfigurationRejected(t *testing.T) {
 	cluster := &v1alpha1.Cluster{
 		Server:      "server",
@@ -0,0 +1,448 @@
+// Package ical implements the subset of the iCalendar format (RFC 5545) needed to turn the events of a calendar into
+// time intervals: VEVENT components with their start, end or duration, recurrence rule and exception dates.
+package ical
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+const (
+	// maxRecurrences limits the number of occurrences expanded from a single recurrence rule
+	maxRecurrences = 100000
+	// maxParsedCalendars limits the number of parsed calendars kept by ParseCached
+	maxParsedCalendars = 256
+)
+
+var (
+	// parsedCalendars holds the events of the calendars parsed by ParseCached, by the hash of their data and their location
+	parsedCalendars     = map[string][]Event{}
+	parsedCalendarsLock sync.Mutex
+)
+
+// Frequency is the frequency of a recurrence rule
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+	FrequencyYearly  Frequency = "YEARLY"
+)
+
+// RecurrenceRule is a recurrence rule of an event
+type RecurrenceRule struct {
+	Frequency Frequency
+	Interval  int
+	// Count is the number of occurrences, zero if unlimited
+	Count int
+	// Until is the time of the last occurrence, zero if unlimited
+	Until time.Time
+	// ByDay contains the days of the week a weekly event occurs on
+	ByDay []time.Weekday
+}
+
+// Event is an event of a calendar
+type Event struct {
+	UID     string
+	Summary string
+	Start   time.Time
+	End     time.Time
+	// Recurrence is the recurrence rule of the event, nil if the event occurs once
+	Recurrence *RecurrenceRule
+	// ExDates contains the start times of the occurrences excluded from the recurrence
+	ExDates []time.Time
+}
+
+// Interval is a single occurrence of an event
+type Interval struct {
+	Start   time.Time
+	End     time.Time
+	Summary string
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse parses the events of the given iCalendar data. Times without a time zone are interpreted in the given location.
+// Since the data may be fetched from a URL, the errors only refer to the number of the content line, not its content.
+func Parse(data string, loc *time.Location) ([]Event, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	var events []Event
+	var current *Event
+	var durationValue string
+	var allDay bool
+	depth, eventDepth := 0, 0
+	for i, line := range unfold(data) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		prop, err := parseProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("content line %d: %w", i+1, err)
+		}
+		switch prop.name {
+		case "BEGIN":
+			depth++
+			if strings.EqualFold(prop.value, "VEVENT") {
+				if current != nil {
+					return nil, fmt.Errorf("content line %d: nested VEVENT", i+1)
+				}
+				current = &Event{}
+				eventDepth = depth
+				durationValue = ""
+				allDay = false
+			}
+			continue
+		case "END":
+			depth--
+			if strings.EqualFold(prop.value, "VEVENT") && current != nil {
+				if err := finishEvent(current, durationValue, allDay); err != nil {
+					return nil, fmt.Errorf("content line %d: %w", i+1, err)
+				}
+				events = append(events, *current)
+				current = nil
+			}
+			continue
+		}
+		// properties of nested components such as VALARM are ignored
+		if current == nil || depth > eventDepth {
+			continue
+		}
+		switch prop.name {
+		case "UID":
+			current.UID = prop.value
+		case "SUMMARY":
+			current.Summary = unescape(prop.value)
+		case "DTSTART":
+			current.Start, err = parseTime(prop, loc)
+			allDay = isDate(prop)
+		case "DTEND":
+			current.End, err = parseTime(prop, loc)
+		case "DURATION":
+			durationValue = prop.value
+		case "RRULE":
+			current.Recurrence, err = parseRecurrenceRule(prop.value, loc)
+		case "EXDATE":
+			for value := range strings.SplitSeq(prop.value, ",") {
+				exDate, exErr := parseTime(property{name: prop.name, params: prop.params, value: value}, loc)
+				if exErr != nil {
+					err = exErr
+					break
+				}
+				current.ExDates = append(current.ExDates, exDate)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("content line %d: %w", i+1, err)
+		}
+	}
+	if current != nil {
+		return nil, errors.New("unterminated VEVENT")
+	}
+	return events, nil
+}
+
+func finishEvent(event *Event, durationValue string, allDay bool) error {
+	if event.Start.IsZero() {
+		return errors.New("missing DTSTART")
+	}
+	if event.End.IsZero() {
+		switch {
+		case durationValue != "":
+			duration, err := parseDuration(durationValue)
+			if err != nil {
+				return err
+			}
+			event.End = event.Start.Add(duration)
+		case allDay:
+			event.End = event.Start.AddDate(0, 0, 1)
+		default:
+			event.End = event.Start
+		}
+	}
+	if event.End.Before(event.Start) {
+		return errors.New("DTEND is before DTSTART")
+	}
+	return nil
+}
+
+// unfold joins the lines which were folded according to RFC 5545 section 3.1
+func unfold(data string) []string {
+	rawLines := strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n")
+	lines := make([]string, 0, len(rawLines))
+	for _, line := range rawLines {
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func parseProperty(line string) (property, error) {
+	colon := indexOutsideQuotes(line, ':')
+	if colon < 0 {
+		return property{}, errors.New("invalid content line")
+	}
+	parts := strings.Split(line[:colon], ";")
+	prop := property{name: strings.ToUpper(parts[0]), params: map[string]string{}, value: line[colon+1:]}
+	for _, param := range parts[1:] {
+		name, value, _ := strings.Cut(param, "=")
+		prop.params[strings.ToUpper(name)] = strings.Trim(value, `"`)
+	}
+	return prop, nil
+}
+
+func indexOutsideQuotes(s string, c byte) int {
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			quoted = !quoted
+		case c:
+			if !quoted {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func unescape(s string) string {
+	return strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(s)
+}
+
+func isDate(prop property) bool {
+	return prop.params["VALUE"] == "DATE" || len(strings.TrimSpace(prop.value)) == len("20060102")
+}
+
+func parseTime(prop property, loc *time.Location) (time.Time, error) {
+	value := strings.TrimSpace(prop.value)
+	if isDate(prop) {
+		t, err := time.ParseInLocation("20060102", value, loc)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid %s date", prop.name)
+		}
+		return t, nil
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid %s time", prop.name)
+		}
+		return t, nil
+	}
+	if tzid := prop.params["TZID"]; tzid != "" {
+		tzLoc, err := time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unknown %s time zone", prop.name)
+		}
+		loc = tzLoc
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s time", prop.name)
+	}
+	return t, nil
+}
+
+var durationRegexp = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// parseDuration parses a duration according to RFC 5545 section 3.3.6 (e.g. "P1D", "PT1H30M")
+func parseDuration(value string) (time.Duration, error) {
+	match := durationRegexp.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return 0, errors.New("invalid DURATION")
+	}
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var duration time.Duration
+	for i, unit := range units {
+		if match[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(match[i+2])
+		if err != nil {
+			return 0, errors.New("invalid DURATION")
+		}
+		duration += time.Duration(n) * unit
+	}
+	if match[1] == "-" {
+		return 0, errors.New("negative DURATION is not supported")
+	}
+	return duration, nil
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+func parseRecurrenceRule(value string, loc *time.Location) (*RecurrenceRule, error) {
+	rule := &RecurrenceRule{Interval: 1}
+	for part := range strings.SplitSeq(value, ";") {
+		name, partValue, _ := strings.Cut(part, "=")
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			rule.Frequency = Frequency(strings.ToUpper(partValue))
+			if !slices.Contains([]Frequency{FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly}, rule.Frequency) {
+				return nil, errors.New("unsupported RRULE frequency")
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(partValue)
+			if err != nil || interval < 1 {
+				return nil, errors.New("invalid RRULE interval")
+			}
+			rule.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(partValue)
+			if err != nil || count < 1 {
+				return nil, errors.New("invalid RRULE count")
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := parseTime(property{name: "UNTIL", params: map[string]string{}, value: partValue}, loc)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = until
+		case "BYDAY":
+			for day := range strings.SplitSeq(partValue, ",") {
+				weekday, ok := weekdays[strings.ToUpper(day)]
+				if !ok {
+					return nil, errors.New("unsupported RRULE day")
+				}
+				rule.ByDay = append(rule.ByDay, weekday)
+			}
+		case "WKST":
+			// the week start does not affect the supported rules
+		default:
+			return nil, errors.New("unsupported RRULE part")
+		}
+	}
+	if rule.Frequency == "" {
+		return nil, errors.New("RRULE is missing FREQ")
+	}
+	if len(rule.ByDay) > 0 && rule.Frequency != FrequencyWeekly {
+		return nil, errors.New("RRULE BYDAY is only supported for WEEKLY events")
+	}
+	return rule, nil
+}
+
+// Occurrences returns the occurrences of the event which overlap with the interval between from and to
+func (e Event) Occurrences(from time.Time, to time.Time) []Interval {
+	duration := e.End.Sub(e.Start)
+	var intervals []Interval
+	add := func(start time.Time) {
+		end := start.Add(duration)
+		if end.After(from) && start.Before(to) && !slices.ContainsFunc(e.ExDates, start.Equal) {
+			intervals = append(intervals, Interval{Start: start, End: end, Summary: e.Summary})
+		}
+	}
+	if e.Recurrence == nil {
+		add(e.Start)
+		return intervals
+	}
+	rule := e.Recurrence
+	count := 0
+	for period := 0; period < maxRecurrences; period++ {
+		var starts []time.Time
+		switch rule.Frequency {
+		case FrequencyDaily:
+			starts = []time.Time{e.Start.AddDate(0, 0, period*rule.Interval)}
+		case FrequencyWeekly:
+			weekStart := e.Start.AddDate(0, 0, period*rule.Interval*7)
+			if len(rule.ByDay) == 0 {
+				starts = []time.Time{weekStart}
+				break
+			}
+			for offset := range 7 {
+				start := weekStart.AddDate(0, 0, offset)
+				if slices.Contains(rule.ByDay, start.Weekday()) {
+					starts = append(starts, start)
+				}
+			}
+		case FrequencyMonthly:
+			start := e.Start.AddDate(0, period*rule.Interval, 0)
+			// months without the day of the start are skipped
+			if start.Day() == e.Start.Day() {
+				starts = []time.Time{start}
+			}
+		case FrequencyYearly:
+			start := e.Start.AddDate(period*rule.Interval, 0, 0)
+			if start.Day() == e.Start.Day() {
+				starts = []time.Time{start}
+			}
+		}
+		for _, start := range starts {
+			if (!rule.Until.IsZero() && start.After(rule.Until)) || !start.Before(to) {
+				return intervals
+			}
+			count++
+			if rule.Count > 0 && count > rule.Count {
+				return intervals
+			}
+			add(start)
+		}
+	}
+	return intervals
+}
+
+// ParseCached parses the events of the given iCalendar data like Parse, but returns the events of data which was
+// already parsed for the same location without parsing it again. The returned events must not be modified.
+func ParseCached(data string, loc *time.Location) ([]Event, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	key := fmt.Sprintf("%x/%s", xxhash.Sum64String(data), loc.String())
+	parsedCalendarsLock.Lock()
+	events, ok := parsedCalendars[key]
+	parsedCalendarsLock.Unlock()
+	if ok {
+		return events, nil
+	}
+	events, err := Parse(data, loc)
+	if err != nil {
+		return nil, err
+	}
+	parsedCalendarsLock.Lock()
+	if len(parsedCalendars) >= maxParsedCalendars {
+		clear(parsedCalendars)
+	}
+	parsedCalendars[key] = events
+	parsedCalendarsLock.Unlock()
+	return events, nil
+}
+
+// Occurrences returns the occurrences of the given events which overlap with the interval between from and to,
+// ordered by their start
+func Occurrences(events []Event, from time.Time, to time.Time) []Interval {
+	var intervals []Interval
+	for _, event := range events {
+		intervals = append(intervals, event.Occurrences(from, to)...)
+	}
+	sort.SliceStable(intervals, func(i, j int) bool {
+		return intervals[i].Start.Before(intervals[j].Start)
+	})
+	return intervals
+}
@@ -0,0 +1,163 @@
+package ical
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCalendar = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Example//Change Freeze//EN
+BEGIN:VEVENT
+UID:christmas
+SUMMARY:Christmas\, no deployments
+DTSTART;VALUE=DATE:20261225
+DTEND;VALUE=DATE:20261227
+RRULE:FREQ=YEARLY
+BEGIN:VALARM
+TRIGGER:-PT15M
+DESCRIPTION:Reminder
+END:VALARM
+END:VEVENT
+BEGIN:VEVENT
+UID:quarter-end
+SUMMARY:Quarter end
+DTSTART;TZID=Europe/Berlin:20260330T180000
+DURATION:P2D
+RRULE:FREQ=MONTHLY;INTERVAL=3;COUNT=4
+EXDATE;TZID=Europe/Berlin:20260630T180000
+END:VEVENT
+BEGIN:VEVENT
+UID:incident
+SUMMARY:Incident 42
+DTSTART:20261018T120000Z
+DTEND:20261018T
+ 140000Z
+END:VEVENT
+END:VCALENDAR
+`
+
+func TestParse(t *testing.T) {
+	events, err := Parse(testCalendar, time.UTC)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+
+	assert.Equal(t, "Christmas, no deployments", events[0].Summary)
+	assert.Equal(t, time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC), events[0].Start)
+	assert.Equal(t, time.Date(2026, 12, 27, 0, 0, 0, 0, time.UTC), events[0].End)
+	require.NotNil(t, events[0].Recurrence)
+	assert.Equal(t, FrequencyYearly, events[0].Recurrence.Frequency)
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 30, 18, 0, 0, 0, berlin), events[1].Start)
+	assert.Equal(t, 48*time.Hour, events[1].End.Sub(events[1].Start))
+	assert.Equal(t, &RecurrenceRule{Frequency: FrequencyMonthly, Interval: 3, Count: 4}, events[1].Recurrence)
+	assert.Len(t, events[1].ExDates, 1)
+
+	assert.Equal(t, time.Date(2026, 10, 18, 14, 0, 0, 0, time.UTC), events[2].End)
+}
+
+func TestParse_Errors(t *testing.T) {
+	for name, data := range map[string]string{
+		"missing start":        "BEGIN:VEVENT\nSUMMARY:test\nEND:VEVENT",
+		"unterminated":         "BEGIN:VEVENT\nDTSTART:20260101T000000Z",
+		"invalid start":        "BEGIN:VEVENT\nDTSTART:tomorrow\nEND:VEVENT",
+		"end before start":     "BEGIN:VEVENT\nDTSTART:20260102T000000Z\nDTEND:20260101T000000Z\nEND:VEVENT",
+		"unsupported rule":     "BEGIN:VEVENT\nDTSTART:20260101T000000Z\nRRULE:FREQ=HOURLY\nEND:VEVENT",
+		"unsupported part":     "BEGIN:VEVENT\nDTSTART:20260101T000000Z\nRRULE:FREQ=MONTHLY;BYSETPOS=-1\nEND:VEVENT",
+		"invalid duration":     "BEGIN:VEVENT\nDTSTART:20260101T000000Z\nDURATION:1 day\nEND:VEVENT",
+		"unknown time zone":    "BEGIN:VEVENT\nDTSTART;TZID=Mars/Olympus:20260101T000000\nEND:VEVENT",
+		"invalid content line": "BEGIN:VEVENT\nDTSTART\nEND:VEVENT",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(data, time.UTC)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParse_ErrorsDoNotContainContent(t *testing.T) {
+	_, err := Parse("BEGIN:VEVENT\nsecret-token\nEND:VEVENT", time.UTC)
+	require.EqualError(t, err, "content line 2: invalid content line")
+
+	_, err = Parse("BEGIN:VEVENT\nSUMMARY:secret-summary\nDTSTART:secret-token\nEND:VEVENT", time.UTC)
+	require.EqualError(t, err, "content line 3: invalid DTSTART time")
+
+	_, err = Parse("BEGIN:VEVENT\nSUMMARY:secret-summary\nDTSTART:20260101T000000Z\nRRULE:FREQ=DAILY;SECRET=token\nEND:VEVENT", time.UTC)
+	require.EqualError(t, err, "content line 4: unsupported RRULE part")
+}
+
+func TestParseCached(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	events, err := ParseCached(testCalendar, loc)
+	require.NoError(t, err)
+	parsed, err := Parse(testCalendar, loc)
+	require.NoError(t, err)
+	assert.Equal(t, parsed, events)
+
+	cached, err := ParseCached(testCalendar, loc)
+	require.NoError(t, err)
+	assert.Same(t, &events[0], &cached[0])
+
+	// the data is parsed again for another location
+	other, err := ParseCached(testCalendar, time.UTC)
+	require.NoError(t, err)
+	assert.NotSame(t, &events[0], &other[0])
+
+	_, err = ParseCached("BEGIN:VEVENT\nEND:VEVENT", loc)
+	require.Error(t, err)
+}
+
+func TestOccurrences(t *testing.T) {
+	events, err := Parse(testCalendar, time.UTC)
+	require.NoError(t, err)
+
+	intervals := Occurrences(events, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2028, 1, 1, 0, 0, 0, 0, time.UTC))
+	var summaries []string
+	var starts []string
+	for _, interval := range intervals {
+		summaries = append(summaries, interval.Summary)
+		starts = append(starts, interval.Start.UTC().Format(time.RFC3339))
+	}
+	assert.Equal(t, []string{
+		"Quarter end",
+		"Quarter end",
+		"Incident 42",
+		"Christmas, no deployments",
+		"Quarter end",
+		"Christmas, no deployments",
+	}, summaries)
+	assert.Equal(t, []string{
+		"2026-03-30T16:00:00Z",
+		"2026-09-30T16:00:00Z",
+		"2026-10-18T12:00:00Z",
+		"2026-12-25T00:00:00Z",
+		"2026-12-30T17:00:00Z",
+		"2027-12-25T00:00:00Z",
+	}, starts)
+}
+
+func TestOccurrences_Weekly(t *testing.T) {
+	events, err := Parse("BEGIN:VEVENT\nDTSTART:20261016T170000Z\nDTEND:20261016T180000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO,FR;UNTIL=20261027T000000Z\nEND:VEVENT", time.UTC)
+	require.NoError(t, err)
+
+	intervals := Occurrences(events, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC))
+	require.Len(t, intervals, 3)
+	assert.Equal(t, time.Date(2026, 10, 19, 17, 0, 0, 0, time.UTC), intervals[0].Start)
+	assert.Equal(t, time.Date(2026, 10, 23, 17, 0, 0, 0, time.UTC), intervals[1].Start)
+	assert.Equal(t, time.Date(2026, 10, 26, 18, 0, 0, 0, time.UTC), intervals[2].End)
+}
+
+func TestOccurrences_Ongoing(t *testing.T) {
+	events, err := Parse("BEGIN:VEVENT\nDTSTART;VALUE=DATE:20261018\nEND:VEVENT", time.UTC)
+	require.NoError(t, err)
+
+	intervals := Occurrences(events, time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC), time.Date(2026, 10, 18, 12, 0, 1, 0, time.UTC))
+	require.Len(t, intervals, 1)
+	assert.Equal(t, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), intervals[0].End)
+}
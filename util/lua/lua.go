@@ -26,6 +26,7 @@ import (
 	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/resource_customizations"
+	"github.com/argoproj/argo-cd/v3/util/cel"
 	argoglob "github.com/argoproj/argo-cd/v3/util/glob"
 )
 
@@ -102,6 +103,9 @@ func (overrides ResourceHealthOverrides) GetResourceHealth(obj *unstructured.Uns
 	luaVM := VM{
 		ResourceOverrides: overrides,
 	}
+	if expression := luaVM.GetHealthCEL(obj); expression != "" {
+		return cel.GetResourceHealth(obj, expression)
+	}
 	script, useOpenLibs, err := luaVM.GetHealthScript(obj)
 	if err != nil {
 		return nil, err
@@ -245,21 +249,24 @@ func (vm VM) ExecuteHealthLua(obj *unstructured.Unstructured, script string) (*h
 	return nil, fmt.Errorf(incorrectReturnType, "table", returnValue.Type().String())
 }
 
-// GetHealthScript attempts to read lua script from config and then filesystem for that resource. If none exists, return
-// an empty string.
+// GetHealthCEL returns the CEL health check expression of the resource override for that resource. If the resource
+// override defines no CEL health check, or is not found, return an empty string.
+func (vm VM) GetHealthCEL(obj *unstructured.Unstructured) string {
+	override, _ := vm.getHealthOverride(obj.GroupVersionKind())
+	return override.HealthCEL
+}
+
+// GetHealthScript attempts to read lua script from config and then filesystem for that resource. If none exists, or
+// the resource override for that resource defines a CEL health check instead, return an empty string.
 func (vm VM) GetHealthScript(obj *unstructured.Unstructured) (script string, useOpenLibs bool, err error) {
-	// first, search the gvk as is in the ResourceOverrides
 	key := GetConfigMapKey(obj.GroupVersionKind())
 
-	if script, ok := vm.ResourceOverrides[key]; ok && script.HealthLua != "" {
-		return script.HealthLua, script.UseOpenLibs, nil
-	}
-
-	// if not found as is, perhaps it matches a wildcard entry in the configmap
-	getWildcardHealthOverride, useOpenLibs := getWildcardHealthOverrideLua(vm.ResourceOverrides, obj.GroupVersionKind())
-
-	if getWildcardHealthOverride != "" {
-		return getWildcardHealthOverride, useOpenLibs, nil
+	// first, search the ResourceOverrides
+	if override, ok := vm.getHealthOverride(obj.GroupVersionKind()); ok {
+		if override.HealthCEL != "" {
+			return "", false, nil
+		}
+		return override.HealthLua, override.UseOpenLibs, nil
 	}
 
 	// if not found in the ResourceOverrides at all, search it as is in the built-in scripts
@@ -561,18 +568,31 @@ func GetConfigMapKey(gvk schema.GroupVersionKind) string {
 	return fmt.Sprintf("%s/%s", gvk.Group, gvk.Kind)
 }
 
-// getWildcardHealthOverrideLua returns the first encountered resource override which matches the wildcard and has a
-// non-empty health script. Having multiple wildcards with non-empty health checks that can match the GVK is
+// getHealthOverride returns the resource override which defines the health check of the GVK. The gvk is searched as
+// is first, and if not found, it is matched against the wildcard entries.
+func (vm VM) getHealthOverride(gvk schema.GroupVersionKind) (appv1.ResourceOverride, bool) {
+	if override, ok := vm.ResourceOverrides[GetConfigMapKey(gvk)]; ok && hasHealthCheck(override) {
+		return override, true
+	}
+	return getWildcardHealthOverride(vm.ResourceOverrides, gvk)
+}
+
+// getWildcardHealthOverride returns the first encountered resource override which matches the wildcard and has a
+// non-empty health check. Having multiple wildcards with non-empty health checks that can match the GVK is
 // non-deterministic.
-func getWildcardHealthOverrideLua(overrides map[string]appv1.ResourceOverride, gvk schema.GroupVersionKind) (string, bool) {
+func getWildcardHealthOverride(overrides map[string]appv1.ResourceOverride, gvk schema.GroupVersionKind) (appv1.ResourceOverride, bool) {
 	gvkKeyToMatch := GetConfigMapKey(gvk)
 
 	for key, override := range overrides {
-		if argoglob.Match(key, gvkKeyToMatch) && override.HealthLua != "" {
-			return override.HealthLua, override.UseOpenLibs
+		if argoglob.Match(key, gvkKeyToMatch) && hasHealthCheck(override) {
+			return override, true
 		}
 	}
-	return "", false
+	return appv1.ResourceOverride{}, false
+}
+
+func hasHealthCheck(override appv1.ResourceOverride) bool {
+	return override.HealthLua != "" || override.HealthCEL != ""
 }
 
 func (vm VM) getPredefinedLuaScripts(objKey string, scriptFile string) (string, error) {
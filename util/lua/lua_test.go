@@ -268,6 +268,24 @@ func TestGetHealthScriptWithGroupAndKindWildcardOverride(t *testing.T) {
 	assert.Equal(t, newHealthStatusFunction, script)
 }
 
+func TestGetHealthScriptWithCELOverride(t *testing.T) {
+	t.Parallel()
+	testObj := StrToUnstructured(objJSON)
+	vm := VM{
+		ResourceOverrides: map[string]appv1.ResourceOverride{
+			"argoproj.io/*": {
+				HealthCEL: `"Healthy"`,
+			},
+		},
+	}
+
+	// the CEL health check takes precedence over the predefined script
+	script, _, err := vm.GetHealthScript(testObj)
+	require.NoError(t, err)
+	assert.Empty(t, script)
+	assert.Equal(t, `"Healthy"`, vm.GetHealthCEL(testObj))
+}
+
 func TestGetHealthScriptPredefined(t *testing.T) {
 	t.Parallel()
 	testObj := StrToUnstructured(objJSON)
@@ -1042,6 +1060,51 @@ return hs`
 	})
 }
 
+func TestGetResourceHealthCEL(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Get resource health for CEL override", func(t *testing.T) {
+		t.Parallel()
+		testObj := StrToUnstructured(objJSON)
+		overrides := ResourceHealthOverrides{
+			"argoproj.io/Rollout": appv1.ResourceOverride{
+				HealthCEL: `{"status": "Suspended", "message": "kind " + kind}`,
+			},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusSuspended, Message: "kind Rollout"}, status)
+	})
+
+	t.Run("Lua override takes precedence over CEL wildcard override", func(t *testing.T) {
+		t.Parallel()
+		testObj := StrToUnstructured(objJSON)
+		overrides := ResourceHealthOverrides{
+			"argoproj.io/Rollout": appv1.ResourceOverride{
+				HealthLua: newHealthStatusFunction,
+			},
+			"argoproj.io/*": appv1.ResourceOverride{
+				HealthCEL: `"Suspended"`,
+			},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, status.Status)
+	})
+
+	t.Run("Invalid CEL override", func(t *testing.T) {
+		t.Parallel()
+		testObj := StrToUnstructured(objJSON)
+		overrides := ResourceHealthOverrides{
+			"argoproj.io/Rollout": appv1.ResourceOverride{
+				HealthCEL: `"Healthy" +`,
+			},
+		}
+		_, err := overrides.GetResourceHealth(testObj)
+		require.ErrorContains(t, err, "failed to compile CEL expression")
+	})
+}
+
 func TestExecuteResourceActionWithParams(t *testing.T) {
 	t.Parallel()
 	deploymentObj := createMockResource("Deployment", "test-deployment", 1)
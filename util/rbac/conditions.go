@@ -0,0 +1,174 @@
+package rbac
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/casbin/govaluate"
+	log "github.com/sirupsen/logrus"
+)
+
+// Attributes are the attributes of the object of an RBAC request, which the conditions of the policies are evaluated
+// against. They are given as an optional fifth request value, after the subject, resource, action and object.
+type Attributes struct {
+	// Labels are the labels of the application
+	Labels map[string]string `json:"labels,omitempty"`
+	// Server is the server URL of the destination cluster of the application
+	Server string `json:"server,omitempty"`
+	// Cluster is the name of the destination cluster of the application
+	Cluster string `json:"cluster,omitempty"`
+	// Namespace is the destination namespace of the application
+	Namespace string `json:"namespace,omitempty"`
+	// Group is the API group of the resource of the application the request is about, if any
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of the resource of the application the request is about, if any
+	Kind string `json:"kind,omitempty"`
+}
+
+// UnknownAttributes can be given instead of the attributes of a request whose object is not known yet. The conditions
+// are then evaluated optimistically: the conditions of allow policies match and the conditions of deny policies don't.
+// It must only be used for preliminary checks, which are followed by a check with the actual attributes.
+var UnknownAttributes = &Attributes{}
+
+// String returns a string representation of the attributes
+func (a *Attributes) String() string {
+	switch a {
+	case nil:
+		return ""
+	case UnknownAttributes:
+		return "?"
+	}
+	data, err := json.Marshal(a)
+	if err != nil {
+		return fmt.Sprintf("%#v", *a)
+	}
+	return string(data)
+}
+
+// GetCacheKey implements casbin.CacheableParam, so that the results of requests with attributes are cached
+func (a *Attributes) GetCacheKey() string {
+	return a.String()
+}
+
+// conditionVariables are the variables which can be used in policy conditions
+var conditionVariables = []string{"subject", "server", "cluster", "namespace", "group", "kind"}
+
+// conditionFunctions returns the functions which can be used in policy conditions, for the given attributes
+func conditionFunctions(attrs *Attributes) map[string]govaluate.ExpressionFunction {
+	return map[string]govaluate.ExpressionFunction{
+		"label": func(args ...any) (any, error) {
+			key, err := stringArgs("label", 1, args...)
+			if err != nil {
+				return nil, err
+			}
+			return attrs.Labels[key[0]], nil
+		},
+		"hasLabel": func(args ...any) (any, error) {
+			key, err := stringArgs("hasLabel", 1, args...)
+			if err != nil {
+				return nil, err
+			}
+			_, ok := attrs.Labels[key[0]]
+			return ok, nil
+		},
+		"hasPrefix": func(args ...any) (any, error) {
+			strs, err := stringArgs("hasPrefix", 2, args...)
+			if err != nil {
+				return nil, err
+			}
+			return strings.HasPrefix(strs[0], strs[1]), nil
+		},
+		"hasSuffix": func(args ...any) (any, error) {
+			strs, err := stringArgs("hasSuffix", 2, args...)
+			if err != nil {
+				return nil, err
+			}
+			return strings.HasSuffix(strs[0], strs[1]), nil
+		},
+	}
+}
+
+// stringArgs returns the arguments of the named condition function, which must be the given number of strings
+func stringArgs(name string, count int, args ...any) ([]string, error) {
+	if len(args) != count {
+		return nil, fmt.Errorf("%s() expects %d argument(s), got %d", name, count, len(args))
+	}
+	strs := make([]string, len(args))
+	for i, arg := range args {
+		str, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s() expects string arguments, got %v", name, arg)
+		}
+		strs[i] = str
+	}
+	return strs, nil
+}
+
+// evaluateCondition evaluates the condition of a policy against the subject and the attributes of a request
+func evaluateCondition(condition string, subject string, attrs *Attributes) (bool, error) {
+	expr, err := govaluate.NewEvaluableExpressionWithFunctions(condition, conditionFunctions(attrs))
+	if err != nil {
+		return false, err
+	}
+	for _, v := range expr.Vars() {
+		if !slices.Contains(conditionVariables, v) {
+			return false, fmt.Errorf("unknown variable '%s', must be one of: %s", v, strings.Join(conditionVariables, ", "))
+		}
+	}
+	res, err := expr.Evaluate(map[string]any{
+		"subject":   subject,
+		"server":    attrs.Server,
+		"cluster":   attrs.Cluster,
+		"namespace": attrs.Namespace,
+		"group":     attrs.Group,
+		"kind":      attrs.Kind,
+	})
+	if err != nil {
+		return false, err
+	}
+	matched, ok := res.(bool)
+	if !ok {
+		return false, errors.New("condition must evaluate to a boolean")
+	}
+	return matched, nil
+}
+
+// ValidateCondition verifies that a policy condition is a valid expression
+func ValidateCondition(condition string) error {
+	if _, err := evaluateCondition(condition, "", &Attributes{}); err != nil {
+		return fmt.Errorf("invalid condition '%s': %w", condition, err)
+	}
+	return nil
+}
+
+// conditionMatchFunc is the matcher function which evaluates the condition of a policy. Its arguments are the subject
+// and the attributes of the request, and the effect and the condition of the policy. A policy without condition always
+// matches. If the attributes of the request are unknown, or if the condition can't be evaluated, the conditions of
+// deny policies match and the conditions of allow policies don't.
+func conditionMatchFunc(args ...any) (any, error) {
+	if len(args) != 4 {
+		return false, nil
+	}
+	condition, _ := args[3].(string)
+	if condition == "" {
+		return true, nil
+	}
+	deny := args[2] == "deny"
+	attrs, _ := args[1].(*Attributes)
+	switch attrs {
+	case nil:
+		return deny, nil
+	case UnknownAttributes:
+		return !deny, nil
+	}
+	subject, _ := args[0].(string)
+	matched, err := evaluateCondition(condition, subject, attrs)
+	if err != nil {
+		log.Warnf("Failed to evaluate RBAC policy condition '%s': %v", condition, err)
+		return deny, nil
+	}
+	return matched, nil
+}
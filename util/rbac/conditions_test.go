@@ -0,0 +1,59 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionMatchFunc(t *testing.T) {
+	attrs := &Attributes{
+		Labels:    map[string]string{"env": "prod", "team": "a"},
+		Server:    "https://kubernetes.default.svc",
+		Cluster:   "in-cluster",
+		Namespace: "guestbook",
+		Group:     "apps",
+		Kind:      "Deployment",
+	}
+	match := func(attrs any, eft string, condition string) bool {
+		t.Helper()
+		res, err := conditionMatchFunc("my-org:a", attrs, eft, condition)
+		require.NoError(t, err)
+		return res.(bool)
+	}
+
+	assert.True(t, match(attrs, "allow", ""))
+	assert.True(t, match(nil, "allow", ""))
+	assert.True(t, match(attrs, "allow", "label('env') == 'prod' && hasLabel('team') && !hasLabel('tier')"))
+	assert.True(t, match(attrs, "allow", "label('tier') == ''"))
+	assert.True(t, match(attrs, "allow", "server == 'https://kubernetes.default.svc' && cluster == 'in-cluster'"))
+	assert.True(t, match(attrs, "allow", "namespace =~ '^guest' && kind == 'Deployment' && group == 'apps'"))
+	assert.True(t, match(attrs, "allow", "hasPrefix(subject, 'my-org:') && hasSuffix(subject, ':' + label('team'))"))
+	assert.False(t, match(attrs, "allow", "namespace in ('default', 'kube-system')"))
+
+	// unknown attributes
+	assert.False(t, match(nil, "allow", "kind == 'Deployment'"))
+	assert.True(t, match(nil, "deny", "kind == 'Deployment'"))
+	assert.False(t, match((*Attributes)(nil), "allow", "kind == 'Deployment'"))
+	assert.True(t, match(UnknownAttributes, "allow", "kind == 'Deployment'"))
+	assert.False(t, match(UnknownAttributes, "deny", "kind == 'Deployment'"))
+
+	// conditions which can't be evaluated
+	assert.False(t, match(attrs, "allow", "kind"))
+	assert.True(t, match(attrs, "deny", "kind"))
+}
+
+func TestValidateCondition(t *testing.T) {
+	require.NoError(t, ValidateCondition("label('env') != 'prod' || kind in ('Pod', 'ConfigMap')"))
+	require.ErrorContains(t, ValidateCondition("env == 'prod'"), "unknown variable 'env'")
+	require.ErrorContains(t, ValidateCondition("label('env')"), "condition must evaluate to a boolean")
+	require.ErrorContains(t, ValidateCondition("hasPrefix(kind)"), "hasPrefix() expects 2 argument(s), got 1")
+	require.Error(t, ValidateCondition("kind == "))
+}
+
+func TestAttributes_GetCacheKey(t *testing.T) {
+	assert.Empty(t, (*Attributes)(nil).GetCacheKey())
+	assert.Equal(t, "?", UnknownAttributes.GetCacheKey())
+	assert.JSONEq(t, `{"labels":{"env":"prod"},"kind":"Pod"}`, (&Attributes{Labels: map[string]string{"env": "prod"}, Kind: "Pod"}).GetCacheKey())
+}
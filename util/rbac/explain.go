@@ -0,0 +1,161 @@
+package rbac
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/casbin/casbin/v2/util"
+	"github.com/casbin/govaluate"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// BuiltinPolicySource is the name of the source of the policies of the built-in policy
+const BuiltinPolicySource = "built-in"
+
+// Explanation traces the evaluation of an RBAC request
+type Explanation struct {
+	// Allowed is whether the request is allowed
+	Allowed bool
+	// DefaultRole is the evaluation of the request for the default role, if one is set
+	DefaultRole *SubjectExplanation
+	// Subjects are the evaluations of the request for the subject of the request, or for the subjects of its claims
+	Subjects []SubjectExplanation
+}
+
+// SubjectExplanation traces the evaluation of an RBAC request for a single subject
+type SubjectExplanation struct {
+	// Subject is the user, group or role the request was evaluated for
+	Subject string
+	// Roles are the roles the subject inherits through grouping policies
+	Roles []string
+	// Policies are the policies of the subject and its roles which match the request
+	Policies []MatchedPolicy
+	// Allowed is whether the policies allow the request, i.e. at least one allow policy and no deny policy matches
+	Allowed bool
+	// Skipped is the reason why the request wasn't evaluated for the subject, if it wasn't
+	Skipped string
+}
+
+// MatchedPolicy is a policy which matches an RBAC request
+type MatchedPolicy struct {
+	// Source is where the policy is defined: the built-in policy, a key of the RBAC ConfigMap or a project
+	Source string
+	// Policy is the policy line as it is defined
+	Policy string
+	// Effect is the effect of the policy, allow or deny
+	Effect string
+}
+
+// ClaimsExplainerFunc is func template to trace the evaluation of a request by a ClaimsEnforcerFunc
+type ClaimsExplainerFunc func(claims jwt.Claims, rvals ...any) []SubjectExplanation
+
+// SetClaimsExplainerFunc sets the function which traces the evaluation of a request by the claims enforce function
+func (e *Enforcer) SetClaimsExplainerFunc(claimsExplainer ClaimsExplainerFunc) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.claimsExplainerFunc = claimsExplainer
+}
+
+// Explain traces the evaluation of a request by Enforce: for the default role, and for the subject of the request or,
+// if the subject is given as claims, for the subjects returned by the claims explainer function.
+func (e *Enforcer) Explain(rvals ...any) *Explanation {
+	explanation := &Explanation{Allowed: e.Enforce(rvals...)}
+	if len(rvals) == 0 {
+		return explanation
+	}
+	e.lock.Lock()
+	defaultRole, claimsExplainerFunc := e.defaultRole, e.claimsExplainerFunc
+	e.lock.Unlock()
+
+	if defaultRole != "" && len(rvals) >= 2 {
+		defaultRoleExplanation := e.ExplainRuntimePolicy("", "", append([]any{defaultRole}, rvals[1:]...)...)
+		explanation.DefaultRole = &defaultRoleExplanation
+	}
+	switch s := rvals[0].(type) {
+	case string:
+		explanation.Subjects = []SubjectExplanation{e.ExplainRuntimePolicy("", "", rvals...)}
+	case jwt.Claims:
+		if claimsExplainerFunc != nil {
+			explanation.Subjects = claimsExplainerFunc(s, rvals...)
+		}
+	}
+	return explanation
+}
+
+// ExplainRuntimePolicy traces the evaluation of a request for its subject against the built-in, the user-defined and
+// the given run-time policy of a project. Unlike EnforceRuntimePolicy, the default role is not considered.
+func (e *Enforcer) ExplainRuntimePolicy(project string, policy string, rvals ...any) SubjectExplanation {
+	if len(rvals) == 0 {
+		return SubjectExplanation{Skipped: "no subject"}
+	}
+	subject, ok := rvals[0].(string)
+	if !ok {
+		return SubjectExplanation{Skipped: fmt.Sprintf("unsupported subject %v", rvals[0])}
+	}
+	explanation := SubjectExplanation{Subject: subject}
+	enf, err := e.tryGetCasbinEnforcer(project, policy)
+	if err != nil {
+		explanation.Skipped = err.Error()
+		return explanation
+	}
+	rvals = withAttributes(rvals)
+	allowed, err := enf.Enforce(rvals...)
+	explanation.Allowed = allowed && err == nil
+	explanation.Roles, err = enf.GetImplicitRolesForUser(subject)
+	if err != nil {
+		explanation.Skipped = fmt.Sprintf("error getting roles: %v", err)
+		return explanation
+	}
+	explanation.Policies = e.matchingPolicies(project, policy, append([]string{subject}, explanation.Roles...), rvals)
+	return explanation
+}
+
+// matchingPolicies returns the policies of the given subjects which match the request, in the order of their sources:
+// the built-in policy, the user-defined policy and the run-time policy of the project.
+func (e *Enforcer) matchingPolicies(project string, policy string, subjects []string, rvals []any) []MatchedPolicy {
+	if len(rvals) != 5 {
+		return nil
+	}
+	request := make([]string, 3)
+	for i := range request {
+		val, ok := rvals[i+1].(string)
+		if !ok {
+			return nil
+		}
+		request[i] = val
+	}
+
+	e.lock.Lock()
+	sources := append([]PolicySource{{Name: BuiltinPolicySource, Policy: e.adapter.builtinPolicy}}, e.userPolicySources...)
+	var matchFunc govaluate.ExpressionFunction = globMatchFunc
+	if e.matchMode == RegexMatchMode {
+		matchFunc = util.RegexMatchFunc
+	}
+	e.lock.Unlock()
+	if policy != "" {
+		sources = append(sources, PolicySource{Name: "project " + project, Policy: policy})
+	}
+
+	matches := func(fn govaluate.ExpressionFunction, vals ...any) bool {
+		res, err := fn(vals...)
+		matched, _ := res.(bool)
+		return err == nil && matched
+	}
+	var matched []MatchedPolicy
+	for _, source := range sources {
+		for line := range strings.SplitSeq(source.Policy, "\n") {
+			line = strings.TrimSpace(line)
+			tokens, err := parsePolicyLine(line)
+			if err != nil || len(tokens) != 7 || tokens[0] != "p" || !slices.Contains(subjects, tokens[1]) {
+				continue
+			}
+			if !matches(matchFunc, request[0], tokens[2]) || !matches(matchFunc, request[1], tokens[3]) || !matches(matchFunc, request[2], tokens[4]) ||
+				!matches(conditionMatchFunc, rvals[0], rvals[4], tokens[5], tokens[6]) {
+				continue
+			}
+			matched = append(matched, MatchedPolicy{Source: source.Name, Policy: line, Effect: tokens[5]})
+		}
+	}
+	return matched
+}
@@ -0,0 +1,74 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplain(t *testing.T) {
+	enf := NewEnforcer(nil, "argocd", "argocd-rbac-cm", nil)
+	require.NoError(t, enf.SetBuiltinPolicy("p, role:readonly, applications, get, */*, allow"))
+	require.NoError(t, enf.SetUserPolicySources([]PolicySource{
+		{Name: ConfigMapPolicyCSVKey, Policy: "p, role:dev, applications, sync, */*, allow\ng, alice, role:dev\n# comment"},
+		{Name: "policy.prod.csv", Policy: "p, role:dev, applications, sync, */*, deny, label('env') == 'prod'\np, bob, applications, sync, */*, allow"},
+	}))
+	enf.SetDefaultRole("role:readonly")
+
+	t.Run("allowed", func(t *testing.T) {
+		explanation := enf.Explain("alice", "applications", "sync", "default/app", &Attributes{Labels: map[string]string{"env": "dev"}})
+		assert.True(t, explanation.Allowed)
+		require.NotNil(t, explanation.DefaultRole)
+		assert.False(t, explanation.DefaultRole.Allowed)
+		assert.Empty(t, explanation.DefaultRole.Policies)
+		require.Len(t, explanation.Subjects, 1)
+		assert.Equal(t, SubjectExplanation{
+			Subject:  "alice",
+			Roles:    []string{"role:dev"},
+			Policies: []MatchedPolicy{{Source: ConfigMapPolicyCSVKey, Policy: "p, role:dev, applications, sync, */*, allow", Effect: "allow"}},
+			Allowed:  true,
+		}, explanation.Subjects[0])
+	})
+
+	t.Run("denied by a condition", func(t *testing.T) {
+		explanation := enf.Explain("alice", "applications", "sync", "default/app", &Attributes{Labels: map[string]string{"env": "prod"}})
+		assert.False(t, explanation.Allowed)
+		require.Len(t, explanation.Subjects, 1)
+		assert.False(t, explanation.Subjects[0].Allowed)
+		assert.Equal(t, []MatchedPolicy{
+			{Source: ConfigMapPolicyCSVKey, Policy: "p, role:dev, applications, sync, */*, allow", Effect: "allow"},
+			{Source: "policy.prod.csv", Policy: "p, role:dev, applications, sync, */*, deny, label('env') == 'prod'", Effect: "deny"},
+		}, explanation.Subjects[0].Policies)
+	})
+
+	t.Run("default role", func(t *testing.T) {
+		explanation := enf.Explain("carol", "applications", "get", "default/app")
+		assert.True(t, explanation.Allowed)
+		require.NotNil(t, explanation.DefaultRole)
+		assert.True(t, explanation.DefaultRole.Allowed)
+		assert.Equal(t, []MatchedPolicy{{Source: BuiltinPolicySource, Policy: "p, role:readonly, applications, get, */*, allow", Effect: "allow"}}, explanation.DefaultRole.Policies)
+		require.Len(t, explanation.Subjects, 1)
+		assert.False(t, explanation.Subjects[0].Allowed)
+		assert.Empty(t, explanation.Subjects[0].Roles)
+	})
+
+	t.Run("run-time policy", func(t *testing.T) {
+		explanation := enf.ExplainRuntimePolicy("my-proj", "p, proj:my-proj:deployer, applications, sync, my-proj/*, allow\ng, carol, proj:my-proj:deployer", "carol", "applications", "sync", "my-proj/app")
+		assert.True(t, explanation.Allowed)
+		assert.Equal(t, []string{"proj:my-proj:deployer"}, explanation.Roles)
+		assert.Equal(t, []MatchedPolicy{{Source: "project my-proj", Policy: "p, proj:my-proj:deployer, applications, sync, my-proj/*, allow", Effect: "allow"}}, explanation.Policies)
+	})
+}
+
+func TestExplain_RegexMatchMode(t *testing.T) {
+	enf := NewEnforcer(nil, "argocd", "argocd-rbac-cm", nil)
+	enf.SetMatchMode(RegexMatchMode)
+	require.NoError(t, enf.SetUserPolicy("p, alice, applications, get, .*/guest.*, allow\np, alice, applications, get, .*/other, allow"))
+
+	explanation := enf.Explain("alice", "applications", "get", "default/guestbook")
+	assert.True(t, explanation.Allowed)
+	assert.Nil(t, explanation.DefaultRole)
+	require.Len(t, explanation.Subjects, 1)
+	assert.Equal(t, []MatchedPolicy{{Source: ConfigMapPolicyCSVKey, Policy: "p, alice, applications, get, .*/guest.*, allow", Effect: "allow"}}, explanation.Subjects[0].Policies)
+}
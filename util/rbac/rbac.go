@@ -53,6 +53,7 @@ type CasbinEnforcer interface {
 	GetGroupingPolicy() ([][]string, error)
 	GetAllRoles() ([]string, error)
 	GetImplicitPermissionsForUser(user string, domain ...string) ([][]string, error)
+	GetImplicitRolesForUser(name string, domain ...string) ([]string, error)
 }
 
 const (
@@ -127,18 +128,20 @@ var ProjectScoped = map[string]bool{
 // * supports a user-defined policy
 // * supports a custom JWT claims enforce function
 type Enforcer struct {
-	lock               sync.Mutex
-	enforcerCache      *gocache.Cache
-	adapter            *argocdAdapter
-	enableLog          bool
-	enabled            bool
-	clientset          kubernetes.Interface
-	namespace          string
-	configmap          string
-	claimsEnforcerFunc ClaimsEnforcerFunc
-	model              model.Model
-	defaultRole        string
-	matchMode          string
+	lock                sync.Mutex
+	enforcerCache       *gocache.Cache
+	adapter             *argocdAdapter
+	userPolicySources   []PolicySource
+	enableLog           bool
+	enabled             bool
+	clientset           kubernetes.Interface
+	namespace           string
+	configmap           string
+	claimsEnforcerFunc  ClaimsEnforcerFunc
+	claimsExplainerFunc ClaimsExplainerFunc
+	model               model.Model
+	defaultRole         string
+	matchMode           string
 }
 
 // cachedEnforcer holds the Casbin enforcer instances and optional custom project policy
@@ -179,6 +182,16 @@ func (e *Enforcer) tryGetCasbinEnforcer(project string, policy string) (CasbinEn
 	if cached != nil {
 		return cached.enforcer, nil
 	}
+	enforcer, err := e.newCasbinEnforcer(project, policy)
+	if err != nil {
+		return nil, err
+	}
+	e.enforcerCache.SetDefault(project, &cachedEnforcer{enforcer: enforcer, policy: policy})
+	return enforcer, nil
+}
+
+// newCasbinEnforcer creates an enforcer for the given optional project and project policy. The lock must be held.
+func (e *Enforcer) newCasbinEnforcer(project string, policy string) (CasbinEnforcer, error) {
 	matchFunc := globMatchFunc
 	if e.matchMode == RegexMatchMode {
 		matchFunc = util.RegexMatchFunc
@@ -202,7 +215,6 @@ func (e *Enforcer) tryGetCasbinEnforcer(project string, policy string) (CasbinEn
 	enforcer.AddFunction("globOrRegexMatch", matchFunc)
 	enforcer.EnableLog(e.enableLog)
 	enforcer.EnableEnforce(e.enabled)
-	e.enforcerCache.SetDefault(project, &cachedEnforcer{enforcer: enforcer, policy: policy})
 	return enforcer, nil
 }
 
@@ -221,6 +233,7 @@ func newEnforcerSafe(matchFunction govaluate.ExpressionFunction, params ...any)
 		return nil, err
 	}
 	enfs.AddFunction("globOrRegexMatch", matchFunction)
+	enfs.AddFunction("conditionMatch", conditionMatchFunc)
 	return enfs, nil
 }
 
@@ -353,9 +366,13 @@ func (e *Enforcer) EnforceErr(rvals ...any) error {
 		errMsg := "permission denied"
 
 		if len(rvals) > 0 {
-			rvalsStrs := make([]string, len(rvals)-1)
-			for i, rval := range rvals[1:] {
-				rvalsStrs[i] = fmt.Sprintf("%s", rval)
+			rvalsStrs := make([]string, 0, len(rvals)-1)
+			for _, rval := range rvals[1:] {
+				// the attributes of the request are not part of the error message
+				if _, ok := rval.(*Attributes); ok {
+					continue
+				}
+				rvalsStrs = append(rvalsStrs, fmt.Sprintf("%s", rval))
 			}
 			if s, ok := rvals[0].(jwt.Claims); ok {
 				claims, err := jwtutil.MapClaims(s)
@@ -392,17 +409,39 @@ func (e *Enforcer) CreateEnforcerWithRuntimePolicy(project string, policy string
 	return e.getCasbinEnforcer(project, policy)
 }
 
+// NewEnforcerWithRuntimePolicy creates an enforcer with a policy defined at run-time, like
+// CreateEnforcerWithRuntimePolicy, but without caching it. It does not replace the cached enforcer of the project, so
+// that the enforcers of policies which are only evaluated occasionally do not evict it.
+func (e *Enforcer) NewEnforcerWithRuntimePolicy(project string, policy string) (CasbinEnforcer, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.newCasbinEnforcer(project, policy)
+}
+
 // EnforceWithCustomEnforcer wraps enforce with an custom enforcer
 func (e *Enforcer) EnforceWithCustomEnforcer(enf CasbinEnforcer, rvals ...any) bool {
 	defaultRole, claimsEnforcerFunc := e.snapshotEnforceState()
 	return enforce(enf, defaultRole, claimsEnforcerFunc, rvals...)
 }
 
+// withAttributes returns the request values with nil attributes if the request has none, so that the conditions of the
+// policies are evaluated as for unknown attributes
+func withAttributes(rvals []any) []any {
+	if len(rvals) == 4 {
+		return append(rvals[:4:4], (*Attributes)(nil))
+	}
+	return rvals
+}
+
 // enforce is a helper to additionally check a default role and invoke a custom claims enforcement function
 func enforce(enf CasbinEnforcer, defaultRole string, claimsEnforcerFunc ClaimsEnforcerFunc, rvals ...any) bool {
+	// the scopes of a token narrow all its permissions, including the ones of the default role
+	if len(rvals) > 0 && !tokenScopesAllow(rvals...) {
+		return false
+	}
 	// check the default role
 	if defaultRole != "" && len(rvals) >= 2 {
-		if ok, err := enf.Enforce(append([]any{defaultRole}, rvals[1:]...)...); ok && err == nil {
+		if ok, err := enf.Enforce(withAttributes(append([]any{defaultRole}, rvals[1:]...))...); ok && err == nil {
 			return true
 		}
 	}
@@ -423,7 +462,7 @@ func enforce(enf CasbinEnforcer, defaultRole string, claimsEnforcerFunc ClaimsEn
 	default:
 		rvals = append([]any{""}, rvals[1:]...)
 	}
-	ok, err := enf.Enforce(rvals...)
+	ok, err := enf.Enforce(withAttributes(rvals)...)
 	return ok && err == nil
 }
 
@@ -437,8 +476,15 @@ func (e *Enforcer) SetBuiltinPolicy(policy string) error {
 
 // SetUserPolicy sets a user policy, augmenting the built-in policy
 func (e *Enforcer) SetUserPolicy(policy string) error {
+	return e.SetUserPolicySources([]PolicySource{{Name: ConfigMapPolicyCSVKey, Policy: policy}})
+}
+
+// SetUserPolicySources sets a user policy composed of the given sources, augmenting the built-in policy. The sources
+// are used to tell where the policies come from when explaining a request.
+func (e *Enforcer) SetUserPolicySources(sources []PolicySource) error {
 	e.invalidateCache(func() {
-		e.adapter.userDefinedPolicy = policy
+		e.adapter.userDefinedPolicy = JoinPolicySources(sources)
+		e.userPolicySources = sources
 	})
 	return e.LoadPolicy()
 }
@@ -518,16 +564,31 @@ func (e *Enforcer) rbacConfigMapEventHandler(onUpdated func(cm *corev1.ConfigMap
 	}
 }
 
+// PolicySource is a named part of the user-defined RBAC policy, e.g. one of the policy keys of the RBAC ConfigMap
+type PolicySource struct {
+	// Name is the name of the source, e.g. the key of the RBAC ConfigMap it comes from
+	Name string
+	// Policy is the policy CSV of the source
+	Policy string
+}
+
 // PolicyCSV will generate the final policy csv to be used
 // by Argo CD RBAC. It will find entries in the given data
 // that matches the policy key name convention:
 //
 //	policy[.overlay].csv
 func PolicyCSV(data map[string]string) string {
-	var strBuilder strings.Builder
+	return JoinPolicySources(PolicyCSVSources(data))
+}
+
+// PolicyCSVSources returns the entries of the given data which
+// the final policy csv is generated from, in order: the main
+// policy first, then the overlays sorted by key.
+func PolicyCSVSources(data map[string]string) []PolicySource {
+	var sources []PolicySource
 	// add the main policy first
 	if p, ok := data[ConfigMapPolicyCSVKey]; ok {
-		strBuilder.WriteString(p)
+		sources = append(sources, PolicySource{Name: ConfigMapPolicyCSVKey, Policy: p})
 	}
 
 	keys := make([]string, 0, len(data))
@@ -538,33 +599,40 @@ func PolicyCSV(data map[string]string) string {
 
 	// append additional policies at the end of the csv
 	for _, key := range keys {
-		value := data[key]
 		if strings.HasPrefix(key, "policy.") &&
 			strings.HasSuffix(key, ".csv") &&
 			key != ConfigMapPolicyCSVKey {
-			strBuilder.WriteString("\n")
-			strBuilder.WriteString(value)
+			sources = append(sources, PolicySource{Name: key, Policy: data[key]})
 		}
 	}
-	return strBuilder.String()
+	return sources
+}
+
+// JoinPolicySources returns the policy csv composed of the given sources
+func JoinPolicySources(sources []PolicySource) string {
+	policies := make([]string, len(sources))
+	for i, source := range sources {
+		policies[i] = source.Policy
+	}
+	return strings.Join(policies, "\n")
 }
 
 // syncUpdate updates the enforcer
 func (e *Enforcer) syncUpdate(cm *corev1.ConfigMap, onUpdated func(cm *corev1.ConfigMap) error) error {
 	e.SetDefaultRole(cm.Data[ConfigMapPolicyDefaultKey])
 	e.SetMatchMode(cm.Data[ConfigMapMatchModeKey])
-	policyCSV := PolicyCSV(cm.Data)
+	sources := PolicyCSVSources(cm.Data)
 	if err := onUpdated(cm); err != nil {
 		return fmt.Errorf("error running policy update callback: %w", err)
 	}
-	return e.SetUserPolicy(policyCSV)
+	return e.SetUserPolicySources(sources)
 }
 
 // ValidatePolicy verifies a policy string is acceptable to casbin
 func ValidatePolicy(policy string) error {
 	casbinEnforcer, err := newEnforcerSafe(globMatchFunc, newBuiltInModel(), newAdapter("", "", policy))
 	if err != nil {
-		return fmt.Errorf("policy syntax error: %s", policy)
+		return fmt.Errorf("policy syntax error: %w", err)
 	}
 
 	// Check for referential integrity
@@ -614,15 +682,35 @@ func (a *argocdAdapter) LoadPolicy(model model.Model) error {
 // The modified version of LoadPolicyLine function defined in "persist" package of github.com/casbin/casbin.
 // Uses CVS parser to correctly handle quotes in policy line.
 func loadPolicyLine(line string, model model.Model) error {
+	tokens, err := parsePolicyLine(line)
+	if err != nil || tokens == nil {
+		return err
+	}
+
+	key := tokens[0]
+	sec := key[:1]
+	if _, ok := model[sec]; !ok {
+		return fmt.Errorf("invalid RBAC policy: %s", line)
+	}
+	if _, ok := model[sec][key]; !ok {
+		return fmt.Errorf("invalid RBAC policy: %s", line)
+	}
+	model[sec][key].Policy = append(model[sec][key].Policy, tokens[1:])
+	return nil
+}
+
+// parsePolicyLine returns the tokens of a policy line, or nil if the line is empty or a comment. The condition of a
+// permission line is optional, it is an empty string if the line has none.
+func parsePolicyLine(line string) ([]string, error) {
 	if line == "" || strings.HasPrefix(line, "#") {
-		return nil
+		return nil, nil
 	}
 
 	reader := csv.NewReader(strings.NewReader(line))
 	reader.TrimLeadingSpace = true
 	tokens, err := reader.Read()
 	if err != nil {
-		return fmt.Errorf("error parsing policy line %q: %w", line, err)
+		return nil, fmt.Errorf("error parsing policy line %q: %w", line, err)
 	}
 
 	tokenLen := len(tokens)
@@ -630,20 +718,18 @@ func loadPolicyLine(line string, model model.Model) error {
 	if tokenLen < 1 ||
 		tokens[0] == "" ||
 		(tokens[0] == "g" && tokenLen != 3) ||
-		(tokens[0] == "p" && tokenLen != 6) {
-		return fmt.Errorf("invalid RBAC policy: %s", line)
+		(tokens[0] == "p" && tokenLen != 6 && tokenLen != 7) {
+		return nil, fmt.Errorf("invalid RBAC policy: %s", line)
 	}
-
-	key := tokens[0]
-	sec := key[:1]
-	if _, ok := model[sec]; !ok {
-		return fmt.Errorf("invalid RBAC policy: %s", line)
+	if tokens[0] == "p" && tokenLen == 6 {
+		tokens = append(tokens, "")
 	}
-	if _, ok := model[sec][key]; !ok {
-		return fmt.Errorf("invalid RBAC policy: %s", line)
+	if tokens[0] == "p" && tokens[6] != "" {
+		if err := ValidateCondition(tokens[6]); err != nil {
+			return nil, fmt.Errorf("invalid RBAC policy %s: %w", line, err)
+		}
 	}
-	model[sec][key].Policy = append(model[sec][key].Policy, tokens[1:])
-	return nil
+	return tokens, nil
 }
 
 func (a *argocdAdapter) SavePolicy(_ model.Model) error {
@@ -106,6 +106,21 @@ func TestPolicyCSV(t *testing.T) {
 	})
 }
 
+func TestPolicyCSVSources(t *testing.T) {
+	data := map[string]string{
+		"UnrelatedKey":        "unrelated value",
+		"policy.B.csv":        "policyb",
+		"policy.A.csv":        "policya",
+		ConfigMapPolicyCSVKey: "policy1",
+	}
+	assert.Equal(t, []PolicySource{
+		{Name: ConfigMapPolicyCSVKey, Policy: "policy1"},
+		{Name: "policy.A.csv", Policy: "policya"},
+		{Name: "policy.B.csv", Policy: "policyb"},
+	}, PolicyCSVSources(data))
+	assert.Empty(t, PolicyCSVSources(map[string]string{"UnrelatedKey": "unrelated value"}))
+}
+
 // TestBuiltinPolicyEnforcer tests the builtin policy rules
 func TestBuiltinPolicyEnforcer(t *testing.T) {
 	kubeclientset := fake.NewClientset()
@@ -437,6 +452,61 @@ func TestValidatePolicy(t *testing.T) {
 	}
 }
 
+func TestValidatePolicyWithConditions(t *testing.T) {
+	goodPolicies := []string{
+		"p, role:dev, applications, sync, */*, deny, label('env') == 'prod'",
+		`p, role:dev, applications, sync, */*, allow, "namespace in ('a', 'b')"`,
+		"p, role:dev, applications, sync, */*, allow,",
+	}
+	for _, good := range goodPolicies {
+		require.NoError(t, ValidatePolicy(good))
+	}
+	badPolicies := []string{
+		"p, role:dev, applications, sync, */*, allow, label('env') ==",
+		"p, role:dev, applications, sync, */*, allow, environment == 'prod'",
+		"p, role:dev, applications, sync, */*, allow, label('a', 'b')",
+		"p, role:dev, applications, sync, */*, allow, namespace",
+		"p, role:dev, applications, sync, */*, allow, namespace == 'a', kind == 'Pod'",
+	}
+	for _, bad := range badPolicies {
+		require.Error(t, ValidatePolicy(bad), bad)
+	}
+}
+
+// TestEnforceConditions tests the evaluation of the conditions of policies against the attributes of requests
+func TestEnforceConditions(t *testing.T) {
+	kubeclientset := fake.NewClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	require.NoError(t, enf.SetUserPolicy(`
+p, role:dev, applications, sync, */*, allow
+p, role:dev, applications, sync, */*, deny, label('env') == 'prod'
+p, role:dev, applications, action/apps/Deployment/restart, */*, allow, "hasSuffix(subject, ':' + label('team'))"
+g, my-org:dev, role:dev
+g, my-org:team-a, role:dev
+`))
+	prod := &Attributes{Labels: map[string]string{"env": "prod"}}
+	dev := &Attributes{Labels: map[string]string{"env": "dev", "team": "team-a"}}
+
+	assert.True(t, enf.Enforce("my-org:dev", "applications", "sync", "default/app", dev))
+	assert.False(t, enf.Enforce("my-org:dev", "applications", "sync", "default/app", prod))
+	// deny conditions match when the attributes are unknown
+	assert.False(t, enf.Enforce("my-org:dev", "applications", "sync", "default/app"))
+	assert.True(t, enf.Enforce("my-org:dev", "applications", "sync", "default/app", UnknownAttributes))
+
+	assert.True(t, enf.Enforce("my-org:team-a", "applications", "action/apps/Deployment/restart", "default/app", dev))
+	assert.False(t, enf.Enforce("my-org:dev", "applications", "action/apps/Deployment/restart", "default/app", dev))
+	assert.False(t, enf.Enforce("my-org:team-a", "applications", "action/apps/Deployment/restart", "default/app", prod))
+	// allow conditions don't match when the attributes are unknown
+	assert.False(t, enf.Enforce("my-org:team-a", "applications", "action/apps/Deployment/restart", "default/app"))
+
+	// the default role is also evaluated against the attributes
+	enf.SetDefaultRole("role:dev")
+	assert.True(t, enf.Enforce("bob", "applications", "sync", "default/app", dev))
+	assert.False(t, enf.Enforce("bob", "applications", "sync", "default/app", prod))
+
+	require.EqualError(t, enf.EnforceErr("bob", "applications", "sync", "default/app", prod), "rpc error: code = PermissionDenied desc = permission denied: applications, sync, default/app")
+}
+
 // TestEnforceErrorMessage ensures we give descriptive error message
 func TestEnforceErrorMessage(t *testing.T) {
 	kubeclientset := fake.NewClientset()
@@ -565,6 +635,17 @@ func TestLoadPolicyLine(t *testing.T) {
 		model := newBuiltInModel()
 		require.Error(t, loadPolicyLine(policy, model))
 	})
+	t.Run("Valid permission line with condition", func(t *testing.T) {
+		policy := `p, role:Myrole, applications, *, myproj/*, allow, "label('env') in ('dev', 'staging')"`
+		model := newBuiltInModel()
+		require.NoError(t, loadPolicyLine(policy, model))
+		assert.Equal(t, []string{"role:Myrole", "applications", "*", "myproj/*", "allow", "label('env') in ('dev', 'staging')"}, model["p"]["p"].Policy[0])
+	})
+	t.Run("Invalid policy line with invalid condition", func(t *testing.T) {
+		policy := `p, role:Myrole, applications, *, myproj/*, allow, label('env') ==`
+		model := newBuiltInModel()
+		require.Error(t, loadPolicyLine(policy, model))
+	})
 	t.Run("Invalid policy line missing policy type", func(t *testing.T) {
 		policy := ", role:Myrole, applications, *, myproj/*, allow"
 		model := newBuiltInModel()
@@ -0,0 +1,44 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/util/assets"
+)
+
+func TestTokenScopeMatches(t *testing.T) {
+	scope := TokenScope{Resource: "applications", Action: "sync", Object: "default/*"}
+	assert.True(t, scope.Matches("applications", "sync", "default/guestbook"))
+	assert.False(t, scope.Matches("applications", "delete", "default/guestbook"))
+	assert.False(t, scope.Matches("applications", "sync", "prod/guestbook"))
+	assert.False(t, scope.Matches("applicationsets", "sync", "default/guestbook"))
+
+	require.NoError(t, scope.Validate())
+	require.Error(t, TokenScope{Resource: "applications", Action: "sync"}.Validate())
+}
+
+func TestEnforce_TokenScopes(t *testing.T) {
+	kubeclientset := fake.NewClientset(fakeConfigMap())
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	require.NoError(t, enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+	enf.SetDefaultRole("role:admin")
+
+	unscoped := jwt.MapClaims{"sub": "alice"}
+	assert.True(t, enf.Enforce(unscoped, "applications", "delete", "default/guestbook"))
+
+	// the scopes narrow the permissions of the default role, too
+	scoped := jwt.MapClaims{"sub": "alice", TokenScopesClaim: []any{
+		map[string]any{"resource": "applications", "action": "sync", "object": "default/*"},
+	}}
+	assert.True(t, enf.Enforce(scoped, "applications", "sync", "default/guestbook"))
+	assert.False(t, enf.Enforce(scoped, "applications", "delete", "default/guestbook"))
+	assert.False(t, enf.Enforce(scoped, "clusters", "get", "*"))
+
+	assert.False(t, enf.Enforce(jwt.MapClaims{"sub": "alice", TokenScopesClaim: []any{}}, "applications", "sync", "default/guestbook"))
+	assert.False(t, enf.Enforce(jwt.MapClaims{"sub": "alice", TokenScopesClaim: "applications"}, "applications", "sync", "default/guestbook"))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"math/rand"
 	"net"
@@ -35,6 +36,7 @@ import (
 	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
 	oidcutil "github.com/argoproj/argo-cd/v3/util/oidc"
 	passwordutil "github.com/argoproj/argo-cd/v3/util/password"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
@@ -66,7 +68,10 @@ type MetricsRegistry interface {
 const (
 	// SessionManagerClaimsIssuer fills the "iss" field of the token.
 	SessionManagerClaimsIssuer = "argocd"
-	AuthErrorCtxKey            = "auth-error"
+	// PersonalTokenClaim marks the personal access tokens of SSO users. Like the tokens of local accounts, they are
+	// issued by Argo CD, but their subject is an SSO user.
+	PersonalTokenClaim = "pat"
+	AuthErrorCtxKey    = "auth-error"
 
 	// invalidLoginError, for security purposes, doesn't say whether the username or password was invalid.  This does not mitigate the potential for timing attacks to determine which is which.
 	invalidLoginError           = "Invalid username or password"
@@ -190,6 +195,27 @@ func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id
 	return mgr.signClaims(claims)
 }
 
+// CreatePersonalToken creates a new personal access token for a given SSO user and returns it as a string. The token
+// carries the given claims of the user, i.e. its groups, and the scopes its permissions are narrowed to, if any.
+func (mgr *SessionManager) CreatePersonalToken(subject string, secondsBeforeExpiry int64, id string, userClaims jwt.MapClaims, scopes []rbac.TokenScope) (string, error) {
+	now := time.Now().UTC()
+	claims := jwt.MapClaims{}
+	maps.Copy(claims, userClaims)
+	claims["iat"] = jwt.NewNumericDate(now)
+	claims["iss"] = SessionManagerClaimsIssuer
+	claims["nbf"] = jwt.NewNumericDate(now)
+	claims["sub"] = subject
+	claims["jti"] = id
+	claims[PersonalTokenClaim] = true
+	if secondsBeforeExpiry > 0 {
+		claims["exp"] = jwt.NewNumericDate(now.Add(time.Duration(secondsBeforeExpiry) * time.Second))
+	}
+	if scopes != nil {
+		claims[rbac.TokenScopesClaim] = scopes
+	}
+	return mgr.signClaims(claims)
+}
+
 func (mgr *SessionManager) CollectMetrics(registry MetricsRegistry) {
 	mgr.metricsRegistry = registry
 	if mgr.metricsRegistry == nil {
@@ -259,6 +285,13 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 	subject := jwtutil.GetUserIdentifier(claims)
 	id := jwtutil.StringField(claims, "jti")
 
+	if IsPersonalTokenClaims(claims) {
+		if err := mgr.verifyPersonalToken(subject, id); err != nil {
+			return nil, "", err
+		}
+		return token.Claims, "", nil
+	}
+
 	if projName, role, ok := rbacpolicy.GetProjectRoleFromSubject(subject); ok {
 		proj, err := mgr.projectsLister.Get(projName)
 		if err != nil {
@@ -319,6 +352,31 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 	return token.Claims, newToken, nil
 }
 
+// verifyPersonalToken verifies that the personal access token with the given identifier of an SSO user is neither
+// revoked nor deleted
+func (mgr *SessionManager) verifyPersonalToken(subject string, id string) error {
+	if id == "" {
+		return errors.New("token does not have a unique identifier (jti claim) and cannot be validated")
+	}
+	if mgr.storage.IsTokenRevoked(id) {
+		return errors.New("token is revoked")
+	}
+	token, err := mgr.settingsMgr.GetPersonalToken(id)
+	if err != nil {
+		return err
+	}
+	if token.Subject != subject {
+		return fmt.Errorf("personal token with id %s does not belong to %s", id, subject)
+	}
+	return nil
+}
+
+// IsPersonalTokenClaims returns whether the given claims are the claims of a personal access token of an SSO user
+func IsPersonalTokenClaims(claims jwt.MapClaims) bool {
+	pat, _ := claims[PersonalTokenClaim].(bool)
+	return pat
+}
+
 // GetLoginFailures retrieves the login failure information from the cache. Any modifications to the LoginAttemps map must be done in a thread-safe manner.
 func (mgr *SessionManager) GetLoginFailures() map[string]LoginAttempts {
 	// Get failures from the cache
@@ -685,6 +743,15 @@ func Iss(ctx context.Context) string {
 	return jwtutil.StringField(mapClaims, "iss")
 }
 
+// IsPersonalToken returns whether the request is authenticated with a personal access token of an SSO user
+func IsPersonalToken(ctx context.Context) bool {
+	mapClaims, ok := mapClaims(ctx)
+	if !ok {
+		return false
+	}
+	return IsPersonalTokenClaims(mapClaims)
+}
+
 func Iat(ctx context.Context) (time.Time, error) {
 	mapClaims, ok := mapClaims(ctx)
 	if !ok {
@@ -38,6 +38,7 @@ import (
 	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
 	"github.com/argoproj/argo-cd/v3/util/oidc"
 	"github.com/argoproj/argo-cd/v3/util/password"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 	utiltest "github.com/argoproj/argo-cd/v3/util/test"
 )
@@ -284,6 +285,62 @@ func TestSessionManager_ProjectToken(t *testing.T) {
 	})
 }
 
+func TestSessionManager_PersonalToken(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClient(t, "pass", true), "argocd")
+	storage := NewUserStateStorage(redisClient)
+	mgr := newSessionManager(settingsMgr, getProjLister(), storage)
+	require.NoError(t, settingsMgr.UpdatePersonalTokens(func(tokens []settings.PersonalToken) ([]settings.PersonalToken, error) {
+		return append(tokens, settings.PersonalToken{ID: "abc", Subject: "alice", IssuedAt: time.Now().Unix(), ExpiresAt: time.Now().Add(time.Hour).Unix()}), nil
+	}))
+
+	t.Run("Valid Token", func(t *testing.T) {
+		scopes := []rbac.TokenScope{{Resource: "applications", Action: "get", Object: "*"}}
+		jwtToken, err := mgr.CreatePersonalToken("alice", 100, "abc", jwt.MapClaims{"groups": []string{"team-a"}}, scopes)
+		require.NoError(t, err)
+
+		claims, newToken, err := mgr.Parse(jwtToken)
+		require.NoError(t, err)
+		assert.Empty(t, newToken)
+
+		mapClaims, err := jwtutil.MapClaims(claims)
+		require.NoError(t, err)
+		assert.Equal(t, "alice", mapClaims["sub"])
+		assert.Equal(t, []any{"team-a"}, mapClaims["groups"])
+		assert.True(t, IsPersonalTokenClaims(mapClaims))
+		tokenScopes, narrowed := rbac.GetTokenScopes(claims)
+		assert.True(t, narrowed)
+		assert.Equal(t, scopes, tokenScopes)
+	})
+
+	t.Run("Token Of Another User", func(t *testing.T) {
+		jwtToken, err := mgr.CreatePersonalToken("bob", 100, "abc", nil, nil)
+		require.NoError(t, err)
+
+		_, _, err = mgr.Parse(jwtToken)
+		assert.EqualError(t, err, "personal token with id abc does not belong to bob")
+	})
+
+	t.Run("Token Deleted", func(t *testing.T) {
+		jwtToken, err := mgr.CreatePersonalToken("alice", 100, "def", nil, nil)
+		require.NoError(t, err)
+
+		_, _, err = mgr.Parse(jwtToken)
+		assert.ErrorContains(t, err, "personal token with id 'def' does not exist")
+	})
+
+	t.Run("Token Revoked", func(t *testing.T) {
+		jwtToken, err := mgr.CreatePersonalToken("alice", 100, "abc", nil, nil)
+		require.NoError(t, err)
+		require.NoError(t, storage.RevokeToken(t.Context(), "abc", time.Hour))
+
+		_, _, err = mgr.Parse(jwtToken)
+		assert.EqualError(t, err, "token is revoked")
+	})
+}
+
 type tokenVerifierMock struct {
 	claims jwt.Claims
 	err    error
@@ -15,6 +15,7 @@ import (
 	"k8s.io/client-go/util/retry"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
 )
 
 const (
@@ -31,6 +32,9 @@ const (
 	settingAdminPasswordMtimeKey = "admin.passwordMtime"
 	settingAdminEnabledKey       = "admin.enabled"
 	settingAdminTokensKey        = "admin.tokens"
+
+	// settingPersonalTokensKey designates the key for the personal access tokens of SSO users inside a Kubernetes secret.
+	settingPersonalTokensKey = "personal.tokens"
 )
 
 type AccountCapability string
@@ -49,6 +53,19 @@ type Token struct {
 	ExpiresAt int64  `json:"exp,omitempty"`
 }
 
+// PersonalToken holds the information about a personal access token of an SSO user.
+type PersonalToken struct {
+	ID          string `json:"id"`
+	Subject     string `json:"sub"`
+	Description string `json:"description,omitempty"`
+	IssuedAt    int64  `json:"iat"`
+	ExpiresAt   int64  `json:"exp"`
+	// Groups are the groups of the user when the token was issued, which the token is a member of
+	Groups []string `json:"groups,omitempty"`
+	// Scopes narrow the permissions of the token, if set
+	Scopes []rbac.TokenScope `json:"scopes,omitempty"`
+}
+
 // Account holds local account information
 type Account struct {
 	PasswordHash  string
@@ -311,3 +328,66 @@ func parseAccounts(secret *corev1.Secret, cm *corev1.ConfigMap) (map[string]Acco
 
 	return accounts, nil
 }
+
+// GetPersonalTokens returns the personal access tokens of all SSO users
+func (mgr *SettingsManager) GetPersonalTokens() ([]PersonalToken, error) {
+	secret, err := mgr.getSecret()
+	if err != nil {
+		return nil, err
+	}
+	return parsePersonalTokens(secret)
+}
+
+// GetPersonalToken returns the personal access token with the given identifier.
+func (mgr *SettingsManager) GetPersonalToken(id string) (*PersonalToken, error) {
+	tokens, err := mgr.GetPersonalTokens()
+	if err != nil {
+		return nil, err
+	}
+	for i := range tokens {
+		if tokens[i].ID == id {
+			return &tokens[i], nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "personal token with id '%s' does not exist", id)
+}
+
+// UpdatePersonalTokens runs the callback function against the personal access tokens of all SSO users and persists
+// the tokens returned by the callback. Expired tokens are dropped.
+func (mgr *SettingsManager) UpdatePersonalTokens(callback func(tokens []PersonalToken) ([]PersonalToken, error)) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		return mgr.updateSecret(func(secret *corev1.Secret) error {
+			tokens, err := parsePersonalTokens(secret)
+			if err != nil {
+				return err
+			}
+			tokens, err = callback(tokens)
+			if err != nil {
+				return err
+			}
+			now := time.Now().Unix()
+			tokens = slices.DeleteFunc(tokens, func(token PersonalToken) bool {
+				return token.ExpiresAt > 0 && token.ExpiresAt <= now
+			})
+			data, err := json.Marshal(tokens)
+			if err != nil {
+				return err
+			}
+			if secret.Data == nil {
+				secret.Data = make(map[string][]byte)
+			}
+			updateAccountSecret(secret, settingPersonalTokensKey, string(data), "[]")
+			return nil
+		})
+	})
+}
+
+func parsePersonalTokens(secret *corev1.Secret) ([]PersonalToken, error) {
+	tokens := make([]PersonalToken, 0)
+	if tokensStr, ok := secret.Data[settingPersonalTokensKey]; ok && len(tokensStr) != 0 {
+		if err := json.Unmarshal(tokensStr, &tokens); err != nil {
+			return nil, fmt.Errorf("secret '%s' has invalid personal tokens: %w", secret.Name, err)
+		}
+	}
+	return tokens, nil
+}
@@ -1,6 +1,7 @@
 package settings
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
 )
 
 func TestGetAccounts_NoAccountsConfigured(t *testing.T) {
@@ -253,3 +255,47 @@ func TestUpdateAccount_AccountDoesNotExist(t *testing.T) {
 	})
 	require.Error(t, err)
 }
+
+func TestGetPersonalTokens(t *testing.T) {
+	t.Parallel()
+	_, settingsManager := fixtures(t.Context(), nil, func(secret *corev1.Secret) {
+		secret.Data["personal.tokens"] = []byte(`[{"id":"123","sub":"alice","iat":1583789194,"exp":1583789294,"groups":["team-a"],"scopes":[{"resource":"applications","action":"get","object":"*"}]}]`)
+	})
+	tokens, err := settingsManager.GetPersonalTokens()
+	require.NoError(t, err)
+	assert.Equal(t, []PersonalToken{{
+		ID:        "123",
+		Subject:   "alice",
+		IssuedAt:  1583789194,
+		ExpiresAt: 1583789294,
+		Groups:    []string{"team-a"},
+		Scopes:    []rbac.TokenScope{{Resource: "applications", Action: "get", Object: "*"}},
+	}}, tokens)
+
+	token, err := settingsManager.GetPersonalToken("123")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", token.Subject)
+
+	_, err = settingsManager.GetPersonalToken("456")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUpdatePersonalTokens(t *testing.T) {
+	t.Parallel()
+	clientset, settingsManager := fixtures(t.Context(), nil, func(secret *corev1.Secret) {
+		secret.Data["personal.tokens"] = []byte(`[{"id":"expired","sub":"alice","iat":1583789194,"exp":1583789294}]`)
+	})
+	expiresAt := time.Now().Add(time.Hour).Unix()
+	err := settingsManager.UpdatePersonalTokens(func(tokens []PersonalToken) ([]PersonalToken, error) {
+		return append(tokens, PersonalToken{ID: "123", Subject: "bob", IssuedAt: time.Now().Unix(), ExpiresAt: expiresAt}), nil
+	})
+	require.NoError(t, err)
+
+	secret, err := clientset.CoreV1().Secrets("default").Get(t.Context(), common.ArgoCDSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	var tokens []PersonalToken
+	require.NoError(t, json.Unmarshal(secret.Data["personal.tokens"], &tokens))
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "123", tokens[0].ID)
+	assert.Equal(t, expiresAt, tokens[0].ExpiresAt)
+}
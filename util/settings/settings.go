@@ -503,6 +503,10 @@ const (
 	resourceIncludeEventLabelKeys = "resource.includeEventLabelKeys"
 	// resourceExcludeEventLabelKeys is the key to labels to be excluded from adding onto Application's k8s events. Supports wildcard.
 	resourceExcludeEventLabelKeys = "resource.excludeEventLabelKeys"
+	// syncWindowCalendarURLsKey is the key to the URLs sync window calendars may be fetched from. Supports wildcard.
+	syncWindowCalendarURLsKey = "syncwindows.calendar.urls"
+	// syncWindowCalendarConfigMapsKey is the key to the ConfigMaps sync window calendars may be read from. Supports wildcard.
+	syncWindowCalendarConfigMapsKey = "syncwindows.calendar.configmaps"
 	// kustomizeBuildOptionsKey is a string of kustomize build parameters
 	kustomizeBuildOptionsKey = "kustomize.buildOptions"
 	// kustomizeVersionKeyPrefix is a kustomize version key prefix
@@ -513,6 +517,8 @@ const (
 	anonymousUserEnabledKey = "users.anonymous.enabled"
 	// userSessionDurationKey is the key which specifies token expiration duration
 	userSessionDurationKey = "users.session.duration"
+	// personalTokenMaxLifetimeKey is the key which specifies the maximum lifetime of the personal tokens of SSO users
+	personalTokenMaxLifetimeKey = "users.personalTokens.maxLifetime"
 	// diffOptions is the key where diff options are configured
 	resourceCompareOptionsKey = "resource.compareoptions"
 	// settingUICSSURLKey designates the key for user-defined CSS URL for UI customization
@@ -595,6 +601,9 @@ const (
 	// default webhook refresh jitter threshold
 	defaultWebhookRefreshJitterThreshold = 10
 
+	// DefaultPersonalTokenMaxLifetime is the default maximum lifetime of the personal tokens of SSO users
+	DefaultPersonalTokenMaxLifetime = 90 * 24 * time.Hour
+
 	// application sync with impersonation feature is disabled by default.
 	defaultImpersonationEnabledFlag = false
 
@@ -1041,6 +1050,9 @@ func (mgr *SettingsManager) GetIgnoreResourceUpdatesOverrides() (map[string]v1al
 			resourceUpdates.JQPathExpressions = append(resourceUpdates.JQPathExpressions, v.IgnoreDifferences.JQPathExpressions...)
 			resourceUpdates.JSONPointers = append(resourceUpdates.JSONPointers, v.IgnoreDifferences.JSONPointers...)
 			resourceUpdates.ManagedFieldsManagers = append(resourceUpdates.ManagedFieldsManagers, v.IgnoreDifferences.ManagedFieldsManagers...)
+			// the merged CEL expressions can't share a single condition, so each expression carries its own
+			resourceUpdates.CELExpressions = append(conditionalCELExpressions(resourceUpdates), conditionalCELExpressions(v.IgnoreDifferences)...)
+			resourceUpdates.CELCondition = ""
 		}
 		// Set the IgnoreDifferences because these are the overrides used by Normalizers
 		v.IgnoreDifferences = resourceUpdates
@@ -1146,6 +1158,19 @@ func (mgr *SettingsManager) GetCommitAuthorEmail() (string, error) {
 	return argoCDCM.Data[settingsCommitAuthorEmailKey], nil
 }
 
+// conditionalCELExpressions returns the CEL expressions of the given ignore differences, which only return fields if
+// their condition is met
+func conditionalCELExpressions(ignoreDiff v1alpha1.OverrideIgnoreDiff) []string {
+	if ignoreDiff.CELCondition == "" {
+		return ignoreDiff.CELExpressions
+	}
+	expressions := make([]string, 0, len(ignoreDiff.CELExpressions))
+	for _, expression := range ignoreDiff.CELExpressions {
+		expressions = append(expressions, fmt.Sprintf("(%s) ? dyn(%s) : dyn([])", ignoreDiff.CELCondition, expression))
+	}
+	return expressions
+}
+
 func addStatusOverrideToGK(resourceOverrides map[string]v1alpha1.ResourceOverride, groupKind string) {
 	if val, ok := resourceOverrides[groupKind]; ok {
 		val.IgnoreDifferences.JSONPointers = append(val.IgnoreDifferences.JSONPointers, "/status")
@@ -1198,6 +1223,8 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 		switch customizationType {
 		case "health":
 			overrideVal.HealthLua = v
+		case "healthCEL":
+			overrideVal.HealthCEL = v
 		case "useOpenLibs":
 			useOpenLibs, err := strconv.ParseBool(v)
 			if err != nil {
@@ -2767,6 +2794,31 @@ func (mgr *SettingsManager) GetExcludeEventLabelKeys() []string {
 	return labelKeys
 }
 
+// GetSyncWindowCalendarURLs returns the patterns of the URLs sync window calendars may be fetched from
+func (mgr *SettingsManager) GetSyncWindowCalendarURLs() []string {
+	return mgr.getCommaSeparatedList(syncWindowCalendarURLsKey)
+}
+
+// GetSyncWindowCalendarConfigMaps returns the patterns of the names of the ConfigMaps sync window calendars may be read from
+func (mgr *SettingsManager) GetSyncWindowCalendarConfigMaps() []string {
+	return mgr.getCommaSeparatedList(syncWindowCalendarConfigMapsKey)
+}
+
+func (mgr *SettingsManager) getCommaSeparatedList(key string) []string {
+	items := []string{}
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		log.Error(fmt.Errorf("failed getting configmap: %w", err))
+		return items
+	}
+	for item := range strings.SplitSeq(argoCDCM.Data[key], ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 func (mgr *SettingsManager) GetSensitiveAnnotations() map[string]bool {
 	annotationKeys := make(map[string]bool)
 
@@ -2827,6 +2879,27 @@ func (mgr *SettingsManager) GetWebhookRefreshJitter() time.Duration {
 	return *jitter
 }
 
+// GetPersonalTokenMaxLifetime returns the maximum lifetime of the personal tokens of SSO users
+func (mgr *SettingsManager) GetPersonalTokenMaxLifetime() time.Duration {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		log.Warnf("Failed to get config map for personal token max lifetime: %v", err)
+		return DefaultPersonalTokenMaxLifetime
+	}
+
+	if argoCDCM.Data[personalTokenMaxLifetimeKey] == "" {
+		return DefaultPersonalTokenMaxLifetime
+	}
+
+	maxLifetime, err := timeutil.ParseDuration(argoCDCM.Data[personalTokenMaxLifetimeKey])
+	if err != nil || *maxLifetime <= 0 {
+		log.Warnf("Failed to parse '%s' key as a positive duration: %v", personalTokenMaxLifetimeKey, err)
+		return DefaultPersonalTokenMaxLifetime
+	}
+
+	return *maxLifetime
+}
+
 func (mgr *SettingsManager) GetWebhookRefreshJitterThreshold() int {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
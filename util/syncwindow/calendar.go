@@ -0,0 +1,280 @@
+package syncwindow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/env"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// configMapCacheTTL is the duration calendars loaded from a ConfigMap are cached for
+	configMapCacheTTL = time.Minute
+	// maxCalendarSize is the maximum size of calendars loaded from a URL
+	maxCalendarSize = 1024 * 1024
+	// maxCalendarRedirects is the maximum number of redirects followed when loading a calendar from a URL
+	maxCalendarRedirects = 10
+	// fetchTimeout is the timeout of loading a calendar
+	fetchTimeout = 10 * time.Second
+	// refreshInterval is the interval at which the calendars whose cache expired are loaded again
+	refreshInterval = 30 * time.Second
+	// unusedCalendarExpiration is the duration after which a calendar which is not used anymore is removed from the cache
+	unusedCalendarExpiration = 24 * time.Hour
+)
+
+// urlCacheTTL is the duration calendars fetched from a URL are cached for
+var urlCacheTTL = env.ParseDurationFromEnv("ARGOCD_SYNC_WINDOW_CALENDAR_CACHE_TTL", time.Hour, time.Minute, 24*time.Hour)
+
+type cachedCalendar struct {
+	calendar v1alpha1.SyncWindowCalendar
+	// data is the data of the last successful load, which is kept if the calendar cannot be loaded anymore
+	data     string
+	loadedAt time.Time
+	// err is the error of the last load, if it failed
+	err         error
+	attemptedAt time.Time
+	loading     bool
+	usedAt      time.Time
+}
+
+// CalendarLoader loads the iCalendar data of sync window calendars which reference a ConfigMap in the Argo CD
+// namespace or a URL. Only the ConfigMaps and URLs permitted by the Argo CD settings are loaded. The calendars are
+// loaded and refreshed in the background, and the sync windows are evaluated with the cached data, so that evaluating
+// them never waits for a calendar to be loaded.
+type CalendarLoader struct {
+	kubeClientset kubernetes.Interface
+	settingsMgr   *settings.SettingsManager
+	namespace     string
+	httpClient    *http.Client
+
+	lock  sync.Mutex
+	cache map[string]*cachedCalendar
+}
+
+// NewCalendarLoader creates a loader for calendars stored in ConfigMaps of the given namespace or served from a URL
+func NewCalendarLoader(kubeClientset kubernetes.Interface, settingsMgr *settings.SettingsManager, namespace string) *CalendarLoader {
+	l := &CalendarLoader{
+		kubeClientset: kubeClientset,
+		settingsMgr:   settingsMgr,
+		namespace:     namespace,
+		cache:         map[string]*cachedCalendar{},
+	}
+	l.httpClient = &http.Client{Timeout: fetchTimeout, CheckRedirect: l.checkRedirect}
+	return l
+}
+
+// Run refreshes the cached calendars whose cache expired until the context is done
+func (l *CalendarLoader) Run(ctx context.Context) {
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.refresh()
+		}
+	}
+}
+
+func (l *CalendarLoader) refresh() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for key, entry := range l.cache {
+		if time.Since(entry.usedAt) > unusedCalendarExpiration {
+			delete(l.cache, key)
+			continue
+		}
+		l.loadIfExpired(key, entry)
+	}
+}
+
+// Resolve returns a copy of the given sync windows in which the calendars referencing a ConfigMap or a URL contain the
+// data loaded for them, so that the windows can be evaluated. The calendars are only read from the cache: a calendar
+// which is not cached yet is loaded in the background, and cannot be resolved until then. A nil loader returns the
+// windows unchanged.
+func (l *CalendarLoader) Resolve(windows *v1alpha1.SyncWindows) (*v1alpha1.SyncWindows, error) {
+	if l == nil || !windows.HasWindows() {
+		return windows, nil
+	}
+	res := make(v1alpha1.SyncWindows, 0, len(*windows))
+	var errs []error
+	for _, window := range *windows {
+		// all the windows are resolved, so that all their calendars are loaded even if one of them cannot be resolved
+		resolved, err := l.ResolveWindow(window)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		res = append(res, resolved)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return &res, nil
+}
+
+// ResolveWindow returns a copy of the given sync window in which a calendar referencing a ConfigMap or a URL contains
+// the data loaded for it, see Resolve
+func (l *CalendarLoader) ResolveWindow(window *v1alpha1.SyncWindow) (*v1alpha1.SyncWindow, error) {
+	calendar := window.Calendar
+	if l == nil || calendar == nil || calendar.ICS != "" || (calendar.ConfigMapRef == nil && calendar.URL == "") {
+		return window, nil
+	}
+	data, err := l.getCachedCalendar(calendar)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load calendar %s: %w", calendar.Source(), err)
+	}
+	resolved := window.DeepCopy()
+	// the reference of the calendar is kept along with its data, so that the errors refer to it
+	resolved.Calendar.ICS = data
+	return resolved, nil
+}
+
+// getCachedCalendar returns the cached data of the given calendar, and loads it in the background if its cache expired
+func (l *CalendarLoader) getCachedCalendar(calendar *v1alpha1.SyncWindowCalendar) (string, error) {
+	if err := l.checkPermitted(calendar); err != nil {
+		return "", err
+	}
+	key := calendar.Source()
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	entry, ok := l.cache[key]
+	if !ok {
+		entry = &cachedCalendar{calendar: *calendar.DeepCopy()}
+		l.cache[key] = entry
+	}
+	entry.usedAt = time.Now()
+	l.loadIfExpired(key, entry)
+	if entry.loadedAt.IsZero() {
+		if entry.err != nil {
+			return "", entry.err
+		}
+		return "", errors.New("the calendar has not been loaded yet")
+	}
+	return entry.data, nil
+}
+
+// loadIfExpired loads the given calendar in the background if its cache expired. The lock must be held.
+func (l *CalendarLoader) loadIfExpired(key string, entry *cachedCalendar) {
+	ttl := urlCacheTTL
+	if entry.calendar.ConfigMapRef != nil {
+		ttl = configMapCacheTTL
+	}
+	// the calendars which failed to load are retried sooner
+	if entry.err != nil {
+		ttl = min(ttl, refreshInterval)
+	}
+	if entry.loading || (!entry.attemptedAt.IsZero() && time.Since(entry.attemptedAt) < ttl) {
+		return
+	}
+	entry.loading = true
+	go l.load(key, entry.calendar)
+}
+
+func (l *CalendarLoader) load(key string, calendar v1alpha1.SyncWindowCalendar) {
+	data, err := l.fetch(&calendar)
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	entry, ok := l.cache[key]
+	if !ok {
+		return
+	}
+	entry.loading = false
+	entry.attemptedAt = time.Now()
+	entry.err = err
+	if err != nil {
+		if !entry.loadedAt.IsZero() {
+			log.WithError(err).Warnf("Failed to refresh sync window calendar %s, using the calendar loaded at %s", key, entry.loadedAt.Format(time.RFC3339))
+		} else {
+			log.WithError(err).Warnf("Failed to load sync window calendar %s", key)
+		}
+		return
+	}
+	entry.data = data
+	entry.loadedAt = entry.attemptedAt
+}
+
+// checkPermitted returns an error if the settings don't permit loading the given calendar
+func (l *CalendarLoader) checkPermitted(calendar *v1alpha1.SyncWindowCalendar) error {
+	if ref := calendar.ConfigMapRef; ref != nil {
+		if !glob.MatchStringInList(l.settingsMgr.GetSyncWindowCalendarConfigMaps(), ref.Name, glob.GLOB) {
+			return fmt.Errorf("ConfigMap %s is not permitted by the sync window calendar settings", ref.Name)
+		}
+		return nil
+	}
+	if calendar.URL != "" && !glob.MatchStringInList(l.settingsMgr.GetSyncWindowCalendarURLs(), calendar.URL, glob.GLOB) {
+		return errors.New("URL is not permitted by the sync window calendar settings")
+	}
+	return nil
+}
+
+// checkRedirect only follows the redirects to URLs which are permitted by the settings
+func (l *CalendarLoader) checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxCalendarRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxCalendarRedirects)
+	}
+	if !glob.MatchStringInList(l.settingsMgr.GetSyncWindowCalendarURLs(), req.URL.String(), glob.GLOB) {
+		return errors.New("redirect to a URL which is not permitted by the sync window calendar settings")
+	}
+	return nil
+}
+
+func (l *CalendarLoader) fetch(calendar *v1alpha1.SyncWindowCalendar) (string, error) {
+	// the settings may have changed since the calendar was first loaded
+	if err := l.checkPermitted(calendar); err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+
+	if ref := calendar.ConfigMapRef; ref != nil {
+		cm, err := l.kubeClientset.CoreV1().ConfigMaps(l.namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("error getting ConfigMap %s: %w", ref.Name, err)
+		}
+		data, ok := cm.Data[ref.Key]
+		if !ok {
+			return "", fmt.Errorf("key %s not found in ConfigMap %s", ref.Key, ref.Name)
+		}
+		return data, nil
+	}
+	if calendar.URL == "" {
+		return "", errors.New("calendar has no source")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, calendar.URL, http.NoBody)
+	if err != nil {
+		return "", fmt.Errorf("error creating request: %w", err)
+	}
+	resp, err := l.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error fetching calendar: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error fetching calendar: unexpected status %s", resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxCalendarSize+1))
+	if err != nil {
+		return "", fmt.Errorf("error reading calendar: %w", err)
+	}
+	if len(body) > maxCalendarSize {
+		return "", fmt.Errorf("calendar exceeds the maximum size of %d bytes", maxCalendarSize)
+	}
+	return string(body), nil
+}
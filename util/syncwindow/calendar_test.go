@@ -0,0 +1,182 @@
+package syncwindow
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const testCalendar = "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20261225\nEND:VEVENT\nEND:VCALENDAR"
+
+func newTestCalendarLoader(t *testing.T, calendarSettings map[string]string, objs ...runtime.Object) *CalendarLoader {
+	t.Helper()
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: "argocd",
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: calendarSettings,
+	}
+	kubeClientset := fake.NewClientset(append(objs, cm)...)
+	return NewCalendarLoader(kubeClientset, settings.NewSettingsManager(t.Context(), kubeClientset, "argocd"), "argocd")
+}
+
+// resolveCalendar resolves a window with the given calendar once the calendar has been loaded in the background
+func resolveCalendar(t *testing.T, loader *CalendarLoader, calendar *v1alpha1.SyncWindowCalendar) (string, error) {
+	t.Helper()
+	window := &v1alpha1.SyncWindow{Kind: "deny", Calendar: calendar}
+	_, _ = loader.ResolveWindow(window)
+	require.Eventually(t, func() bool {
+		loader.lock.Lock()
+		defer loader.lock.Unlock()
+		entry, ok := loader.cache[calendar.Source()]
+		return !ok || (!entry.loading && !entry.attemptedAt.IsZero())
+	}, 5*time.Second, 10*time.Millisecond)
+	resolved, err := loader.ResolveWindow(window)
+	if err != nil {
+		return "", err
+	}
+	// the window is copied
+	assert.Empty(t, calendar.ICS)
+	return resolved.Calendar.ICS, nil
+}
+
+func TestCalendarLoader_ConfigMap(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "change-freeze", Namespace: "argocd"},
+		Data:       map[string]string{"holidays.ics": testCalendar},
+	}
+	loader := newTestCalendarLoader(t, map[string]string{"syncwindows.calendar.configmaps": "change-*, missing"}, cm)
+
+	data, err := resolveCalendar(t, loader, &v1alpha1.SyncWindowCalendar{ConfigMapRef: &v1alpha1.SyncWindowCalendarConfigMapRef{Name: "change-freeze", Key: "holidays.ics"}})
+	require.NoError(t, err)
+	assert.Equal(t, testCalendar, data)
+
+	_, err = resolveCalendar(t, loader, &v1alpha1.SyncWindowCalendar{ConfigMapRef: &v1alpha1.SyncWindowCalendarConfigMapRef{Name: "change-freeze", Key: "missing"}})
+	require.ErrorContains(t, err, "key missing not found in ConfigMap change-freeze")
+
+	_, err = resolveCalendar(t, loader, &v1alpha1.SyncWindowCalendar{ConfigMapRef: &v1alpha1.SyncWindowCalendarConfigMapRef{Name: "missing", Key: "holidays.ics"}})
+	require.ErrorContains(t, err, "error getting ConfigMap missing")
+
+	// ConfigMaps which are not permitted by the settings are not read
+	_, err = resolveCalendar(t, loader, &v1alpha1.SyncWindowCalendar{ConfigMapRef: &v1alpha1.SyncWindowCalendarConfigMapRef{Name: "argocd-secret", Key: "admin.password"}})
+	require.ErrorContains(t, err, "ConfigMap argocd-secret is not permitted")
+	assert.NotContains(t, loader.cache, "configmap:argocd-secret/admin.password")
+}
+
+func TestCalendarLoader_URL(t *testing.T) {
+	var requests atomic.Int32
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(int(status.Load()))
+		_, _ = w.Write([]byte(testCalendar))
+	}))
+	defer server.Close()
+	calendarSettings := map[string]string{"syncwindows.calendar.urls": server.URL + "/*"}
+	loader := newTestCalendarLoader(t, calendarSettings)
+	calendar := &v1alpha1.SyncWindowCalendar{URL: server.URL + "/change-freeze.ics"}
+
+	data, err := resolveCalendar(t, loader, calendar)
+	require.NoError(t, err)
+	assert.Equal(t, testCalendar, data)
+
+	// the calendar is cached
+	_, err = resolveCalendar(t, loader, calendar)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+
+	// the cached calendar is used if it cannot be refreshed
+	status.Store(http.StatusInternalServerError)
+	loader.lock.Lock()
+	loader.cache[calendar.Source()].attemptedAt = time.Now().Add(-2 * urlCacheTTL)
+	loader.lock.Unlock()
+	data, err = resolveCalendar(t, loader, calendar)
+	require.NoError(t, err)
+	assert.Equal(t, testCalendar, data)
+	assert.Equal(t, int32(2), requests.Load())
+
+	_, err = resolveCalendar(t, newTestCalendarLoader(t, calendarSettings), calendar)
+	require.ErrorContains(t, err, "unexpected status 500 Internal Server Error")
+
+	// URLs which are not permitted by the settings are not fetched
+	_, err = resolveCalendar(t, newTestCalendarLoader(t, nil), calendar)
+	require.ErrorContains(t, err, "URL is not permitted")
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestCalendarLoader_Redirect(t *testing.T) {
+	var forbiddenRequests atomic.Int32
+	forbidden := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		forbiddenRequests.Add(1)
+		_, _ = w.Write([]byte(testCalendar))
+	}))
+	defer forbidden.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/moved.ics":
+			http.Redirect(w, r, "/change-freeze.ics", http.StatusFound)
+		case "/forbidden.ics":
+			http.Redirect(w, r, forbidden.URL+"/change-freeze.ics", http.StatusFound)
+		default:
+			_, _ = w.Write([]byte(testCalendar))
+		}
+	}))
+	defer server.Close()
+	loader := newTestCalendarLoader(t, map[string]string{"syncwindows.calendar.urls": server.URL + "/*"})
+
+	// redirects to permitted URLs are followed
+	data, err := resolveCalendar(t, loader, &v1alpha1.SyncWindowCalendar{URL: server.URL + "/moved.ics"})
+	require.NoError(t, err)
+	assert.Equal(t, testCalendar, data)
+
+	// redirects to URLs which are not permitted by the settings are not followed
+	_, err = resolveCalendar(t, loader, &v1alpha1.SyncWindowCalendar{URL: server.URL + "/forbidden.ics"})
+	require.ErrorContains(t, err, "redirect to a URL which is not permitted")
+	assert.Equal(t, int32(0), forbiddenRequests.Load())
+}
+
+func TestCalendarLoader_Resolve(t *testing.T) {
+	var loader *CalendarLoader
+	windows := &v1alpha1.SyncWindows{{Kind: "deny", Calendar: &v1alpha1.SyncWindowCalendar{URL: "https://example.com/change-freeze.ics"}}}
+	resolved, err := loader.Resolve(windows)
+	require.NoError(t, err)
+	assert.Same(t, windows, resolved)
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "change-freeze", Namespace: "argocd"},
+		Data:       map[string]string{"holidays.ics": testCalendar},
+	}
+	loader = newTestCalendarLoader(t, map[string]string{"syncwindows.calendar.configmaps": "change-freeze"}, cm)
+	inline := &v1alpha1.SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h"}
+	ref := &v1alpha1.SyncWindow{Kind: "deny", Calendar: &v1alpha1.SyncWindowCalendar{ConfigMapRef: &v1alpha1.SyncWindowCalendarConfigMapRef{Name: "change-freeze", Key: "holidays.ics"}}}
+	windows = &v1alpha1.SyncWindows{inline, ref}
+
+	// the calendar is loaded in the background
+	_, err = loader.Resolve(windows)
+	require.ErrorContains(t, err, "cannot load calendar configmap:change-freeze/holidays.ics: the calendar has not been loaded yet")
+	require.Eventually(t, func() bool {
+		resolved, err = loader.Resolve(windows)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, *resolved, 2)
+	assert.Same(t, inline, (*resolved)[0])
+	assert.Equal(t, testCalendar, (*resolved)[1].Calendar.ICS)
+	assert.Equal(t, "change-freeze", (*resolved)[1].Calendar.ConfigMapRef.Name)
+	assert.Empty(t, ref.Calendar.ICS)
+}